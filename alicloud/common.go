@@ -6,6 +6,7 @@ import (
 
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -22,6 +23,9 @@ const defaultTimeout = 120
 // timeout for long time progerss product, rds e.g.
 const defaultLongTimeout = 1000
 
+// interval in seconds between two status checks while waiting
+const DefaultIntervalShort = 5
+
 func getRegion(d *schema.ResourceData, meta interface{}) common.Region {
 	return meta.(*AliyunClient).Region
 }
@@ -76,6 +80,8 @@ const COLON_SEPARATED = ":"
 
 const DOT_SEPARATED = "."
 
+const SLASH_SEPARATED = "/"
+
 const LOCAL_HOST_IP = "127.0.0.1"
 
 // Takes the result of flatmap.Expand for an array of strings
@@ -127,10 +133,33 @@ func getPagination(pageNumber, pageSize int) (pagination common.Pagination) {
 	return
 }
 
+// describeAllPages calls the describe with the pages one by one from the first page, until the describe
+// returns less items than the page size, so the results beyond the first page are not dropped.
+func describeAllPages(describe func(pagination common.Pagination) (int, error)) error {
+	pagination := getPagination(1, 50)
+	for {
+		count, err := describe(pagination)
+		if err != nil {
+			return err
+		}
+		if count < pagination.PageSize {
+			return nil
+		}
+		pagination.PageNumber++
+	}
+}
+
+// buildClientToken returns the ClientToken of a creation API, which is generated once for each resource creation
+// and shared by all its retried requests, so that the requests retried after the network timeouts do not create
+// duplicate resources.
+func buildClientToken(prefix string) string {
+	return resource.PrefixedUniqueId(prefix)
+}
+
 const CharityPageUrl = "http://promotion.alicdn.com/help/oss/error.html"
 
 func (client *AliyunClient) JudgeRegionValidation(key string, region common.Region) error {
-	regions, err := client.ecsconn.DescribeRegions()
+	regions, err := client.ecsconn().DescribeRegions()
 	if err != nil {
 		return fmt.Errorf("DescribeRegions got an error: %#v", err)
 	}
@@ -144,3 +173,13 @@ func (client *AliyunClient) JudgeRegionValidation(key string, region common.Regi
 	}
 	return fmt.Errorf("'%s' is invalid. Expected on %v.", key, strings.Join(rs, ", "))
 }
+
+// parseResourceId splits the ID of a resource which is composed of several parts joined by ':',
+// for example '<cluster id>:<account name>', and checks the number of the parts.
+func parseResourceId(id string, length int) (parts []string, err error) {
+	parts = strings.SplitN(id, COLON_SEPARATED, length)
+	if len(parts) != length {
+		err = ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid resource id %s, expected %d parts separated by '%s'.", id, length, COLON_SEPARATED)
+	}
+	return parts, err
+}
@@ -17,7 +17,11 @@ import (
 	"github.com/denverdino/aliyungo/cs"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
+	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Config of aliyun
@@ -25,23 +29,44 @@ type Config struct {
 	AccessKey string
 	SecretKey string
 	Region    common.Region
+	// Endpoints overrides the default endpoint of the service clients, keyed by the service name like "ecs".
+	Endpoints map[string]string
+	// MaxRetries is the maximum number of retries for the throttling and the transient server errors.
+	MaxRetries int
+	// MaxRequestsPerSecond limits the requests per second to each service, and 0 means no limit.
+	MaxRequestsPerSecond int
+	// Proxy is the URL of the proxy for all the service clients. The HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables are used when it is empty.
+	Proxy string
+	// SkipRegionValidation allows the regions which are not in the SDK region list, like the new or the finance cloud ones.
+	SkipRegionValidation bool
+	// ResourceGroupId is the default resource group of the resources which support the resource group.
+	ResourceGroupId string
+	// DefaultTags are set on all the resources which support the tags, and the tags of a resource override them.
+	DefaultTags map[string]string
+	// ClientTimeout is the default client timeout of all the services.
+	ClientTimeout ClientTimeout
+	// ServiceClientTimeouts override the ClientTimeout of the services, keyed by the service name like "rds".
+	ServiceClientTimeouts map[string]ClientTimeout
+
+	// transport is the apiTransport of the service clients, which is created by the Client.
+	transport *apiTransport
 }
 
-// AliyunClient of aliyun
+// AliyunClient of aliyun. The service clients are created on first use and cached, so that the services which
+// are not used by the configuration never need to be reachable.
 type AliyunClient struct {
-	Region  common.Region
-	ecsconn *ecs.Client
-	essconn *ess.Client
-	rdsconn *rds.Client
-	// use new version
-	ecsNewconn *ecs.Client
-	vpcconn    *ecs.Client
-	slbconn    *slb.Client
-	ossconn    *oss.Client
-	dnsconn    *dns.Client
-	ramconn    ram.RamClientInterface
-	csconn     *cs.Client
-	cdnconn    *cdn.CdnClient
+	Region common.Region
+
+	// ResourceGroupId is the default resource group of the resources which support the resource group.
+	ResourceGroupId string
+	// DefaultTags are set on all the resources which support the tags, and the tags of a resource override them.
+	DefaultTags map[string]string
+
+	config   *Config
+	connLock sync.Mutex
+	// conns are the created service clients, keyed by the service name like "ecs".
+	conns map[string]interface{}
 }
 
 // Client for AliyunClient
@@ -51,79 +76,375 @@ func (c *Config) Client() (*AliyunClient, error) {
 		return nil, err
 	}
 
-	ecsconn, err := c.ecsConn()
-	if err != nil {
-		return nil, err
-	}
-
-	ecsNewconn, err := c.ecsConn()
-	if err != nil {
-		return nil, err
-	}
-	ecsNewconn.SetVersion(EcsApiVersion20160314)
-
-	rdsconn, err := c.rdsConn()
-	if err != nil {
-		return nil, err
-	}
+	c.transport = newApiTransport(c)
 
-	slbconn, err := c.slbConn()
-	if err != nil {
-		return nil, err
-	}
+	return &AliyunClient{
+		Region:          c.Region,
+		ResourceGroupId: c.ResourceGroupId,
+		DefaultTags:     c.DefaultTags,
+		config:          c,
+		conns:           make(map[string]interface{}),
+	}, nil
+}
 
-	vpcconn, err := c.vpcConn()
-	if err != nil {
-		return nil, err
-	}
+// serviceConn returns the client of the service, which is created by the newConn on first use.
+func (client *AliyunClient) serviceConn(service string, newConn func() (interface{}, error)) (interface{}, error) {
+	client.connLock.Lock()
+	defer client.connLock.Unlock()
 
-	essconn, err := c.essConn()
-	if err != nil {
-		return nil, err
-	}
-	ossconn, err := c.ossConn()
-	if err != nil {
-		return nil, err
+	if conn, ok := client.conns[service]; ok {
+		return conn, nil
 	}
-	dnsconn, err := c.dnsConn()
+	conn, err := newConn()
 	if err != nil {
 		return nil, err
 	}
-	ramconn, err := c.ramConn()
-	if err != nil {
-		return nil, err
-	}
-	csconn, err := c.csConn()
-	if err != nil {
-		return nil, err
+	if client.conns == nil {
+		client.conns = make(map[string]interface{})
 	}
-	cdnconn, err := c.cdnConn()
+	client.conns[service] = conn
+	return conn, nil
+}
+
+// mustServiceConn returns the client of the service whose creation never fails.
+func (client *AliyunClient) mustServiceConn(service string, newConn func() interface{}) interface{} {
+	conn, _ := client.serviceConn(service, func() (interface{}, error) {
+		return newConn(), nil
+	})
+	return conn
+}
+
+// ossconn returns the error of the creation of the OSS client, as its endpoint is looked up by the location API
+// when it is not set, while the creation of the other service clients never fails.
+func (client *AliyunClient) ossconn() (*oss.Client, error) {
+	conn, err := client.serviceConn("oss", func() (interface{}, error) {
+		return client.config.ossConn()
+	})
 	if err != nil {
 		return nil, err
 	}
+	return conn.(*oss.Client), nil
+}
 
-	return &AliyunClient{
-		Region:     c.Region,
-		ecsconn:    ecsconn,
-		ecsNewconn: ecsNewconn,
-		vpcconn:    vpcconn,
-		slbconn:    slbconn,
-		rdsconn:    rdsconn,
-		essconn:    essconn,
-		ossconn:    ossconn,
-		dnsconn:    dnsconn,
-		ramconn:    ramconn,
-		csconn:     csconn,
-		cdnconn:    cdnconn,
-	}, nil
+// ecsconn and vpcconn still return the concrete client rather than an interface like SLBService. The client
+// is shared by the ECS and VPC resources and passed to the helpers like AddTags, and the over 80 APIs they
+// call would have to be wrapped at once, so only the SLB resources can be tested against the fakes so far.
+func (client *AliyunClient) ecsconn() *ecs.Client {
+	return client.mustServiceConn("ecs", func() interface{} {
+		return client.config.ecsConn()
+	}).(*ecs.Client)
+}
+
+func (client *AliyunClient) essconn() *ess.Client {
+	return client.mustServiceConn("ess", func() interface{} {
+		return client.config.essConn()
+	}).(*ess.Client)
+}
+
+func (client *AliyunClient) rdsconn() *rds.Client {
+	return client.mustServiceConn("rds", func() interface{} {
+		return client.config.rdsConn()
+	}).(*rds.Client)
+}
+
+func (client *AliyunClient) vpcconn() *ecs.Client {
+	return client.mustServiceConn("vpc", func() interface{} {
+		return client.config.vpcConn()
+	}).(*ecs.Client)
+}
+
+func (client *AliyunClient) slbconn() SLBService {
+	return client.mustServiceConn("slb", func() interface{} {
+		return client.config.slbConn()
+	}).(SLBService)
+}
+
+func (client *AliyunClient) dnsconn() *dns.Client {
+	return client.mustServiceConn("dns", func() interface{} {
+		return client.config.dnsConn()
+	}).(*dns.Client)
+}
+
+func (client *AliyunClient) ramconn() ram.RamClientInterface {
+	return client.mustServiceConn("ram", func() interface{} {
+		return client.config.ramConn()
+	}).(ram.RamClientInterface)
+}
+
+func (client *AliyunClient) csconn() *cs.Client {
+	return client.mustServiceConn("cs", func() interface{} {
+		return client.config.csConn()
+	}).(*cs.Client)
+}
+
+func (client *AliyunClient) cdnconn() *cdn.CdnClient {
+	return client.mustServiceConn("cdn", func() interface{} {
+		return client.config.cdnConn()
+	}).(*cdn.CdnClient)
+}
+
+func (client *AliyunClient) cdnNewconn() *cdn.CdnClient {
+	return client.mustServiceConn("cdnNew", func() interface{} {
+		conn := client.config.cdnConn()
+		conn.SetVersion(CdnApiVersion20180510)
+		return conn
+	}).(*cdn.CdnClient)
+}
+
+func (client *AliyunClient) kvstoreconn() *common.Client {
+	return client.mustServiceConn("kvstore", func() interface{} {
+		return client.config.kvstoreConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) mongodbconn() *common.Client {
+	return client.mustServiceConn("mongodb", func() interface{} {
+		return client.config.mongodbConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) ocsconn() *common.Client {
+	return client.mustServiceConn("ocs", func() interface{} {
+		return client.config.ocsConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) polardbconn() *common.Client {
+	return client.mustServiceConn("polardb", func() interface{} {
+		return client.config.polardbConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) hbaseconn() *common.Client {
+	return client.mustServiceConn("hbase", func() interface{} {
+		return client.config.hbaseConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) adbconn() *common.Client {
+	return client.mustServiceConn("adb", func() interface{} {
+		return client.config.adbConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) clickhouseconn() *common.Client {
+	return client.mustServiceConn("clickhouse", func() interface{} {
+		return client.config.clickhouseConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) crconn() *cs.Client {
+	return client.mustServiceConn("cr", func() interface{} {
+		return client.config.crConn()
+	}).(*cs.Client)
+}
+
+func (client *AliyunClient) dcdnconn() *common.Client {
+	return client.mustServiceConn("dcdn", func() interface{} {
+		return client.config.dcdnConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) onsconn() *common.Client {
+	return client.mustServiceConn("ons", func() interface{} {
+		return client.config.onsConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) alikafkaconn() *common.Client {
+	return client.mustServiceConn("alikafka", func() interface{} {
+		return client.config.alikafkaConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) bssconn() *common.Client {
+	return client.mustServiceConn("bss", func() interface{} {
+		return client.config.bssConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) amqpconn() *common.Client {
+	return client.mustServiceConn("amqp", func() interface{} {
+		return client.config.amqpConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) stsconn() *common.Client {
+	return client.mustServiceConn("sts", func() interface{} {
+		return client.config.stsConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) mnsconn() *MnsClient {
+	return client.mustServiceConn("mns", func() interface{} {
+		return client.config.mnsConn()
+	}).(*MnsClient)
+}
+
+func (client *AliyunClient) eventbridgeconn() *common.Client {
+	return client.mustServiceConn("eventbridge", func() interface{} {
+		return client.config.eventbridgeConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) cmsconn() *common.Client {
+	return client.mustServiceConn("cms", func() interface{} {
+		return client.config.cmsConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) logconn() *LogClient {
+	return client.mustServiceConn("log", func() interface{} {
+		return client.config.logConn()
+	}).(*LogClient)
+}
+
+func (client *AliyunClient) fcconn() *FcClient {
+	return client.mustServiceConn("fc", func() interface{} {
+		return client.config.fcConn()
+	}).(*FcClient)
+}
+
+func (client *AliyunClient) cloudapiconn() *common.Client {
+	return client.mustServiceConn("cloudapi", func() interface{} {
+		return client.config.cloudapiConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) saeconn() *cs.Client {
+	return client.mustServiceConn("sae", func() interface{} {
+		return client.config.saeConn()
+	}).(*cs.Client)
+}
+
+func (client *AliyunClient) rosconn() *common.Client {
+	return client.mustServiceConn("ros", func() interface{} {
+		return client.config.rosConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) wafconn() *common.Client {
+	return client.mustServiceConn("waf", func() interface{} {
+		return client.config.wafConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) ddoscooconn() *common.Client {
+	return client.mustServiceConn("ddoscoo", func() interface{} {
+		return client.config.ddoscooConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) configconn() *common.Client {
+	return client.mustServiceConn("config", func() interface{} {
+		return client.config.configConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) bastionhostconn() *common.Client {
+	return client.mustServiceConn("bastionhost", func() interface{} {
+		return client.config.bastionhostConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) cloudfwconn() *common.Client {
+	return client.mustServiceConn("cloudfw", func() interface{} {
+		return client.config.cloudfwConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) hbrconn() *common.Client {
+	return client.mustServiceConn("hbr", func() interface{} {
+		return client.config.hbrConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) dtsconn() *common.Client {
+	return client.mustServiceConn("dts", func() interface{} {
+		return client.config.dtsConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) oosconn() *common.Client {
+	return client.mustServiceConn("oos", func() interface{} {
+		return client.config.oosConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) emrconn() *common.Client {
+	return client.mustServiceConn("emr", func() interface{} {
+		return client.config.emrConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) fnfconn() *common.Client {
+	return client.mustServiceConn("fnf", func() interface{} {
+		return client.config.fnfConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) privatelinkconn() *common.Client {
+	return client.mustServiceConn("privatelink", func() interface{} {
+		return client.config.privatelinkConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) cenconn() *common.Client {
+	return client.mustServiceConn("cen", func() interface{} {
+		return client.config.cenConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) sagconn() *common.Client {
+	return client.mustServiceConn("sag", func() interface{} {
+		return client.config.sagConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) mseconn() *common.Client {
+	return client.mustServiceConn("mse", func() interface{} {
+		return client.config.mseConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) marketconn() *common.Client {
+	return client.mustServiceConn("market", func() interface{} {
+		return client.config.marketConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) quotasconn() *common.Client {
+	return client.mustServiceConn("quotas", func() interface{} {
+		return client.config.quotasConn()
+	}).(*common.Client)
+}
+
+func (client *AliyunClient) dmconn() *common.Client {
+	return client.mustServiceConn("dm", func() interface{} {
+		return client.config.dmConn()
+	}).(*common.Client)
 }
 
 const BusinessInfoKey = "Terraform"
 
+// The default timeouts of the OSS client.
+const (
+	ossDefaultConnectTimeout   = 30 * time.Second
+	ossDefaultReadWriteTimeout = 60 * time.Second
+)
+
 func (c *Config) loadAndValidate() error {
-	err := c.validateRegion()
-	if err != nil {
-		return err
+	if !c.SkipRegionValidation {
+		err := c.validateRegion()
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.Proxy != "" {
+		if _, err := url.Parse(c.Proxy); err != nil {
+			return ConfigErrorf(ErrorCodeInvalidProviderConfig, "Not a valid proxy: %s", c.Proxy)
+		}
 	}
 
 	return nil
@@ -137,102 +458,597 @@ func (c *Config) validateRegion() error {
 		}
 	}
 
-	return fmt.Errorf("Not a valid region: %s", c.Region)
+	return ConfigErrorf(ErrorCodeInvalidProviderConfig, "Not a valid region: %s. Set skip_region_validation to use a region which is not known by the provider yet.", c.Region)
 }
 
-func (c *Config) ecsConn() (*ecs.Client, error) {
+func (c *Config) ecsConn() *ecs.Client {
 	client := ecs.NewECSClient(c.AccessKey, c.SecretKey, c.Region)
+	if endpoint, ok := c.Endpoints["ecs"]; ok {
+		client = ecs.NewECSClientWithEndpoint(endpoint, c.AccessKey, c.SecretKey, c.Region)
+	}
 	client.SetBusinessInfo(BusinessInfoKey)
 	client.SetUserAgent(getUserAgent())
-
-	_, err := client.DescribeRegions()
-
-	if err != nil {
-		return nil, err
-	}
-
-	return client, nil
+	c.setTransport(client)
+	return client
 }
 
-func (c *Config) rdsConn() (*rds.Client, error) {
+func (c *Config) rdsConn() *rds.Client {
 	client := rds.NewRDSClient(c.AccessKey, c.SecretKey, c.Region)
+	if endpoint, ok := c.Endpoints["rds"]; ok {
+		client = rds.NewRDSClientWithEndpoint(endpoint, c.AccessKey, c.SecretKey, c.Region)
+	}
 	client.SetBusinessInfo(BusinessInfoKey)
 	client.SetUserAgent(getUserAgent())
-	return client, nil
+	c.setTransport(client)
+	return client
 }
 
-func (c *Config) slbConn() (*slb.Client, error) {
+func (c *Config) slbConn() *slb.Client {
 	client := slb.NewSLBClient(c.AccessKey, c.SecretKey, c.Region)
+	if endpoint, ok := c.Endpoints["slb"]; ok {
+		client = slb.NewSLBClientWithEndpoint(endpoint, c.AccessKey, c.SecretKey, c.Region)
+	}
 	client.SetBusinessInfo(BusinessInfoKey)
 	client.SetUserAgent(getUserAgent())
-	return client, nil
+	c.setTransport(client)
+	return client
 }
 
-func (c *Config) vpcConn() (*ecs.Client, error) {
+func (c *Config) vpcConn() *ecs.Client {
 	client := ecs.NewVPCClient(c.AccessKey, c.SecretKey, c.Region)
+	if endpoint, ok := c.Endpoints["vpc"]; ok {
+		client = ecs.NewVPCClientWithEndpoint(endpoint, c.AccessKey, c.SecretKey, c.Region)
+	}
 	client.SetBusinessInfo(BusinessInfoKey)
 	client.SetUserAgent(getUserAgent())
-	return client, nil
+	c.setTransport(client)
+	return client
 
 }
-func (c *Config) essConn() (*ess.Client, error) {
+func (c *Config) essConn() *ess.Client {
 	client := ess.NewESSClient(c.AccessKey, c.SecretKey, c.Region)
+	if endpoint, ok := c.Endpoints["ess"]; ok {
+		client = ess.NewESSClientWithEndpoint(endpoint, c.AccessKey, c.SecretKey, c.Region)
+	}
 	client.SetBusinessInfo(BusinessInfoKey)
 	client.SetUserAgent(getUserAgent())
-	return client, nil
+	c.setTransport(client)
+	return client
 }
 func (c *Config) ossConn() (*oss.Client, error) {
+	endpoint, ok := c.Endpoints["oss"]
+	if !ok {
+		endpointClient := location.NewClient(c.AccessKey, c.SecretKey)
+		c.setTransport(endpointClient)
+		args := &location.DescribeEndpointsArgs{
+			Id:          c.Region,
+			ServiceCode: "oss",
+			Type:        "openAPI",
+		}
 
-	endpointClient := location.NewClient(c.AccessKey, c.SecretKey)
-	args := &location.DescribeEndpointsArgs{
-		Id:          c.Region,
-		ServiceCode: "oss",
-		Type:        "openAPI",
+		endpoints, err := endpointClient.DescribeEndpoints(args)
+		if err != nil {
+			return nil, fmt.Errorf("Describe endpoint using region: %#v got an error: %#v.", c.Region, err)
+		}
+		endpointItem := endpoints.Endpoints.Endpoint
+		if endpointItem == nil || len(endpointItem) <= 0 {
+			// return nil, fmt.Errorf("Cannot find endpoint in the region: %#v", c.Region")
+			log.Printf("Cannot find endpoint in the region: %#v", c.Region)
+			endpoint = ""
+		} else {
+			endpoint = strings.ToLower(endpointItem[0].Protocols.Protocols[0]) + "://" + endpointItem[0].Endpoint
+		}
 	}
 
-	endpoints, err := endpointClient.DescribeEndpoints(args)
-	if err != nil {
-		return nil, fmt.Errorf("Describe endpoint using region: %#v got an error: %#v.", c.Region, err)
+	options := []oss.ClientOption{oss.UserAgent(getUserAgent())}
+	// The OSS client has its own transport, so the client timeout is passed to it explicitly. Its zero
+	// timeouts are the default ones of the OSS client, because a zero read timeout fails all the requests.
+	if timeout := c.serviceClientTimeout("oss"); timeout != (ClientTimeout{}) {
+		connect, read := int64(ossDefaultConnectTimeout/time.Second), int64(ossDefaultReadWriteTimeout/time.Second)
+		if timeout.Connect > 0 {
+			connect = int64(timeout.Connect / time.Second)
+		}
+		if timeout.Read > 0 {
+			read = int64(timeout.Read / time.Second)
+		}
+		options = append(options, oss.Timeout(connect, read))
 	}
-	endpointItem := endpoints.Endpoints.Endpoint
-	var endpoint string
-	if endpointItem == nil || len(endpointItem) <= 0 {
-		// return nil, fmt.Errorf("Cannot find endpoint in the region: %#v", c.Region")
-		log.Printf("Cannot find endpoint in the region: %#v", c.Region)
-		endpoint = ""
-	} else {
-		endpoint = strings.ToLower(endpointItem[0].Protocols.Protocols[0]) + "://" + endpointItem[0].Endpoint
+	// The OSS client has its own transport, so the proxy is passed to it explicitly.
+	if proxy, err := c.proxyForEndpoint(endpoint); err != nil {
+		return nil, err
+	} else if proxy != nil {
+		if proxy.User != nil {
+			password, _ := proxy.User.Password()
+			options = append(options, oss.AuthProxy(proxyWithoutUser(proxy), proxy.User.Username(), password))
+		} else {
+			options = append(options, oss.Proxy(proxy.String()))
+		}
 	}
 
 	log.Printf("[DEBUG] Instantiate OSS client using endpoint: %#v", endpoint)
-	client, err := oss.New(endpoint, c.AccessKey, c.SecretKey, oss.UserAgent(getUserAgent()))
+	client, err := oss.New(endpoint, c.AccessKey, c.SecretKey, options...)
 
 	return client, err
 }
 
-func (c *Config) dnsConn() (*dns.Client, error) {
+func (c *Config) dnsConn() *dns.Client {
 	client := dns.NewClientNew(c.AccessKey, c.SecretKey)
+	if endpoint, ok := c.Endpoints["dns"]; ok {
+		client = dns.NewCustomClient(c.AccessKey, c.SecretKey, endpoint)
+	}
 	client.SetBusinessInfo(BusinessInfoKey)
 	client.SetUserAgent(getUserAgent())
-	return client, nil
+	c.setTransport(client)
+	return client
 }
 
-func (c *Config) ramConn() (ram.RamClientInterface, error) {
+func (c *Config) ramConn() ram.RamClientInterface {
 	client := ram.NewClient(c.AccessKey, c.SecretKey)
-	return client, nil
+	if endpoint, ok := c.Endpoints["ram"]; ok {
+		client = ram.NewClientWithEndpoint(endpoint, c.AccessKey, c.SecretKey)
+	}
+	c.setTransport(client)
+	return client
 }
 
-func (c *Config) csConn() (*cs.Client, error) {
+func (c *Config) csConn() *cs.Client {
 	client := cs.NewClient(c.AccessKey, c.SecretKey)
+	if endpoint, ok := c.Endpoints["cs"]; ok {
+		client = cs.NewClientWithEndpoint(endpoint, c.AccessKey, c.SecretKey)
+	}
 	client.SetUserAgent(getUserAgent())
-	return client, nil
+	c.setTransport(client)
+	return client
 }
 
-func (c *Config) cdnConn() (*cdn.CdnClient, error) {
+func (c *Config) cdnConn() *cdn.CdnClient {
 	client := cdn.NewClient(c.AccessKey, c.SecretKey)
+	if endpoint, ok := c.Endpoints["cdn"]; ok {
+		client.SetEndpoint(endpoint)
+	}
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) kvstoreConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("kvstore", KVStoreEndpoint), KVStoreApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) mongodbConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("mongodb", MongoDBEndpoint), MongoDBApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) ocsConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("ocs", OcsEndpoint), OcsApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) polardbConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("polardb", PolarDBEndpoint), PolarDBApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) hbaseConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("hbase", HBaseEndpoint), HBaseApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) adbConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("adb", ADBEndpoint), ADBApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) clickhouseConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("clickhouse", ClickHouseEndpoint), ClickHouseApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) crConn() *cs.Client {
+	client := cs.NewClientWithEndpoint(c.endpoint("cr", fmt.Sprintf(CrEndpointTemplate, c.Region)), c.AccessKey, c.SecretKey)
+	client.Version = CrApiVersion
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) dcdnConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("dcdn", DcdnEndpoint), DcdnApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) onsConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("ons", fmt.Sprintf(OnsEndpointTemplate, c.Region)), OnsApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) alikafkaConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("alikafka", fmt.Sprintf(AlikafkaEndpointTemplate, c.Region)), AlikafkaApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) bssConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("bss", BssEndpoint), BssApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) amqpConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("amqp", fmt.Sprintf(AmqpEndpointTemplate, c.Region)), AmqpApiVersion, c.AccessKey, c.SecretKey)
 	client.SetBusinessInfo(BusinessInfoKey)
 	client.SetUserAgent(getUserAgent())
-	return client, nil
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) stsConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("sts", StsEndpoint), StsApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) mnsConn() *MnsClient {
+	client := NewMnsClient(c.AccessKey, c.SecretKey, c.Region)
+	client.Endpoint = c.Endpoints["mns"]
+	client.UserAgent = getUserAgent()
+	if c.transport != nil {
+		client.httpClient.Transport = c.transport
+	}
+	if timeout := c.serviceClientTimeout("mns"); timeout.Read > 0 {
+		client.httpClient.Timeout = timeout.Read
+	}
+	return client
+}
+
+func (c *Config) eventbridgeConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("eventbridge", fmt.Sprintf(EventBridgeEndpointTemplate, c.Region)), EventBridgeApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) cmsConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("cms", CmsEndpoint), CmsApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) logConn() *LogClient {
+	client := NewLogClient(c.AccessKey, c.SecretKey, c.Region)
+	client.Endpoint = c.Endpoints["log"]
+	client.UserAgent = getUserAgent()
+	if c.transport != nil {
+		client.httpClient.Transport = c.transport
+	}
+	if timeout := c.serviceClientTimeout("log"); timeout.Read > 0 {
+		client.httpClient.Timeout = timeout.Read
+	}
+	return client
+}
+
+func (c *Config) fcConn() *FcClient {
+	client := NewFcClient(c.AccessKey, c.SecretKey, c.Region)
+	client.Endpoint = c.Endpoints["fc"]
+	client.UserAgent = getUserAgent()
+	if c.transport != nil {
+		client.httpClient.Transport = c.transport
+	}
+	if timeout := c.serviceClientTimeout("fc"); timeout.Read > 0 {
+		client.httpClient.Timeout = timeout.Read
+	}
+	return client
+}
+
+func (c *Config) cloudapiConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("cloudapi", fmt.Sprintf(CloudApiEndpointTemplate, c.Region)), CloudApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) saeConn() *cs.Client {
+	client := cs.NewClientWithEndpoint(c.endpoint("sae", fmt.Sprintf(SaeEndpointTemplate, c.Region)), c.AccessKey, c.SecretKey)
+	client.Version = SaeApiVersion
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) rosConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("ros", RosEndpoint), RosApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+// centralServiceRegion returns the region serving the APIs of the security services, like WAF and
+// Anti-DDoS Pro, which are only served in the cn-hangzhou for the mainland instances and the
+// ap-southeast-1 for the others.
+func centralServiceRegion(region common.Region) string {
+	if strings.HasPrefix(string(region), "cn-") {
+		return "cn-hangzhou"
+	}
+	return "ap-southeast-1"
+}
+
+func (c *Config) wafConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("waf", fmt.Sprintf(WafEndpointTemplate, centralServiceRegion(c.Region))), WafApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) ddoscooConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("ddoscoo", fmt.Sprintf(DdoscooEndpointTemplate, centralServiceRegion(c.Region))), DdoscooApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+// The Cloud Config API is served in the cn-shanghai instead of the cn-hangzhou for the mainland accounts.
+func (c *Config) configConn() *common.Client {
+	region := centralServiceRegion(c.Region)
+	if region == "cn-hangzhou" {
+		region = "cn-shanghai"
+	}
+	client := &common.Client{}
+	client.Init(c.endpoint("config", fmt.Sprintf(ConfigEndpointTemplate, region)), ConfigApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) bastionhostConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("bastionhost", BastionhostEndpoint), BastionhostApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) cloudfwConn() *common.Client {
+	endpoint := CloudfwEndpoint
+	if region := centralServiceRegion(c.Region); region != "cn-hangzhou" {
+		endpoint = fmt.Sprintf(CloudfwEndpointTemplate, region)
+	}
+	client := &common.Client{}
+	client.Init(c.endpoint("cloudfw", endpoint), CloudfwApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) hbrConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("hbr", fmt.Sprintf(HbrEndpointTemplate, c.Region)), HbrApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) dtsConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("dts", fmt.Sprintf(DtsEndpointTemplate, c.Region)), DtsApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) oosConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("oos", fmt.Sprintf(OosEndpointTemplate, c.Region)), OosApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) emrConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("emr", fmt.Sprintf(EmrEndpointTemplate, c.Region)), EmrApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) fnfConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("fnf", fmt.Sprintf(FnfEndpointTemplate, c.Region)), FnfApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) privatelinkConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("privatelink", fmt.Sprintf(PrivateLinkEndpointTemplate, c.Region)), PrivateLinkApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) cenConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("cen", CenEndpoint), CenApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) sagConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("sag", fmt.Sprintf(SagEndpointTemplate, c.Region)), SagApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) mseConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("mse", fmt.Sprintf(MseEndpointTemplate, c.Region)), MseApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) marketConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("market", MarketEndpoint), MarketApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) quotasConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("quotas", QuotasEndpoint), QuotasApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+func (c *Config) dmConn() *common.Client {
+	client := &common.Client{}
+	client.Init(c.endpoint("dm", directMailEndpoint(c.Region)), DirectMailApiVersion, c.AccessKey, c.SecretKey)
+	client.SetBusinessInfo(BusinessInfoKey)
+	client.SetUserAgent(getUserAgent())
+	c.setTransport(client)
+	return client
+}
+
+// setTransport sets the apiTransport of the config on the SDK client. The client is left with the
+// http.DefaultTransport when the SDK does not support a custom transport.
+func (c *Config) setTransport(client interface{}) {
+	if c.transport == nil {
+		return
+	}
+	if sc, ok := client.(interface {
+		SetTransport(http.RoundTripper)
+	}); ok {
+		sc.SetTransport(c.transport)
+		return
+	}
+	log.Printf("[WARN] The %T client does not support a custom transport, so its requests are not retried.", client)
+}
+
+// proxyFunc returns the proxy function of the transports of the service clients.
+func (c *Config) proxyFunc() func(*http.Request) (*url.URL, error) {
+	if c.Proxy == "" {
+		return http.ProxyFromEnvironment
+	}
+	proxy, _ := url.Parse(c.Proxy)
+	return http.ProxyURL(proxy)
+}
+
+// proxyForEndpoint returns the proxy for the requests to the endpoint, and nil means no proxy.
+func (c *Config) proxyForEndpoint(endpoint string) (*url.URL, error) {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.proxyFunc()(req)
+}
+
+func proxyWithoutUser(proxy *url.URL) string {
+	u := *proxy
+	u.User = nil
+	return u.String()
+}
+
+// serviceClientTimeout returns the client timeout of the service, whose zero timeouts are the default ones.
+func (c *Config) serviceClientTimeout(service string) ClientTimeout {
+	timeout := c.ServiceClientTimeouts[service]
+	if timeout.Connect == 0 {
+		timeout.Connect = c.ClientTimeout.Connect
+	}
+	if timeout.Read == 0 {
+		timeout.Read = c.ClientTimeout.Read
+	}
+	return timeout
+}
+
+// endpoint returns the custom endpoint of the service if it is specified in the provider, or the defaultEndpoint.
+func (c *Config) endpoint(service, defaultEndpoint string) string {
+	if endpoint, ok := c.Endpoints[service]; ok {
+		return endpoint
+	}
+	return defaultEndpoint
 }
 
 func getUserAgent() string {
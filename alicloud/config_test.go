@@ -0,0 +1,52 @@
+package alicloud
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+func TestAliyunClient_serviceConn(t *testing.T) {
+	client := &AliyunClient{
+		Region: common.Hangzhou,
+		config: &Config{
+			AccessKey: "access-key",
+			SecretKey: "secret-key",
+			Region:    common.Hangzhou,
+			// The unreachable endpoint is never requested, as the clients are created on first use.
+			Endpoints: map[string]string{"ecs": "http://127.0.0.1:1"},
+		},
+	}
+
+	if len(client.conns) != 0 {
+		t.Fatalf("expected no service clients before the first use, got %d", len(client.conns))
+	}
+
+	var wg sync.WaitGroup
+	conns := make([]*common.Client, 10)
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conns[i] = client.mseconn()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, conn := range conns {
+		if conn != conns[0] {
+			t.Fatalf("expected the mse client to be created once and cached")
+		}
+	}
+	if _, ok := client.conns["ecs"]; ok {
+		t.Fatalf("expected the ecs client not to be created")
+	}
+
+	if client.ecsconn() != client.ecsconn() {
+		t.Fatalf("expected the ecs client to be cached")
+	}
+	if len(client.conns) != 2 {
+		t.Fatalf("expected 2 service clients, got %d", len(client.conns))
+	}
+}
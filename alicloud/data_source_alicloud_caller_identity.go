@@ -0,0 +1,72 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCallerIdentity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCallerIdentityRead,
+
+		Schema: map[string]*schema.Schema{
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	identity, err := meta.(*AliyunClient).DescribeCallerIdentity()
+	if err != nil {
+		return err
+	}
+
+	d.SetId(identity.AccountId)
+	d.Set("account_id", identity.AccountId)
+	d.Set("arn", identity.Arn)
+	d.Set("identity_type", identity.IdentityType)
+	d.Set("user_id", identity.UserId)
+	d.Set("role_id", identity.RoleId)
+	d.Set("principal_id", identity.PrincipalId)
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		s := map[string]interface{}{
+			"account_id":    identity.AccountId,
+			"arn":           identity.Arn,
+			"identity_type": identity.IdentityType,
+			"user_id":       identity.UserId,
+			"role_id":       identity.RoleId,
+			"principal_id":  identity.PrincipalId,
+		}
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCallerIdentityDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudCallerIdentityDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_caller_identity.current"),
+					resource.TestMatchResourceAttr("data.alicloud_caller_identity.current", "account_id", regexp.MustCompile("^[0-9]+$")),
+					resource.TestMatchResourceAttr("data.alicloud_caller_identity.current", "arn", regexp.MustCompile("^acs:ram::[0-9]+:")),
+					resource.TestCheckResourceAttrSet("data.alicloud_caller_identity.current", "identity_type"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudCallerIdentityDataSourceBasic = `
+data "alicloud_caller_identity" "current" {
+}`
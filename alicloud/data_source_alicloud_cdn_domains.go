@@ -0,0 +1,176 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCdnDomains() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCdnDomainsRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain_name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNameRegex,
+			},
+			"domain_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(CdnDomainOnline), string(CdnDomainOffline), string(CdnDomainConfiguring),
+					string(CdnDomainConfigureFailed), string(CdnDomainChecking), string(CdnDomainCheckFailed)}),
+			},
+			"cdn_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCdnType,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cdn_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"ssl_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gmt_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gmt_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCdnDomainsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AliyunClient).cdnconn()
+
+	args := DescribeCdnUserDomainsArgs{
+		DomainStatus: d.Get("domain_status").(string),
+		CdnType:      d.Get("cdn_type").(string),
+	}
+
+	var allDomains []CdnUserDomain
+	pagination := getPagination(1, 50)
+	for {
+		args.PageSize = pagination.PageSize
+		args.PageNumber = pagination.PageNumber
+		resp := DescribeCdnUserDomainsResponse{}
+		if err := conn.Invoke("DescribeUserDomains", &args, &resp); err != nil {
+			return WrapError(err, "DescribeUserDomains", "")
+		}
+		allDomains = append(allDomains, resp.Domains.PageData...)
+
+		if len(resp.Domains.PageData) < pagination.PageSize {
+			break
+		}
+		pagination.PageNumber += 1
+	}
+
+	var filteredDomains []CdnUserDomain
+	for _, domain := range allDomains {
+		if v, ok := d.GetOk("domain_name_regex"); ok && v.(string) != "" {
+			r := regexp.MustCompile(v.(string))
+			if !r.MatchString(domain.DomainName) {
+				continue
+			}
+		}
+
+		filteredDomains = append(filteredDomains, domain)
+	}
+
+	if len(filteredDomains) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_cdn_domains - Domains found: %#v", filteredDomains)
+
+	return cdnDomainsDescriptionAttributes(d, filteredDomains)
+}
+
+func cdnDomainsDescriptionAttributes(d *schema.ResourceData, domains []CdnUserDomain) error {
+	var ids []string
+	var s []map[string]interface{}
+	for _, domain := range domains {
+		mapping := map[string]interface{}{
+			"domain_name":   domain.DomainName,
+			"cname":         domain.Cname,
+			"cdn_type":      domain.CdnType,
+			"domain_status": domain.DomainStatus,
+			"source_type":   domain.SourceType,
+			"sources":       domain.Sources.Source,
+			"ssl_protocol":  domain.SslProtocol,
+			"description":   domain.Description,
+			"gmt_created":   domain.GmtCreated,
+			"gmt_modified":  domain.GmtModified,
+		}
+		log.Printf("[DEBUG] alicloud_cdn_domains - adding domain: %v", mapping)
+		ids = append(ids, domain.DomainName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("domains", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCdnDomainsDataSource_name_regex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudCdnDomainsDataSourceNameRegexConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_cdn_domains.domain"),
+					resource.TestCheckResourceAttr("data.alicloud_cdn_domains.domain", "domains.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_cdn_domains.domain", "domains.0.domain_name", "www.aliyun.com"),
+					resource.TestCheckResourceAttr("data.alicloud_cdn_domains.domain", "domains.0.cdn_type", "web"),
+					resource.TestCheckResourceAttr("data.alicloud_cdn_domains.domain", "domains.0.source_type", "domain"),
+					resource.TestCheckResourceAttr("data.alicloud_cdn_domains.domain", "domains.0.sources.#", "1"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cdn_domains.domain", "domains.0.cname"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudCdnDomainsDataSourceNameRegexConfig = `
+resource "alicloud_cdn_domain" "domain" {
+  domain_name = "www.aliyun.com"
+  cdn_type = "web"
+  source_type = "domain"
+  sources = ["jb51.net"]
+}
+
+data "alicloud_cdn_domains" "domain" {
+  domain_name_regex = "^${alicloud_cdn_domain.domain.domain_name}$"
+}
+`
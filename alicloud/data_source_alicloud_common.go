@@ -21,12 +21,20 @@ func dataResourceIdHash(ids []string) string {
 	return fmt.Sprintf("%d", hashcode.String(buf.String()))
 }
 
-func writeToFile(filePath string, data interface{}) {
-	os.Remove(filePath)
-	if bs, err := json.MarshalIndent(data, "", "\t"); err == nil {
-		str := string(bs)
-		ioutil.WriteFile(filePath, []byte(str), 777)
+// writeToFile writes the data as indented JSON into the file, which is replaced if it exists. It is used by
+// the output_file of the data sources to save the results for the tools outside terraform.
+func writeToFile(filePath string, data interface{}) error {
+	bs, err := json.MarshalIndent(data, "", "\t")
+	if err != nil {
+		return fmt.Errorf("Marshaling the data source results got an error: %s", err)
 	}
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("Removing the output file %s got an error: %s", filePath, err)
+	}
+	if err := ioutil.WriteFile(filePath, bs, 0644); err != nil {
+		return fmt.Errorf("Writing the output file %s got an error: %s", filePath, err)
+	}
+	return nil
 }
 
 func outputInstancesSchema() map[string]*schema.Schema {
@@ -0,0 +1,45 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteToFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-test-output")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "output.json")
+	if err := ioutil.WriteFile(path, []byte("outdated"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	data := []map[string]interface{}{
+		{"id": "vpc-abc123", "cidr_block": "172.16.0.0/12"},
+	}
+	if err := writeToFile(path, data); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	var got []map[string]interface{}
+	if err := json.Unmarshal(bs, &got); err != nil {
+		t.Fatalf("the output file should be JSON, but got %q: %s", bs, err)
+	}
+	if len(got) != 1 || got[0]["id"] != "vpc-abc123" {
+		t.Fatalf("the output file should contain the results, but got %v", got)
+	}
+
+	if err := writeToFile(filepath.Join(dir, "missing", "output.json"), data); err == nil {
+		t.Fatalf("writing into a missing directory should return an error")
+	}
+}
@@ -0,0 +1,105 @@
+package alicloud
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCSKubernetesClusterCredential() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCSKubernetesClusterCredentialRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"private_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// The raw kubeconfig is written into the file, which can be used by kubectl directly.
+			"kube_config_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values.
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kube_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"api_server_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"intranet_api_server_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCSKubernetesClusterCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	clusterId := d.Get("cluster_id").(string)
+
+	cluster, err := client.DescribeCsKubernetesCluster(clusterId)
+	if err != nil {
+		return fmt.Errorf("Describing cluster %s got an error: %#v", clusterId, err)
+	}
+
+	masterUrl, err := client.DescribeCsKubernetesMasterUrl(clusterId)
+	if err != nil {
+		return err
+	}
+
+	config, err := client.DescribeCsKubernetesUserConfig(clusterId, d.Get("private_ip_address").(bool))
+	if err != nil {
+		return fmt.Errorf("Describing kubeconfig of cluster %s got an error: %#v", clusterId, err)
+	}
+
+	certs, err := client.DescribeCsKubernetesClusterCerts(clusterId)
+	if err != nil {
+		return fmt.Errorf("Describing certificates of cluster %s got an error: %#v", clusterId, err)
+	}
+
+	d.SetId(clusterId)
+	d.Set("cluster_name", cluster.Name)
+	d.Set("kube_config", config)
+	d.Set("cluster_ca_certificate", certs.Ca)
+	d.Set("client_certificate", certs.Cert)
+	d.Set("client_key", certs.Key)
+	d.Set("api_server_endpoint", masterUrl.ApiServerEndpoint)
+	d.Set("intranet_api_server_endpoint", masterUrl.IntranetApiServerEndpoint)
+
+	if file, ok := d.GetOk("kube_config_file"); ok && file.(string) != "" {
+		if err := ioutil.WriteFile(file.(string), []byte(config), 0600); err != nil {
+			return fmt.Errorf("Writing kubeconfig into %s got an error: %#v", file.(string), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCSKubernetesClusterCredentialDataSource_basic(t *testing.T) {
+	clusterId := os.Getenv("ALICLOUD_CS_CLUSTER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithKubernetesCluster(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudCSKubernetesClusterCredentialDataSource(clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_cs_kubernetes_cluster_credential.default"),
+					resource.TestCheckResourceAttr("data.alicloud_cs_kubernetes_cluster_credential.default", "cluster_id", clusterId),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credential.default", "cluster_name"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credential.default", "kube_config"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credential.default", "cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credential.default", "client_certificate"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credential.default", "client_key"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credential.default", "intranet_api_server_endpoint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudCSKubernetesClusterCredentialDataSource(clusterId string) string {
+	return fmt.Sprintf(`
+data "alicloud_cs_kubernetes_cluster_credential" "default" {
+  cluster_id = "%s"
+}`, clusterId)
+}
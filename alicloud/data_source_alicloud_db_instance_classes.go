@@ -0,0 +1,150 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/denverdino/aliyungo/rds"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBInstanceClasses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBInstanceClassesRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Basic", "HighAvailability", "Finance"}),
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"local_ssd", "cloud_ssd", "cloud_essd"}),
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(rds.Postpaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(rds.Postpaid), string(rds.Prepaid)}),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"instance_classes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"storage_range": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBInstanceClassesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	zones, err := client.DescribeRdsAvailableZones(d.Get("zone_id").(string), d.Get("instance_charge_type").(string))
+	if err != nil {
+		return err
+	}
+
+	entries := FlattenRdsAvailableZones(zones, d.Get("engine").(string), d.Get("engine_version").(string), d.Get("category").(string))
+
+	storageType := d.Get("storage_type").(string)
+	zoneIds := make(map[string]map[string]bool)
+	ranges := make(map[string]RdsStorageRange)
+	for _, entry := range entries {
+		if storageType != "" && entry.StorageType != storageType {
+			continue
+		}
+		if _, ok := zoneIds[entry.InstanceClass]; !ok {
+			zoneIds[entry.InstanceClass] = make(map[string]bool)
+			ranges[entry.InstanceClass] = entry.StorageRange
+		}
+		zoneIds[entry.InstanceClass][entry.ZoneId] = true
+	}
+
+	if len(zoneIds) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	var classes []string
+	for class := range zoneIds {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+
+	log.Printf("[DEBUG] alicloud_db_instance_classes - instance classes found: %#v", classes)
+
+	var s []map[string]interface{}
+	for _, class := range classes {
+		var ids []string
+		for id := range zoneIds[class] {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		mapping := map[string]interface{}{
+			"instance_class": class,
+			"zone_ids":       ids,
+			"storage_range": map[string]interface{}{
+				"min":  fmt.Sprintf("%d", ranges[class].Min),
+				"max":  fmt.Sprintf("%d", ranges[class].Max),
+				"step": fmt.Sprintf("%d", ranges[class].Step),
+			},
+		}
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(classes))
+	if err := d.Set("instance_classes", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
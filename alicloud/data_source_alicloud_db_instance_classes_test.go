@@ -0,0 +1,41 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDBInstanceClassesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBInstanceClassesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_instance_classes.classes"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.classes", "instance_classes.0.instance_class"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.classes", "instance_classes.0.zone_ids.0"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.classes", "instance_classes.0.storage_range.min"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudDBInstanceClassesDataSourceConfig = `
+data "alicloud_db_zones" "zones" {
+	engine = "MySQL"
+	engine_version = "5.6"
+}
+
+data "alicloud_db_instance_classes" "classes" {
+	zone_id = "${data.alicloud_db_zones.zones.zones.0.id}"
+	engine = "MySQL"
+	engine_version = "5.6"
+	category = "HighAvailability"
+}
+`
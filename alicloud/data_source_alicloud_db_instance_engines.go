@@ -0,0 +1,136 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/denverdino/aliyungo/rds"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBInstanceEngines() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBInstanceEnginesRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(rds.Postpaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(rds.Postpaid), string(rds.Prepaid)}),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"instance_engines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBInstanceEnginesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	zones, err := client.DescribeRdsAvailableZones(d.Get("zone_id").(string), d.Get("instance_charge_type").(string))
+	if err != nil {
+		return err
+	}
+
+	entries := FlattenRdsAvailableZones(zones, d.Get("engine").(string), d.Get("engine_version").(string), "")
+
+	engines := make(map[string]RdsAvailableEntry)
+	zoneIds := make(map[string]map[string]bool)
+	for _, entry := range entries {
+		key := fmt.Sprintf("%s%s%s%s%s", entry.Engine, COLON_SEPARATED, entry.EngineVersion, COLON_SEPARATED, entry.Category)
+		if _, ok := engines[key]; !ok {
+			engines[key] = entry
+			zoneIds[key] = make(map[string]bool)
+		}
+		zoneIds[key][entry.ZoneId] = true
+	}
+
+	if len(engines) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	var keys []string
+	for key := range engines {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	log.Printf("[DEBUG] alicloud_db_instance_engines - instance engines found: %#v", keys)
+
+	var s []map[string]interface{}
+	for _, key := range keys {
+		var ids []string
+		for id := range zoneIds[key] {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		mapping := map[string]interface{}{
+			"engine":         engines[key].Engine,
+			"engine_version": engines[key].EngineVersion,
+			"category":       engines[key].Category,
+			"zone_ids":       ids,
+		}
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(keys))
+	if err := d.Set("instance_engines", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
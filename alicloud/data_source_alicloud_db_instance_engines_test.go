@@ -0,0 +1,33 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDBInstanceEnginesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBInstanceEnginesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_instance_engines.engines"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instance_engines.engines", "instance_engines.0.engine", "MySQL"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_engines.engines", "instance_engines.0.engine_version"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_engines.engines", "instance_engines.0.zone_ids.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudDBInstanceEnginesDataSourceConfig = `
+data "alicloud_db_instance_engines" "engines" {
+	engine = "MySQL"
+}
+`
@@ -0,0 +1,224 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"MySQL", "SQLServer", "PostgreSQL", "PPAS"}),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"db_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Primary", "Readonly", "Guard", "Temp"}),
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"charge_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expire_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"net_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"master_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AliyunClient).rdsconn()
+
+	args := DescribeDBInstancesArgs{
+		RegionId:         getRegion(d, meta),
+		Engine:           d.Get("engine").(string),
+		DBInstanceStatus: d.Get("status").(string),
+		VpcId:            d.Get("vpc_id").(string),
+		VSwitchId:        d.Get("vswitch_id").(string),
+		PageSize:         50,
+		PageNumber:       1,
+	}
+
+	var allInstances []DBInstanceItem
+
+	for {
+		resp := DescribeDBInstancesResponse{}
+		if err := conn.Invoke("DescribeDBInstances", &args, &resp); err != nil {
+			return WrapError(err, "DescribeDBInstances", "")
+		}
+
+		allInstances = append(allInstances, resp.Items.DBInstance...)
+
+		if len(resp.Items.DBInstance) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+
+	var r *regexp.Regexp
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		r = regexp.MustCompile(nameRegex.(string))
+	}
+
+	var filteredInstances []DBInstanceItem
+	for _, item := range allInstances {
+		if r != nil && !r.MatchString(item.DBInstanceDescription) {
+			continue
+		}
+		if dbType, ok := d.GetOk("db_type"); ok && item.DBInstanceType != dbType.(string) {
+			continue
+		}
+		filteredInstances = append(filteredInstances, item)
+	}
+
+	if len(filteredInstances) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_db_instances - DB instances found: %#v", filteredInstances)
+
+	return dbInstancesDescriptionAttributes(d, filteredInstances)
+}
+
+func dbInstancesDescriptionAttributes(d *schema.ResourceData, instances []DBInstanceItem) error {
+	var ids []string
+	var s []map[string]interface{}
+	for _, item := range instances {
+		mapping := map[string]interface{}{
+			"id":                 item.DBInstanceId,
+			"name":               item.DBInstanceDescription,
+			"charge_type":        item.PayType,
+			"db_type":            item.DBInstanceType,
+			"region_id":          item.RegionId,
+			"create_time":        item.CreateTime,
+			"expire_time":        item.ExpireTime,
+			"status":             item.DBInstanceStatus,
+			"engine":             item.Engine,
+			"engine_version":     item.EngineVersion,
+			"net_type":           item.DBInstanceNetType,
+			"network_type":       item.InstanceNetworkType,
+			"availability_zone":  item.ZoneId,
+			"master_instance_id": item.MasterInstanceId,
+			"vpc_id":             item.VpcId,
+			"vswitch_id":         item.VSwitchId,
+		}
+		log.Printf("[DEBUG] alicloud_db_instances - adding db instance: %v", mapping)
+		ids = append(ids, item.DBInstanceId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("instances", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDBInstancesDataSource_engine(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBInstancesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_instances.dbs"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instances.dbs", "instances.0.id"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.dbs", "instances.0.engine", "MySQL"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.dbs", "instances.0.db_type", "Primary"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudDBInstancesDataSourceConfig = `
+resource "alicloud_db_instance" "db" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	db_instance_class = "rds.mysql.t1.small"
+	db_instance_storage = "10"
+	db_instance_net_type = "Intranet"
+}
+
+data "alicloud_db_instances" "dbs" {
+	engine = "${alicloud_db_instance.db.engine}"
+	db_type = "Primary"
+}
+`
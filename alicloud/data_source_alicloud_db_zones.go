@@ -0,0 +1,154 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/denverdino/aliyungo/rds"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(rds.Postpaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(rds.Postpaid), string(rds.Prepaid)}),
+			},
+			"multi": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multi_zone_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBZonesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	zones, err := client.DescribeRdsAvailableZones("", d.Get("instance_charge_type").(string))
+	if err != nil {
+		return err
+	}
+
+	engine := d.Get("engine").(string)
+	version := d.Get("engine_version").(string)
+	multi := d.Get("multi").(bool)
+
+	var zoneIds []string
+	for _, zone := range zones {
+		// The multiple zone id looks like cn-hangzhou-MAZ1(b,c)
+		if multi != strings.Contains(zone.ZoneId, "MAZ") {
+			continue
+		}
+		if !rdsZoneSupportsEngine(zone, engine, version) {
+			continue
+		}
+		zoneIds = append(zoneIds, zone.ZoneId)
+	}
+
+	if len(zoneIds) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	sort.Strings(zoneIds)
+
+	log.Printf("[DEBUG] alicloud_db_zones - zones found: %#v", zoneIds)
+
+	var s []map[string]interface{}
+	for _, id := range zoneIds {
+		mapping := map[string]interface{}{
+			"id":             id,
+			"multi_zone_ids": splitRdsMultiZoneId(id),
+		}
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(zoneIds))
+	if err := d.Set("zones", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rdsZoneSupportsEngine(zone RdsAvailableZone, engine, version string) bool {
+	if engine == "" {
+		return true
+	}
+	for _, e := range zone.SupportedEngines.SupportedEngine {
+		if e.Engine != engine {
+			continue
+		}
+		if version == "" {
+			return true
+		}
+		for _, v := range e.SupportedEngineVersions.SupportedEngineVersion {
+			if v.Version == version {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splitRdsMultiZoneId converts the multiple zone id cn-hangzhou-MAZ1(b,c) to [cn-hangzhou-b, cn-hangzhou-c].
+func splitRdsMultiZoneId(id string) (ids []string) {
+	start := strings.Index(id, "MAZ")
+	left := strings.Index(id, "(")
+	right := strings.Index(id, ")")
+	if start < 0 || left < 0 || right < left {
+		return []string{id}
+	}
+	for _, suffix := range strings.Split(id[left+1:right], COMMA_SEPARATED) {
+		ids = append(ids, id[:start]+suffix)
+	}
+	return
+}
@@ -0,0 +1,60 @@
+package alicloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDBZonesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBZonesDataSourceBasicConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_zones.zones"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_zones.zones", "zones.0.id"),
+				),
+			},
+			{
+				Config: testAccCheckAlicloudDBZonesDataSourceMultiConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_zones.zones"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_zones.zones", "zones.0.multi_zone_ids.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestSplitRdsMultiZoneId(t *testing.T) {
+	cases := map[string][]string{
+		"cn-hangzhou-b":          {"cn-hangzhou-b"},
+		"cn-hangzhou-MAZ1(b,c)":  {"cn-hangzhou-b", "cn-hangzhou-c"},
+		"cn-beijing-MAZ3(c,d,e)": {"cn-beijing-c", "cn-beijing-d", "cn-beijing-e"},
+	}
+	for id, expected := range cases {
+		if ids := splitRdsMultiZoneId(id); !reflect.DeepEqual(ids, expected) {
+			t.Fatalf("Splitting %q expected %#v, got %#v", id, expected, ids)
+		}
+	}
+}
+
+const testAccCheckAlicloudDBZonesDataSourceBasicConfig = `
+data "alicloud_db_zones" "zones" {
+	engine = "MySQL"
+	engine_version = "5.6"
+}
+`
+
+const testAccCheckAlicloudDBZonesDataSourceMultiConfig = `
+data "alicloud_db_zones" "zones" {
+	engine = "MySQL"
+	multi = true
+}
+`
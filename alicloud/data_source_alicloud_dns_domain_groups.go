@@ -46,7 +46,7 @@ func dataSourceAlicloudDnsDomainGroups() *schema.Resource {
 }
 
 func dataSourceAlicloudDnsDomainGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainGroupsArgs{}
 
@@ -108,7 +108,9 @@ func groupsDecriptionAttributes(d *schema.ResourceData, groupTypes []dns.DomainG
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
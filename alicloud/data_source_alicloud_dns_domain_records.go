@@ -118,7 +118,7 @@ func dataSourceAlicloudDnsDomainRecords() *schema.Resource {
 }
 
 func dataSourceAlicloudDnsDomainRecordsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainRecordsNewArgs{
 		DomainName: d.Get("domain_name").(string),
@@ -213,7 +213,9 @@ func recordsDecriptionAttributes(d *schema.ResourceData, recordTypes []dns.Recor
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
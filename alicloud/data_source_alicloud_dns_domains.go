@@ -96,7 +96,7 @@ func dataSourceAlicloudDnsDomains() *schema.Resource {
 	}
 }
 func dataSourceAlicloudDnsDomainsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainsArgs{}
 
@@ -184,7 +184,9 @@ func domainsDecriptionAttributes(d *schema.ResourceData, domainTypes []dns.Domai
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
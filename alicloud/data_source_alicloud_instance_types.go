@@ -87,7 +87,7 @@ func dataSourceAlicloudInstanceTypesRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	resp, err := client.ecsconn.DescribeInstanceTypesNew(args)
+	resp, err := client.ecsconn().DescribeInstanceTypesNew(args)
 	if err != nil {
 		return err
 	}
@@ -148,7 +148,9 @@ func instanceTypesDescriptionAttributes(d *schema.ResourceData, types []ecs.Inst
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,194 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudMarketProducts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudMarketProductsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"search_term": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"category_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			// The product_type is MIRROR to find the images, which are launched by the instances with their codes
+			// as the marketplace_image_product_code.
+			"product_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{MarketProductTypeImage, "SERVICE", "API", "SAAS", "DATA"}),
+			},
+			"supplier_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"sort": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(MarketProductSorts),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"products": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"supplier_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"supplier_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"short_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"suggested_price": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operation_system": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delivery_way": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudMarketProductsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	var filters []MarketProductFilter
+	if v, ok := d.GetOk("search_term"); ok {
+		filters = append(filters, MarketProductFilter{Key: "searchTerm", Value: v.(string)})
+	}
+	if v, ok := d.GetOk("category_id"); ok {
+		filters = append(filters, MarketProductFilter{Key: "categoryId", Value: strconv.Itoa(v.(int))})
+	}
+	if v, ok := d.GetOk("product_type"); ok {
+		filters = append(filters, MarketProductFilter{Key: "productType", Value: v.(string)})
+	}
+	if v, ok := d.GetOk("supplier_id"); ok {
+		filters = append(filters, MarketProductFilter{Key: "supplierId", Value: strconv.Itoa(v.(int))})
+	}
+	if v, ok := d.GetOk("sort"); ok {
+		filters = append(filters, MarketProductFilter{Key: "sort", Value: v.(string)})
+	}
+
+	products, err := client.DescribeMarketProducts(filters)
+	if err != nil {
+		return WrapError(err, "DescribeProducts", "")
+	}
+
+	idsMap := make(map[string]bool)
+	for _, id := range expandStringList(d.Get("ids").([]interface{})) {
+		idsMap[id] = true
+	}
+
+	var filtered []MarketProduct
+	for _, product := range products {
+		if len(idsMap) > 0 && !idsMap[product.Code] {
+			continue
+		}
+		filtered = append(filtered, product)
+	}
+
+	if len(filtered) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_market_products - products found: %#v", filtered)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, product := range filtered {
+		mapping := map[string]interface{}{
+			"code":              product.Code,
+			"name":              product.Name,
+			"category_id":       product.CategoryId,
+			"supplier_id":       product.SupplierId,
+			"supplier_name":     product.SupplierName,
+			"short_description": product.ShortDescription,
+			"suggested_price":   product.SuggestedPrice,
+			"operation_system":  product.OperationSystem,
+			"delivery_way":      product.DeliveryWay,
+			"score":             product.Score,
+			"target_url":        product.TargetUrl,
+			"tags":              product.Tags,
+		}
+		ids = append(ids, product.Code)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("products", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudMarketProductsDataSource_image(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudMarketProductsDataSourceImageConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_market_products.products"),
+					resource.TestCheckResourceAttrSet("data.alicloud_market_products.products", "products.0.code"),
+					resource.TestCheckResourceAttrSet("data.alicloud_market_products.products", "products.0.name"),
+					resource.TestCheckResourceAttrSet("data.alicloud_market_products.products", "products.0.supplier_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudMarketProductsDataSourceImageConfig = `
+data "alicloud_market_products" "products" {
+	product_type = "MIRROR"
+	search_term = "Ubuntu"
+	sort = "user_count-desc"
+}
+`
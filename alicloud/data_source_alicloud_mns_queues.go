@@ -0,0 +1,129 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudMnsQueues() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudMnsQueuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"queues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notification_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delay_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"maximum_message_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"message_retention_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"visibility_timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"polling_wait_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudMnsQueuesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	queues, err := client.DescribeMnsQueues(d.Get("name_prefix").(string))
+	if err != nil {
+		return WrapError(err, "ListQueue", "")
+	}
+
+	if len(queues) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_mns_queues - Queues found: %#v", queues)
+
+	return mnsQueuesDescriptionAttributes(d, queues, client)
+}
+
+func mnsQueuesDescriptionAttributes(d *schema.ResourceData, queues []MnsQueue, client *AliyunClient) error {
+	var names []string
+	var s []map[string]interface{}
+	for _, queue := range queues {
+		mapping := map[string]interface{}{
+			"name": queue.QueueName,
+			"url":  queue.QueueURL,
+			// The arn can be used as the notification_arn of alicloud_ess_notification.
+			"notification_arn":         fmt.Sprintf("acs:ess:%s:%s:queue/%s", client.Region, client.mnsconn().AccountId, queue.QueueName),
+			"delay_seconds":            queue.DelaySeconds,
+			"maximum_message_size":     queue.MaximumMessageSize,
+			"message_retention_period": queue.MessageRetentionPeriod,
+			"visibility_timeout":       queue.VisibilityTimeout,
+			"polling_wait_seconds":     queue.PollingWaitSeconds,
+		}
+		log.Printf("[DEBUG] alicloud_mns_queues - adding queue: %v", mapping)
+		names = append(names, queue.QueueName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(names))
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+	if err := d.Set("queues", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
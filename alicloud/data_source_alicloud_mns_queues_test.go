@@ -0,0 +1,40 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudMnsQueuesDataSource_name_prefix(t *testing.T) {
+	name := os.Getenv("ALICLOUD_MNS_QUEUE_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithMnsQueue(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudMnsQueuesDataSourceNamePrefixConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_mns_queues.queue"),
+					resource.TestCheckResourceAttrSet("data.alicloud_mns_queues.queue", "queues.#"),
+					resource.TestCheckResourceAttrSet("data.alicloud_mns_queues.queue", "queues.0.name"),
+					resource.TestCheckResourceAttrSet("data.alicloud_mns_queues.queue", "queues.0.url"),
+					resource.TestCheckResourceAttrSet("data.alicloud_mns_queues.queue", "queues.0.notification_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudMnsQueuesDataSourceNamePrefixConfig(name string) string {
+	return fmt.Sprintf(`
+data "alicloud_mns_queues" "queue" {
+  name_prefix = "%s"
+}
+`, name)
+}
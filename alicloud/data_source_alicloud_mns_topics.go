@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudMnsTopics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudMnsTopicsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notification_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maximum_message_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"logging_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudMnsTopicsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	topics, err := client.DescribeMnsTopics(d.Get("name_prefix").(string))
+	if err != nil {
+		return WrapError(err, "ListTopic", "")
+	}
+
+	if len(topics) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_mns_topics - Topics found: %#v", topics)
+
+	return mnsTopicsDescriptionAttributes(d, topics, client)
+}
+
+func mnsTopicsDescriptionAttributes(d *schema.ResourceData, topics []MnsTopic, client *AliyunClient) error {
+	var names []string
+	var s []map[string]interface{}
+	for _, topic := range topics {
+		mapping := map[string]interface{}{
+			"name": topic.TopicName,
+			"url":  topic.TopicURL,
+			// The arn can be used as the notification_arn of alicloud_ess_notification.
+			"notification_arn":     fmt.Sprintf("acs:ess:%s:%s:topic/%s", client.Region, client.mnsconn().AccountId, topic.TopicName),
+			"maximum_message_size": topic.MaximumMessageSize,
+			"logging_enabled":      topic.LoggingEnabled,
+		}
+		log.Printf("[DEBUG] alicloud_mns_topics - adding topic: %v", mapping)
+		names = append(names, topic.TopicName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(names))
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+	if err := d.Set("topics", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudMnsTopicsDataSource_name_prefix(t *testing.T) {
+	name := os.Getenv("ALICLOUD_MNS_TOPIC_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithMnsTopic(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudMnsTopicsDataSourceNamePrefixConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_mns_topics.topic"),
+					resource.TestCheckResourceAttrSet("data.alicloud_mns_topics.topic", "topics.#"),
+					resource.TestCheckResourceAttrSet("data.alicloud_mns_topics.topic", "topics.0.name"),
+					resource.TestCheckResourceAttrSet("data.alicloud_mns_topics.topic", "topics.0.url"),
+					resource.TestCheckResourceAttrSet("data.alicloud_mns_topics.topic", "topics.0.notification_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudMnsTopicsDataSourceNamePrefixConfig(name string) string {
+	return fmt.Sprintf(`
+data "alicloud_mns_topics" "topic" {
+  name_prefix = "%s"
+}
+`, name)
+}
@@ -0,0 +1,184 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			// The product_code is like ecs, vpc and slb for the quotas of the ECS instances, the EIPs and the
+			// SLB instances.
+			"product_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quota_action_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNameRegex,
+			},
+			// The dimensions narrow the quotas down, like the regionId one for the quotas of a region.
+			"dimensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"quota_action_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_quota": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"total_usage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						// The remaining is the total_quota minus the total_usage, which is what a module checks
+						// before it creates more resources.
+						"remaining": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"adjustable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	productCode := d.Get("product_code").(string)
+	dimensions := expandQuotaDimensions(d.Get("dimensions").([]interface{}))
+	quotas, err := client.DescribeProductQuotas(productCode, d.Get("quota_action_code").(string), dimensions)
+	if err != nil {
+		return WrapError(err, "ListProductQuotas", productCode)
+	}
+
+	var regex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		regex = regexp.MustCompile(v.(string))
+	}
+	idsMap := make(map[string]bool)
+	for _, id := range expandStringList(d.Get("ids").([]interface{})) {
+		idsMap[id] = true
+	}
+
+	var filtered []ProductQuota
+	for _, quota := range quotas {
+		if regex != nil && !regex.MatchString(quota.QuotaName) {
+			continue
+		}
+		if len(idsMap) > 0 && !idsMap[quota.QuotaActionCode] {
+			continue
+		}
+		filtered = append(filtered, quota)
+	}
+
+	if len(filtered) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_quotas - quotas found: %#v", filtered)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, quota := range filtered {
+		dimensions := make(map[string]interface{})
+		for k, v := range quota.Dimensions {
+			dimensions[k] = v
+		}
+		mapping := map[string]interface{}{
+			"quota_action_code": quota.QuotaActionCode,
+			"quota_name":        quota.QuotaName,
+			"quota_description": quota.QuotaDescription,
+			"quota_unit":        quota.QuotaUnit,
+			"total_quota":       quota.TotalQuota,
+			"total_usage":       quota.TotalUsage,
+			"remaining":         quota.TotalQuota - quota.TotalUsage,
+			"adjustable":        quota.Adjustable,
+			"dimensions":        dimensions,
+		}
+		ids = append(ids, quota.QuotaActionCode)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("quotas", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudQuotasDataSource_ecs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudQuotasDataSourceEcsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_quotas.ecs"),
+					resource.TestCheckResourceAttr("data.alicloud_quotas.ecs", "quotas.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_quotas.ecs", "quotas.0.quota_action_code", "q_prepaid-instance-count-per-once-purchase"),
+					resource.TestCheckResourceAttrSet("data.alicloud_quotas.ecs", "quotas.0.quota_name"),
+					resource.TestCheckResourceAttrSet("data.alicloud_quotas.ecs", "quotas.0.total_quota"),
+					resource.TestCheckResourceAttrSet("data.alicloud_quotas.ecs", "quotas.0.remaining"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudQuotasDataSourceEcsConfig = `
+data "alicloud_quotas" "ecs" {
+	product_code = "ecs"
+	ids = ["q_prepaid-instance-count-per-once-purchase"]
+}
+`
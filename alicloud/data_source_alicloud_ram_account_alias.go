@@ -22,7 +22,7 @@ func dataSourceAlicloudRamAccountAlias() *schema.Resource {
 }
 
 func dataSourceAlicloudRamAccountAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	resp, err := conn.GetAccountAlias()
 	if err != nil {
@@ -33,7 +33,9 @@ func dataSourceAlicloudRamAccountAliasRead(d *schema.ResourceData, meta interfac
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		s := map[string]interface{}{"account_alias": resp.AccountAlias}
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -63,7 +63,7 @@ func dataSourceAlicloudRamGroups() *schema.Resource {
 }
 
 func dataSourceAlicloudRamGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	allGroups := []interface{}{}
 
 	allGroupsMap := make(map[string]interface{})
@@ -86,7 +86,7 @@ func dataSourceAlicloudRamGroupsRead(d *schema.ResourceData, meta interface{}) e
 	for {
 		resp, err := conn.ListGroup(args)
 		if err != nil {
-			return fmt.Errorf("ListGroup got an error: %#v", err)
+			return WrapError(err, "ListGroup", "")
 		}
 		for _, v := range resp.Groups.Group {
 			if nameRegexOk {
@@ -107,7 +107,7 @@ func dataSourceAlicloudRamGroupsRead(d *schema.ResourceData, meta interface{}) e
 	if userNameOk {
 		resp, err := conn.ListGroupsForUser(ram.UserQueryRequest{UserName: userName.(string)})
 		if err != nil {
-			return fmt.Errorf("ListGroupsForUser got an error: %#v", err)
+			return WrapError(err, "ListGroupsForUser", "")
 		}
 
 		for _, v := range resp.Groups.Group {
@@ -124,7 +124,7 @@ func dataSourceAlicloudRamGroupsRead(d *schema.ResourceData, meta interface{}) e
 		}
 		resp, err := conn.ListEntitiesForPolicy(ram.PolicyRequest{PolicyName: policyName.(string), PolicyType: pType})
 		if err != nil {
-			return fmt.Errorf("ListEntitiesForPolicy got an error: %#v", err)
+			return WrapError(err, "ListEntitiesForPolicy", "")
 		}
 
 		for _, v := range resp.Groups.Group {
@@ -166,7 +166,9 @@ func ramGroupsDescriptionAttributes(d *schema.ResourceData, groups []interface{}
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
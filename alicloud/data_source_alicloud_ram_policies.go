@@ -94,7 +94,7 @@ func dataSourceAlicloudRamPolicies() *schema.Resource {
 }
 
 func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	allPolicies := []interface{}{}
 
 	allPoliciesMap := make(map[string]interface{})
@@ -115,7 +115,7 @@ func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{})
 	for {
 		resp, err := conn.ListPolicies(args)
 		if err != nil {
-			return fmt.Errorf("ListPolicies got an error: %#v", err)
+			return WrapError(err, "ListPolicies", "")
 		}
 		for _, v := range resp.Policies.Policy {
 			if policyTypeOk && policyType.(string) != v.PolicyType {
@@ -139,7 +139,7 @@ func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{})
 	if userNameOk {
 		resp, err := conn.ListPoliciesForUser(ram.UserQueryRequest{UserName: userName.(string)})
 		if err != nil {
-			return fmt.Errorf("ListPoliciesForUser got an error: %#v", err)
+			return WrapError(err, "ListPoliciesForUser", "")
 		}
 
 		for _, v := range resp.Policies.Policy {
@@ -152,7 +152,7 @@ func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{})
 	if groupNameOk {
 		resp, err := conn.ListPoliciesForGroup(ram.GroupQueryRequest{GroupName: groupName.(string)})
 		if err != nil {
-			return fmt.Errorf("ListPoliciesForGroup got an error: %#v", err)
+			return WrapError(err, "ListPoliciesForGroup", "")
 		}
 
 		for _, v := range resp.Policies.Policy {
@@ -165,7 +165,7 @@ func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{})
 	if roleNameOk {
 		resp, err := conn.ListPoliciesForRole(ram.RoleQueryRequest{RoleName: roleName.(string)})
 		if err != nil {
-			return fmt.Errorf("ListPoliciesForRole got an error: %#v", err)
+			return WrapError(err, "ListPoliciesForRole", "")
 		}
 
 		for _, v := range resp.Policies.Policy {
@@ -187,7 +187,7 @@ func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{})
 }
 
 func ramPoliciesDescriptionAttributes(d *schema.ResourceData, policies []interface{}, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	var ids []string
 	var s []map[string]interface{}
 	for _, v := range policies {
@@ -224,7 +224,9 @@ func ramPoliciesDescriptionAttributes(d *schema.ResourceData, policies []interfa
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
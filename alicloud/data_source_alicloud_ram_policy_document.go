@@ -0,0 +1,191 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// RamPolicyConditionOperators are the operators of the conditions of the RAM policy statements.
+var RamPolicyConditionOperators = []string{
+	"StringEquals", "StringNotEquals", "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase", "StringLike",
+	"StringNotLike", "NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals",
+	"NumericGreaterThan", "NumericGreaterThanEquals", "DateEquals", "DateNotEquals", "DateLessThan",
+	"DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals", "Bool", "IpAddress", "NotIpAddress",
+}
+
+var ramPolicyActionPattern = regexp.MustCompile(`^(\*|[a-z0-9-]+:[A-Za-z0-9*]+)$`)
+
+func dataSourceAlicloudRamPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudRamPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1",
+				ValidateFunc: validatePolicyDocVersion,
+			},
+			"statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(Allow),
+							ValidateFunc: validateAllowedStringValue([]string{string(Allow), string(Deny)}),
+						},
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateRamPolicyAction,
+							},
+						},
+						// The resource is required by the statements of the policies, and the principal is required
+						// by the ones of the trust policies of the roles.
+						"resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entity": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAllowedStringValue([]string{"RAM", "Service", "Federated"}),
+									},
+									"identifiers": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAllowedStringValue(RamPolicyConditionOperators),
+									},
+									"variable": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"document": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudRamPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	document, err := assembleRamPolicyDocument(d.Get("version").(string), d.Get("statement").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(document)))
+	d.Set("document", document)
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), map[string]interface{}{"document": document}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func assembleRamPolicyDocument(version string, statements []interface{}) (string, error) {
+	policy := PolicyDocument{
+		Version:   version,
+		Statement: make([]PolicyDocumentStatement, 0, len(statements)),
+	}
+
+	for i, v := range statements {
+		item := v.(map[string]interface{})
+		statement := PolicyDocumentStatement{
+			Effect:   Effect(item["effect"].(string)),
+			Action:   expandStringList(item["action"].([]interface{})),
+			Resource: expandStringList(item["resource"].([]interface{})),
+		}
+
+		for _, p := range item["principal"].([]interface{}) {
+			principal := p.(map[string]interface{})
+			if statement.Principal == nil {
+				statement.Principal = make(map[string][]string)
+			}
+			entity := principal["entity"].(string)
+			statement.Principal[entity] = append(statement.Principal[entity], expandStringList(principal["identifiers"].([]interface{}))...)
+		}
+
+		// The conditions with the same operator are merged, as the keys of the Condition are the operators.
+		for _, c := range item["condition"].([]interface{}) {
+			condition := c.(map[string]interface{})
+			if statement.Condition == nil {
+				statement.Condition = make(map[string]map[string][]string)
+			}
+			operator := condition["operator"].(string)
+			if statement.Condition[operator] == nil {
+				statement.Condition[operator] = make(map[string][]string)
+			}
+			variable := condition["variable"].(string)
+			statement.Condition[operator][variable] = append(statement.Condition[operator][variable], expandStringList(condition["values"].([]interface{}))...)
+		}
+
+		if len(statement.Resource) == 0 && len(statement.Principal) == 0 {
+			return "", ConfigErrorf(ErrorCodeMissingArgument, "statement.%d must have one of 'resource' and 'principal'.", i)
+		}
+		if len(statement.Resource) > 0 && len(statement.Principal) > 0 {
+			return "", ConfigErrorf(ErrorCodeInvalidArgument, "statement.%d can not have both 'resource' and 'principal'.", i)
+		}
+
+		policy.Statement = append(policy.Statement, statement)
+	}
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func validateRamPolicyAction(v interface{}, k string) (ws []string, errors []error) {
+	if value := v.(string); !ramPolicyActionPattern.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be '*' or in the format of '<service>:<action>', got %q.", k, value))
+	}
+	return
+}
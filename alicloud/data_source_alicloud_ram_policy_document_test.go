@@ -0,0 +1,66 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudRamPolicyDocumentDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudRamPolicyDocumentDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_ram_policy_document.default"),
+					resource.TestCheckResourceAttr("data.alicloud_ram_policy_document.default", "document",
+						`{"Version":"1","Statement":[{"Effect":"Allow","Action":["oss:GetObject","oss:ListObjects"],`+
+							`"Resource":["acs:oss:*:*:mybucket","acs:oss:*:*:mybucket/*"],`+
+							`"Condition":{"IpAddress":{"acs:SourceIp":["10.0.0.0/8","192.168.0.0/16"]}}},`+
+							`{"Effect":"Deny","Action":["oss:DeleteObject"],"Resource":["*"]}]}`),
+					resource.TestCheckResourceAttr("data.alicloud_ram_policy_document.role", "document",
+						`{"Version":"1","Statement":[{"Effect":"Allow","Action":["sts:AssumeRole"],`+
+							`"Principal":{"Service":["ecs.aliyuncs.com"]}}]}`),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudRamPolicyDocumentDataSourceBasic = `
+data "alicloud_ram_policy_document" "default" {
+  statement {
+    action = ["oss:GetObject", "oss:ListObjects"]
+    resource = ["acs:oss:*:*:mybucket", "acs:oss:*:*:mybucket/*"]
+    condition {
+      operator = "IpAddress"
+      variable = "acs:SourceIp"
+      values = ["10.0.0.0/8"]
+    }
+    condition {
+      operator = "IpAddress"
+      variable = "acs:SourceIp"
+      values = ["192.168.0.0/16"]
+    }
+  }
+  statement {
+    effect = "Deny"
+    action = ["oss:DeleteObject"]
+    resource = ["*"]
+  }
+}
+
+data "alicloud_ram_policy_document" "role" {
+  statement {
+    action = ["sts:AssumeRole"]
+    principal {
+      entity = "Service"
+      identifiers = ["ecs.aliyuncs.com"]
+    }
+  }
+}
+`
@@ -82,7 +82,7 @@ func dataSourceAlicloudRamRoles() *schema.Resource {
 }
 
 func dataSourceAlicloudRamRolesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	allRoles := []interface{}{}
 
 	allRolesMap := make(map[string]interface{})
@@ -101,7 +101,7 @@ func dataSourceAlicloudRamRolesRead(d *schema.ResourceData, meta interface{}) er
 	// all roles
 	resp, err := conn.ListRoles()
 	if err != nil {
-		return fmt.Errorf("ListRoles got an error: %#v", err)
+		return WrapError(err, "ListRoles", "")
 	}
 	for _, v := range resp.Roles.Role {
 		if nameRegexOk {
@@ -121,7 +121,7 @@ func dataSourceAlicloudRamRolesRead(d *schema.ResourceData, meta interface{}) er
 		}
 		resp, err := conn.ListEntitiesForPolicy(ram.PolicyRequest{PolicyName: policyName.(string), PolicyType: pType})
 		if err != nil {
-			return fmt.Errorf("ListEntitiesForPolicy got an error: %#v", err)
+			return WrapError(err, "ListEntitiesForPolicy", "")
 		}
 
 		for _, v := range resp.Roles.Role {
@@ -147,7 +147,7 @@ func ramRolesDescriptionAttributes(d *schema.ResourceData, meta interface{}, rol
 	var s []map[string]interface{}
 	for _, v := range roles {
 		role := v.(ram.Role)
-		conn := meta.(*AliyunClient).ramconn
+		conn := meta.(*AliyunClient).ramconn()
 		resp, _ := conn.GetRole(ram.RoleQueryRequest{RoleName: role.RoleName})
 		mapping := map[string]interface{}{
 			"id":                          role.RoleId,
@@ -171,7 +171,9 @@ func ramRolesDescriptionAttributes(d *schema.ResourceData, meta interface{}, rol
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
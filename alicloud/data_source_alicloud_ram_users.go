@@ -72,7 +72,7 @@ func dataSourceAlicloudRamUsers() *schema.Resource {
 }
 
 func dataSourceAlicloudRamUsersRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	allUsers := []interface{}{}
 
 	allUsersMap := make(map[string]interface{})
@@ -95,7 +95,7 @@ func dataSourceAlicloudRamUsersRead(d *schema.ResourceData, meta interface{}) er
 	for {
 		resp, err := conn.ListUsers(args)
 		if err != nil {
-			return fmt.Errorf("ListUsers got an error: %#v", err)
+			return WrapError(err, "ListUsers", "")
 		}
 		for _, v := range resp.Users.User {
 			if nameRegexOk {
@@ -116,7 +116,7 @@ func dataSourceAlicloudRamUsersRead(d *schema.ResourceData, meta interface{}) er
 	if groupNameOk {
 		resp, err := conn.ListUsersForGroup(ram.GroupQueryRequest{GroupName: groupName.(string)})
 		if err != nil {
-			return fmt.Errorf("ListUsersForGroup got an error: %#v", err)
+			return WrapError(err, "ListUsersForGroup", "")
 		}
 
 		for _, v := range resp.Users.User {
@@ -133,7 +133,7 @@ func dataSourceAlicloudRamUsersRead(d *schema.ResourceData, meta interface{}) er
 		}
 		resp, err := conn.ListEntitiesForPolicy(ram.PolicyRequest{PolicyName: policyName.(string), PolicyType: pType})
 		if err != nil {
-			return fmt.Errorf("ListEntitiesForPolicy got an error: %#v", err)
+			return WrapError(err, "ListEntitiesForPolicy", "")
 		}
 
 		for _, v := range resp.Users.User {
@@ -177,7 +177,9 @@ func ramUsersDescriptionAttributes(d *schema.ResourceData, users []interface{})
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"fmt"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"log"
 )
@@ -47,6 +46,10 @@ func dataSourceAlicloudRegions() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"region_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -55,20 +58,20 @@ func dataSourceAlicloudRegions() *schema.Resource {
 }
 
 func dataSourceAlicloudRegionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 	currentRegion := getRegion(d, meta)
 
-	resp, err := conn.DescribeRegions()
-	if err != nil {
-		return err
+	resp := DescribeRegionEndpointsResponse{}
+	if err := conn.Invoke("DescribeRegions", &DescribeRegionEndpointsArgs{}, &resp); err != nil {
+		return WrapError(err, "DescribeRegions", "")
 	}
-	if resp == nil || len(resp) == 0 {
+	if len(resp.Regions.Region) == 0 {
 		return fmt.Errorf("no matching regions found")
 	}
 	name, nameOk := d.GetOk("name")
 	current := d.Get("current").(bool)
-	var filterRegions []ecs.RegionType
-	for _, region := range resp {
+	var filterRegions []RegionEndpointType
+	for _, region := range resp.Regions.Region {
 		if current {
 			if nameOk && common.Region(name.(string)) != currentRegion {
 				return fmt.Errorf("name doesn't match current region: %#v, please input again.", currentRegion)
@@ -95,14 +98,15 @@ func dataSourceAlicloudRegionsRead(d *schema.ResourceData, meta interface{}) err
 	return regionsDescriptionAttributes(d, filterRegions)
 }
 
-func regionsDescriptionAttributes(d *schema.ResourceData, regions []ecs.RegionType) error {
+func regionsDescriptionAttributes(d *schema.ResourceData, regions []RegionEndpointType) error {
 	var ids []string
 	var s []map[string]interface{}
 	for _, region := range regions {
 		mapping := map[string]interface{}{
-			"id":         region.RegionId,
-			"region_id":  region.RegionId,
-			"local_name": region.LocalName,
+			"id":              region.RegionId,
+			"region_id":       region.RegionId,
+			"local_name":      region.LocalName,
+			"region_endpoint": region.RegionEndpoint,
 		}
 
 		log.Printf("[DEBUG] alicloud_regions - adding region mapping: %v", mapping)
@@ -117,7 +121,9 @@ func regionsDescriptionAttributes(d *schema.ResourceData, regions []ecs.RegionTy
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
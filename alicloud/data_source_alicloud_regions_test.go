@@ -24,6 +24,7 @@ func TestAccAlicloudRegionsDataSource_regions(t *testing.T) {
 					resource.TestCheckResourceAttr("data.alicloud_regions.region", "regions.0.id", "cn-beijing"),
 					resource.TestCheckResourceAttr("data.alicloud_regions.region", "regions.0.region_id", "cn-beijing"),
 					resource.TestCheckResourceAttr("data.alicloud_regions.region", "regions.0.local_name", "华北 2"),
+					resource.TestCheckResourceAttr("data.alicloud_regions.region", "regions.0.region_endpoint", "ecs.aliyuncs.com"),
 				),
 			},
 		},
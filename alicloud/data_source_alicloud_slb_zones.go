@@ -0,0 +1,147 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudSlbZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudSlbZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"address_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"internet", "intranet"}),
+			},
+			"address_ip_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"ipv4", "ipv6"}),
+			},
+			"master_zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"master_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slave_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supported_resources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"address_ip_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"specifications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudSlbZonesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	resources, err := client.DescribeSlbAvailableResources(d.Get("address_type").(string), d.Get("address_ip_version").(string))
+	if err != nil {
+		return err
+	}
+
+	masterZoneId := d.Get("master_zone_id").(string)
+
+	var filtered []SlbAvailableResource
+	for _, r := range resources {
+		if masterZoneId != "" && r.MasterZoneId != masterZoneId {
+			continue
+		}
+		if len(r.SupportResources.SupportResource) < 1 {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+
+	if len(filtered) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		if filtered[i].MasterZoneId != filtered[j].MasterZoneId {
+			return filtered[i].MasterZoneId < filtered[j].MasterZoneId
+		}
+		return filtered[i].SlaveZoneId < filtered[j].SlaveZoneId
+	})
+
+	log.Printf("[DEBUG] alicloud_slb_zones - zones found: %#v", filtered)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, r := range filtered {
+		var supported []map[string]interface{}
+		for _, support := range r.SupportResources.SupportResource {
+			supported = append(supported, map[string]interface{}{
+				"address_type":       support.AddressType,
+				"address_ip_version": support.AddressIPVersion,
+			})
+		}
+		mapping := map[string]interface{}{
+			"master_zone_id":      r.MasterZoneId,
+			"slave_zone_id":       r.SlaveZoneId,
+			"supported_resources": supported,
+		}
+		ids = append(ids, r.MasterZoneId+COLON_SEPARATED+r.SlaveZoneId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("zones", s); err != nil {
+		return err
+	}
+	if err := d.Set("specifications", SlbSpecifications); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudSlbZonesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudSlbZonesDataSourceBasicConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_slb_zones.zones"),
+					resource.TestCheckResourceAttrSet("data.alicloud_slb_zones.zones", "zones.0.master_zone_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_slb_zones.zones", "zones.0.slave_zone_id"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_zones.zones", "zones.0.supported_resources.0.address_type", "intranet"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_zones.zones", "specifications.0", "slb.s1.small"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudSlbZonesDataSourceBasicConfig = `
+data "alicloud_slb_zones" "zones" {
+	address_type = "intranet"
+	address_ip_version = "ipv4"
+}
+`
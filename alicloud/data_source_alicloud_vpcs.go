@@ -102,7 +102,7 @@ func dataSourceAlicloudVpcs() *schema.Resource {
 	}
 }
 func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	args := &ecs.DescribeVpcsArgs{
 		RegionId: getRegion(d, meta),
@@ -146,7 +146,7 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 			continue
 		}
 
-		vrouters, _, err := meta.(*AliyunClient).vpcconn.DescribeVRouters(&ecs.DescribeVRoutersArgs{
+		vrouters, _, err := meta.(*AliyunClient).vpcconn().DescribeVRouters(&ecs.DescribeVRoutersArgs{
 			VRouterId: vpc.VRouterId,
 			RegionId:  getRegion(d, meta),
 		})
@@ -221,7 +221,9 @@ func vpcsDecriptionAttributes(d *schema.ResourceData, vpcSetTypes []ecs.VpcSetTy
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -161,7 +161,9 @@ func zonesDescriptionAttributes(d *schema.ResourceData, types []ecs.ZoneType) er
 
 	// create a json file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		if err := writeToFile(output.(string), s); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"fmt"
 	"github.com/denverdino/aliyungo/common"
 	"strings"
 )
@@ -11,6 +12,9 @@ const (
 	// ecs
 	InstanceNotFound        = "Instance.Notfound"
 	MessageInstanceNotFound = "instance is not found"
+	// cloud assistant
+	InvalidCmdIdNotFound    = "InvalidCmdId.NotFound"
+	InvalidInvokeIdNotFound = "InvalidInvokeId.NotFound"
 	// disk
 	DiskIncorrectStatus       = "IncorrectDiskStatus"
 	DiskCreatingSnapshot      = "DiskCreatingSnapshot"
@@ -44,6 +48,8 @@ const (
 	// ess
 	InvalidScalingGroupIdNotFound               = "InvalidScalingGroupId.NotFound"
 	IncorrectScalingConfigurationLifecycleState = "IncorrectScalingConfigurationLifecycleState"
+	IncorrectScalingGroupStatus                 = "IncorrectScalingGroupStatus"
+	ScalingActivityInProgress                   = "ScalingActivityInProgress"
 
 	// oss
 	OssBucketNotFound = "NoSuchBucket"
@@ -55,6 +61,9 @@ const (
 
 	// dns
 	RecordForbiddenDNSChange = "RecordForbidden.DNSChange"
+	DnsGtmInstanceNotExist   = "DnsGtmInstance.NotExists"
+	DnsGtmAddrPoolNotExist   = "DnsGtmAddrPool.NotExists"
+	DnsGtmStrategyNotExist   = "DnsGtmAccessStrategy.NotExists"
 	FobiddenNotEmptyGroup    = "Fobidden.NotEmptyGroup"
 
 	// ram user
@@ -87,16 +96,162 @@ const (
 	KeyPairServiceUnavailable = "ServiceUnavailable"
 
 	// Container
-	ErrorClusterNotFound = "ErrorClusterNotFound"
+	ErrorClusterNotFound  = "ErrorClusterNotFound"
+	ErrorNodePoolNotFound = "ErrorNodePoolNotFound"
+
+	// ons
+	OnsInstanceNotExist = "INSTANCE_NOT_EXIST"
+	OnsInstanceNotFound = "InstanceNotFound"
+	OnsInstanceNotEmpty = "INSTANCE_NOT_EMPTY"
+
+	// fc
+	FcServiceNotFound  = "ServiceNotFound"
+	FcFunctionNotFound = "FunctionNotFound"
+	FcTriggerNotFound  = "TriggerNotFound"
+	FcServiceNotEmpty  = "ServiceNotEmpty"
+	FcFunctionNotEmpty = "FunctionNotEmpty"
+	FcDomainNotFound   = "DomainNameNotFound"
+	FcOnDemandNotFound = "OnDemandConfigNotFound"
+	FcLayerNotFound    = "LayerNotFound"
+	FcAsyncNotFound    = "AsyncConfigNotExists"
+
+	// cloudapi
+	CloudApiGroupNotFound          = "NotFoundApiGroup"
+	CloudApiNotFound               = "NotFoundApi"
+	CloudApiAppNotFound            = "NotFoundApp"
+	CloudApiGroupNotEmpty          = "ExistApis"
+	CloudApiStageNotFound          = "NotFoundStage"
+	CloudApiTrafficControlNotFound = "NotFoundTrafficControl"
+
+	// sae
+	SaeNamespaceNotFound   = "InvalidNamespaceId.NotFound"
+	SaeApplicationNotFound = "InvalidAppId.NotFound"
+
+	// ros
+	RosStackNotFound = "StackNotFound"
+
+	// cms
+	CmsResourceNotFound      = "ResourceNotFound"
+	CmsResourceNotFoundError = "ResourceNotFoundError"
+
+	// sag
+	SagInstanceNotFound = "ParameterSmartAGId"
+	SagAclNotFound      = "ParameterSagAclId"
+
+	// alikafka
+	AlikafkaTopicNotFound        = "BIZ_TOPIC_NOT_FOUND"
+	AlikafkaSubscriptionNotFound = "BIZ_SUBSCRIPTION_NOT_FOUND"
+
+	// amqp
+	AmqpInstanceNotExist = "InstanceNotExist"
+	AmqpExchangeNotExist = "ExchangeNotExist"
+	AmqpQueueNotExist    = "QueueNotExist"
+
+	// mse
+	MseClusterNotFound = "mse-200-021"
+
+	// ddoscoo
+	DdoscooInstanceNotFound = "InstanceNotFound"
+
+	// waf
+	WafDomainNotExist = "DomainNotExist"
+
+	// config
+	ConfigRuleNotExists            = "ConfigRuleNotExists"
+	ConfigInvalidAggregatorId      = "Invalid.AggregatorId.Value"
+	ConfigDeliveryChannelNotExists = "DeliveryChannelNotExists"
+
+	// bastionhost
+	BastionhostObjectNotFound = "OBJECT_NOT_FOUND"
+
+	// dts
+	DtsInvalidJobId     = "InvalidJobId"
+	DtsInstanceNotFound = "Forbidden.InstanceNotFound"
+	DtsJobIdNotFound    = "InvalidDtsJobId.NotFound"
+
+	// oos
+	OosTemplateNotExists  = "EntityNotExists.Template"
+	OosExecutionNotExists = "EntityNotExists.Execution"
+
+	// emr
+	EmrClusterNotFound = "ErrorCode.Cluster.NotFound"
+
+	// fnf
+	FnfFlowNotExists     = "FlowNotExists"
+	FnfScheduleNotExists = "ScheduleNotExists"
+
+	// privatelink
+	PrivateLinkServiceNotFound  = "EndpointServiceNotFound"
+	PrivateLinkEndpointNotFound = "EndpointNotFound"
+	PrivateLinkZoneNotFound     = "EndpointZoneNotFound"
+
+	// cen
+	CenInstanceNotExist = "ParameterCenInstanceId"
+	CenRouteMapNotExist = "InvalidRouteMapId.NotFound"
+	// The child instance is not attached to the CEN instance.
+	CenChildInstanceNotExist = "ParameterInstanceId"
+
+	// vpn
+	VpnGatewayNotFound    = "InvalidVpnGatewayInstanceId.NotFound"
+	VpnRouteEntryNotFound = "VpnRouteEntry.NotFound"
+	// The vpn gateway rejects the changes of the routes while it is applying the previous ones.
+	VpnGatewayConfiguring = "VpnGateway.Configuring"
+
+	// log
+	LogProjectNotExist      = "ProjectNotExist"
+	LogMachineGroupNotExist = "MachineGroupNotExist"
+	LogConfigNotExist       = "ConfigNotExist"
+	LogJobNotExist          = "JobNotExist"
+	LogDashboardNotExist    = "DashboardNotExist"
+	LogSavedSearchNotExist  = "SavedSearchNotExist"
+
+	// eventbridge
+	EventBusNotExist    = "EventBusNotExist"
+	EventRuleNotExisted = "EventRuleNotExisted"
+	EventSourceNotExist = "EventSourceNotExist"
+
+	// Container registry
+	CrNamespaceNotExist = "NAMESPACE_NOT_EXIST"
+	CrRepoNotExist      = "REPO_NOT_EXIST"
 
 	// cdn
-	ServiceBusy = "ServiceBusy"
+	ServiceBusy                       = "ServiceBusy"
+	InvalidDomainNotFound             = "InvalidDomain.NotFound"
+	InvalidDomainNotICP               = "InvalidDomain.NotBeian"
+	DomainOwnerVerifyFail             = "DomainOwnerVerifyFail"
+	InvalidRealTimeLogServiceNotFound = "InvalidRealTimeLogService.NotFound"
 
 	//
 	InvalidRamRoleNotFound       = "InvalidRamRole.NotFound"
 	RoleAttachmentUnExpectedJson = "unexpected end of JSON input"
 	InvalidInstanceIdNotFound    = "InvalidInstanceId.NotFound"
 
+	// kvstore
+	KVStoreIncorrectInstanceStatus = "IncorrectDBInstanceState"
+
+	// rds
+	InvalidDBInstanceIdNotFound = "InvalidDBInstanceId.NotFound"
+
+	// polardb
+	InvalidPolarDBClusterNotFound = "InvalidDBClusterId.NotFound"
+	PolarDBOperationDeniedStatus  = "OperationDenied.DBClusterStatus"
+
+	// adb and clickhouse
+	InvalidADBClusterNotFound        = "InvalidDBClusterId.NotFound"
+	InvalidClickHouseClusterNotFound = "InvalidDBClusterId.NotFound"
+	ClickHouseOperationDeniedStatus  = "IncorrectDBInstanceState"
+
+	// hbase
+	InvalidHBaseInstanceNotFound = "Instance.NotFound"
+	HBaseOperationDeniedStatus   = "Instance.StatusNotSupport"
+
+	// mongodb
+	InvalidMongoDBInstanceIdNotFound = "InvalidDBInstanceId.NotFound"
+	MongoDBOperationDeniedStatus     = "OperationDenied.DBInstanceStatus"
+
+	// quotas
+	QuotaAlarmNotFound = "QUOTA.ALARM.NOT.FOUND"
+
 	RouterInterfaceIncorrectStatus                        = "IncorrectStatus"
 	DependencyViolationRouterInterfaceReferedByRouteEntry = "DependencyViolation.RouterInterfaceReferedByRouteEntry"
 )
@@ -111,18 +266,127 @@ func GetNotFoundErrorFromString(str string) error {
 	}
 }
 
-func NotFoundError(err error) bool {
-	if e, ok := err.(*common.Error); ok &&
-		(e.Code == InstanceNotFound || e.Code == RamInstanceNotFound ||
-			strings.Contains(strings.ToLower(e.Message), MessageInstanceNotFound)) {
+// ApiError is the error of an API call on a resource, and its message contains the action, the resource id
+// and the request id of the API error, which are needed to open a support ticket.
+type ApiError struct {
+	Action     string
+	ResourceId string
+	Cause      error
+}
+
+func (e *ApiError) Error() string {
+	msg := e.Action
+	if e.ResourceId != "" {
+		msg = fmt.Sprintf("%s on %s", e.Action, e.ResourceId)
+	}
+	if ce, ok := e.Cause.(*common.Error); ok {
+		return fmt.Sprintf("%s got an error, RequestId: %s, Code: %s, Message: %s", msg, ce.RequestId, ce.Code, ce.Message)
+	}
+	return fmt.Sprintf("%s got an error: %s", msg, e.Cause)
+}
+
+// WrapError wraps the error of the action on the resource into an ApiError, and the wrapped error can still
+// be checked by the NotFoundError and the IsExceptedError.
+func WrapError(err error, action, resourceId string) error {
+	return &ApiError{
+		Action:     action,
+		ResourceId: resourceId,
+		Cause:      err,
+	}
+}
+
+// causeOf returns the API error wrapped in the err, or the err itself.
+func causeOf(err error) error {
+	if e, ok := err.(*ApiError); ok {
+		return e.Cause
+	}
+	return err
+}
+
+// notFoundErrorCodes are the error codes of each service, which are returned when the resource or its parent
+// resource is not found. They are checked by the NotFoundError of the service, so that the Read and the Delete
+// of all the resources handle the resources deleted out of band in the same way, and a code of a service is never
+// taken as a not found error of the others. The services without codes, like the hbr, report a resource not
+// found by an empty result of its Describe API, which is turned into the InstanceNotFound by the
+// GetNotFoundErrorFromString.
+var notFoundErrorCodes = map[string][]string{
+	"ecs":         {InvalidInstanceIdNotFound, InvalidSecurityGroupIdNotFound, KeyPairNotFound, InvalidRamRoleNotFound, InvalidCmdIdNotFound, InvalidInvokeIdNotFound},
+	"vpc":         {InvalidInstanceIdNotFound},
+	"rds":         {InvalidDBInstanceIdNotFound},
+	"kvstore":     {InvalidInstanceIdNotFound},
+	"ocs":         {InvalidInstanceIdNotFound},
+	"slb":         {LoadBalancerNotFound},
+	"ess":         {InvalidScalingGroupIdNotFound},
+	"ram":         {RamInstanceNotFound},
+	"cs":          {ErrorClusterNotFound, ErrorNodePoolNotFound},
+	"ons":         {OnsInstanceNotExist},
+	"fc":          {FcServiceNotFound, FcFunctionNotFound, FcTriggerNotFound, FcDomainNotFound, FcOnDemandNotFound, FcLayerNotFound, FcAsyncNotFound},
+	"cloudapi":    {CloudApiGroupNotFound, CloudApiNotFound, CloudApiAppNotFound, CloudApiStageNotFound, CloudApiTrafficControlNotFound},
+	"sae":         {SaeNamespaceNotFound, SaeApplicationNotFound},
+	"ros":         {RosStackNotFound},
+	"waf":         {WafDomainNotExist},
+	"config":      {ConfigRuleNotExists, ConfigInvalidAggregatorId, ConfigDeliveryChannelNotExists},
+	"bastionhost": {BastionhostObjectNotFound},
+	"dts":         {DtsInstanceNotFound, DtsJobIdNotFound},
+	"oos":         {OosTemplateNotExists, OosExecutionNotExists},
+	"emr":         {EmrClusterNotFound},
+	"fnf":         {FnfFlowNotExists, FnfScheduleNotExists},
+	"privatelink": {PrivateLinkServiceNotFound, PrivateLinkEndpointNotFound, PrivateLinkZoneNotFound},
+	"cen":         {CenRouteMapNotExist},
+	"vpn":         {VpnGatewayNotFound, VpnRouteEntryNotFound},
+	"dns":         {DnsGtmInstanceNotExist, DnsGtmAddrPoolNotExist, DnsGtmStrategyNotExist},
+	"log":         {LogProjectNotExist, LogMachineGroupNotExist, LogConfigNotExist, LogJobNotExist, LogDashboardNotExist, LogSavedSearchNotExist},
+	"eventbridge": {EventBusNotExist, EventRuleNotExisted, EventSourceNotExist},
+	"cr":          {CrNamespaceNotExist, CrRepoNotExist},
+	"cdn":         {InvalidDomainNotFound, InvalidRealTimeLogServiceNotFound},
+	"polardb":     {InvalidPolarDBClusterNotFound},
+	"adb":         {InvalidADBClusterNotFound},
+	"clickhouse":  {InvalidClickHouseClusterNotFound},
+	"hbase":       {InvalidHBaseInstanceNotFound},
+	"mongodb":     {InvalidMongoDBInstanceIdNotFound},
+	"quotas":      {QuotaAlarmNotFound},
+	"cms":         {CmsResourceNotFound, CmsResourceNotFoundError},
+	"sag":         {SagInstanceNotFound, SagAclNotFound},
+	"alikafka":    {AlikafkaTopicNotFound, AlikafkaSubscriptionNotFound},
+	"amqp":        {AmqpInstanceNotExist, AmqpExchangeNotExist, AmqpQueueNotExist},
+	"hbr":         {},
+	"mse":         {MseClusterNotFound},
+	"ddoscoo":     {DdoscooInstanceNotFound},
+	"cloudfw":     {},
+	"dm":          {},
+	"dcdn":        {InvalidDomainNotFound},
+}
+
+// NotFoundError returns whether the err of the service, which can be wrapped by the WrapError, means that the
+// resource is not found. It checks the not found error codes of the service, the errors returned by the
+// GetNotFoundErrorFromString, the RAM EntityNotExist ones and the OSS ones. The service is the name of the
+// provider endpoints block, like "ecs".
+func NotFoundError(err error, service string) bool {
+	if err == nil {
+		return false
+	}
+	cause := causeOf(err)
+	if ossNotFoundError(cause) || RamEntityNotExist(cause) {
 		return true
 	}
 
-	return false
+	e, ok := cause.(*common.Error)
+	if !ok {
+		return false
+	}
+	if e.Code == InstanceNotFound {
+		return true
+	}
+	for _, code := range notFoundErrorCodes[service] {
+		if e.Code == code {
+			return true
+		}
+	}
+	return strings.Contains(strings.ToLower(e.Message), MessageInstanceNotFound)
 }
 
 func IsExceptedError(err error, expectCode string) bool {
-	if e, ok := err.(*common.Error); ok && (e.Code == expectCode || strings.Contains(e.Message, expectCode)) {
+	if e, ok := causeOf(err).(*common.Error); ok && (e.Code == expectCode || strings.Contains(e.Message, expectCode)) {
 		return true
 	}
 
@@ -130,8 +394,94 @@ func IsExceptedError(err error, expectCode string) bool {
 }
 
 func RamEntityNotExist(err error) bool {
-	if e, ok := err.(*common.Error); ok && strings.Contains(e.Code, "EntityNotExist") {
+	if e, ok := causeOf(err).(*common.Error); ok && strings.Contains(e.Code, "EntityNotExist") {
 		return true
 	}
 	return false
 }
+
+// The classes of the errors returned by the provider, which are returned by the ErrorClass, so that the
+// wrapper tools and the tests can check the kind of a failure without matching the error messages.
+const (
+	// ErrorClassConfig is the class of the errors caused by the configuration of the user.
+	ErrorClassConfig = "ConfigError"
+	// ErrorClassApi is the class of the errors returned by the APIs.
+	ErrorClassApi = "ApiError"
+	// ErrorClassBug is the class of the errors which should never happen, and are bugs of the provider.
+	ErrorClassBug = "ProviderBug"
+)
+
+// The codes of the ConfigError and the BugError.
+const (
+	ErrorCodeInvalidProviderConfig   = "InvalidProviderConfig"
+	ErrorCodeInvalidResourceId       = "InvalidResourceId"
+	ErrorCodeInvalidArgument         = "InvalidArgument"
+	ErrorCodeMissingArgument         = "MissingArgument"
+	ErrorCodeConflictingArguments    = "ConflictingArguments"
+	ErrorCodeUnsupportedOperation    = "UnsupportedOperation"
+	ErrorCodeUnsupportedResourceType = "UnsupportedResourceType"
+)
+
+// ConfigError is the error of an invalid provider or resource configuration, which has to be fixed by the user.
+type ConfigError struct {
+	Code    string
+	Message string
+}
+
+func (e *ConfigError) Error() string {
+	return e.Message
+}
+
+// ConfigErrorf returns a ConfigError with the code and the message formatted like the fmt.Errorf.
+func ConfigErrorf(code, format string, a ...interface{}) error {
+	return &ConfigError{
+		Code:    code,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// BugError is the error of a case which should never happen, and it has to be reported to the provider.
+type BugError struct {
+	Code    string
+	Message string
+}
+
+func (e *BugError) Error() string {
+	return fmt.Sprintf("%s. This is a bug of the provider, please report it to the provider developers.", e.Message)
+}
+
+// BugErrorf returns a BugError with the code and the message formatted like the fmt.Errorf.
+func BugErrorf(code, format string, a ...interface{}) error {
+	return &BugError{
+		Code:    code,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// ErrorClass returns the class of the err, which is one of the ErrorClassConfig, the ErrorClassApi and the
+// ErrorClassBug, or an empty string when the class of the err is unknown.
+func ErrorClass(err error) string {
+	switch err.(type) {
+	case *ConfigError:
+		return ErrorClassConfig
+	case *BugError:
+		return ErrorClassBug
+	case *ApiError, *common.Error:
+		return ErrorClassApi
+	}
+	return ""
+}
+
+// ErrorCode returns the code of the err, which is the error code of the API for the API errors, or an empty
+// string when the err has no code.
+func ErrorCode(err error) string {
+	switch e := causeOf(err).(type) {
+	case *ConfigError:
+		return e.Code
+	case *BugError:
+		return e.Code
+	case *common.Error:
+		return e.Code
+	}
+	return ""
+}
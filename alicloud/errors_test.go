@@ -0,0 +1,180 @@
+package alicloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+func TestWrapError(t *testing.T) {
+	apiErr := &common.Error{
+		ErrorResponse: common.ErrorResponse{
+			Response: common.Response{RequestId: "473469C7-AA6F-4DC5-B3DB-A3DC0DE3C83E"},
+			Code:     "InvalidVpcID.NotFound",
+			Message:  "Specified VPC does not exist.",
+		},
+		StatusCode: 404,
+	}
+
+	err := WrapError(apiErr, "DescribeVpcs", "vpc-abc123")
+	expected := "DescribeVpcs on vpc-abc123 got an error, RequestId: 473469C7-AA6F-4DC5-B3DB-A3DC0DE3C83E, Code: InvalidVpcID.NotFound, Message: Specified VPC does not exist."
+	if err.Error() != expected {
+		t.Fatalf("expected %q, but got %q", expected, err.Error())
+	}
+	if !IsExceptedError(err, "InvalidVpcID.NotFound") {
+		t.Fatalf("the wrapped error should be checked by IsExceptedError")
+	}
+
+	err = WrapError(GetNotFoundErrorFromString("Vpc vpc-abc123 not found"), "DescribeVpcs", "vpc-abc123")
+	if !NotFoundError(err, "vpc") {
+		t.Fatalf("the wrapped error should be checked by NotFoundError")
+	}
+
+	err = WrapError(fmt.Errorf("connection reset by peer"), "DescribeVpcs", "")
+	expected = "DescribeVpcs got an error: connection reset by peer"
+	if err.Error() != expected {
+		t.Fatalf("expected %q, but got %q", expected, err.Error())
+	}
+}
+
+func TestNotFoundError(t *testing.T) {
+	notFound := map[error]string{
+		GetNotFoundErrorFromString("Vpc vpc-abc123 not found"):                                                       "vpc",
+		&common.Error{ErrorResponse: common.ErrorResponse{Code: LoadBalancerNotFound}}:                               "slb",
+		&common.Error{ErrorResponse: common.ErrorResponse{Code: FcFunctionNotFound}}:                                 "fc",
+		&common.Error{ErrorResponse: common.ErrorResponse{Code: "EntityNotExist.User"}}:                              "ram",
+		WrapError(&common.Error{ErrorResponse: common.ErrorResponse{Code: LogProjectNotExist}}, "GetProject", "foo"): "log",
+	}
+	for err, service := range notFound {
+		if !NotFoundError(err, service) {
+			t.Fatalf("%s should be a not found error of %s", err, service)
+		}
+	}
+
+	found := map[error]string{
+		&common.Error{ErrorResponse: common.ErrorResponse{Code: SgDependencyViolation}}: "ecs",
+		&common.Error{ErrorResponse: common.ErrorResponse{Code: FcFunctionNotFound}}:    "ecs",
+		&common.Error{ErrorResponse: common.ErrorResponse{Code: "InvalidJobId"}}:        "dts",
+		fmt.Errorf("connection reset by peer"):                                          "ecs",
+	}
+	for err, service := range found {
+		if NotFoundError(err, service) {
+			t.Fatalf("%v should not be a not found error of %s", err, service)
+		}
+	}
+	if NotFoundError(nil, "ecs") {
+		t.Fatalf("nil should not be a not found error")
+	}
+}
+
+func TestNotFoundErrorServices(t *testing.T) {
+	// Every service passed to the NotFoundError by a caller, with one of its not found error codes, or with
+	// an empty code when the service reports a resource not found by an empty result only.
+	services := map[string]string{
+		"adb":         InvalidADBClusterNotFound,
+		"alikafka":    AlikafkaTopicNotFound,
+		"amqp":        AmqpInstanceNotExist,
+		"bastionhost": BastionhostObjectNotFound,
+		"cdn":         InvalidDomainNotFound,
+		"cen":         CenRouteMapNotExist,
+		"clickhouse":  InvalidClickHouseClusterNotFound,
+		"cloudapi":    CloudApiGroupNotFound,
+		"cloudfw":     "",
+		"cms":         CmsResourceNotFound,
+		"config":      ConfigRuleNotExists,
+		"cr":          CrRepoNotExist,
+		"cs":          ErrorClusterNotFound,
+		"dcdn":        InvalidDomainNotFound,
+		"ddoscoo":     DdoscooInstanceNotFound,
+		"dm":          "",
+		"dns":         DnsGtmInstanceNotExist,
+		"dts":         DtsJobIdNotFound,
+		"ecs":         InvalidInstanceIdNotFound,
+		"emr":         EmrClusterNotFound,
+		"ess":         InvalidScalingGroupIdNotFound,
+		"eventbridge": EventBusNotExist,
+		"fc":          FcServiceNotFound,
+		"fnf":         FnfFlowNotExists,
+		"hbase":       InvalidHBaseInstanceNotFound,
+		"hbr":         "",
+		"kvstore":     InvalidInstanceIdNotFound,
+		"log":         LogProjectNotExist,
+		"mongodb":     InvalidMongoDBInstanceIdNotFound,
+		"mse":         MseClusterNotFound,
+		"ocs":         InvalidInstanceIdNotFound,
+		"ons":         OnsInstanceNotExist,
+		"oos":         OosTemplateNotExists,
+		"polardb":     InvalidPolarDBClusterNotFound,
+		"privatelink": PrivateLinkEndpointNotFound,
+		"quotas":      QuotaAlarmNotFound,
+		"ram":         RamInstanceNotFound,
+		"rds":         InvalidDBInstanceIdNotFound,
+		"ros":         RosStackNotFound,
+		"sae":         SaeApplicationNotFound,
+		"sag":         SagAclNotFound,
+		"slb":         LoadBalancerNotFound,
+		"vpc":         InvalidInstanceIdNotFound,
+		"vpn":         VpnGatewayNotFound,
+		"waf":         WafDomainNotExist,
+	}
+	for service, code := range services {
+		if _, ok := notFoundErrorCodes[service]; !ok {
+			t.Fatalf("the not found error codes of %s are missing", service)
+		}
+		if code != "" && !NotFoundError(&common.Error{ErrorResponse: common.ErrorResponse{Code: code}}, service) {
+			t.Fatalf("%s should be a not found error of %s", code, service)
+		}
+		if !NotFoundError(GetNotFoundErrorFromString("foo not found"), service) {
+			t.Fatalf("the error of GetNotFoundErrorFromString should be a not found error of %s", service)
+		}
+	}
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	caller := regexp.MustCompile(`NotFoundError\(\w+, "(\w+)"\)`)
+	for _, file := range files {
+		bytes, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, match := range caller.FindAllStringSubmatch(string(bytes), -1) {
+			if _, ok := services[match[1]]; !ok {
+				t.Fatalf("the service %s passed to the NotFoundError in %s is not in the table", match[1], file)
+			}
+		}
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	cases := []struct {
+		err   error
+		class string
+		code  string
+	}{
+		{ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid resource id %s", "foo"), ErrorClassConfig, ErrorCodeInvalidResourceId},
+		{BugErrorf(ErrorCodeUnsupportedResourceType, "Resource type %s does not support resource group", "disk"), ErrorClassBug, ErrorCodeUnsupportedResourceType},
+		{&common.Error{ErrorResponse: common.ErrorResponse{Code: LoadBalancerNotFound}}, ErrorClassApi, LoadBalancerNotFound},
+		{WrapError(&common.Error{ErrorResponse: common.ErrorResponse{Code: SgDependencyViolation}}, "DeleteSecurityGroup", "sg-abc123"), ErrorClassApi, SgDependencyViolation},
+		{fmt.Errorf("connection reset by peer"), "", ""},
+	}
+	for _, c := range cases {
+		if class := ErrorClass(c.err); class != c.class {
+			t.Fatalf("the class of %q should be %q, but got %q", c.err, c.class, class)
+		}
+		if code := ErrorCode(c.err); code != c.code {
+			t.Fatalf("the code of %q should be %q, but got %q", c.err, c.code, code)
+		}
+	}
+
+	err := BugErrorf(ErrorCodeUnsupportedResourceType, "Resource type %s does not support resource group", "disk")
+	if !strings.Contains(err.Error(), "bug of the provider") {
+		t.Fatalf("the message of a BugError should ask to report it, but got %q", err.Error())
+	}
+}
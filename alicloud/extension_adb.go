@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	ADBEndpoint   = "https://adb.aliyuncs.com"
+	ADBApiVersion = "2019-03-15"
+)
+
+type ADBClusterStatus string
+
+const (
+	ADBRunning      = ADBClusterStatus("Running")
+	ADBPreparing    = ADBClusterStatus("Preparing")
+	ADBClassChanged = ADBClusterStatus("ClassChanging")
+	ADBDeleting     = ADBClusterStatus("Deleting")
+)
+
+var ADBClusterCategories = []string{"Basic", "Cluster"}
+
+type CreateADBClusterArgs struct {
+	RegionId             common.Region
+	ZoneId               string
+	DBClusterCategory    string
+	DBClusterClass       string
+	DBClusterVersion     string
+	DBNodeGroupCount     string
+	DBNodeStorage        string
+	DBClusterDescription string
+	DBClusterNetworkType string
+	VPCId                string
+	VSwitchId            string
+	PayType              string
+	Period               string
+	UsedTime             string
+	ClientToken          string
+}
+
+type CreateADBClusterResponse struct {
+	common.Response
+	DBClusterId string
+	OrderId     string
+}
+
+type ADBClusterArgs struct {
+	RegionId    common.Region
+	DBClusterId string
+}
+
+type ADBCluster struct {
+	DBClusterId          string
+	DBClusterDescription string
+	DBClusterStatus      ADBClusterStatus
+	Category             string
+	DBNodeClass          string
+	DBNodeCount          int
+	DBNodeStorage        int
+	DBVersion            string
+	PayType              string
+	ZoneId               string
+	VPCId                string
+	VSwitchId            string
+	ConnectionString     string
+	Port                 string
+	MaintainTime         string
+}
+
+type DescribeADBClusterAttributeResponse struct {
+	common.Response
+	Items struct {
+		DBCluster []ADBCluster
+	}
+}
+
+type ModifyADBClusterDescriptionArgs struct {
+	RegionId             common.Region
+	DBClusterId          string
+	DBClusterDescription string
+}
+
+type ModifyADBClusterMaintainTimeArgs struct {
+	RegionId     common.Region
+	DBClusterId  string
+	MaintainTime string
+}
+
+type ModifyADBClusterArgs struct {
+	RegionId         common.Region
+	DBClusterId      string
+	DBNodeClass      string
+	DBNodeGroupCount string
+	DBNodeStorage    string
+}
+
+type ModifyADBAccessWhiteListArgs struct {
+	RegionId             common.Region
+	DBClusterId          string
+	DBClusterIPArrayName string
+	SecurityIps          string
+}
+
+type DescribeADBAccessWhiteListResponse struct {
+	common.Response
+	Items struct {
+		IPArray []struct {
+			DBClusterIPArrayName string
+			SecurityIPList       string
+		}
+	}
+}
+
+// ADBResponse is used by the actions which only return a RequestId
+type ADBResponse struct {
+	common.Response
+}
@@ -0,0 +1,195 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	AlikafkaEndpointTemplate = "https://alikafka.%s.aliyuncs.com"
+	AlikafkaApiVersion       = "2019-09-16"
+)
+
+type AlikafkaInstanceStatus int
+
+const (
+	AlikafkaInstancePending   = AlikafkaInstanceStatus(0)
+	AlikafkaInstanceDeploying = AlikafkaInstanceStatus(1)
+	AlikafkaInstanceRunning   = AlikafkaInstanceStatus(5)
+	AlikafkaInstanceExpired   = AlikafkaInstanceStatus(15)
+)
+
+const (
+	// The instance is only reachable in the vpc.
+	AlikafkaDeployTypeVpc = 5
+	// The instance is reachable in both the vpc and the internet.
+	AlikafkaDeployTypeInternet = 4
+)
+
+var AlikafkaSaslUserTypes = []string{"plain", "scram"}
+var AlikafkaAclResourceTypes = []string{"Topic", "Group"}
+var AlikafkaAclPatternTypes = []string{"LITERAL", "PREFIXED"}
+var AlikafkaAclOperationTypes = []string{"Write", "Read"}
+
+type CreateAlikafkaOrderArgs struct {
+	RegionId   common.Region
+	TopicQuota int
+	DiskType   int
+	DiskSize   int
+	DeployType int
+	IoMax      int
+}
+
+type CreateAlikafkaOrderResponse struct {
+	common.Response
+	OrderId string
+}
+
+type UpgradeAlikafkaOrderArgs struct {
+	RegionId   common.Region
+	InstanceId string
+	TopicQuota int
+	DiskSize   int
+	IoMax      int
+}
+
+type AlikafkaInstanceArgs struct {
+	RegionId            common.Region
+	InstanceId          string
+	OrderId             string
+	InstanceName        string
+	ReleaseIgnoreTime   string
+	ForceDeleteInstance string
+}
+
+type StartAlikafkaInstanceArgs struct {
+	RegionId     common.Region
+	InstanceId   string
+	VpcId        string
+	VSwitchId    string
+	ZoneId       string
+	Name         string
+	DeployModule string
+}
+
+type AlikafkaInstance struct {
+	InstanceId    string
+	Name          string
+	ServiceStatus AlikafkaInstanceStatus
+	DeployType    int
+	DiskType      int
+	DiskSize      int
+	IoMax         int
+	TopicNumLimit int
+	VpcId         string
+	VSwitchId     string
+	ZoneId        string
+	EndPoint      string
+}
+
+type DescribeAlikafkaInstancesResponse struct {
+	common.Response
+	InstanceList struct {
+		InstanceVO []AlikafkaInstance
+	}
+}
+
+type AlikafkaTopicArgs struct {
+	RegionId        common.Region
+	InstanceId      string
+	Topic           string
+	Remark          string
+	LocalTopic      string
+	CompactTopic    string
+	PartitionNum    int
+	AddPartitionNum int
+}
+
+type DescribeAlikafkaTopicsArgs struct {
+	RegionId    common.Region
+	InstanceId  string
+	CurrentPage int
+	PageSize    int
+}
+
+type AlikafkaTopic struct {
+	Topic        string
+	Remark       string
+	PartitionNum int
+	LocalTopic   bool
+	CompactTopic bool
+	Status       int
+}
+
+type DescribeAlikafkaTopicsResponse struct {
+	common.Response
+	Total     int
+	TopicList struct {
+		TopicVO []AlikafkaTopic
+	}
+}
+
+type AlikafkaConsumerGroupArgs struct {
+	RegionId   common.Region
+	InstanceId string
+	ConsumerId string
+	Remark     string
+}
+
+type AlikafkaConsumerGroup struct {
+	ConsumerId string
+	Remark     string
+}
+
+type DescribeAlikafkaConsumerGroupsResponse struct {
+	common.Response
+	ConsumerList struct {
+		ConsumerVO []AlikafkaConsumerGroup
+	}
+}
+
+type AlikafkaSaslUserArgs struct {
+	RegionId   common.Region
+	InstanceId string
+	Username   string
+	Password   string
+	Type       string
+}
+
+type AlikafkaSaslUser struct {
+	Username string
+	Password string
+	Type     string
+}
+
+type DescribeAlikafkaSaslUsersResponse struct {
+	common.Response
+	SaslUserList struct {
+		SaslUserVO []AlikafkaSaslUser
+	}
+}
+
+type AlikafkaAclArgs struct {
+	RegionId               common.Region
+	InstanceId             string
+	Username               string
+	AclResourceType        string
+	AclResourceName        string
+	AclResourcePatternType string
+	AclOperationType       string
+}
+
+type AlikafkaAcl struct {
+	Username               string
+	AclResourceType        string
+	AclResourceName        string
+	AclResourcePatternType string
+	AclOperationType       string
+	Host                   string
+}
+
+type DescribeAlikafkaAclsResponse struct {
+	common.Response
+	KafkaAclList struct {
+		KafkaAclVO []AlikafkaAcl
+	}
+}
@@ -0,0 +1,147 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	AmqpEndpointTemplate = "https://amqp-open.%s.aliyuncs.com"
+	AmqpApiVersion       = "2019-12-12"
+)
+
+const (
+	AmqpInstanceServing = "SERVING"
+)
+
+var AmqpInstanceTypes = []string{"professional", "vip"}
+var AmqpExchangeTypes = []string{"DIRECT", "TOPIC", "FANOUT", "HEADERS"}
+var AmqpBindingTypes = []string{"QUEUE", "EXCHANGE"}
+
+type AmqpListArgs struct {
+	InstanceId  string
+	VirtualHost string
+	NextToken   string
+	MaxResults  int
+}
+
+type AmqpInstance struct {
+	InstanceId      string
+	InstanceName    string
+	InstanceType    string
+	Status          string
+	SupportEIP      bool
+	ClassicEndpoint string
+	PrivateEndpoint string
+	PublicEndpoint  string
+	ExpireTime      int64
+}
+
+type DescribeAmqpInstancesResponse struct {
+	common.Response
+	Data struct {
+		Instances []AmqpInstance
+		NextToken string
+	}
+}
+
+type AmqpInstanceArgs struct {
+	InstanceId   string
+	InstanceName string
+}
+
+type AmqpVirtualHostArgs struct {
+	InstanceId  string
+	VirtualHost string
+}
+
+type AmqpVirtualHost struct {
+	Name string
+}
+
+type DescribeAmqpVirtualHostsResponse struct {
+	common.Response
+	Data struct {
+		VirtualHosts []AmqpVirtualHost
+		NextToken    string
+	}
+}
+
+type AmqpQueueArgs struct {
+	InstanceId           string
+	VirtualHost          string
+	QueueName            string
+	AutoDeleteState      string
+	ExclusiveState       string
+	MessageTTL           int
+	MaxLength            int
+	AutoExpireState      int
+	DeadLetterExchange   string
+	DeadLetterRoutingKey string
+	MaximumPriority      int
+}
+
+type AmqpQueue struct {
+	Name            string
+	VHostName       string
+	AutoDeleteState bool
+	ExclusiveState  bool
+}
+
+type DescribeAmqpQueuesResponse struct {
+	common.Response
+	Data struct {
+		Queues    []AmqpQueue
+		NextToken string
+	}
+}
+
+type AmqpExchangeArgs struct {
+	InstanceId        string
+	VirtualHost       string
+	ExchangeName      string
+	ExchangeType      string
+	AutoDeleteState   string
+	Internal          string
+	AlternateExchange string
+}
+
+type AmqpExchange struct {
+	Name            string
+	VHostName       string
+	ExchangeType    string
+	AutoDeleteState bool
+}
+
+type DescribeAmqpExchangesResponse struct {
+	common.Response
+	Data struct {
+		Exchanges []AmqpExchange
+		NextToken string
+	}
+}
+
+type AmqpBindingArgs struct {
+	InstanceId      string
+	VirtualHost     string
+	SourceExchange  string
+	DestinationName string
+	BindingKey      string
+	BindingType     string
+	Argument        string
+}
+
+type AmqpBinding struct {
+	SourceExchange  string
+	DestinationName string
+	BindingKey      string
+	BindingType     string
+	Argument        string
+}
+
+type DescribeAmqpBindingsResponse struct {
+	common.Response
+	Data struct {
+		Bindings  []AmqpBinding
+		NextToken string
+	}
+}
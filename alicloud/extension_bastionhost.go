@@ -0,0 +1,154 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	BastionhostEndpoint   = "https://yundun-bastionhost.aliyuncs.com"
+	BastionhostApiVersion = "2019-12-09"
+)
+
+// A bought instance is PENDING until it is started in a vswitch.
+const (
+	BastionhostInstancePending      = "PENDING"
+	BastionhostInstanceRunning      = "RUNNING"
+	BastionhostInstanceCreateFailed = "CREATE_FAILED"
+)
+
+var BastionhostPlanCodes = []string{"cloudbastion", "cloudbastion_ha"}
+var BastionhostSources = []string{"Local", "Ram"}
+var BastionhostHostSources = []string{"Local", "Ecs", "Rds"}
+var BastionhostAddressTypes = []string{"Public", "Private"}
+var BastionhostOSTypes = []string{"Linux", "Windows"}
+var BastionhostProtocols = []string{"SSH", "RDP"}
+
+type BastionhostInstanceArgs struct {
+	RegionId                 common.Region
+	InstanceId               string
+	Description              string
+	VswitchId                string
+	SecurityGroupIds         []string
+	AuthorizedSecurityGroups []string
+}
+
+type BastionhostInstance struct {
+	InstanceId               string
+	Description              string
+	InstanceStatus           string
+	LicenseCode              string
+	VpcId                    string
+	VswitchId                string
+	PublicNetworkAccess      bool
+	InternetEndpoint         string
+	IntranetEndpoint         string
+	AuthorizedSecurityGroups []string
+}
+
+type DescribeBastionhostInstanceAttributeResponse struct {
+	common.Response
+	InstanceAttribute BastionhostInstance
+}
+
+type BastionhostUserArgs struct {
+	RegionId     common.Region
+	InstanceId   string
+	UserId       string
+	UserName     string
+	Source       string
+	SourceUserId string
+	Password     string
+	DisplayName  string
+	Email        string
+	Mobile       string
+	Comment      string
+}
+
+type CreateBastionhostUserResponse struct {
+	common.Response
+	UserId string
+}
+
+type BastionhostUser struct {
+	UserId       string
+	UserName     string
+	Source       string
+	SourceUserId string
+	DisplayName  string
+	Email        string
+	Mobile       string
+	Comment      string
+}
+
+type GetBastionhostUserResponse struct {
+	common.Response
+	User BastionhostUser
+}
+
+type BastionhostHostArgs struct {
+	RegionId           common.Region
+	InstanceId         string
+	HostId             string
+	HostIds            string
+	HostName           string
+	ActiveAddressType  string
+	HostPrivateAddress string
+	HostPublicAddress  string
+	OSType             string
+	Source             string
+	SourceInstanceId   string
+	InstanceRegionId   string
+	Comment            string
+}
+
+type CreateBastionhostHostResponse struct {
+	common.Response
+	HostId int
+}
+
+type BastionhostHost struct {
+	HostId             int
+	HostName           string
+	ActiveAddressType  string
+	HostPrivateAddress string
+	HostPublicAddress  string
+	OSType             string
+	Source             string
+	SourceInstanceId   string
+	Comment            string
+}
+
+type GetBastionhostHostResponse struct {
+	common.Response
+	Host BastionhostHost
+}
+
+type BastionhostHostAccountArgs struct {
+	RegionId        common.Region
+	InstanceId      string
+	HostId          string
+	HostAccountId   string
+	HostAccountName string
+	ProtocolName    string
+	Password        string
+	PrivateKey      string
+	PassPhrase      string
+}
+
+type CreateBastionhostHostAccountResponse struct {
+	common.Response
+	HostAccountId int
+}
+
+type BastionhostHostAccount struct {
+	HostAccountId   int
+	HostAccountName string
+	HostId          int
+	ProtocolName    string
+	HasPassword     bool
+}
+
+type GetBastionhostHostAccountResponse struct {
+	common.Response
+	HostAccount BastionhostHostAccount
+}
@@ -0,0 +1,59 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The subscription instances of some products, like AMQP, can only be bought by the business
+// support system API.
+const (
+	BssEndpoint   = "https://business.aliyuncs.com"
+	BssApiVersion = "2017-12-14"
+)
+
+type BssInstanceParameter struct {
+	Code  string
+	Value string
+}
+
+type CreateBssInstanceArgs struct {
+	ProductCode      string
+	ProductType      string
+	SubscriptionType string
+	Period           int
+	RenewalStatus    string
+	RenewPeriod      int
+	Parameter        []BssInstanceParameter
+}
+
+type CreateBssInstanceResponse struct {
+	common.Response
+	Code    string
+	Message string
+	Success bool
+	Data    struct {
+		OrderId    string
+		InstanceId string
+	}
+}
+
+// ModifyBssInstanceArgs upgrades or downgrades the specification of a subscription instance.
+type ModifyBssInstanceArgs struct {
+	ProductCode      string
+	ProductType      string
+	SubscriptionType string
+	ModifyType       string
+	InstanceId       string
+	Parameter        []BssInstanceParameter
+}
+
+type ModifyBssInstanceResponse struct {
+	common.Response
+	Code    string
+	Message string
+	Success bool
+	Data    struct {
+		OrderId    string
+		InstanceId string
+	}
+}
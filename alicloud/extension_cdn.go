@@ -0,0 +1,179 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const CdnApiVersion20180510 = "2018-05-10"
+
+type CdnDomainStatus string
+
+const (
+	CdnDomainOnline          = CdnDomainStatus("online")
+	CdnDomainOffline         = CdnDomainStatus("offline")
+	CdnDomainConfiguring     = CdnDomainStatus("configuring")
+	CdnDomainConfigureFailed = CdnDomainStatus("configure_failed")
+	CdnDomainChecking        = CdnDomainStatus("checking")
+	CdnDomainCheckFailed     = CdnDomainStatus("check_failed")
+)
+
+type CdnIpAllowListConfigArgs struct {
+	DomainName string
+	AllowIps   string
+}
+
+type DescribeCdnDomainConfigsArgs struct {
+	DomainName string
+	ConfigList string
+}
+
+// DescribeCdnDomainConfigsResponse contains the configs which are not supported by the cdn package.
+type DescribeCdnDomainConfigsResponse struct {
+	common.Response
+	DomainConfigs struct {
+		IpAllowListConfig struct {
+			ConfigId string
+			AllowIps string
+		}
+		ForceRedirectConfig struct {
+			ConfigId     string
+			RedirectType string
+		}
+	}
+}
+
+var CdnCertTypes = []string{"upload", "cas", "free"}
+
+// The TLS versions are mapped to the arguments of the https_tls_version function.
+var CdnTlsVersions = map[string]string{
+	"TLSv1.0": "tls10",
+	"TLSv1.1": "tls11",
+	"TLSv1.2": "tls12",
+	"TLSv1.3": "tls13",
+}
+
+type SetCdnDomainServerCertificateArgs struct {
+	DomainName              string
+	CertName                string
+	CertType                string
+	ServerCertificateStatus string
+	ServerCertificate       string
+	PrivateKey              string
+	ForceSet                string
+}
+
+type DescribeCdnDomainCertificateInfoArgs struct {
+	DomainName string
+}
+
+type CdnCertInfo struct {
+	DomainName              string
+	CertName                string
+	CertType                string
+	ServerCertificateStatus string
+	ServerCertificate       string
+	Status                  string
+	CertExpireTime          string
+}
+
+type DescribeCdnDomainCertificateInfoResponse struct {
+	common.Response
+	CertInfos struct {
+		CertInfo []CdnCertInfo
+	}
+}
+
+type SetCdnForceRedirectConfigArgs struct {
+	DomainName   string
+	RedirectType string
+}
+
+type CdnFunctionArg struct {
+	ArgName  string `json:"argName"`
+	ArgValue string `json:"argValue"`
+}
+
+type CdnFunction struct {
+	FunctionName string           `json:"functionName"`
+	FunctionArgs []CdnFunctionArg `json:"functionArgs"`
+}
+
+type BatchSetCdnDomainConfigArgs struct {
+	DomainNames string
+	// Functions is a JSON string of []CdnFunction.
+	Functions string
+}
+
+type DescribeCdnDomainFunctionsArgs struct {
+	DomainName    string
+	FunctionNames string
+}
+
+type DescribeCdnDomainFunctionsResponse struct {
+	common.Response
+	DomainConfigs struct {
+		DomainConfig []struct {
+			FunctionName string
+			ConfigId     string
+			FunctionArgs struct {
+				FunctionArg []struct {
+					ArgName  string
+					ArgValue string
+				}
+			}
+		}
+	}
+}
+
+type DescribeCdnUserDomainsArgs struct {
+	DomainName       string
+	DomainSearchType string
+	DomainStatus     string
+	CdnType          string
+	PageSize         int
+	PageNumber       int
+}
+
+type CdnUserDomain struct {
+	DomainName   string
+	Cname        string
+	CdnType      string
+	DomainStatus string
+	SourceType   string
+	SslProtocol  string
+	Description  string
+	GmtCreated   string
+	GmtModified  string
+	Sources      struct {
+		Source []string
+	}
+}
+
+type DescribeCdnUserDomainsResponse struct {
+	common.Response
+	PageNumber int
+	PageSize   int
+	TotalCount int
+	Domains    struct {
+		PageData []CdnUserDomain
+	}
+}
+
+type CdnRealTimeLogDeliveryArgs struct {
+	Domain   string
+	Project  string
+	Logstore string
+	Region   string
+}
+
+type CdnDomainArgs struct {
+	Domain string
+}
+
+type DescribeCdnRealTimeLogDeliveryResponse struct {
+	common.Response
+	Project  string
+	Logstore string
+	Region   string
+	Status   string
+}
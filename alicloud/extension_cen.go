@@ -0,0 +1,208 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	CenEndpoint   = "https://cbn.aliyuncs.com"
+	CenApiVersion = "2017-09-12"
+)
+
+const (
+	CenRouteMapCreating = "Creating"
+	CenRouteMapActive   = "Active"
+	CenRouteMapDeleting = "Deleting"
+)
+
+// The route maps of the RegionIn direction filter the routes learned by the region, and the ones of the
+// RegionOut direction filter the routes advertised to the other regions.
+const (
+	CenRegionIn  = "RegionIn"
+	CenRegionOut = "RegionOut"
+)
+
+const (
+	CenRouteEntryPublished    = "Published"
+	CenRouteEntryNonPublished = "NonPublished"
+)
+
+const CenChildInstanceVpc = "VPC"
+
+// The smart access gateways reach the CEN instances through the CCN instances, which are the cloud connect
+// networks the smart access gateways are bound to.
+const CenChildInstanceCcn = "CCN"
+
+const (
+	CenChildInstanceAttaching = "Attaching"
+	CenChildInstanceAttached  = "Attached"
+	CenChildInstanceDetaching = "Detaching"
+)
+
+// CenRouteMapArgs is used by the CreateCenRouteMap and ModifyCenRouteMap, and the RouteMapId is only set for the
+// ModifyCenRouteMap.
+type CenRouteMapArgs struct {
+	CenId                              string
+	CenRegionId                        string
+	RouteMapId                         string
+	TransmitDirection                  string
+	Priority                           int
+	MapResult                          string
+	NextPriority                       int
+	Description                        string
+	CidrMatchMode                      string
+	AsPathMatchMode                    string
+	CommunityMatchMode                 string
+	CommunityOperateMode               string
+	Preference                         int
+	SourceInstanceIdsReverseMatch      bool
+	DestinationInstanceIdsReverseMatch bool
+	SourceInstanceIds                  []string
+	DestinationInstanceIds             []string
+	SourceRegionIds                    []string
+	SourceChildInstanceTypes           []string
+	DestinationChildInstanceTypes      []string
+	SourceRouteTableIds                []string
+	DestinationRouteTableIds           []string
+	DestinationCidrBlocks              []string
+	RouteTypes                         []string
+	MatchAsns                          []string
+	MatchCommunitySet                  []string
+	OperateCommunitySet                []string
+	PrependAsPath                      []string
+}
+
+type CreateCenRouteMapResponse struct {
+	common.Response
+	RouteMapId string
+}
+
+type DescribeCenRouteMapsArgs struct {
+	CenId       string
+	CenRegionId string
+	RouteMapId  string
+	PageNumber  int
+	PageSize    int
+}
+
+type CenRouteMap struct {
+	RouteMapId                         string
+	CenId                              string
+	CenRegionId                        string
+	Status                             string
+	TransmitDirection                  string
+	Priority                           int
+	MapResult                          string
+	NextPriority                       int
+	Description                        string
+	CidrMatchMode                      string
+	AsPathMatchMode                    string
+	CommunityMatchMode                 string
+	CommunityOperateMode               string
+	Preference                         int
+	SourceInstanceIdsReverseMatch      bool
+	DestinationInstanceIdsReverseMatch bool
+	SourceInstanceIds                  struct {
+		SourceInstanceId []string
+	}
+	DestinationInstanceIds struct {
+		DestinationInstanceId []string
+	}
+	SourceRegionIds struct {
+		SourceRegionId []string
+	}
+	SourceChildInstanceTypes struct {
+		SourceChildInstanceType []string
+	}
+	DestinationChildInstanceTypes struct {
+		DestinationChildInstanceType []string
+	}
+	SourceRouteTableIds struct {
+		SourceRouteTableId []string
+	}
+	DestinationRouteTableIds struct {
+		DestinationRouteTableId []string
+	}
+	DestinationCidrBlocks struct {
+		DestinationCidrBlock []string
+	}
+	RouteTypes struct {
+		RouteType []string
+	}
+	MatchAsns struct {
+		MatchAsn []string
+	}
+	MatchCommunitySet struct {
+		MatchCommunity []string
+	}
+	OperateCommunitySet struct {
+		OperateCommunity []string
+	}
+	PrependAsPath struct {
+		AsPath []string
+	}
+}
+
+type DescribeCenRouteMapsResponse struct {
+	common.Response
+	TotalCount int
+	RouteMaps  struct {
+		RouteMap []CenRouteMap
+	}
+}
+
+type DeleteCenRouteMapArgs struct {
+	CenId       string
+	CenRegionId string
+	RouteMapId  string
+}
+
+// CenRouteEntryArgs is used by the PublishRouteEntries, WithdrawPublishedRouteEntries and
+// DescribePublishedRouteEntries, and the child instance is the vpc attached to the CEN instance.
+type CenRouteEntryArgs struct {
+	CenId                     string
+	ChildInstanceId           string
+	ChildInstanceType         string
+	ChildInstanceRegionId     common.Region
+	ChildInstanceRouteTableId string
+	DestinationCidrBlock      string
+	PageNumber                int
+	PageSize                  int
+}
+
+type CenPublishedRouteEntry struct {
+	DestinationCidrBlock      string
+	ChildInstanceRouteTableId string
+	NextHopType               string
+	NextHopId                 string
+	RouteType                 string
+	PublishStatus             string
+	OperationalMode           bool
+}
+
+type DescribePublishedRouteEntriesResponse struct {
+	common.Response
+	TotalCount            int
+	PublishedRouteEntries struct {
+		PublishedRouteEntry []CenPublishedRouteEntry
+	}
+}
+
+// CenChildInstanceArgs is used by the AttachCenChildInstance, DetachCenChildInstance and
+// DescribeCenAttachedChildInstanceAttribute of the CEN APIs.
+type CenChildInstanceArgs struct {
+	CenId                 string
+	ChildInstanceId       string
+	ChildInstanceType     string
+	ChildInstanceRegionId common.Region
+}
+
+type CenAttachedChildInstance struct {
+	common.Response
+	CenId                 string
+	ChildInstanceId       string
+	ChildInstanceType     string
+	ChildInstanceRegionId string
+	ChildInstanceOwnerId  int64
+	Status                string
+}
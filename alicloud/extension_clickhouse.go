@@ -0,0 +1,149 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	ClickHouseEndpoint   = "https://clickhouse.aliyuncs.com"
+	ClickHouseApiVersion = "2019-11-11"
+)
+
+type ClickHouseStatus string
+
+const (
+	ClickHouseRunning   = ClickHouseStatus("Running")
+	ClickHouseCreating  = ClickHouseStatus("Creating")
+	ClickHouseDeleting  = ClickHouseStatus("Deleting")
+	ClickHouseAvailable = ClickHouseStatus("Available")
+)
+
+const ClickHouseEngine = "clickhouse"
+
+var ClickHouseCategories = []string{"Basic", "HighAvailability"}
+
+var ClickHouseStorageTypes = []string{"cloud_essd", "cloud_efficiency", "cloud_essd_pl2", "cloud_essd_pl3"}
+
+type CreateClickHouseClusterArgs struct {
+	RegionId             common.Region
+	ZoneId               string
+	Engine               string
+	EngineVersion        string
+	DBClusterCategory    string
+	DBClusterClass       string
+	DBNodeGroupCount     string
+	DBNodeStorage        string
+	DbNodeStorageType    string
+	DBClusterDescription string
+	DBClusterNetworkType string
+	VPCId                string
+	VSwitchId            string
+	PayType              string
+	Period               string
+	UsedTime             string
+	ClientToken          string
+}
+
+type CreateClickHouseClusterResponse struct {
+	common.Response
+	DBClusterId string
+	OrderId     string
+}
+
+type ClickHouseClusterArgs struct {
+	RegionId    common.Region
+	DBClusterId string
+}
+
+type ClickHouseCluster struct {
+	DBClusterId          string
+	DBClusterDescription string
+	DBClusterStatus      ClickHouseStatus
+	Category             string
+	DBNodeClass          string
+	DBNodeCount          int
+	DBNodeStorage        int
+	StorageType          string
+	EngineVersion        string
+	PayType              string
+	ZoneId               string
+	VpcId                string
+	VSwitchId            string
+	ConnectionString     string
+	Port                 string
+}
+
+type DescribeClickHouseClusterAttributeResponse struct {
+	common.Response
+	DBCluster ClickHouseCluster
+}
+
+type ModifyClickHouseClusterDescriptionArgs struct {
+	RegionId             common.Region
+	DBClusterId          string
+	DBClusterDescription string
+}
+
+type ModifyClickHouseAccessWhiteListArgs struct {
+	RegionId             common.Region
+	DBClusterId          string
+	DBClusterIPArrayName string
+	SecurityIps          string
+}
+
+type DescribeClickHouseAccessWhiteListResponse struct {
+	common.Response
+	DBClusterAccessWhiteList struct {
+		IPArray []struct {
+			DBClusterIPArrayName string
+			SecurityIPList       string
+		}
+	}
+}
+
+type CreateClickHouseAccountArgs struct {
+	RegionId           common.Region
+	DBClusterId        string
+	AccountName        string
+	AccountPassword    string
+	AccountDescription string
+}
+
+type ClickHouseAccountArgs struct {
+	RegionId    common.Region
+	DBClusterId string
+	AccountName string
+}
+
+type ModifyClickHouseAccountDescriptionArgs struct {
+	RegionId           common.Region
+	DBClusterId        string
+	AccountName        string
+	AccountDescription string
+}
+
+type ResetClickHouseAccountPasswordArgs struct {
+	RegionId        common.Region
+	DBClusterId     string
+	AccountName     string
+	AccountPassword string
+}
+
+type ClickHouseAccount struct {
+	AccountName        string
+	AccountStatus      ClickHouseStatus
+	AccountType        string
+	AccountDescription string
+}
+
+type DescribeClickHouseAccountsResponse struct {
+	common.Response
+	Accounts struct {
+		Account []ClickHouseAccount
+	}
+}
+
+// ClickHouseResponse is used by the actions which only return a RequestId
+type ClickHouseResponse struct {
+	common.Response
+}
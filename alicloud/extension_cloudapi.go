@@ -0,0 +1,292 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	CloudApiEndpointTemplate = "https://apigateway.%s.aliyuncs.com"
+	CloudApiVersion          = "2016-07-14"
+)
+
+var CloudApiAuthTypes = []string{"APP", "ANONYMOUS"}
+var CloudApiRequestProtocols = []string{"HTTP", "HTTPS", "HTTP,HTTPS"}
+var CloudApiHttpMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "OPTIONS", "ANY"}
+var CloudApiRequestModes = []string{"MAPPING", "PASSTHROUGH"}
+var CloudApiServiceTypes = []string{"HTTP", "HTTP-VPC", "FunctionCompute", "MOCK"}
+var CloudApiParameterTypes = []string{"STRING", "INT", "LONG", "FLOAT", "DOUBLE", "BOOLEAN"}
+var CloudApiParameterLocations = []string{"BODY", "HEAD", "QUERY", "PATH"}
+var CloudApiStageNames = []string{"RELEASE", "PRE", "TEST"}
+var CloudApiTrafficControlUnits = []string{"SECOND", "MINUTE", "HOUR", "DAY"}
+var CloudApiSpecialTypes = []string{"APP", "USER"}
+
+type CloudApiGroupArgs struct {
+	RegionId    common.Region
+	GroupId     string
+	GroupName   string
+	Description string
+}
+
+type CreateCloudApiGroupResponse struct {
+	common.Response
+	GroupId   string
+	SubDomain string
+}
+
+type CloudApiGroup struct {
+	common.Response
+	GroupId     string
+	GroupName   string
+	Description string
+	SubDomain   string
+}
+
+type CloudApiArgs struct {
+	RegionId             common.Region
+	GroupId              string
+	ApiId                string
+	ApiName              string
+	Description          string
+	Visibility           string
+	AuthType             string
+	RequestConfig        string
+	ServiceConfig        string
+	RequestParameters    string
+	ServiceParameters    string
+	ServiceParametersMap string
+	ResultType           string
+	ResultSample         string
+	StageName            string
+}
+
+type CreateCloudApiResponse struct {
+	common.Response
+	ApiId string
+}
+
+// The configs below are sent as JSON strings, and they are also the structs in the response of DescribeApi.
+
+type CloudApiRequestConfig struct {
+	RequestProtocol   string
+	RequestHttpMethod string
+	RequestPath       string
+	RequestMode       string
+	BodyFormat        string `json:",omitempty"`
+}
+
+type CloudApiFunctionComputeConfig struct {
+	FcRegionId   string
+	ServiceName  string
+	FunctionName string
+	RoleArn      string
+}
+
+type CloudApiVpcConfig struct {
+	Name       string `json:"VpcAccessName"`
+	VpcId      string
+	InstanceId string
+	Port       int
+}
+
+type CloudApiServiceConfig struct {
+	ServiceProtocol       string
+	ServiceAddress        string                         `json:",omitempty"`
+	ServicePath           string                         `json:",omitempty"`
+	ServiceHttpMethod     string                         `json:",omitempty"`
+	ServiceTimeout        int                            `json:",omitempty"`
+	ContentTypeCatagory   string                         `json:",omitempty"`
+	Mock                  string                         `json:",omitempty"`
+	MockResult            string                         `json:",omitempty"`
+	FunctionComputeEnable string                         `json:",omitempty"`
+	FunctionComputeConfig *CloudApiFunctionComputeConfig `json:",omitempty"`
+	ServiceVpcEnable      string                         `json:",omitempty"`
+	VpcConfig             *CloudApiVpcConfig             `json:",omitempty"`
+}
+
+type CloudApiRequestParameter struct {
+	ApiParameterName string
+	ParameterType    string
+	Required         string
+	Location         string
+	DefaultValue     string `json:",omitempty"`
+	Description      string `json:",omitempty"`
+}
+
+type CloudApiServiceParameter struct {
+	ServiceParameterName string
+	Location             string
+	ParameterType        string
+	ParameterCatalog     string
+}
+
+type CloudApiServiceParameterMap struct {
+	ServiceParameterName string
+	RequestParameterName string
+}
+
+type CloudApi struct {
+	common.Response
+	GroupId           string
+	ApiId             string
+	ApiName           string
+	Description       string
+	Visibility        string
+	AuthType          string
+	ResultType        string
+	ResultSample      string
+	RequestConfig     CloudApiRequestConfig
+	ServiceConfig     CloudApiServiceConfig
+	RequestParameters struct {
+		RequestParameter []CloudApiRequestParameter
+	}
+	ServiceParameters struct {
+		ServiceParameter []CloudApiServiceParameter
+	}
+	ServiceParametersMap struct {
+		ServiceParameterMap []CloudApiServiceParameterMap
+	}
+}
+
+// CloudApiDeployedApi is the response of DescribeDeployedApi, which describes the api deployed in a stage.
+type CloudApiDeployedApi struct {
+	common.Response
+	GroupId   string
+	ApiId     string
+	StageName string
+}
+
+type CloudApiVpcAccessArgs struct {
+	RegionId   common.Region
+	Name       string
+	VpcId      string
+	InstanceId string
+	Port       int
+	common.Pagination
+}
+
+type CloudApiVpcAccess struct {
+	Name       string
+	VpcId      string
+	InstanceId string
+	Port       int
+}
+
+type DescribeCloudApiVpcAccessesResponse struct {
+	common.Response
+	common.PaginationResult
+	VpcAccessAttributes struct {
+		VpcAccessAttribute []CloudApiVpcAccess
+	}
+}
+
+type CloudApiAppArgs struct {
+	RegionId    common.Region
+	AppId       string
+	AppName     string
+	Description string
+}
+
+type CreateCloudApiAppResponse struct {
+	common.Response
+	AppId int64
+}
+
+type CloudApiApp struct {
+	AppId       int64
+	AppName     string
+	Description string
+}
+
+type DescribeCloudApiAppsResponse struct {
+	common.Response
+	Apps struct {
+		AppAttribute []CloudApiApp
+	}
+}
+
+type CloudApiAuthorityArgs struct {
+	RegionId    common.Region
+	GroupId     string
+	ApiId       string
+	ApiIds      string
+	AppId       string
+	StageName   string
+	Description string
+	common.Pagination
+}
+
+type CloudApiAuthorizedApp struct {
+	AppId     int64
+	AppName   string
+	StageName string
+}
+
+type DescribeCloudApiAuthorizedAppsResponse struct {
+	common.Response
+	common.PaginationResult
+	AuthorizedApps struct {
+		AuthorizedApp []CloudApiAuthorizedApp
+	}
+}
+
+type CloudApiTrafficControlArgs struct {
+	RegionId           common.Region
+	TrafficControlId   string
+	TrafficControlName string
+	TrafficControlUnit string
+	ApiDefault         int
+	UserDefault        int
+	AppDefault         int
+	Description        string
+	GroupId            string
+	ApiId              string
+	ApiIds             string
+	StageName          string
+	common.Pagination
+}
+
+type CreateCloudApiTrafficControlResponse struct {
+	common.Response
+	TrafficControlId string
+}
+
+type CloudApiTrafficSpecialControlArgs struct {
+	RegionId         common.Region
+	TrafficControlId string
+	SpecialType      string
+	SpecialKey       string
+	TrafficValue     int
+}
+
+type CloudApiSpecial struct {
+	SpecialKey   string
+	TrafficValue int
+}
+
+type CloudApiSpecialPolicy struct {
+	SpecialType string
+	Specials    struct {
+		Special []CloudApiSpecial
+	}
+}
+
+type CloudApiTrafficControl struct {
+	TrafficControlId   string
+	TrafficControlName string
+	TrafficControlUnit string
+	ApiDefault         int
+	UserDefault        int
+	AppDefault         int
+	Description        string
+	SpecialPolicies    struct {
+		SpecialPolicy []CloudApiSpecialPolicy
+	}
+}
+
+type DescribeCloudApiTrafficControlsResponse struct {
+	common.Response
+	common.PaginationResult
+	TrafficControls struct {
+		TrafficControl []CloudApiTrafficControl
+	}
+}
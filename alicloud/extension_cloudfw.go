@@ -0,0 +1,118 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The Cloud Firewall API is served by the cloudfw.aliyuncs.com for the mainland instances and in the
+// ap-southeast-1 for the others.
+const (
+	CloudfwEndpoint         = "https://cloudfw.aliyuncs.com"
+	CloudfwEndpointTemplate = "https://cloudfw.%s.aliyuncs.com"
+	CloudfwApiVersion       = "2017-12-07"
+)
+
+var CloudfwAclActions = []string{"accept", "drop", "log"}
+var CloudfwDirections = []string{"in", "out"}
+var CloudfwProtocols = []string{"ANY", "TCP", "UDP", "ICMP"}
+var CloudfwSourceTypes = []string{"net", "group", "location"}
+var CloudfwDestinationTypes = []string{"net", "group", "domain", "location"}
+var CloudfwDestPortTypes = []string{"port", "group"}
+var CloudfwAddressBookTypes = []string{"ip", "port", "domain"}
+
+// CloudfwControlPolicyArgs is used by the AddControlPolicy, ModifyControlPolicy and DeleteControlPolicy.
+type CloudfwControlPolicyArgs struct {
+	AclUuid         string
+	AclAction       string
+	ApplicationName string
+	Description     string
+	Direction       string
+	Proto           string
+	Source          string
+	SourceType      string
+	Destination     string
+	DestinationType string
+	DestPort        string
+	DestPortGroup   string
+	DestPortType    string
+	NewOrder        string
+	Lang            string
+}
+
+type AddCloudfwControlPolicyResponse struct {
+	common.Response
+	AclUuid string
+}
+
+type CloudfwControlPolicy struct {
+	AclUuid         string
+	AclAction       string
+	ApplicationName string
+	Description     string
+	Direction       string
+	Proto           string
+	Source          string
+	SourceType      string
+	Destination     string
+	DestinationType string
+	DestPort        string
+	DestPortGroup   string
+	DestPortType    string
+	Order           int
+}
+
+type DescribeCloudfwControlPolicyArgs struct {
+	Direction   string
+	AclUuid     string
+	CurrentPage string
+	PageSize    string
+	Lang        string
+}
+
+type DescribeCloudfwControlPolicyResponse struct {
+	common.Response
+	TotalCount string
+	Policys    []CloudfwControlPolicy
+}
+
+// CloudfwAddressBookArgs is used by the AddAddressBook, ModifyAddressBook and DeleteAddressBook. The AddressList
+// is separated by commas.
+type CloudfwAddressBookArgs struct {
+	GroupUuid   string
+	GroupName   string
+	GroupType   string
+	Description string
+	AddressList string
+	Lang        string
+}
+
+type AddCloudfwAddressBookResponse struct {
+	common.Response
+	GroupUuid string
+}
+
+type CloudfwAddressBook struct {
+	GroupUuid   string
+	GroupName   string
+	GroupType   string
+	Description string
+	AddressList []string
+}
+
+type DescribeCloudfwAddressBookArgs struct {
+	GroupType   string
+	Query       string
+	CurrentPage string
+	PageSize    string
+	Lang        string
+}
+
+type DescribeCloudfwAddressBookResponse struct {
+	common.Response
+	PageInfo struct {
+		CurrentPage int
+		PageSize    int
+		TotalCount  int
+	}
+	Acls []CloudfwAddressBook
+}
@@ -0,0 +1,250 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The cloud monitor API is served by the endpoint in cn-hangzhou for all the regions.
+const (
+	CmsEndpoint   = "https://metrics.cn-hangzhou.aliyuncs.com"
+	CmsApiVersion = "2019-01-01"
+)
+
+var CmsSiteMonitorTaskTypes = []string{"HTTP", "PING", "TCP", "UDP", "DNS", "SMTP", "POP3", "FTP"}
+
+// CmsResponse is embedded in all the responses, and a request is failed when Success is false
+// even though its http status is OK.
+type CmsResponse struct {
+	common.Response
+	Code    string
+	Message string
+	Success bool
+}
+
+type CmsIspCityArg struct {
+	City string `json:"city"`
+	Isp  string `json:"isp"`
+}
+
+type CmsIspCity struct {
+	City     string
+	Isp      string
+	CityName string
+	IspName  string
+}
+
+type CmsSiteMonitorArgs struct {
+	TaskId   string
+	TaskIds  string
+	TaskName string
+	TaskType string
+	Address  string
+	Interval int
+	// IspCities is a JSON array of the CmsIspCityArg.
+	IspCities      string
+	OptionsJson    string
+	AlertIds       string
+	IsDeleteAlarms string
+}
+
+type CreateCmsSiteMonitorResponse struct {
+	CmsResponse
+	CreateResultList struct {
+		CreateResultList []struct {
+			TaskId   string
+			TaskName string
+		}
+	}
+}
+
+type CmsSiteMonitor struct {
+	TaskId    string
+	TaskName  string
+	TaskType  string
+	Address   string
+	Interval  int
+	TaskState string
+	IspCities struct {
+		IspCity []CmsIspCity
+	}
+}
+
+type DescribeCmsSiteMonitorResponse struct {
+	CmsResponse
+	SiteMonitors CmsSiteMonitor
+}
+
+type CmsMonitorGroupArgs struct {
+	GroupId       string
+	GroupName     string
+	ContactGroups string
+}
+
+type CreateCmsMonitorGroupResponse struct {
+	CmsResponse
+	Id int64
+}
+
+type CmsMonitorGroup struct {
+	GroupId       int64
+	GroupName     string
+	ContactGroups struct {
+		ContactGroup []struct {
+			Name string
+		}
+	}
+}
+
+type DescribeCmsMonitorGroupsResponse struct {
+	CmsResponse
+	Resources struct {
+		Resource []CmsMonitorGroup
+	}
+}
+
+var CmsMetricRuleStatistics = []string{"Average", "Minimum", "Maximum", "Value", "Sum", "ErrorCodeMaximum"}
+var CmsMetricRuleComparisonOperators = []string{"GreaterThanOrEqualToThreshold", "GreaterThanThreshold", "LessThanOrEqualToThreshold",
+	"LessThanThreshold", "NotEqualToThreshold", "GreaterThanYesterday", "LessThanYesterday", "GreaterThanLastWeek",
+	"LessThanLastWeek", "GreaterThanLastPeriod", "LessThanLastPeriod"}
+
+type CmsEscalation struct {
+	Statistics         string
+	ComparisonOperator string
+	Threshold          string
+	Times              string
+}
+
+type CmsGroupMetricRuleArgs struct {
+	GroupId             string
+	RuleId              string
+	RuleName            string
+	Category            string
+	Namespace           string
+	MetricName          string
+	Dimensions          string
+	Period              string
+	Interval            string
+	EffectiveInterval   string
+	NoEffectiveInterval string
+	SilenceTime         int
+	EmailSubject        string
+	Webhook             string
+	Escalations         struct {
+		Critical *CmsEscalation
+		Warn     *CmsEscalation
+		Info     *CmsEscalation
+	}
+}
+
+type CmsMetricRuleArgs struct {
+	RuleIds string
+	Id      []string
+}
+
+type CmsMetricRuleEscalation struct {
+	Statistics         string
+	ComparisonOperator string
+	Threshold          string
+	Times              int
+}
+
+type CmsMetricRule struct {
+	RuleId              string
+	RuleName            string
+	GroupId             string
+	Namespace           string
+	MetricName          string
+	Dimensions          string
+	Period              string
+	EffectiveInterval   string
+	NoEffectiveInterval string
+	SilenceTime         int
+	MailSubject         string
+	Webhook             string
+	AlertState          string
+	EnableState         bool
+	Escalations         struct {
+		Critical CmsMetricRuleEscalation
+		Warn     CmsMetricRuleEscalation
+		Info     CmsMetricRuleEscalation
+	}
+}
+
+type DescribeCmsMetricRulesResponse struct {
+	CmsResponse
+	Alarms struct {
+		Alarm []CmsMetricRule
+	}
+}
+
+var CmsEventRuleLevels = []string{"CRITICAL", "WARN", "INFO", "*"}
+
+type CmsEventPatternArg struct {
+	Product       string
+	EventTypeList []string
+	LevelList     []string
+	NameList      []string
+}
+
+type CmsEventRuleArgs struct {
+	RuleName     string
+	GroupId      string
+	Description  string
+	EventType    string
+	State        string
+	SilenceTime  int
+	EventPattern []CmsEventPatternArg
+	NamePrefix   string
+	RuleNames    []string
+}
+
+type CmsEventPattern struct {
+	Product       string
+	EventTypeList struct {
+		EventTypeList []string
+	}
+	LevelList struct {
+		LevelList []string
+	}
+	NameList struct {
+		NameList []string
+	}
+}
+
+type CmsEventRule struct {
+	Name         string
+	GroupId      string
+	Description  string
+	EventType    string
+	State        string
+	SilenceTime  int
+	EventPattern struct {
+		EventPattern []CmsEventPattern
+	}
+}
+
+type DescribeCmsEventRulesResponse struct {
+	CmsResponse
+	EventRules struct {
+		EventRule []CmsEventRule
+	}
+}
+
+type CmsContactParameter struct {
+	Id               string
+	ContactGroupName string
+	Level            string
+}
+
+type CmsEventRuleTargetsArgs struct {
+	RuleName          string
+	ContactParameters []CmsContactParameter
+	Ids               []string
+}
+
+type DescribeCmsEventRuleTargetsResponse struct {
+	CmsResponse
+	ContactParameters struct {
+		ContactParameter []CmsContactParameter
+	}
+}
@@ -0,0 +1,153 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The Cloud Config API is only served in cn-shanghai for the mainland accounts and ap-southeast-1 for the others.
+const (
+	ConfigEndpointTemplate = "https://config.%s.aliyuncs.com"
+	ConfigApiVersion       = "2020-09-07"
+)
+
+const (
+	ConfigRuleActive = "ACTIVE"
+)
+
+// The aggregator is normal when its status is 1.
+const (
+	ConfigAggregatorNormal = "1"
+)
+
+var ConfigRuleSourceOwners = []string{"ALIYUN", "CUSTOM_FC"}
+var ConfigRuleTriggerTypes = []string{"ConfigurationItemChangeNotification", "ScheduledNotification"}
+var ConfigRuleMaximumExecutionFrequencies = []string{"One_Hour", "Three_Hours", "Six_Hours", "Twelve_Hours", "TwentyFour_Hours"}
+var ConfigAggregatorTypes = []string{"RD", "CUSTOM"}
+var ConfigDeliveryChannelTypes = []string{"OSS", "MNS", "SLS"}
+
+// ConfigRuleArgs is used by the CreateConfigRule and the UpdateConfigRule. The ResourceTypesScope is separated
+// by commas and the InputParameters is a JSON object.
+type ConfigRuleArgs struct {
+	ConfigRuleId              string
+	ConfigRuleName            string
+	Description               string
+	SourceOwner               string
+	SourceIdentifier          string
+	ConfigRuleTriggerTypes    string
+	MaximumExecutionFrequency string
+	ResourceTypesScope        string
+	RiskLevel                 int
+	InputParameters           string
+	ClientToken               string
+}
+
+type CreateConfigRuleResponse struct {
+	common.Response
+	ConfigRuleId string
+}
+
+type ConfigRule struct {
+	ConfigRuleId              string
+	ConfigRuleName            string
+	ConfigRuleArn             string
+	ConfigRuleState           string
+	ConfigRuleTriggerTypes    string
+	MaximumExecutionFrequency string
+	Description               string
+	RiskLevel                 int
+	InputParameters           map[string]interface{}
+	Source                    struct {
+		Owner      string
+		Identifier string
+	}
+	Scope struct {
+		ComplianceResourceTypes []string
+	}
+}
+
+type GetConfigRuleResponse struct {
+	common.Response
+	ConfigRule ConfigRule
+}
+
+type DeleteConfigRulesArgs struct {
+	ConfigRuleIds string
+}
+
+type ConfigAggregatorAccount struct {
+	AccountId   int64
+	AccountName string
+	AccountType string
+}
+
+// ConfigAggregatorArgs is used by the CreateAggregator and the UpdateAggregator. The AggregatorAccounts is a JSON array.
+type ConfigAggregatorArgs struct {
+	AggregatorId       string
+	AggregatorName     string
+	AggregatorType     string
+	Description        string
+	AggregatorAccounts string
+	ClientToken        string
+}
+
+type CreateConfigAggregatorResponse struct {
+	common.Response
+	AggregatorId string
+}
+
+type ConfigAggregator struct {
+	AggregatorId       string
+	AggregatorName     string
+	AggregatorType     string
+	AggregatorStatus   int
+	Description        string
+	AggregatorAccounts []ConfigAggregatorAccount
+}
+
+type GetConfigAggregatorResponse struct {
+	common.Response
+	Aggregator ConfigAggregator
+}
+
+type DeleteConfigAggregatorsArgs struct {
+	AggregatorIds string
+}
+
+// ConfigDeliveryChannelArgs is used by the CreateConfigDeliveryChannel, UpdateConfigDeliveryChannel,
+// GetConfigDeliveryChannel and DeleteConfigDeliveryChannel.
+type ConfigDeliveryChannelArgs struct {
+	DeliveryChannelId                   string
+	DeliveryChannelName                 string
+	DeliveryChannelType                 string
+	DeliveryChannelTargetArn            string
+	DeliveryChannelCondition            string
+	Description                         string
+	Status                              string
+	ConfigurationItemChangeNotification bool
+	ConfigurationSnapshot               bool
+	NonCompliantNotification            bool
+	ClientToken                         string
+}
+
+type CreateConfigDeliveryChannelResponse struct {
+	common.Response
+	DeliveryChannelId string
+}
+
+type ConfigDeliveryChannel struct {
+	DeliveryChannelId                   string
+	DeliveryChannelName                 string
+	DeliveryChannelType                 string
+	DeliveryChannelTargetArn            string
+	DeliveryChannelCondition            string
+	Description                         string
+	Status                              int
+	ConfigurationItemChangeNotification bool
+	ConfigurationSnapshot               bool
+	NonCompliantNotification            bool
+}
+
+type GetConfigDeliveryChannelResponse struct {
+	common.Response
+	DeliveryChannel ConfigDeliveryChannel
+}
@@ -0,0 +1,66 @@
+package alicloud
+
+const (
+	CrEndpointTemplate = "https://cr.%s.aliyuncs.com"
+	CrApiVersion       = "2016-06-07"
+)
+
+type CrVisibility string
+
+const (
+	CrVisibilityPublic  = CrVisibility("PUBLIC")
+	CrVisibilityPrivate = CrVisibility("PRIVATE")
+)
+
+type CrNamespaceArgs struct {
+	Namespace struct {
+		Namespace         string `json:"Namespace,omitempty"`
+		AutoCreate        *bool  `json:"AutoCreate,omitempty"`
+		DefaultVisibility string `json:"DefaultVisibility,omitempty"`
+	} `json:"Namespace"`
+}
+
+type CrNamespace struct {
+	Namespace         string `json:"namespace"`
+	NamespaceStatus   string `json:"namespaceStatus"`
+	AuthorizeType     string `json:"authorizeType"`
+	AutoCreate        bool   `json:"autoCreate"`
+	DefaultVisibility string `json:"defaultVisibility"`
+}
+
+type GetCrNamespaceResponse struct {
+	Data struct {
+		Namespace CrNamespace `json:"namespace"`
+	} `json:"data"`
+}
+
+type CrRepoArgs struct {
+	Repo struct {
+		RepoNamespace string `json:"RepoNamespace,omitempty"`
+		RepoName      string `json:"RepoName,omitempty"`
+		Summary       string `json:"Summary"`
+		Detail        string `json:"Detail,omitempty"`
+		RepoType      string `json:"RepoType"`
+	} `json:"Repo"`
+}
+
+type CrRepo struct {
+	RepoId         int    `json:"repoId"`
+	RepoNamespace  string `json:"repoNamespace"`
+	RepoName       string `json:"repoName"`
+	Summary        string `json:"summary"`
+	Detail         string `json:"detail"`
+	RepoType       string `json:"repoType"`
+	RepoStatus     string `json:"repoStatus"`
+	RepoDomainList struct {
+		Public   string `json:"public"`
+		Internal string `json:"internal"`
+		Vpc      string `json:"vpc"`
+	} `json:"repoDomainList"`
+}
+
+type GetCrRepoResponse struct {
+	Data struct {
+		Repo CrRepo `json:"repo"`
+	} `json:"data"`
+}
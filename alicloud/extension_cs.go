@@ -0,0 +1,170 @@
+package alicloud
+
+// The container service API is RESTful, so the following structs are sent and received as JSON
+// by the csconn.Invoke.
+
+type CsNodePoolState string
+
+const (
+	CsNodePoolActive   = CsNodePoolState("active")
+	CsNodePoolScaling  = CsNodePoolState("scaling")
+	CsNodePoolUpdating = CsNodePoolState("updating")
+	CsNodePoolRemoving = CsNodePoolState("removing")
+	CsNodePoolDeleting = CsNodePoolState("deleting")
+	CsNodePoolFailed   = CsNodePoolState("failed")
+)
+
+var CsTaintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
+type CsNodePoolInfo struct {
+	NodePoolId string `json:"nodepool_id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Type       string `json:"type,omitempty"`
+}
+
+type CsSpotPriceLimit struct {
+	InstanceType string `json:"instance_type"`
+	PriceLimit   string `json:"price_limit"`
+}
+
+type CsTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type CsNodePoolScalingGroup struct {
+	ScalingGroupId     string             `json:"scaling_group_id,omitempty"`
+	VSwitchIds         []string           `json:"vswitch_ids,omitempty"`
+	InstanceTypes      []string           `json:"instance_types,omitempty"`
+	InstanceChargeType string             `json:"instance_charge_type,omitempty"`
+	SecurityGroupId    string             `json:"security_group_id,omitempty"`
+	ImageId            string             `json:"image_id,omitempty"`
+	SystemDiskCategory string             `json:"system_disk_category,omitempty"`
+	SystemDiskSize     int                `json:"system_disk_size,omitempty"`
+	KeyPair            string             `json:"key_pair,omitempty"`
+	LoginPassword      string             `json:"login_password,omitempty"`
+	DesiredSize        *int               `json:"desired_size,omitempty"`
+	SpotStrategy       string             `json:"spot_strategy,omitempty"`
+	SpotPriceLimit     []CsSpotPriceLimit `json:"spot_price_limit,omitempty"`
+	Tags               []CsTag            `json:"tags,omitempty"`
+}
+
+type CsNodePoolAutoScaling struct {
+	Enable       bool   `json:"enable"`
+	MinInstances int    `json:"min_instances,omitempty"`
+	MaxInstances int    `json:"max_instances,omitempty"`
+	Type         string `json:"type,omitempty"`
+}
+
+type CsTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+type CsNodePoolKubernetesConfig struct {
+	Labels []CsTag   `json:"labels"`
+	Taints []CsTaint `json:"taints"`
+}
+
+type CsNodePoolUpgradeConfig struct {
+	AutoUpgrade    bool `json:"auto_upgrade"`
+	Surge          int  `json:"surge,omitempty"`
+	MaxUnavailable int  `json:"max_unavailable,omitempty"`
+}
+
+type CsNodePoolManagement struct {
+	Enable        bool                    `json:"enable"`
+	AutoRepair    bool                    `json:"auto_repair"`
+	UpgradeConfig CsNodePoolUpgradeConfig `json:"upgrade_config"`
+}
+
+type CsNodePoolArgs struct {
+	NodePoolInfo     *CsNodePoolInfo             `json:"nodepool_info,omitempty"`
+	ScalingGroup     *CsNodePoolScalingGroup     `json:"scaling_group,omitempty"`
+	AutoScaling      *CsNodePoolAutoScaling      `json:"auto_scaling,omitempty"`
+	KubernetesConfig *CsNodePoolKubernetesConfig `json:"kubernetes_config,omitempty"`
+	Management       *CsNodePoolManagement       `json:"management,omitempty"`
+}
+
+type CreateCsNodePoolResponse struct {
+	NodePoolId string `json:"nodepool_id"`
+}
+
+type CsNodePool struct {
+	NodePoolInfo     CsNodePoolInfo             `json:"nodepool_info"`
+	ScalingGroup     CsNodePoolScalingGroup     `json:"scaling_group"`
+	AutoScaling      CsNodePoolAutoScaling      `json:"auto_scaling"`
+	KubernetesConfig CsNodePoolKubernetesConfig `json:"kubernetes_config"`
+	Management       CsNodePoolManagement       `json:"management"`
+	Status           struct {
+		State      CsNodePoolState `json:"state"`
+		TotalNodes int             `json:"total_nodes"`
+	} `json:"status"`
+}
+
+type CsKubernetesCluster struct {
+	ClusterId   string `json:"cluster_id"`
+	Name        string `json:"name"`
+	ClusterType string `json:"cluster_type"`
+	State       string `json:"state"`
+	VpcId       string `json:"vpc_id"`
+	// MasterUrl is a JSON string which can be parsed into CsMasterUrl.
+	MasterUrl string `json:"master_url"`
+}
+
+type CsMasterUrl struct {
+	ApiServerEndpoint         string `json:"api_server_endpoint"`
+	IntranetApiServerEndpoint string `json:"intranet_api_server_endpoint"`
+}
+
+type CsKubernetesUserConfig struct {
+	Config string `json:"config"`
+}
+
+type CsKubernetesClusterCerts struct {
+	Ca   string `json:"ca"`
+	Cert string `json:"cert"`
+	Key  string `json:"key"`
+}
+
+type CsAddonTaskState string
+
+const (
+	CsAddonTaskRunning = CsAddonTaskState("running")
+	CsAddonTaskSuccess = CsAddonTaskState("success")
+	CsAddonTaskFailed  = CsAddonTaskState("failed")
+)
+
+type CsKubernetesAddonArgs struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Config  string `json:"config,omitempty"`
+}
+
+type CsKubernetesAddonUpgradeArgs struct {
+	ComponentName string `json:"component_name"`
+	Version       string `json:"version,omitempty"`
+	NextVersion   string `json:"next_version"`
+	Config        string `json:"config,omitempty"`
+}
+
+type CsKubernetesAddonConfigArgs struct {
+	Config string `json:"config"`
+}
+
+type CsKubernetesAddon struct {
+	ComponentName string `json:"component_name"`
+	Version       string `json:"version"`
+	NextVersion   string `json:"next_version"`
+	CanUpgrade    bool   `json:"can_upgrade"`
+	Required      bool   `json:"required"`
+	Config        string `json:"config"`
+}
+
+type CsKubernetesAddonUpgradeStatus struct {
+	Tasks struct {
+		State   CsAddonTaskState `json:"state"`
+		Message string           `json:"message"`
+	} `json:"tasks"`
+}
@@ -0,0 +1,109 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	DcdnEndpoint   = "https://dcdn.aliyuncs.com"
+	DcdnApiVersion = "2018-01-15"
+)
+
+var DcdnSourceTypes = []string{"ipaddr", "domain", "oss"}
+
+var DcdnScopes = []string{"domestic", "overseas", "global"}
+
+// DcdnSource is sent as a JSON string in the Sources parameter.
+type DcdnSource struct {
+	Content  string `json:"content"`
+	Type     string `json:"type"`
+	Port     int    `json:"port"`
+	Priority string `json:"priority"`
+	Weight   string `json:"weight"`
+}
+
+type AddDcdnDomainArgs struct {
+	DomainName string
+	Sources    string
+	Scope      string
+	CheckUrl   string
+}
+
+type UpdateDcdnDomainArgs struct {
+	DomainName string
+	Sources    string
+}
+
+type DcdnDomainArgs struct {
+	DomainName string
+}
+
+type DcdnDomainDetail struct {
+	DomainName   string
+	Cname        string
+	DomainStatus string
+	Scope        string
+	SSLProtocol  string
+	SSLPub       string
+	CertName     string
+	Description  string
+	Sources      struct {
+		Source []struct {
+			Content  string
+			Type     string
+			Port     int
+			Priority string
+			Weight   string
+		}
+	}
+}
+
+type DescribeDcdnDomainDetailResponse struct {
+	common.Response
+	DomainDetail DcdnDomainDetail
+}
+
+type SetDcdnDomainCertificateArgs struct {
+	DomainName  string
+	CertName    string
+	CertType    string
+	SSLProtocol string
+	SSLPub      string
+	SSLPri      string
+	ForceSet    string
+}
+
+type BatchSetDcdnDomainConfigsArgs struct {
+	DomainNames string
+	// Functions is a JSON string of []CdnFunction, which is the same as CDN.
+	Functions string
+}
+
+type DescribeDcdnDomainConfigsArgs struct {
+	DomainName    string
+	FunctionNames string
+}
+
+type DcdnDomainConfig struct {
+	FunctionName string
+	ConfigId     string
+	Status       string
+	FunctionArgs struct {
+		FunctionArg []struct {
+			ArgName  string
+			ArgValue string
+		}
+	}
+}
+
+type DescribeDcdnDomainConfigsResponse struct {
+	common.Response
+	DomainConfigs struct {
+		DomainConfig []DcdnDomainConfig
+	}
+}
+
+type DeleteDcdnSpecificConfigArgs struct {
+	DomainName string
+	ConfigId   string
+}
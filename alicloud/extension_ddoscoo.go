@@ -0,0 +1,138 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The Anti-DDoS Pro API is only served in cn-hangzhou for the mainland instances and ap-southeast-1
+// for the others, like the WAF one.
+const (
+	DdoscooEndpointTemplate = "https://ddoscoo.%s.aliyuncs.com"
+	DdoscooApiVersion       = "2020-01-01"
+)
+
+// The Anti-DDoS Pro instance is normal when its status is 1.
+const (
+	DdoscooInstanceNormal = "1"
+)
+
+var DdoscooPortProtocols = []string{"tcp", "udp"}
+var DdoscooProxyTypes = []string{"http", "https", "websocket", "websockets"}
+
+// The real servers of a domain resource are the origin IPs when the RsType is 0, or the origin domains when it is 1.
+var DdoscooRsTypes = []int{0, 1}
+
+type DdoscooInstance struct {
+	InstanceId string
+	Remark     string
+	Status     int
+	Edition    int
+	Enabled    int
+	ExpireTime int64
+	CreateTime int64
+}
+
+type DescribeDdoscooInstancesArgs struct {
+	InstanceIds []string
+	PageNumber  string
+	PageSize    string
+}
+
+type DescribeDdoscooInstancesResponse struct {
+	common.Response
+	TotalCount int
+	Instances  []DdoscooInstance
+}
+
+type DdoscooInstanceSpec struct {
+	InstanceId       string
+	BaseBandwidth    int
+	ElasticBandwidth int
+	BandwidthMbps    int
+	PortLimit        int
+	DomainLimit      int
+}
+
+type DescribeDdoscooInstanceSpecsArgs struct {
+	InstanceIds []string
+}
+
+type DescribeDdoscooInstanceSpecsResponse struct {
+	common.Response
+	InstanceSpecs []DdoscooInstanceSpec
+}
+
+type ModifyDdoscooInstanceRemarkArgs struct {
+	InstanceId string
+	Remark     string
+}
+
+// DdoscooWebRuleArgs is used by the CreateWebRule, ModifyWebRule and DeleteWebRule. The InstanceIds, Rules
+// and ProxyTypes are JSON arrays.
+type DdoscooWebRuleArgs struct {
+	Domain      string
+	RsType      int
+	InstanceIds string
+	Rules       string
+	ProxyTypes  string
+	RealServers []string
+}
+
+type DdoscooWebRuleProxyType struct {
+	ProxyType  string
+	ProxyPorts []int
+}
+
+type DdoscooWebRule struct {
+	Domain      string
+	RsType      int
+	Cname       string
+	InstanceIds []string
+	RealServers []struct {
+		RealServer string
+	}
+	ProxyTypes []DdoscooWebRuleProxyType
+}
+
+type DescribeDdoscooWebRulesArgs struct {
+	Domain     string
+	PageNumber int
+	PageSize   int
+}
+
+type DescribeDdoscooWebRulesResponse struct {
+	common.Response
+	TotalCount int
+	WebRules   []DdoscooWebRule
+}
+
+// DdoscooPortArgs is used by the CreatePort, ConfigPort and DeletePort.
+type DdoscooPortArgs struct {
+	InstanceId       string
+	FrontendPort     string
+	BackendPort      string
+	FrontendProtocol string
+	RealServers      []string
+}
+
+type DdoscooPort struct {
+	InstanceId       string
+	FrontendPort     int
+	BackendPort      int
+	FrontendProtocol string
+	RealServers      []string
+}
+
+type DescribeDdoscooPortArgs struct {
+	InstanceId       string
+	FrontendPort     int
+	FrontendProtocol string
+	PageNumber       string
+	PageSize         string
+}
+
+type DescribeDdoscooPortResponse struct {
+	common.Response
+	TotalCount   int
+	NetworkRules []DdoscooPort
+}
@@ -0,0 +1,167 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	DirectMailEndpoint         = "https://dm.aliyuncs.com"
+	DirectMailEndpointTemplate = "https://dm.%s.aliyuncs.com"
+	DirectMailApiVersion       = "2015-11-23"
+)
+
+// directMailEndpoint returns the endpoint of the region, as the DirectMail of cn-hangzhou is served by the
+// endpoint without the region.
+func directMailEndpoint(region common.Region) string {
+	if region == "" || region == common.Hangzhou {
+		return DirectMailEndpoint
+	}
+	return fmt.Sprintf(DirectMailEndpointTemplate, region)
+}
+
+// The status of the domains, mail addresses and receiver lists, which are "0" when they are verified or in use.
+const (
+	DirectMailStatusNormal = "0"
+)
+
+// The send types of the mail addresses.
+const (
+	DirectMailSendTypeBatch   = "batch"
+	DirectMailSendTypeTrigger = "trigger"
+)
+
+// The ids and the status are json.Number, as they are numbers in some responses and strings in the others.
+
+type CreateDirectMailDomainArgs struct {
+	DomainName string
+}
+
+type CreateDirectMailDomainResponse struct {
+	common.Response
+	DomainId json.Number
+}
+
+type DirectMailDomainArgs struct {
+	DomainId string
+}
+
+type DirectMailPageArgs struct {
+	KeyWord  string
+	PageNo   int
+	PageSize int
+}
+
+type DirectMailDomain struct {
+	DomainId        json.Number
+	DomainName      string
+	DomainRecord    string
+	DomainStatus    json.Number
+	CnameAuthStatus json.Number
+	MxAuthStatus    json.Number
+	SpfAuthStatus   json.Number
+	IcpStatus       json.Number
+	CreateTime      string
+}
+
+type QueryDirectMailDomainsResponse struct {
+	common.Response
+	TotalCount int
+	Data       struct {
+		Domain []DirectMailDomain
+	}
+}
+
+// DirectMailDomainRecords are the DNS records to verify the domain.
+type DirectMailDomainRecords struct {
+	common.Response
+	HostRecord     string
+	SpfRecord      string
+	MxRecord       string
+	DkimRR         string
+	DkimPublicKey  string
+	DkimAuthStatus json.Number
+	DomainType     string
+}
+
+type CreateDirectMailAddressArgs struct {
+	AccountName  string
+	Sendtype     string
+	ReplyAddress string
+}
+
+type CreateDirectMailAddressResponse struct {
+	common.Response
+	MailAddressId json.Number
+}
+
+type ModifyDirectMailAddressArgs struct {
+	MailAddressId string
+	ReplyAddress  string
+	Password      string
+}
+
+type DirectMailAddressArgs struct {
+	MailAddressId string
+}
+
+type DirectMailAddress struct {
+	MailAddressId json.Number
+	AccountName   string
+	AccountStatus json.Number
+	ReplyAddress  string
+	ReplyStatus   json.Number
+	Sendtype      string
+	CreateTime    string
+}
+
+type QueryDirectMailAddressesResponse struct {
+	common.Response
+	TotalCount int
+	Data       struct {
+		MailAddress []DirectMailAddress
+	}
+}
+
+type CreateDirectMailReceiversArgs struct {
+	ReceiversAlias string
+	ReceiversName  string
+	Desc           string
+}
+
+type CreateDirectMailReceiversResponse struct {
+	common.Response
+	ReceiverId json.Number
+}
+
+type DirectMailReceiversArgs struct {
+	ReceiverId string
+}
+
+// The receiver lists are paged by the NextStart instead of the page number.
+type QueryDirectMailReceiversArgs struct {
+	KeyWord   string
+	PageSize  int
+	NextStart string
+}
+
+type DirectMailReceivers struct {
+	ReceiverId      json.Number
+	ReceiversName   string
+	ReceiversAlias  string
+	Desc            string
+	Count           json.Number
+	ReceiversStatus json.Number
+	CreateTime      string
+}
+
+type QueryDirectMailReceiversResponse struct {
+	common.Response
+	TotalCount int
+	NextStart  string
+	Data       struct {
+		Receiver []DirectMailReceivers
+	}
+}
@@ -0,0 +1,199 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The GTM instances of the mainland China accounts and the international ones are different products of the
+// business support system.
+const (
+	DnsGtmProductCode     = "dns"
+	DnsGtmProductType     = "dns_gtm_public_cn"
+	DnsGtmProductTypeIntl = "dns_gtm_public_intl"
+)
+
+const (
+	DnsGtmStrategyModeGeo     = "GEO"
+	DnsGtmStrategyModeLatency = "LATENCY"
+)
+
+const (
+	DnsGtmAddrPoolIpv4   = "IPV4"
+	DnsGtmAddrPoolIpv6   = "IPV6"
+	DnsGtmAddrPoolDomain = "DOMAIN"
+)
+
+// The addresses of the ALL_RR address pools are all returned, and the ones of the RATIO address pools are returned
+// by their weights.
+const (
+	DnsGtmLbaAllRr = "ALL_RR"
+	DnsGtmLbaRatio = "RATIO"
+)
+
+type DnsGtmInstanceArgs struct {
+	InstanceId   string
+	StrategyMode string
+}
+
+// UpdateDnsGtmInstanceGlobalConfigArgs is used by the UpdateDnsGtmInstanceGlobalConfig, and the AlertGroup is the
+// JSON array of the names of the contact groups.
+type UpdateDnsGtmInstanceGlobalConfigArgs struct {
+	InstanceId           string
+	InstanceName         string
+	Ttl                  int
+	CnameType            string
+	PublicCnameMode      string
+	PublicUserDomainName string
+	PublicZoneName       string
+	PublicRr             string
+	AlertGroup           string
+}
+
+type DnsGtmInstance struct {
+	common.Response
+	InstanceId  string
+	VersionCode string
+	ExpireTime  string
+	CreateTime  string
+	Config      struct {
+		InstanceName         string
+		Ttl                  int
+		CnameType            string
+		PublicCnameMode      string
+		PublicUserDomainName string
+		PublicZoneName       string
+		PublicRr             string
+		AlertGroup           string
+		StrategyMode         string
+	}
+}
+
+type DnsGtmAddr struct {
+	Addr          string
+	AttributeInfo string
+	LbaWeight     int
+	Mode          string
+	Remark        string
+}
+
+// DnsGtmAddressPoolArgs is used by the AddDnsGtmAddressPool and UpdateDnsGtmAddressPool, and the AddrPoolId is
+// only set for the UpdateDnsGtmAddressPool.
+type DnsGtmAddressPoolArgs struct {
+	InstanceId    string
+	AddrPoolId    string
+	Name          string
+	Type          string
+	LbaStrategy   string
+	MonitorStatus string
+	Addr          []DnsGtmAddr
+}
+
+type AddDnsGtmAddressPoolResponse struct {
+	common.Response
+	AddrPoolId string
+}
+
+type DnsGtmAddrPoolIdArgs struct {
+	AddrPoolId string
+}
+
+type DnsGtmAddressPool struct {
+	common.Response
+	AddrPoolId    string
+	Name          string
+	Type          string
+	LbaStrategy   string
+	MonitorStatus string
+	Addrs         struct {
+		Addr []DnsGtmAddr
+	}
+}
+
+type DnsGtmStrategyAddrPool struct {
+	Id        string
+	LbaWeight int
+}
+
+// DnsGtmAccessStrategyArgs is used by the AddDnsGtmAccessStrategy and UpdateDnsGtmAccessStrategy, and the Lines is
+// the JSON array of the line codes, like ["default"].
+type DnsGtmAccessStrategyArgs struct {
+	InstanceId                  string
+	StrategyId                  string
+	StrategyName                string
+	StrategyMode                string
+	Lines                       string
+	AccessMode                  string
+	DefaultAddrPoolType         string
+	DefaultLbaStrategy          string
+	DefaultMinAvailableAddrNum  int
+	DefaultAddrPool             []DnsGtmStrategyAddrPool
+	FailoverAddrPoolType        string
+	FailoverLbaStrategy         string
+	FailoverMinAvailableAddrNum int
+	FailoverAddrPool            []DnsGtmStrategyAddrPool
+}
+
+type AddDnsGtmAccessStrategyResponse struct {
+	common.Response
+	StrategyId string
+}
+
+type DnsGtmStrategyIdArgs struct {
+	StrategyId string
+}
+
+type DnsGtmAccessStrategy struct {
+	common.Response
+	StrategyId                  string
+	StrategyName                string
+	StrategyMode                string
+	InstanceId                  string
+	AccessMode                  string
+	EffectiveAddrPoolGroupType  string
+	DefaultAddrPoolType         string
+	DefaultLbaStrategy          string
+	DefaultMinAvailableAddrNum  int
+	FailoverAddrPoolType        string
+	FailoverLbaStrategy         string
+	FailoverMinAvailableAddrNum int
+	Lines                       struct {
+		Line []struct {
+			LineCode string
+		}
+	}
+	DefaultAddrPools struct {
+		DefaultAddrPool []DnsGtmStrategyAddrPool
+	}
+	FailoverAddrPools struct {
+		FailoverAddrPool []DnsGtmStrategyAddrPool
+	}
+}
+
+// The weighted round robin of the records is enabled for a sub domain, like www.example.com, and the records of
+// the sub domain are returned by their weights.
+type SetDnsSlbStatusArgs struct {
+	SubDomain string
+	Open      bool
+}
+
+type UpdateDnsSlbWeightArgs struct {
+	RecordId string
+	Weight   int
+}
+
+type DescribeSubDomainRecordsArgs struct {
+	SubDomain  string
+	PageNumber int
+	PageSize   int
+}
+
+type DescribeSubDomainRecordsResponse struct {
+	common.Response
+	TotalCount    int
+	DomainRecords struct {
+		Record []struct {
+			RecordId string
+			Weight   int
+		}
+	}
+}
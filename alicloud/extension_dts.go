@@ -0,0 +1,176 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	DtsEndpointTemplate = "https://dts.%s.aliyuncs.com"
+	DtsApiVersion       = "2020-01-01"
+)
+
+const (
+	DtsJobTypeMigration = "MIGRATION"
+	DtsJobTypeSync      = "SYNC"
+)
+
+const (
+	DtsPayTypePostPaid = "PostPaid"
+	DtsPayTypePrePaid  = "PrePaid"
+)
+
+// The statuses of the DTS jobs, and a job is NotConfigured before it is configured or after it is reset.
+const (
+	DtsJobNotConfigured    = "NotConfigured"
+	DtsJobPrecheckFailed   = "PrecheckFailed"
+	DtsJobInitializeFailed = "InitializeFailed"
+	DtsJobFailed           = "Failed"
+	DtsJobMigrating        = "Migrating"
+	DtsJobSynchronizing    = "Synchronizing"
+	DtsJobSuspending       = "Suspending"
+	DtsJobFinished         = "Finished"
+)
+
+var DtsJobTypes = []string{DtsJobTypeMigration, DtsJobTypeSync}
+var DtsPayTypes = []string{DtsPayTypePostPaid, DtsPayTypePrePaid}
+var DtsInstanceClasses = []string{"micro", "small", "medium", "large", "xlarge", "2xlarge"}
+var DtsEndpointInstanceTypes = []string{"RDS", "ECS", "LocalInstance", "Express", "CEN", "dg", "POLARDB", "REDIS", "MONGODB"}
+var DtsSynchronizationDirections = []string{"Forward", "Reverse"}
+
+type CreateDtsInstanceArgs struct {
+	RegionId                      common.Region
+	Type                          string
+	SourceRegion                  string
+	DestinationRegion             string
+	SourceEndpointEngineName      string
+	DestinationEndpointEngineName string
+	InstanceClass                 string
+	PayType                       string
+	Period                        string
+	UsedTime                      int
+	SyncArchitecture              string
+	AutoPay                       bool
+	Quantity                      int
+}
+
+type CreateDtsInstanceResponse struct {
+	common.Response
+	Success    bool
+	InstanceId string
+	JobId      string
+}
+
+// DtsJobArgs is used by the DescribeDtsJobDetail, StartDtsJob, SuspendDtsJob, ResetDtsJob and DeleteDtsJob.
+type DtsJobArgs struct {
+	RegionId                 common.Region
+	DtsJobId                 string
+	DtsInstanceId            string
+	SynchronizationDirection string
+}
+
+// DescribeDtsJobDetailArgs is like the DtsJobArgs but the instance id is named DtsInstanceID by the API.
+type DescribeDtsJobDetailArgs struct {
+	RegionId                 common.Region
+	DtsJobId                 string
+	DtsInstanceID            string
+	SynchronizationDirection string
+}
+
+type DtsEndpoint struct {
+	Region       string
+	InstanceType string
+	EngineName   string
+	InstanceID   string
+	Ip           string
+	Port         string
+	DatabaseName string
+	UserName     string
+}
+
+type DtsJobDetail struct {
+	DtsJobId        string
+	DtsInstanceID   string
+	DtsJobName      string
+	DtsJobClass     string
+	DtsJobDirection string
+	PayType         string
+	Status          string
+	DbObject        string
+	ErrorMessage    string
+	MigrationMode   struct {
+		StructureInitialization bool
+		DataInitialization      bool
+		DataSynchronization     bool
+	}
+	SourceEndpoint      DtsEndpoint
+	DestinationEndpoint DtsEndpoint
+}
+
+type DescribeDtsJobDetailResponse struct {
+	common.Response
+	Success bool
+	DtsJobDetail
+}
+
+// ConfigureDtsJobArgs is used by the ConfigureDtsJob to configure the endpoints and the objects of the migration
+// and the synchronization jobs. The DbList is a JSON object of the databases and the tables to migrate or sync.
+type ConfigureDtsJobArgs struct {
+	RegionId                        common.Region
+	DtsInstanceId                   string
+	DtsJobId                        string
+	DtsJobName                      string
+	JobType                         string
+	SourceEndpointInstanceType      string
+	SourceEndpointEngineName        string
+	SourceEndpointRegion            string
+	SourceEndpointInstanceID        string
+	SourceEndpointIP                string
+	SourceEndpointPort              string
+	SourceEndpointDatabaseName      string
+	SourceEndpointUserName          string
+	SourceEndpointPassword          string
+	DestinationEndpointInstanceType string
+	DestinationEndpointEngineName   string
+	DestinationEndpointRegion       string
+	DestinationEndpointInstanceID   string
+	DestinationEndpointIP           string
+	DestinationEndpointPort         string
+	DestinationEndpointDataBaseName string
+	DestinationEndpointUserName     string
+	DestinationEndpointPassword     string
+	StructureInitialization         bool
+	DataInitialization              bool
+	DataSynchronization             bool
+	SynchronizationDirection        string
+	DbList                          string
+}
+
+type ConfigureDtsJobResponse struct {
+	common.Response
+	Success       bool
+	DtsJobId      string
+	DtsInstanceId string
+	Status        string
+}
+
+type ModifyDtsJobNameArgs struct {
+	RegionId   common.Region
+	DtsJobId   string
+	DtsJobName string
+}
+
+// ModifyDtsJobArgs is used by the ModifyDtsJob to change the synchronization objects of a running job.
+type ModifyDtsJobArgs struct {
+	RegionId                 common.Region
+	DtsInstanceId            string
+	SynchronizationDirection string
+	DbList                   string
+}
+
+// TransferDtsInstanceClassArgs is used by the TransferInstanceClass to upgrade or downgrade the instance class.
+type TransferDtsInstanceClassArgs struct {
+	RegionId      common.Region
+	DtsJobId      string
+	InstanceClass string
+	OrderType     string
+}
@@ -1,6 +1,9 @@
 package alicloud
 
-import "github.com/denverdino/aliyungo/ecs"
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/denverdino/aliyungo/ecs"
+)
 
 type GroupRuleDirection string
 
@@ -50,7 +53,291 @@ var HalfIoOptimizedFamily = map[string]string{"ecs.s2": "", "ecs.s3": "", "ecs.m
 var OutdatedDiskCategory = map[ecs.DiskCategory]ecs.DiskCategory{
 	ecs.DiskCategoryCloud: ecs.DiskCategoryCloud}
 
+// DiskCategoryCloudESSD is the enhanced SSD, whose performance is set by its performance level.
+const DiskCategoryCloudESSD = ecs.DiskCategory("cloud_essd")
+
 var SupportedDiskCategory = map[ecs.DiskCategory]ecs.DiskCategory{
 	ecs.DiskCategoryCloudSSD:        ecs.DiskCategoryCloudSSD,
 	ecs.DiskCategoryCloudEfficiency: ecs.DiskCategoryCloudEfficiency,
-	ecs.DiskCategoryCloud:           ecs.DiskCategoryCloud}
+	ecs.DiskCategoryCloud:           ecs.DiskCategoryCloud,
+	DiskCategoryCloudESSD:           DiskCategoryCloudESSD}
+
+var DiskPerformanceLevels = []string{"PL0", "PL1", "PL2", "PL3"}
+
+const (
+	DiskResizeOnline  = "online"
+	DiskResizeOffline = "offline"
+)
+
+// RegionEndpointType is a region returned by the DescribeRegions with its endpoint, which is not in the
+// RegionType of the SDK.
+type RegionEndpointType struct {
+	RegionId       common.Region
+	LocalName      string
+	RegionEndpoint string
+}
+
+type DescribeRegionEndpointsArgs struct {
+}
+
+type DescribeRegionEndpointsResponse struct {
+	common.Response
+	Regions struct {
+		Region []RegionEndpointType
+	}
+}
+
+const (
+	EcsCommandRunShellScript      = "RunShellScript"
+	EcsCommandRunBatScript        = "RunBatScript"
+	EcsCommandRunPowerShellScript = "RunPowerShellScript"
+)
+
+var EcsCommandTypes = []string{EcsCommandRunShellScript, EcsCommandRunBatScript, EcsCommandRunPowerShellScript}
+
+// The statuses of the Cloud Assistant invocations, and the timed invocations are Scheduled between the runs.
+const (
+	EcsInvocationPending       = "Pending"
+	EcsInvocationScheduled     = "Scheduled"
+	EcsInvocationRunning       = "Running"
+	EcsInvocationSuccess       = "Success"
+	EcsInvocationFailed        = "Failed"
+	EcsInvocationPartialFailed = "PartialFailed"
+	EcsInvocationStopping      = "Stopping"
+	EcsInvocationStopped       = "Stopped"
+)
+
+// CreateEcsDiskArgs covers the PerformanceLevel of the cloud_essd disks which is missing in ecs.CreateDiskArgs.
+type CreateEcsDiskArgs struct {
+	RegionId         common.Region
+	ZoneId           string
+	DiskName         string
+	Description      string
+	DiskCategory     ecs.DiskCategory
+	Size             int
+	SnapshotId       string
+	PerformanceLevel string
+	ClientToken      string
+}
+
+type CreateEcsDiskResponse struct {
+	common.Response
+	DiskId string
+}
+
+// DescribeEcsDisksArgs is used by the DescribeDisks, and the DiskIds is a JSON array.
+type DescribeEcsDisksArgs struct {
+	RegionId common.Region
+	DiskIds  string
+}
+
+type EcsDisk struct {
+	DiskId           string
+	ZoneId           string
+	DiskName         string
+	Description      string
+	Category         ecs.DiskCategory
+	Size             int
+	SourceSnapshotId string
+	PerformanceLevel string
+	Status           ecs.DiskStatus
+}
+
+type DescribeEcsDisksResponse struct {
+	common.Response
+	Disks struct {
+		Disk []EcsDisk
+	}
+}
+
+// ResizeEcsDiskArgs is used by the ResizeDisk, and the Type is online to resize the disk attached to a running
+// instance without restarting it.
+type ResizeEcsDiskArgs struct {
+	DiskId  string
+	NewSize int
+	Type    string
+}
+
+type ModifyEcsDiskSpecArgs struct {
+	DiskId           string
+	PerformanceLevel string
+}
+
+// ModifyInstanceNetworkSpecArgs is used by the ModifyInstanceNetworkSpec. The bandwidths are strings as 0 is a
+// valid bandwidth out, and the NetworkChargeType is left empty when the charge type is not changed.
+type ModifyInstanceNetworkSpecArgs struct {
+	InstanceId              string
+	InternetMaxBandwidthOut string
+	InternetMaxBandwidthIn  string
+	NetworkChargeType       string
+	AutoPay                 bool
+}
+
+const (
+	EcsDeploymentSetAvailability = "Availability"
+	EcsDeploymentSetLowLatency   = "LowLatency"
+)
+
+// EcsDeploymentSetArgs is used by the CreateDeploymentSet, ModifyDeploymentSetAttribute and DeleteDeploymentSet.
+type EcsDeploymentSetArgs struct {
+	RegionId          common.Region
+	DeploymentSetId   string
+	DeploymentSetName string
+	Description       string
+	Strategy          string
+}
+
+type CreateEcsDeploymentSetResponse struct {
+	common.Response
+	DeploymentSetId string
+}
+
+// DescribeEcsDeploymentSetsArgs is used by the DescribeDeploymentSets, and the DeploymentSetIds is a JSON array.
+type DescribeEcsDeploymentSetsArgs struct {
+	RegionId         common.Region
+	DeploymentSetIds string
+}
+
+type EcsDeploymentSet struct {
+	DeploymentSetId          string
+	DeploymentSetName        string
+	DeploymentSetDescription string
+	DeploymentStrategy       string
+	InstanceAmount           int
+	InstanceIds              struct {
+		InstanceId []string
+	}
+}
+
+type DescribeEcsDeploymentSetsResponse struct {
+	common.Response
+	DeploymentSets struct {
+		DeploymentSet []EcsDeploymentSet
+	}
+}
+
+// ModifyInstanceDeploymentArgs is used by the ModifyInstanceDeployment to move a stopped instance into a
+// deployment set.
+type ModifyInstanceDeploymentArgs struct {
+	RegionId        common.Region
+	InstanceId      string
+	DeploymentSetId string
+}
+
+// The following args and response only describe the deployment set of the instances, which is not in the
+// responses of the SDK.
+
+type DescribeInstanceDeploymentSetArgs struct {
+	RegionId    common.Region
+	InstanceIds string
+}
+
+type DescribeInstanceDeploymentSetResponse struct {
+	common.Response
+	Instances struct {
+		Instance []struct {
+			InstanceId      string
+			DeploymentSetId string
+		}
+	}
+}
+
+// EcsCommandArgs is used by the CreateCommand, ModifyCommand, DescribeCommands and DeleteCommand of the Cloud
+// Assistant. The CommandContent is encoded in base64.
+type EcsCommandArgs struct {
+	RegionId        common.Region
+	CommandId       string
+	Name            string
+	Description     string
+	Type            string
+	CommandContent  string
+	WorkingDir      string
+	Timeout         int
+	EnableParameter bool
+}
+
+type CreateEcsCommandResponse struct {
+	common.Response
+	CommandId string
+}
+
+type EcsCommand struct {
+	CommandId       string
+	Name            string
+	Description     string
+	Type            string
+	CommandContent  string
+	WorkingDir      string
+	Timeout         int
+	EnableParameter bool
+	ParameterNames  struct {
+		ParameterName []string
+	}
+}
+
+type DescribeEcsCommandsResponse struct {
+	common.Response
+	Commands struct {
+		Command []EcsCommand
+	}
+}
+
+// InvokeEcsCommandArgs is used by the InvokeCommand, and the Parameters is a JSON object of the values of the
+// custom parameters of the command.
+type InvokeEcsCommandArgs struct {
+	RegionId   common.Region
+	CommandId  string
+	InstanceId []string
+	Timed      bool
+	Frequency  string
+	Parameters string
+}
+
+type InvokeEcsCommandResponse struct {
+	common.Response
+	InvokeId string
+}
+
+// EcsInvocationArgs is used by the DescribeInvocations, DescribeInvocationResults and StopInvocation.
+type EcsInvocationArgs struct {
+	RegionId   common.Region
+	InvokeId   string
+	InstanceId []string
+	PageNumber int
+	PageSize   int
+}
+
+type EcsInvocation struct {
+	InvokeId         string
+	CommandId        string
+	InvocationStatus string
+	Timed            bool
+	Frequency        string
+	Parameters       string
+}
+
+type DescribeEcsInvocationsResponse struct {
+	common.Response
+	Invocations struct {
+		Invocation []EcsInvocation
+	}
+}
+
+// EcsInvocationResult is the result of an invocation on an instance, and the Output is encoded in base64.
+type EcsInvocationResult struct {
+	InstanceId       string
+	InvocationStatus string
+	ExitCode         int
+	Output           string
+	ErrorInfo        string
+}
+
+type DescribeEcsInvocationResultsResponse struct {
+	common.Response
+	Invocation struct {
+		TotalCount        int
+		InvocationResults struct {
+			InvocationResult []EcsInvocationResult
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	EmrEndpointTemplate = "https://emr.%s.aliyuncs.com"
+	EmrApiVersion       = "2016-04-08"
+)
+
+// The statuses of the EMR clusters, and the cluster is IDLE when there are no running jobs.
+const (
+	EmrClusterCreating     = "CREATING"
+	EmrClusterCreateFailed = "CREATE_FAILED"
+	EmrClusterIdle         = "IDLE"
+	EmrClusterRunning      = "RUNNING"
+	EmrClusterResizing     = "RESIZING"
+	EmrClusterReleasing    = "RELEASING"
+	EmrClusterReleased     = "RELEASED"
+	EmrClusterAbnormal     = "ABNORMAL"
+)
+
+const (
+	EmrHostGroupMaster  = "MASTER"
+	EmrHostGroupCore    = "CORE"
+	EmrHostGroupTask    = "TASK"
+	EmrHostGroupGateway = "GATEWAY"
+)
+
+var EmrClusterTypes = []string{"HADOOP", "KAFKA", "ZOOKEEPER", "DRUID", "FLINK", "DATA_SCIENCE", "CLICKHOUSE"}
+var EmrHostGroupTypes = []string{EmrHostGroupMaster, EmrHostGroupCore, EmrHostGroupTask, EmrHostGroupGateway}
+var EmrDiskTypes = []string{"cloud", "cloud_efficiency", "cloud_ssd", "cloud_essd", "local_disk"}
+
+// EmrHostGroup is a host group of the CreateClusterV2 and the ResizeClusterV2. The NodeCount of the resized
+// host groups is the number of the nodes to add, and the new host groups have no HostGroupId.
+type EmrHostGroup struct {
+	HostGroupId     string
+	HostGroupName   string
+	HostGroupType   string
+	NodeCount       int
+	InstanceType    string
+	DiskType        string
+	DiskCapacity    int
+	DiskCount       int
+	SysDiskType     string
+	SysDiskCapacity int
+	ChargeType      string
+	Period          int
+	VSwitchId       string
+}
+
+type EmrBootstrapAction struct {
+	Name string
+	Path string
+	Arg  string
+}
+
+type CreateEmrClusterArgs struct {
+	RegionId               common.Region
+	Name                   string
+	ZoneId                 string
+	ClusterType            string
+	EmrVer                 string
+	ChargeType             string
+	Period                 int
+	NetType                string
+	VpcId                  string
+	VSwitchId              string
+	SecurityGroupId        string
+	IsOpenPublicIp         bool
+	SshEnable              bool
+	MasterPwd              string
+	HighAvailabilityEnable bool
+	UseLocalMetaDb         bool
+	DepositType            string
+	MachineType            string
+	UserDefinedEmrEcsRole  string
+	HostGroup              []EmrHostGroup
+	BootstrapAction        []EmrBootstrapAction
+}
+
+type CreateEmrClusterResponse struct {
+	common.Response
+	ClusterId string
+}
+
+type ResizeEmrClusterArgs struct {
+	RegionId       common.Region
+	ClusterId      string
+	VswitchId      string
+	IsOpenPublicIp bool
+	HostGroup      []EmrHostGroup
+}
+
+// EmrClusterArgs is used by the DescribeClusterV2, ModifyClusterName and ReleaseCluster.
+type EmrClusterArgs struct {
+	RegionId     common.Region
+	Id           string
+	Name         string
+	ForceRelease bool
+}
+
+type EmrCluster struct {
+	Id                     string
+	Name                   string
+	Status                 string
+	ZoneId                 string
+	ChargeType             string
+	Period                 int
+	NetType                string
+	VpcId                  string
+	VSwitchId              string
+	SecurityGroupId        string
+	HighAvailabilityEnable bool
+	LocalMetaDb            bool
+	DepositType            string
+	UserDefinedEmrEcsRole  string
+	SoftwareInfo           struct {
+		EmrVer      string
+		ClusterType string
+	}
+	BootstrapActionList struct {
+		BootstrapAction []EmrBootstrapAction
+	}
+}
+
+type DescribeEmrClusterResponse struct {
+	common.Response
+	ClusterInfo EmrCluster
+}
+
+type ListEmrClusterHostGroupArgs struct {
+	RegionId   common.Region
+	ClusterId  string
+	PageNumber int
+	PageSize   int
+}
+
+type EmrHostGroupInfo struct {
+	HostGroupId        string
+	HostGroupName      string
+	HostGroupType      string
+	NodeCount          int
+	InstanceType       string
+	DataDiskCategory   string
+	DataDiskSize       int
+	DataDiskCount      int
+	SystemDiskCategory string
+	SystemDiskSize     int
+}
+
+type ListEmrClusterHostGroupResponse struct {
+	common.Response
+	TotalCount    int
+	HostGroupList struct {
+		HostGroup []EmrHostGroupInfo
+	}
+}
@@ -0,0 +1,406 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/denverdino/aliyungo/ess"
+)
+
+type EssSpotStrategy string
+
+const (
+	EssNoSpot             = EssSpotStrategy("NoSpot")
+	EssSpotWithPriceLimit = EssSpotStrategy("SpotWithPriceLimit")
+	EssSpotAsPriceGo      = EssSpotStrategy("SpotAsPriceGo")
+)
+
+type EssSpotPriceLimit struct {
+	InstanceType string
+	PriceLimit   float64
+}
+
+type EssTag struct {
+	Key   string
+	Value string
+}
+
+// CreateEssScalingConfigurationArgs covers the parameters which are missing in ess.CreateScalingConfigurationArgs,
+// like multiple instance types, user data, tags and spot options.
+type CreateEssScalingConfigurationArgs struct {
+	ScalingGroupId           string
+	ImageId                  string
+	InstanceType             string
+	InstanceTypes            []string
+	IoOptimized              ecs.IoOptimized
+	SecurityGroupId          string
+	ScalingConfigurationName string
+	InternetChargeType       common.InternetChargeType
+	InternetMaxBandwidthIn   int
+	InternetMaxBandwidthOut  int
+	SystemDisk_Category      common.UnderlineString
+	DataDisk                 []ess.DataDiskType
+	UserData                 string
+	KeyPairName              string
+	RamRoleName              string
+	InstanceName             string
+	Tags                     string
+	SpotStrategy             EssSpotStrategy
+	SpotPriceLimit           []EssSpotPriceLimit
+	DeploymentSetId          string
+}
+
+type CreateEssScalingConfigurationResponse struct {
+	common.Response
+	ScalingConfigurationId string
+}
+
+type DescribeEssScalingConfigurationsArgs struct {
+	RegionId               common.Region
+	ScalingGroupId         string
+	ScalingConfigurationId []string
+	common.Pagination
+}
+
+type EssScalingConfiguration struct {
+	ScalingConfigurationId   string
+	ScalingConfigurationName string
+	ScalingGroupId           string
+	LifecycleState           ess.LifecycleState
+	ImageId                  string
+	InstanceType             string
+	InstanceTypes            struct {
+		InstanceType []string
+	}
+	SecurityGroupId         string
+	InternetChargeType      string
+	InternetMaxBandwidthIn  int
+	InternetMaxBandwidthOut int
+	SystemDiskCategory      string
+	DataDisks               struct {
+		DataDisk []ess.DataDiskItemType
+	}
+	UserData     string
+	KeyPairName  string
+	RamRoleName  string
+	InstanceName string
+	Tags         struct {
+		Tag []EssTag
+	}
+	SpotStrategy   EssSpotStrategy
+	SpotPriceLimit struct {
+		SpotPriceModel []EssSpotPriceLimit
+	}
+	DeploymentSetId string
+}
+
+type DescribeEssScalingConfigurationsResponse struct {
+	common.Response
+	common.PaginationResult
+	ScalingConfigurations struct {
+		ScalingConfiguration []EssScalingConfiguration
+	}
+}
+
+type EssScalingRuleType string
+
+const (
+	EssSimpleScalingRule         = EssScalingRuleType("SimpleScalingRule")
+	EssTargetTrackingScalingRule = EssScalingRuleType("TargetTrackingScalingRule")
+	EssStepScalingRule           = EssScalingRuleType("StepScalingRule")
+)
+
+var EssTargetTrackingMetricNames = []string{"CpuUtilization", "ClassicInternetRx", "ClassicInternetTx",
+	"VpcInternetRx", "VpcInternetTx", "IntranetRx", "IntranetTx"}
+
+// EssStepAdjustmentArgs uses strings as the bounds, because an empty bound means negative or positive infinity.
+type EssStepAdjustmentArgs struct {
+	MetricIntervalLowerBound string
+	MetricIntervalUpperBound string
+	ScalingAdjustment        int
+}
+
+type CreateEssScalingRuleArgs struct {
+	RegionId                common.Region
+	ScalingGroupId          string
+	ScalingRuleName         string
+	ScalingRuleType         EssScalingRuleType
+	Cooldown                int
+	AdjustmentType          ess.AdjustmentType
+	AdjustmentValue         int
+	MetricName              string
+	TargetValue             float64
+	DisableScaleIn          string
+	EstimatedInstanceWarmup int
+	StepAdjustment          []EssStepAdjustmentArgs
+}
+
+type CreateEssScalingRuleResponse struct {
+	common.Response
+	ScalingRuleId  string
+	ScalingRuleAri string
+}
+
+type ModifyEssScalingRuleArgs struct {
+	RegionId                common.Region
+	ScalingRuleId           string
+	ScalingRuleName         string
+	Cooldown                int
+	AdjustmentType          ess.AdjustmentType
+	AdjustmentValue         int
+	MetricName              string
+	TargetValue             float64
+	DisableScaleIn          string
+	EstimatedInstanceWarmup int
+	StepAdjustment          []EssStepAdjustmentArgs
+}
+
+type DescribeEssScalingRulesArgs struct {
+	RegionId       common.Region
+	ScalingGroupId string
+	ScalingRuleId  []string
+	ScalingRuleAri []string
+	common.Pagination
+}
+
+type EssStepAdjustment struct {
+	MetricIntervalLowerBound *float64
+	MetricIntervalUpperBound *float64
+	ScalingAdjustment        int
+}
+
+type EssScalingRule struct {
+	ScalingRuleId           string
+	ScalingGroupId          string
+	ScalingRuleName         string
+	ScalingRuleAri          string
+	ScalingRuleType         EssScalingRuleType
+	Cooldown                int
+	AdjustmentType          string
+	AdjustmentValue         int
+	MetricName              string
+	TargetValue             float64
+	DisableScaleIn          bool
+	EstimatedInstanceWarmup int
+	StepAdjustments         struct {
+		StepAdjustment []EssStepAdjustment
+	}
+}
+
+type DescribeEssScalingRulesResponse struct {
+	common.Response
+	common.PaginationResult
+	ScalingRules struct {
+		ScalingRule []EssScalingRule
+	}
+}
+
+// EssResponse is used by the actions which only return a RequestId
+type EssResponse struct {
+	common.Response
+}
+
+// EssRecurrenceCron means the recurrence value is a cron expression, like '0 2 * * *'.
+const EssRecurrenceCron = ess.RecurrenceType("Cron")
+
+// ModifyEssScheduledTaskArgs uses a string to send TaskEnabled only when it is changed,
+// otherwise the bool in ess.ModifyScheduledTaskArgs always disables the task.
+type ModifyEssScheduledTaskArgs struct {
+	RegionId             common.Region
+	ScheduledTaskId      string
+	ScheduledTaskName    string
+	Description          string
+	ScheduledAction      string
+	LaunchTime           string
+	LaunchExpirationTime int
+	RecurrenceType       ess.RecurrenceType
+	RecurrenceValue      string
+	RecurrenceEndTime    string
+	TaskEnabled          string
+}
+
+type EssScalingInstanceCreationType string
+
+const (
+	EssAutoCreated = EssScalingInstanceCreationType("AutoCreated")
+	EssAttached    = EssScalingInstanceCreationType("Attached")
+)
+
+type EssInstancesArgs struct {
+	RegionId       common.Region
+	ScalingGroupId string
+	InstanceId     []string
+}
+
+type DescribeEssScalingInstancesArgs struct {
+	RegionId       common.Region
+	ScalingGroupId string
+	CreationType   EssScalingInstanceCreationType
+	InstanceId     []string
+	common.Pagination
+}
+
+type EssScalingInstance struct {
+	InstanceId     string
+	ScalingGroupId string
+	CreationType   EssScalingInstanceCreationType
+	HealthStatus   string
+	LifecycleState string
+}
+
+type DescribeEssScalingInstancesResponse struct {
+	common.Response
+	common.PaginationResult
+	ScalingInstances struct {
+		ScalingInstance []EssScalingInstance
+	}
+}
+
+var EssNotificationTypes = []string{
+	"AUTOSCALING:SCALE_OUT_SUCCESS", "AUTOSCALING:SCALE_IN_SUCCESS",
+	"AUTOSCALING:SCALE_OUT_ERROR", "AUTOSCALING:SCALE_IN_ERROR",
+	"AUTOSCALING:SCALE_REJECT", "AUTOSCALING:SCALE_OUT_START", "AUTOSCALING:SCALE_IN_START",
+	"AUTOSCALING:SCHEDULE_TASK_EXPIRING",
+}
+
+type EssNotificationConfigurationArgs struct {
+	RegionId         common.Region
+	ScalingGroupId   string
+	NotificationArn  string
+	NotificationType []string
+}
+
+type DescribeEssNotificationConfigurationsArgs struct {
+	RegionId       common.Region
+	ScalingGroupId string
+}
+
+type EssNotificationConfiguration struct {
+	ScalingGroupId    string
+	NotificationArn   string
+	NotificationTypes struct {
+		NotificationType []string
+	}
+}
+
+type DescribeEssNotificationConfigurationsResponse struct {
+	common.Response
+	NotificationConfigurationModels struct {
+		NotificationConfigurationModel []EssNotificationConfiguration
+	}
+}
+
+type EssAlarmDimension struct {
+	DimensionKey   string
+	DimensionValue string
+}
+
+type CreateEssAlarmArgs struct {
+	RegionId           common.Region
+	ScalingGroupId     string
+	Name               string
+	Description        string
+	MetricType         string
+	MetricName         string
+	Period             int
+	Statistics         string
+	Threshold          float64
+	ComparisonOperator string
+	EvaluationCount    int
+	GroupId            int
+	AlarmAction        []string
+	Dimension          []EssAlarmDimension
+}
+
+type CreateEssAlarmResponse struct {
+	common.Response
+	AlarmTaskId string
+}
+
+type ModifyEssAlarmArgs struct {
+	RegionId           common.Region
+	AlarmTaskId        string
+	Name               string
+	Description        string
+	MetricType         string
+	MetricName         string
+	Period             int
+	Statistics         string
+	Threshold          float64
+	ComparisonOperator string
+	EvaluationCount    int
+	GroupId            int
+	AlarmAction        []string
+	Dimension          []EssAlarmDimension
+}
+
+type EssAlarmArgs struct {
+	RegionId    common.Region
+	AlarmTaskId string
+}
+
+type EssAlarm struct {
+	AlarmTaskId        string
+	ScalingGroupId     string
+	Name               string
+	Description        string
+	MetricType         string
+	MetricName         string
+	Period             int
+	Statistics         string
+	Threshold          float64
+	ComparisonOperator string
+	EvaluationCount    int
+	GroupId            int
+	State              string
+	Enable             bool
+	AlarmActions       struct {
+		AlarmAction []string
+	}
+	Dimensions struct {
+		Dimension []EssAlarmDimension
+	}
+}
+
+type DescribeEssAlarmsResponse struct {
+	common.Response
+	common.PaginationResult
+	AlarmList struct {
+		Alarm []EssAlarm
+	}
+}
+
+type EssLaunchTemplateOverride struct {
+	InstanceType     string
+	WeightedCapacity int
+}
+
+type ModifyEssScalingGroupLaunchTemplateArgs struct {
+	RegionId               common.Region
+	ScalingGroupId         string
+	LaunchTemplateId       string
+	LaunchTemplateVersion  string
+	LaunchTemplateOverride []EssLaunchTemplateOverride
+}
+
+type DescribeEssScalingGroupsArgs struct {
+	RegionId       common.Region
+	ScalingGroupId []string
+}
+
+// EssScalingGroupLaunchTemplate only contains the launch template attributes of the scaling group,
+// which are missing in ess.ScalingGroupItemType.
+type EssScalingGroupLaunchTemplate struct {
+	ScalingGroupId          string
+	LaunchTemplateId        string
+	LaunchTemplateVersion   string
+	LaunchTemplateOverrides struct {
+		LaunchTemplateOverride []EssLaunchTemplateOverride
+	}
+}
+
+type DescribeEssScalingGroupsResponse struct {
+	common.Response
+	ScalingGroups struct {
+		ScalingGroup []EssScalingGroupLaunchTemplate
+	}
+}
@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	EventBridgeEndpointTemplate = "https://eventbridge.%s.aliyuncs.com"
+	EventBridgeApiVersion       = "2020-04-01"
+)
+
+const (
+	EventBridgeRuleEnable  = "ENABLE"
+	EventBridgeRuleDisable = "DISABLE"
+)
+
+var EventBridgeTargetTypes = []string{"acs.fc.function", "acs.mns.queue", "acs.mns.topic", "acs.sms", "acs.dingtalk", "http", "https", "mail"}
+var EventBridgeParamForms = []string{"ORIGINAL", "TEMPLATE", "JSONPATH", "CONSTANT"}
+var EventBridgePushRetryStrategies = []string{"BACKOFF_RETRY", "EXPONENTIAL_DECAY_RETRY"}
+var EventBridgeExternalSourceTypes = []string{"RabbitMQ", "RocketMQ", "MNS"}
+
+// EventBridgeResponse is embedded in all the responses, and a request is failed when Success is false
+// even though its http status is OK.
+type EventBridgeResponse struct {
+	common.Response
+	Code    string
+	Message string
+	Success bool
+}
+
+type EventBusArgs struct {
+	EventBusName string
+	Description  string
+}
+
+type EventBus struct {
+	EventBusName    string
+	EventBusARN     string
+	Description     string
+	CreateTimestamp int64
+}
+
+type DescribeEventBusResponse struct {
+	EventBridgeResponse
+	Data EventBus
+}
+
+type EventRuleArgs struct {
+	EventBusName  string
+	RuleName      string
+	Description   string
+	FilterPattern string
+	Status        string
+	// Targets is a JSON array of the EventRuleTarget.
+	Targets string
+	// TargetIds is a JSON array of the target ids.
+	TargetIds string
+}
+
+type EventRuleTargetParam struct {
+	ResourceKey string `json:"ResourceKey"`
+	Form        string `json:"Form"`
+	Value       string `json:"Value,omitempty"`
+	Template    string `json:"Template,omitempty"`
+}
+
+type EventRuleTarget struct {
+	Id                string                 `json:"Id"`
+	Type              string                 `json:"Type"`
+	Endpoint          string                 `json:"Endpoint"`
+	PushRetryStrategy string                 `json:"PushRetryStrategy,omitempty"`
+	ParamList         []EventRuleTargetParam `json:"ParamList,omitempty"`
+}
+
+type EventRule struct {
+	EventBusName  string
+	RuleName      string
+	RuleARN       string
+	Description   string
+	FilterPattern string
+	Status        string
+	Targets       []EventRuleTarget
+}
+
+type DescribeEventRuleResponse struct {
+	EventBridgeResponse
+	Data EventRule
+}
+
+type EventSourceArgs struct {
+	EventSourceName      string
+	EventBusName         string
+	Description          string
+	LinkedExternalSource string
+	ExternalSourceType   string
+	// ExternalSourceConfig is a JSON object of the config of the external source, like the queue name of MNS.
+	ExternalSourceConfig string
+}
+
+type EventSource struct {
+	Name                 string
+	ARN                  string
+	EventBusName         string
+	Description          string
+	ExternalSourceType   string
+	ExternalSourceConfig map[string]interface{}
+}
+
+type DescribeEventSourcesResponse struct {
+	EventBridgeResponse
+	Data struct {
+		EventSourceList []EventSource
+	}
+}
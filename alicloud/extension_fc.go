@@ -0,0 +1,143 @@
+package alicloud
+
+// The Function Compute API is a RESTful JSON one whose endpoint contains the account id, so it is requested
+// by the FcClient and the account id is got by the caller identity of STS.
+const (
+	FcEndpointTemplate = "https://%s.%s.fc.aliyuncs.com"
+	FcApiVersion       = "2016-08-15"
+)
+
+type FcError struct {
+	ErrorCode    string `json:"ErrorCode"`
+	ErrorMessage string `json:"ErrorMessage"`
+}
+
+type FcLogConfig struct {
+	Project  string `json:"project"`
+	Logstore string `json:"logstore"`
+}
+
+type FcVpcConfig struct {
+	VpcId           string   `json:"vpcId"`
+	VSwitchIds      []string `json:"vSwitchIds"`
+	SecurityGroupId string   `json:"securityGroupId"`
+}
+
+type FcService struct {
+	ServiceName      string       `json:"serviceName,omitempty"`
+	ServiceId        string       `json:"serviceId,omitempty"`
+	Description      string       `json:"description"`
+	Role             string       `json:"role"`
+	InternetAccess   *bool        `json:"internetAccess,omitempty"`
+	LogConfig        *FcLogConfig `json:"logConfig,omitempty"`
+	VpcConfig        *FcVpcConfig `json:"vpcConfig,omitempty"`
+	LastModifiedTime string       `json:"lastModifiedTime,omitempty"`
+}
+
+var FcFunctionRuntimes = []string{"nodejs6", "nodejs8", "nodejs10", "nodejs12", "python2.7", "python3",
+	"java8", "java11", "php7.2", "dotnetcore2.1", "custom", "custom-container"}
+
+// FcCode is either the OSS object or the base64 encoded zip file of the function code.
+type FcCode struct {
+	OssBucketName string `json:"ossBucketName,omitempty"`
+	OssObjectName string `json:"ossObjectName,omitempty"`
+	ZipFile       string `json:"zipFile,omitempty"`
+}
+
+type FcFunction struct {
+	FunctionName         string            `json:"functionName,omitempty"`
+	FunctionId           string            `json:"functionId,omitempty"`
+	Description          string            `json:"description"`
+	Runtime              string            `json:"runtime"`
+	Handler              string            `json:"handler"`
+	MemorySize           int               `json:"memorySize"`
+	Timeout              int               `json:"timeout"`
+	EnvironmentVariables map[string]string `json:"environmentVariables"`
+	Code                 *FcCode           `json:"code,omitempty"`
+	CodeChecksum         string            `json:"codeChecksum,omitempty"`
+	CodeSize             int64             `json:"codeSize,omitempty"`
+	LastModifiedTime     string            `json:"lastModifiedTime,omitempty"`
+}
+
+var FcTriggerTypes = []string{"oss", "log", "timer", "http", "mns_topic", "cdn_events"}
+
+type FcTrigger struct {
+	TriggerName      string      `json:"triggerName,omitempty"`
+	TriggerType      string      `json:"triggerType,omitempty"`
+	SourceArn        string      `json:"sourceArn,omitempty"`
+	InvocationRole   string      `json:"invocationRole"`
+	TriggerConfig    interface{} `json:"triggerConfig"`
+	LastModifiedTime string      `json:"lastModifiedTime,omitempty"`
+}
+
+var FcCustomDomainProtocols = []string{"HTTP", "HTTP,HTTPS"}
+
+type FcPathConfig struct {
+	Path         string   `json:"path"`
+	ServiceName  string   `json:"serviceName"`
+	FunctionName string   `json:"functionName"`
+	Qualifier    string   `json:"qualifier,omitempty"`
+	Methods      []string `json:"methods,omitempty"`
+}
+
+type FcRouteConfig struct {
+	Routes []FcPathConfig `json:"routes"`
+}
+
+type FcCertConfig struct {
+	CertName    string `json:"certName"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey,omitempty"`
+}
+
+type FcCustomDomain struct {
+	DomainName       string         `json:"domainName,omitempty"`
+	Protocol         string         `json:"protocol"`
+	RouteConfig      *FcRouteConfig `json:"routeConfig,omitempty"`
+	CertConfig       *FcCertConfig  `json:"certConfig,omitempty"`
+	AccountId        string         `json:"accountId,omitempty"`
+	LastModifiedTime string         `json:"lastModifiedTime,omitempty"`
+}
+
+type FcProvisionConfig struct {
+	Resource string `json:"resource,omitempty"`
+	Target   int64  `json:"target"`
+	Current  int64  `json:"current,omitempty"`
+}
+
+type FcOnDemandConfig struct {
+	Resource             string `json:"resource,omitempty"`
+	MaximumInstanceCount int64  `json:"maximumInstanceCount"`
+}
+
+type FcLayer struct {
+	LayerName         string   `json:"layerName,omitempty"`
+	Version           int      `json:"version,omitempty"`
+	Description       string   `json:"description"`
+	Code              *FcCode  `json:"code,omitempty"`
+	CodeChecksum      string   `json:"codeChecksum,omitempty"`
+	CodeSize          int64    `json:"codeSize,omitempty"`
+	CompatibleRuntime []string `json:"compatibleRuntime"`
+	Arn               string   `json:"arn,omitempty"`
+	Acl               int      `json:"acl,omitempty"`
+	CreateTime        string   `json:"createTime,omitempty"`
+}
+
+type FcDestination struct {
+	Destination string `json:"destination"`
+}
+
+type FcDestinationConfig struct {
+	OnSuccess *FcDestination `json:"onSuccess,omitempty"`
+	OnFailure *FcDestination `json:"onFailure,omitempty"`
+}
+
+type FcAsyncInvokeConfig struct {
+	Service                   string               `json:"service,omitempty"`
+	Function                  string               `json:"function,omitempty"`
+	Qualifier                 string               `json:"qualifier,omitempty"`
+	MaxAsyncEventAgeInSeconds *int                 `json:"maxAsyncEventAgeInSeconds,omitempty"`
+	MaxAsyncRetryAttempts     *int                 `json:"maxAsyncRetryAttempts,omitempty"`
+	DestinationConfig         *FcDestinationConfig `json:"destinationConfig,omitempty"`
+	StatefulInvocation        bool                 `json:"statefulInvocation"`
+}
@@ -0,0 +1,58 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The Serverless Workflow hosts start with the region, like cn-hangzhou.fnf.aliyuncs.com.
+const (
+	FnfEndpointTemplate = "https://%s.fnf.aliyuncs.com"
+	FnfApiVersion       = "2019-03-15"
+)
+
+const FnfFlowTypeFDL = "FDL"
+
+// FnfFlowArgs is used by the CreateFlow, DescribeFlow, UpdateFlow and DeleteFlow, and the Definition is the
+// FDL of the flow.
+type FnfFlowArgs struct {
+	Name        string
+	Definition  string
+	Description string
+	Type        string
+	RoleArn     string
+}
+
+type FnfFlowResponse struct {
+	common.Response
+	Id               string
+	Name             string
+	Definition       string
+	Description      string
+	Type             string
+	RoleArn          string
+	CreatedTime      string
+	LastModifiedTime string
+}
+
+// FnfScheduleArgs is used by the CreateSchedule, DescribeSchedule, UpdateSchedule and DeleteSchedule, and the
+// Payload is the JSON input of the executions started by the schedule.
+type FnfScheduleArgs struct {
+	FlowName       string
+	ScheduleName   string
+	CronExpression string
+	Description    string
+	Payload        string
+	Enable         bool
+}
+
+type FnfScheduleResponse struct {
+	common.Response
+	ScheduleId       string
+	ScheduleName     string
+	CronExpression   string
+	Description      string
+	Payload          string
+	Enable           bool
+	CreatedTime      string
+	LastModifiedTime string
+}
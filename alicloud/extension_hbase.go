@@ -0,0 +1,119 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	HBaseEndpoint   = "https://hbase.aliyuncs.com"
+	HBaseApiVersion = "2019-01-01"
+)
+
+type HBaseInstanceStatus string
+
+const (
+	HBaseActivation     = HBaseInstanceStatus("ACTIVATION")
+	HBaseCreating       = HBaseInstanceStatus("LAUNCHING")
+	HBaseNodeResizing   = HBaseInstanceStatus("HBASE_SCALE_OUT")
+	HBaseDiskResizing   = HBaseInstanceStatus("HBASE_EXPANDING")
+	HBaseClassChanging  = HBaseInstanceStatus("CLASS_CHANGING")
+	HBaseDeleting       = HBaseInstanceStatus("DELETING")
+	HBaseEngine         = "hbase"
+	HBaseDefaultNetType = "VPC"
+)
+
+var HBaseEngineVersions = []string{"1.1", "2.0"}
+
+var HBaseDiskTypes = []string{"cloud_ssd", "cloud_efficiency", "local_hdd_pro", "local_ssd_pro"}
+
+type CreateHBaseInstanceArgs struct {
+	RegionId           common.Region
+	ZoneId             string
+	Engine             string
+	EngineVersion      string
+	ClusterName        string
+	MasterInstanceType string
+	CoreInstanceType   string
+	NodeCount          int
+	CoreDiskType       string
+	CoreDiskSize       int
+	ColdStorageSize    int
+	PayType            string
+	Period             int
+	PeriodUnit         string
+	NetType            string
+	VpcId              string
+	VSwitchId          string
+	ClientToken        string
+}
+
+type CreateHBaseInstanceResponse struct {
+	common.Response
+	ClusterId string
+	OrderId   string
+}
+
+type HBaseInstanceArgs struct {
+	RegionId  common.Region
+	ClusterId string
+}
+
+type HBaseInstance struct {
+	InstanceId         string
+	InstanceName       string
+	Status             HBaseInstanceStatus
+	Engine             string
+	MajorVersion       string
+	ZoneId             string
+	MasterInstanceType string
+	CoreInstanceType   string
+	CoreNodeCount      int
+	CoreDiskType       string
+	CoreDiskSize       int
+	ColdStorageSize    int
+	PayType            string
+	NetworkType        string
+	VpcId              string
+	VswitchId          string
+}
+
+type DescribeHBaseInstanceResponse struct {
+	common.Response
+	HBaseInstance
+}
+
+type ModifyHBaseInstanceNameArgs struct {
+	RegionId    common.Region
+	ClusterId   string
+	ClusterName string
+}
+
+type ModifyHBaseInstanceTypeArgs struct {
+	RegionId           common.Region
+	ClusterId          string
+	MasterInstanceType string
+	CoreInstanceType   string
+}
+
+type ResizeHBaseNodeCountArgs struct {
+	RegionId  common.Region
+	ClusterId string
+	NodeCount int
+}
+
+type ResizeHBaseDiskSizeArgs struct {
+	RegionId     common.Region
+	ClusterId    string
+	NodeDiskSize int
+}
+
+type ResizeHBaseColdStorageSizeArgs struct {
+	RegionId        common.Region
+	ClusterId       string
+	ColdStorageSize int
+}
+
+// HBaseResponse is used by the actions which only return a RequestId
+type HBaseResponse struct {
+	common.Response
+}
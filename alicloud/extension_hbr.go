@@ -0,0 +1,183 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	HbrEndpointTemplate = "https://hbr.%s.aliyuncs.com"
+	HbrApiVersion       = "2017-09-08"
+)
+
+const (
+	HbrVaultCreated = "CREATED"
+	HbrVaultError   = "ERROR"
+)
+
+const (
+	HbrSourceEcsFile = "ECS_FILE"
+	HbrSourceOss     = "OSS"
+	HbrSourceNas     = "NAS"
+)
+
+const (
+	HbrRestoreJobComplete        = "COMPLETE"
+	HbrRestoreJobPartialComplete = "PARTIAL_COMPLETE"
+	HbrRestoreJobFailed          = "FAILED"
+	HbrRestoreJobCanceled        = "CANCELED"
+)
+
+var HbrSourceTypes = []string{HbrSourceEcsFile, HbrSourceOss, HbrSourceNas}
+
+// HbrFilter filters the backup plans and the restore jobs, like the planId and the restoreId.
+type HbrFilter struct {
+	Key    string
+	Values []string
+}
+
+type HbrVaultArgs struct {
+	VaultId           string
+	VaultRegionId     common.Region
+	VaultName         string
+	VaultType         string
+	VaultStorageClass string
+	Description       string
+	PageNumber        int
+	PageSize          int
+}
+
+type CreateHbrVaultResponse struct {
+	common.Response
+	VaultId string
+}
+
+type HbrVault struct {
+	VaultId           string
+	VaultName         string
+	VaultType         string
+	VaultStorageClass string
+	Description       string
+	Status            string
+}
+
+type DescribeHbrVaultsResponse struct {
+	common.Response
+	TotalCount int
+	Vaults     struct {
+		Vault []HbrVault
+	}
+}
+
+// HbrBackupPlanArgs is used by the CreateBackupPlan, UpdateBackupPlan, EnableBackupPlan, DisableBackupPlan and
+// DeleteBackupPlan. The Exclude and the Include are JSON arrays.
+type HbrBackupPlanArgs struct {
+	PlanId       string
+	VaultId      string
+	PlanName     string
+	SourceType   string
+	BackupType   string
+	Schedule     string
+	Retention    int
+	InstanceId   string
+	Path         []string
+	Exclude      string
+	Include      string
+	SpeedLimit   string
+	Bucket       string
+	Prefix       string
+	FileSystemId string
+	CreateTime   string
+}
+
+type CreateHbrBackupPlanResponse struct {
+	common.Response
+	PlanId string
+}
+
+type HbrBackupPlan struct {
+	PlanId     string
+	PlanName   string
+	VaultId    string
+	SourceType string
+	BackupType string
+	Schedule   string
+	Retention  int
+	Disabled   bool
+	InstanceId string
+	Paths      struct {
+		Path []string
+	}
+	Exclude      string
+	Include      string
+	SpeedLimit   string
+	Bucket       string
+	Prefix       string
+	FileSystemId string
+	CreateTime   int64
+}
+
+type DescribeHbrBackupPlansArgs struct {
+	SourceType string
+	Filters    []HbrFilter
+	PageNumber int
+	PageSize   int
+}
+
+type DescribeHbrBackupPlansResponse struct {
+	common.Response
+	TotalCount  int
+	BackupPlans struct {
+		BackupPlan []HbrBackupPlan
+	}
+}
+
+type HbrRestoreJobArgs struct {
+	RestoreId          string
+	RestoreType        string
+	VaultId            string
+	SnapshotId         string
+	SnapshotHash       string
+	SourceType         string
+	TargetInstanceId   string
+	TargetPath         string
+	TargetBucket       string
+	TargetPrefix       string
+	TargetFileSystemId string
+	TargetCreateTime   string
+	Include            string
+	Exclude            string
+}
+
+type CreateHbrRestoreJobResponse struct {
+	common.Response
+	RestoreId string
+}
+
+type HbrRestoreJob struct {
+	RestoreId          string
+	RestoreType        string
+	VaultId            string
+	SnapshotId         string
+	SnapshotHash       string
+	SourceType         string
+	Status             string
+	TargetInstanceId   string
+	TargetPath         string
+	TargetBucket       string
+	TargetPrefix       string
+	TargetFileSystemId string
+	TargetCreateTime   int64
+	ErrorMessage       string
+}
+
+type DescribeHbrRestoreJobsArgs struct {
+	RestoreType string
+	Filters     []HbrFilter
+}
+
+type DescribeHbrRestoreJobsResponse struct {
+	common.Response
+	RestoreJobs struct {
+		RestoreJob []HbrRestoreJob
+	}
+}
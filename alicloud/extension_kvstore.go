@@ -0,0 +1,195 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	KVStoreEndpoint   = "https://r-kvstore.aliyuncs.com"
+	KVStoreApiVersion = "2015-01-01"
+)
+
+type KVStoreInstanceStatus string
+
+const (
+	KVStoreNormal           = KVStoreInstanceStatus("Normal")
+	KVStoreCreating         = KVStoreInstanceStatus("Creating")
+	KVStoreChanging         = KVStoreInstanceStatus("Changing")
+	KVStoreNetworkModifying = KVStoreInstanceStatus("NetworkModifying")
+)
+
+type KVStoreAccountStatus string
+
+const (
+	KVStoreAccountAvailable   = KVStoreAccountStatus("Available")
+	KVStoreAccountUnavailable = KVStoreAccountStatus("Unavailable")
+)
+
+type KVStoreAccountPrivilege string
+
+const (
+	KVStoreRoleReadOnly  = KVStoreAccountPrivilege("RoleReadOnly")
+	KVStoreRoleReadWrite = KVStoreAccountPrivilege("RoleReadWrite")
+	KVStoreRoleRepl      = KVStoreAccountPrivilege("RoleRepl")
+)
+
+// The value of DBInstanceNetType returned by DescribeDBInstanceNetInfo
+const (
+	KVStoreNetTypeInternet = "0"
+	KVStoreNetTypeClassic  = "1"
+	KVStoreNetTypeVpc      = "2"
+)
+
+var KVStoreBackupPeriod = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+var KVStoreBackupTime = []string{
+	"00:00Z-01:00Z", "01:00Z-02:00Z", "02:00Z-03:00Z", "03:00Z-04:00Z", "04:00Z-05:00Z", "05:00Z-06:00Z",
+	"06:00Z-07:00Z", "07:00Z-08:00Z", "08:00Z-09:00Z", "09:00Z-10:00Z", "10:00Z-11:00Z", "11:00Z-12:00Z",
+	"12:00Z-13:00Z", "13:00Z-14:00Z", "14:00Z-15:00Z", "15:00Z-16:00Z", "16:00Z-17:00Z", "17:00Z-18:00Z",
+	"18:00Z-19:00Z", "19:00Z-20:00Z", "20:00Z-21:00Z", "21:00Z-22:00Z", "22:00Z-23:00Z", "23:00Z-24:00Z",
+}
+
+type KVStoreInstanceArgs struct {
+	RegionId   common.Region
+	InstanceId string
+}
+
+type KVStoreInstanceAttribute struct {
+	InstanceId       string
+	InstanceName     string
+	InstanceStatus   KVStoreInstanceStatus
+	InstanceType     string
+	EngineVersion    string
+	ZoneId           string
+	NetworkType      string
+	VpcId            string
+	VSwitchId        string
+	ConnectionDomain string
+	Port             int
+}
+
+type DescribeKVStoreInstanceAttributeResponse struct {
+	common.Response
+	Instances struct {
+		DBInstanceAttribute []KVStoreInstanceAttribute
+	}
+}
+
+type KVStoreBackupPolicy struct {
+	PreferredBackupTime     string
+	PreferredBackupPeriod   string
+	PreferredNextBackupTime string
+	BackupRetentionPeriod   string
+}
+
+type DescribeKVStoreBackupPolicyResponse struct {
+	common.Response
+	KVStoreBackupPolicy
+}
+
+type ModifyKVStoreBackupPolicyArgs struct {
+	RegionId              common.Region
+	InstanceId            string
+	PreferredBackupTime   string
+	PreferredBackupPeriod string
+}
+
+type CreateKVStoreAccountArgs struct {
+	RegionId           common.Region
+	InstanceId         string
+	AccountName        string
+	AccountPassword    string
+	AccountPrivilege   KVStoreAccountPrivilege
+	AccountDescription string
+}
+
+type KVStoreAccountArgs struct {
+	RegionId    common.Region
+	InstanceId  string
+	AccountName string
+}
+
+type KVStoreAccount struct {
+	InstanceId         string
+	AccountName        string
+	AccountStatus      KVStoreAccountStatus
+	AccountType        string
+	AccountDescription string
+	DatabasePrivileges struct {
+		DatabasePrivilege []struct {
+			AccountPrivilege KVStoreAccountPrivilege
+		}
+	}
+}
+
+type DescribeKVStoreAccountsResponse struct {
+	common.Response
+	Accounts struct {
+		Account []KVStoreAccount
+	}
+}
+
+type ModifyKVStoreAccountDescriptionArgs struct {
+	RegionId           common.Region
+	InstanceId         string
+	AccountName        string
+	AccountDescription string
+}
+
+type ResetKVStoreAccountPasswordArgs struct {
+	RegionId        common.Region
+	InstanceId      string
+	AccountName     string
+	AccountPassword string
+}
+
+type GrantKVStoreAccountPrivilegeArgs struct {
+	RegionId         common.Region
+	InstanceId       string
+	AccountName      string
+	AccountPrivilege KVStoreAccountPrivilege
+}
+
+type AllocateKVStorePublicConnectionArgs struct {
+	RegionId               common.Region
+	InstanceId             string
+	ConnectionStringPrefix string
+	Port                   string
+}
+
+type ModifyKVStoreConnectionStringArgs struct {
+	RegionId                common.Region
+	DBInstanceId            string
+	CurrentConnectionString string
+	NewConnectionString     string
+	Port                    string
+	IPType                  string
+}
+
+type ReleaseKVStorePublicConnectionArgs struct {
+	RegionId                common.Region
+	InstanceId              string
+	CurrentConnectionString string
+}
+
+type KVStoreNetInfo struct {
+	ConnectionString  string
+	IPAddress         string
+	IPType            string
+	Port              string
+	DBInstanceNetType string
+	VPCId             string
+	VSwitchId         string
+}
+
+type DescribeKVStoreNetInfoResponse struct {
+	common.Response
+	NetInfoItems struct {
+		InstanceNetInfo []KVStoreNetInfo
+	}
+}
+
+// KVStoreResponse is used by the actions which only return a RequestId
+type KVStoreResponse struct {
+	common.Response
+}
@@ -0,0 +1,108 @@
+package alicloud
+
+// The Log Service API is a RESTful JSON one whose endpoint contains the project name, so it is requested
+// by the LogClient.
+const (
+	LogEndpointTemplate = "https://%s.log.aliyuncs.com"
+	LogApiVersion       = "0.6.0"
+)
+
+type LogError struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+var LogMachineGroupIdentifyTypes = []string{"ip", "userdefined"}
+
+type LogMachineGroupAttribute struct {
+	ExternalName string `json:"externalName"`
+	TopicName    string `json:"groupTopic"`
+}
+
+type LogMachineGroup struct {
+	Name          string                   `json:"groupName"`
+	Type          string                   `json:"groupType"`
+	IdentifyType  string                   `json:"machineIdentifyType"`
+	Attribute     LogMachineGroupAttribute `json:"groupAttribute"`
+	MachineIdList []string                 `json:"machineList"`
+}
+
+var LogtailInputTypes = []string{"file", "plugin"}
+
+type LogtailOutputDetail struct {
+	LogStoreName string `json:"logstoreName"`
+}
+
+type LogtailConfig struct {
+	Name         string                 `json:"configName"`
+	InputType    string                 `json:"inputType"`
+	InputDetail  map[string]interface{} `json:"inputDetail"`
+	OutputType   string                 `json:"outputType"`
+	OutputDetail LogtailOutputDetail    `json:"outputDetail"`
+	LogSample    string                 `json:"logSample,omitempty"`
+}
+
+type ListLogMachineGroupConfigsResponse struct {
+	Count   int      `json:"count"`
+	Configs []string `json:"configs"`
+}
+
+var LogAlertNotificationTypes = []string{"SMS", "DingTalk", "Email", "MessageCenter"}
+
+type LogAlertQuery struct {
+	ChartTitle   string `json:"chartTitle"`
+	LogStore     string `json:"logStore"`
+	Query        string `json:"query"`
+	Start        string `json:"start"`
+	End          string `json:"end"`
+	TimeSpanType string `json:"timeSpanType"`
+}
+
+type LogAlertNotification struct {
+	Type       string   `json:"type"`
+	Content    string   `json:"content"`
+	ServiceUri string   `json:"serviceUri,omitempty"`
+	MobileList []string `json:"mobileList,omitempty"`
+	EmailList  []string `json:"emailList,omitempty"`
+}
+
+type LogAlertConfiguration struct {
+	Condition        string                 `json:"condition"`
+	Dashboard        string                 `json:"dashboard"`
+	QueryList        []LogAlertQuery        `json:"queryList"`
+	NotificationList []LogAlertNotification `json:"notificationList"`
+	MuteUntil        int64                  `json:"muteUntil"`
+	Throttling       string                 `json:"throttling"`
+	NotifyThreshold  int                    `json:"notifyThreshold"`
+}
+
+type LogJobSchedule struct {
+	Type     string `json:"type"`
+	Interval string `json:"interval"`
+}
+
+// LogAlert is the job whose type is 'Alert'.
+type LogAlert struct {
+	Name          string                `json:"name"`
+	DisplayName   string                `json:"displayName"`
+	Description   string                `json:"description"`
+	Type          string                `json:"type"`
+	State         string                `json:"state"`
+	Schedule      LogJobSchedule        `json:"schedule"`
+	Configuration LogAlertConfiguration `json:"configuration"`
+}
+
+type LogDashboard struct {
+	Name        string        `json:"dashboardName"`
+	DisplayName string        `json:"displayName"`
+	Description string        `json:"description"`
+	Charts      []interface{} `json:"charts"`
+}
+
+type LogSavedSearch struct {
+	Name        string `json:"savedsearchName"`
+	DisplayName string `json:"displayName"`
+	SearchQuery string `json:"searchQuery"`
+	LogStore    string `json:"logstore"`
+	Topic       string `json:"topic"`
+}
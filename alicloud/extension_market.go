@@ -0,0 +1,77 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	MarketEndpoint   = "https://market.aliyuncs.com"
+	MarketApiVersion = "2015-11-01"
+)
+
+// MarketProductTypeImage is the product type of the images in the Marketplace.
+const MarketProductTypeImage = "MIRROR"
+
+var MarketProductSorts = []string{"user_count-desc", "created_on-desc", "price-desc", "score-desc"}
+
+type MarketProductFilter struct {
+	Key   string
+	Value string
+}
+
+type DescribeMarketProductsArgs struct {
+	Filter []MarketProductFilter
+	common.Pagination
+}
+
+type MarketProduct struct {
+	Code             string
+	Name             string
+	CategoryId       int
+	SupplierId       int
+	SupplierName     string
+	ShortDescription string
+	SuggestedPrice   string
+	TargetUrl        string
+	ImageUrl         string
+	Score            string
+	OperationSystem  string
+	WarrantyDate     string
+	DeliveryDate     string
+	DeliveryWay      string
+	Tags             string
+}
+
+type DescribeMarketProductsResponse struct {
+	common.Response
+	common.PaginationResult
+	ProductItems struct {
+		ProductItem []MarketProduct
+	}
+}
+
+// MarketplaceImageOwnerAlias is the owner alias of the images from the Marketplace.
+const MarketplaceImageOwnerAlias = "marketplace"
+
+// The following args and response only describe the Marketplace product of the images, which is not in the
+// responses of the SDK.
+
+type DescribeMarketplaceImageArgs struct {
+	RegionId        common.Region
+	ImageId         string
+	ImageOwnerAlias string
+}
+
+type MarketplaceImage struct {
+	ImageId      string
+	ImageName    string
+	ProductCode  string
+	IsSubscribed bool
+}
+
+type DescribeMarketplaceImageResponse struct {
+	common.Response
+	Images struct {
+		Image []MarketplaceImage
+	}
+}
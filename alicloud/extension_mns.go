@@ -0,0 +1,72 @@
+package alicloud
+
+import (
+	"encoding/xml"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The MNS API is a RESTful XML one whose endpoint contains the account id, so it is requested by the
+// MnsClient and the account id is got by the caller identity of STS.
+const (
+	MnsEndpointTemplate = "https://%s.mns.%s.aliyuncs.com"
+	MnsApiVersion       = "2015-06-06"
+
+	StsEndpoint   = "https://sts.aliyuncs.com"
+	StsApiVersion = "2015-04-01"
+)
+
+type GetCallerIdentityArgs struct {
+}
+
+type GetCallerIdentityResponse struct {
+	common.Response
+	AccountId string
+	UserId    string
+	Arn       string
+	// IdentityType is Account, RAMUser or AssumedRoleUser.
+	IdentityType string
+	PrincipalId  string
+	RoleId       string
+}
+
+type MnsError struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	RequestId string   `xml:"RequestId"`
+	HostId    string   `xml:"HostId"`
+}
+
+type MnsQueue struct {
+	QueueURL               string `xml:"QueueURL"`
+	QueueName              string `xml:"QueueName"`
+	DelaySeconds           int    `xml:"DelaySeconds"`
+	MaximumMessageSize     int    `xml:"MaximumMessageSize"`
+	MessageRetentionPeriod int    `xml:"MessageRetentionPeriod"`
+	VisibilityTimeout      int    `xml:"VisibilityTimeout"`
+	PollingWaitSeconds     int    `xml:"PollingWaitSeconds"`
+	CreateTime             int64  `xml:"CreateTime"`
+	LastModifyTime         int64  `xml:"LastModifyTime"`
+}
+
+type ListMnsQueuesResponse struct {
+	XMLName    xml.Name   `xml:"Queues"`
+	Queues     []MnsQueue `xml:"Queue"`
+	NextMarker string     `xml:"NextMarker"`
+}
+
+type MnsTopic struct {
+	TopicURL           string `xml:"TopicURL"`
+	TopicName          string `xml:"TopicName"`
+	MaximumMessageSize int    `xml:"MaximumMessageSize"`
+	LoggingEnabled     bool   `xml:"LoggingEnabled"`
+	CreateTime         int64  `xml:"CreateTime"`
+	LastModifyTime     int64  `xml:"LastModifyTime"`
+}
+
+type ListMnsTopicsResponse struct {
+	XMLName    xml.Name   `xml:"Topics"`
+	Topics     []MnsTopic `xml:"Topic"`
+	NextMarker string     `xml:"NextMarker"`
+}
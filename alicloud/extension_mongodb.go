@@ -0,0 +1,233 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	MongoDBEndpoint   = "https://mongodb.aliyuncs.com"
+	MongoDBApiVersion = "2015-12-01"
+)
+
+type MongoDBInstanceStatus string
+
+const (
+	MongoDBRunning                 = MongoDBInstanceStatus("Running")
+	MongoDBCreating                = MongoDBInstanceStatus("Creating")
+	MongoDBDBInstanceClassChanging = MongoDBInstanceStatus("DBInstanceClassChanging")
+	MongoDBNodeCreating            = MongoDBInstanceStatus("NodeCreating")
+	MongoDBNodeDeleting            = MongoDBInstanceStatus("NodeDeleting")
+	MongoDBDeleting                = MongoDBInstanceStatus("Deleting")
+)
+
+type MongoDBNodeType string
+
+const (
+	MongoDBNodeMongos = MongoDBNodeType("mongos")
+	MongoDBNodeShard  = MongoDBNodeType("shard")
+)
+
+const (
+	MongoDBEngine          = "MongoDB"
+	MongoDBRootAccountName = "root"
+	MongoDBDefaultPort     = 3717
+)
+
+var MongoDBEngineVersions = []string{"3.2", "3.4"}
+
+var MongoDBStorageEngines = []string{"WiredTiger", "RocksDB"}
+
+type CreateMongoDBInstanceArgs struct {
+	RegionId              common.Region
+	ZoneId                string
+	Engine                string
+	EngineVersion         string
+	DBInstanceClass       string
+	DBInstanceStorage     int
+	DBInstanceDescription string
+	ReplicationFactor     string
+	StorageEngine         string
+	SecurityIPList        string
+	AccountPassword       string
+	ChargeType            string
+	Period                int
+	NetworkType           string
+	VpcId                 string
+	VSwitchId             string
+	ClientToken           string
+}
+
+type MongoDBNodeArgs struct {
+	Class   string
+	Storage int
+}
+
+type CreateMongoDBShardingInstanceArgs struct {
+	RegionId              common.Region
+	ZoneId                string
+	Engine                string
+	EngineVersion         string
+	DBInstanceDescription string
+	StorageEngine         string
+	SecurityIPList        string
+	AccountPassword       string
+	ChargeType            string
+	Period                int
+	NetworkType           string
+	VpcId                 string
+	VSwitchId             string
+	ClientToken           string
+	Mongos                []MongoDBNodeArgs
+	ReplicaSet            []MongoDBNodeArgs
+}
+
+type CreateMongoDBInstanceResponse struct {
+	common.Response
+	DBInstanceId string
+	OrderId      string
+}
+
+type MongoDBInstanceArgs struct {
+	RegionId     common.Region
+	DBInstanceId string
+}
+
+type MongoDBMongosAttribute struct {
+	NodeId          string
+	NodeClass       string
+	NodeDescription string
+	ConnectSting    string
+	Port            int
+}
+
+type MongoDBShardAttribute struct {
+	NodeId          string
+	NodeClass       string
+	NodeStorage     int
+	NodeDescription string
+}
+
+type MongoDBInstance struct {
+	DBInstanceId          string
+	DBInstanceDescription string
+	DBInstanceStatus      MongoDBInstanceStatus
+	DBInstanceType        string
+	DBInstanceClass       string
+	DBInstanceStorage     int
+	Engine                string
+	EngineVersion         string
+	StorageEngine         string
+	ReplicationFactor     string
+	ReplicaSetName        string
+	ZoneId                string
+	ChargeType            string
+	NetworkType           string
+	VPCId                 string
+	VSwitchId             string
+	MongosList            struct {
+		MongosAttribute []MongoDBMongosAttribute
+	}
+	ShardList struct {
+		ShardAttribute []MongoDBShardAttribute
+	}
+}
+
+type DescribeMongoDBInstancesArgs struct {
+	RegionId   common.Region
+	PageNumber int
+	PageSize   int
+}
+
+type DescribeMongoDBInstancesResponse struct {
+	common.Response
+	DBInstances struct {
+		DBInstance []MongoDBInstance
+	}
+	TotalCount int
+}
+
+type DescribeMongoDBInstanceAttributeResponse struct {
+	common.Response
+	DBInstances struct {
+		DBInstance []MongoDBInstance
+	}
+}
+
+type ModifyMongoDBDescriptionArgs struct {
+	RegionId              common.Region
+	DBInstanceId          string
+	DBInstanceDescription string
+}
+
+type ModifyMongoDBSpecArgs struct {
+	RegionId          common.Region
+	DBInstanceId      string
+	DBInstanceClass   string
+	DBInstanceStorage string
+}
+
+type ModifyMongoDBSecurityIpsArgs struct {
+	RegionId     common.Region
+	DBInstanceId string
+	SecurityIps  string
+	ModifyMode   string
+}
+
+type DescribeMongoDBSecurityIpsResponse struct {
+	common.Response
+	SecurityIps string
+}
+
+type MongoDBBackupPolicy struct {
+	PreferredBackupTime   string
+	PreferredBackupPeriod string
+	BackupRetentionPeriod string
+}
+
+type DescribeMongoDBBackupPolicyResponse struct {
+	common.Response
+	MongoDBBackupPolicy
+}
+
+type ModifyMongoDBBackupPolicyArgs struct {
+	RegionId              common.Region
+	DBInstanceId          string
+	PreferredBackupTime   string
+	PreferredBackupPeriod string
+}
+
+type ResetMongoDBAccountPasswordArgs struct {
+	RegionId        common.Region
+	DBInstanceId    string
+	AccountName     string
+	AccountPassword string
+}
+
+type CreateMongoDBNodeArgs struct {
+	RegionId     common.Region
+	DBInstanceId string
+	NodeType     MongoDBNodeType
+	NodeClass    string
+	NodeStorage  int
+	ClientToken  string
+}
+
+type ModifyMongoDBNodeSpecArgs struct {
+	RegionId     common.Region
+	DBInstanceId string
+	NodeId       string
+	NodeClass    string
+	NodeStorage  int
+}
+
+type DeleteMongoDBNodeArgs struct {
+	RegionId     common.Region
+	DBInstanceId string
+	NodeId       string
+	ClientToken  string
+}
+
+// MongoDBResponse is used by the actions which only return a RequestId
+type MongoDBResponse struct {
+	common.Response
+}
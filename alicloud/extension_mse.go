@@ -0,0 +1,165 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	MseEndpointTemplate = "https://mse.%s.aliyuncs.com"
+	MseApiVersion       = "2019-05-31"
+)
+
+const (
+	MseClusterTypeNacos     = "Nacos-Ans"
+	MseClusterTypeZooKeeper = "ZooKeeper"
+)
+
+// The init status of the clusters. The cluster is in service when it is INIT_SUCCESS or RESTART_SUCCESS.
+const (
+	MseClusterInitSuccess    = "INIT_SUCCESS"
+	MseClusterRestartSuccess = "RESTART_SUCCESS"
+)
+
+// The status of the gateways is a number, and 2 means the gateway is running.
+const MseGatewayRunning = 2
+
+// CreateMseClusterArgs is used by the CreateCluster, and the PubNetworkFlow is a string so that 0 is sent for
+// the clusters without the public network.
+type CreateMseClusterArgs struct {
+	Region                  string
+	ClusterType             string
+	ClusterVersion          string
+	ClusterSpecification    string
+	InstanceCount           int
+	MseVersion              string
+	NetType                 string
+	PubNetworkFlow          string
+	VSwitchId               string
+	ConnectionType          string
+	PrivateSlbSpecification string
+	PubSlbSpecification     string
+}
+
+type CreateMseClusterResponse struct {
+	common.Response
+	Success    bool
+	Message    string
+	InstanceId string
+	OrderId    string
+}
+
+type MseClusterArgs struct {
+	InstanceId string
+}
+
+type MseCluster struct {
+	InstanceId           string
+	ClusterName          string
+	ClusterAliasName     string
+	ClusterType          string
+	ClusterVersion       string
+	ClusterSpecification string
+	InstanceCount        int
+	MseVersion           string
+	NetType              string
+	PubNetworkFlow       string
+	VpcId                string
+	VSwitchId            string
+	IntranetDomain       string
+	InternetDomain       string
+	InitStatus           string
+	AppVersion           string
+}
+
+type QueryMseClusterDetailResponse struct {
+	common.Response
+	Success bool
+	Message string
+	Data    MseCluster
+}
+
+type UpdateMseClusterArgs struct {
+	InstanceId       string
+	ClusterAliasName string
+}
+
+// UpdateMseAclArgs is used by the UpdateAcl, and the AclEntryList is the comma separated CIDR blocks allowed to
+// access the cluster through the public network.
+type UpdateMseAclArgs struct {
+	InstanceId   string
+	AclEntryList string
+}
+
+type QueryMseAclResponse struct {
+	common.Response
+	Success bool
+	Message string
+	Data    struct {
+		Acls []struct {
+			Ip string
+		}
+	}
+}
+
+type AddMseGatewayArgs struct {
+	Region                  string
+	Name                    string
+	Vpc                     string
+	VSwitchId               string
+	BackupVSwitchId         string
+	Spec                    string
+	Replica                 int
+	SlbSpec                 string
+	InternetSlbSpec         string
+	EnterpriseSecurityGroup bool
+}
+
+type AddMseGatewayResponse struct {
+	common.Response
+	Success bool
+	Message string
+	Data    struct {
+		GatewayUniqueId string
+	}
+}
+
+// ListMseGatewayArgs is used by the ListGateway, and the FilterParams is the JSON of the filters, like
+// {"GatewayUniqueId":"gw-xxx"}.
+type ListMseGatewayArgs struct {
+	FilterParams string
+	PageNumber   int
+	PageSize     int
+}
+
+type MseGateway struct {
+	GatewayUniqueId string
+	Name            string
+	Status          int
+	StatusDesc      string
+	Vpc             string
+	Vswitch         string
+	Vswitch2        string
+	Spec            string
+	Replica         int
+	SecurityGroup   string
+}
+
+type ListMseGatewayResponse struct {
+	common.Response
+	Success bool
+	Message string
+	Data    struct {
+		TotalSize int
+		Result    []MseGateway
+	}
+}
+
+type UpdateMseGatewayNameArgs struct {
+	GatewayUniqueId string
+	Name            string
+}
+
+type DeleteMseGatewayArgs struct {
+	GatewayUniqueId string
+	DeleteSlb       bool
+}
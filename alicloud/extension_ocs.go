@@ -0,0 +1,105 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	OcsEndpoint   = "https://m-kvstore.aliyuncs.com"
+	OcsApiVersion = "2015-03-01"
+)
+
+type OcsInstanceStatus string
+
+const (
+	OcsNormal   = OcsInstanceStatus("Normal")
+	OcsCreating = OcsInstanceStatus("Creating")
+	OcsChanging = OcsInstanceStatus("Changing")
+	OcsDeleting = OcsInstanceStatus("Deleting")
+)
+
+// Memcache capacity in MB
+var OcsCapacities = []int{1024, 2048, 4096, 8192, 16384, 32768, 65536}
+
+type CreateOcsInstanceArgs struct {
+	RegionId     common.Region
+	ZoneId       string
+	InstanceName string
+	Capacity     int
+	Password     string
+	NetworkType  string
+	VpcId        string
+	VSwitchId    string
+	Token        string
+}
+
+type CreateOcsInstanceResponse struct {
+	common.Response
+	Instance OcsInstance
+}
+
+type OcsInstanceArgs struct {
+	RegionId   common.Region
+	InstanceId string
+}
+
+type DescribeOcsInstancesArgs struct {
+	RegionId    common.Region
+	InstanceIds string
+	common.Pagination
+}
+
+type OcsInstance struct {
+	InstanceId       string
+	InstanceName     string
+	InstanceStatus   OcsInstanceStatus
+	ZoneId           string
+	Capacity         int
+	Bandwidth        int
+	Connections      int
+	ConnectionDomain string
+	Port             int
+	NetworkType      string
+	VpcId            string
+	VSwitchId        string
+	PrivateIpAddress string
+}
+
+type DescribeOcsInstancesResponse struct {
+	common.Response
+	common.PaginationResult
+	Instances struct {
+		OcsInstance []OcsInstance
+	}
+}
+
+type ModifyOcsInstanceAttributeArgs struct {
+	RegionId     common.Region
+	InstanceId   string
+	InstanceName string
+	NewPassword  string
+}
+
+type ModifyOcsInstanceCapacityArgs struct {
+	RegionId   common.Region
+	InstanceId string
+	Capacity   int
+}
+
+type DescribeOcsAuthenticIPResponse struct {
+	common.Response
+	AuthenticIPs struct {
+		IP []string
+	}
+}
+
+type OcsAuthenticIPArgs struct {
+	RegionId    common.Region
+	InstanceId  string
+	AuthenticIP string
+}
+
+// OcsResponse is used by the actions which only return a RequestId
+type OcsResponse struct {
+	common.Response
+}
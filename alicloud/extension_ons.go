@@ -0,0 +1,115 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	OnsEndpointTemplate = "https://ons.%s.aliyuncs.com"
+	OnsApiVersion       = "2019-02-14"
+)
+
+type OnsInstanceStatus int
+
+const (
+	OnsInstanceDeploying = OnsInstanceStatus(0)
+	OnsInstanceExpired   = OnsInstanceStatus(2)
+	OnsInstanceReleased  = OnsInstanceStatus(5)
+	OnsInstanceRunning   = OnsInstanceStatus(7)
+)
+
+// OnsMessageTypes maps the message type names to the codes of ONS.
+var OnsMessageTypes = map[string]int{
+	"normal":          0,
+	"partition_order": 1,
+	"order":           2,
+	"transaction":     4,
+	"delay":           5,
+}
+
+// OnsTopicPerms maps the topic permission names to the codes of ONS.
+var OnsTopicPerms = map[string]int{
+	"sub":     2,
+	"pub":     4,
+	"pub_sub": 6,
+}
+
+var OnsGroupTypes = []string{"tcp", "http"}
+
+type OnsInstanceArgs struct {
+	InstanceId   string
+	InstanceName string
+	Remark       string
+}
+
+type CreateOnsInstanceResponse struct {
+	common.Response
+	Data struct {
+		InstanceId   string
+		InstanceType int
+	}
+}
+
+type OnsInstance struct {
+	InstanceId     string
+	InstanceName   string
+	InstanceStatus OnsInstanceStatus
+	InstanceType   int
+	Remark         string
+	ReleaseTime    int64
+	Endpoints      struct {
+		TcpEndpoint          string
+		HttpInternetEndpoint string
+		HttpInternalEndpoint string
+	}
+}
+
+type DescribeOnsInstanceResponse struct {
+	common.Response
+	InstanceBaseInfo OnsInstance
+}
+
+type OnsTopicArgs struct {
+	InstanceId  string
+	Topic       string
+	MessageType int
+	Remark      string
+	Perm        int
+}
+
+type OnsTopic struct {
+	InstanceId  string
+	Topic       string
+	MessageType int
+	Remark      string
+	Perm        int
+}
+
+type DescribeOnsTopicsResponse struct {
+	common.Response
+	Data struct {
+		PublishInfoDo []OnsTopic
+	}
+}
+
+type OnsGroupArgs struct {
+	InstanceId string
+	GroupId    string
+	GroupType  string
+	Remark     string
+	ReadEnable string
+}
+
+type OnsGroup struct {
+	InstanceId string
+	GroupId    string
+	GroupType  string
+	Remark     string
+}
+
+type DescribeOnsGroupsResponse struct {
+	common.Response
+	Data struct {
+		SubscribeInfoDo []OnsGroup
+	}
+}
@@ -0,0 +1,95 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	OosEndpointTemplate = "https://oos.%s.aliyuncs.com"
+	OosApiVersion       = "2019-06-01"
+)
+
+// The statuses of the OOS executions, and the Success, the Failed and the Cancelled ones are finished.
+const (
+	OosExecutionRunning   = "Running"
+	OosExecutionWaiting   = "Waiting"
+	OosExecutionSuccess   = "Success"
+	OosExecutionFailed    = "Failed"
+	OosExecutionCancelled = "Cancelled"
+)
+
+var OosExecutionModes = []string{"Automatic", "Debug"}
+var OosSafetyChecks = []string{"ConfirmEveryHighRiskAction", "Skip"}
+
+// OosTemplateArgs is used by the CreateTemplate, GetTemplate, UpdateTemplate and DeleteTemplate, and the Content
+// is the JSON or the YAML of the template.
+type OosTemplateArgs struct {
+	RegionId        common.Region
+	TemplateName    string
+	TemplateVersion string
+	VersionName     string
+	Content         string
+}
+
+type OosTemplate struct {
+	TemplateId      string
+	TemplateName    string
+	TemplateVersion string
+	TemplateFormat  string
+	TemplateType    string
+	Description     string
+	Hash            string
+	CreatedBy       string
+	CreatedDate     string
+}
+
+type OosTemplateResponse struct {
+	common.Response
+	Content  string
+	Template OosTemplate
+}
+
+// StartOosExecutionArgs is used by the StartExecution, and the Parameters is a JSON object of the parameters
+// of the template.
+type StartOosExecutionArgs struct {
+	RegionId        common.Region
+	TemplateName    string
+	TemplateVersion string
+	Mode            string
+	Parameters      string
+	SafetyCheck     string
+	Description     string
+}
+
+type OosExecution struct {
+	ExecutionId     string
+	TemplateName    string
+	TemplateVersion string
+	Mode            string
+	SafetyCheck     string
+	Status          string
+	StatusMessage   string
+	Description     string
+	Parameters      map[string]interface{}
+	Outputs         map[string]interface{}
+	StartDate       string
+	EndDate         string
+}
+
+type StartOosExecutionResponse struct {
+	common.Response
+	Execution OosExecution
+}
+
+// OosExecutionArgs is used by the ListExecutions and CancelExecution, and the DeleteExecutions deletes the
+// executions in the ExecutionIds which is a JSON array.
+type OosExecutionArgs struct {
+	RegionId     common.Region
+	ExecutionId  string
+	ExecutionIds string
+}
+
+type ListOosExecutionsResponse struct {
+	common.Response
+	Executions []OosExecution
+}
@@ -0,0 +1,254 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	PolarDBEndpoint   = "https://polardb.aliyuncs.com"
+	PolarDBApiVersion = "2017-08-01"
+)
+
+type PolarDBStatus string
+
+const (
+	PolarDBRunning        = PolarDBStatus("Running")
+	PolarDBCreating       = PolarDBStatus("Creating")
+	PolarDBClassChanging  = PolarDBStatus("ClassChanging")
+	PolarDBNodeCreating   = PolarDBStatus("NodeCreating")
+	PolarDBNodeDeleting   = PolarDBStatus("NodeDeleting")
+	PolarDBAvailable      = PolarDBStatus("Available")
+	PolarDBEndpointActive = PolarDBStatus("Active")
+)
+
+var PolarDBTypes = []string{"MySQL", "PostgreSQL", "Oracle"}
+
+type CreatePolarDBClusterArgs struct {
+	RegionId             common.Region
+	ZoneId               string
+	DBType               string
+	DBVersion            string
+	DBNodeClass          string
+	PayType              string
+	Period               string
+	UsedTime             string
+	DBClusterDescription string
+	ClusterNetworkType   string
+	VPCId                string
+	VSwitchId            string
+	SecurityIPList       string
+	ClientToken          string
+}
+
+type CreatePolarDBClusterResponse struct {
+	common.Response
+	DBClusterId string
+	OrderId     string
+}
+
+type PolarDBClusterArgs struct {
+	RegionId    common.Region
+	DBClusterId string
+}
+
+type PolarDBNode struct {
+	DBNodeId    string
+	DBNodeClass string
+	DBNodeRole  string
+	ZoneId      string
+}
+
+type PolarDBCluster struct {
+	DBClusterId          string
+	DBClusterDescription string
+	DBClusterStatus      PolarDBStatus
+	DBType               string
+	DBVersion            string
+	PayType              string
+	ZoneIds              string
+	VPCId                string
+	VSwitchId            string
+	MaintainTime         string
+	DBNodes              []PolarDBNode
+}
+
+type DescribePolarDBClusterAttributeResponse struct {
+	common.Response
+	PolarDBCluster
+}
+
+type ModifyPolarDBClusterDescriptionArgs struct {
+	RegionId             common.Region
+	DBClusterId          string
+	DBClusterDescription string
+}
+
+type ModifyPolarDBClusterMaintainTimeArgs struct {
+	RegionId     common.Region
+	DBClusterId  string
+	MaintainTime string
+}
+
+type ModifyPolarDBAccessWhitelistArgs struct {
+	RegionId             common.Region
+	DBClusterId          string
+	DBClusterIPArrayName string
+	SecurityIps          string
+}
+
+type DescribePolarDBAccessWhitelistResponse struct {
+	common.Response
+	Items struct {
+		DBClusterIPArray []struct {
+			DBClusterIPArrayName string
+			SecurityIps          string
+		}
+	}
+}
+
+type ModifyPolarDBNodeClassArgs struct {
+	RegionId          common.Region
+	DBClusterId       string
+	ModifyType        string
+	DBNodeTargetClass string
+}
+
+type AddPolarDBNodesArgs struct {
+	RegionId    common.Region
+	DBClusterId string
+	DBNode      []struct {
+		TargetClass string
+	}
+}
+
+type DeletePolarDBNodesArgs struct {
+	RegionId    common.Region
+	DBClusterId string
+	DBNodeId    []string
+}
+
+type PolarDBEndpointArgs struct {
+	RegionId     common.Region
+	DBClusterId  string
+	DBEndpointId string
+}
+
+type CreatePolarDBEndpointArgs struct {
+	RegionId        common.Region
+	DBClusterId     string
+	EndpointType    string
+	Nodes           string
+	ReadWriteMode   string
+	AutoAddNewNodes string
+	EndpointConfig  string
+}
+
+type ModifyPolarDBEndpointArgs struct {
+	RegionId        common.Region
+	DBClusterId     string
+	DBEndpointId    string
+	Nodes           string
+	ReadWriteMode   string
+	AutoAddNewNodes string
+	EndpointConfig  string
+}
+
+type PolarDBEndpoint struct {
+	DBEndpointId    string
+	EndpointType    string
+	Nodes           string
+	ReadWriteMode   string
+	AutoAddNewNodes string
+	EndpointConfig  string
+	AddressItems    []struct {
+		ConnectionString string
+		Port             string
+		NetType          string
+	}
+}
+
+type DescribePolarDBEndpointsResponse struct {
+	common.Response
+	Items []PolarDBEndpoint
+}
+
+type CreatePolarDBAccountArgs struct {
+	RegionId           common.Region
+	DBClusterId        string
+	AccountName        string
+	AccountPassword    string
+	AccountType        string
+	AccountDescription string
+}
+
+type PolarDBAccountArgs struct {
+	RegionId    common.Region
+	DBClusterId string
+	AccountName string
+}
+
+type ModifyPolarDBAccountDescriptionArgs struct {
+	RegionId           common.Region
+	DBClusterId        string
+	AccountName        string
+	AccountDescription string
+}
+
+type ModifyPolarDBAccountPasswordArgs struct {
+	RegionId           common.Region
+	DBClusterId        string
+	AccountName        string
+	NewAccountPassword string
+}
+
+type PolarDBAccount struct {
+	AccountName        string
+	AccountStatus      PolarDBStatus
+	AccountType        string
+	AccountDescription string
+}
+
+type DescribePolarDBAccountsResponse struct {
+	common.Response
+	Accounts []PolarDBAccount
+}
+
+type CreatePolarDBDatabaseArgs struct {
+	RegionId         common.Region
+	DBClusterId      string
+	DBName           string
+	CharacterSetName string
+	DBDescription    string
+}
+
+type PolarDBDatabaseArgs struct {
+	RegionId    common.Region
+	DBClusterId string
+	DBName      string
+}
+
+type ModifyPolarDBDatabaseDescriptionArgs struct {
+	RegionId      common.Region
+	DBClusterId   string
+	DBName        string
+	DBDescription string
+}
+
+type PolarDBDatabase struct {
+	DBName           string
+	DBStatus         PolarDBStatus
+	CharacterSetName string
+	DBDescription    string
+}
+
+type DescribePolarDBDatabasesResponse struct {
+	common.Response
+	Databases struct {
+		Database []PolarDBDatabase
+	}
+}
+
+// PolarDBResponse is used by the actions which only return a RequestId
+type PolarDBResponse struct {
+	common.Response
+}
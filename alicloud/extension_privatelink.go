@@ -0,0 +1,217 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	PrivateLinkEndpointTemplate = "https://privatelink.%s.aliyuncs.com"
+	PrivateLinkApiVersion       = "2020-04-15"
+)
+
+// The status of the endpoint services and endpoints.
+const (
+	PrivateLinkCreating = "Creating"
+	PrivateLinkPending  = "Pending"
+	PrivateLinkActive   = "Active"
+	PrivateLinkDeleting = "Deleting"
+)
+
+// The status of the zones of an endpoint. The zone stays in Wait until the connection is accepted by the
+// endpoint service.
+const (
+	PrivateLinkZoneCreating  = "Creating"
+	PrivateLinkZoneWait      = "Wait"
+	PrivateLinkZoneConnected = "Connected"
+	PrivateLinkZoneDeleting  = "Deleting"
+)
+
+const PrivateLinkResourceTypeSlb = "slb"
+
+// The Payer decides which side pays for the connections between the endpoints and the endpoint service.
+const (
+	PrivateLinkPayerEndpoint        = "Endpoint"
+	PrivateLinkPayerEndpointService = "EndpointService"
+)
+
+type PrivateLinkServiceResource struct {
+	ResourceType string
+	ResourceId   string
+}
+
+type CreateVpcEndpointServiceArgs struct {
+	RegionId           common.Region
+	ClientToken        string
+	ServiceDescription string
+	AutoAcceptEnabled  bool
+	Payer              string
+	Resource           []PrivateLinkServiceResource
+}
+
+type CreateVpcEndpointServiceResponse struct {
+	common.Response
+	ServiceId     string
+	ServiceName   string
+	ServiceDomain string
+	ServiceStatus string
+}
+
+type PrivateLinkServiceArgs struct {
+	RegionId  common.Region
+	ServiceId string
+}
+
+type VpcEndpointServiceAttribute struct {
+	common.Response
+	ServiceId             string
+	ServiceName           string
+	ServiceDomain         string
+	ServiceDescription    string
+	ServiceStatus         string
+	ServiceBusinessStatus string
+	AutoAcceptEnabled     bool
+	Payer                 string
+	ConnectBandwidth      int
+}
+
+type UpdateVpcEndpointServiceAttributeArgs struct {
+	RegionId           common.Region
+	ServiceId          string
+	ServiceDescription string
+	AutoAcceptEnabled  bool
+	ConnectBandwidth   int
+}
+
+type PrivateLinkServiceResourceArgs struct {
+	RegionId     common.Region
+	ServiceId    string
+	ResourceType string
+	ResourceId   string
+}
+
+type PrivateLinkServiceUserArgs struct {
+	RegionId  common.Region
+	ServiceId string
+	UserId    string
+}
+
+// PrivateLinkListArgs is used by the List* APIs, which are paged by the NextToken of the previous response.
+type PrivateLinkListArgs struct {
+	RegionId   common.Region
+	ServiceId  string
+	EndpointId string
+	NextToken  string
+	MaxResults int
+}
+
+type ListVpcEndpointServiceResourcesResponse struct {
+	common.Response
+	NextToken string
+	Resources []struct {
+		ResourceId   string
+		ResourceType string
+		VpcId        string
+		VSwitchId    string
+		ZoneId       string
+		Ip           string
+	}
+}
+
+type ListVpcEndpointServiceUsersResponse struct {
+	common.Response
+	NextToken string
+	Users     []struct {
+		UserId int64
+	}
+}
+
+type PrivateLinkEndpointZone struct {
+	ZoneId    string
+	VSwitchId string
+}
+
+// CreateVpcEndpointArgs is used by the CreateVpcEndpoint, and only one of the ServiceId and ServiceName is
+// needed to find the endpoint service.
+type CreateVpcEndpointArgs struct {
+	RegionId            common.Region
+	ClientToken         string
+	VpcId               string
+	ServiceId           string
+	ServiceName         string
+	EndpointName        string
+	EndpointDescription string
+	SecurityGroupId     []string
+	Zone                []PrivateLinkEndpointZone
+}
+
+type CreateVpcEndpointResponse struct {
+	common.Response
+	EndpointId     string
+	EndpointName   string
+	EndpointStatus string
+	EndpointDomain string
+}
+
+type PrivateLinkEndpointArgs struct {
+	RegionId   common.Region
+	EndpointId string
+}
+
+type VpcEndpointAttribute struct {
+	common.Response
+	EndpointId             string
+	EndpointName           string
+	EndpointDescription    string
+	EndpointDomain         string
+	EndpointStatus         string
+	EndpointBusinessStatus string
+	ConnectionStatus       string
+	VpcId                  string
+	ServiceId              string
+	ServiceName            string
+	Bandwidth              int
+}
+
+type UpdateVpcEndpointAttributeArgs struct {
+	RegionId            common.Region
+	EndpointId          string
+	EndpointName        string
+	EndpointDescription string
+}
+
+type PrivateLinkEndpointSecurityGroupArgs struct {
+	RegionId        common.Region
+	EndpointId      string
+	SecurityGroupId string
+}
+
+type ListVpcEndpointSecurityGroupsResponse struct {
+	common.Response
+	NextToken      string
+	SecurityGroups []struct {
+		SecurityGroupId string
+	}
+}
+
+type PrivateLinkEndpointZoneArgs struct {
+	RegionId    common.Region
+	ClientToken string
+	EndpointId  string
+	ZoneId      string
+	VSwitchId   string
+}
+
+type VpcEndpointZone struct {
+	ZoneId     string
+	VSwitchId  string
+	EniId      string
+	EniIp      string
+	ZoneDomain string
+	ZoneStatus string
+}
+
+type ListVpcEndpointZonesResponse struct {
+	common.Response
+	NextToken string
+	Zones     []VpcEndpointZone
+}
@@ -0,0 +1,97 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	QuotasEndpoint   = "https://quotas.aliyuncs.com"
+	QuotasApiVersion = "2020-05-10"
+)
+
+// The product codes of the quotas, like the ECS instances, the EIPs and the SLB instances per region.
+const (
+	QuotasProductEcs = "ecs"
+	QuotasProductVpc = "vpc"
+	QuotasProductSlb = "slb"
+)
+
+// QuotaDimension narrows a quota down, like the regionId dimension for the quotas per region.
+type QuotaDimension struct {
+	Key   string
+	Value string
+}
+
+type ListProductQuotasArgs struct {
+	ProductCode     string
+	QuotaActionCode string
+	Dimensions      []QuotaDimension
+	NextToken       string
+	MaxResults      int
+}
+
+type ProductQuota struct {
+	QuotaActionCode  string
+	QuotaName        string
+	QuotaDescription string
+	QuotaUnit        string
+	QuotaArn         string
+	TotalQuota       float64
+	TotalUsage       float64
+	Adjustable       bool
+	Dimensions       map[string]string
+}
+
+type ListProductQuotasResponse struct {
+	common.Response
+	Quotas    []ProductQuota
+	NextToken string
+}
+
+// The thresholds are strings, as the quota alarms are triggered by either the Threshold or the ThresholdPercent,
+// and the zero one must not be sent.
+
+type CreateQuotaAlarmArgs struct {
+	AlarmName        string
+	ProductCode      string
+	QuotaActionCode  string
+	QuotaDimensions  []QuotaDimension
+	Threshold        string
+	ThresholdPercent string
+	WebHook          string
+}
+
+type CreateQuotaAlarmResponse struct {
+	common.Response
+	AlarmId string
+}
+
+type UpdateQuotaAlarmArgs struct {
+	AlarmId          string
+	AlarmName        string
+	Threshold        string
+	ThresholdPercent string
+	WebHook          string
+}
+
+type QuotaAlarmArgs struct {
+	AlarmId string
+}
+
+type QuotaAlarm struct {
+	AlarmId          string
+	AlarmName        string
+	ProductCode      string
+	QuotaActionCode  string
+	QuotaDimensions  map[string]string
+	Threshold        float64
+	ThresholdPercent float64
+	WebHook          string
+	QuotaValue       float64
+	QuotaUsage       float64
+}
+
+type GetQuotaAlarmResponse struct {
+	common.Response
+	QuotaAlarm QuotaAlarm
+}
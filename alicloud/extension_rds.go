@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+type DescribeDBInstancesArgs struct {
+	RegionId         common.Region
+	Engine           string
+	DBInstanceStatus string
+	VpcId            string
+	VSwitchId        string
+	PageSize         int
+	PageNumber       int
+}
+
+type DBInstanceItem struct {
+	DBInstanceId          string
+	DBInstanceDescription string
+	DBInstanceStatus      string
+	DBInstanceType        string
+	Engine                string
+	EngineVersion         string
+	RegionId              string
+	ZoneId                string
+	PayType               string
+	DBInstanceNetType     string
+	InstanceNetworkType   string
+	VpcId                 string
+	VSwitchId             string
+	CreateTime            string
+	ExpireTime            string
+	MasterInstanceId      string
+}
+
+type DescribeDBInstancesResponse struct {
+	common.Response
+	TotalRecordCount int
+	PageNumber       int
+	Items            struct {
+		DBInstance []DBInstanceItem
+	}
+}
+
+type DescribeRdsAvailableResourceArgs struct {
+	RegionId           common.Region
+	ZoneId             string
+	InstanceChargeType string
+}
+
+type RdsStorageRange struct {
+	Min  int
+	Max  int
+	Step int
+}
+
+type RdsAvailableResource struct {
+	DBInstanceClass        string
+	DBInstanceStorageRange RdsStorageRange
+}
+
+type RdsSupportedStorageType struct {
+	StorageType        string
+	AvailableResources struct {
+		AvailableResource []RdsAvailableResource
+	}
+}
+
+type RdsSupportedCategory struct {
+	Category              string
+	SupportedStorageTypes struct {
+		SupportedStorageType []RdsSupportedStorageType
+	}
+}
+
+type RdsSupportedEngineVersion struct {
+	Version            string
+	SupportedCategorys struct {
+		SupportedCategory []RdsSupportedCategory
+	}
+}
+
+type RdsSupportedEngine struct {
+	Engine                  string
+	SupportedEngineVersions struct {
+		SupportedEngineVersion []RdsSupportedEngineVersion
+	}
+}
+
+type RdsAvailableZone struct {
+	ZoneId           string
+	Status           string
+	SupportedEngines struct {
+		SupportedEngine []RdsSupportedEngine
+	}
+}
+
+type DescribeRdsAvailableResourceResponse struct {
+	common.Response
+	AvailableZones struct {
+		AvailableZone []RdsAvailableZone
+	}
+}
+
+type UpgradeDBInstanceEngineVersionArgs struct {
+	RegionId      common.Region
+	DBInstanceId  string
+	EngineVersion string
+	EffectiveTime string
+}
+
+type UpgradeDBInstanceEngineVersionResponse struct {
+	common.Response
+	TaskId string
+}
+
+const (
+	RdsEffectiveImmediately   = "Immediate"
+	RdsEffectiveInMaintenance = "MaintainTime"
+)
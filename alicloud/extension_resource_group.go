@@ -0,0 +1,89 @@
+package alicloud
+
+import "github.com/denverdino/aliyungo/common"
+
+// ResourceGroupResourceType is the type of the resources which can be moved into a resource group.
+type ResourceGroupResourceType string
+
+const (
+	ResourceGroupResourceInstance     = ResourceGroupResourceType("instance")
+	ResourceGroupResourceVpc          = ResourceGroupResourceType("vpc")
+	ResourceGroupResourceLoadBalancer = ResourceGroupResourceType("loadbalancer")
+	ResourceGroupResourceDBInstance   = ResourceGroupResourceType("dbinstance")
+)
+
+// JoinResourceGroupArgs is used by the ECS JoinResourceGroup.
+type JoinResourceGroupArgs struct {
+	RegionId        common.Region
+	ResourceGroupId string
+	ResourceId      string
+	ResourceType    ResourceGroupResourceType
+}
+
+// MoveResourceGroupArgs is used by the VPC and SLB MoveResourceGroup.
+type MoveResourceGroupArgs struct {
+	RegionId           common.Region
+	ResourceId         string
+	NewResourceGroupId string
+	ResourceType       ResourceGroupResourceType
+}
+
+type ModifyDBInstanceResourceGroupArgs struct {
+	RegionId        common.Region
+	DBInstanceId    string
+	ResourceGroupId string
+}
+
+// The following args and responses only describe the resource group of the resources, which is not
+// in the responses of the SDK.
+
+type DescribeInstanceResourceGroupArgs struct {
+	RegionId    common.Region
+	InstanceIds string
+}
+
+type DescribeInstanceResourceGroupResponse struct {
+	common.Response
+	Instances struct {
+		Instance []struct {
+			ResourceGroupId string
+		}
+	}
+}
+
+type DescribeVpcResourceGroupArgs struct {
+	RegionId common.Region
+	VpcId    string
+}
+
+type DescribeVpcResourceGroupResponse struct {
+	common.Response
+	Vpcs struct {
+		Vpc []struct {
+			ResourceGroupId string
+		}
+	}
+}
+
+type DescribeLoadBalancerResourceGroupArgs struct {
+	RegionId       common.Region
+	LoadBalancerId string
+}
+
+type DescribeLoadBalancerResourceGroupResponse struct {
+	common.Response
+	ResourceGroupId string
+}
+
+type DescribeDBInstanceResourceGroupArgs struct {
+	DBInstanceId string
+}
+
+type DescribeDBInstanceResourceGroupResponse struct {
+	common.Response
+	Items struct {
+		DBInstanceAttribute []struct {
+			ResourceGroupId string
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	RosEndpoint   = "https://ros.aliyuncs.com"
+	RosApiVersion = "2019-09-10"
+)
+
+type RosStackStatus string
+
+const (
+	RosStackCreateInProgress = RosStackStatus("CREATE_IN_PROGRESS")
+	RosStackCreateComplete   = RosStackStatus("CREATE_COMPLETE")
+	RosStackCreateFailed     = RosStackStatus("CREATE_FAILED")
+	RosStackUpdateInProgress = RosStackStatus("UPDATE_IN_PROGRESS")
+	RosStackUpdateComplete   = RosStackStatus("UPDATE_COMPLETE")
+	RosStackUpdateFailed     = RosStackStatus("UPDATE_FAILED")
+	RosStackDeleteInProgress = RosStackStatus("DELETE_IN_PROGRESS")
+	RosStackDeleteComplete   = RosStackStatus("DELETE_COMPLETE")
+	RosStackDeleteFailed     = RosStackStatus("DELETE_FAILED")
+	RosStackRollbackComplete = RosStackStatus("ROLLBACK_COMPLETE")
+	RosStackRollbackFailed   = RosStackStatus("ROLLBACK_FAILED")
+)
+
+type RosStackParameter struct {
+	ParameterKey   string
+	ParameterValue string
+}
+
+type RosStackArgs struct {
+	RegionId         common.Region
+	StackId          string
+	StackName        string
+	TemplateBody     string
+	TemplateURL      string
+	Parameters       []RosStackParameter
+	TimeoutInMinutes int
+	DisableRollback  bool
+	StackPolicyBody  string
+	ClientToken      string
+}
+
+type CreateRosStackResponse struct {
+	common.Response
+	StackId string
+}
+
+type RosStackOutput struct {
+	OutputKey   string
+	OutputValue interface{}
+	Description string
+}
+
+type RosStack struct {
+	common.Response
+	StackId          string
+	StackName        string
+	Status           RosStackStatus
+	StatusReason     string
+	TimeoutInMinutes int
+	DisableRollback  bool
+	Parameters       []RosStackParameter
+	Outputs          []RosStackOutput
+}
+
+type GetRosTemplateResponse struct {
+	common.Response
+	TemplateBody string
+}
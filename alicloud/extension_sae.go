@@ -0,0 +1,130 @@
+package alicloud
+
+const (
+	SaeEndpointTemplate = "https://sae.%s.aliyuncs.com"
+	SaeApiVersion       = "2019-05-06"
+)
+
+var SaePackageTypes = []string{"Image", "FatJar", "War"}
+var SaeScalingRuleTypes = []string{"timing", "metric"}
+var SaeSlbProtocols = []string{"TCP", "HTTP", "HTTPS"}
+
+// The SAE API is RESTful and most of the arguments are sent as the query, so only the responses are
+// defined here, and all of them are wrapped by the 'Data' of the SaeResponse.
+
+type SaeResponse struct {
+	RequestId string `json:"RequestId"`
+	Code      string `json:"Code"`
+	Message   string `json:"Message"`
+	Success   bool   `json:"Success"`
+	ErrorCode string `json:"ErrorCode"`
+}
+
+type SaeNamespace struct {
+	NamespaceId          string `json:"NamespaceId"`
+	NamespaceName        string `json:"NamespaceName"`
+	NamespaceDescription string `json:"NamespaceDescription"`
+	RegionId             string `json:"RegionId"`
+}
+
+type SaeNamespaceResponse struct {
+	SaeResponse
+	Data SaeNamespace `json:"Data"`
+}
+
+type SaeChangeOrderResponse struct {
+	SaeResponse
+	Data struct {
+		AppId         string `json:"AppId"`
+		ChangeOrderId string `json:"ChangeOrderId"`
+	} `json:"Data"`
+}
+
+type SaeChangeOrderStatus int
+
+const (
+	SaeChangeOrderPreparing  = SaeChangeOrderStatus(0)
+	SaeChangeOrderExecuting  = SaeChangeOrderStatus(1)
+	SaeChangeOrderSuccess    = SaeChangeOrderStatus(2)
+	SaeChangeOrderFailed     = SaeChangeOrderStatus(3)
+	SaeChangeOrderTerminated = SaeChangeOrderStatus(6)
+)
+
+type SaeChangeOrder struct {
+	ChangeOrderId string               `json:"ChangeOrderId"`
+	Status        SaeChangeOrderStatus `json:"Status"`
+	Description   string               `json:"Description"`
+}
+
+type DescribeSaeChangeOrderResponse struct {
+	SaeResponse
+	Data SaeChangeOrder `json:"Data"`
+}
+
+type SaeApplication struct {
+	AppId           string `json:"AppId"`
+	AppName         string `json:"AppName"`
+	AppDescription  string `json:"AppDescription"`
+	NamespaceId     string `json:"NamespaceId"`
+	PackageType     string `json:"PackageType"`
+	ImageUrl        string `json:"ImageUrl"`
+	PackageUrl      string `json:"PackageUrl"`
+	PackageVersion  string `json:"PackageVersion"`
+	Jdk             string `json:"Jdk"`
+	Command         string `json:"Command"`
+	Envs            string `json:"Envs"`
+	Replicas        int    `json:"Replicas"`
+	Cpu             int    `json:"Cpu"`
+	Memory          int    `json:"Memory"`
+	VpcId           string `json:"VpcId"`
+	VSwitchId       string `json:"VSwitchId"`
+	SecurityGroupId string `json:"SecurityGroupId"`
+}
+
+type DescribeSaeApplicationResponse struct {
+	SaeResponse
+	Data SaeApplication `json:"Data"`
+}
+
+// SaeEnv is an item of the application 'Envs' which is a JSON string.
+type SaeEnv struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type SaeScalingRule struct {
+	ScalingRuleName   string `json:"ScalingRuleName"`
+	ScalingRuleType   string `json:"ScalingRuleType"`
+	ScalingRuleEnable bool   `json:"ScalingRuleEnable"`
+	// The timer and metric are JSON strings when they are sent, but they are objects in the response.
+	Timer  interface{} `json:"Timer"`
+	Metric interface{} `json:"Metric"`
+}
+
+type DescribeSaeScalingRulesResponse struct {
+	SaeResponse
+	Data struct {
+		ApplicationScalingRules []SaeScalingRule `json:"ApplicationScalingRules"`
+	} `json:"Data"`
+}
+
+// SaeSlbListener is an item of the 'Internet' and 'Intranet' of the SLB binding which are JSON strings.
+type SaeSlbListener struct {
+	Port       int    `json:"port"`
+	TargetPort int    `json:"targetPort"`
+	Protocol   string `json:"protocol"`
+}
+
+type SaeApplicationSlb struct {
+	InternetSlbId string           `json:"InternetSlbId"`
+	InternetIp    string           `json:"InternetIp"`
+	Internet      []SaeSlbListener `json:"Internet"`
+	IntranetSlbId string           `json:"IntranetSlbId"`
+	IntranetIp    string           `json:"IntranetIp"`
+	Intranet      []SaeSlbListener `json:"Intranet"`
+}
+
+type DescribeSaeApplicationSlbResponse struct {
+	SaeResponse
+	Data SaeApplicationSlb `json:"Data"`
+}
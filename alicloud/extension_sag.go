@@ -0,0 +1,213 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+const (
+	SagEndpointTemplate = "https://smartag.%s.aliyuncs.com"
+	SagApiVersion       = "2018-03-13"
+)
+
+type SagAclArgs struct {
+	RegionId common.Region
+	AclId    string
+	Name     string
+}
+
+type CreateSagAclResponse struct {
+	common.Response
+	AclId string
+	Name  string
+}
+
+type DescribeSagAclsArgs struct {
+	RegionId   common.Region
+	AclIds     string
+	PageNumber int
+	PageSize   int
+}
+
+type SagAcl struct {
+	AclId    string
+	Name     string
+	SagCount string
+}
+
+type DescribeSagAclsResponse struct {
+	common.Response
+	TotalCount int
+	Acls       struct {
+		Acl []SagAcl
+	}
+}
+
+// SagAclRuleArgs is used by the AddACLRule, ModifyACLRule and DeleteACLRule, and the AcrId is the id of the rule.
+type SagAclRuleArgs struct {
+	RegionId        common.Region
+	AclId           string
+	AcrId           string
+	Description     string
+	Policy          string
+	IpProtocol      string
+	SourceCidr      string
+	SourcePortRange string
+	DestCidr        string
+	DestPortRange   string
+	Direction       string
+	Priority        int
+}
+
+type AddSagAclRuleResponse struct {
+	common.Response
+	AcrId string
+}
+
+type DescribeSagAclAttributeArgs struct {
+	RegionId   common.Region
+	AclId      string
+	PageNumber int
+	PageSize   int
+}
+
+type SagAclRule struct {
+	AcrId           string
+	AclId           string
+	Description     string
+	Policy          string
+	IpProtocol      string
+	SourceCidr      string
+	SourcePortRange string
+	DestCidr        string
+	DestPortRange   string
+	Direction       string
+	Priority        int
+}
+
+type DescribeSagAclAttributeResponse struct {
+	common.Response
+	TotalCount int
+	Acrs       struct {
+		Acr []SagAclRule
+	}
+}
+
+type SagAclAssociationArgs struct {
+	RegionId  common.Region
+	AclId     string
+	SmartAGId string
+}
+
+type DescribeSmartAccessGatewayAttributeArgs struct {
+	RegionId  common.Region
+	SmartAGId string
+}
+
+type SmartAccessGatewayAttribute struct {
+	common.Response
+	SmartAGId       string
+	Name            string
+	Status          string
+	AssociatedCcnId string
+	AclIds          struct {
+		AclId []string
+	}
+}
+
+// SagClientUserArgs is used by the client user APIs of the smart access gateway apps, and the Password is
+// generated by the smart access gateway when it is empty.
+type SagClientUserArgs struct {
+	RegionId   common.Region
+	SmartAGId  string
+	UserName   string
+	UserMail   string
+	ClientIp   string
+	Bandwidth  int
+	Password   string
+	PageNumber int
+	PageSize   int
+}
+
+type SagClientUser struct {
+	UserName  string
+	UserMail  string
+	ClientIp  string
+	Bandwidth int
+	State     int
+}
+
+type DescribeSagClientUsersResponse struct {
+	common.Response
+	TotalCount int
+	Users      struct {
+		User []SagClientUser
+	}
+}
+
+type SagSnatEntryArgs struct {
+	RegionId   common.Region
+	SmartAGId  string
+	InstanceId string
+	CidrBlock  string
+	SnatIp     string
+	PageNumber int
+	PageSize   int
+}
+
+type AddSagSnatEntryResponse struct {
+	common.Response
+	InstanceId string
+}
+
+type SagSnatEntry struct {
+	InstanceId string
+	CidrBlock  string
+	SnatIp     string
+}
+
+type DescribeSagSnatEntriesResponse struct {
+	common.Response
+	TotalCount  int
+	SnatEntries struct {
+		SnatEntry []SagSnatEntry
+	}
+}
+
+// SagDnatEntryArgs is used by the DNAT entry APIs, which take the SagId rather than the SmartAGId.
+type SagDnatEntryArgs struct {
+	RegionId     common.Region
+	SagId        string
+	DnatEntryId  string
+	Type         string
+	IpProtocol   string
+	ExternalIp   string
+	ExternalPort string
+	InternalIp   string
+	InternalPort string
+	PageNumber   int
+	PageSize     int
+}
+
+type AddSagDnatEntryResponse struct {
+	common.Response
+	DnatEntryId string
+}
+
+type SagDnatEntry struct {
+	DnatEntryId  string
+	SagId        string
+	Type         string
+	IpProtocol   string
+	ExternalIp   string
+	ExternalPort string
+	InternalIp   string
+	InternalPort string
+}
+
+type DescribeSagDnatEntriesResponse struct {
+	common.Response
+	TotalCount  int
+	DnatEntries struct {
+		DnatEntry []SagDnatEntry
+	}
+}
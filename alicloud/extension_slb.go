@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/slb"
 )
 
@@ -162,3 +163,43 @@ func expandBackendServers(list []interface{}) []slb.BackendServerType {
 	}
 	return result
 }
+
+type DescribeSlbAvailableResourceArgs struct {
+	RegionId         common.Region
+	AddressType      string
+	AddressIPVersion string
+}
+
+type SlbSupportResource struct {
+	AddressType      string
+	AddressIPVersion string
+}
+
+// SlbAvailableResource is a pair of the master zone and the slave zone in which load balancers can be purchased.
+type SlbAvailableResource struct {
+	MasterZoneId     string
+	SlaveZoneId      string
+	SupportResources struct {
+		SupportResource []SlbSupportResource
+	}
+}
+
+type DescribeSlbAvailableResourceResponse struct {
+	common.Response
+	AvailableResources struct {
+		AvailableResource []SlbAvailableResource
+	}
+}
+
+// SlbSpecifications are the performance guaranteed specifications from the smallest to the largest. The API does
+// not report them, as they are sold in all the zones where the load balancers can be purchased.
+var SlbSpecifications = []string{
+	"slb.s1.small",
+	"slb.s2.small",
+	"slb.s2.medium",
+	"slb.s3.small",
+	"slb.s3.medium",
+	"slb.s3.large",
+	"slb.s3.xlarge",
+	"slb.s3.xxlarge",
+}
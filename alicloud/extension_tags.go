@@ -41,3 +41,76 @@ func RemoveTags(client *ecs.Client, args *RemoveTagsArgs) error {
 	}
 	return err
 }
+
+// The ECS AddTags and RemoveTags support the security group as well.
+const TagResourceSecurityGroup = ecs.TagResourceType("securitygroup")
+
+// VpcTagResourceType is the type of the resources tagged by the VPC TagResources.
+type VpcTagResourceType string
+
+const (
+	VpcTagResourceVpc     = VpcTagResourceType("VPC")
+	VpcTagResourceVSwitch = VpcTagResourceType("VSWITCH")
+	VpcTagResourceEip     = VpcTagResourceType("EIP")
+)
+
+type VpcTagResourcesArgs struct {
+	RegionId     common.Region
+	ResourceType VpcTagResourceType
+	ResourceId   []string
+	Tag          []Tag
+	TagKey       []string
+}
+
+type VpcTagResource struct {
+	ResourceId string
+	TagKey     string
+	TagValue   string
+}
+
+type ListVpcTagResourcesResponse struct {
+	common.Response
+	TagResources struct {
+		TagResource []VpcTagResource
+	}
+}
+
+type SlbTag struct {
+	TagKey   string
+	TagValue string
+}
+
+type SlbTagsArgs struct {
+	RegionId       common.Region
+	LoadBalancerId string
+	// Tags is a JSON string of []SlbTag.
+	Tags string
+}
+
+type DescribeSlbTagsResponse struct {
+	common.Response
+	TagSets struct {
+		TagSet []SlbTag
+	}
+}
+
+type RdsTag struct {
+	Key   string `ArgName:"key"`
+	Value string `ArgName:"value"`
+}
+
+type RdsTagsArgs struct {
+	RegionId     common.Region
+	DBInstanceId string
+	Tag          []RdsTag
+}
+
+type DescribeRdsTagsResponse struct {
+	common.Response
+	Items struct {
+		TagInfos []struct {
+			TagKey   string
+			TagValue string
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// DefaultSecurityGroupDescription is the description of the security group created by the system in the default VPC.
+const DefaultSecurityGroupDescription = "System created security group."
+
+type DescribeDefaultVpcArgs struct {
+	RegionId  common.Region
+	IsDefault bool
+}
+
+type DescribeDefaultVpcResponse struct {
+	common.Response
+	Vpcs struct {
+		Vpc []struct {
+			VpcId     string
+			IsDefault bool
+		}
+	}
+}
+
+type EipISP string
+
+const (
+	EipISPBGP    = EipISP("BGP")
+	EipISPBGPPro = EipISP("BGP_PRO")
+)
+
+// AllocateVpcEipAddressArgs is used by the AllocateEipAddress of the VPC API, which supports the ISP and the
+// PrePaid EIPs. The Period is in the PricingCycle, and the PrePaid EIPs are paid with the balance of the account.
+type AllocateVpcEipAddressArgs struct {
+	RegionId           common.Region
+	Bandwidth          string
+	InternetChargeType string
+	InstanceChargeType string
+	Period             int
+	PricingCycle       string
+	AutoPay            bool
+	ISP                string
+	ClientToken        string
+}
+
+type AllocateVpcEipAddressResponse struct {
+	common.Response
+	AllocationId string
+	EipAddress   string
+	OrderId      string
+}
+
+type DescribeVpcEipAddressesArgs struct {
+	RegionId     common.Region
+	AllocationId string
+}
+
+// VpcEipAddress is the EIP described by the VPC API. Its ChargeType is the instance charge type of the EIP.
+type VpcEipAddress struct {
+	AllocationId       string
+	IpAddress          string
+	Status             string
+	InstanceId         string
+	Bandwidth          string
+	InternetChargeType string
+	ChargeType         string
+	ISP                string
+	DeletionProtection bool
+	ExpiredTime        string
+}
+
+type DescribeVpcEipAddressesResponse struct {
+	common.Response
+	EipAddresses struct {
+		EipAddress []VpcEipAddress
+	}
+}
+
+// DeletionProtectionArgs is used by the DeletionProtection to turn on or off the deletion protection of the
+// instance of the Type, such as the EIP.
+type DeletionProtectionArgs struct {
+	RegionId         common.Region
+	InstanceId       string
+	Type             string
+	ProtectionEnable bool
+}
@@ -0,0 +1,75 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The route entries of the vpn gateways are normal until they are published to the route tables of the vpc.
+const (
+	VpnRouteEntryNormal    = "normal"
+	VpnRouteEntryPublished = "published"
+)
+
+// The destination-based routes are the ones of the vpn route entries, and the policy-based routes are the ones
+// of the vpn pbr route entries, which match the source cidr block as well.
+const (
+	VpnRouteTypeDbr = "dbr"
+	VpnRouteTypePbr = "pbr"
+)
+
+// VpnRouteEntryArgs is used by the route entry APIs of both the destination-based and policy-based routes, and
+// the RouteSource is only set for the policy-based ones. The NextHop is the id of the vpn connection, and the
+// weights are strings as the zero integers are left out of the queries.
+type VpnRouteEntryArgs struct {
+	RegionId     common.Region
+	VpnGatewayId string
+	RouteSource  string
+	RouteDest    string
+	NextHop      string
+	Weight       string
+	NewWeight    string
+	PublishVpc   bool
+	Description  string
+}
+
+type PublishVpnRouteEntryArgs struct {
+	RegionId     common.Region
+	VpnGatewayId string
+	RouteDest    string
+	NextHop      string
+	RouteType    string
+	PublishVpc   bool
+}
+
+type DescribeVpnRouteEntriesArgs struct {
+	RegionId     common.Region
+	VpnGatewayId string
+	PageNumber   int
+	PageSize     int
+}
+
+type VpnRouteEntry struct {
+	VpnInstanceId string
+	RouteSource   string
+	RouteDest     string
+	NextHop       string
+	Weight        int
+	State         string
+	Description   string
+}
+
+type DescribeVpnRouteEntriesResponse struct {
+	common.Response
+	TotalCount      int
+	VpnRouteEntries struct {
+		VpnRouteEntry []VpnRouteEntry
+	}
+}
+
+type DescribeVpnPbrRouteEntriesResponse struct {
+	common.Response
+	TotalCount         int
+	VpnPbrRouteEntries struct {
+		VpnPbrRouteEntry []VpnRouteEntry
+	}
+}
@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// The WAF API is only served in cn-hangzhou for the mainland instances and ap-southeast-1 for the others.
+const (
+	WafEndpointTemplate = "https://wafopenapi.%s.aliyuncs.com"
+	WafApiVersion       = "2019-09-10"
+)
+
+// The WAF instance is normal when its status is 1.
+const (
+	WafInstanceNormal = "1"
+)
+
+const (
+	WafClusterPhysical = "PhysicalCluster"
+	WafClusterVirtual  = "VirtualCluster"
+)
+
+var WafClusterTypes = map[string]int{
+	WafClusterPhysical: 0,
+	WafClusterVirtual:  1,
+}
+
+const (
+	WafProtectionModeBlock = "Block"
+	WafProtectionModeWarn  = "Warn"
+)
+
+var WafProtectionModes = map[string]int{
+	WafProtectionModeBlock: 0,
+	WafProtectionModeWarn:  1,
+}
+
+// The protection mode of the domain is the mode of the WAF protection module.
+const WafDefenseTypeWaf = "waf"
+
+type WafInstanceArgs struct {
+	InstanceId      string
+	ResourceGroupId string
+}
+
+type WafInstanceInfo struct {
+	InstanceId       string
+	Status           int
+	Version          string
+	Region           string
+	PayType          int
+	EndDate          int64
+	RemainDay        int
+	Trial            int
+	InDebt           int
+	SubscriptionType string
+}
+
+type DescribeWafInstanceInfoResponse struct {
+	common.Response
+	InstanceInfo WafInstanceInfo
+}
+
+// WafDomainArgs is used by the CreateDomain and the ModifyDomain. The ports and the source IPs are
+// JSON arrays.
+type WafDomainArgs struct {
+	InstanceId      string
+	Domain          string
+	SourceIps       string
+	IsAccessProduct int
+	ClusterType     int
+	HttpPort        string
+	HttpsPort       string
+	Http2Port       string
+	HttpsRedirect   int
+	HttpToUserIp    int
+	LoadBalancing   int
+	ConnectionTime  int
+	ReadTime        int
+	WriteTime       int
+	ResourceGroupId string
+}
+
+type WafDomain struct {
+	Cname           string
+	SourceIps       []string
+	IsAccessProduct int
+	ClusterType     int
+	HttpPort        []string
+	HttpsPort       []string
+	Http2Port       []string
+	HttpsRedirect   int
+	HttpToUserIp    int
+	LoadBalancing   int
+	ConnectionTime  int
+	ReadTime        int
+	WriteTime       int
+	ResourceGroupId string
+}
+
+type DescribeWafDomainResponse struct {
+	common.Response
+	Domain WafDomain
+}
+
+type WafProtectionModeArgs struct {
+	InstanceId  string
+	Domain      string
+	DefenseType string
+	Mode        int
+}
+
+type DescribeWafProtectionModeResponse struct {
+	common.Response
+	Mode int
+}
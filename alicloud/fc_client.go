@@ -0,0 +1,138 @@
+package alicloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+// FcClient requests the Function Compute API which is signed by the 'FC' authorization instead of the RPC signature.
+type FcClient struct {
+	AccessKey string
+	SecretKey string
+	Region    common.Region
+	// AccountId is a part of the endpoint, and it is set by the first request when it is empty.
+	AccountId string
+	// Endpoint overrides the endpoint of the account and the region when it is not empty, like an internal one.
+	Endpoint   string
+	UserAgent  string
+	httpClient *http.Client
+}
+
+func NewFcClient(accessKey, secretKey string, region common.Region) *FcClient {
+	return &FcClient{
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		Region:     region,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// Invoke sends the request to the resource, like '/services', with the JSON body and decodes the JSON
+// response into resp. The resource is prefixed with the API version.
+func (client *FcClient) Invoke(method, resource string, body interface{}, resp interface{}) error {
+	path := fmt.Sprintf("/%s%s", FcApiVersion, resource)
+
+	var content []byte
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		content = b
+	}
+
+	var reader io.Reader
+	if content != nil {
+		reader = bytes.NewReader(content)
+	}
+	endpoint := client.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(FcEndpointTemplate, client.AccountId, client.Region)
+	}
+	req, err := http.NewRequest(method, endpoint+path, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("Content-Type", "application/json")
+	if content != nil {
+		sum := md5.Sum(content)
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	}
+	if client.UserAgent != "" {
+		req.Header.Set("User-Agent", client.UserAgent)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("FC %s:%s", client.AccessKey, client.signature(req, path)))
+
+	httpResp, err := client.httpClient.Do(req)
+	if err != nil {
+		return common.GetClientError(err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return common.GetClientError(err)
+	}
+
+	if httpResp.StatusCode >= 400 {
+		fcErr := FcError{}
+		if err := json.Unmarshal(respBody, &fcErr); err != nil {
+			return common.GetClientError(fmt.Errorf("Parsing FC error response %s got an error: %#v", string(respBody), err))
+		}
+		return &common.Error{
+			ErrorResponse: common.ErrorResponse{
+				Response: common.Response{RequestId: httpResp.Header.Get("x-fc-request-id")},
+				Code:     fcErr.ErrorCode,
+				Message:  fcErr.ErrorMessage,
+			},
+			StatusCode: httpResp.StatusCode,
+		}
+	}
+
+	if resp != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, resp); err != nil {
+			return common.GetClientError(fmt.Errorf("Parsing FC response %s got an error: %#v", string(respBody), err))
+		}
+	}
+	return nil
+}
+
+func (client *FcClient) signature(req *http.Request, path string) string {
+	var fcHeaders []string
+	for k := range req.Header {
+		if key := strings.ToLower(k); strings.HasPrefix(key, "x-fc-") {
+			fcHeaders = append(fcHeaders, key+":"+req.Header.Get(k))
+		}
+	}
+	sort.Strings(fcHeaders)
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		req.Header.Get("Date"),
+	}, "\n") + "\n"
+	for _, h := range fcHeaders {
+		stringToSign += h + "\n"
+	}
+	stringToSign += path
+
+	mac := hmac.New(sha1.New, []byte(client.SecretKey))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
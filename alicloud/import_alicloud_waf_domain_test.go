@@ -0,0 +1,30 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudWafDomain_importBasic(t *testing.T) {
+	resourceName := "alicloud_waf_domain.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithWafInstance(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWafDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWafDomainConfig("1.1.1.1", "Block"),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,154 @@
+package alicloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+// LogClient requests the Log Service API which is signed by the 'LOG' authorization instead of the RPC signature.
+type LogClient struct {
+	AccessKey string
+	SecretKey string
+	Region    common.Region
+	// Endpoint overrides the endpoint of the region when it is not empty, like an internal one.
+	Endpoint   string
+	UserAgent  string
+	httpClient *http.Client
+}
+
+func NewLogClient(accessKey, secretKey string, region common.Region) *LogClient {
+	return &LogClient{
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		Region:     region,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// Invoke sends the request to the resource of the project, like '/machinegroups', with the JSON body and
+// decodes the JSON response into resp. The project is empty when the resource is not under any project.
+func (client *LogClient) Invoke(method, project, resource string, query url.Values, body interface{}, resp interface{}) error {
+	endpoint := client.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(LogEndpointTemplate, client.Region)
+	}
+	if project != "" {
+		endpoint = fmt.Sprintf("https://%s.%s", project, strings.TrimPrefix(endpoint, "https://"))
+	}
+
+	var content []byte
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		content = b
+	}
+
+	u := endpoint + resource
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var reader io.Reader
+	if content != nil {
+		reader = bytes.NewReader(content)
+	}
+	req, err := http.NewRequest(method, u, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-log-apiversion", LogApiVersion)
+	req.Header.Set("x-log-signaturemethod", "hmac-sha1")
+	req.Header.Set("x-log-bodyrawsize", strconv.Itoa(len(content)))
+	if content != nil {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-MD5", fmt.Sprintf("%X", md5.Sum(content)))
+	}
+	if client.UserAgent != "" {
+		req.Header.Set("User-Agent", client.UserAgent)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("LOG %s:%s", client.AccessKey, client.signature(req, resource, query)))
+
+	httpResp, err := client.httpClient.Do(req)
+	if err != nil {
+		return common.GetClientError(err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return common.GetClientError(err)
+	}
+
+	if httpResp.StatusCode >= 400 {
+		logErr := LogError{}
+		if err := json.Unmarshal(respBody, &logErr); err != nil {
+			return common.GetClientError(fmt.Errorf("Parsing Log Service error response %s got an error: %#v", string(respBody), err))
+		}
+		return &common.Error{
+			ErrorResponse: common.ErrorResponse{
+				Response: common.Response{RequestId: httpResp.Header.Get("x-log-requestid")},
+				Code:     logErr.ErrorCode,
+				Message:  logErr.ErrorMessage,
+			},
+			StatusCode: httpResp.StatusCode,
+		}
+	}
+
+	if resp != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, resp); err != nil {
+			return common.GetClientError(fmt.Errorf("Parsing Log Service response %s got an error: %#v", string(respBody), err))
+		}
+	}
+	return nil
+}
+
+func (client *LogClient) signature(req *http.Request, resource string, query url.Values) string {
+	var logHeaders []string
+	for k := range req.Header {
+		if key := strings.ToLower(k); strings.HasPrefix(key, "x-log-") || strings.HasPrefix(key, "x-acs-") {
+			logHeaders = append(logHeaders, key+":"+req.Header.Get(k))
+		}
+	}
+	sort.Strings(logHeaders)
+
+	canonicalizedResource := resource
+	if len(query) > 0 {
+		var params []string
+		for k := range query {
+			params = append(params, k+"="+query.Get(k))
+		}
+		sort.Strings(params)
+		canonicalizedResource += "?" + strings.Join(params, "&")
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		req.Header.Get("Date"),
+		strings.Join(logHeaders, "\n"),
+		canonicalizedResource,
+	}, "\n")
+
+	mac := hmac.New(sha1.New, []byte(client.SecretKey))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+// MnsClient requests the MNS API which is signed by the 'MNS' authorization instead of the RPC signature.
+type MnsClient struct {
+	AccessKey string
+	SecretKey string
+	Region    common.Region
+	// AccountId is a part of the endpoint, and it is set by the first request when it is empty.
+	AccountId string
+	// Endpoint overrides the endpoint of the account and the region when it is not empty, like an internal one.
+	Endpoint   string
+	UserAgent  string
+	httpClient *http.Client
+}
+
+func NewMnsClient(accessKey, secretKey string, region common.Region) *MnsClient {
+	return &MnsClient{
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		Region:     region,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// Invoke sends the request to the resource, like '/queues', and decodes the XML response into resp.
+func (client *MnsClient) Invoke(method, resource string, headers map[string]string, resp interface{}) error {
+	endpoint := client.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(MnsEndpointTemplate, client.AccountId, client.Region)
+	}
+	req, err := http.NewRequest(method, endpoint+resource, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "text/xml;charset=utf-8")
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-mns-version", MnsApiVersion)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if client.UserAgent != "" {
+		req.Header.Set("User-Agent", client.UserAgent)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("MNS %s:%s", client.AccessKey, client.signature(req, resource)))
+
+	httpResp, err := client.httpClient.Do(req)
+	if err != nil {
+		return common.GetClientError(err)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return common.GetClientError(err)
+	}
+
+	if httpResp.StatusCode >= 400 {
+		mnsErr := MnsError{}
+		if err := xml.Unmarshal(body, &mnsErr); err != nil {
+			return common.GetClientError(fmt.Errorf("Parsing MNS error response %s got an error: %#v", string(body), err))
+		}
+		return &common.Error{
+			ErrorResponse: common.ErrorResponse{
+				Response: common.Response{RequestId: mnsErr.RequestId},
+				HostId:   mnsErr.HostId,
+				Code:     mnsErr.Code,
+				Message:  mnsErr.Message,
+			},
+			StatusCode: httpResp.StatusCode,
+		}
+	}
+
+	if resp != nil && len(body) > 0 {
+		if err := xml.Unmarshal(body, resp); err != nil {
+			return common.GetClientError(fmt.Errorf("Parsing MNS response %s got an error: %#v", string(body), err))
+		}
+	}
+	return nil
+}
+
+func (client *MnsClient) signature(req *http.Request, resource string) string {
+	var mnsHeaders []string
+	for k := range req.Header {
+		if key := strings.ToLower(k); strings.HasPrefix(key, "x-mns-") {
+			mnsHeaders = append(mnsHeaders, key+":"+req.Header.Get(k))
+		}
+	}
+	sort.Strings(mnsHeaders)
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		req.Header.Get("Date"),
+	}, "\n") + "\n"
+	for _, h := range mnsHeaders {
+		stringToSign += h + "\n"
+	}
+	stringToSign += resource
+
+	mac := hmac.New(sha1.New, []byte(client.SecretKey))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"fmt"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -57,3 +58,305 @@ func testAccCheckAlicloudDataSourceID(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// testAccCheckResourceTimeout checks the timeout of the operation, like schema.TimeoutDelete, which is kept in
+// the meta of the resource state.
+func testAccCheckResourceTimeout(n, key string, timeout time.Duration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		timeouts, ok := rs.Primary.Meta[schema.TimeoutKey].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("No timeouts are set on %s", n)
+		}
+		var got time.Duration
+		switch v := timeouts[key].(type) {
+		case int64:
+			got = time.Duration(v)
+		case float64:
+			got = time.Duration(v)
+		default:
+			return fmt.Errorf("No %s timeout is set on %s", key, n)
+		}
+		if got != timeout {
+			return fmt.Errorf("The %s timeout of %s is %s, expected %s", key, n, got, timeout)
+		}
+		return nil
+	}
+}
+
+// There is no kvstore instance resource in the provider, so the kvstore acceptance tests
+// run against an existing instance specified by ALICLOUD_KVSTORE_INSTANCE_ID.
+func testAccPreCheckWithKVStoreInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_KVSTORE_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_KVSTORE_INSTANCE_ID must be set for kvstore acceptance tests")
+	}
+}
+
+// The notification arn of ESS contains the account id, which is specified by ALICLOUD_ACCOUNT_ID.
+func testAccPreCheckWithAccountId(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_ACCOUNT_ID"); v == "" {
+		t.Skip("ALICLOUD_ACCOUNT_ID must be set for the acceptance tests which need the account id")
+	}
+}
+
+// There is no launch template resource in the provider, so the launch template acceptance tests
+// run against an existing template specified by ALICLOUD_LAUNCH_TEMPLATE_ID.
+func testAccPreCheckWithLaunchTemplate(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_LAUNCH_TEMPLATE_ID"); v == "" {
+		t.Skip("ALICLOUD_LAUNCH_TEMPLATE_ID must be set for launch template acceptance tests")
+	}
+}
+
+// There is no managed kubernetes cluster resource in the provider, so the node pool acceptance tests
+// run against an existing cluster and one of its vswitches specified by ALICLOUD_CS_CLUSTER_ID and ALICLOUD_CS_VSWITCH_ID.
+func testAccPreCheckWithKubernetesCluster(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_CS_CLUSTER_ID"); v == "" {
+		t.Skip("ALICLOUD_CS_CLUSTER_ID must be set for kubernetes node pool acceptance tests")
+	}
+	if v := os.Getenv("ALICLOUD_CS_VSWITCH_ID"); v == "" {
+		t.Skip("ALICLOUD_CS_VSWITCH_ID must be set for kubernetes node pool acceptance tests")
+	}
+}
+
+// There are no log project and logstore resources in the provider, so the log acceptance tests
+// run against an existing project and logstore specified by ALICLOUD_LOG_PROJECT and ALICLOUD_LOG_STORE.
+func testAccPreCheckWithLogStore(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_LOG_PROJECT"); v == "" {
+		t.Skip("ALICLOUD_LOG_PROJECT must be set for log acceptance tests")
+	}
+	if v := os.Getenv("ALICLOUD_LOG_STORE"); v == "" {
+		t.Skip("ALICLOUD_LOG_STORE must be set for log acceptance tests")
+	}
+}
+
+// The AMQP instance is a subscription one which can not be released by API, so the acceptance tests of
+// the resources in it run against an existing instance specified by ALICLOUD_AMQP_INSTANCE_ID.
+func testAccPreCheckWithAmqpInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_AMQP_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_AMQP_INSTANCE_ID must be set for AMQP acceptance tests")
+	}
+}
+
+// There are no MNS resources in the provider, so the MNS data source acceptance tests run against
+// an existing queue and topic specified by ALICLOUD_MNS_QUEUE_NAME and ALICLOUD_MNS_TOPIC_NAME.
+func testAccPreCheckWithMnsQueue(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_MNS_QUEUE_NAME"); v == "" {
+		t.Skip("ALICLOUD_MNS_QUEUE_NAME must be set for MNS queues data source acceptance tests")
+	}
+}
+
+func testAccPreCheckWithMnsTopic(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_MNS_TOPIC_NAME"); v == "" {
+		t.Skip("ALICLOUD_MNS_TOPIC_NAME must be set for MNS topics data source acceptance tests")
+	}
+}
+
+// The custom domain must be resolved to the FC endpoint by CNAME before it is added, so the acceptance
+// test runs against an existing domain specified by ALICLOUD_FC_CUSTOM_DOMAIN.
+func testAccPreCheckWithFcCustomDomain(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_FC_CUSTOM_DOMAIN"); v == "" {
+		t.Skip("ALICLOUD_FC_CUSTOM_DOMAIN must be set for FC custom domain acceptance tests")
+	}
+}
+
+// There are no FC version and alias resources in the provider, so the provision config acceptance test
+// runs against an existing function alias specified by ALICLOUD_FC_SERVICE, ALICLOUD_FC_FUNCTION and ALICLOUD_FC_ALIAS.
+func testAccPreCheckWithFcAlias(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"ALICLOUD_FC_SERVICE", "ALICLOUD_FC_FUNCTION", "ALICLOUD_FC_ALIAS"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("%s must be set for FC provision config acceptance tests", env)
+		}
+	}
+}
+
+// The WAF instance is a subscription one which can not be released by API, so the domain acceptance tests
+// run against an existing instance specified by ALICLOUD_WAF_INSTANCE_ID.
+func testAccPreCheckWithWafInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_WAF_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_WAF_INSTANCE_ID must be set for WAF domain acceptance tests")
+	}
+}
+
+// The Anti-DDoS Pro instance is a subscription one which can not be released by API, so the acceptance tests
+// of the domain resources and ports run against an existing instance specified by ALICLOUD_DDOSCOO_INSTANCE_ID.
+func testAccPreCheckWithDdoscooInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_DDOSCOO_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_DDOSCOO_INSTANCE_ID must be set for Anti-DDoS Pro acceptance tests")
+	}
+}
+
+// There are no resource directory resources in the provider, so the config aggregator acceptance tests run
+// against an existing member account specified by ALICLOUD_RD_ACCOUNT_ID and ALICLOUD_RD_ACCOUNT_NAME.
+func testAccPreCheckWithResourceDirectoryAccount(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"ALICLOUD_RD_ACCOUNT_ID", "ALICLOUD_RD_ACCOUNT_NAME"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("%s must be set for config aggregator acceptance tests", env)
+		}
+	}
+}
+
+// The Bastionhost instance is a subscription one which can not be released by API, so the acceptance tests of
+// the users, hosts and host accounts run against an existing instance specified by ALICLOUD_BASTIONHOST_INSTANCE_ID.
+func testAccPreCheckWithBastionhostInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_BASTIONHOST_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_BASTIONHOST_INSTANCE_ID must be set for Bastionhost acceptance tests")
+	}
+}
+
+// The HBR client must be installed on the ecs instance to back up the files of it, so the acceptance tests of
+// the ecs backup plans run against an existing instance specified by ALICLOUD_HBR_ECS_INSTANCE_ID.
+func testAccPreCheckWithHbrEcsInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_HBR_ECS_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_HBR_ECS_INSTANCE_ID must be set for HBR ecs backup plan acceptance tests")
+	}
+}
+
+// There are no nas resources in the provider, so the acceptance tests of the nas backup plans run against an
+// existing file system specified by ALICLOUD_HBR_NAS_FILE_SYSTEM_ID and ALICLOUD_HBR_NAS_CREATE_TIME.
+func testAccPreCheckWithHbrNasFileSystem(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"ALICLOUD_HBR_NAS_FILE_SYSTEM_ID", "ALICLOUD_HBR_NAS_CREATE_TIME"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("%s must be set for HBR nas backup plan acceptance tests", env)
+		}
+	}
+}
+
+// The snapshots are created by the backup jobs which run by the schedules of the backup plans, so the acceptance
+// tests of the restore jobs restore an existing oss snapshot specified by ALICLOUD_HBR_VAULT_ID,
+// ALICLOUD_HBR_SNAPSHOT_ID and ALICLOUD_HBR_SNAPSHOT_HASH.
+func testAccPreCheckWithHbrSnapshot(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"ALICLOUD_HBR_VAULT_ID", "ALICLOUD_HBR_SNAPSHOT_ID", "ALICLOUD_HBR_SNAPSHOT_HASH"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("%s must be set for HBR restore job acceptance tests", env)
+		}
+	}
+}
+
+// There are no rds database and account resources in the provider, so the acceptance tests of the DTS jobs
+// migrate and synchronize the dtstest database between the existing rds instances specified by
+// ALICLOUD_DTS_SOURCE_RDS_ID and ALICLOUD_DTS_DESTINATION_RDS_ID with the account of both of them specified by
+// ALICLOUD_DTS_DB_USER and ALICLOUD_DTS_DB_PASSWORD.
+func testAccPreCheckWithDtsRdsInstances(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"ALICLOUD_DTS_SOURCE_RDS_ID", "ALICLOUD_DTS_DESTINATION_RDS_ID", "ALICLOUD_DTS_DB_USER", "ALICLOUD_DTS_DB_PASSWORD"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("%s must be set for DTS job acceptance tests", env)
+		}
+	}
+}
+
+// There are no CEN instance and attachment resources in the provider, so the acceptance tests of the CEN route maps
+// and route entries run against an existing CEN instance specified by ALICLOUD_CEN_ID, which the vpc of the vswitch
+// specified by ALICLOUD_CEN_VSWITCH_ID is attached to.
+func testAccPreCheckWithCenInstance(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"ALICLOUD_CEN_ID", "ALICLOUD_CEN_VSWITCH_ID"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("%s must be set for CEN acceptance tests", env)
+		}
+	}
+}
+
+// The smart access gateways are hardware devices, so the acceptance tests of their ACL associations and SNAT and
+// DNAT entries run against an existing smart access gateway specified by ALICLOUD_SAG_INSTANCE_ID, and the ones of
+// the client users run against an existing smart access gateway app specified by ALICLOUD_SAG_APP_INSTANCE_ID.
+func testAccPreCheckWithSagInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_SAG_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_SAG_INSTANCE_ID must be set for SAG acceptance tests")
+	}
+}
+
+func testAccPreCheckWithSagAppInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_SAG_APP_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_SAG_APP_INSTANCE_ID must be set for SAG client user acceptance tests")
+	}
+}
+
+// There are no CCN resources in the provider, so the acceptance tests of the SAG CEN bindings bind the existing
+// CCN instance specified by ALICLOUD_SAG_CCN_ID to the CEN instance specified by ALICLOUD_CEN_ID.
+func testAccPreCheckWithSagCcnInstance(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"ALICLOUD_SAG_CCN_ID", "ALICLOUD_CEN_ID"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("%s must be set for SAG CEN binding acceptance tests", env)
+		}
+	}
+}
+
+// There are no vpn gateway and connection resources in the provider, so the acceptance tests of the vpn route
+// entries run against the existing vpn gateway specified by ALICLOUD_VPN_GATEWAY_ID and its vpn connection
+// specified by ALICLOUD_VPN_CONNECTION_ID.
+func testAccPreCheckWithVpnConnection(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"ALICLOUD_VPN_GATEWAY_ID", "ALICLOUD_VPN_CONNECTION_ID"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("%s must be set for vpn route entry acceptance tests", env)
+		}
+	}
+}
+
+// The GTM instance is a subscription one which can not be released by API, so the acceptance tests of the address
+// pools and access strategies run against an existing instance specified by ALICLOUD_GTM_INSTANCE_ID.
+func testAccPreCheckWithGtmInstance(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_GTM_INSTANCE_ID"); v == "" {
+		t.Skip("ALICLOUD_GTM_INSTANCE_ID must be set for GTM acceptance tests")
+	}
+}
+
+// The default VPC is created by the system only when an instance is launched without a VPC in the console, so the
+// acceptance tests adopting the default networking objects are skipped in the regions without it.
+func testAccPreCheckWithDefaultVpc(t *testing.T) {
+	testAccPreCheck(t)
+	client, err := sharedClientForRegion(os.Getenv("ALICLOUD_REGION"))
+	if err != nil {
+		t.Fatalf("Creating the client got an error: %#v", err)
+	}
+	if _, err := client.DescribeDefaultVpcId(); err != nil {
+		if NotFoundError(err, "vpc") {
+			t.Skipf("There is no default VPC in region %s", client.Region)
+		}
+		t.Fatalf("Describing the default VPC got an error: %#v", err)
+	}
+}
+
+// There is no snapshot resource in the provider, so the acceptance test of the disks created from a snapshot uses
+// the existing snapshot specified by ALICLOUD_SNAPSHOT_ID.
+func testAccPreCheckWithSnapshot(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_SNAPSHOT_ID"); v == "" {
+		t.Skip("ALICLOUD_SNAPSHOT_ID must be set for disk snapshot acceptance tests")
+	}
+}
+
+// The mail addresses and the receiver lists of DirectMail must be on a verified domain, so their acceptance tests
+// use the existing one specified by ALICLOUD_DIRECT_MAIL_DOMAIN.
+func testAccPreCheckWithDirectMailDomain(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_DIRECT_MAIL_DOMAIN"); v == "" {
+		t.Skip("ALICLOUD_DIRECT_MAIL_DOMAIN must be set for DirectMail acceptance tests")
+	}
+}
@@ -0,0 +1,307 @@
+package alicloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// The modes of the recorder, which is set by ALICLOUD_VCR_MODE. The acceptance tests send the requests to the
+// APIs when it is not set.
+const (
+	// recorderModeRecord sends the requests to the APIs and saves the interactions to the cassette of the test.
+	recorderModeRecord = "record"
+	// recorderModeReplay replays the responses of the cassette of the test without sending any request, so
+	// that the tests run in the CI without the credentials and without creating any billable resource.
+	recorderModeReplay = "replay"
+)
+
+const recorderCassetteDir = "testdata/cassettes"
+
+// The request parameters which change for every request, like the signature nonce, or which are scrubbed
+// from the cassettes. They are not matched when the requests are replayed.
+var recorderVolatileParamRegexp = regexp.MustCompile(`(?i)^(timestamp|signaturenonce|clienttoken)$`)
+
+type recordedRequest struct {
+	Method string
+	Url    string
+	Body   string
+}
+
+type recordedResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        string
+}
+
+type recordedInteraction struct {
+	Request  recordedRequest
+	Response recordedResponse
+}
+
+// recorder is the base transport of the apiTransports in the acceptance tests. It records the interactions
+// with the APIs, or replays them in the order they are recorded for each request.
+type recorder struct {
+	mode      string
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []recordedInteraction
+	// replayed are the indexes of the next interactions to replay, keyed by the requests.
+	replayed map[string]int
+}
+
+// testAccRecorder sets the recorder of the test when ALICLOUD_VCR_MODE is set, and returns the function which
+// saves the cassette and restores the transport, like:
+//
+//	defer testAccRecorder(t)()
+//
+// It must be called before the resource.Test configures the provider, which still needs TF_ACC to be set in the
+// replay mode. The test fails in the replay mode when its cassette is missing, so that a test which is not
+// recorded is not reported as passed. The recorder is skipped by the services with the client timeouts and by the
+// OSS client, which have their own transports.
+func testAccRecorder(t *testing.T) func() {
+	mode := os.Getenv("ALICLOUD_VCR_MODE")
+	if mode == "" {
+		return func() {}
+	}
+	if mode != recorderModeRecord && mode != recorderModeReplay {
+		t.Fatalf("ALICLOUD_VCR_MODE must be %s or %s, got %s", recorderModeRecord, recorderModeReplay, mode)
+	}
+
+	path := recorderCassettePath(t.Name())
+	rec := &recorder{mode: mode, replayed: make(map[string]int)}
+	if mode == recorderModeReplay {
+		b, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			t.Fatalf("There is no cassette %s, which is recorded by running the test with ALICLOUD_VCR_MODE=%s.", path, recorderModeRecord)
+		}
+		if err != nil {
+			t.Fatalf("Reading the cassette %s got an error: %s", path, err)
+		}
+		if err := json.Unmarshal(b, &rec.interactions); err != nil {
+			t.Fatalf("Parsing the cassette %s got an error: %s", path, err)
+		}
+		// The requests are signed by the fake credentials, as they are never sent.
+		for _, env := range []string{"ALICLOUD_ACCESS_KEY", "ALICLOUD_SECRET_KEY"} {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, "replay")
+			}
+		}
+	}
+
+	wrap := wrapBaseTransport
+	wrapBaseTransport = func(transport http.RoundTripper) http.RoundTripper {
+		rec.transport = transport
+		return rec
+	}
+
+	return func() {
+		wrapBaseTransport = wrap
+		if mode != recorderModeRecord || t.Failed() {
+			return
+		}
+		if err := rec.save(path); err != nil {
+			t.Fatalf("Saving the cassette %s got an error: %s", path, err)
+		}
+	}
+}
+
+func recorderCassettePath(name string) string {
+	return filepath.Join(recorderCassetteDir, strings.Replace(name, "/", "_", -1)+".json")
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	key := recorderRequestKey(req.Method, req.URL, body)
+
+	if r.mode == recorderModeReplay {
+		return r.replay(req, key)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	u := *req.URL
+	u.RawQuery = redactQuery(u.RawQuery)
+	r.mu.Lock()
+	r.interactions = append(r.interactions, recordedInteraction{
+		Request: recordedRequest{
+			Method: req.Method,
+			Url:    u.String(),
+			Body:   redactBody(body),
+		},
+		Response: recordedResponse{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        redactBody(respBody),
+		},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the response of the next interaction of the request which is not replayed yet.
+func (r *recorder) replay(req *http.Request, key string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := r.replayed[key]; i < len(r.interactions); i++ {
+		interaction := r.interactions[i]
+		u, err := url.Parse(interaction.Request.Url)
+		if err != nil {
+			return nil, err
+		}
+		if recorderRequestKey(interaction.Request.Method, u, []byte(interaction.Request.Body)) != key {
+			continue
+		}
+		r.replayed[key] = i + 1
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode: interaction.Response.StatusCode,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"Content-Type": []string{interaction.Response.ContentType}},
+			Body:       ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			Request:    req,
+		}, nil
+	}
+	return nil, fmt.Errorf("There is no recorded interaction of %s %s left, and the cassette needs to be recorded again.", req.Method, req.URL.Host+req.URL.Path)
+}
+
+func (r *recorder) save(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+// recorderRequestKey returns the key of the request to match the recorded ones, which is made of the method,
+// the host, the path and the sorted parameters of the query and the form body except the volatile and the
+// scrubbed ones. The other bodies, like the JSON ones, are matched as they are.
+func recorderRequestKey(method string, u *url.URL, body []byte) string {
+	params := recorderParams(u.RawQuery)
+	b := strings.TrimSpace(string(body))
+	if b != "" && !strings.HasPrefix(b, "{") && !strings.HasPrefix(b, "[") && !strings.HasPrefix(b, "<") {
+		params = append(params, recorderParams(b)...)
+		b = ""
+	}
+	sort.Strings(params)
+	return strings.Join([]string{method, u.Host, u.Path, strings.Join(params, "&"), b}, " ")
+}
+
+func recorderParams(rawQuery string) []string {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return []string{rawQuery}
+	}
+	var params []string
+	for k, vs := range query {
+		if recorderVolatileParamRegexp.MatchString(k) || sensitiveNameRegexp.MatchString(k) {
+			continue
+		}
+		for _, v := range vs {
+			params = append(params, url.QueryEscape(k)+"="+url.QueryEscape(v))
+		}
+	}
+	return params
+}
+
+func TestRecorder(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"RequestId":"%d","AccessKeySecret":"secret"}`, requests)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "cassette.json")
+	rec := &recorder{mode: recorderModeRecord, transport: http.DefaultTransport, replayed: make(map[string]int)}
+	client := &http.Client{Transport: rec}
+	for _, nonce := range []string{"1", "2"} {
+		resp, err := client.Get(server.URL + "/?Action=DescribeRegions&AccessKeyId=foo&Signature=bar&SignatureNonce=" + nonce)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		resp.Body.Close()
+	}
+	if err := rec.save(path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, secret := range []string{"foo", "bar", `"secret"`} {
+		if strings.Contains(string(b), secret) {
+			t.Fatalf("the cassette should be scrubbed, but got %s", b)
+		}
+	}
+
+	replayer := &recorder{mode: recorderModeReplay, replayed: make(map[string]int)}
+	if err := json.Unmarshal(b, &replayer.interactions); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client = &http.Client{Transport: replayer}
+	for _, expected := range []string{"1", "2"} {
+		resp, err := client.Get(server.URL + "/?Action=DescribeRegions&AccessKeyId=baz&Signature=qux&SignatureNonce=3")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), `"RequestId":"`+expected+`"`) {
+			t.Fatalf("expected the response %s to be replayed, got %s", expected, body)
+		}
+	}
+	if _, err := client.Get(server.URL + "/?Action=DescribeRegions"); err == nil {
+		t.Fatalf("expected an error when the recorded interactions run out")
+	}
+	if requests != 2 {
+		t.Fatalf("the replayed requests should not be sent, but %d requests are sent", requests)
+	}
+}
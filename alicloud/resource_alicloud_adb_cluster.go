@@ -0,0 +1,319 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudADBCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudADBClusterCreate,
+		Read:   resourceAlicloudADBClusterRead,
+		Update: resourceAlicloudADBClusterUpdate,
+		Delete: resourceAlicloudADBClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_version": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"3.0"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "3.0",
+			},
+			"db_cluster_category": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(ADBClusterCategories),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"db_node_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"db_node_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateIntegerInRange(1, 32),
+				Required:     true,
+			},
+			"db_node_storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceDescription,
+				Optional:     true,
+			},
+			"pay_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"PostPaid", "PrePaid"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "PostPaid",
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_ips": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"maintain_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTimeWindow,
+			},
+			"connection_string": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudADBClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CreateADBClusterArgs{
+		RegionId:             client.Region,
+		DBClusterVersion:     d.Get("db_cluster_version").(string),
+		DBClusterCategory:    d.Get("db_cluster_category").(string),
+		DBClusterClass:       d.Get("db_node_class").(string),
+		DBNodeGroupCount:     strconv.Itoa(d.Get("db_node_count").(int)),
+		DBNodeStorage:        strconv.Itoa(d.Get("db_node_storage").(int)),
+		DBClusterDescription: d.Get("description").(string),
+		DBClusterNetworkType: strings.ToUpper(string(VpcNet)),
+		PayType:              "Postpaid",
+	}
+
+	if d.Get("pay_type").(string) == "PrePaid" {
+		period := d.Get("period").(int)
+		if period == 0 {
+			return ConfigErrorf(ErrorCodeMissingArgument, "'period' is required when 'pay_type' is PrePaid")
+		}
+		args.PayType = "Prepaid"
+		if period > 9 {
+			args.Period = "Year"
+			args.UsedTime = strconv.Itoa(period / 12)
+		} else {
+			args.Period = "Month"
+			args.UsedTime = strconv.Itoa(period)
+		}
+	}
+
+	vswitchId := d.Get("vswitch_id").(string)
+	vpcId, zoneId, err := client.DescribeVSwitchPlacement(vswitchId, d.Get("zone_id").(string))
+	if err != nil {
+		return err
+	}
+	args.ZoneId = zoneId
+	args.VPCId = vpcId
+	args.VSwitchId = vswitchId
+
+	resp := CreateADBClusterResponse{}
+	if err := client.adbconn().Invoke("CreateDBCluster", &args, &resp); err != nil {
+		return fmt.Errorf("Error creating Alicloud AnalyticDB cluster: %#v", err)
+	}
+
+	if resp.DBClusterId == "" {
+		return fmt.Errorf("Error get Alicloud AnalyticDB cluster id")
+	}
+	d.SetId(resp.DBClusterId)
+
+	if err := client.WaitForADBCluster(d.Id(), ADBRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForADBCluster %s got an error: %#v", ADBRunning, err)
+	}
+
+	return resourceAlicloudADBClusterUpdate(d, meta)
+}
+
+func resourceAlicloudADBClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if d.HasChange("description") && !d.IsNewResource() {
+		args := ModifyADBClusterDescriptionArgs{
+			RegionId:             client.Region,
+			DBClusterId:          d.Id(),
+			DBClusterDescription: d.Get("description").(string),
+		}
+		if err := client.adbconn().Invoke("ModifyDBClusterDescription", &args, &ADBResponse{}); err != nil {
+			return WrapError(err, "ModifyDBClusterDescription", d.Id())
+		}
+		d.SetPartial("description")
+	}
+
+	if d.HasChange("maintain_time") {
+		if maintainTime := d.Get("maintain_time").(string); maintainTime != "" {
+			args := ModifyADBClusterMaintainTimeArgs{
+				RegionId:     client.Region,
+				DBClusterId:  d.Id(),
+				MaintainTime: maintainTime,
+			}
+			if err := client.adbconn().Invoke("ModifyDBClusterMaintainTime", &args, &ADBResponse{}); err != nil {
+				return WrapError(err, "ModifyDBClusterMaintainTime", d.Id())
+			}
+		}
+		d.SetPartial("maintain_time")
+	}
+
+	if d.HasChange("security_ips") {
+		ips := expandStringList(d.Get("security_ips").(*schema.Set).List())
+		if len(ips) < 1 {
+			ips = []string{LOCAL_HOST_IP}
+		}
+		args := ModifyADBAccessWhiteListArgs{
+			RegionId:             client.Region,
+			DBClusterId:          d.Id(),
+			DBClusterIPArrayName: "default",
+			SecurityIps:          strings.Join(ips, COMMA_SEPARATED),
+		}
+		if err := client.adbconn().Invoke("ModifyClusterAccessWhiteList", &args, &ADBResponse{}); err != nil {
+			return WrapError(err, "ModifyClusterAccessWhiteList", d.Id())
+		}
+		if err := client.WaitForADBCluster(d.Id(), ADBRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForADBCluster %s got an error: %#v", ADBRunning, err)
+		}
+		d.SetPartial("security_ips")
+	}
+
+	if (d.HasChange("db_node_class") || d.HasChange("db_node_count") || d.HasChange("db_node_storage")) && !d.IsNewResource() {
+		args := ModifyADBClusterArgs{
+			RegionId:    client.Region,
+			DBClusterId: d.Id(),
+		}
+		if d.HasChange("db_node_class") {
+			args.DBNodeClass = d.Get("db_node_class").(string)
+		}
+		if d.HasChange("db_node_count") {
+			args.DBNodeGroupCount = strconv.Itoa(d.Get("db_node_count").(int))
+		}
+		if d.HasChange("db_node_storage") {
+			args.DBNodeStorage = strconv.Itoa(d.Get("db_node_storage").(int))
+		}
+		if err := client.adbconn().Invoke("ModifyDBCluster", &args, &ADBResponse{}); err != nil {
+			return WrapError(err, "ModifyDBCluster", d.Id())
+		}
+
+		// The cluster turns into ClassChanging after a while.
+		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := client.WaitForADBCluster(d.Id(), ADBRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForADBCluster %s got an error: %#v", ADBRunning, err)
+		}
+		d.SetPartial("db_node_class")
+		d.SetPartial("db_node_count")
+		d.SetPartial("db_node_storage")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudADBClusterRead(d, meta)
+}
+
+func resourceAlicloudADBClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	cluster, err := client.DescribeADBClusterById(d.Id())
+	if err != nil {
+		if NotFoundError(err, "adb") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe AnalyticDB Cluster Attribute: %#v", err)
+	}
+
+	d.Set("db_cluster_version", cluster.DBVersion)
+	d.Set("db_cluster_category", cluster.Category)
+	d.Set("db_node_class", cluster.DBNodeClass)
+	d.Set("db_node_count", cluster.DBNodeCount)
+	d.Set("db_node_storage", cluster.DBNodeStorage)
+	d.Set("description", cluster.DBClusterDescription)
+	d.Set("zone_id", cluster.ZoneId)
+	d.Set("vswitch_id", cluster.VSwitchId)
+	d.Set("maintain_time", cluster.MaintainTime)
+	d.Set("connection_string", cluster.ConnectionString)
+	d.Set("port", cluster.Port)
+	if cluster.PayType == "Prepaid" {
+		d.Set("pay_type", "PrePaid")
+	} else {
+		d.Set("pay_type", "PostPaid")
+	}
+
+	ips, err := client.DescribeADBSecurityIps(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeDBClusterAccessWhiteList", d.Id())
+	}
+	if err := d.Set("security_ips", ips); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudADBClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	if d.Get("pay_type").(string) == "PrePaid" {
+		return ConfigErrorf(ErrorCodeUnsupportedOperation, "At present, 'PrePaid' AnalyticDB cluster cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+
+	args := ADBClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: d.Id(),
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.adbconn().Invoke("DeleteDBCluster", &args, &ADBResponse{}); err != nil {
+			if NotFoundError(err, "adb") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteDBCluster", d.Id()))
+		}
+
+		if _, err := client.DescribeADBClusterById(d.Id()); err != nil {
+			if NotFoundError(err, "adb") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("AnalyticDB cluster %s in use - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,132 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudADBCluster_basic(t *testing.T) {
+	var cluster ADBCluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_adb_cluster.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckADBClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccADBClusterConfig("tf-test-adb", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckADBClusterExists(
+						"alicloud_adb_cluster.foo", &cluster),
+					resource.TestCheckResourceAttr(
+						"alicloud_adb_cluster.foo",
+						"db_cluster_category",
+						"Cluster"),
+					resource.TestCheckResourceAttr(
+						"alicloud_adb_cluster.foo",
+						"db_node_count",
+						"2"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_adb_cluster.foo",
+						"connection_string"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccADBClusterConfig("tf-test-adb-update", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckADBClusterExists(
+						"alicloud_adb_cluster.foo", &cluster),
+					resource.TestCheckResourceAttr(
+						"alicloud_adb_cluster.foo",
+						"description",
+						"tf-test-adb-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_adb_cluster.foo",
+						"db_node_count",
+						"3"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckADBClusterExists(n string, cluster *ADBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AnalyticDB cluster ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		attr, err := client.DescribeADBClusterById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*cluster = *attr
+		return nil
+	}
+}
+
+func testAccCheckADBClusterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_adb_cluster" {
+			continue
+		}
+
+		if _, err := client.DescribeADBClusterById(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "adb") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("AnalyticDB cluster %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccADBClusterConfig(description string, count int) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_adb_cluster" "foo" {
+	db_cluster_category = "Cluster"
+	db_node_class = "C8"
+	db_node_count = %d
+	db_node_storage = 200
+	description = "%s"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	security_ips = ["10.168.1.12"]
+}
+`, count, description)
+}
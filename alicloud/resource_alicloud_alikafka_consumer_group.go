@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAlikafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAlikafkaConsumerGroupCreate,
+		Read:   resourceAlicloudAlikafkaConsumerGroupRead,
+		Delete: resourceAlicloudAlikafkaConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 64),
+			},
+			"remark": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(0, 64),
+			},
+		},
+	}
+}
+
+func resourceAlicloudAlikafkaConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := AlikafkaConsumerGroupArgs{
+		RegionId:   client.Region,
+		InstanceId: d.Get("instance_id").(string),
+		ConsumerId: d.Get("consumer_id").(string),
+		Remark:     d.Get("remark").(string),
+	}
+	if err := client.alikafkaconn().Invoke("CreateConsumerGroup", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateConsumerGroup", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.InstanceId, COLON_SEPARATED, args.ConsumerId))
+
+	return resourceAlicloudAlikafkaConsumerGroupRead(d, meta)
+}
+
+func resourceAlicloudAlikafkaConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	group, err := meta.(*AliyunClient).DescribeAlikafkaConsumerGroup(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "alikafka") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetConsumerList", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("consumer_id", group.ConsumerId)
+	d.Set("remark", group.Remark)
+
+	return nil
+}
+
+func resourceAlicloudAlikafkaConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := AlikafkaConsumerGroupArgs{
+		RegionId:   client.Region,
+		InstanceId: parts[0],
+		ConsumerId: parts[1],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.alikafkaconn().Invoke("DeleteConsumerGroup", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAlikafkaConsumerGroup(parts[0], parts[1]); e != nil && NotFoundError(e, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteConsumerGroup", d.Id()))
+		}
+
+		if _, err := client.DescribeAlikafkaConsumerGroup(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Alikafka consumer group %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,104 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAlikafkaConsumerGroup_basic(t *testing.T) {
+	var v AlikafkaConsumerGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_alikafka_consumer_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlikafkaConsumerGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAlikafkaConsumerGroupConfig("tf-testAccAlikafkaConsumerGroup"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlikafkaConsumerGroupExists(
+						"alicloud_alikafka_consumer_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_consumer_group.foo",
+						"consumer_id",
+						"tf-testAccAlikafkaConsumerGroup"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_consumer_group.foo",
+						"remark",
+						"test remark"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlikafkaConsumerGroupExists(n string, d *AlikafkaConsumerGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Alikafka consumer group ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		group, err := client.DescribeAlikafkaConsumerGroup(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *group
+		return nil
+	}
+}
+
+func testAccCheckAlikafkaConsumerGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_alikafka_consumer_group" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeAlikafkaConsumerGroup(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "alikafka") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Alikafka consumer group %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAlikafkaConsumerGroupConfig(consumerId string) string {
+	return fmt.Sprintf(testAccAlikafkaInstanceForSub+`
+resource "alicloud_alikafka_consumer_group" "foo" {
+  instance_id = "${alicloud_alikafka_instance.foo.id}"
+  consumer_id = "%s"
+  remark = "test remark"
+}
+`, consumerId)
+}
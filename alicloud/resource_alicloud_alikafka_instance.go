@@ -0,0 +1,222 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAlikafkaInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAlikafkaInstanceCreate,
+		Read:   resourceAlicloudAlikafkaInstanceRead,
+		Update: resourceAlicloudAlikafkaInstanceUpdate,
+		Delete: resourceAlicloudAlikafkaInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringLengthInRange(3, 64),
+			},
+			"topic_quota": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"disk_type": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedIntValue([]int{0, 1}),
+			},
+			"disk_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"deploy_type": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      AlikafkaDeployTypeVpc,
+				ValidateFunc: validateAllowedIntValue([]int{AlikafkaDeployTypeInternet, AlikafkaDeployTypeVpc}),
+			},
+			"io_max": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_point": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudAlikafkaInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	vswitchId := d.Get("vswitch_id").(string)
+	vpcId, zoneId, err := client.DescribeVSwitchPlacement(vswitchId, "")
+	if err != nil {
+		return err
+	}
+
+	args := CreateAlikafkaOrderArgs{
+		RegionId:   client.Region,
+		TopicQuota: d.Get("topic_quota").(int),
+		DiskType:   d.Get("disk_type").(int),
+		DiskSize:   d.Get("disk_size").(int),
+		DeployType: d.Get("deploy_type").(int),
+		IoMax:      d.Get("io_max").(int),
+	}
+	resp := CreateAlikafkaOrderResponse{}
+	if err := client.alikafkaconn().Invoke("CreatePostPayOrder", &args, &resp); err != nil {
+		return WrapError(err, "CreatePostPayOrder", d.Id())
+	}
+
+	instance, err := client.DescribeAlikafkaInstanceByOrderId(resp.OrderId, deadline.Seconds())
+	if err != nil {
+		return fmt.Errorf("Getting the instance of the order %s got an error: %#v", resp.OrderId, err)
+	}
+	d.SetId(instance.InstanceId)
+
+	// The instance of the order is not deployed until it is started in the vswitch.
+	startArgs := StartAlikafkaInstanceArgs{
+		RegionId:     client.Region,
+		InstanceId:   d.Id(),
+		VpcId:        vpcId,
+		VSwitchId:    vswitchId,
+		ZoneId:       zoneId,
+		Name:         d.Get("name").(string),
+		DeployModule: "vpc",
+	}
+	if err := client.alikafkaconn().Invoke("StartInstance", &startArgs, &common.Response{}); err != nil {
+		return WrapError(err, "StartInstance", d.Id())
+	}
+
+	if err := client.WaitForAlikafkaInstance(d.Id(), AlikafkaInstanceRunning, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForAlikafkaInstance", d.Id())
+	}
+
+	return resourceAlicloudAlikafkaInstanceRead(d, meta)
+}
+
+func resourceAlicloudAlikafkaInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeAlikafkaInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err, "alikafka") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetInstanceList", d.Id())
+	}
+
+	d.Set("name", instance.Name)
+	d.Set("topic_quota", instance.TopicNumLimit)
+	d.Set("disk_type", instance.DiskType)
+	d.Set("disk_size", instance.DiskSize)
+	d.Set("deploy_type", instance.DeployType)
+	d.Set("io_max", instance.IoMax)
+	d.Set("vswitch_id", instance.VSwitchId)
+	d.Set("vpc_id", instance.VpcId)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("end_point", instance.EndPoint)
+
+	return nil
+}
+
+func resourceAlicloudAlikafkaInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		args := AlikafkaInstanceArgs{
+			RegionId:     client.Region,
+			InstanceId:   d.Id(),
+			InstanceName: d.Get("name").(string),
+		}
+		if err := client.alikafkaconn().Invoke("ModifyInstanceName", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyInstanceName", d.Id())
+		}
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("topic_quota") || d.HasChange("disk_size") || d.HasChange("io_max") {
+		args := UpgradeAlikafkaOrderArgs{
+			RegionId:   client.Region,
+			InstanceId: d.Id(),
+			TopicQuota: d.Get("topic_quota").(int),
+			DiskSize:   d.Get("disk_size").(int),
+			IoMax:      d.Get("io_max").(int),
+		}
+		if err := client.alikafkaconn().Invoke("UpgradePostPayOrder", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpgradePostPayOrder", d.Id())
+		}
+		if err := client.WaitForAlikafkaInstance(d.Id(), AlikafkaInstanceRunning, deadline.Seconds()); err != nil {
+			return WrapError(err, "WaitForAlikafkaInstance", d.Id())
+		}
+		d.SetPartial("topic_quota")
+		d.SetPartial("disk_size")
+		d.SetPartial("io_max")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudAlikafkaInstanceRead(d, meta)
+}
+
+func resourceAlicloudAlikafkaInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := AlikafkaInstanceArgs{
+		RegionId:            client.Region,
+		InstanceId:          d.Id(),
+		ReleaseIgnoreTime:   "true",
+		ForceDeleteInstance: "true",
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.alikafkaconn().Invoke("ReleaseInstance", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAlikafkaInstance(d.Id()); e != nil && NotFoundError(e, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "ReleaseInstance", d.Id()))
+		}
+
+		if _, err := client.DescribeAlikafkaInstance(d.Id()); err != nil {
+			if NotFoundError(err, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Alikafka instance %s is being released - trying again while it is released.", d.Id()))
+	})
+}
@@ -0,0 +1,144 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAlikafkaInstance_basic(t *testing.T) {
+	var v AlikafkaInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_alikafka_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlikafkaInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAlikafkaInstanceConfig("tf-testAccAlikafkaInstance", 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlikafkaInstanceExists(
+						"alicloud_alikafka_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_instance.foo",
+						"name",
+						"tf-testAccAlikafkaInstance"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_instance.foo",
+						"topic_quota",
+						"50"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_alikafka_instance.foo",
+						"end_point"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAlikafkaInstanceConfig("tf-testAccAlikafkaInstanceUpdate", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlikafkaInstanceExists(
+						"alicloud_alikafka_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_instance.foo",
+						"name",
+						"tf-testAccAlikafkaInstanceUpdate"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_instance.foo",
+						"topic_quota",
+						"60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlikafkaInstanceExists(n string, d *AlikafkaInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Alikafka instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instance, err := client.DescribeAlikafkaInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *instance
+		return nil
+	}
+}
+
+func testAccCheckAlikafkaInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_alikafka_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeAlikafkaInstance(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "alikafka") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Alikafka instance %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAlikafkaInstanceConfig(name string, topicQuota int) string {
+	return fmt.Sprintf(testAccAlikafkaInstanceBase+`
+resource "alicloud_alikafka_instance" "foo" {
+  name = "%s"
+  topic_quota = %d
+  disk_type = 1
+  disk_size = 500
+  io_max = 20
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`, name, topicQuota)
+}
+
+const testAccAlikafkaInstanceBase = `
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+`
+
+// testAccAlikafkaInstanceForSub is shared by the resources which belong to an Alikafka instance.
+const testAccAlikafkaInstanceForSub = testAccAlikafkaInstanceBase + `
+resource "alicloud_alikafka_instance" "foo" {
+  name = "tf-testAccAlikafkaInstance"
+  topic_quota = 50
+  disk_type = 1
+  disk_size = 500
+  io_max = 20
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`
@@ -0,0 +1,158 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAlikafkaSaslAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAlikafkaSaslAclCreate,
+		Read:   resourceAlicloudAlikafkaSaslAclRead,
+		Delete: resourceAlicloudAlikafkaSaslAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"acl_resource_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(AlikafkaAclResourceTypes),
+			},
+			"acl_resource_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"acl_resource_pattern_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(AlikafkaAclPatternTypes),
+			},
+			"acl_operation_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(AlikafkaAclOperationTypes),
+			},
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudAlikafkaSaslAclCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := AlikafkaAclArgs{
+		RegionId:               client.Region,
+		InstanceId:             d.Get("instance_id").(string),
+		Username:               d.Get("username").(string),
+		AclResourceType:        d.Get("acl_resource_type").(string),
+		AclResourceName:        d.Get("acl_resource_name").(string),
+		AclResourcePatternType: d.Get("acl_resource_pattern_type").(string),
+		AclOperationType:       d.Get("acl_operation_type").(string),
+	}
+	if err := client.alikafkaconn().Invoke("CreateAcl", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateAcl", d.Id())
+	}
+
+	d.SetId(strings.Join([]string{args.InstanceId, args.Username, args.AclResourceType, args.AclResourceName,
+		args.AclResourcePatternType, args.AclOperationType}, COLON_SEPARATED))
+
+	return resourceAlicloudAlikafkaSaslAclRead(d, meta)
+}
+
+func resourceAlicloudAlikafkaSaslAclRead(d *schema.ResourceData, meta interface{}) error {
+	args, err := parseAlikafkaSaslAclId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	acl, err := meta.(*AliyunClient).DescribeAlikafkaAcl(args)
+	if err != nil {
+		if NotFoundError(err, "alikafka") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeAcls", d.Id())
+	}
+
+	d.Set("instance_id", args.InstanceId)
+	d.Set("username", acl.Username)
+	d.Set("acl_resource_type", acl.AclResourceType)
+	d.Set("acl_resource_name", acl.AclResourceName)
+	d.Set("acl_resource_pattern_type", acl.AclResourcePatternType)
+	d.Set("acl_operation_type", acl.AclOperationType)
+	d.Set("host", acl.Host)
+
+	return nil
+}
+
+func resourceAlicloudAlikafkaSaslAclDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	args, err := parseAlikafkaSaslAclId(d.Id())
+	if err != nil {
+		return err
+	}
+	args.RegionId = client.Region
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.alikafkaconn().Invoke("DeleteAcl", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAlikafkaAcl(args); e != nil && NotFoundError(e, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteAcl", d.Id()))
+		}
+
+		if _, err := client.DescribeAlikafkaAcl(args); err != nil {
+			if NotFoundError(err, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Alikafka acl %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+// parseAlikafkaSaslAclId splits the ID '<instance id>:<username>:<resource type>:<resource name>:<pattern type>:<operation type>'.
+func parseAlikafkaSaslAclId(id string) (AlikafkaAclArgs, error) {
+	parts, err := parseResourceId(id, 6)
+	if err != nil {
+		return AlikafkaAclArgs{}, err
+	}
+	return AlikafkaAclArgs{
+		InstanceId:             parts[0],
+		Username:               parts[1],
+		AclResourceType:        parts[2],
+		AclResourceName:        parts[3],
+		AclResourcePatternType: parts[4],
+		AclOperationType:       parts[5],
+	}, nil
+}
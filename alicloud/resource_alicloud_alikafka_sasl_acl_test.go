@@ -0,0 +1,129 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAlikafkaSaslAcl_basic(t *testing.T) {
+	var v AlikafkaAcl
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_alikafka_sasl_acl.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlikafkaSaslAclDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAlikafkaSaslAclConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlikafkaSaslAclExists(
+						"alicloud_alikafka_sasl_acl.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_sasl_acl.foo",
+						"username",
+						"tf-testAccAlikafkaSaslAcl"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_sasl_acl.foo",
+						"acl_resource_type",
+						"Topic"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_sasl_acl.foo",
+						"acl_resource_name",
+						"tf-testAccAlikafkaSaslAcl"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_sasl_acl.foo",
+						"acl_resource_pattern_type",
+						"LITERAL"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_sasl_acl.foo",
+						"acl_operation_type",
+						"Write"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlikafkaSaslAclExists(n string, d *AlikafkaAcl) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Alikafka acl ID is set")
+		}
+
+		args, err := parseAlikafkaSaslAclId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		acl, err := client.DescribeAlikafkaAcl(args)
+		if err != nil {
+			return err
+		}
+
+		*d = *acl
+		return nil
+	}
+}
+
+func testAccCheckAlikafkaSaslAclDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_alikafka_sasl_acl" {
+			continue
+		}
+
+		args, err := parseAlikafkaSaslAclId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeAlikafkaAcl(args); err != nil {
+			if NotFoundError(err, "alikafka") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Alikafka acl %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAlikafkaSaslAclConfig = testAccAlikafkaInstanceForSub + `
+resource "alicloud_alikafka_topic" "foo" {
+  instance_id = "${alicloud_alikafka_instance.foo.id}"
+  topic = "tf-testAccAlikafkaSaslAcl"
+  remark = "tf-testAccAlikafkaSaslAcl"
+}
+
+resource "alicloud_alikafka_sasl_user" "foo" {
+  instance_id = "${alicloud_alikafka_instance.foo.id}"
+  username = "tf-testAccAlikafkaSaslAcl"
+  password = "Test12345"
+}
+
+resource "alicloud_alikafka_sasl_acl" "foo" {
+  instance_id = "${alicloud_alikafka_instance.foo.id}"
+  username = "${alicloud_alikafka_sasl_user.foo.username}"
+  acl_resource_type = "Topic"
+  acl_resource_name = "${alicloud_alikafka_topic.foo.topic}"
+  acl_resource_pattern_type = "LITERAL"
+  acl_operation_type = "Write"
+}
+`
@@ -0,0 +1,151 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAlikafkaSaslUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAlikafkaSaslUserCreate,
+		Read:   resourceAlicloudAlikafkaSaslUserRead,
+		Update: resourceAlicloudAlikafkaSaslUserUpdate,
+		Delete: resourceAlicloudAlikafkaSaslUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 64),
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "plain",
+				ValidateFunc: validateAllowedStringValue(AlikafkaSaslUserTypes),
+			},
+		},
+	}
+}
+
+func resourceAlicloudAlikafkaSaslUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := AlikafkaSaslUserArgs{
+		RegionId:   client.Region,
+		InstanceId: d.Get("instance_id").(string),
+		Username:   d.Get("username").(string),
+		Password:   d.Get("password").(string),
+		Type:       d.Get("type").(string),
+	}
+	if err := client.alikafkaconn().Invoke("CreateSaslUser", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateSaslUser", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.InstanceId, COLON_SEPARATED, args.Username))
+
+	return resourceAlicloudAlikafkaSaslUserRead(d, meta)
+}
+
+func resourceAlicloudAlikafkaSaslUserRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	user, err := meta.(*AliyunClient).DescribeAlikafkaSaslUser(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "alikafka") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeSaslUsers", d.Id())
+	}
+
+	// The password is not read back, and it is kept as it is in the state.
+	d.Set("instance_id", parts[0])
+	d.Set("username", user.Username)
+	d.Set("type", user.Type)
+
+	return nil
+}
+
+func resourceAlicloudAlikafkaSaslUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// The password of the existing user is overwritten by CreateSaslUser.
+	if d.HasChange("password") {
+		parts, err := parseResourceId(d.Id(), 2)
+		if err != nil {
+			return err
+		}
+		args := AlikafkaSaslUserArgs{
+			RegionId:   client.Region,
+			InstanceId: parts[0],
+			Username:   parts[1],
+			Password:   d.Get("password").(string),
+			Type:       d.Get("type").(string),
+		}
+		if err := client.alikafkaconn().Invoke("CreateSaslUser", &args, &common.Response{}); err != nil {
+			return WrapError(err, "CreateSaslUser", d.Id())
+		}
+	}
+
+	return resourceAlicloudAlikafkaSaslUserRead(d, meta)
+}
+
+func resourceAlicloudAlikafkaSaslUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := AlikafkaSaslUserArgs{
+		RegionId:   client.Region,
+		InstanceId: parts[0],
+		Username:   parts[1],
+		Type:       d.Get("type").(string),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.alikafkaconn().Invoke("DeleteSaslUser", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAlikafkaSaslUser(parts[0], parts[1]); e != nil && NotFoundError(e, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteSaslUser", d.Id()))
+		}
+
+		if _, err := client.DescribeAlikafkaSaslUser(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Alikafka sasl user %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,115 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAlikafkaSaslUser_basic(t *testing.T) {
+	var v AlikafkaSaslUser
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_alikafka_sasl_user.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlikafkaSaslUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAlikafkaSaslUserConfig("Test12345"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlikafkaSaslUserExists(
+						"alicloud_alikafka_sasl_user.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_sasl_user.foo",
+						"username",
+						"tf-testAccAlikafkaSaslUser"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_sasl_user.foo",
+						"type",
+						"plain"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAlikafkaSaslUserConfig("Test54321"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlikafkaSaslUserExists(
+						"alicloud_alikafka_sasl_user.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_sasl_user.foo",
+						"password",
+						"Test54321"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlikafkaSaslUserExists(n string, d *AlikafkaSaslUser) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Alikafka sasl user ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		user, err := client.DescribeAlikafkaSaslUser(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *user
+		return nil
+	}
+}
+
+func testAccCheckAlikafkaSaslUserDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_alikafka_sasl_user" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeAlikafkaSaslUser(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "alikafka") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Alikafka sasl user %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAlikafkaSaslUserConfig(password string) string {
+	return fmt.Sprintf(testAccAlikafkaInstanceForSub+`
+resource "alicloud_alikafka_sasl_user" "foo" {
+  instance_id = "${alicloud_alikafka_instance.foo.id}"
+  username = "tf-testAccAlikafkaSaslUser"
+  password = "%s"
+}
+`, password)
+}
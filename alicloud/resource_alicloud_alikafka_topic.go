@@ -0,0 +1,184 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAlikafkaTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAlikafkaTopicCreate,
+		Read:   resourceAlicloudAlikafkaTopicRead,
+		Update: resourceAlicloudAlikafkaTopicUpdate,
+		Delete: resourceAlicloudAlikafkaTopicDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 64),
+			},
+			"local_topic": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"compact_topic": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"partition_num": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      12,
+				ValidateFunc: validateIntegerInRange(1, 360),
+			},
+			"remark": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 64),
+			},
+		},
+	}
+}
+
+func resourceAlicloudAlikafkaTopicCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := AlikafkaTopicArgs{
+		RegionId:     client.Region,
+		InstanceId:   d.Get("instance_id").(string),
+		Topic:        d.Get("topic").(string),
+		Remark:       d.Get("remark").(string),
+		LocalTopic:   strconv.FormatBool(d.Get("local_topic").(bool)),
+		CompactTopic: strconv.FormatBool(d.Get("compact_topic").(bool)),
+		PartitionNum: d.Get("partition_num").(int),
+	}
+	if err := client.alikafkaconn().Invoke("CreateTopic", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateTopic", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.InstanceId, COLON_SEPARATED, args.Topic))
+
+	return resourceAlicloudAlikafkaTopicRead(d, meta)
+}
+
+func resourceAlicloudAlikafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	topic, err := meta.(*AliyunClient).DescribeAlikafkaTopic(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "alikafka") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetTopicList", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("topic", topic.Topic)
+	d.Set("local_topic", topic.LocalTopic)
+	d.Set("compact_topic", topic.CompactTopic)
+	d.Set("partition_num", topic.PartitionNum)
+	d.Set("remark", topic.Remark)
+
+	return nil
+}
+
+func resourceAlicloudAlikafkaTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	d.Partial(true)
+
+	if d.HasChange("remark") {
+		args := AlikafkaTopicArgs{
+			RegionId:   client.Region,
+			InstanceId: parts[0],
+			Topic:      parts[1],
+			Remark:     d.Get("remark").(string),
+		}
+		if err := client.alikafkaconn().Invoke("ModifyTopicRemark", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyTopicRemark", d.Id())
+		}
+		d.SetPartial("remark")
+	}
+
+	if d.HasChange("partition_num") {
+		o, n := d.GetChange("partition_num")
+		if n.(int) < o.(int) {
+			return ConfigErrorf(ErrorCodeUnsupportedOperation, "The partition_num of the topic %s can not be decreased from %d to %d.", d.Id(), o.(int), n.(int))
+		}
+		args := AlikafkaTopicArgs{
+			RegionId:        client.Region,
+			InstanceId:      parts[0],
+			Topic:           parts[1],
+			AddPartitionNum: n.(int) - o.(int),
+		}
+		if err := client.alikafkaconn().Invoke("ModifyPartitionNum", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyPartitionNum", d.Id())
+		}
+		d.SetPartial("partition_num")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudAlikafkaTopicRead(d, meta)
+}
+
+func resourceAlicloudAlikafkaTopicDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := AlikafkaTopicArgs{
+		RegionId:   client.Region,
+		InstanceId: parts[0],
+		Topic:      parts[1],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.alikafkaconn().Invoke("DeleteTopic", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAlikafkaTopic(parts[0], parts[1]); e != nil && NotFoundError(e, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteTopic", d.Id()))
+		}
+
+		if _, err := client.DescribeAlikafkaTopic(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "alikafka") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Alikafka topic %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAlikafkaTopic_basic(t *testing.T) {
+	var v AlikafkaTopic
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_alikafka_topic.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlikafkaTopicDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAlikafkaTopicConfig("tf-testAccAlikafkaTopic", 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlikafkaTopicExists(
+						"alicloud_alikafka_topic.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_topic.foo",
+						"topic",
+						"tf-testAccAlikafkaTopic"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_topic.foo",
+						"partition_num",
+						"12"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_topic.foo",
+						"remark",
+						"tf-testAccAlikafkaTopic"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAlikafkaTopicConfig("tf-testAccAlikafkaTopicUpdate", 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlikafkaTopicExists(
+						"alicloud_alikafka_topic.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_topic.foo",
+						"partition_num",
+						"24"),
+					resource.TestCheckResourceAttr(
+						"alicloud_alikafka_topic.foo",
+						"remark",
+						"tf-testAccAlikafkaTopicUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlikafkaTopicExists(n string, d *AlikafkaTopic) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Alikafka topic ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		topic, err := client.DescribeAlikafkaTopic(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *topic
+		return nil
+	}
+}
+
+func testAccCheckAlikafkaTopicDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_alikafka_topic" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeAlikafkaTopic(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "alikafka") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Alikafka topic %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAlikafkaTopicConfig(remark string, partitionNum int) string {
+	return fmt.Sprintf(testAccAlikafkaInstanceForSub+`
+resource "alicloud_alikafka_topic" "foo" {
+  instance_id = "${alicloud_alikafka_instance.foo.id}"
+  topic = "tf-testAccAlikafkaTopic"
+  remark = "%s"
+  partition_num = %d
+}
+`, remark, partitionNum)
+}
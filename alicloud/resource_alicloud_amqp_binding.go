@@ -0,0 +1,158 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAmqpBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAmqpBindingCreate,
+		Read:   resourceAlicloudAmqpBindingRead,
+		Delete: resourceAlicloudAmqpBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"virtual_host_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_exchange": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"binding_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(AmqpBindingTypes),
+			},
+			"binding_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"argument": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudAmqpBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := AmqpBindingArgs{
+		InstanceId:      d.Get("instance_id").(string),
+		VirtualHost:     d.Get("virtual_host_name").(string),
+		SourceExchange:  d.Get("source_exchange").(string),
+		DestinationName: d.Get("destination_name").(string),
+		BindingType:     d.Get("binding_type").(string),
+		BindingKey:      d.Get("binding_key").(string),
+		Argument:        d.Get("argument").(string),
+	}
+	if err := client.amqpconn().Invoke("CreateBinding", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateBinding", d.Id())
+	}
+
+	d.SetId(strings.Join([]string{args.InstanceId, args.VirtualHost, args.SourceExchange, args.BindingType,
+		args.DestinationName, args.BindingKey}, COLON_SEPARATED))
+
+	return resourceAlicloudAmqpBindingRead(d, meta)
+}
+
+func resourceAlicloudAmqpBindingRead(d *schema.ResourceData, meta interface{}) error {
+	args, err := parseAmqpBindingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	binding, err := meta.(*AliyunClient).DescribeAmqpBinding(args)
+	if err != nil {
+		if NotFoundError(err, "amqp") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListBindings", d.Id())
+	}
+
+	d.Set("instance_id", args.InstanceId)
+	d.Set("virtual_host_name", args.VirtualHost)
+	d.Set("source_exchange", binding.SourceExchange)
+	d.Set("destination_name", binding.DestinationName)
+	d.Set("binding_type", binding.BindingType)
+	d.Set("binding_key", binding.BindingKey)
+	d.Set("argument", binding.Argument)
+
+	return nil
+}
+
+func resourceAlicloudAmqpBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	args, err := parseAmqpBindingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.amqpconn().Invoke("DeleteBinding", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAmqpBinding(args); e != nil && NotFoundError(e, "amqp") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteBinding", d.Id()))
+		}
+
+		if _, err := client.DescribeAmqpBinding(args); err != nil {
+			if NotFoundError(err, "amqp") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("AMQP binding %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+// parseAmqpBindingId splits the ID '<instance id>:<virtual host>:<source exchange>:<binding type>:<destination name>:<binding key>',
+// and the binding key is the last one since it may contain ':'.
+func parseAmqpBindingId(id string) (AmqpBindingArgs, error) {
+	parts, err := parseResourceId(id, 6)
+	if err != nil {
+		return AmqpBindingArgs{}, err
+	}
+	return AmqpBindingArgs{
+		InstanceId:      parts[0],
+		VirtualHost:     parts[1],
+		SourceExchange:  parts[2],
+		BindingType:     parts[3],
+		DestinationName: parts[4],
+		BindingKey:      parts[5],
+	}, nil
+}
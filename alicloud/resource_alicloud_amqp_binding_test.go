@@ -0,0 +1,134 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAmqpBinding_basic(t *testing.T) {
+	var v AmqpBinding
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithAmqpInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_amqp_binding.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmqpBindingDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAmqpBindingConfig(os.Getenv("ALICLOUD_AMQP_INSTANCE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmqpBindingExists(
+						"alicloud_amqp_binding.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_binding.foo",
+						"source_exchange",
+						"tf-testAccAmqpBinding"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_binding.foo",
+						"destination_name",
+						"tf-testAccAmqpBinding"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_binding.foo",
+						"binding_type",
+						"QUEUE"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_binding.foo",
+						"binding_key",
+						"tf.test.key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAmqpBindingExists(n string, d *AmqpBinding) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AMQP binding ID is set")
+		}
+
+		args, err := parseAmqpBindingId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		binding, err := client.DescribeAmqpBinding(args)
+		if err != nil {
+			return err
+		}
+
+		*d = *binding
+		return nil
+	}
+}
+
+func testAccCheckAmqpBindingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_amqp_binding" {
+			continue
+		}
+
+		args, err := parseAmqpBindingId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeAmqpBinding(args); err != nil {
+			if NotFoundError(err, "amqp") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("AMQP binding %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAmqpBindingConfig(instanceId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_amqp_virtual_host" "foo" {
+  instance_id = "%s"
+  virtual_host_name = "tf-testAccAmqpBinding"
+}
+
+resource "alicloud_amqp_exchange" "foo" {
+  instance_id = "${alicloud_amqp_virtual_host.foo.instance_id}"
+  virtual_host_name = "${alicloud_amqp_virtual_host.foo.virtual_host_name}"
+  exchange_name = "tf-testAccAmqpBinding"
+  exchange_type = "TOPIC"
+}
+
+resource "alicloud_amqp_queue" "foo" {
+  instance_id = "${alicloud_amqp_virtual_host.foo.instance_id}"
+  virtual_host_name = "${alicloud_amqp_virtual_host.foo.virtual_host_name}"
+  queue_name = "tf-testAccAmqpBinding"
+}
+
+resource "alicloud_amqp_binding" "foo" {
+  instance_id = "${alicloud_amqp_virtual_host.foo.instance_id}"
+  virtual_host_name = "${alicloud_amqp_virtual_host.foo.virtual_host_name}"
+  source_exchange = "${alicloud_amqp_exchange.foo.exchange_name}"
+  destination_name = "${alicloud_amqp_queue.foo.queue_name}"
+  binding_type = "QUEUE"
+  binding_key = "tf.test.key"
+}
+`, instanceId)
+}
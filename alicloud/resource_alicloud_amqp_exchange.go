@@ -0,0 +1,145 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAmqpExchange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAmqpExchangeCreate,
+		Read:   resourceAlicloudAmqpExchangeRead,
+		Delete: resourceAlicloudAmqpExchangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"virtual_host_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exchange_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 255),
+			},
+			"exchange_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(AmqpExchangeTypes),
+			},
+			"auto_delete_state": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"internal": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"alternate_exchange": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudAmqpExchangeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := AmqpExchangeArgs{
+		InstanceId:        d.Get("instance_id").(string),
+		VirtualHost:       d.Get("virtual_host_name").(string),
+		ExchangeName:      d.Get("exchange_name").(string),
+		ExchangeType:      d.Get("exchange_type").(string),
+		AutoDeleteState:   strconv.FormatBool(d.Get("auto_delete_state").(bool)),
+		Internal:          strconv.FormatBool(d.Get("internal").(bool)),
+		AlternateExchange: d.Get("alternate_exchange").(string),
+	}
+	if err := client.amqpconn().Invoke("CreateExchange", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateExchange", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", args.InstanceId, COLON_SEPARATED, args.VirtualHost, COLON_SEPARATED, args.ExchangeName))
+
+	return resourceAlicloudAmqpExchangeRead(d, meta)
+}
+
+func resourceAlicloudAmqpExchangeRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return err
+	}
+
+	exchange, err := meta.(*AliyunClient).DescribeAmqpExchange(parts[0], parts[1], parts[2])
+	if err != nil {
+		if NotFoundError(err, "amqp") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListExchanges", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("virtual_host_name", parts[1])
+	d.Set("exchange_name", exchange.Name)
+	d.Set("exchange_type", exchange.ExchangeType)
+	d.Set("auto_delete_state", exchange.AutoDeleteState)
+
+	return nil
+}
+
+func resourceAlicloudAmqpExchangeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return err
+	}
+
+	args := AmqpExchangeArgs{
+		InstanceId:   parts[0],
+		VirtualHost:  parts[1],
+		ExchangeName: parts[2],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.amqpconn().Invoke("DeleteExchange", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAmqpExchange(parts[0], parts[1], parts[2]); e != nil && NotFoundError(e, "amqp") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteExchange", d.Id()))
+		}
+
+		if _, err := client.DescribeAmqpExchange(parts[0], parts[1], parts[2]); err != nil {
+			if NotFoundError(err, "amqp") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("AMQP exchange %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
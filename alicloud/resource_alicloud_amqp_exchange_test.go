@@ -0,0 +1,115 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAmqpExchange_basic(t *testing.T) {
+	var v AmqpExchange
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithAmqpInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_amqp_exchange.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmqpExchangeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAmqpExchangeConfig(os.Getenv("ALICLOUD_AMQP_INSTANCE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmqpExchangeExists(
+						"alicloud_amqp_exchange.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_exchange.foo",
+						"exchange_name",
+						"tf-testAccAmqpExchange"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_exchange.foo",
+						"exchange_type",
+						"DIRECT"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_exchange.foo",
+						"auto_delete_state",
+						"false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAmqpExchangeExists(n string, d *AmqpExchange) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AMQP exchange ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 3)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		exchange, err := client.DescribeAmqpExchange(parts[0], parts[1], parts[2])
+		if err != nil {
+			return err
+		}
+
+		*d = *exchange
+		return nil
+	}
+}
+
+func testAccCheckAmqpExchangeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_amqp_exchange" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 3)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeAmqpExchange(parts[0], parts[1], parts[2]); err != nil {
+			if NotFoundError(err, "amqp") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("AMQP exchange %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAmqpExchangeConfig(instanceId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_amqp_virtual_host" "foo" {
+  instance_id = "%s"
+  virtual_host_name = "tf-testAccAmqpExchange"
+}
+
+resource "alicloud_amqp_exchange" "foo" {
+  instance_id = "${alicloud_amqp_virtual_host.foo.instance_id}"
+  virtual_host_name = "${alicloud_amqp_virtual_host.foo.virtual_host_name}"
+  exchange_name = "tf-testAccAmqpExchange"
+  exchange_type = "DIRECT"
+}
+`, instanceId)
+}
@@ -0,0 +1,180 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAmqpInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAmqpInstanceCreate,
+		Read:   resourceAlicloudAmqpInstanceRead,
+		Update: resourceAlicloudAmqpInstanceUpdate,
+		Delete: resourceAlicloudAmqpInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringLengthInRange(3, 64),
+			},
+			"instance_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(AmqpInstanceTypes),
+			},
+			"max_tps": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"queue_capacity": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"support_eip": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"max_eip_tps": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 6, 12, 24}),
+			},
+			"renewal_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ManualRenewal",
+				ValidateFunc: validateAllowedStringValue([]string{"AutoRenewal", "ManualRenewal", "NotRenewal"}),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudAmqpInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	supportEip := d.Get("support_eip").(bool)
+	if supportEip && d.Get("max_eip_tps").(string) == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "'max_eip_tps' is required when 'support_eip' is true.")
+	}
+
+	args := CreateBssInstanceArgs{
+		ProductCode:      "ons",
+		ProductType:      "ons_onsproxy_pre",
+		SubscriptionType: "Subscription",
+		Period:           d.Get("period").(int),
+		RenewalStatus:    d.Get("renewal_status").(string),
+		Parameter: []BssInstanceParameter{
+			{Code: "Region", Value: string(client.Region)},
+			{Code: "InstanceType", Value: d.Get("instance_type").(string)},
+			{Code: "MaxTps", Value: d.Get("max_tps").(string)},
+			{Code: "QueueCapacity", Value: d.Get("queue_capacity").(string)},
+			{Code: "SupportEip", Value: strconv.FormatBool(supportEip)},
+		},
+	}
+	if args.RenewalStatus == "AutoRenewal" {
+		args.RenewPeriod = args.Period
+	}
+	if supportEip {
+		args.Parameter = append(args.Parameter, BssInstanceParameter{Code: "MaxEipTps", Value: d.Get("max_eip_tps").(string)})
+	}
+
+	resp := CreateBssInstanceResponse{}
+	if err := client.bssconn().Invoke("CreateInstance", &args, &resp); err != nil {
+		return WrapError(err, "CreateInstance", d.Id())
+	}
+	if !resp.Success {
+		return fmt.Errorf("CreateInstance got an error: %s %s", resp.Code, resp.Message)
+	}
+
+	d.SetId(resp.Data.InstanceId)
+
+	if err := client.WaitForAmqpInstance(d.Id(), AmqpInstanceServing, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForAmqpInstance", d.Id())
+	}
+
+	return resourceAlicloudAmqpInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudAmqpInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeAmqpInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err, "amqp") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListInstances", d.Id())
+	}
+
+	d.Set("instance_name", instance.InstanceName)
+	d.Set("instance_type", instance.InstanceType)
+	d.Set("support_eip", instance.SupportEIP)
+	d.Set("status", instance.Status)
+	d.Set("private_endpoint", instance.PrivateEndpoint)
+	d.Set("public_endpoint", instance.PublicEndpoint)
+
+	return nil
+}
+
+func resourceAlicloudAmqpInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// The name of a new instance is the same as its ID.
+	if d.HasChange("instance_name") && d.Get("instance_name").(string) != "" {
+		args := AmqpInstanceArgs{
+			InstanceId:   d.Id(),
+			InstanceName: d.Get("instance_name").(string),
+		}
+		if err := client.amqpconn().Invoke("UpdateInstanceName", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateInstanceName", d.Id())
+		}
+	}
+
+	return resourceAlicloudAmqpInstanceRead(d, meta)
+}
+
+func resourceAlicloudAmqpInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	// The subscription instance can not be released by API, and it is released automatically after it is expired.
+	log.Printf("[WARN] AMQP instance %s can not be deleted and it is only removed from the state. "+
+		"It will be released automatically after it is expired.", d.Id())
+	return nil
+}
@@ -0,0 +1,92 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The subscription AMQP instance can not be released by API, so there is no destroy check and
+// the instance is released automatically after it is expired.
+func TestAccAlicloudAmqpInstance_basic(t *testing.T) {
+	var v AmqpInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_amqp_instance.foo",
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAmqpInstanceConfig("tf-testAccAmqpInstance"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmqpInstanceExists(
+						"alicloud_amqp_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_instance.foo",
+						"instance_name",
+						"tf-testAccAmqpInstance"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_instance.foo",
+						"instance_type",
+						"professional"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_instance.foo",
+						"status",
+						"SERVING"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAmqpInstanceConfig("tf-testAccAmqpInstanceUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmqpInstanceExists(
+						"alicloud_amqp_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_instance.foo",
+						"instance_name",
+						"tf-testAccAmqpInstanceUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAmqpInstanceExists(n string, d *AmqpInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AMQP instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instance, err := client.DescribeAmqpInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *instance
+		return nil
+	}
+}
+
+func testAccAmqpInstanceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_amqp_instance" "foo" {
+  instance_name = "%s"
+  instance_type = "professional"
+  max_tps = "1000"
+  queue_capacity = "50"
+  period = 1
+}
+`, name)
+}
@@ -0,0 +1,170 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAmqpQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAmqpQueueCreate,
+		Read:   resourceAlicloudAmqpQueueRead,
+		Delete: resourceAlicloudAmqpQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"virtual_host_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"queue_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 255),
+			},
+			"auto_delete_state": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"exclusive_state": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"message_ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"max_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"auto_expire_state": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dead_letter_exchange": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dead_letter_routing_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"maximum_priority": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 10),
+			},
+		},
+	}
+}
+
+func resourceAlicloudAmqpQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := AmqpQueueArgs{
+		InstanceId:           d.Get("instance_id").(string),
+		VirtualHost:          d.Get("virtual_host_name").(string),
+		QueueName:            d.Get("queue_name").(string),
+		AutoDeleteState:      strconv.FormatBool(d.Get("auto_delete_state").(bool)),
+		ExclusiveState:       strconv.FormatBool(d.Get("exclusive_state").(bool)),
+		MessageTTL:           d.Get("message_ttl").(int),
+		MaxLength:            d.Get("max_length").(int),
+		AutoExpireState:      d.Get("auto_expire_state").(int),
+		DeadLetterExchange:   d.Get("dead_letter_exchange").(string),
+		DeadLetterRoutingKey: d.Get("dead_letter_routing_key").(string),
+		MaximumPriority:      d.Get("maximum_priority").(int),
+	}
+	if err := client.amqpconn().Invoke("CreateQueue", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateQueue", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", args.InstanceId, COLON_SEPARATED, args.VirtualHost, COLON_SEPARATED, args.QueueName))
+
+	return resourceAlicloudAmqpQueueRead(d, meta)
+}
+
+func resourceAlicloudAmqpQueueRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return err
+	}
+
+	queue, err := meta.(*AliyunClient).DescribeAmqpQueue(parts[0], parts[1], parts[2])
+	if err != nil {
+		if NotFoundError(err, "amqp") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListQueues", d.Id())
+	}
+
+	// The other arguments are not returned by ListQueues, and they are kept as they are in the state.
+	d.Set("instance_id", parts[0])
+	d.Set("virtual_host_name", parts[1])
+	d.Set("queue_name", queue.Name)
+	d.Set("auto_delete_state", queue.AutoDeleteState)
+	d.Set("exclusive_state", queue.ExclusiveState)
+
+	return nil
+}
+
+func resourceAlicloudAmqpQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return err
+	}
+
+	args := AmqpQueueArgs{
+		InstanceId:  parts[0],
+		VirtualHost: parts[1],
+		QueueName:   parts[2],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.amqpconn().Invoke("DeleteQueue", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAmqpQueue(parts[0], parts[1], parts[2]); e != nil && NotFoundError(e, "amqp") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteQueue", d.Id()))
+		}
+
+		if _, err := client.DescribeAmqpQueue(parts[0], parts[1], parts[2]); err != nil {
+			if NotFoundError(err, "amqp") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("AMQP queue %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAmqpQueue_basic(t *testing.T) {
+	var v AmqpQueue
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithAmqpInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_amqp_queue.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmqpQueueDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAmqpQueueConfig(os.Getenv("ALICLOUD_AMQP_INSTANCE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmqpQueueExists(
+						"alicloud_amqp_queue.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_queue.foo",
+						"queue_name",
+						"tf-testAccAmqpQueue"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_queue.foo",
+						"auto_delete_state",
+						"false"),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_queue.foo",
+						"exclusive_state",
+						"false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAmqpQueueExists(n string, d *AmqpQueue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AMQP queue ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 3)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		queue, err := client.DescribeAmqpQueue(parts[0], parts[1], parts[2])
+		if err != nil {
+			return err
+		}
+
+		*d = *queue
+		return nil
+	}
+}
+
+func testAccCheckAmqpQueueDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_amqp_queue" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 3)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeAmqpQueue(parts[0], parts[1], parts[2]); err != nil {
+			if NotFoundError(err, "amqp") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("AMQP queue %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAmqpQueueConfig(instanceId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_amqp_virtual_host" "foo" {
+  instance_id = "%s"
+  virtual_host_name = "tf-testAccAmqpQueue"
+}
+
+resource "alicloud_amqp_queue" "foo" {
+  instance_id = "${alicloud_amqp_virtual_host.foo.instance_id}"
+  virtual_host_name = "${alicloud_amqp_virtual_host.foo.virtual_host_name}"
+  queue_name = "tf-testAccAmqpQueue"
+  message_ttl = 60000
+  max_length = 1000
+}
+`, instanceId)
+}
@@ -0,0 +1,107 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudAmqpVirtualHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudAmqpVirtualHostCreate,
+		Read:   resourceAlicloudAmqpVirtualHostRead,
+		Delete: resourceAlicloudAmqpVirtualHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"virtual_host_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 255),
+			},
+		},
+	}
+}
+
+func resourceAlicloudAmqpVirtualHostCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := AmqpVirtualHostArgs{
+		InstanceId:  d.Get("instance_id").(string),
+		VirtualHost: d.Get("virtual_host_name").(string),
+	}
+	if err := client.amqpconn().Invoke("CreateVirtualHost", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateVirtualHost", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.InstanceId, COLON_SEPARATED, args.VirtualHost))
+
+	return resourceAlicloudAmqpVirtualHostRead(d, meta)
+}
+
+func resourceAlicloudAmqpVirtualHostRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	vhost, err := meta.(*AliyunClient).DescribeAmqpVirtualHost(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "amqp") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListVirtualHosts", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("virtual_host_name", vhost.Name)
+
+	return nil
+}
+
+func resourceAlicloudAmqpVirtualHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := AmqpVirtualHostArgs{
+		InstanceId:  parts[0],
+		VirtualHost: parts[1],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.amqpconn().Invoke("DeleteVirtualHost", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeAmqpVirtualHost(parts[0], parts[1]); e != nil && NotFoundError(e, "amqp") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteVirtualHost", d.Id()))
+		}
+
+		if _, err := client.DescribeAmqpVirtualHost(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "amqp") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("AMQP virtual host %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
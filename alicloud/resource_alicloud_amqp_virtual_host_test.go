@@ -0,0 +1,100 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudAmqpVirtualHost_basic(t *testing.T) {
+	var v AmqpVirtualHost
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithAmqpInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_amqp_virtual_host.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmqpVirtualHostDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAmqpVirtualHostConfig(os.Getenv("ALICLOUD_AMQP_INSTANCE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmqpVirtualHostExists(
+						"alicloud_amqp_virtual_host.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_amqp_virtual_host.foo",
+						"virtual_host_name",
+						"tf-testAccAmqpVirtualHost"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAmqpVirtualHostExists(n string, d *AmqpVirtualHost) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AMQP virtual host ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		vhost, err := client.DescribeAmqpVirtualHost(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *vhost
+		return nil
+	}
+}
+
+func testAccCheckAmqpVirtualHostDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_amqp_virtual_host" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeAmqpVirtualHost(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "amqp") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("AMQP virtual host %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAmqpVirtualHostConfig(instanceId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_amqp_virtual_host" "foo" {
+  instance_id = "%s"
+  virtual_host_name = "tf-testAccAmqpVirtualHost"
+}
+`, instanceId)
+}
@@ -0,0 +1,636 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayApi() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayApiCreate,
+		Read:   resourceAlicloudApiGatewayApiRead,
+		Update: resourceAlicloudApiGatewayApiUpdate,
+		Delete: resourceAlicloudApiGatewayApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"auth_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "APP",
+				ValidateFunc: validateAllowedStringValue(CloudApiAuthTypes),
+			},
+			"request_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(CloudApiRequestProtocols),
+						},
+						"method": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(CloudApiHttpMethods),
+						},
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"mode": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "MAPPING",
+							ValidateFunc: validateAllowedStringValue(CloudApiRequestModes),
+						},
+						"body_format": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"FORM", "STREAM"}),
+						},
+					},
+				},
+			},
+			"service_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(CloudApiServiceTypes),
+			},
+			"http_service_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"method": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(CloudApiHttpMethods),
+						},
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"timeout": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10000,
+							ValidateFunc: validateIntegerInRange(1, 60000),
+						},
+					},
+				},
+			},
+			"http_vpc_service_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"method": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(CloudApiHttpMethods),
+						},
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"timeout": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10000,
+							ValidateFunc: validateIntegerInRange(1, 60000),
+						},
+					},
+				},
+			},
+			"fc_service_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"function_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"arn_role": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"timeout": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10000,
+							ValidateFunc: validateIntegerInRange(1, 60000),
+						},
+					},
+				},
+			},
+			"mock_service_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"result": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"request_parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(CloudApiParameterTypes),
+						},
+						"required": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"REQUIRED", "OPTIONAL"}),
+						},
+						"in": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(CloudApiParameterLocations),
+						},
+						"in_service": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(CloudApiParameterLocations),
+						},
+						"name_service": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default_value": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"stage_names": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateAllowedStringValue(CloudApiStageNames)},
+				Optional: true,
+				Set:      schema.HashString,
+			},
+			"api_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayApiCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args, err := buildCloudApiArgs(d, meta)
+	if err != nil {
+		return err
+	}
+	resp := CreateCloudApiResponse{}
+	if err := client.cloudapiconn().Invoke("CreateApi", args, &resp); err != nil {
+		return WrapError(err, "CreateApi", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.GroupId, COLON_SEPARATED, resp.ApiId))
+
+	return resourceAlicloudApiGatewayApiUpdate(d, meta)
+}
+
+func resourceAlicloudApiGatewayApiRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	groupId, apiId := parts[0], parts[1]
+
+	client := meta.(*AliyunClient)
+	api, err := client.DescribeCloudApi(groupId, apiId)
+	if err != nil {
+		if NotFoundError(err, "cloudapi") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeApi", d.Id())
+	}
+
+	d.Set("group_id", api.GroupId)
+	d.Set("api_id", api.ApiId)
+	d.Set("name", api.ApiName)
+	d.Set("description", api.Description)
+	d.Set("auth_type", api.AuthType)
+	if err := d.Set("request_config", []map[string]interface{}{{
+		"protocol":    api.RequestConfig.RequestProtocol,
+		"method":      api.RequestConfig.RequestHttpMethod,
+		"path":        api.RequestConfig.RequestPath,
+		"mode":        api.RequestConfig.RequestMode,
+		"body_format": api.RequestConfig.BodyFormat,
+	}}); err != nil {
+		return err
+	}
+
+	service := api.ServiceConfig
+	if err := d.Set("http_service_config", []map[string]interface{}{}); err != nil {
+		return err
+	}
+	if err := d.Set("http_vpc_service_config", []map[string]interface{}{}); err != nil {
+		return err
+	}
+	if err := d.Set("fc_service_config", []map[string]interface{}{}); err != nil {
+		return err
+	}
+	if err := d.Set("mock_service_config", []map[string]interface{}{}); err != nil {
+		return err
+	}
+	if service.Mock == "TRUE" {
+		d.Set("service_type", "MOCK")
+		if err := d.Set("mock_service_config", []map[string]interface{}{{
+			"result": service.MockResult,
+		}}); err != nil {
+			return err
+		}
+	} else if service.FunctionComputeEnable == "TRUE" && service.FunctionComputeConfig != nil {
+		d.Set("service_type", "FunctionCompute")
+		if err := d.Set("fc_service_config", []map[string]interface{}{{
+			"region":        service.FunctionComputeConfig.FcRegionId,
+			"service_name":  service.FunctionComputeConfig.ServiceName,
+			"function_name": service.FunctionComputeConfig.FunctionName,
+			"arn_role":      service.FunctionComputeConfig.RoleArn,
+			"timeout":       service.ServiceTimeout,
+		}}); err != nil {
+			return err
+		}
+	} else if service.ServiceVpcEnable == "TRUE" && service.VpcConfig != nil {
+		d.Set("service_type", "HTTP-VPC")
+		if err := d.Set("http_vpc_service_config", []map[string]interface{}{{
+			"name":    service.VpcConfig.Name,
+			"method":  service.ServiceHttpMethod,
+			"path":    service.ServicePath,
+			"timeout": service.ServiceTimeout,
+		}}); err != nil {
+			return err
+		}
+	} else {
+		d.Set("service_type", "HTTP")
+		if err := d.Set("http_service_config", []map[string]interface{}{{
+			"address": service.ServiceAddress,
+			"method":  service.ServiceHttpMethod,
+			"path":    service.ServicePath,
+			"timeout": service.ServiceTimeout,
+		}}); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("request_parameters", flattenCloudApiRequestParameters(api)); err != nil {
+		return err
+	}
+
+	var stages []string
+	for _, stage := range CloudApiStageNames {
+		if _, err := client.DescribeCloudApiDeployment(groupId, apiId, stage); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				continue
+			}
+			return WrapError(err, "DescribeDeployedApi", d.Id())
+		}
+		stages = append(stages, stage)
+	}
+	if err := d.Set("stage_names", stages); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayApiUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	groupId, apiId := parts[0], parts[1]
+
+	changed := false
+	if !d.IsNewResource() && (d.HasChange("name") || d.HasChange("description") || d.HasChange("auth_type") ||
+		d.HasChange("request_config") || d.HasChange("service_type") || d.HasChange("http_service_config") ||
+		d.HasChange("http_vpc_service_config") || d.HasChange("fc_service_config") || d.HasChange("mock_service_config") ||
+		d.HasChange("request_parameters")) {
+		args, err := buildCloudApiArgs(d, meta)
+		if err != nil {
+			return err
+		}
+		args.ApiId = apiId
+		if err := client.cloudapiconn().Invoke("ModifyApi", args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyApi", d.Id())
+		}
+		for _, key := range []string{"name", "description", "auth_type", "request_config", "service_type", "http_service_config",
+			"http_vpc_service_config", "fc_service_config", "mock_service_config", "request_parameters"} {
+			d.SetPartial(key)
+		}
+		changed = true
+	}
+
+	// The modification of an api takes effect only after it is deployed again, so the api is redeployed
+	// to all of its stages when it is changed.
+	if d.HasChange("stage_names") || changed {
+		o, n := d.GetChange("stage_names")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, stage := range expandStringList(os.Difference(ns).List()) {
+			if err := abolishCloudApi(client, groupId, apiId, stage); err != nil {
+				return err
+			}
+		}
+
+		stages := ns.Difference(os)
+		if changed {
+			stages = ns
+		}
+		for _, stage := range expandStringList(stages.List()) {
+			args := CloudApiArgs{
+				RegionId:    client.Region,
+				GroupId:     groupId,
+				ApiId:       apiId,
+				StageName:   stage,
+				Description: fmt.Sprintf("Deployed by terraform at %s", time.Now().Format(time.RFC3339)),
+			}
+			if err := client.cloudapiconn().Invoke("DeployApi", &args, &common.Response{}); err != nil {
+				return fmt.Errorf("DeployApi to stage %s got an error: %#v", stage, err)
+			}
+		}
+		d.SetPartial("stage_names")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudApiGatewayApiRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayApiDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	groupId, apiId := parts[0], parts[1]
+
+	// A deployed api can not be deleted.
+	for _, stage := range expandStringList(d.Get("stage_names").(*schema.Set).List()) {
+		if err := abolishCloudApi(client, groupId, apiId, stage); err != nil {
+			return err
+		}
+	}
+
+	args := CloudApiArgs{
+		RegionId: client.Region,
+		GroupId:  groupId,
+		ApiId:    apiId,
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.cloudapiconn().Invoke("DeleteApi", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteApi", d.Id()))
+		}
+
+		if _, err := client.DescribeCloudApi(groupId, apiId); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Api %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildCloudApiArgs(d *schema.ResourceData, meta interface{}) (*CloudApiArgs, error) {
+	args := CloudApiArgs{
+		RegionId:    meta.(*AliyunClient).Region,
+		GroupId:     d.Get("group_id").(string),
+		ApiName:     d.Get("name").(string),
+		Description: d.Get("description").(string),
+		AuthType:    d.Get("auth_type").(string),
+		Visibility:  "PRIVATE",
+		ResultType:  "JSON",
+	}
+
+	request := d.Get("request_config").([]interface{})[0].(map[string]interface{})
+	requestConfig := CloudApiRequestConfig{
+		RequestProtocol:   request["protocol"].(string),
+		RequestHttpMethod: request["method"].(string),
+		RequestPath:       request["path"].(string),
+		RequestMode:       request["mode"].(string),
+		BodyFormat:        request["body_format"].(string),
+	}
+
+	serviceConfig := CloudApiServiceConfig{
+		ServiceProtocol: "HTTP",
+		Mock:            "FALSE",
+	}
+	switch serviceType := d.Get("service_type").(string); serviceType {
+	case "HTTP":
+		configs := d.Get("http_service_config").([]interface{})
+		if len(configs) == 0 || configs[0] == nil {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'http_service_config' is required when 'service_type' is HTTP.")
+		}
+		config := configs[0].(map[string]interface{})
+		serviceConfig.ServiceAddress = config["address"].(string)
+		serviceConfig.ServiceHttpMethod = config["method"].(string)
+		serviceConfig.ServicePath = config["path"].(string)
+		serviceConfig.ServiceTimeout = config["timeout"].(int)
+		serviceConfig.ContentTypeCatagory = "CLIENT"
+	case "HTTP-VPC":
+		configs := d.Get("http_vpc_service_config").([]interface{})
+		if len(configs) == 0 || configs[0] == nil {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'http_vpc_service_config' is required when 'service_type' is HTTP-VPC.")
+		}
+		config := configs[0].(map[string]interface{})
+		serviceConfig.ServiceHttpMethod = config["method"].(string)
+		serviceConfig.ServicePath = config["path"].(string)
+		serviceConfig.ServiceTimeout = config["timeout"].(int)
+		serviceConfig.ContentTypeCatagory = "CLIENT"
+		serviceConfig.ServiceVpcEnable = "TRUE"
+		serviceConfig.VpcConfig = &CloudApiVpcConfig{
+			Name: config["name"].(string),
+		}
+	case "FunctionCompute":
+		configs := d.Get("fc_service_config").([]interface{})
+		if len(configs) == 0 || configs[0] == nil {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'fc_service_config' is required when 'service_type' is FunctionCompute.")
+		}
+		config := configs[0].(map[string]interface{})
+		serviceConfig.ServiceProtocol = "FunctionCompute"
+		serviceConfig.ServiceTimeout = config["timeout"].(int)
+		serviceConfig.FunctionComputeEnable = "TRUE"
+		serviceConfig.FunctionComputeConfig = &CloudApiFunctionComputeConfig{
+			FcRegionId:   config["region"].(string),
+			ServiceName:  config["service_name"].(string),
+			FunctionName: config["function_name"].(string),
+			RoleArn:      config["arn_role"].(string),
+		}
+	case "MOCK":
+		configs := d.Get("mock_service_config").([]interface{})
+		if len(configs) == 0 || configs[0] == nil {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'mock_service_config' is required when 'service_type' is MOCK.")
+		}
+		serviceConfig.Mock = "TRUE"
+		serviceConfig.MockResult = configs[0].(map[string]interface{})["result"].(string)
+	}
+
+	requestParameters := []CloudApiRequestParameter{}
+	serviceParameters := []CloudApiServiceParameter{}
+	serviceParametersMap := []CloudApiServiceParameterMap{}
+	for _, v := range d.Get("request_parameters").([]interface{}) {
+		param := v.(map[string]interface{})
+		requestParameters = append(requestParameters, CloudApiRequestParameter{
+			ApiParameterName: param["name"].(string),
+			ParameterType:    param["type"].(string),
+			Required:         param["required"].(string),
+			Location:         param["in"].(string),
+			Description:      param["description"].(string),
+			DefaultValue:     param["default_value"].(string),
+		})
+		serviceParameters = append(serviceParameters, CloudApiServiceParameter{
+			ServiceParameterName: param["name_service"].(string),
+			Location:             param["in_service"].(string),
+			ParameterType:        param["type"].(string),
+			ParameterCatalog:     "REQUEST",
+		})
+		serviceParametersMap = append(serviceParametersMap, CloudApiServiceParameterMap{
+			ServiceParameterName: param["name_service"].(string),
+			RequestParameterName: param["name"].(string),
+		})
+	}
+
+	configs := []struct {
+		name  string
+		value interface{}
+		field *string
+	}{
+		{"RequestConfig", requestConfig, &args.RequestConfig},
+		{"ServiceConfig", serviceConfig, &args.ServiceConfig},
+		{"RequestParameters", requestParameters, &args.RequestParameters},
+		{"ServiceParameters", serviceParameters, &args.ServiceParameters},
+		{"ServiceParametersMap", serviceParametersMap, &args.ServiceParametersMap},
+	}
+	for _, c := range configs {
+		bytes, err := json.Marshal(c.value)
+		if err != nil {
+			return nil, fmt.Errorf("Marshalling %s got an error: %#v", c.name, err)
+		}
+		*c.field = string(bytes)
+	}
+
+	return &args, nil
+}
+
+func abolishCloudApi(client *AliyunClient, groupId, apiId, stageName string) error {
+	args := CloudApiArgs{
+		RegionId:  client.Region,
+		GroupId:   groupId,
+		ApiId:     apiId,
+		StageName: stageName,
+	}
+	if err := client.cloudapiconn().Invoke("AbolishApi", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			return nil
+		}
+		return fmt.Errorf("AbolishApi from stage %s got an error: %#v", stageName, err)
+	}
+	return nil
+}
+
+func flattenCloudApiRequestParameters(api *CloudApi) []map[string]interface{} {
+	serviceNames := make(map[string]string)
+	for _, m := range api.ServiceParametersMap.ServiceParameterMap {
+		serviceNames[m.RequestParameterName] = m.ServiceParameterName
+	}
+	serviceLocations := make(map[string]string)
+	for _, p := range api.ServiceParameters.ServiceParameter {
+		serviceLocations[p.ServiceParameterName] = p.Location
+	}
+
+	var params []map[string]interface{}
+	for _, p := range api.RequestParameters.RequestParameter {
+		nameService := serviceNames[p.ApiParameterName]
+		params = append(params, map[string]interface{}{
+			"name":          p.ApiParameterName,
+			"type":          p.ParameterType,
+			"required":      p.Required,
+			"in":            p.Location,
+			"in_service":    serviceLocations[nameService],
+			"name_service":  nameService,
+			"description":   p.Description,
+			"default_value": p.DefaultValue,
+		})
+	}
+	return params
+}
@@ -0,0 +1,165 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayApi_basic(t *testing.T) {
+	var v CloudApi
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_api.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayApiDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayApiConfig("/web/cloudapi", "RELEASE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayApiExists(
+						"alicloud_api_gateway_api.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_api.foo",
+						"service_type",
+						"HTTP"),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_api.foo",
+						"request_config.0.path",
+						"/web/cloudapi"),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_api.foo",
+						"request_parameters.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_api.foo",
+						"stage_names.#",
+						"1"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_api_gateway_api.foo",
+						"api_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccApiGatewayApiConfig("/web/cloudapi/update", "PRE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayApiExists(
+						"alicloud_api_gateway_api.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_api.foo",
+						"request_config.0.path",
+						"/web/cloudapi/update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_api.foo",
+						"stage_names.#",
+						"1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiGatewayApiExists(n string, d *CloudApi) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No api ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		api, err := client.DescribeCloudApi(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *api
+		return nil
+	}
+}
+
+func testAccCheckApiGatewayApiDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_api" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCloudApi(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Api %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApiGatewayApiConfig(path, stage string) string {
+	return fmt.Sprintf(`
+resource "alicloud_api_gateway_group" "foo" {
+  name = "tf_testAccApiGatewayApi"
+  description = "tf-testAccApiGatewayApi"
+}
+
+resource "alicloud_api_gateway_api" "foo" {
+  group_id = "${alicloud_api_gateway_group.foo.id}"
+  name = "tf_testAccApiGatewayApi"
+  description = "tf-testAccApiGatewayApi"
+  auth_type = "APP"
+
+  request_config {
+    protocol = "HTTP"
+    method = "GET"
+    path = "%s"
+    mode = "MAPPING"
+  }
+
+  service_type = "HTTP"
+
+  http_service_config {
+    address = "http://apigateway-backend.alicloudapi.com:8080"
+    method = "GET"
+    path = "/web/cloudapi"
+    timeout = 20
+  }
+
+  request_parameters = [
+    {
+      name = "aaa"
+      type = "STRING"
+      required = "OPTIONAL"
+      in = "QUERY"
+      in_service = "QUERY"
+      name_service = "testparams"
+    },
+  ]
+
+  stage_names = ["%s"]
+}
+`, path, stage)
+}
@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayAppCreate,
+		Read:   resourceAlicloudApiGatewayAppRead,
+		Update: resourceAlicloudApiGatewayAppUpdate,
+		Delete: resourceAlicloudApiGatewayAppDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayAppCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CloudApiAppArgs{
+		RegionId:    client.Region,
+		AppName:     d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	resp := CreateCloudApiAppResponse{}
+	if err := client.cloudapiconn().Invoke("CreateApp", &args, &resp); err != nil {
+		return WrapError(err, "CreateApp", d.Id())
+	}
+
+	d.SetId(strconv.FormatInt(resp.AppId, 10))
+
+	return resourceAlicloudApiGatewayAppRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayAppRead(d *schema.ResourceData, meta interface{}) error {
+	app, err := meta.(*AliyunClient).DescribeCloudApiApp(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cloudapi") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeAppAttributes", d.Id())
+	}
+
+	d.Set("name", app.AppName)
+	d.Set("description", app.Description)
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		args := CloudApiAppArgs{
+			RegionId:    client.Region,
+			AppId:       d.Id(),
+			AppName:     d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		if err := client.cloudapiconn().Invoke("ModifyApp", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyApp", d.Id())
+		}
+	}
+
+	return resourceAlicloudApiGatewayAppRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayAppDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := CloudApiAppArgs{
+		RegionId: client.Region,
+		AppId:    d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.cloudapiconn().Invoke("DeleteApp", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteApp", d.Id()))
+		}
+
+		if _, err := client.DescribeCloudApiApp(d.Id()); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Api app %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
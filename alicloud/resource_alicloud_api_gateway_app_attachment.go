@@ -0,0 +1,126 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayAppAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayAppAttachmentCreate,
+		Read:   resourceAlicloudApiGatewayAppAttachmentRead,
+		Delete: resourceAlicloudApiGatewayAppAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"app_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stage_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(CloudApiStageNames),
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayAppAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CloudApiAuthorityArgs{
+		RegionId:  client.Region,
+		GroupId:   d.Get("group_id").(string),
+		ApiIds:    d.Get("api_id").(string),
+		AppId:     d.Get("app_id").(string),
+		StageName: d.Get("stage_name").(string),
+	}
+	if err := client.cloudapiconn().Invoke("SetApisAuthorities", &args, &common.Response{}); err != nil {
+		return WrapError(err, "SetApisAuthorities", d.Id())
+	}
+
+	d.SetId(strings.Join([]string{args.GroupId, args.ApiIds, args.AppId, args.StageName}, COLON_SEPARATED))
+
+	return resourceAlicloudApiGatewayAppAttachmentRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayAppAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	if _, err := meta.(*AliyunClient).DescribeCloudApiAuthorization(parts[0], parts[1], parts[2], parts[3]); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeAuthorizedApps", d.Id())
+	}
+
+	d.Set("group_id", parts[0])
+	d.Set("api_id", parts[1])
+	d.Set("app_id", parts[2])
+	d.Set("stage_name", parts[3])
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayAppAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	args := CloudApiAuthorityArgs{
+		RegionId:  client.Region,
+		GroupId:   parts[0],
+		ApiIds:    parts[1],
+		AppId:     parts[2],
+		StageName: parts[3],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.cloudapiconn().Invoke("RemoveApisAuthorities", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "RemoveApisAuthorities", d.Id()))
+		}
+
+		if _, err := client.DescribeCloudApiAuthorization(parts[0], parts[1], parts[2], parts[3]); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Authorization %s is being removed - trying again while it is removed.", d.Id()))
+	})
+}
@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayAppAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_app_attachment.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayAppAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayAppAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayAppAttachmentExists(
+						"alicloud_api_gateway_app_attachment.foo"),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_app_attachment.foo",
+						"stage_name",
+						"PRE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiGatewayAppAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No app attachment ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 4)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		_, err = client.DescribeCloudApiAuthorization(parts[0], parts[1], parts[2], parts[3])
+		return err
+	}
+}
+
+func testAccCheckApiGatewayAppAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_app_attachment" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 4)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCloudApiAuthorization(parts[0], parts[1], parts[2], parts[3]); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("App attachment %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccApiGatewayAppAttachmentConfig = `
+resource "alicloud_api_gateway_group" "foo" {
+  name = "tf_testAccApiGatewayAppAttachment"
+  description = "tf-testAccApiGatewayAppAttachment"
+}
+
+resource "alicloud_api_gateway_api" "foo" {
+  group_id = "${alicloud_api_gateway_group.foo.id}"
+  name = "tf_testAccApiGatewayAppAttachment"
+  description = "tf-testAccApiGatewayAppAttachment"
+
+  request_config {
+    protocol = "HTTP"
+    method = "GET"
+    path = "/test/path"
+  }
+
+  service_type = "MOCK"
+
+  mock_service_config {
+    result = "this is a mock test"
+  }
+}
+
+resource "alicloud_api_gateway_app" "foo" {
+  name = "tf_testAccApiGatewayAppAttachment"
+  description = "tf-testAccApiGatewayAppAttachment"
+}
+
+resource "alicloud_api_gateway_app_attachment" "foo" {
+  group_id = "${alicloud_api_gateway_group.foo.id}"
+  api_id = "${alicloud_api_gateway_api.foo.api_id}"
+  app_id = "${alicloud_api_gateway_app.foo.id}"
+  stage_name = "PRE"
+}
+`
@@ -0,0 +1,100 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayApp_basic(t *testing.T) {
+	var v CloudApiApp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_app.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayAppDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayAppConfig("tf-testAccApiGatewayApp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayAppExists(
+						"alicloud_api_gateway_app.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_app.foo",
+						"description",
+						"tf-testAccApiGatewayApp"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccApiGatewayAppConfig("tf-testAccApiGatewayApp-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayAppExists(
+						"alicloud_api_gateway_app.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_app.foo",
+						"description",
+						"tf-testAccApiGatewayApp-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiGatewayAppExists(n string, d *CloudApiApp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No api app ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		app, err := client.DescribeCloudApiApp(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *app
+		return nil
+	}
+}
+
+func testAccCheckApiGatewayAppDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_app" {
+			continue
+		}
+
+		if _, err := client.DescribeCloudApiApp(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Api app %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApiGatewayAppConfig(description string) string {
+	return fmt.Sprintf(`
+resource "alicloud_api_gateway_app" "foo" {
+  name = "tf_testAccApiGatewayApp"
+  description = "%s"
+}
+`, description)
+}
@@ -0,0 +1,125 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayGroupCreate,
+		Read:   resourceAlicloudApiGatewayGroupRead,
+		Update: resourceAlicloudApiGatewayGroupUpdate,
+		Delete: resourceAlicloudApiGatewayGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sub_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CloudApiGroupArgs{
+		RegionId:    client.Region,
+		GroupName:   d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	resp := CreateCloudApiGroupResponse{}
+	if err := client.cloudapiconn().Invoke("CreateApiGroup", &args, &resp); err != nil {
+		return WrapError(err, "CreateApiGroup", d.Id())
+	}
+
+	d.SetId(resp.GroupId)
+
+	return resourceAlicloudApiGatewayGroupRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayGroupRead(d *schema.ResourceData, meta interface{}) error {
+	group, err := meta.(*AliyunClient).DescribeCloudApiGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cloudapi") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeApiGroup", d.Id())
+	}
+
+	d.Set("name", group.GroupName)
+	d.Set("description", group.Description)
+	d.Set("sub_domain", group.SubDomain)
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		args := CloudApiGroupArgs{
+			RegionId:    client.Region,
+			GroupId:     d.Id(),
+			GroupName:   d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		if err := client.cloudapiconn().Invoke("ModifyApiGroup", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyApiGroup", d.Id())
+		}
+	}
+
+	return resourceAlicloudApiGatewayGroupRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := CloudApiGroupArgs{
+		RegionId: client.Region,
+		GroupId:  d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.cloudapiconn().Invoke("DeleteApiGroup", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			// The apis in the group may be still being deleted.
+			if IsExceptedError(err, CloudApiGroupNotEmpty) {
+				return resource.RetryableError(fmt.Errorf("Api group %s is not empty - trying again while it is deleted.", d.Id()))
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteApiGroup", d.Id()))
+		}
+
+		if _, err := client.DescribeCloudApiGroup(d.Id()); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Api group %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
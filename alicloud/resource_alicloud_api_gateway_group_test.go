@@ -0,0 +1,103 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayGroup_basic(t *testing.T) {
+	var v CloudApiGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayGroupConfig("tf_testAccApiGatewayGroup"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayGroupExists(
+						"alicloud_api_gateway_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_group.foo",
+						"name",
+						"tf_testAccApiGatewayGroup"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_api_gateway_group.foo",
+						"sub_domain"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccApiGatewayGroupConfig("tf_testAccApiGatewayGroupUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayGroupExists(
+						"alicloud_api_gateway_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_group.foo",
+						"name",
+						"tf_testAccApiGatewayGroupUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiGatewayGroupExists(n string, d *CloudApiGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No api group ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		group, err := client.DescribeCloudApiGroup(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *group
+		return nil
+	}
+}
+
+func testAccCheckApiGatewayGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_group" {
+			continue
+		}
+
+		if _, err := client.DescribeCloudApiGroup(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Api group %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApiGatewayGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_api_gateway_group" "foo" {
+  name = "%s"
+  description = "tf-testAccApiGatewayGroup"
+}
+`, name)
+}
@@ -0,0 +1,216 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayTrafficControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayTrafficControlCreate,
+		Read:   resourceAlicloudApiGatewayTrafficControlRead,
+		Update: resourceAlicloudApiGatewayTrafficControlUpdate,
+		Delete: resourceAlicloudApiGatewayTrafficControlDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"unit": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(CloudApiTrafficControlUnits),
+			},
+			"api_default": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"user_default": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"app_default": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"special_controls": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(CloudApiSpecialTypes),
+						},
+						"key": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayTrafficControlCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildCloudApiTrafficControlArgs(d, meta)
+	resp := CreateCloudApiTrafficControlResponse{}
+	if err := client.cloudapiconn().Invoke("CreateTrafficControl", &args, &resp); err != nil {
+		return WrapError(err, "CreateTrafficControl", d.Id())
+	}
+
+	d.SetId(resp.TrafficControlId)
+
+	return resourceAlicloudApiGatewayTrafficControlUpdate(d, meta)
+}
+
+func resourceAlicloudApiGatewayTrafficControlRead(d *schema.ResourceData, meta interface{}) error {
+	control, err := meta.(*AliyunClient).DescribeCloudApiTrafficControl(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cloudapi") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeTrafficControls", d.Id())
+	}
+
+	d.Set("name", control.TrafficControlName)
+	d.Set("description", control.Description)
+	d.Set("unit", control.TrafficControlUnit)
+	d.Set("api_default", control.ApiDefault)
+	d.Set("user_default", control.UserDefault)
+	d.Set("app_default", control.AppDefault)
+
+	var specials []map[string]interface{}
+	for _, policy := range control.SpecialPolicies.SpecialPolicy {
+		for _, special := range policy.Specials.Special {
+			specials = append(specials, map[string]interface{}{
+				"type":  policy.SpecialType,
+				"key":   special.SpecialKey,
+				"value": special.TrafficValue,
+			})
+		}
+	}
+	if err := d.Set("special_controls", specials); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayTrafficControlUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if !d.IsNewResource() && (d.HasChange("name") || d.HasChange("description") || d.HasChange("unit") ||
+		d.HasChange("api_default") || d.HasChange("user_default") || d.HasChange("app_default")) {
+		args := buildCloudApiTrafficControlArgs(d, meta)
+		args.TrafficControlId = d.Id()
+		if err := client.cloudapiconn().Invoke("ModifyTrafficControl", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyTrafficControl", d.Id())
+		}
+		for _, key := range []string{"name", "description", "unit", "api_default", "user_default", "app_default"} {
+			d.SetPartial(key)
+		}
+	}
+
+	if d.HasChange("special_controls") {
+		o, n := d.GetChange("special_controls")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, v := range os.Difference(ns).List() {
+			special := v.(map[string]interface{})
+			args := CloudApiTrafficSpecialControlArgs{
+				RegionId:         client.Region,
+				TrafficControlId: d.Id(),
+				SpecialType:      special["type"].(string),
+				SpecialKey:       special["key"].(string),
+			}
+			if err := client.cloudapiconn().Invoke("DeleteTrafficSpecialControl", &args, &common.Response{}); err != nil {
+				return WrapError(err, "DeleteTrafficSpecialControl", d.Id())
+			}
+		}
+
+		for _, v := range ns.Difference(os).List() {
+			special := v.(map[string]interface{})
+			args := CloudApiTrafficSpecialControlArgs{
+				RegionId:         client.Region,
+				TrafficControlId: d.Id(),
+				SpecialType:      special["type"].(string),
+				SpecialKey:       special["key"].(string),
+				TrafficValue:     special["value"].(int),
+			}
+			if err := client.cloudapiconn().Invoke("AddTrafficSpecialControl", &args, &common.Response{}); err != nil {
+				return WrapError(err, "AddTrafficSpecialControl", d.Id())
+			}
+		}
+		d.SetPartial("special_controls")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudApiGatewayTrafficControlRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayTrafficControlDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := CloudApiTrafficControlArgs{
+		RegionId:         client.Region,
+		TrafficControlId: d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.cloudapiconn().Invoke("DeleteTrafficControl", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteTrafficControl", d.Id()))
+		}
+
+		if _, err := client.DescribeCloudApiTrafficControl(d.Id()); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Traffic control %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildCloudApiTrafficControlArgs(d *schema.ResourceData, meta interface{}) CloudApiTrafficControlArgs {
+	return CloudApiTrafficControlArgs{
+		RegionId:           meta.(*AliyunClient).Region,
+		TrafficControlName: d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		TrafficControlUnit: d.Get("unit").(string),
+		ApiDefault:         d.Get("api_default").(int),
+		UserDefault:        d.Get("user_default").(int),
+		AppDefault:         d.Get("app_default").(int),
+	}
+}
@@ -0,0 +1,126 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayTrafficControlAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayTrafficControlAttachmentCreate,
+		Read:   resourceAlicloudApiGatewayTrafficControlAttachmentRead,
+		Delete: resourceAlicloudApiGatewayTrafficControlAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"traffic_control_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stage_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(CloudApiStageNames),
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayTrafficControlAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CloudApiTrafficControlArgs{
+		RegionId:         client.Region,
+		TrafficControlId: d.Get("traffic_control_id").(string),
+		GroupId:          d.Get("group_id").(string),
+		ApiIds:           d.Get("api_id").(string),
+		StageName:        d.Get("stage_name").(string),
+	}
+	if err := client.cloudapiconn().Invoke("SetTrafficControlApis", &args, &common.Response{}); err != nil {
+		return WrapError(err, "SetTrafficControlApis", d.Id())
+	}
+
+	d.SetId(strings.Join([]string{args.TrafficControlId, args.GroupId, args.ApiIds, args.StageName}, COLON_SEPARATED))
+
+	return resourceAlicloudApiGatewayTrafficControlAttachmentRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayTrafficControlAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	if _, err := meta.(*AliyunClient).DescribeCloudApiTrafficControlBinding(parts[0], parts[1], parts[2], parts[3]); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeTrafficControls", d.Id())
+	}
+
+	d.Set("traffic_control_id", parts[0])
+	d.Set("group_id", parts[1])
+	d.Set("api_id", parts[2])
+	d.Set("stage_name", parts[3])
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayTrafficControlAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	args := CloudApiTrafficControlArgs{
+		RegionId:         client.Region,
+		TrafficControlId: parts[0],
+		GroupId:          parts[1],
+		ApiIds:           parts[2],
+		StageName:        parts[3],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.cloudapiconn().Invoke("DeleteTrafficControlApis", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteTrafficControlApis", d.Id()))
+		}
+
+		if _, err := client.DescribeCloudApiTrafficControlBinding(parts[0], parts[1], parts[2], parts[3]); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Traffic control attachment %s is being removed - trying again while it is removed.", d.Id()))
+	})
+}
@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayTrafficControlAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_traffic_control_attachment.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayTrafficControlAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayTrafficControlAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayTrafficControlAttachmentExists(
+						"alicloud_api_gateway_traffic_control_attachment.foo"),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_traffic_control_attachment.foo",
+						"stage_name",
+						"RELEASE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiGatewayTrafficControlAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No traffic control attachment ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 4)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		_, err = client.DescribeCloudApiTrafficControlBinding(parts[0], parts[1], parts[2], parts[3])
+		return err
+	}
+}
+
+func testAccCheckApiGatewayTrafficControlAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_traffic_control_attachment" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 4)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCloudApiTrafficControlBinding(parts[0], parts[1], parts[2], parts[3]); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Traffic control attachment %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccApiGatewayTrafficControlAttachmentConfig = `
+resource "alicloud_api_gateway_group" "foo" {
+  name = "tf_testAccApiGatewayTrafficControlAttachment"
+  description = "tf-testAccApiGatewayTrafficControlAttachment"
+}
+
+resource "alicloud_api_gateway_api" "foo" {
+  group_id = "${alicloud_api_gateway_group.foo.id}"
+  name = "tf_testAccApiGatewayTrafficControlAttachment"
+  description = "tf-testAccApiGatewayTrafficControlAttachment"
+
+  request_config {
+    protocol = "HTTP"
+    method = "GET"
+    path = "/test/path"
+  }
+
+  service_type = "MOCK"
+
+  mock_service_config {
+    result = "this is a mock test"
+  }
+
+  stage_names = ["RELEASE"]
+}
+
+resource "alicloud_api_gateway_traffic_control" "foo" {
+  name = "tf_testAccApiGatewayTrafficControlAttachment"
+  unit = "MINUTE"
+  api_default = 100
+}
+
+resource "alicloud_api_gateway_traffic_control_attachment" "foo" {
+  traffic_control_id = "${alicloud_api_gateway_traffic_control.foo.id}"
+  group_id = "${alicloud_api_gateway_group.foo.id}"
+  api_id = "${alicloud_api_gateway_api.foo.api_id}"
+  stage_name = "RELEASE"
+}
+`
@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayTrafficControl_basic(t *testing.T) {
+	var v CloudApiTrafficControl
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_traffic_control.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayTrafficControlDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayTrafficControlConfig(100, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayTrafficControlExists(
+						"alicloud_api_gateway_traffic_control.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_traffic_control.foo",
+						"api_default",
+						"100"),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_traffic_control.foo",
+						"special_controls.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccApiGatewayTrafficControlConfig(200, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayTrafficControlExists(
+						"alicloud_api_gateway_traffic_control.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_traffic_control.foo",
+						"api_default",
+						"200"),
+					resource.TestCheckResourceAttr(
+						"alicloud_api_gateway_traffic_control.foo",
+						"special_controls.#",
+						"1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiGatewayTrafficControlExists(n string, d *CloudApiTrafficControl) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No traffic control ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		control, err := client.DescribeCloudApiTrafficControl(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *control
+		return nil
+	}
+}
+
+func testAccCheckApiGatewayTrafficControlDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_traffic_control" {
+			continue
+		}
+
+		if _, err := client.DescribeCloudApiTrafficControl(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Traffic control %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApiGatewayTrafficControlConfig(apiDefault, appValue int) string {
+	return fmt.Sprintf(`
+resource "alicloud_api_gateway_app" "foo" {
+  name = "tf_testAccApiGatewayTrafficControl"
+  description = "tf-testAccApiGatewayTrafficControl"
+}
+
+resource "alicloud_api_gateway_traffic_control" "foo" {
+  name = "tf_testAccApiGatewayTrafficControl"
+  description = "tf-testAccApiGatewayTrafficControl"
+  unit = "MINUTE"
+  api_default = %d
+  app_default = 50
+
+  special_controls {
+    type = "APP"
+    key = "${alicloud_api_gateway_app.foo.id}"
+    value = %d
+  }
+}
+`, apiDefault, appValue)
+}
@@ -0,0 +1,136 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayVpcAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayVpcAccessCreate,
+		Read:   resourceAlicloudApiGatewayVpcAccessRead,
+		Delete: resourceAlicloudApiGatewayVpcAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayVpcAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CloudApiVpcAccessArgs{
+		RegionId:   client.Region,
+		Name:       d.Get("name").(string),
+		VpcId:      d.Get("vpc_id").(string),
+		InstanceId: d.Get("instance_id").(string),
+		Port:       d.Get("port").(int),
+	}
+	if err := client.cloudapiconn().Invoke("SetVpcAccess", &args, &common.Response{}); err != nil {
+		return WrapError(err, "SetVpcAccess", d.Id())
+	}
+
+	d.SetId(strings.Join([]string{args.Name, args.VpcId, args.InstanceId, strconv.Itoa(args.Port)}, COLON_SEPARATED))
+
+	return resourceAlicloudApiGatewayVpcAccessRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayVpcAccessRead(d *schema.ResourceData, meta interface{}) error {
+	name, vpcId, instanceId, port, err := parseApiGatewayVpcAccessId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	access, err := meta.(*AliyunClient).DescribeCloudApiVpcAccess(name, vpcId, instanceId, port)
+	if err != nil {
+		if NotFoundError(err, "cloudapi") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeVpcAccesses", d.Id())
+	}
+
+	d.Set("name", access.Name)
+	d.Set("vpc_id", access.VpcId)
+	d.Set("instance_id", access.InstanceId)
+	d.Set("port", access.Port)
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayVpcAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	name, vpcId, instanceId, port, err := parseApiGatewayVpcAccessId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	args := CloudApiVpcAccessArgs{
+		RegionId:   client.Region,
+		VpcId:      vpcId,
+		InstanceId: instanceId,
+		Port:       port,
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.cloudapiconn().Invoke("RemoveVpcAccess", &args, &common.Response{}); err != nil {
+			return resource.NonRetryableError(WrapError(err, "RemoveVpcAccess", d.Id()))
+		}
+
+		if _, err := client.DescribeCloudApiVpcAccess(name, vpcId, instanceId, port); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Vpc access %s is being removed - trying again while it is removed.", d.Id()))
+	})
+}
+
+func parseApiGatewayVpcAccessId(id string) (name, vpcId, instanceId string, port int, err error) {
+	parts, err := parseResourceId(id, 4)
+	if err != nil {
+		return "", "", "", 0, err
+	}
+	port, err = strconv.Atoi(parts[3])
+	if err != nil {
+		return "", "", "", 0, ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid port %s in vpc access ID %s.", parts[3], id)
+	}
+	return parts[0], parts[1], parts[2], port, nil
+}
@@ -0,0 +1,226 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudBastionhostHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudBastionhostHostCreate,
+		Read:   resourceAlicloudBastionhostHostRead,
+		Update: resourceAlicloudBastionhostHostUpdate,
+		Delete: resourceAlicloudBastionhostHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 128),
+			},
+			"active_address_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(BastionhostAddressTypes),
+			},
+			"host_private_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"host_public_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"os_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(BastionhostOSTypes),
+			},
+			"source": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Local",
+				ValidateFunc: validateAllowedStringValue(BastionhostHostSources),
+			},
+			// The ID of the ECS or RDS instance, which is required when the source is Ecs or Rds.
+			"source_instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"host_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudBastionhostHostCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := BastionhostHostArgs{
+		RegionId:           client.Region,
+		InstanceId:         d.Get("instance_id").(string),
+		HostName:           d.Get("host_name").(string),
+		ActiveAddressType:  d.Get("active_address_type").(string),
+		HostPrivateAddress: d.Get("host_private_address").(string),
+		HostPublicAddress:  d.Get("host_public_address").(string),
+		OSType:             d.Get("os_type").(string),
+		Source:             d.Get("source").(string),
+		SourceInstanceId:   d.Get("source_instance_id").(string),
+		Comment:            d.Get("comment").(string),
+	}
+	if err := checkBastionhostHostAddress(args); err != nil {
+		return err
+	}
+	if args.Source != "Local" {
+		if args.SourceInstanceId == "" {
+			return ConfigErrorf(ErrorCodeMissingArgument, "'source_instance_id' is required when 'source' is %s.", args.Source)
+		}
+		args.InstanceRegionId = string(client.Region)
+	}
+
+	resp := CreateBastionhostHostResponse{}
+	if err := client.bastionhostconn().Invoke("CreateHost", &args, &resp); err != nil {
+		return WrapError(err, "CreateHost", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%d", args.InstanceId, COLON_SEPARATED, resp.HostId))
+
+	return resourceAlicloudBastionhostHostRead(d, meta)
+}
+
+func resourceAlicloudBastionhostHostRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	host, err := meta.(*AliyunClient).DescribeBastionhostHost(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "bastionhost") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetHost", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("host_id", strconv.Itoa(host.HostId))
+	d.Set("host_name", host.HostName)
+	d.Set("active_address_type", host.ActiveAddressType)
+	d.Set("host_private_address", host.HostPrivateAddress)
+	d.Set("host_public_address", host.HostPublicAddress)
+	d.Set("os_type", host.OSType)
+	d.Set("source", host.Source)
+	d.Set("source_instance_id", host.SourceInstanceId)
+	d.Set("comment", host.Comment)
+
+	return nil
+}
+
+func resourceAlicloudBastionhostHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	d.Partial(true)
+
+	args := BastionhostHostArgs{
+		RegionId:           client.Region,
+		InstanceId:         parts[0],
+		HostId:             parts[1],
+		HostName:           d.Get("host_name").(string),
+		ActiveAddressType:  d.Get("active_address_type").(string),
+		HostPrivateAddress: d.Get("host_private_address").(string),
+		HostPublicAddress:  d.Get("host_public_address").(string),
+		OSType:             d.Get("os_type").(string),
+		Comment:            d.Get("comment").(string),
+	}
+	if err := checkBastionhostHostAddress(args); err != nil {
+		return err
+	}
+
+	if d.HasChange("host_name") || d.HasChange("host_private_address") || d.HasChange("host_public_address") ||
+		d.HasChange("os_type") || d.HasChange("comment") {
+		if err := client.bastionhostconn().Invoke("ModifyHost", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyHost", d.Id())
+		}
+		d.SetPartial("host_name")
+		d.SetPartial("host_private_address")
+		d.SetPartial("host_public_address")
+		d.SetPartial("os_type")
+		d.SetPartial("comment")
+	}
+
+	if d.HasChange("active_address_type") {
+		hostIds, err := json.Marshal([]string{parts[1]})
+		if err != nil {
+			return err
+		}
+		addressArgs := BastionhostHostArgs{
+			RegionId:          client.Region,
+			InstanceId:        parts[0],
+			HostIds:           string(hostIds),
+			ActiveAddressType: args.ActiveAddressType,
+		}
+		if err := client.bastionhostconn().Invoke("ModifyHostsActiveAddressType", &addressArgs, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyHostsActiveAddressType", d.Id())
+		}
+		d.SetPartial("active_address_type")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudBastionhostHostRead(d, meta)
+}
+
+func resourceAlicloudBastionhostHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := BastionhostHostArgs{
+		RegionId:   client.Region,
+		InstanceId: parts[0],
+		HostId:     parts[1],
+	}
+	if err := client.bastionhostconn().Invoke("DeleteHost", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "bastionhost") {
+			return nil
+		}
+		return WrapError(err, "DeleteHost", d.Id())
+	}
+	return nil
+}
+
+// checkBastionhostHostAddress checks that the active address of the host is set.
+func checkBastionhostHostAddress(args BastionhostHostArgs) error {
+	if args.ActiveAddressType == "Private" && args.HostPrivateAddress == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "'host_private_address' is required when 'active_address_type' is Private.")
+	}
+	if args.ActiveAddressType == "Public" && args.HostPublicAddress == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "'host_public_address' is required when 'active_address_type' is Public.")
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudBastionhostHostAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudBastionhostHostAccountCreate,
+		Read:   resourceAlicloudBastionhostHostAccountRead,
+		Update: resourceAlicloudBastionhostHostAccountUpdate,
+		Delete: resourceAlicloudBastionhostHostAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_account_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 128),
+			},
+			"protocol_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(BastionhostProtocols),
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			// The private key and its pass phrase are only supported by the SSH accounts.
+			"private_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"pass_phrase": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"host_account_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudBastionhostHostAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := BastionhostHostAccountArgs{
+		RegionId:        client.Region,
+		InstanceId:      d.Get("instance_id").(string),
+		HostId:          d.Get("host_id").(string),
+		HostAccountName: d.Get("host_account_name").(string),
+		ProtocolName:    d.Get("protocol_name").(string),
+		Password:        d.Get("password").(string),
+		PrivateKey:      d.Get("private_key").(string),
+		PassPhrase:      d.Get("pass_phrase").(string),
+	}
+	if args.ProtocolName == "RDP" && args.PrivateKey != "" {
+		return ConfigErrorf(ErrorCodeConflictingArguments, "'private_key' is not supported when 'protocol_name' is RDP.")
+	}
+
+	resp := CreateBastionhostHostAccountResponse{}
+	if err := client.bastionhostconn().Invoke("CreateHostAccount", &args, &resp); err != nil {
+		return WrapError(err, "CreateHostAccount", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%d", args.InstanceId, COLON_SEPARATED, resp.HostAccountId))
+
+	return resourceAlicloudBastionhostHostAccountRead(d, meta)
+}
+
+func resourceAlicloudBastionhostHostAccountRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	account, err := meta.(*AliyunClient).DescribeBastionhostHostAccount(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "bastionhost") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetHostAccount", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("host_account_id", strconv.Itoa(account.HostAccountId))
+	d.Set("host_id", strconv.Itoa(account.HostId))
+	d.Set("host_account_name", account.HostAccountName)
+	d.Set("protocol_name", account.ProtocolName)
+
+	return nil
+}
+
+func resourceAlicloudBastionhostHostAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("host_account_name") || d.HasChange("password") || d.HasChange("private_key") || d.HasChange("pass_phrase") {
+		args := BastionhostHostAccountArgs{
+			RegionId:        client.Region,
+			InstanceId:      parts[0],
+			HostAccountId:   parts[1],
+			HostAccountName: d.Get("host_account_name").(string),
+		}
+		// The credentials are only sent when they are changed, because they are not returned.
+		if d.HasChange("password") {
+			args.Password = d.Get("password").(string)
+		}
+		if d.HasChange("private_key") || d.HasChange("pass_phrase") {
+			args.PrivateKey = d.Get("private_key").(string)
+			args.PassPhrase = d.Get("pass_phrase").(string)
+		}
+		if err := client.bastionhostconn().Invoke("ModifyHostAccount", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyHostAccount", d.Id())
+		}
+	}
+
+	return resourceAlicloudBastionhostHostAccountRead(d, meta)
+}
+
+func resourceAlicloudBastionhostHostAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := BastionhostHostAccountArgs{
+		RegionId:      client.Region,
+		InstanceId:    parts[0],
+		HostAccountId: parts[1],
+	}
+	if err := client.bastionhostconn().Invoke("DeleteHostAccount", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "bastionhost") {
+			return nil
+		}
+		return WrapError(err, "DeleteHostAccount", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudBastionhostHostAccount_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithBastionhostInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_bastionhost_host_account.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBastionhostHostDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBastionhostHostAccountConfig("root"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_host_account.foo",
+						"host_account_name",
+						"root"),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_host_account.foo",
+						"protocol_name",
+						"SSH"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_bastionhost_host_account.foo",
+						"host_account_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBastionhostHostAccountConfig("admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_host_account.foo",
+						"host_account_name",
+						"admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBastionhostHostAccountConfig(name string) string {
+	return testAccBastionhostHostConfig("tf-testAccBastionhostHost", "Private") + fmt.Sprintf(`
+resource "alicloud_bastionhost_host_account" "foo" {
+  instance_id = "${alicloud_bastionhost_host.foo.instance_id}"
+  host_id = "${alicloud_bastionhost_host.foo.host_id}"
+  host_account_name = "%s"
+  protocol_name = "SSH"
+  password = "Tf-test123456"
+}
+`, name)
+}
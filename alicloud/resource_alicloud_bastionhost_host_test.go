@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudBastionhostHost_basic(t *testing.T) {
+	var v BastionhostHost
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithBastionhostInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_bastionhost_host.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBastionhostHostDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBastionhostHostConfig("tf-testAccBastionhostHost", "Private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBastionhostHostExists(
+						"alicloud_bastionhost_host.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_host.foo",
+						"host_name",
+						"tf-testAccBastionhostHost"),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_host.foo",
+						"active_address_type",
+						"Private"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBastionhostHostConfig("tf-testAccBastionhostHostUpdate", "Public"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBastionhostHostExists(
+						"alicloud_bastionhost_host.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_host.foo",
+						"host_name",
+						"tf-testAccBastionhostHostUpdate"),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_host.foo",
+						"active_address_type",
+						"Public"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBastionhostHostExists(n string, d *BastionhostHost) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bastionhost host ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		host, err := client.DescribeBastionhostHost(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *host
+		return nil
+	}
+}
+
+// The host accounts are deleted with their hosts.
+func testAccCheckBastionhostHostDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_bastionhost_host" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeBastionhostHost(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "bastionhost") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Bastionhost host %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBastionhostHostConfig(name, addressType string) string {
+	return fmt.Sprintf(`
+resource "alicloud_bastionhost_host" "foo" {
+  instance_id = "%s"
+  host_name = "%s"
+  active_address_type = "%s"
+  host_private_address = "172.16.0.10"
+  host_public_address = "47.100.0.10"
+  os_type = "Linux"
+}
+`, os.Getenv("ALICLOUD_BASTIONHOST_INSTANCE_ID"), name, addressType)
+}
@@ -0,0 +1,208 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudBastionhostInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudBastionhostInstanceCreate,
+		Read:   resourceAlicloudBastionhostInstanceRead,
+		Update: resourceAlicloudBastionhostInstanceUpdate,
+		Delete: resourceAlicloudBastionhostInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 63),
+			},
+			// The license code is the edition and the number of the assets, like bhah_ent_50_asset.
+			"license_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plan_code": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "cloudbastion",
+				ValidateFunc: validateAllowedStringValue(BastionhostPlanCodes),
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+			},
+			"renewal_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ManualRenewal",
+				ValidateFunc: validateAllowedStringValue([]string{"AutoRenewal", "ManualRenewal", "NotRenewal"}),
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudBastionhostInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	productType := "bastionhost"
+	if centralServiceRegion(client.Region) != "cn-hangzhou" {
+		productType = "bastionhost_std_public_intl"
+	}
+	args := CreateBssInstanceArgs{
+		ProductCode:      "bastionhost",
+		ProductType:      productType,
+		SubscriptionType: "Subscription",
+		Period:           d.Get("period").(int),
+		RenewalStatus:    d.Get("renewal_status").(string),
+		Parameter: []BssInstanceParameter{
+			{Code: "Region", Value: string(client.Region)},
+			{Code: "NetworkType", Value: "vpc"},
+			{Code: "LicenseCode", Value: d.Get("license_code").(string)},
+			{Code: "PlanCode", Value: d.Get("plan_code").(string)},
+			{Code: "VSwitchId", Value: d.Get("vswitch_id").(string)},
+		},
+	}
+	if args.RenewalStatus == "AutoRenewal" {
+		args.RenewPeriod = args.Period
+	}
+
+	resp := CreateBssInstanceResponse{}
+	if err := client.bssconn().Invoke("CreateInstance", &args, &resp); err != nil {
+		return WrapError(err, "CreateInstance", d.Id())
+	}
+	if !resp.Success {
+		return fmt.Errorf("CreateInstance got an error: %s %s", resp.Code, resp.Message)
+	}
+
+	d.SetId(resp.Data.InstanceId)
+
+	// The bought instance is started in the vswitch with the security groups.
+	if err := client.WaitForBastionhostInstance(d.Id(), BastionhostInstancePending, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForBastionhostInstance", d.Id())
+	}
+	startArgs := BastionhostInstanceArgs{
+		RegionId:         client.Region,
+		InstanceId:       d.Id(),
+		VswitchId:        d.Get("vswitch_id").(string),
+		SecurityGroupIds: expandStringList(d.Get("security_group_ids").(*schema.Set).List()),
+	}
+	if err := client.bastionhostconn().Invoke("StartInstance", &startArgs, &common.Response{}); err != nil {
+		return WrapError(err, "StartInstance", d.Id())
+	}
+	if err := client.WaitForBastionhostInstance(d.Id(), BastionhostInstanceRunning, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForBastionhostInstance", d.Id())
+	}
+
+	return resourceAlicloudBastionhostInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudBastionhostInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeBastionhostInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err, "bastionhost") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeInstanceAttribute", d.Id())
+	}
+
+	d.Set("description", instance.Description)
+	d.Set("license_code", instance.LicenseCode)
+	d.Set("vswitch_id", instance.VswitchId)
+	d.Set("vpc_id", instance.VpcId)
+	d.Set("status", instance.InstanceStatus)
+	d.Set("public_endpoint", instance.InternetEndpoint)
+	d.Set("private_endpoint", instance.IntranetEndpoint)
+	if err := d.Set("security_group_ids", instance.AuthorizedSecurityGroups); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudBastionhostInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if d.HasChange("description") {
+		args := BastionhostInstanceArgs{
+			RegionId:    client.Region,
+			InstanceId:  d.Id(),
+			Description: d.Get("description").(string),
+		}
+		if err := client.bastionhostconn().Invoke("ModifyInstanceAttribute", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyInstanceAttribute", d.Id())
+		}
+		d.SetPartial("description")
+	}
+
+	// The security groups of a new instance are set by the StartInstance.
+	if !d.IsNewResource() && d.HasChange("security_group_ids") {
+		args := BastionhostInstanceArgs{
+			RegionId:                 client.Region,
+			InstanceId:               d.Id(),
+			AuthorizedSecurityGroups: expandStringList(d.Get("security_group_ids").(*schema.Set).List()),
+		}
+		if err := client.bastionhostconn().Invoke("ConfigInstanceSecurityGroups", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ConfigInstanceSecurityGroups", d.Id())
+		}
+		d.SetPartial("security_group_ids")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudBastionhostInstanceRead(d, meta)
+}
+
+func resourceAlicloudBastionhostInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	// The subscription instance can not be released by API, and it is released automatically after it is expired.
+	log.Printf("[WARN] Bastionhost instance %s can not be deleted and it is only removed from the state. "+
+		"It will be released automatically after it is expired.", d.Id())
+	return nil
+}
@@ -0,0 +1,112 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The subscription Bastionhost instance can not be released by API, so there is no destroy check and
+// the instance is released automatically after it is expired.
+func TestAccAlicloudBastionhostInstance_basic(t *testing.T) {
+	var v BastionhostInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_bastionhost_instance.foo",
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBastionhostInstanceConfig("tf-testAccBastionhostInstance"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBastionhostInstanceExists(
+						"alicloud_bastionhost_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_instance.foo",
+						"description",
+						"tf-testAccBastionhostInstance"),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_instance.foo",
+						"security_group_ids.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_instance.foo",
+						"status",
+						"RUNNING"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBastionhostInstanceConfig("tf-testAccBastionhostInstanceUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBastionhostInstanceExists(
+						"alicloud_bastionhost_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_instance.foo",
+						"description",
+						"tf-testAccBastionhostInstanceUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBastionhostInstanceExists(n string, d *BastionhostInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bastionhost instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instance, err := client.DescribeBastionhostInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *instance
+		return nil
+	}
+}
+
+func testAccBastionhostInstanceConfig(description string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+	vpc_id = "${alicloud_vpc.foo.id}"
+	cidr_block = "172.16.0.0/21"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+	name = "tf_test_foo"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_bastionhost_instance" "foo" {
+  description = "%s"
+  license_code = "bhah_ent_50_asset"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  security_group_ids = ["${alicloud_security_group.foo.id}"]
+  period = 1
+}
+`, description)
+}
@@ -0,0 +1,178 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudBastionhostUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudBastionhostUserCreate,
+		Read:   resourceAlicloudBastionhostUserRead,
+		Update: resourceAlicloudBastionhostUserUpdate,
+		Delete: resourceAlicloudBastionhostUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 128),
+			},
+			"source": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Local",
+				ValidateFunc: validateAllowedStringValue(BastionhostSources),
+			},
+			// The ID of the RAM user, which is required when the source is Ram.
+			"source_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mobile": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudBastionhostUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := BastionhostUserArgs{
+		RegionId:     client.Region,
+		InstanceId:   d.Get("instance_id").(string),
+		UserName:     d.Get("user_name").(string),
+		Source:       d.Get("source").(string),
+		SourceUserId: d.Get("source_user_id").(string),
+		Password:     d.Get("password").(string),
+		DisplayName:  d.Get("display_name").(string),
+		Email:        d.Get("email").(string),
+		Mobile:       d.Get("mobile").(string),
+		Comment:      d.Get("comment").(string),
+	}
+	if args.Source == "Ram" && args.SourceUserId == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "'source_user_id' is required when 'source' is Ram.")
+	}
+	if args.Source == "Local" && args.Password == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "'password' is required when 'source' is Local.")
+	}
+
+	resp := CreateBastionhostUserResponse{}
+	if err := client.bastionhostconn().Invoke("CreateUser", &args, &resp); err != nil {
+		return WrapError(err, "CreateUser", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.InstanceId, COLON_SEPARATED, resp.UserId))
+
+	return resourceAlicloudBastionhostUserRead(d, meta)
+}
+
+func resourceAlicloudBastionhostUserRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	user, err := meta.(*AliyunClient).DescribeBastionhostUser(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "bastionhost") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetUser", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("user_name", user.UserName)
+	d.Set("source", user.Source)
+	d.Set("source_user_id", user.SourceUserId)
+	d.Set("display_name", user.DisplayName)
+	d.Set("email", user.Email)
+	d.Set("mobile", user.Mobile)
+	d.Set("comment", user.Comment)
+
+	return nil
+}
+
+func resourceAlicloudBastionhostUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("password") || d.HasChange("display_name") || d.HasChange("email") || d.HasChange("mobile") || d.HasChange("comment") {
+		args := BastionhostUserArgs{
+			RegionId:    client.Region,
+			InstanceId:  parts[0],
+			UserId:      parts[1],
+			DisplayName: d.Get("display_name").(string),
+			Email:       d.Get("email").(string),
+			Mobile:      d.Get("mobile").(string),
+			Comment:     d.Get("comment").(string),
+		}
+		// The password is only sent when it is changed, because it is not returned.
+		if d.HasChange("password") {
+			args.Password = d.Get("password").(string)
+		}
+		if err := client.bastionhostconn().Invoke("ModifyUser", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyUser", d.Id())
+		}
+	}
+
+	return resourceAlicloudBastionhostUserRead(d, meta)
+}
+
+func resourceAlicloudBastionhostUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := BastionhostUserArgs{
+		RegionId:   client.Region,
+		InstanceId: parts[0],
+		UserId:     parts[1],
+	}
+	if err := client.bastionhostconn().Invoke("DeleteUser", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "bastionhost") {
+			return nil
+		}
+		return WrapError(err, "DeleteUser", d.Id())
+	}
+	return nil
+}
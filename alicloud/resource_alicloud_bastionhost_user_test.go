@@ -0,0 +1,118 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudBastionhostUser_basic(t *testing.T) {
+	var v BastionhostUser
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithBastionhostInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_bastionhost_user.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBastionhostUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBastionhostUserConfig("tf-testAccBastionhostUser"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBastionhostUserExists(
+						"alicloud_bastionhost_user.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_user.foo",
+						"user_name",
+						"tf-testAccBastionhostUser"),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_user.foo",
+						"display_name",
+						"tf-testAccBastionhostUser"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBastionhostUserConfig("tf-testAccBastionhostUserUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBastionhostUserExists(
+						"alicloud_bastionhost_user.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_bastionhost_user.foo",
+						"display_name",
+						"tf-testAccBastionhostUserUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBastionhostUserExists(n string, d *BastionhostUser) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bastionhost user ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		user, err := client.DescribeBastionhostUser(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *user
+		return nil
+	}
+}
+
+func testAccCheckBastionhostUserDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_bastionhost_user" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeBastionhostUser(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "bastionhost") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Bastionhost user %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBastionhostUserConfig(displayName string) string {
+	return fmt.Sprintf(`
+resource "alicloud_bastionhost_user" "foo" {
+  instance_id = "%s"
+  user_name = "tf-testAccBastionhostUser"
+  source = "Local"
+  password = "Tf-test123456"
+  display_name = "%s"
+}
+`, os.Getenv("ALICLOUD_BASTIONHOST_INSTANCE_ID"), displayName)
+}
@@ -1,8 +1,10 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/denverdino/aliyungo/cdn"
+	"github.com/denverdino/aliyungo/common"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"strconv"
@@ -16,16 +18,26 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 		Read:   resourceAlicloudCdnDomainRead,
 		Update: resourceAlicloudCdnDomainUpdate,
 		Delete: resourceAlicloudCdnDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validateDomainName,
 			},
 			"cdn_type": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validateCdnType,
 			},
 			"source_type": &schema.Schema{
@@ -50,8 +62,18 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 			"scope": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
 				ValidateFunc: validateCdnScope,
 			},
+			"cname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 
 			// configs
 			"optimize_enable": &schema.Schema{
@@ -84,6 +106,67 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
+				ConflictsWith: []string{"allow_ips"},
+			},
+			"allow_ips": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ConflictsWith: []string{"block_ips"},
+			},
+
+			"redirect_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Off",
+				ValidateFunc: validateCdnRedirectType,
+			},
+			"certificate_config": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_certificate_status": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "on",
+							ValidateFunc: validateCdnEnable,
+						},
+						"cert_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"cert_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "upload",
+							ValidateFunc: validateAllowedStringValue(CdnCertTypes),
+						},
+						"server_certificate": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"private_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+				MaxItems: 1,
+			},
+			"tls_versions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAllowedStringValue([]string{"TLSv1.0", "TLSv1.1", "TLSv1.2", "TLSv1.3"}),
+				},
 			},
 
 			"parameter_filter_config": &schema.Schema{
@@ -257,7 +340,8 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 }
 
 func resourceAlicloudCdnDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).cdnconn
+	conn := meta.(*AliyunClient).cdnconn()
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
 
 	args := cdn.AddDomainRequest{
 		DomainName: d.Get("domain_name").(string),
@@ -274,26 +358,37 @@ func resourceAlicloudCdnDomainCreate(d *schema.ResourceData, meta interface{}) e
 			sources := expandStringList(v.(*schema.Set).List())
 			args.Sources = strings.Join(sources, ",")
 		} else {
-			return fmt.Errorf("Sources is required when 'cdn_type' is not 'liveStream'.")
+			return ConfigErrorf(ErrorCodeMissingArgument, "Sources is required when 'cdn_type' is not 'liveStream'.")
 		}
 
 		if v, ok := d.GetOk("source_type"); ok && v.(string) != "" {
 			args.SourceType = v.(string)
 		} else {
-			return fmt.Errorf("SourceType is required when 'cdn_type' is not 'liveStream'.")
+			return ConfigErrorf(ErrorCodeMissingArgument, "SourceType is required when 'cdn_type' is not 'liveStream'.")
 		}
 	}
 	_, err := conn.AddCdnDomain(args)
 	if err != nil {
-		return fmt.Errorf("AddCdnDomain got an error: %#v", err)
+		if IsExceptedError(err, InvalidDomainNotICP) {
+			return fmt.Errorf("The domain %s has not been filed for ICP, and it can not be added into CDN before the ICP filing is completed.", args.DomainName)
+		}
+		if IsExceptedError(err, DomainOwnerVerifyFail) {
+			return fmt.Errorf("The ownership of the domain %s has not been verified, please add the verification DNS record and try again.", args.DomainName)
+		}
+		return WrapError(err, "AddCdnDomain", d.Id())
 	}
 
 	d.SetId(args.DomainName)
+
+	if err := meta.(*AliyunClient).WaitForCdnDomain(args.DomainName, CdnDomainOnline, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForCdnDomain %s got an error: %#v", CdnDomainOnline, err)
+	}
+
 	return resourceAlicloudCdnDomainUpdate(d, meta)
 }
 
 func resourceAlicloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).cdnconn
+	conn := meta.(*AliyunClient).cdnconn()
 
 	d.Partial(true)
 
@@ -322,7 +417,7 @@ func resourceAlicloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) e
 		if attributeUpdate {
 			_, err := conn.ModifyCdnDomain(args)
 			if err != nil {
-				return fmt.Errorf("ModifyCdnDomain got an error: %#v", err)
+				return WrapError(err, "ModifyCdnDomain", d.Id())
 			}
 		}
 	}
@@ -341,6 +436,36 @@ func resourceAlicloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("allow_ips") {
+		d.SetPartial("allow_ips")
+		allowIps := expandStringList(d.Get("allow_ips").(*schema.Set).List())
+		args := CdnIpAllowListConfigArgs{DomainName: d.Id(), AllowIps: strings.Join(allowIps, ",")}
+		if err := conn.Invoke("SetIpAllowListConfig", &args, &common.Response{}); err != nil {
+			return WrapError(err, "SetIpAllowListConfig", d.Id())
+		}
+	}
+
+	if d.HasChange("certificate_config") {
+		if err := certificateConfigUpdate(conn, d); err != nil {
+			return err
+		}
+	}
+
+	// Forcing https needs the certificate, so it is set after the certificate.
+	if d.HasChange("redirect_type") {
+		d.SetPartial("redirect_type")
+		args := SetCdnForceRedirectConfigArgs{DomainName: d.Id(), RedirectType: d.Get("redirect_type").(string)}
+		if err := conn.Invoke("SetForceRedirectConfig", &args, &common.Response{}); err != nil {
+			return WrapError(err, "SetForceRedirectConfig", d.Id())
+		}
+	}
+
+	if d.HasChange("tls_versions") {
+		if err := tlsVersionsUpdate(conn, d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("parameter_filter_config") {
 		if err := queryStringConfigUpdate(conn, d); err != nil {
 			return err
@@ -382,22 +507,27 @@ func resourceAlicloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).cdnconn
+	client := meta.(*AliyunClient)
+	conn := client.cdnconn()
 
-	args := cdn.DescribeDomainRequest{
-		DomainName: d.Id(),
-	}
-	response, err := conn.DescribeCdnDomainDetail(args)
+	domain, err := client.DescribeCdnDomain(d.Id())
 	if err != nil {
-		return fmt.Errorf("DescribeCdnDomainDetail got an error: %#v", err)
+		if NotFoundError(err, "cdn") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeCdnDomainDetail", d.Id())
 	}
 
-	domain := response.GetDomainDetailModel
 	d.Set("domain_name", domain.DomainName)
-	d.Set("sources", domain.Sources.Source)
+	if err := d.Set("sources", domain.Sources.Source); err != nil {
+		return err
+	}
 	d.Set("cdn_type", domain.CdnType)
 	d.Set("source_type", domain.SourceType)
 	d.Set("scope", domain.Scope)
+	d.Set("cname", domain.Cname)
+	d.Set("domain_status", domain.DomainStatus)
 
 	// get domain configs
 	describeConfigArgs := cdn.DomainConfigRequest{
@@ -405,7 +535,7 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 	}
 	resp, err := conn.DescribeDomainConfigs(describeConfigArgs)
 	if err != nil {
-		return fmt.Errorf("DescribeDomainConfigs got an error: %#v", err)
+		return WrapError(err, "DescribeDomainConfigs", d.Id())
 	}
 	configs := resp.DomainConfigs
 
@@ -416,7 +546,9 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 			"enable":        queryStringConfig.Enable,
 			"hash_key_args": strings.Split(queryStringConfig.HashKeyArgs, ","),
 		}
-		d.Set("parameter_filter_config", config)
+		if err := d.Set("parameter_filter_config", config); err != nil {
+			return err
+		}
 	}
 
 	errorPageConfig := configs.ErrorPageConfig
@@ -430,7 +562,9 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 		if errorPageConfig.PageType == "" {
 			config[0]["page_type"] = "default"
 		}
-		d.Set("page_404_config", config)
+		if err := d.Set("page_404_config", config); err != nil {
+			return err
+		}
 	}
 
 	referConfig := configs.RefererConfig
@@ -441,7 +575,9 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 			"refer_list":  strings.Split(referConfig.ReferList, ","),
 			"allow_empty": referConfig.AllowEmpty,
 		}
-		d.Set("refer_config", config)
+		if err := d.Set("refer_config", config); err != nil {
+			return err
+		}
 	}
 
 	authConfig := configs.ReqAuthConfig
@@ -454,7 +590,9 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 			"slave_key":  authConfig.Key2,
 			"timeout":    timeout,
 		}
-		d.Set("auth_config", config)
+		if err := d.Set("auth_config", config); err != nil {
+			return err
+		}
 	}
 
 	headerConfigs := configs.HttpHeaderConfigs.HttpHeaderConfig
@@ -466,7 +604,9 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 		val["header_id"] = v.ConfigId
 		httpHeaderConfigs = append(httpHeaderConfigs, val)
 	}
-	d.Set("http_header_config", httpHeaderConfigs)
+	if err := d.Set("http_header_config", httpHeaderConfigs); err != nil {
+		return err
+	}
 
 	cacheConfigs := configs.CacheExpiredConfigs.CacheExpiredConfig
 	cacheExpiredConfigs := make([]map[string]interface{}, 0, len(cacheConfigs))
@@ -481,25 +621,76 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 		val["ttl"] = ttl
 		cacheExpiredConfigs = append(cacheExpiredConfigs, val)
 	}
-	d.Set("cache_config", cacheExpiredConfigs)
+	if err := d.Set("cache_config", cacheExpiredConfigs); err != nil {
+		return err
+	}
 
 	d.Set("optimize_enable", configs.OptimizeConfig.Enable)
 	d.Set("page_compress_enable", configs.PageCompressConfig.Enable)
 	d.Set("range_enable", configs.RangeConfig.Enable)
 	d.Set("video_seek_enable", configs.VideoSeekConfig.Enable)
-	d.Set("block_ips", strings.Split(configs.CcConfig.BlockIps, ","))
+	if err := d.Set("block_ips", splitCdnConfigList(configs.CcConfig.BlockIps)); err != nil {
+		return err
+	}
+
+	extraConfigs, err := client.DescribeCdnDomainConfigs(d.Id(), "ip_allow_list,force_redirect")
+	if err != nil {
+		return WrapError(err, "DescribeDomainConfigs", d.Id())
+	}
+	if err := d.Set("allow_ips", splitCdnConfigList(extraConfigs.DomainConfigs.IpAllowListConfig.AllowIps)); err != nil {
+		return err
+	}
+	if redirectType := extraConfigs.DomainConfigs.ForceRedirectConfig.RedirectType; redirectType != "" {
+		d.Set("redirect_type", redirectType)
+	} else {
+		d.Set("redirect_type", "Off")
+	}
+
+	if _, ok := d.GetOk("certificate_config"); ok {
+		cert, err := client.DescribeCdnDomainCertificate(d.Id())
+		if err != nil && !NotFoundError(err, "cdn") {
+			return WrapError(err, "DescribeDomainCertificateInfo", d.Id())
+		}
+		if cert != nil {
+			config := d.Get("certificate_config").(*schema.Set).List()[0].(map[string]interface{})
+			if err := d.Set("certificate_config", []map[string]interface{}{
+				{
+					"server_certificate_status": cert.ServerCertificateStatus,
+					"cert_name":                 cert.CertName,
+					"cert_type":                 cert.CertType,
+					"server_certificate":        cert.ServerCertificate,
+					// The private key is never returned.
+					"private_key": config["private_key"],
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	tlsVersions, err := client.DescribeCdnDomainTlsVersions(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeCdnDomainConfigs https_tls_version got an error: %#v", err)
+	}
+	if err := d.Set("tls_versions", tlsVersions); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 func resourceAlicloudCdnDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).cdnconn
+	conn := meta.(*AliyunClient).cdnconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := cdn.DescribeDomainRequest{
 		DomainName: d.Id(),
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DeleteCdnDomain(args); err != nil {
+			if NotFoundError(err, "cdn") {
+				return nil
+			}
 			if IsExceptedError(err, ServiceBusy) {
 				return resource.RetryableError(fmt.Errorf("The specified Domain is configuring, please retry later."))
 			}
@@ -580,13 +771,13 @@ func page404ConfigUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
 	}
 
 	if args.PageType == "charity" && (ok && customPageUrl.(string) != CharityPageUrl || !ok) {
-		return fmt.Errorf("If 'page_type' value is 'charity', you must set 'custom_page_url' with '%s'.", CharityPageUrl)
+		return ConfigErrorf(ErrorCodeMissingArgument, "If 'page_type' value is 'charity', you must set 'custom_page_url' with '%s'.", CharityPageUrl)
 	}
 	if args.PageType == "default" && ok && customPageUrl.(string) != "" {
-		return fmt.Errorf("If 'page_type' value is 'default', you can not set 'custom_page_url'.")
+		return ConfigErrorf(ErrorCodeConflictingArguments, "If 'page_type' value is 'default', you can not set 'custom_page_url'.")
 	}
 	if args.PageType == "other" && (!ok || customPageUrl.(string) == "") {
-		return fmt.Errorf("If 'page_type' value is 'other', you must set the value of 'custom_page_url'.")
+		return ConfigErrorf(ErrorCodeMissingArgument, "If 'page_type' value is 'other', you must set the value of 'custom_page_url'.")
 	}
 
 	if _, err := conn.SetErrorPageConfig(args); err != nil {
@@ -652,17 +843,17 @@ func authConfigUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
 	if args.AuthType == "no_auth" {
 		if oldConfig == nil || oldConfig.Len() == 0 {
 			if okMasterKey || okSlaveKey {
-				return fmt.Errorf("If 'auth_type' value is 'no_auth', you can not set the value of 'master_key' and 'slave_key'.")
+				return ConfigErrorf(ErrorCodeConflictingArguments, "If 'auth_type' value is 'no_auth', you can not set the value of 'master_key' and 'slave_key'.")
 			}
 		} else {
 			oldVal := oldConfig.List()[0].(map[string]interface{})
 			if oldVal["master_key"] != val["master_key"] || oldVal["slave_key"] != val["slave_key"] {
-				return fmt.Errorf("If 'auth_type' value is 'no_auth', you can not change the value of 'master_key' and 'slave_key'.")
+				return ConfigErrorf(ErrorCodeConflictingArguments, "If 'auth_type' value is 'no_auth', you can not change the value of 'master_key' and 'slave_key'.")
 			}
 		}
 	} else {
 		if !okMasterKey || !okSlaveKey {
-			return fmt.Errorf("If 'auth_type' value is one of ['type_a', 'type_b', 'type_c'], you must set 'master_key' and 'slave_key' at one time.")
+			return ConfigErrorf(ErrorCodeMissingArgument, "If 'auth_type' value is one of ['type_a', 'type_b', 'type_c'], you must set 'master_key' and 'slave_key' at one time.")
 		}
 	}
 
@@ -700,7 +891,7 @@ func httpHeaderConfigUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
 		}
 		_, err := conn.SetHttpHeaderConfig(args)
 		if err != nil {
-			return fmt.Errorf("SetHttpHeaderConfig got an error: %#v", err)
+			return WrapError(err, "SetHttpHeaderConfig", d.Id())
 		}
 	}
 
@@ -721,7 +912,7 @@ func cacheConfigUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
 			CacheType:  val["cache_type"].(string),
 		}
 		if _, err := conn.DeleteCacheExpiredConfig(args); err != nil {
-			return fmt.Errorf("DeleteCacheExpiredConfig got an error: %#v", err)
+			return WrapError(err, "DeleteCacheExpiredConfig", d.Id())
 		}
 	}
 
@@ -753,3 +944,72 @@ func setCacheExpiredConfig(req cdn.CacheConfigRequest, cacheType string, conn *c
 	}
 	return
 }
+
+func certificateConfigUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
+	valSet := d.Get("certificate_config").(*schema.Set)
+	args := SetCdnDomainServerCertificateArgs{DomainName: d.Id()}
+
+	if valSet == nil || valSet.Len() == 0 {
+		args.ServerCertificateStatus = "off"
+		if err := conn.Invoke("SetDomainServerCertificate", &args, &common.Response{}); err != nil {
+			return WrapError(err, "SetDomainServerCertificate", d.Id())
+		}
+		return nil
+	}
+
+	val := valSet.List()[0].(map[string]interface{})
+	d.SetPartial("certificate_config")
+	args.ServerCertificateStatus = val["server_certificate_status"].(string)
+	args.CertName = val["cert_name"].(string)
+	args.CertType = val["cert_type"].(string)
+
+	if args.ServerCertificateStatus == "on" {
+		switch args.CertType {
+		case "upload":
+			args.ServerCertificate = val["server_certificate"].(string)
+			args.PrivateKey = val["private_key"].(string)
+			if args.ServerCertificate == "" || args.PrivateKey == "" {
+				return ConfigErrorf(ErrorCodeMissingArgument, "If 'cert_type' value is 'upload', you must set 'server_certificate' and 'private_key' at one time.")
+			}
+			// Overwrite the certificate with the same name.
+			args.ForceSet = "1"
+		case "cas":
+			if args.CertName == "" {
+				return ConfigErrorf(ErrorCodeMissingArgument, "If 'cert_type' value is 'cas', you must set 'cert_name' with the name of the certificate in the SSL certificates service.")
+			}
+		}
+	}
+
+	if err := conn.Invoke("SetDomainServerCertificate", &args, &common.Response{}); err != nil {
+		return WrapError(err, "SetDomainServerCertificate", d.Id())
+	}
+	return nil
+}
+
+func tlsVersionsUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
+	d.SetPartial("tls_versions")
+	enabled := d.Get("tls_versions").(*schema.Set)
+
+	var args []CdnFunctionArg
+	for version, name := range CdnTlsVersions {
+		value := "off"
+		if enabled.Contains(version) {
+			value = "on"
+		}
+		args = append(args, CdnFunctionArg{ArgName: name, ArgValue: value})
+	}
+
+	functions, err := json.Marshal([]CdnFunction{{FunctionName: "https_tls_version", FunctionArgs: args}})
+	if err != nil {
+		return err
+	}
+
+	req := BatchSetCdnDomainConfigArgs{
+		DomainNames: d.Id(),
+		Functions:   string(functions),
+	}
+	if err := conn.Invoke("BatchSetCdnDomainConfig", &req, &common.Response{}); err != nil {
+		return WrapError(err, "BatchSetCdnDomainConfig", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCdnRealTimeLogDelivery() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCdnRealTimeLogDeliveryCreate,
+		Read:   resourceAlicloudCdnRealTimeLogDeliveryRead,
+		Update: resourceAlicloudCdnRealTimeLogDeliveryUpdate,
+		Delete: resourceAlicloudCdnRealTimeLogDeliveryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDomainName,
+			},
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"logstore": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sls_region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(CdnDomainOnline),
+				ValidateFunc: validateAllowedStringValue([]string{string(CdnDomainOnline), string(CdnDomainOffline)}),
+			},
+		},
+	}
+}
+
+func resourceAlicloudCdnRealTimeLogDeliveryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CdnRealTimeLogDeliveryArgs{
+		Domain:   d.Get("domain").(string),
+		Project:  d.Get("project").(string),
+		Logstore: d.Get("logstore").(string),
+		Region:   d.Get("sls_region").(string),
+	}
+	if err := client.cdnNewconn().Invoke("CreateRealTimeLogDelivery", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateRealTimeLogDelivery", d.Id())
+	}
+
+	d.SetId(args.Domain)
+
+	return resourceAlicloudCdnRealTimeLogDeliveryUpdate(d, meta)
+}
+
+func resourceAlicloudCdnRealTimeLogDeliveryRead(d *schema.ResourceData, meta interface{}) error {
+	delivery, err := meta.(*AliyunClient).DescribeCdnRealTimeLogDelivery(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cdn") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDomainRealTimeLogDelivery", d.Id())
+	}
+
+	d.Set("domain", d.Id())
+	d.Set("project", delivery.Project)
+	d.Set("logstore", delivery.Logstore)
+	d.Set("sls_region", delivery.Region)
+	d.Set("status", delivery.Status)
+
+	return nil
+}
+
+func resourceAlicloudCdnRealTimeLogDeliveryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if !d.IsNewResource() && (d.HasChange("project") || d.HasChange("logstore") || d.HasChange("sls_region")) {
+		args := CdnRealTimeLogDeliveryArgs{
+			Domain:   d.Id(),
+			Project:  d.Get("project").(string),
+			Logstore: d.Get("logstore").(string),
+			Region:   d.Get("sls_region").(string),
+		}
+		if err := client.cdnNewconn().Invoke("ModifyRealtimeLogDelivery", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyRealtimeLogDelivery", d.Id())
+		}
+		d.SetPartial("project")
+		d.SetPartial("logstore")
+		d.SetPartial("sls_region")
+	}
+
+	// A new real-time log delivery is online by default.
+	if d.HasChange("status") && !(d.IsNewResource() && d.Get("status").(string) == string(CdnDomainOnline)) {
+		action := "EnableRealtimeLogDelivery"
+		if d.Get("status").(string) == string(CdnDomainOffline) {
+			action = "DisableRealtimeLogDelivery"
+		}
+		args := CdnDomainArgs{
+			Domain: d.Id(),
+		}
+		if err := client.cdnNewconn().Invoke(action, &args, &common.Response{}); err != nil {
+			return fmt.Errorf("%s got an error: %#v", action, err)
+		}
+		d.SetPartial("status")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudCdnRealTimeLogDeliveryRead(d, meta)
+}
+
+func resourceAlicloudCdnRealTimeLogDeliveryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := CdnRealTimeLogDeliveryArgs{
+		Domain:   d.Id(),
+		Project:  d.Get("project").(string),
+		Logstore: d.Get("logstore").(string),
+		Region:   d.Get("sls_region").(string),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.cdnNewconn().Invoke("DeleteRealtimeLogDelivery", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "cdn") {
+				return nil
+			}
+			if IsExceptedError(err, ServiceBusy) {
+				return resource.RetryableError(fmt.Errorf("The specified domain is configuring, please retry later."))
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteRealtimeLogDelivery", d.Id()))
+		}
+
+		if _, err := client.DescribeCdnRealTimeLogDelivery(d.Id()); err != nil {
+			if NotFoundError(err, "cdn") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Real-time log delivery %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
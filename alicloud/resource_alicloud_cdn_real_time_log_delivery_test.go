@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCdnRealTimeLogDelivery_basic(t *testing.T) {
+	var v DescribeCdnRealTimeLogDeliveryResponse
+	project := os.Getenv("ALICLOUD_LOG_PROJECT")
+	logstore := os.Getenv("ALICLOUD_LOG_STORE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithLogStore(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cdn_real_time_log_delivery.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCdnRealTimeLogDeliveryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCdnRealTimeLogDeliveryConfig(project, logstore, os.Getenv("ALICLOUD_REGION"), "online"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCdnRealTimeLogDeliveryExists(
+						"alicloud_cdn_real_time_log_delivery.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cdn_real_time_log_delivery.foo",
+						"project",
+						project),
+					resource.TestCheckResourceAttr(
+						"alicloud_cdn_real_time_log_delivery.foo",
+						"logstore",
+						logstore),
+					resource.TestCheckResourceAttr(
+						"alicloud_cdn_real_time_log_delivery.foo",
+						"status",
+						"online"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCdnRealTimeLogDeliveryConfig(project, logstore, os.Getenv("ALICLOUD_REGION"), "offline"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCdnRealTimeLogDeliveryExists(
+						"alicloud_cdn_real_time_log_delivery.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cdn_real_time_log_delivery.foo",
+						"status",
+						"offline"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCdnRealTimeLogDeliveryExists(n string, d *DescribeCdnRealTimeLogDeliveryResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No real-time log delivery ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		delivery, err := client.DescribeCdnRealTimeLogDelivery(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *delivery
+		return nil
+	}
+}
+
+func testAccCheckCdnRealTimeLogDeliveryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cdn_real_time_log_delivery" {
+			continue
+		}
+
+		if _, err := client.DescribeCdnRealTimeLogDelivery(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cdn") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Real-time log delivery %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCdnRealTimeLogDeliveryConfig(project, logstore, region, status string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cdn_domain" "domain" {
+  domain_name = "www.aliyun.com"
+  cdn_type = "web"
+  source_type = "domain"
+  sources = ["jb51.net"]
+}
+
+resource "alicloud_cdn_real_time_log_delivery" "foo" {
+  domain = "${alicloud_cdn_domain.domain.domain_name}"
+  project = "%s"
+  logstore = "%s"
+  sls_region = "%s"
+  status = "%s"
+}
+`, project, logstore, region, status)
+}
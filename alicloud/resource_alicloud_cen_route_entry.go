@@ -0,0 +1,145 @@
+package alicloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCenRouteEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCenRouteEntryCreate,
+		Read:   resourceAlicloudCenRouteEntryRead,
+		Delete: resourceAlicloudCenRouteEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cen_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The vpc_id is the vpc attached to the CEN instance, and the route_table_id is its route table.
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"next_hop_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_hop_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCenRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CenRouteEntryArgs{
+		CenId:                     d.Get("cen_id").(string),
+		ChildInstanceId:           d.Get("vpc_id").(string),
+		ChildInstanceType:         CenChildInstanceVpc,
+		ChildInstanceRegionId:     client.Region,
+		ChildInstanceRouteTableId: d.Get("route_table_id").(string),
+		DestinationCidrBlock:      d.Get("cidr_block").(string),
+	}
+	if err := client.cenconn().Invoke("PublishRouteEntries", &args, &common.Response{}); err != nil {
+		return WrapError(err, "PublishRouteEntries", d.Id())
+	}
+
+	// The cidr block contains colons for the ipv6 routes, which are kept by parseResourceId as the last part.
+	d.SetId(strings.Join([]string{args.CenId, args.ChildInstanceId, args.ChildInstanceRouteTableId,
+		args.DestinationCidrBlock}, COLON_SEPARATED))
+
+	if err := client.WaitForCenRouteEntry(args.CenId, args.ChildInstanceId, args.ChildInstanceRouteTableId,
+		args.DestinationCidrBlock, CenRouteEntryPublished, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForCenRouteEntry", d.Id())
+	}
+
+	return resourceAlicloudCenRouteEntryRead(d, meta)
+}
+
+func resourceAlicloudCenRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	entry, err := meta.(*AliyunClient).DescribeCenRouteEntry(parts[0], parts[1], parts[2], parts[3])
+	if err != nil {
+		if NotFoundError(err, "cen") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribePublishedRouteEntries", d.Id())
+	}
+	// The entry withdrawn outside of terraform is still listed by the DescribePublishedRouteEntries.
+	if entry.PublishStatus != CenRouteEntryPublished {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cen_id", parts[0])
+	d.Set("vpc_id", parts[1])
+	d.Set("route_table_id", entry.ChildInstanceRouteTableId)
+	d.Set("cidr_block", entry.DestinationCidrBlock)
+	d.Set("next_hop_type", entry.NextHopType)
+	d.Set("next_hop_id", entry.NextHopId)
+
+	return nil
+}
+
+func resourceAlicloudCenRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	args := CenRouteEntryArgs{
+		CenId:                     parts[0],
+		ChildInstanceId:           parts[1],
+		ChildInstanceType:         CenChildInstanceVpc,
+		ChildInstanceRegionId:     client.Region,
+		ChildInstanceRouteTableId: parts[2],
+		DestinationCidrBlock:      parts[3],
+	}
+	if err := client.cenconn().Invoke("WithdrawPublishedRouteEntries", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "cen") {
+			return nil
+		}
+		return WrapError(err, "WithdrawPublishedRouteEntries", d.Id())
+	}
+
+	if err := client.WaitForCenRouteEntry(parts[0], parts[1], parts[2], parts[3], CenRouteEntryNonPublished, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForCenRouteEntry", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCenRouteEntry_basic(t *testing.T) {
+	var v CenPublishedRouteEntry
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithCenInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cen_route_entry.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCenRouteEntryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCenRouteEntryConfig(os.Getenv("ALICLOUD_CEN_ID"), os.Getenv("ALICLOUD_CEN_VSWITCH_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCenRouteEntryExists(
+						"alicloud_cen_route_entry.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_entry.foo",
+						"cidr_block",
+						"192.168.254.0/24"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_entry.foo",
+						"next_hop_type",
+						"Instance"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCenRouteEntryExists(n string, d *CenPublishedRouteEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CEN route entry ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 4)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		entry, err := client.DescribeCenRouteEntry(parts[0], parts[1], parts[2], parts[3])
+		if err != nil {
+			return err
+		}
+		if entry.PublishStatus != CenRouteEntryPublished {
+			return fmt.Errorf("CEN route entry %s is %s.", rs.Primary.ID, entry.PublishStatus)
+		}
+
+		*d = *entry
+		return nil
+	}
+}
+
+func testAccCheckCenRouteEntryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cen_route_entry" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 4)
+		if err != nil {
+			return err
+		}
+
+		entry, err := client.DescribeCenRouteEntry(parts[0], parts[1], parts[2], parts[3])
+		if err != nil {
+			if NotFoundError(err, "cen") {
+				continue
+			}
+			return err
+		}
+		if entry.PublishStatus == CenRouteEntryPublished {
+			return fmt.Errorf("CEN route entry %s is still published.", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCenRouteEntryConfig(cenId, vswitchId string) string {
+	return fmt.Sprintf(`
+data "alicloud_vpcs" "default" {
+  vswitch_id = "%s"
+}
+
+data "alicloud_images" "default" {
+  most_recent = true
+  owners = "system"
+  name_regex = "^centos_7"
+}
+
+resource "alicloud_security_group" "foo" {
+  name = "tf-testAccCenRouteEntry"
+  vpc_id = "${data.alicloud_vpcs.default.vpcs.0.id}"
+}
+
+resource "alicloud_instance" "foo" {
+  vswitch_id = "%s"
+  image_id = "${data.alicloud_images.default.images.0.id}"
+  instance_type = "ecs.n4.large"
+  system_disk_category = "cloud_efficiency"
+  security_groups = ["${alicloud_security_group.foo.id}"]
+  instance_name = "tf-testAccCenRouteEntry"
+}
+
+resource "alicloud_route_entry" "foo" {
+  route_table_id = "${data.alicloud_vpcs.default.vpcs.0.route_table_id}"
+  destination_cidrblock = "192.168.254.0/24"
+  nexthop_type = "Instance"
+  nexthop_id = "${alicloud_instance.foo.id}"
+}
+
+resource "alicloud_cen_route_entry" "foo" {
+  cen_id = "%s"
+  vpc_id = "${data.alicloud_vpcs.default.vpcs.0.id}"
+  route_table_id = "${alicloud_route_entry.foo.route_table_id}"
+  cidr_block = "${alicloud_route_entry.foo.destination_cidrblock}"
+}
+`, vswitchId, vswitchId, cenId)
+}
@@ -0,0 +1,302 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// cenRouteMapStringSets are the match and action conditions of the route maps which are sets of strings, like
+// the source_instance_ids. The prepend_as_path is a list, as the order of the AS numbers matters.
+var cenRouteMapStringSets = []string{
+	"source_instance_ids", "destination_instance_ids", "source_region_ids", "source_child_instance_types",
+	"destination_child_instance_types", "source_route_table_ids", "destination_route_table_ids",
+	"destination_cidr_blocks", "route_types", "match_asns", "match_community_set", "operate_community_set",
+}
+
+func resourceAlicloudCenRouteMap() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"cen_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		// The cen_region_id is the region of the route map, and it is the region of the provider by default.
+		"cen_region_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"transmit_direction": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateAllowedStringValue([]string{CenRegionIn, CenRegionOut}),
+		},
+		// The route maps of the same direction are matched in the ascending order of the priority.
+		"priority": &schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validateIntegerInRange(1, 100),
+		},
+		"map_result": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateAllowedStringValue([]string{"Permit", "Deny"}),
+		},
+		// The next_priority is the priority of the route map matched after this one, which only works when the
+		// map_result is Permit.
+		"next_priority": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validateIntegerInRange(1, 100),
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"cidr_match_mode": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateAllowedStringValue([]string{"Include", "Complete"}),
+		},
+		"as_path_match_mode": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateAllowedStringValue([]string{"Include", "Complete"}),
+		},
+		"community_match_mode": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateAllowedStringValue([]string{"Include", "Complete"}),
+		},
+		"community_operate_mode": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateAllowedStringValue([]string{"Additive", "Replace"}),
+		},
+		"preference": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"source_instance_ids_reverse_match": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"destination_instance_ids_reverse_match": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"prepend_as_path": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"route_map_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for _, key := range cenRouteMapStringSets {
+		s[key] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceAlicloudCenRouteMapCreate,
+		Read:   resourceAlicloudCenRouteMapRead,
+		Update: resourceAlicloudCenRouteMapUpdate,
+		Delete: resourceAlicloudCenRouteMapDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceAlicloudCenRouteMapCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := buildCenRouteMapArgs(d)
+	if args.CenRegionId == "" {
+		args.CenRegionId = string(client.Region)
+	}
+	resp := CreateCenRouteMapResponse{}
+	if err := client.cenconn().Invoke("CreateCenRouteMap", &args, &resp); err != nil {
+		return WrapError(err, "CreateCenRouteMap", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.CenId, COLON_SEPARATED, resp.RouteMapId))
+
+	if err := client.WaitForCenRouteMap(args.CenId, resp.RouteMapId, CenRouteMapActive, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForCenRouteMap", d.Id())
+	}
+
+	return resourceAlicloudCenRouteMapRead(d, meta)
+}
+
+func resourceAlicloudCenRouteMapRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	routeMap, err := meta.(*AliyunClient).DescribeCenRouteMap(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "cen") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeCenRouteMaps", d.Id())
+	}
+
+	d.Set("cen_id", parts[0])
+	d.Set("cen_region_id", routeMap.CenRegionId)
+	d.Set("transmit_direction", routeMap.TransmitDirection)
+	d.Set("priority", routeMap.Priority)
+	d.Set("map_result", routeMap.MapResult)
+	d.Set("next_priority", routeMap.NextPriority)
+	d.Set("description", routeMap.Description)
+	d.Set("cidr_match_mode", routeMap.CidrMatchMode)
+	d.Set("as_path_match_mode", routeMap.AsPathMatchMode)
+	d.Set("community_match_mode", routeMap.CommunityMatchMode)
+	d.Set("community_operate_mode", routeMap.CommunityOperateMode)
+	d.Set("preference", routeMap.Preference)
+	d.Set("source_instance_ids_reverse_match", routeMap.SourceInstanceIdsReverseMatch)
+	d.Set("destination_instance_ids_reverse_match", routeMap.DestinationInstanceIdsReverseMatch)
+	d.Set("route_map_id", routeMap.RouteMapId)
+	d.Set("status", routeMap.Status)
+
+	lists := map[string][]string{
+		"source_instance_ids":              routeMap.SourceInstanceIds.SourceInstanceId,
+		"destination_instance_ids":         routeMap.DestinationInstanceIds.DestinationInstanceId,
+		"source_region_ids":                routeMap.SourceRegionIds.SourceRegionId,
+		"source_child_instance_types":      routeMap.SourceChildInstanceTypes.SourceChildInstanceType,
+		"destination_child_instance_types": routeMap.DestinationChildInstanceTypes.DestinationChildInstanceType,
+		"source_route_table_ids":           routeMap.SourceRouteTableIds.SourceRouteTableId,
+		"destination_route_table_ids":      routeMap.DestinationRouteTableIds.DestinationRouteTableId,
+		"destination_cidr_blocks":          routeMap.DestinationCidrBlocks.DestinationCidrBlock,
+		"route_types":                      routeMap.RouteTypes.RouteType,
+		"match_asns":                       routeMap.MatchAsns.MatchAsn,
+		"match_community_set":              routeMap.MatchCommunitySet.MatchCommunity,
+		"operate_community_set":            routeMap.OperateCommunitySet.OperateCommunity,
+		"prepend_as_path":                  routeMap.PrependAsPath.AsPath,
+	}
+	for key, list := range lists {
+		if err := d.Set(key, list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAlicloudCenRouteMapUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	// ModifyCenRouteMap overwrites all the conditions and actions of the route map.
+	args := buildCenRouteMapArgs(d)
+	args.RouteMapId = parts[1]
+	if err := client.cenconn().Invoke("ModifyCenRouteMap", &args, &common.Response{}); err != nil {
+		return WrapError(err, "ModifyCenRouteMap", d.Id())
+	}
+
+	if err := client.WaitForCenRouteMap(parts[0], parts[1], CenRouteMapActive, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForCenRouteMap", d.Id())
+	}
+
+	return resourceAlicloudCenRouteMapRead(d, meta)
+}
+
+func resourceAlicloudCenRouteMapDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := DeleteCenRouteMapArgs{
+		CenId:       parts[0],
+		CenRegionId: d.Get("cen_region_id").(string),
+		RouteMapId:  parts[1],
+	}
+	if err := client.cenconn().Invoke("DeleteCenRouteMap", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "cen") {
+			return nil
+		}
+		return WrapError(err, "DeleteCenRouteMap", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeCenRouteMap(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "cen") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DescribeCenRouteMaps", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("CEN route map %s is still being deleted", d.Id()))
+	})
+}
+
+func buildCenRouteMapArgs(d *schema.ResourceData) CenRouteMapArgs {
+	setList := func(key string) []string {
+		return expandStringList(d.Get(key).(*schema.Set).List())
+	}
+	return CenRouteMapArgs{
+		CenId:                              d.Get("cen_id").(string),
+		CenRegionId:                        d.Get("cen_region_id").(string),
+		TransmitDirection:                  d.Get("transmit_direction").(string),
+		Priority:                           d.Get("priority").(int),
+		MapResult:                          d.Get("map_result").(string),
+		NextPriority:                       d.Get("next_priority").(int),
+		Description:                        d.Get("description").(string),
+		CidrMatchMode:                      d.Get("cidr_match_mode").(string),
+		AsPathMatchMode:                    d.Get("as_path_match_mode").(string),
+		CommunityMatchMode:                 d.Get("community_match_mode").(string),
+		CommunityOperateMode:               d.Get("community_operate_mode").(string),
+		Preference:                         d.Get("preference").(int),
+		SourceInstanceIdsReverseMatch:      d.Get("source_instance_ids_reverse_match").(bool),
+		DestinationInstanceIdsReverseMatch: d.Get("destination_instance_ids_reverse_match").(bool),
+		SourceInstanceIds:                  setList("source_instance_ids"),
+		DestinationInstanceIds:             setList("destination_instance_ids"),
+		SourceRegionIds:                    setList("source_region_ids"),
+		SourceChildInstanceTypes:           setList("source_child_instance_types"),
+		DestinationChildInstanceTypes:      setList("destination_child_instance_types"),
+		SourceRouteTableIds:                setList("source_route_table_ids"),
+		DestinationRouteTableIds:           setList("destination_route_table_ids"),
+		DestinationCidrBlocks:              setList("destination_cidr_blocks"),
+		RouteTypes:                         setList("route_types"),
+		MatchAsns:                          setList("match_asns"),
+		MatchCommunitySet:                  setList("match_community_set"),
+		OperateCommunitySet:                setList("operate_community_set"),
+		PrependAsPath:                      expandStringList(d.Get("prepend_as_path").([]interface{})),
+	}
+}
@@ -0,0 +1,139 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCenRouteMap_basic(t *testing.T) {
+	var v CenRouteMap
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithCenInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cen_route_map.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCenRouteMapDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCenRouteMapConfig(os.Getenv("ALICLOUD_CEN_ID"), "Permit", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCenRouteMapExists(
+						"alicloud_cen_route_map.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_map.foo",
+						"map_result",
+						"Permit"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_map.foo",
+						"preference",
+						"20"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_map.foo",
+						"destination_cidr_blocks.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_map.foo",
+						"prepend_as_path.#",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_map.foo",
+						"status",
+						"Active"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCenRouteMapConfig(os.Getenv("ALICLOUD_CEN_ID"), "Deny", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCenRouteMapExists(
+						"alicloud_cen_route_map.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_map.foo",
+						"map_result",
+						"Deny"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cen_route_map.foo",
+						"preference",
+						"30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCenRouteMapExists(n string, d *CenRouteMap) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CEN route map ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		routeMap, err := client.DescribeCenRouteMap(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *routeMap
+		return nil
+	}
+}
+
+func testAccCheckCenRouteMapDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cen_route_map" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCenRouteMap(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "cen") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("CEN route map %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCenRouteMapConfig(cenId, mapResult string, preference int) string {
+	return fmt.Sprintf(`
+resource "alicloud_cen_route_map" "foo" {
+  cen_id = "%s"
+  transmit_direction = "RegionIn"
+  priority = 50
+  map_result = "%s"
+  description = "tf-testAccCenRouteMap"
+  cidr_match_mode = "Include"
+  destination_cidr_blocks = ["10.1.0.0/16"]
+  route_types = ["System"]
+  preference = %d
+  prepend_as_path = ["65501", "65502"]
+}
+`, cenId, mapResult, preference)
+}
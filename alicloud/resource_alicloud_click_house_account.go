@@ -0,0 +1,186 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudClickHouseAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudClickHouseAccountCreate,
+		Read:   resourceAlicloudClickHouseAccountRead,
+		Update: resourceAlicloudClickHouseAccountUpdate,
+		Delete: resourceAlicloudClickHouseAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceDescription,
+				Optional:     true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudClickHouseAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	clusterId := d.Get("db_cluster_id").(string)
+	name := d.Get("account_name").(string)
+
+	args := CreateClickHouseAccountArgs{
+		RegionId:           client.Region,
+		DBClusterId:        clusterId,
+		AccountName:        name,
+		AccountPassword:    d.Get("account_password").(string),
+		AccountDescription: d.Get("description").(string),
+	}
+
+	err := resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.clickhouseconn().Invoke("CreateAccount", &args, &ClickHouseResponse{}); err != nil {
+			if IsExceptedError(err, ClickHouseOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("ClickHouse cluster %s is busy - trying again while it is Running.", clusterId))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapError(err, "CreateAccount", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, name))
+
+	if err := client.WaitForClickHouseAccount(clusterId, name, ClickHouseAvailable, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForClickHouseAccount %s got an error: %#v", ClickHouseAvailable, err)
+	}
+
+	return resourceAlicloudClickHouseAccountRead(d, meta)
+}
+
+func resourceAlicloudClickHouseAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("description") {
+		args := ModifyClickHouseAccountDescriptionArgs{
+			RegionId:           client.Region,
+			DBClusterId:        parts[0],
+			AccountName:        parts[1],
+			AccountDescription: d.Get("description").(string),
+		}
+		if err := client.clickhouseconn().Invoke("ModifyAccountDescription", &args, &ClickHouseResponse{}); err != nil {
+			return WrapError(err, "ModifyAccountDescription", d.Id())
+		}
+		d.SetPartial("description")
+	}
+
+	if d.HasChange("account_password") {
+		args := ResetClickHouseAccountPasswordArgs{
+			RegionId:        client.Region,
+			DBClusterId:     parts[0],
+			AccountName:     parts[1],
+			AccountPassword: d.Get("account_password").(string),
+		}
+		if err := client.clickhouseconn().Invoke("ResetAccountPassword", &args, &ClickHouseResponse{}); err != nil {
+			return WrapError(err, "ResetAccountPassword", d.Id())
+		}
+		d.SetPartial("account_password")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudClickHouseAccountRead(d, meta)
+}
+
+func resourceAlicloudClickHouseAccountRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	account, err := meta.(*AliyunClient).DescribeClickHouseAccount(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "clickhouse") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeAccounts", d.Id())
+	}
+
+	d.Set("db_cluster_id", parts[0])
+	d.Set("account_name", account.AccountName)
+	d.Set("description", account.AccountDescription)
+	d.Set("type", account.AccountType)
+
+	return nil
+}
+
+func resourceAlicloudClickHouseAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := ClickHouseAccountArgs{
+		RegionId:    client.Region,
+		DBClusterId: parts[0],
+		AccountName: parts[1],
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.clickhouseconn().Invoke("DeleteAccount", &args, &ClickHouseResponse{}); err != nil {
+			if IsExceptedError(err, ClickHouseOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("ClickHouse cluster %s is busy - trying again while it is Running.", parts[0]))
+			}
+			if !NotFoundError(err, "clickhouse") {
+				return resource.NonRetryableError(WrapError(err, "DeleteAccount", d.Id()))
+			}
+		}
+
+		if _, err := client.DescribeClickHouseAccount(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "clickhouse") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete ClickHouse account %s timeout.", parts[1]))
+	})
+}
@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudClickHouseAccount_basic(t *testing.T) {
+	var account ClickHouseAccount
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_click_house_account.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckClickHouseAccountDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccClickHouseAccountConfig("from terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickHouseAccountExists(
+						"alicloud_click_house_account.foo", &account),
+					resource.TestCheckResourceAttr(
+						"alicloud_click_house_account.foo",
+						"account_name",
+						"tftest"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccClickHouseAccountConfig("from terraform update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickHouseAccountExists(
+						"alicloud_click_house_account.foo", &account),
+					resource.TestCheckResourceAttr(
+						"alicloud_click_house_account.foo",
+						"description",
+						"from terraform update"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckClickHouseAccountExists(n string, account *ClickHouseAccount) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ClickHouse account ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		a, err := client.DescribeClickHouseAccount(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*account = *a
+		return nil
+	}
+}
+
+func testAccCheckClickHouseAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_click_house_account" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeClickHouseAccount(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "clickhouse") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ClickHouse account %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccClickHouseAccountConfig(description string) string {
+	return fmt.Sprintf(testAccClickHouseDBClusterBase+`
+resource "alicloud_click_house_db_cluster" "foo" {
+	db_cluster_version = "20.3.10.75"
+	category = "Basic"
+	db_cluster_class = "S8"
+	db_node_group_count = 1
+	db_node_storage = 500
+	storage_type = "cloud_essd"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+
+resource "alicloud_click_house_account" "foo" {
+	db_cluster_id = "${alicloud_click_house_db_cluster.foo.id}"
+	account_name = "tftest"
+	account_password = "Test12345"
+	description = "%s"
+}
+`, description)
+}
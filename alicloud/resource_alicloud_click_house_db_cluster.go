@@ -0,0 +1,283 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudClickHouseDBCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudClickHouseDBClusterCreate,
+		Read:   resourceAlicloudClickHouseDBClusterRead,
+		Update: resourceAlicloudClickHouseDBClusterUpdate,
+		Delete: resourceAlicloudClickHouseDBClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"category": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(ClickHouseCategories),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"db_cluster_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_node_group_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateIntegerInRange(1, 48),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"db_node_storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(ClickHouseStorageTypes),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceDescription,
+				Optional:     true,
+			},
+			"pay_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"PostPaid", "PrePaid"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "PostPaid",
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_ips": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"connection_string": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudClickHouseDBClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CreateClickHouseClusterArgs{
+		RegionId:             client.Region,
+		Engine:               ClickHouseEngine,
+		EngineVersion:        d.Get("db_cluster_version").(string),
+		DBClusterCategory:    d.Get("category").(string),
+		DBClusterClass:       d.Get("db_cluster_class").(string),
+		DBNodeGroupCount:     strconv.Itoa(d.Get("db_node_group_count").(int)),
+		DBNodeStorage:        strconv.Itoa(d.Get("db_node_storage").(int)),
+		DbNodeStorageType:    d.Get("storage_type").(string),
+		DBClusterDescription: d.Get("description").(string),
+		DBClusterNetworkType: strings.ToUpper(string(VpcNet)),
+		PayType:              "Postpaid",
+	}
+
+	if d.Get("pay_type").(string) == "PrePaid" {
+		period := d.Get("period").(int)
+		if period == 0 {
+			return ConfigErrorf(ErrorCodeMissingArgument, "'period' is required when 'pay_type' is PrePaid")
+		}
+		args.PayType = "Prepaid"
+		if period > 9 {
+			args.Period = "Year"
+			args.UsedTime = strconv.Itoa(period / 12)
+		} else {
+			args.Period = "Month"
+			args.UsedTime = strconv.Itoa(period)
+		}
+	}
+
+	vswitchId := d.Get("vswitch_id").(string)
+	vpcId, zoneId, err := client.DescribeVSwitchPlacement(vswitchId, d.Get("zone_id").(string))
+	if err != nil {
+		return err
+	}
+	args.ZoneId = zoneId
+	args.VPCId = vpcId
+	args.VSwitchId = vswitchId
+
+	resp := CreateClickHouseClusterResponse{}
+	if err := client.clickhouseconn().Invoke("CreateDBInstance", &args, &resp); err != nil {
+		return fmt.Errorf("Error creating Alicloud ClickHouse cluster: %#v", err)
+	}
+
+	if resp.DBClusterId == "" {
+		return fmt.Errorf("Error get Alicloud ClickHouse cluster id")
+	}
+	d.SetId(resp.DBClusterId)
+
+	if err := client.WaitForClickHouseCluster(d.Id(), ClickHouseRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForClickHouseCluster %s got an error: %#v", ClickHouseRunning, err)
+	}
+
+	return resourceAlicloudClickHouseDBClusterUpdate(d, meta)
+}
+
+func resourceAlicloudClickHouseDBClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if d.HasChange("description") && !d.IsNewResource() {
+		args := ModifyClickHouseClusterDescriptionArgs{
+			RegionId:             client.Region,
+			DBClusterId:          d.Id(),
+			DBClusterDescription: d.Get("description").(string),
+		}
+		if err := client.clickhouseconn().Invoke("ModifyDBClusterDescription", &args, &ClickHouseResponse{}); err != nil {
+			return WrapError(err, "ModifyDBClusterDescription", d.Id())
+		}
+		d.SetPartial("description")
+	}
+
+	if d.HasChange("security_ips") {
+		ips := expandStringList(d.Get("security_ips").(*schema.Set).List())
+		if len(ips) < 1 {
+			ips = []string{LOCAL_HOST_IP}
+		}
+		args := ModifyClickHouseAccessWhiteListArgs{
+			RegionId:             client.Region,
+			DBClusterId:          d.Id(),
+			DBClusterIPArrayName: "default",
+			SecurityIps:          strings.Join(ips, COMMA_SEPARATED),
+		}
+		if err := client.clickhouseconn().Invoke("ModifyDBClusterAccessWhiteList", &args, &ClickHouseResponse{}); err != nil {
+			return WrapError(err, "ModifyDBClusterAccessWhiteList", d.Id())
+		}
+		if err := client.WaitForClickHouseCluster(d.Id(), ClickHouseRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForClickHouseCluster %s got an error: %#v", ClickHouseRunning, err)
+		}
+		d.SetPartial("security_ips")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudClickHouseDBClusterRead(d, meta)
+}
+
+func resourceAlicloudClickHouseDBClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	cluster, err := client.DescribeClickHouseClusterById(d.Id())
+	if err != nil {
+		if NotFoundError(err, "clickhouse") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe ClickHouse Cluster Attribute: %#v", err)
+	}
+
+	d.Set("db_cluster_version", cluster.EngineVersion)
+	d.Set("category", cluster.Category)
+	d.Set("db_cluster_class", cluster.DBNodeClass)
+	d.Set("db_node_group_count", cluster.DBNodeCount)
+	d.Set("db_node_storage", cluster.DBNodeStorage)
+	d.Set("storage_type", cluster.StorageType)
+	d.Set("description", cluster.DBClusterDescription)
+	d.Set("zone_id", cluster.ZoneId)
+	d.Set("vswitch_id", cluster.VSwitchId)
+	d.Set("connection_string", cluster.ConnectionString)
+	d.Set("port", cluster.Port)
+	if cluster.PayType == "Prepaid" {
+		d.Set("pay_type", "PrePaid")
+	} else {
+		d.Set("pay_type", "PostPaid")
+	}
+
+	ips, err := client.DescribeClickHouseSecurityIps(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeDBClusterAccessWhiteList", d.Id())
+	}
+	if err := d.Set("security_ips", ips); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudClickHouseDBClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	if d.Get("pay_type").(string) == "PrePaid" {
+		return ConfigErrorf(ErrorCodeUnsupportedOperation, "At present, 'PrePaid' ClickHouse cluster cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+
+	args := ClickHouseClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: d.Id(),
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.clickhouseconn().Invoke("DeleteDBCluster", &args, &ClickHouseResponse{}); err != nil {
+			if NotFoundError(err, "clickhouse") {
+				return nil
+			}
+			if IsExceptedError(err, ClickHouseOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("ClickHouse cluster %s is busy - trying again while it is deleted.", d.Id()))
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteDBCluster", d.Id()))
+		}
+
+		if _, err := client.DescribeClickHouseClusterById(d.Id()); err != nil {
+			if NotFoundError(err, "clickhouse") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("ClickHouse cluster %s in use - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,129 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudClickHouseDBCluster_basic(t *testing.T) {
+	var cluster ClickHouseCluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_click_house_db_cluster.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckClickHouseDBClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccClickHouseDBClusterConfig("tf-test-clickhouse"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickHouseDBClusterExists(
+						"alicloud_click_house_db_cluster.foo", &cluster),
+					resource.TestCheckResourceAttr(
+						"alicloud_click_house_db_cluster.foo",
+						"category",
+						"Basic"),
+					resource.TestCheckResourceAttr(
+						"alicloud_click_house_db_cluster.foo",
+						"security_ips.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccClickHouseDBClusterConfig("tf-test-clickhouse-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickHouseDBClusterExists(
+						"alicloud_click_house_db_cluster.foo", &cluster),
+					resource.TestCheckResourceAttr(
+						"alicloud_click_house_db_cluster.foo",
+						"description",
+						"tf-test-clickhouse-update"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckClickHouseDBClusterExists(n string, cluster *ClickHouseCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ClickHouse cluster ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		attr, err := client.DescribeClickHouseClusterById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*cluster = *attr
+		return nil
+	}
+}
+
+func testAccCheckClickHouseDBClusterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_click_house_db_cluster" {
+			continue
+		}
+
+		if _, err := client.DescribeClickHouseClusterById(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "clickhouse") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ClickHouse cluster %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccClickHouseDBClusterBase = `
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+`
+
+func testAccClickHouseDBClusterConfig(description string) string {
+	return fmt.Sprintf(testAccClickHouseDBClusterBase+`
+resource "alicloud_click_house_db_cluster" "foo" {
+	db_cluster_version = "20.3.10.75"
+	category = "Basic"
+	db_cluster_class = "S8"
+	db_node_group_count = 1
+	db_node_storage = 500
+	storage_type = "cloud_essd"
+	description = "%s"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	security_ips = ["10.168.1.12"]
+}
+`, description)
+}
@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"strings"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCloudFirewallAddressBook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCloudFirewallAddressBookCreate,
+		Read:   resourceAlicloudCloudFirewallAddressBookRead,
+		Update: resourceAlicloudCloudFirewallAddressBookUpdate,
+		Delete: resourceAlicloudCloudFirewallAddressBookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(CloudfwAddressBookTypes),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// The addresses are the CIDR blocks, the ports like 80/88 or the domains according to the group type.
+			"address_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCloudFirewallAddressBookCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CloudfwAddressBookArgs{
+		GroupName:   d.Get("group_name").(string),
+		GroupType:   d.Get("group_type").(string),
+		Description: d.Get("description").(string),
+		AddressList: strings.Join(expandStringList(d.Get("address_list").(*schema.Set).List()), COMMA_SEPARATED),
+	}
+	resp := AddCloudfwAddressBookResponse{}
+	if err := client.cloudfwconn().Invoke("AddAddressBook", &args, &resp); err != nil {
+		return WrapError(err, "AddAddressBook", d.Id())
+	}
+
+	d.SetId(resp.GroupUuid)
+
+	return resourceAlicloudCloudFirewallAddressBookRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallAddressBookRead(d *schema.ResourceData, meta interface{}) error {
+	book, err := meta.(*AliyunClient).DescribeCloudfwAddressBook(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cloudfw") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeAddressBook", d.Id())
+	}
+
+	d.Set("group_name", book.GroupName)
+	d.Set("group_type", book.GroupType)
+	d.Set("description", book.Description)
+	if err := d.Set("address_list", book.AddressList); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudCloudFirewallAddressBookUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("group_name") || d.HasChange("description") || d.HasChange("address_list") {
+		args := CloudfwAddressBookArgs{
+			GroupUuid:   d.Id(),
+			GroupName:   d.Get("group_name").(string),
+			Description: d.Get("description").(string),
+			AddressList: strings.Join(expandStringList(d.Get("address_list").(*schema.Set).List()), COMMA_SEPARATED),
+		}
+		if err := client.cloudfwconn().Invoke("ModifyAddressBook", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyAddressBook", d.Id())
+		}
+	}
+
+	return resourceAlicloudCloudFirewallAddressBookRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallAddressBookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CloudfwAddressBookArgs{
+		GroupUuid: d.Id(),
+	}
+	if err := client.cloudfwconn().Invoke("DeleteAddressBook", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "cloudfw") {
+			return nil
+		}
+		return WrapError(err, "DeleteAddressBook", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCloudFirewallAddressBook_basic(t *testing.T) {
+	var v CloudfwAddressBook
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cloud_firewall_address_book.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFirewallAddressBookDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCloudFirewallAddressBookConfig(`["10.0.0.0/8"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFirewallAddressBookExists(
+						"alicloud_cloud_firewall_address_book.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cloud_firewall_address_book.foo",
+						"group_type",
+						"ip"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cloud_firewall_address_book.foo",
+						"address_list.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCloudFirewallAddressBookConfig(`["10.0.0.0/8", "172.16.0.0/12"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFirewallAddressBookExists(
+						"alicloud_cloud_firewall_address_book.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cloud_firewall_address_book.foo",
+						"address_list.#",
+						"2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFirewallAddressBookExists(n string, d *CloudfwAddressBook) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cloud firewall address book ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		book, err := client.DescribeCloudfwAddressBook(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *book
+		return nil
+	}
+}
+
+func testAccCheckCloudFirewallAddressBookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cloud_firewall_address_book" {
+			continue
+		}
+
+		if _, err := client.DescribeCloudfwAddressBook(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cloudfw") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cloud firewall address book %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCloudFirewallAddressBookConfig(addresses string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cloud_firewall_address_book" "foo" {
+  group_name = "tf-testAccCloudFirewallAddressBook"
+  group_type = "ip"
+  description = "tf-testAccCloudFirewallAddressBook"
+  address_list = %s
+}
+`, addresses)
+}
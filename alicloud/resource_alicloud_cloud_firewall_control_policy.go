@@ -0,0 +1,207 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCloudFirewallControlPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCloudFirewallControlPolicyCreate,
+		Read:   resourceAlicloudCloudFirewallControlPolicyRead,
+		Update: resourceAlicloudCloudFirewallControlPolicyUpdate,
+		Delete: resourceAlicloudCloudFirewallControlPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(CloudfwDirections),
+			},
+			"acl_action": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(CloudfwAclActions),
+			},
+			"application_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ANY",
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"proto": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(CloudfwProtocols),
+			},
+			"source": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(CloudfwSourceTypes),
+			},
+			"destination": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(CloudfwDestinationTypes),
+			},
+			// The port is like 80 or 80/88, and the port group is the name of a port address book.
+			"dest_port": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dest_port_group": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dest_port_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "port",
+				ValidateFunc: validateAllowedStringValue(CloudfwDestPortTypes),
+			},
+			"acl_uuid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"order": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCloudFirewallControlPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args, err := buildCloudfwControlPolicyArgs(d)
+	if err != nil {
+		return err
+	}
+	// The new policy is put at the lowest priority.
+	args.NewOrder = "-1"
+
+	resp := AddCloudfwControlPolicyResponse{}
+	if err := client.cloudfwconn().Invoke("AddControlPolicy", &args, &resp); err != nil {
+		return WrapError(err, "AddControlPolicy", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", resp.AclUuid, COLON_SEPARATED, args.Direction))
+
+	return resourceAlicloudCloudFirewallControlPolicyRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallControlPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	policy, err := meta.(*AliyunClient).DescribeCloudfwControlPolicy(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "cloudfw") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeControlPolicy", d.Id())
+	}
+
+	d.Set("acl_uuid", policy.AclUuid)
+	d.Set("direction", parts[1])
+	d.Set("acl_action", policy.AclAction)
+	d.Set("application_name", policy.ApplicationName)
+	d.Set("description", policy.Description)
+	d.Set("proto", policy.Proto)
+	d.Set("source", policy.Source)
+	d.Set("source_type", policy.SourceType)
+	d.Set("destination", policy.Destination)
+	d.Set("destination_type", policy.DestinationType)
+	d.Set("dest_port", policy.DestPort)
+	d.Set("dest_port_group", policy.DestPortGroup)
+	d.Set("dest_port_type", policy.DestPortType)
+	d.Set("order", policy.Order)
+
+	return nil
+}
+
+func resourceAlicloudCloudFirewallControlPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	// The ModifyControlPolicy replaces all the attributes of the policy.
+	args, err := buildCloudfwControlPolicyArgs(d)
+	if err != nil {
+		return err
+	}
+	args.AclUuid = parts[0]
+	if err := client.cloudfwconn().Invoke("ModifyControlPolicy", &args, &common.Response{}); err != nil {
+		return WrapError(err, "ModifyControlPolicy", d.Id())
+	}
+
+	return resourceAlicloudCloudFirewallControlPolicyRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallControlPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := CloudfwControlPolicyArgs{
+		AclUuid:   parts[0],
+		Direction: parts[1],
+	}
+	if err := client.cloudfwconn().Invoke("DeleteControlPolicy", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "cloudfw") {
+			return nil
+		}
+		return WrapError(err, "DeleteControlPolicy", d.Id())
+	}
+	return nil
+}
+
+func buildCloudfwControlPolicyArgs(d *schema.ResourceData) (CloudfwControlPolicyArgs, error) {
+	args := CloudfwControlPolicyArgs{
+		AclAction:       d.Get("acl_action").(string),
+		ApplicationName: d.Get("application_name").(string),
+		Description:     d.Get("description").(string),
+		Direction:       d.Get("direction").(string),
+		Proto:           d.Get("proto").(string),
+		Source:          d.Get("source").(string),
+		SourceType:      d.Get("source_type").(string),
+		Destination:     d.Get("destination").(string),
+		DestinationType: d.Get("destination_type").(string),
+		DestPort:        d.Get("dest_port").(string),
+		DestPortGroup:   d.Get("dest_port_group").(string),
+		DestPortType:    d.Get("dest_port_type").(string),
+	}
+	if args.DestPortType == "group" && args.DestPortGroup == "" {
+		return args, ConfigErrorf(ErrorCodeMissingArgument, "'dest_port_group' is required when 'dest_port_type' is group.")
+	}
+	if args.DestPortType == "port" && args.Proto != "ANY" && args.Proto != "ICMP" && args.DestPort == "" {
+		return args, ConfigErrorf(ErrorCodeMissingArgument, "'dest_port' is required when 'dest_port_type' is port and 'proto' is %s.", args.Proto)
+	}
+	return args, nil
+}
@@ -0,0 +1,125 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCloudFirewallControlPolicy_basic(t *testing.T) {
+	var v CloudfwControlPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cloud_firewall_control_policy.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFirewallControlPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCloudFirewallControlPolicyConfig("accept", "80"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFirewallControlPolicyExists(
+						"alicloud_cloud_firewall_control_policy.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cloud_firewall_control_policy.foo",
+						"acl_action",
+						"accept"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cloud_firewall_control_policy.foo",
+						"dest_port",
+						"80"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCloudFirewallControlPolicyConfig("drop", "80/88"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFirewallControlPolicyExists(
+						"alicloud_cloud_firewall_control_policy.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cloud_firewall_control_policy.foo",
+						"acl_action",
+						"drop"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cloud_firewall_control_policy.foo",
+						"dest_port",
+						"80/88"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFirewallControlPolicyExists(n string, d *CloudfwControlPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cloud firewall control policy ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		policy, err := client.DescribeCloudfwControlPolicy(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *policy
+		return nil
+	}
+}
+
+func testAccCheckCloudFirewallControlPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cloud_firewall_control_policy" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCloudfwControlPolicy(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "cloudfw") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cloud firewall control policy %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCloudFirewallControlPolicyConfig(action, port string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cloud_firewall_control_policy" "foo" {
+  direction = "in"
+  acl_action = "%s"
+  description = "tf-testAccCloudFirewallControlPolicy"
+  proto = "TCP"
+  source = "10.0.0.0/8"
+  source_type = "net"
+  destination = "192.168.0.0/16"
+  destination_type = "net"
+  dest_port = "%s"
+}
+`, action, port)
+}
@@ -0,0 +1,275 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCmsEventRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCmsEventRuleCreate,
+		Read:   resourceAlicloudCmsEventRuleRead,
+		Update: resourceAlicloudCmsEventRuleUpdate,
+		Delete: resourceAlicloudCmsEventRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"event_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SYSTEM",
+				ValidateFunc: validateAllowedStringValue([]string{"SYSTEM", "CUSTOM"}),
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ENABLED",
+				ValidateFunc: validateAllowedStringValue([]string{"ENABLED", "DISABLED"}),
+			},
+			"silence_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  86400,
+			},
+			"event_pattern": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"event_type_list": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"level_list": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateAllowedStringValue(CmsEventRuleLevels)},
+						},
+						"name_list": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"contact_parameters": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_parameters_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"contact_group_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"level": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"2", "3", "4"}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudCmsEventRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := putCmsEventRule(meta.(*AliyunClient), d); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("rule_name").(string))
+
+	return resourceAlicloudCmsEventRuleUpdate(d, meta)
+}
+
+func resourceAlicloudCmsEventRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	rule, err := client.DescribeCmsEventRule(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cms") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeEventRuleList", d.Id())
+	}
+
+	d.Set("rule_name", rule.Name)
+	d.Set("group_id", rule.GroupId)
+	d.Set("description", rule.Description)
+	d.Set("event_type", rule.EventType)
+	d.Set("status", rule.State)
+	d.Set("silence_time", rule.SilenceTime)
+
+	var patterns []map[string]interface{}
+	for _, p := range rule.EventPattern.EventPattern {
+		patterns = append(patterns, map[string]interface{}{
+			"product":         p.Product,
+			"event_type_list": p.EventTypeList.EventTypeList,
+			"level_list":      p.LevelList.LevelList,
+			"name_list":       p.NameList.NameList,
+		})
+	}
+	if err := d.Set("event_pattern", patterns); err != nil {
+		return err
+	}
+
+	targets, err := client.DescribeCmsEventRuleTargets(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeEventRuleTargetList", d.Id())
+	}
+	var contacts []map[string]interface{}
+	for _, t := range targets {
+		contacts = append(contacts, map[string]interface{}{
+			"contact_parameters_id": t.Id,
+			"contact_group_name":    t.ContactGroupName,
+			"level":                 t.Level,
+		})
+	}
+	if err := d.Set("contact_parameters", contacts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudCmsEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if !d.IsNewResource() && (d.HasChange("group_id") || d.HasChange("description") || d.HasChange("status") ||
+		d.HasChange("silence_time") || d.HasChange("event_pattern")) {
+		// PutEventRule overwrites all the arguments of the existing rule.
+		if err := putCmsEventRule(client, d); err != nil {
+			return err
+		}
+		for _, key := range []string{"group_id", "description", "status", "silence_time", "event_pattern"} {
+			d.SetPartial(key)
+		}
+	}
+
+	if d.HasChange("contact_parameters") {
+		o, n := d.GetChange("contact_parameters")
+		remove := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		add := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		if len(remove) > 0 {
+			args := CmsEventRuleTargetsArgs{
+				RuleName: d.Id(),
+			}
+			for _, c := range remove {
+				args.Ids = append(args.Ids, c.(map[string]interface{})["contact_parameters_id"].(string))
+			}
+			if err := client.invokeCms("DeleteEventRuleTargets", &args, &CmsResponse{}); err != nil {
+				return WrapError(err, "DeleteEventRuleTargets", d.Id())
+			}
+		}
+
+		if len(add) > 0 {
+			args := CmsEventRuleTargetsArgs{
+				RuleName: d.Id(),
+			}
+			for _, c := range add {
+				contact := c.(map[string]interface{})
+				args.ContactParameters = append(args.ContactParameters, CmsContactParameter{
+					Id:               contact["contact_parameters_id"].(string),
+					ContactGroupName: contact["contact_group_name"].(string),
+					Level:            contact["level"].(string),
+				})
+			}
+			if err := client.invokeCms("PutEventRuleTargets", &args, &CmsResponse{}); err != nil {
+				return WrapError(err, "PutEventRuleTargets", d.Id())
+			}
+		}
+		d.SetPartial("contact_parameters")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudCmsEventRuleRead(d, meta)
+}
+
+func resourceAlicloudCmsEventRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := CmsEventRuleArgs{
+		RuleNames: []string{d.Id()},
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeCms("DeleteEventRules", &args, &CmsResponse{}); err != nil {
+			if NotFoundError(err, "cms") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteEventRules", d.Id()))
+		}
+
+		if _, err := client.DescribeCmsEventRule(d.Id()); err != nil {
+			if NotFoundError(err, "cms") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Event rule %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func putCmsEventRule(client *AliyunClient, d *schema.ResourceData) error {
+	args := CmsEventRuleArgs{
+		RuleName:    d.Get("rule_name").(string),
+		GroupId:     d.Get("group_id").(string),
+		Description: d.Get("description").(string),
+		EventType:   d.Get("event_type").(string),
+		State:       d.Get("status").(string),
+		SilenceTime: d.Get("silence_time").(int),
+	}
+	for _, p := range d.Get("event_pattern").([]interface{}) {
+		pattern := p.(map[string]interface{})
+		args.EventPattern = append(args.EventPattern, CmsEventPatternArg{
+			Product:       pattern["product"].(string),
+			EventTypeList: expandStringList(pattern["event_type_list"].([]interface{})),
+			LevelList:     expandStringList(pattern["level_list"].([]interface{})),
+			NameList:      expandStringList(pattern["name_list"].([]interface{})),
+		})
+	}
+
+	if err := client.invokeCms("PutEventRule", &args, &CmsResponse{}); err != nil {
+		return WrapError(err, "PutEventRule", d.Id())
+	}
+	return nil
+}
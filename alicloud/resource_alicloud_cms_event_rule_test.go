@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCmsEventRule_basic(t *testing.T) {
+	var v CmsEventRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cms_event_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCmsEventRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsEventRuleConfig("ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsEventRuleExists(
+						"alicloud_cms_event_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_event_rule.foo",
+						"status",
+						"ENABLED"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_event_rule.foo",
+						"event_pattern.0.product",
+						"ecs"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCmsEventRuleConfig("DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsEventRuleExists(
+						"alicloud_cms_event_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_event_rule.foo",
+						"status",
+						"DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCmsEventRuleExists(n string, d *CmsEventRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No event rule ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		rule, err := client.DescribeCmsEventRule(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *rule
+		return nil
+	}
+}
+
+func testAccCheckCmsEventRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cms_event_rule" {
+			continue
+		}
+
+		if _, err := client.DescribeCmsEventRule(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cms") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Event rule %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCmsEventRuleConfig(status string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cms_monitor_group" "foo" {
+  monitor_group_name = "tf-testAccCmsEventRule"
+}
+
+resource "alicloud_cms_event_rule" "foo" {
+  rule_name = "tf-testAccCmsEventRule"
+  group_id = "${alicloud_cms_monitor_group.foo.id}"
+  status = "%s"
+  event_pattern = [{
+    product = "ecs"
+    level_list = ["CRITICAL"]
+    event_type_list = ["StatusNotification"]
+  }]
+}
+`, status)
+}
@@ -0,0 +1,281 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCmsGroupMetricRule() *schema.Resource {
+	escalation := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"statistics": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateAllowedStringValue(CmsMetricRuleStatistics),
+				},
+				"comparison_operator": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateAllowedStringValue(CmsMetricRuleComparisonOperators),
+				},
+				"threshold": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"times": &schema.Schema{
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  3,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAlicloudCmsGroupMetricRuleCreate,
+		Read:   resourceAlicloudCmsGroupMetricRuleRead,
+		Update: resourceAlicloudCmsGroupMetricRuleUpdate,
+		Delete: resourceAlicloudCmsGroupMetricRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"category": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dimensions": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateJsonString,
+			},
+			"period": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+			},
+			"interval": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"effective_interval": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "00:00-23:59",
+			},
+			"no_effective_interval": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"silence_time": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validateIntegerInRange(3600, 86400),
+			},
+			"email_subject": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"webhook": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"escalations": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"critical": escalation,
+						"warn":     escalation,
+						"info":     escalation,
+					},
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCmsGroupMetricRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := putCmsGroupMetricRule(meta.(*AliyunClient), d); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("rule_id").(string))
+
+	return resourceAlicloudCmsGroupMetricRuleRead(d, meta)
+}
+
+func resourceAlicloudCmsGroupMetricRuleRead(d *schema.ResourceData, meta interface{}) error {
+	rule, err := meta.(*AliyunClient).DescribeCmsMetricRule(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cms") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeMetricRuleList", d.Id())
+	}
+
+	d.Set("group_id", rule.GroupId)
+	d.Set("rule_id", rule.RuleId)
+	d.Set("rule_name", rule.RuleName)
+	d.Set("namespace", rule.Namespace)
+	d.Set("metric_name", rule.MetricName)
+	d.Set("dimensions", rule.Dimensions)
+	d.Set("effective_interval", rule.EffectiveInterval)
+	d.Set("no_effective_interval", rule.NoEffectiveInterval)
+	d.Set("silence_time", rule.SilenceTime)
+	d.Set("email_subject", rule.MailSubject)
+	d.Set("webhook", rule.Webhook)
+	d.Set("status", rule.AlertState)
+	if period, err := strconv.Atoi(rule.Period); err == nil {
+		d.Set("period", period)
+	}
+
+	escalations := make(map[string]interface{})
+	for level, e := range map[string]CmsMetricRuleEscalation{
+		"critical": rule.Escalations.Critical,
+		"warn":     rule.Escalations.Warn,
+		"info":     rule.Escalations.Info,
+	} {
+		if e.ComparisonOperator == "" {
+			continue
+		}
+		escalations[level] = []map[string]interface{}{{
+			"statistics":          e.Statistics,
+			"comparison_operator": e.ComparisonOperator,
+			"threshold":           e.Threshold,
+			"times":               e.Times,
+		}}
+	}
+	if err := d.Set("escalations", []map[string]interface{}{escalations}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudCmsGroupMetricRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	// PutGroupMetricRule creates the rule or overwrites all the arguments of the existing rule.
+	if err := putCmsGroupMetricRule(meta.(*AliyunClient), d); err != nil {
+		return err
+	}
+
+	return resourceAlicloudCmsGroupMetricRuleRead(d, meta)
+}
+
+func resourceAlicloudCmsGroupMetricRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := CmsMetricRuleArgs{
+		Id: []string{d.Id()},
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeCms("DeleteMetricRules", &args, &CmsResponse{}); err != nil {
+			if NotFoundError(err, "cms") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteMetricRules", d.Id()))
+		}
+
+		if _, err := client.DescribeCmsMetricRule(d.Id()); err != nil {
+			if NotFoundError(err, "cms") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Metric rule %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func putCmsGroupMetricRule(client *AliyunClient, d *schema.ResourceData) error {
+	args := CmsGroupMetricRuleArgs{
+		GroupId:             d.Get("group_id").(string),
+		RuleId:              d.Get("rule_id").(string),
+		RuleName:            d.Get("rule_name").(string),
+		Category:            d.Get("category").(string),
+		Namespace:           d.Get("namespace").(string),
+		MetricName:          d.Get("metric_name").(string),
+		Dimensions:          d.Get("dimensions").(string),
+		Period:              strconv.Itoa(d.Get("period").(int)),
+		EffectiveInterval:   d.Get("effective_interval").(string),
+		NoEffectiveInterval: d.Get("no_effective_interval").(string),
+		SilenceTime:         d.Get("silence_time").(int),
+		EmailSubject:        d.Get("email_subject").(string),
+		Webhook:             d.Get("webhook").(string),
+	}
+	if interval := d.Get("interval").(int); interval > 0 {
+		args.Interval = strconv.Itoa(interval)
+	}
+
+	escalations := d.Get("escalations").([]interface{})[0].(map[string]interface{})
+	args.Escalations.Critical = expandCmsEscalation(escalations["critical"].([]interface{}))
+	args.Escalations.Warn = expandCmsEscalation(escalations["warn"].([]interface{}))
+	args.Escalations.Info = expandCmsEscalation(escalations["info"].([]interface{}))
+	if args.Escalations.Critical == nil && args.Escalations.Warn == nil && args.Escalations.Info == nil {
+		return ConfigErrorf(ErrorCodeMissingArgument, "At least one of 'critical', 'warn' and 'info' is required in 'escalations'.")
+	}
+
+	if err := client.invokeCms("PutGroupMetricRule", &args, &CmsResponse{}); err != nil {
+		return WrapError(err, "PutGroupMetricRule", d.Id())
+	}
+	return nil
+}
+
+func expandCmsEscalation(list []interface{}) *CmsEscalation {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	e := list[0].(map[string]interface{})
+	return &CmsEscalation{
+		Statistics:         e["statistics"].(string),
+		ComparisonOperator: e["comparison_operator"].(string),
+		Threshold:          e["threshold"].(string),
+		Times:              strconv.Itoa(e["times"].(int)),
+	}
+}
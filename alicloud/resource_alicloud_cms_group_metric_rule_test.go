@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCmsGroupMetricRule_basic(t *testing.T) {
+	var v CmsMetricRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cms_group_metric_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCmsGroupMetricRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsGroupMetricRuleConfig("90"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsGroupMetricRuleExists(
+						"alicloud_cms_group_metric_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_group_metric_rule.foo",
+						"metric_name",
+						"cpu_total"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_group_metric_rule.foo",
+						"escalations.0.critical.0.threshold",
+						"90"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCmsGroupMetricRuleConfig("80"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsGroupMetricRuleExists(
+						"alicloud_cms_group_metric_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_group_metric_rule.foo",
+						"escalations.0.critical.0.threshold",
+						"80"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCmsGroupMetricRuleExists(n string, d *CmsMetricRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No metric rule ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		rule, err := client.DescribeCmsMetricRule(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *rule
+		return nil
+	}
+}
+
+func testAccCheckCmsGroupMetricRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cms_group_metric_rule" {
+			continue
+		}
+
+		if _, err := client.DescribeCmsMetricRule(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cms") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Metric rule %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCmsGroupMetricRuleConfig(threshold string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cms_monitor_group" "foo" {
+  monitor_group_name = "tf-testAccCmsGroupMetricRule"
+}
+
+resource "alicloud_cms_group_metric_rule" "foo" {
+  group_id = "${alicloud_cms_monitor_group.foo.id}"
+  rule_id = "tf-testAccCmsGroupMetricRule"
+  rule_name = "tf-testAccCmsGroupMetricRule"
+  category = "ecs"
+  namespace = "acs_ecs_dashboard"
+  metric_name = "cpu_total"
+  escalations = {
+    critical = {
+      comparison_operator = "GreaterThanOrEqualToThreshold"
+      statistics = "Average"
+      threshold = "%s"
+      times = 3
+    }
+  }
+}
+`, threshold)
+}
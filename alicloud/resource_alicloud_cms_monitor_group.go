@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCmsMonitorGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCmsMonitorGroupCreate,
+		Read:   resourceAlicloudCmsMonitorGroupRead,
+		Update: resourceAlicloudCmsMonitorGroupUpdate,
+		Delete: resourceAlicloudCmsMonitorGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"monitor_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"contact_groups": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCmsMonitorGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CmsMonitorGroupArgs{
+		GroupName:     d.Get("monitor_group_name").(string),
+		ContactGroups: strings.Join(expandStringList(d.Get("contact_groups").(*schema.Set).List()), COMMA_SEPARATED),
+	}
+	resp := CreateCmsMonitorGroupResponse{}
+	if err := client.invokeCms("CreateMonitorGroup", &args, &resp); err != nil {
+		return WrapError(err, "CreateMonitorGroup", d.Id())
+	}
+
+	d.SetId(fmt.Sprint(resp.Id))
+
+	return resourceAlicloudCmsMonitorGroupRead(d, meta)
+}
+
+func resourceAlicloudCmsMonitorGroupRead(d *schema.ResourceData, meta interface{}) error {
+	group, err := meta.(*AliyunClient).DescribeCmsMonitorGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cms") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeMonitorGroups", d.Id())
+	}
+
+	d.Set("monitor_group_name", group.GroupName)
+
+	var contactGroups []string
+	for _, g := range group.ContactGroups.ContactGroup {
+		contactGroups = append(contactGroups, g.Name)
+	}
+	if err := d.Set("contact_groups", contactGroups); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudCmsMonitorGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("monitor_group_name") {
+		args := CmsMonitorGroupArgs{
+			GroupId:   d.Id(),
+			GroupName: d.Get("monitor_group_name").(string),
+		}
+		if err := client.invokeCms("UpdateMonitorGroup", &args, &CmsResponse{}); err != nil {
+			return WrapError(err, "UpdateMonitorGroup", d.Id())
+		}
+	}
+
+	return resourceAlicloudCmsMonitorGroupRead(d, meta)
+}
+
+func resourceAlicloudCmsMonitorGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := CmsMonitorGroupArgs{
+		GroupId: d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeCms("DeleteMonitorGroup", &args, &CmsResponse{}); err != nil {
+			if NotFoundError(err, "cms") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteMonitorGroup", d.Id()))
+		}
+
+		if _, err := client.DescribeCmsMonitorGroup(d.Id()); err != nil {
+			if NotFoundError(err, "cms") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Monitor group %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
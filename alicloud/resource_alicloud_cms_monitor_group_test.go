@@ -0,0 +1,99 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCmsMonitorGroup_basic(t *testing.T) {
+	var v CmsMonitorGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cms_monitor_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCmsMonitorGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsMonitorGroupConfig("tf-testAccCmsMonitorGroup"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsMonitorGroupExists(
+						"alicloud_cms_monitor_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_monitor_group.foo",
+						"monitor_group_name",
+						"tf-testAccCmsMonitorGroup"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCmsMonitorGroupConfig("tf-testAccCmsMonitorGroup-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsMonitorGroupExists(
+						"alicloud_cms_monitor_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_monitor_group.foo",
+						"monitor_group_name",
+						"tf-testAccCmsMonitorGroup-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCmsMonitorGroupExists(n string, d *CmsMonitorGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No monitor group ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		group, err := client.DescribeCmsMonitorGroup(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *group
+		return nil
+	}
+}
+
+func testAccCheckCmsMonitorGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cms_monitor_group" {
+			continue
+		}
+
+		if _, err := client.DescribeCmsMonitorGroup(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cms") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Monitor group %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCmsMonitorGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cms_monitor_group" "foo" {
+  monitor_group_name = "%s"
+}
+`, name)
+}
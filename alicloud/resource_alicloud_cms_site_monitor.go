@@ -0,0 +1,212 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCmsSiteMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCmsSiteMonitorCreate,
+		Read:   resourceAlicloudCmsSiteMonitorRead,
+		Update: resourceAlicloudCmsSiteMonitorUpdate,
+		Delete: resourceAlicloudCmsSiteMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"task_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(4, 100),
+			},
+			"task_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(CmsSiteMonitorTaskTypes),
+			},
+			"interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue([]int{1, 5, 15}),
+			},
+			"isp_cities": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"city": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"isp": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			// The options are not returned as they are set, so they are kept as they are in the state.
+			"options_json": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"alert_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"task_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCmsSiteMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args, err := buildCmsSiteMonitorArgs(d)
+	if err != nil {
+		return err
+	}
+	args.TaskType = d.Get("task_type").(string)
+
+	resp := CreateCmsSiteMonitorResponse{}
+	if err := client.invokeCms("CreateSiteMonitor", &args, &resp); err != nil {
+		return WrapError(err, "CreateSiteMonitor", d.Id())
+	}
+	if len(resp.CreateResultList.CreateResultList) == 0 {
+		return fmt.Errorf("CreateSiteMonitor got no site monitor task in the response: %#v", resp)
+	}
+
+	d.SetId(resp.CreateResultList.CreateResultList[0].TaskId)
+
+	return resourceAlicloudCmsSiteMonitorRead(d, meta)
+}
+
+func resourceAlicloudCmsSiteMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	monitor, err := meta.(*AliyunClient).DescribeCmsSiteMonitor(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cms") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeSiteMonitorAttribute", d.Id())
+	}
+
+	d.Set("address", monitor.Address)
+	d.Set("task_name", monitor.TaskName)
+	d.Set("task_type", monitor.TaskType)
+	d.Set("interval", monitor.Interval)
+	d.Set("task_state", monitor.TaskState)
+
+	var cities []map[string]interface{}
+	for _, c := range monitor.IspCities.IspCity {
+		cities = append(cities, map[string]interface{}{
+			"city": c.City,
+			"isp":  c.Isp,
+		})
+	}
+	if err := d.Set("isp_cities", cities); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudCmsSiteMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("address") || d.HasChange("task_name") || d.HasChange("interval") ||
+		d.HasChange("isp_cities") || d.HasChange("options_json") || d.HasChange("alert_ids") {
+		args, err := buildCmsSiteMonitorArgs(d)
+		if err != nil {
+			return err
+		}
+		args.TaskId = d.Id()
+		if err := client.invokeCms("ModifySiteMonitor", &args, &CmsResponse{}); err != nil {
+			return WrapError(err, "ModifySiteMonitor", d.Id())
+		}
+	}
+
+	return resourceAlicloudCmsSiteMonitorRead(d, meta)
+}
+
+func resourceAlicloudCmsSiteMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := CmsSiteMonitorArgs{
+		TaskIds:        d.Id(),
+		IsDeleteAlarms: "true",
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeCms("DeleteSiteMonitors", &args, &CmsResponse{}); err != nil {
+			if NotFoundError(err, "cms") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteSiteMonitors", d.Id()))
+		}
+
+		if _, err := client.DescribeCmsSiteMonitor(d.Id()); err != nil {
+			if NotFoundError(err, "cms") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Site monitor %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildCmsSiteMonitorArgs(d *schema.ResourceData) (CmsSiteMonitorArgs, error) {
+	args := CmsSiteMonitorArgs{
+		Address:     d.Get("address").(string),
+		TaskName:    d.Get("task_name").(string),
+		Interval:    d.Get("interval").(int),
+		OptionsJson: d.Get("options_json").(string),
+		AlertIds:    strings.Join(expandStringList(d.Get("alert_ids").(*schema.Set).List()), COMMA_SEPARATED),
+	}
+
+	var cities []CmsIspCityArg
+	for _, v := range d.Get("isp_cities").(*schema.Set).List() {
+		city := v.(map[string]interface{})
+		cities = append(cities, CmsIspCityArg{
+			City: city["city"].(string),
+			Isp:  city["isp"].(string),
+		})
+	}
+	if len(cities) > 0 {
+		bytes, err := json.Marshal(cities)
+		if err != nil {
+			return args, fmt.Errorf("Marshaling site monitor isp cities got an error: %#v", err)
+		}
+		args.IspCities = string(bytes)
+	}
+	return args, nil
+}
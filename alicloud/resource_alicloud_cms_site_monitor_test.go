@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCmsSiteMonitor_basic(t *testing.T) {
+	var v CmsSiteMonitor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cms_site_monitor.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCmsSiteMonitorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsSiteMonitorConfig("http://www.aliyun.com", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsSiteMonitorExists(
+						"alicloud_cms_site_monitor.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_site_monitor.foo",
+						"address",
+						"http://www.aliyun.com"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_site_monitor.foo",
+						"task_type",
+						"HTTP"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_site_monitor.foo",
+						"interval",
+						"5"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_site_monitor.foo",
+						"isp_cities.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCmsSiteMonitorConfig("http://www.alibabacloud.com", 15),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsSiteMonitorExists(
+						"alicloud_cms_site_monitor.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_site_monitor.foo",
+						"address",
+						"http://www.alibabacloud.com"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cms_site_monitor.foo",
+						"interval",
+						"15"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCmsSiteMonitorExists(n string, d *CmsSiteMonitor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No site monitor ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		monitor, err := client.DescribeCmsSiteMonitor(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *monitor
+		return nil
+	}
+}
+
+func testAccCheckCmsSiteMonitorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cms_site_monitor" {
+			continue
+		}
+
+		if _, err := client.DescribeCmsSiteMonitor(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cms") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Site monitor %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCmsSiteMonitorConfig(address string, interval int) string {
+	return fmt.Sprintf(`
+resource "alicloud_cms_site_monitor" "foo" {
+  address = "%s"
+  task_name = "tf-testAccCmsSiteMonitor"
+  task_type = "HTTP"
+  interval = %d
+  isp_cities = [{
+    city = "546"
+    isp = "465"
+  }]
+  options_json = "{\"http_method\":\"get\",\"time_out\":30000}"
+}
+`, address, interval)
+}
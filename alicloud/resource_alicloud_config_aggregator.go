@@ -0,0 +1,192 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudConfigAggregator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudConfigAggregatorCreate,
+		Read:   resourceAlicloudConfigAggregatorRead,
+		Update: resourceAlicloudConfigAggregatorUpdate,
+		Delete: resourceAlicloudConfigAggregatorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregator_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"aggregator_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "CUSTOM",
+				ValidateFunc: validateAllowedStringValue(ConfigAggregatorTypes),
+			},
+			// The accounts of the RD aggregator are all the accounts in the resource directory.
+			"aggregator_accounts": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"account_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"account_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "ResourceDirectory",
+							ValidateFunc: validateAllowedStringValue([]string{"ResourceDirectory"}),
+						},
+					},
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudConfigAggregatorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args, err := buildConfigAggregatorArgs(d)
+	if err != nil {
+		return err
+	}
+	args.AggregatorType = d.Get("aggregator_type").(string)
+	if args.AggregatorType == "CUSTOM" && args.AggregatorAccounts == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "'aggregator_accounts' is required when 'aggregator_type' is CUSTOM.")
+	}
+	args.ClientToken = buildClientToken("tf-config-aggregator-")
+
+	resp := CreateConfigAggregatorResponse{}
+	if err := client.configconn().Invoke("CreateAggregator", &args, &resp); err != nil {
+		return WrapError(err, "CreateAggregator", d.Id())
+	}
+
+	d.SetId(resp.AggregatorId)
+
+	if err := client.WaitForConfigAggregator(d.Id(), ConfigAggregatorNormal, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForConfigAggregator", d.Id())
+	}
+
+	return resourceAlicloudConfigAggregatorRead(d, meta)
+}
+
+func resourceAlicloudConfigAggregatorRead(d *schema.ResourceData, meta interface{}) error {
+	aggregator, err := meta.(*AliyunClient).DescribeConfigAggregator(d.Id())
+	if err != nil {
+		if NotFoundError(err, "config") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetAggregator", d.Id())
+	}
+
+	var accounts []map[string]interface{}
+	for _, account := range aggregator.AggregatorAccounts {
+		accounts = append(accounts, map[string]interface{}{
+			"account_id":   strconv.FormatInt(account.AccountId, 10),
+			"account_name": account.AccountName,
+			"account_type": account.AccountType,
+		})
+	}
+
+	d.Set("aggregator_name", aggregator.AggregatorName)
+	d.Set("description", aggregator.Description)
+	d.Set("aggregator_type", aggregator.AggregatorType)
+	d.Set("status", strconv.Itoa(aggregator.AggregatorStatus))
+	if err := d.Set("aggregator_accounts", accounts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudConfigAggregatorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("aggregator_name") || d.HasChange("description") || d.HasChange("aggregator_accounts") {
+		args, err := buildConfigAggregatorArgs(d)
+		if err != nil {
+			return err
+		}
+		args.AggregatorId = d.Id()
+		args.ClientToken = buildClientToken("tf-config-aggregator-")
+		if err := client.configconn().Invoke("UpdateAggregator", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateAggregator", d.Id())
+		}
+	}
+
+	return resourceAlicloudConfigAggregatorRead(d, meta)
+}
+
+func resourceAlicloudConfigAggregatorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := DeleteConfigAggregatorsArgs{
+		AggregatorIds: d.Id(),
+	}
+	if err := client.configconn().Invoke("DeleteAggregators", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "config") {
+			return nil
+		}
+		return WrapError(err, "DeleteAggregators", d.Id())
+	}
+	return nil
+}
+
+func buildConfigAggregatorArgs(d *schema.ResourceData) (ConfigAggregatorArgs, error) {
+	args := ConfigAggregatorArgs{
+		AggregatorName: d.Get("aggregator_name").(string),
+		Description:    d.Get("description").(string),
+	}
+
+	var accounts []ConfigAggregatorAccount
+	for _, v := range d.Get("aggregator_accounts").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		accountId, err := strconv.ParseInt(m["account_id"].(string), 10, 64)
+		if err != nil {
+			return args, ConfigErrorf(ErrorCodeInvalidArgument, "Invalid account_id %s of the aggregator accounts.", m["account_id"].(string))
+		}
+		accounts = append(accounts, ConfigAggregatorAccount{
+			AccountId:   accountId,
+			AccountName: m["account_name"].(string),
+			AccountType: m["account_type"].(string),
+		})
+	}
+	if len(accounts) > 0 {
+		bytes, err := json.Marshal(accounts)
+		if err != nil {
+			return args, err
+		}
+		args.AggregatorAccounts = string(bytes)
+	}
+	return args, nil
+}
@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudConfigAggregator_basic(t *testing.T) {
+	var v ConfigAggregator
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithResourceDirectoryAccount(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_config_aggregator.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigAggregatorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccConfigAggregatorConfig("tf-testAccConfigAggregator"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigAggregatorExists(
+						"alicloud_config_aggregator.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_aggregator.foo",
+						"aggregator_name",
+						"tf-testAccConfigAggregator"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_aggregator.foo",
+						"aggregator_accounts.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_aggregator.foo",
+						"status",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccConfigAggregatorConfig("tf-testAccConfigAggregatorUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigAggregatorExists(
+						"alicloud_config_aggregator.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_aggregator.foo",
+						"aggregator_name",
+						"tf-testAccConfigAggregatorUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigAggregatorExists(n string, d *ConfigAggregator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No config aggregator ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		aggregator, err := client.DescribeConfigAggregator(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *aggregator
+		return nil
+	}
+}
+
+func testAccCheckConfigAggregatorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_config_aggregator" {
+			continue
+		}
+
+		if _, err := client.DescribeConfigAggregator(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "config") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Config aggregator %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConfigAggregatorConfig(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_config_aggregator" "foo" {
+  aggregator_name = "%s"
+  description = "tf-testAccConfigAggregator"
+  aggregator_type = "CUSTOM"
+  aggregator_accounts {
+    account_id = "%s"
+    account_name = "%s"
+  }
+}
+`, name, os.Getenv("ALICLOUD_RD_ACCOUNT_ID"), os.Getenv("ALICLOUD_RD_ACCOUNT_NAME"))
+}
@@ -0,0 +1,160 @@
+package alicloud
+
+import (
+	"strconv"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudConfigDeliveryChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudConfigDeliveryChannelCreate,
+		Read:   resourceAlicloudConfigDeliveryChannelRead,
+		Update: resourceAlicloudConfigDeliveryChannelUpdate,
+		Delete: resourceAlicloudConfigDeliveryChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delivery_channel_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"delivery_channel_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(ConfigDeliveryChannelTypes),
+			},
+			"delivery_channel_target_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// The condition filters the resource changes delivered to the MNS topic, which is a JSON array.
+			"delivery_channel_condition": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"configuration_item_change_notification": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"configuration_snapshot": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"non_compliant_notification": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue([]int{0, 1}),
+			},
+		},
+	}
+}
+
+func resourceAlicloudConfigDeliveryChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildConfigDeliveryChannelArgs(d)
+	args.DeliveryChannelType = d.Get("delivery_channel_type").(string)
+	args.ClientToken = buildClientToken("tf-config-channel-")
+
+	resp := CreateConfigDeliveryChannelResponse{}
+	if err := client.configconn().Invoke("CreateConfigDeliveryChannel", &args, &resp); err != nil {
+		return WrapError(err, "CreateConfigDeliveryChannel", d.Id())
+	}
+
+	d.SetId(resp.DeliveryChannelId)
+
+	return resourceAlicloudConfigDeliveryChannelUpdate(d, meta)
+}
+
+func resourceAlicloudConfigDeliveryChannelRead(d *schema.ResourceData, meta interface{}) error {
+	channel, err := meta.(*AliyunClient).DescribeConfigDeliveryChannel(d.Id())
+	if err != nil {
+		if NotFoundError(err, "config") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetConfigDeliveryChannel", d.Id())
+	}
+
+	d.Set("delivery_channel_name", channel.DeliveryChannelName)
+	d.Set("delivery_channel_type", channel.DeliveryChannelType)
+	d.Set("delivery_channel_target_arn", channel.DeliveryChannelTargetArn)
+	d.Set("delivery_channel_condition", channel.DeliveryChannelCondition)
+	d.Set("description", channel.Description)
+	d.Set("configuration_item_change_notification", channel.ConfigurationItemChangeNotification)
+	d.Set("configuration_snapshot", channel.ConfigurationSnapshot)
+	d.Set("non_compliant_notification", channel.NonCompliantNotification)
+	d.Set("status", channel.Status)
+
+	return nil
+}
+
+func resourceAlicloudConfigDeliveryChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// A new channel is enabled, and it is disabled by the UpdateConfigDeliveryChannel when the status is 0.
+	update := d.IsNewResource() && d.Get("status").(int) == 0
+	for _, attribute := range []string{"delivery_channel_name", "delivery_channel_target_arn", "delivery_channel_condition",
+		"description", "configuration_item_change_notification", "configuration_snapshot", "non_compliant_notification", "status"} {
+		if !d.IsNewResource() && d.HasChange(attribute) {
+			update = true
+		}
+	}
+	if update {
+		args := buildConfigDeliveryChannelArgs(d)
+		args.DeliveryChannelId = d.Id()
+		args.Status = strconv.Itoa(d.Get("status").(int))
+		args.ClientToken = buildClientToken("tf-config-channel-")
+		if err := client.configconn().Invoke("UpdateConfigDeliveryChannel", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateConfigDeliveryChannel", d.Id())
+		}
+	}
+
+	return resourceAlicloudConfigDeliveryChannelRead(d, meta)
+}
+
+func resourceAlicloudConfigDeliveryChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := ConfigDeliveryChannelArgs{
+		DeliveryChannelId: d.Id(),
+	}
+	if err := client.configconn().Invoke("DeleteConfigDeliveryChannel", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "config") {
+			return nil
+		}
+		return WrapError(err, "DeleteConfigDeliveryChannel", d.Id())
+	}
+	return nil
+}
+
+func buildConfigDeliveryChannelArgs(d *schema.ResourceData) ConfigDeliveryChannelArgs {
+	return ConfigDeliveryChannelArgs{
+		DeliveryChannelName:                 d.Get("delivery_channel_name").(string),
+		DeliveryChannelTargetArn:            d.Get("delivery_channel_target_arn").(string),
+		DeliveryChannelCondition:            d.Get("delivery_channel_condition").(string),
+		Description:                         d.Get("description").(string),
+		ConfigurationItemChangeNotification: d.Get("configuration_item_change_notification").(bool),
+		ConfigurationSnapshot:               d.Get("configuration_snapshot").(bool),
+		NonCompliantNotification:            d.Get("non_compliant_notification").(bool),
+	}
+}
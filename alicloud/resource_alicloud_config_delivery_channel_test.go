@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudConfigDeliveryChannel_basic(t *testing.T) {
+	var v ConfigDeliveryChannel
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithAccountId(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_config_delivery_channel.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigDeliveryChannelDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccConfigDeliveryChannelConfig("tf-testAccConfigDeliveryChannel", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigDeliveryChannelExists(
+						"alicloud_config_delivery_channel.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_delivery_channel.foo",
+						"delivery_channel_type",
+						"OSS"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_delivery_channel.foo",
+						"description",
+						"tf-testAccConfigDeliveryChannel"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_delivery_channel.foo",
+						"status",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccConfigDeliveryChannelConfig("tf-testAccConfigDeliveryChannelUpdate", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigDeliveryChannelExists(
+						"alicloud_config_delivery_channel.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_delivery_channel.foo",
+						"description",
+						"tf-testAccConfigDeliveryChannelUpdate"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_delivery_channel.foo",
+						"status",
+						"0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigDeliveryChannelExists(n string, d *ConfigDeliveryChannel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No config delivery channel ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		channel, err := client.DescribeConfigDeliveryChannel(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *channel
+		return nil
+	}
+}
+
+func testAccCheckConfigDeliveryChannelDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_config_delivery_channel" {
+			continue
+		}
+
+		if _, err := client.DescribeConfigDeliveryChannel(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "config") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Config delivery channel %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConfigDeliveryChannelConfig(description string, status int) string {
+	return fmt.Sprintf(`
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "tf-testacc-config-channel-%s"
+}
+
+resource "alicloud_config_delivery_channel" "foo" {
+  delivery_channel_name = "tf-testAccConfigDeliveryChannel"
+  delivery_channel_type = "OSS"
+  delivery_channel_target_arn = "acs:oss:cn-shanghai:%s:${alicloud_oss_bucket.foo.bucket}"
+  description = "%s"
+  configuration_snapshot = true
+  status = %d
+}
+`, os.Getenv("ALICLOUD_ACCOUNT_ID"), os.Getenv("ALICLOUD_ACCOUNT_ID"), description, status)
+}
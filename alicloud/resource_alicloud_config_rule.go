@@ -0,0 +1,191 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudConfigRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudConfigRuleCreate,
+		Read:   resourceAlicloudConfigRuleRead,
+		Update: resourceAlicloudConfigRuleUpdate,
+		Delete: resourceAlicloudConfigRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_owner": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(ConfigRuleSourceOwners),
+			},
+			"source_identifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"config_rule_trigger_types": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedSplitStringValue(ConfigRuleTriggerTypes, COMMA_SEPARATED),
+			},
+			"maximum_execution_frequency": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(ConfigRuleMaximumExecutionFrequencies),
+			},
+			"resource_types_scope": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"risk_level": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3}),
+			},
+			"input_parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudConfigRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args, err := buildConfigRuleArgs(d)
+	if err != nil {
+		return err
+	}
+	args.SourceOwner = d.Get("source_owner").(string)
+	args.SourceIdentifier = d.Get("source_identifier").(string)
+	args.ClientToken = buildClientToken("tf-config-rule-")
+
+	resp := CreateConfigRuleResponse{}
+	if err := client.configconn().Invoke("CreateConfigRule", &args, &resp); err != nil {
+		return WrapError(err, "CreateConfigRule", d.Id())
+	}
+
+	d.SetId(resp.ConfigRuleId)
+
+	return resourceAlicloudConfigRuleRead(d, meta)
+}
+
+func resourceAlicloudConfigRuleRead(d *schema.ResourceData, meta interface{}) error {
+	rule, err := meta.(*AliyunClient).DescribeConfigRule(d.Id())
+	if err != nil {
+		if NotFoundError(err, "config") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetConfigRule", d.Id())
+	}
+
+	inputParameters := make(map[string]interface{})
+	for k, v := range rule.InputParameters {
+		inputParameters[k] = fmt.Sprint(v)
+	}
+
+	d.Set("rule_name", rule.ConfigRuleName)
+	d.Set("description", rule.Description)
+	d.Set("source_owner", rule.Source.Owner)
+	d.Set("source_identifier", rule.Source.Identifier)
+	d.Set("config_rule_trigger_types", rule.ConfigRuleTriggerTypes)
+	d.Set("maximum_execution_frequency", rule.MaximumExecutionFrequency)
+	d.Set("risk_level", rule.RiskLevel)
+	d.Set("arn", rule.ConfigRuleArn)
+	d.Set("status", rule.ConfigRuleState)
+	if err := d.Set("resource_types_scope", rule.Scope.ComplianceResourceTypes); err != nil {
+		return err
+	}
+	if err := d.Set("input_parameters", inputParameters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudConfigRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("description") || d.HasChange("config_rule_trigger_types") || d.HasChange("maximum_execution_frequency") ||
+		d.HasChange("resource_types_scope") || d.HasChange("risk_level") || d.HasChange("input_parameters") {
+		args, err := buildConfigRuleArgs(d)
+		if err != nil {
+			return err
+		}
+		args.ConfigRuleId = d.Id()
+		args.ClientToken = buildClientToken("tf-config-rule-")
+		if err := client.configconn().Invoke("UpdateConfigRule", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateConfigRule", d.Id())
+		}
+	}
+
+	return resourceAlicloudConfigRuleRead(d, meta)
+}
+
+func resourceAlicloudConfigRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := DeleteConfigRulesArgs{
+		ConfigRuleIds: d.Id(),
+	}
+	if err := client.configconn().Invoke("DeleteConfigRules", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "config") {
+			return nil
+		}
+		return WrapError(err, "DeleteConfigRules", d.Id())
+	}
+	return nil
+}
+
+// buildConfigRuleArgs returns the arguments of the rule which can be updated.
+func buildConfigRuleArgs(d *schema.ResourceData) (ConfigRuleArgs, error) {
+	args := ConfigRuleArgs{
+		ConfigRuleName:            d.Get("rule_name").(string),
+		Description:               d.Get("description").(string),
+		ConfigRuleTriggerTypes:    d.Get("config_rule_trigger_types").(string),
+		MaximumExecutionFrequency: d.Get("maximum_execution_frequency").(string),
+		ResourceTypesScope:        strings.Join(expandStringList(d.Get("resource_types_scope").(*schema.Set).List()), COMMA_SEPARATED),
+		RiskLevel:                 d.Get("risk_level").(int),
+	}
+	if strings.Contains(args.ConfigRuleTriggerTypes, "ScheduledNotification") && args.MaximumExecutionFrequency == "" {
+		return args, ConfigErrorf(ErrorCodeMissingArgument, "'maximum_execution_frequency' is required when 'config_rule_trigger_types' contains ScheduledNotification.")
+	}
+	if v, ok := d.GetOk("input_parameters"); ok {
+		parameters, err := json.Marshal(v.(map[string]interface{}))
+		if err != nil {
+			return args, err
+		}
+		args.InputParameters = string(parameters)
+	}
+	return args, nil
+}
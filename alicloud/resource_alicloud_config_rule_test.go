@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudConfigRule_basic(t *testing.T) {
+	var v ConfigRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_config_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccConfigRuleConfig(1, "ecs.c5.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRuleExists(
+						"alicloud_config_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_rule.foo",
+						"rule_name",
+						"tf-testAccConfigRule"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_rule.foo",
+						"risk_level",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_rule.foo",
+						"input_parameters.instanceTypes",
+						"ecs.c5.large"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_rule.foo",
+						"status",
+						"ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccConfigRuleConfig(2, "ecs.c5.xlarge"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRuleExists(
+						"alicloud_config_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_rule.foo",
+						"risk_level",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_config_rule.foo",
+						"input_parameters.instanceTypes",
+						"ecs.c5.xlarge"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigRuleExists(n string, d *ConfigRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No config rule ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		rule, err := client.DescribeConfigRule(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *rule
+		return nil
+	}
+}
+
+func testAccCheckConfigRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_config_rule" {
+			continue
+		}
+
+		if _, err := client.DescribeConfigRule(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "config") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Config rule %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConfigRuleConfig(riskLevel int, instanceType string) string {
+	return fmt.Sprintf(`
+resource "alicloud_config_rule" "foo" {
+  rule_name = "tf-testAccConfigRule"
+  description = "tf-testAccConfigRule"
+  source_owner = "ALIYUN"
+  source_identifier = "ecs-instances-in-vpc"
+  config_rule_trigger_types = "ConfigurationItemChangeNotification"
+  resource_types_scope = ["ACS::ECS::Instance"]
+  risk_level = %d
+  input_parameters = {
+    instanceTypes = "%s"
+  }
+}
+`, riskLevel, instanceType)
+}
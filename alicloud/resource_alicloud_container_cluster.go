@@ -19,6 +19,12 @@ func resourceAlicloudContainerCluster() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(500 * time.Second),
+			Update: schema.DefaultTimeout(500 * time.Second),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
@@ -80,13 +86,22 @@ func resourceAlicloudContainerCluster() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"network_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceAlicloudContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.csconn
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	conn := client.csconn()
 
 	// Ensure instance_type is generation three
 	_, err := meta.(*AliyunClient).CheckParameterValidity(d, meta)
@@ -116,13 +131,13 @@ func resourceAlicloudContainerClusterCreate(d *schema.ResourceData, meta interfa
 	if v, ok := d.GetOk("vswitch_id"); ok && v.(string) != "" {
 		cidr, cidr_ok := d.GetOk("cidr_block")
 		if !cidr_ok || cidr.(string) == "" {
-			return fmt.Errorf("When launching container in the VPC, the 'cidr_block' must be specified.")
+			return ConfigErrorf(ErrorCodeMissingArgument, "When launching container in the VPC, the 'cidr_block' must be specified.")
 		}
 		args.NetworkMode = cs.VPCNetwork
 		args.VSwitchID = v.(string)
 		args.SubnetCIDR = cidr.(string)
 
-		vswInfo, _, err := client.vpcconn.DescribeVSwitches(&ecs.DescribeVSwitchesArgs{
+		vswInfo, _, err := client.vpcconn().DescribeVSwitches(&ecs.DescribeVSwitchesArgs{
 			RegionId:  getRegion(d, meta),
 			VSwitchId: v.(string),
 		})
@@ -138,7 +153,7 @@ func resourceAlicloudContainerClusterCreate(d *schema.ResourceData, meta interfa
 	}
 
 	if imageId, ok := d.GetOk("image_id"); ok {
-		connection := client.ecsconn
+		connection := client.ecsconn()
 		argsImage := &ecs.DescribeImagesArgs{
 			RegionId: getRegion(d, meta),
 			ImageId:  imageId.(string),
@@ -157,7 +172,7 @@ func resourceAlicloudContainerClusterCreate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Creating container Cluster got an error: %#v", err)
 	}
 
-	err = conn.WaitForClusterAsyn(cluster.ClusterID, cs.Running, 500)
+	err = conn.WaitForClusterAsyn(cluster.ClusterID, cs.Running, deadline.Seconds())
 
 	if err != nil {
 		return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
@@ -165,18 +180,24 @@ func resourceAlicloudContainerClusterCreate(d *schema.ResourceData, meta interfa
 
 	d.SetId(cluster.ClusterID)
 
-	return resourceAlicloudContainerClusterUpdate(d, meta)
+	return updateContainerCluster(d, meta, deadline)
 }
 
 func resourceAlicloudContainerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).csconn
+	return updateContainerCluster(d, meta, newOperationDeadline(d, schema.TimeoutUpdate))
+}
+
+// updateContainerCluster updates the cluster before the deadline, which is the one of the Create when the cluster
+// is just created.
+func updateContainerCluster(d *schema.ResourceData, meta interface{}, deadline operationDeadline) error {
+	conn := meta.(*AliyunClient).csconn()
 	d.Partial(true)
 	if d.HasChange("size") && !d.IsNewResource() {
 		o, n := d.GetChange("size")
 		oi := o.(int)
 		ni := n.(int)
 		if ni <= oi {
-			return fmt.Errorf("The new size of clusters must greater than the current. The cluster's current size is %d.", oi)
+			return ConfigErrorf(ErrorCodeUnsupportedOperation, "The new size of clusters must greater than the current. The cluster's current size is %d.", oi)
 		}
 		d.SetPartial("size")
 		err := conn.ResizeCluster(d.Id(), &cs.ClusterResizeArgs{
@@ -192,7 +213,7 @@ func resourceAlicloudContainerClusterUpdate(d *schema.ResourceData, meta interfa
 			return fmt.Errorf("Resize Cluster got an error: %#v", err)
 		}
 
-		err = conn.WaitForClusterAsyn(d.Id(), cs.Running, 500)
+		err = conn.WaitForClusterAsyn(d.Id(), cs.Running, deadline.Seconds())
 
 		if err != nil {
 			return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
@@ -204,7 +225,7 @@ func resourceAlicloudContainerClusterUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAlicloudContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).csconn
+	conn := meta.(*AliyunClient).csconn()
 
 	cluster, err := conn.DescribeCluster(d.Id())
 
@@ -226,12 +247,13 @@ func resourceAlicloudContainerClusterRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAlicloudContainerClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).csconn
+	conn := meta.(*AliyunClient).csconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DeleteCluster(d.Id())
 		if err != nil {
-			if IsExceptedError(err, ErrorClusterNotFound) {
+			if NotFoundError(err, "cs") {
 				return nil
 			}
 			return resource.RetryableError(fmt.Errorf("Cluster in use 1- trying again while it is deleted."))
@@ -239,7 +261,7 @@ func resourceAlicloudContainerClusterDelete(d *schema.ResourceData, meta interfa
 
 		resp, err := conn.DescribeCluster(d.Id())
 		if err != nil {
-			if IsExceptedError(err, ErrorClusterNotFound) {
+			if NotFoundError(err, "cs") {
 				return nil
 			}
 			return resource.NonRetryableError(fmt.Errorf("Deleting container cluster got an error: %#v", err))
@@ -69,7 +69,7 @@ func testAccCheckContainerClusterExists(n string, d *cs.ClusterType) resource.Te
 			return fmt.Errorf("No Container cluster ID is set")
 		}
 
-		client := testAccProvider.Meta().(*AliyunClient).csconn
+		client := testAccProvider.Meta().(*AliyunClient).csconn()
 		attr, err := client.DescribeCluster(cluster.Primary.ID)
 		log.Printf("[DEBUG] check cluster %s attribute %#v", cluster.Primary.ID, attr)
 
@@ -87,7 +87,7 @@ func testAccCheckContainerClusterExists(n string, d *cs.ClusterType) resource.Te
 }
 
 func testAccCheckContainerClusterDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*AliyunClient).csconn
+	client := testAccProvider.Meta().(*AliyunClient).csconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alicloud_container_cluster" {
@@ -0,0 +1,119 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCRNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCRNamespaceCreate,
+		Read:   resourceAlicloudCRNamespaceRead,
+		Update: resourceAlicloudCRNamespaceUpdate,
+		Delete: resourceAlicloudCRNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCRName,
+			},
+			"auto_create": &schema.Schema{
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"default_visibility": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(CrVisibilityPublic), string(CrVisibilityPrivate)}),
+			},
+		},
+	}
+}
+
+func resourceAlicloudCRNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	name := d.Get("name").(string)
+
+	args := CrNamespaceArgs{}
+	args.Namespace.Namespace = name
+	if err := client.crconn().Invoke(client.Region, http.MethodPut, "/namespace", nil, args, nil); err != nil {
+		return fmt.Errorf("Creating container registry namespace %s got an error: %#v", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAlicloudCRNamespaceUpdate(d, meta)
+}
+
+func resourceAlicloudCRNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	namespace, err := meta.(*AliyunClient).DescribeCrNamespace(d.Id())
+	if err != nil {
+		if NotFoundError(err, "cr") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Describing container registry namespace %s got an error: %#v", d.Id(), err)
+	}
+
+	d.Set("name", namespace.Namespace)
+	d.Set("auto_create", namespace.AutoCreate)
+	d.Set("default_visibility", namespace.DefaultVisibility)
+
+	return nil
+}
+
+func resourceAlicloudCRNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// The namespace settings can only be set by updating, so they are always sent for a new namespace.
+	if d.IsNewResource() || d.HasChange("auto_create") || d.HasChange("default_visibility") {
+		autoCreate := d.Get("auto_create").(bool)
+		args := CrNamespaceArgs{}
+		args.Namespace.AutoCreate = &autoCreate
+		args.Namespace.DefaultVisibility = d.Get("default_visibility").(string)
+
+		path := fmt.Sprintf("/namespace/%s", d.Id())
+		if err := client.crconn().Invoke(client.Region, http.MethodPost, path, nil, args, nil); err != nil {
+			return fmt.Errorf("Updating container registry namespace %s got an error: %#v", d.Id(), err)
+		}
+	}
+
+	return resourceAlicloudCRNamespaceRead(d, meta)
+}
+
+func resourceAlicloudCRNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	path := fmt.Sprintf("/namespace/%s", d.Id())
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.crconn().Invoke(client.Region, http.MethodDelete, path, nil, nil, nil); err != nil {
+			if NotFoundError(err, "cr") {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting container registry namespace %s got an error: %#v", d.Id(), err))
+		}
+
+		if _, err := client.DescribeCrNamespace(d.Id()); err != nil {
+			if NotFoundError(err, "cr") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Container registry namespace %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,113 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCRNamespace_basic(t *testing.T) {
+	var namespace CrNamespace
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cr_namespace.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCRNamespaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCRNamespaceConfig(false, "PRIVATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRNamespaceExists(
+						"alicloud_cr_namespace.foo", &namespace),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_namespace.foo",
+						"name",
+						"tf-testacc-namespace"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_namespace.foo",
+						"auto_create",
+						"false"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_namespace.foo",
+						"default_visibility",
+						"PRIVATE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCRNamespaceConfig(true, "PUBLIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRNamespaceExists(
+						"alicloud_cr_namespace.foo", &namespace),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_namespace.foo",
+						"auto_create",
+						"true"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_namespace.foo",
+						"default_visibility",
+						"PUBLIC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCRNamespaceExists(n string, d *CrNamespace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No container registry namespace ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		namespace, err := client.DescribeCrNamespace(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *namespace
+		return nil
+	}
+}
+
+func testAccCheckCRNamespaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cr_namespace" {
+			continue
+		}
+
+		if _, err := client.DescribeCrNamespace(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "cr") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Container registry namespace %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCRNamespaceConfig(autoCreate bool, visibility string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cr_namespace" "foo" {
+  name = "tf-testacc-namespace"
+  auto_create = %t
+  default_visibility = "%s"
+}
+`, autoCreate, visibility)
+}
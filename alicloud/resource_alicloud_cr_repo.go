@@ -0,0 +1,191 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCRRepo() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCRRepoCreate,
+		Read:   resourceAlicloudCRRepoRead,
+		Update: resourceAlicloudCRRepoUpdate,
+		Delete: resourceAlicloudCRRepoDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"namespace": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCRName,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCRName,
+			},
+			"summary": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCRRepoSummary,
+			},
+			"repo_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(CrVisibilityPublic), string(CrVisibilityPrivate)}),
+			},
+			"detail": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCRRepoDetail,
+			},
+			"domain_list": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"internal": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudCRRepoCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	namespace := d.Get("namespace").(string)
+	name := d.Get("name").(string)
+
+	args := CrRepoArgs{}
+	args.Repo.RepoNamespace = namespace
+	args.Repo.RepoName = name
+	args.Repo.Summary = d.Get("summary").(string)
+	args.Repo.Detail = d.Get("detail").(string)
+	args.Repo.RepoType = d.Get("repo_type").(string)
+
+	if err := client.crconn().Invoke(client.Region, http.MethodPut, "/repos", nil, args, nil); err != nil {
+		return fmt.Errorf("Creating container registry repo %s/%s got an error: %#v", namespace, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", namespace, SLASH_SEPARATED, name))
+
+	return resourceAlicloudCRRepoRead(d, meta)
+}
+
+func resourceAlicloudCRRepoRead(d *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := parseCRRepoId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	repo, err := meta.(*AliyunClient).DescribeCrRepo(namespace, name)
+	if err != nil {
+		if NotFoundError(err, "cr") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Describing container registry repo %s got an error: %#v", d.Id(), err)
+	}
+
+	d.Set("namespace", repo.RepoNamespace)
+	d.Set("name", repo.RepoName)
+	d.Set("summary", repo.Summary)
+	d.Set("detail", repo.Detail)
+	d.Set("repo_type", repo.RepoType)
+	if err := d.Set("domain_list", []map[string]interface{}{
+		{
+			"public":   repo.RepoDomainList.Public,
+			"internal": repo.RepoDomainList.Internal,
+			"vpc":      repo.RepoDomainList.Vpc,
+		},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudCRRepoUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("summary") || d.HasChange("detail") || d.HasChange("repo_type") {
+		namespace, name, err := parseCRRepoId(d.Id())
+		if err != nil {
+			return err
+		}
+
+		args := CrRepoArgs{}
+		args.Repo.Summary = d.Get("summary").(string)
+		args.Repo.Detail = d.Get("detail").(string)
+		args.Repo.RepoType = d.Get("repo_type").(string)
+
+		path := fmt.Sprintf("/repos/%s/%s", namespace, name)
+		if err := client.crconn().Invoke(client.Region, http.MethodPost, path, nil, args, nil); err != nil {
+			return fmt.Errorf("Updating container registry repo %s got an error: %#v", d.Id(), err)
+		}
+	}
+
+	return resourceAlicloudCRRepoRead(d, meta)
+}
+
+func resourceAlicloudCRRepoDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	namespace, name, err := parseCRRepoId(d.Id())
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/repos/%s/%s", namespace, name)
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.crconn().Invoke(client.Region, http.MethodDelete, path, nil, nil, nil); err != nil {
+			if NotFoundError(err, "cr") {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting container registry repo %s got an error: %#v", d.Id(), err))
+		}
+
+		if _, err := client.DescribeCrRepo(namespace, name); err != nil {
+			if NotFoundError(err, "cr") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Container registry repo %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+// parseCRRepoId splits the ID '<namespace>/<repo name>', which is the same as the image name without the registry domain.
+func parseCRRepoId(id string) (namespace, name string, err error) {
+	parts := strings.Split(id, SLASH_SEPARATED)
+	if len(parts) != 2 {
+		return "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid container registry repo ID %s, expected '<namespace>/<repo name>'.", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,130 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCRRepo_basic(t *testing.T) {
+	var repo CrRepo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cr_repo.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCRRepoDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCRRepoConfig("test summary", "PRIVATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRRepoExists(
+						"alicloud_cr_repo.foo", &repo),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_repo.foo",
+						"name",
+						"tf-testacc-repo"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_repo.foo",
+						"repo_type",
+						"PRIVATE"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_cr_repo.foo",
+						"domain_list.0.public"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCRRepoConfig("test summary updated", "PUBLIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRRepoExists(
+						"alicloud_cr_repo.foo", &repo),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_repo.foo",
+						"summary",
+						"test summary updated"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cr_repo.foo",
+						"repo_type",
+						"PUBLIC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCRRepoExists(n string, d *CrRepo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No container registry repo ID is set")
+		}
+
+		namespace, name, err := parseCRRepoId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		repo, err := client.DescribeCrRepo(namespace, name)
+		if err != nil {
+			return err
+		}
+
+		*d = *repo
+		return nil
+	}
+}
+
+func testAccCheckCRRepoDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cr_repo" {
+			continue
+		}
+
+		namespace, name, err := parseCRRepoId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCrRepo(namespace, name); err != nil {
+			if NotFoundError(err, "cr") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Container registry repo %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCRRepoConfig(summary, repoType string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cr_namespace" "foo" {
+  name = "tf-testacc-repo-ns"
+  auto_create = false
+  default_visibility = "PRIVATE"
+}
+
+resource "alicloud_cr_repo" "foo" {
+  namespace = "${alicloud_cr_namespace.foo.name}"
+  name = "tf-testacc-repo"
+  summary = "%s"
+  repo_type = "%s"
+  detail = "test detail"
+}
+`, summary, repoType)
+}
@@ -0,0 +1,208 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCSKubernetesAddon() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCSKubernetesAddonCreate,
+		Read:   resourceAlicloudCSKubernetesAddonRead,
+		Update: resourceAlicloudCSKubernetesAddonUpdate,
+		Delete: resourceAlicloudCSKubernetesAddonDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"config": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"next_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"can_upgrade": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"required": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCSKubernetesAddonCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	if _, err := client.DescribeCsKubernetesAddon(clusterId, name); err == nil {
+		return fmt.Errorf("Addon %s has been installed in the cluster %s, please import it instead.", name, clusterId)
+	} else if !NotFoundError(err, "cs") {
+		return fmt.Errorf("Describing addon %s of cluster %s got an error: %#v", name, clusterId, err)
+	}
+
+	args := []CsKubernetesAddonArgs{
+		{
+			Name:    name,
+			Version: d.Get("version").(string),
+			Config:  d.Get("config").(string),
+		},
+	}
+	path := fmt.Sprintf("/clusters/%s/components/install", clusterId)
+	if err := client.csconn().Invoke(client.Region, http.MethodPost, path, nil, args, nil); err != nil {
+		return fmt.Errorf("Installing addon %s into cluster %s got an error: %#v", name, clusterId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, name))
+
+	if err := client.WaitForCsKubernetesAddon(clusterId, name, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForCsKubernetesAddon", d.Id())
+	}
+
+	return resourceAlicloudCSKubernetesAddonRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesAddonRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	addon, err := meta.(*AliyunClient).DescribeCsKubernetesAddon(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "cs") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Describing addon %s got an error: %#v", d.Id(), err)
+	}
+
+	d.Set("cluster_id", parts[0])
+	d.Set("name", addon.ComponentName)
+	d.Set("version", addon.Version)
+	d.Set("next_version", addon.NextVersion)
+	d.Set("can_upgrade", addon.CanUpgrade)
+	d.Set("required", addon.Required)
+	if addon.Config != "" {
+		config, _ := normalizeJsonString(addon.Config)
+		d.Set("config", config)
+	}
+
+	return nil
+}
+
+func resourceAlicloudCSKubernetesAddonUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	clusterId, name := parts[0], parts[1]
+
+	if d.HasChange("version") {
+		o, n := d.GetChange("version")
+		args := []CsKubernetesAddonUpgradeArgs{
+			{
+				ComponentName: name,
+				Version:       o.(string),
+				NextVersion:   n.(string),
+				Config:        d.Get("config").(string),
+			},
+		}
+		path := fmt.Sprintf("/clusters/%s/components/upgrade", clusterId)
+		if err := client.csconn().Invoke(client.Region, http.MethodPost, path, nil, args, nil); err != nil {
+			return fmt.Errorf("Upgrading addon %s to %s got an error: %#v", d.Id(), n.(string), err)
+		}
+	} else if d.HasChange("config") {
+		args := CsKubernetesAddonConfigArgs{
+			Config: d.Get("config").(string),
+		}
+		path := fmt.Sprintf("/clusters/%s/components/%s/config", clusterId, name)
+		if err := client.csconn().Invoke(client.Region, http.MethodPost, path, nil, args, nil); err != nil {
+			return fmt.Errorf("Modifying config of addon %s got an error: %#v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("version") || d.HasChange("config") {
+		if err := client.WaitForCsKubernetesAddon(clusterId, name, deadline.Seconds()); err != nil {
+			return WrapError(err, "WaitForCsKubernetesAddon", d.Id())
+		}
+	}
+
+	return resourceAlicloudCSKubernetesAddonRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesAddonDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	clusterId, name := parts[0], parts[1]
+
+	if d.Get("required").(bool) {
+		return ConfigErrorf(ErrorCodeUnsupportedOperation, "Addon %s is required by the cluster and it can not be uninstalled.", d.Id())
+	}
+
+	args := []CsKubernetesAddonArgs{
+		{
+			Name: name,
+		},
+	}
+	path := fmt.Sprintf("/clusters/%s/components/uninstall", clusterId)
+	if err := client.csconn().Invoke(client.Region, http.MethodPost, path, nil, args, nil); err != nil {
+		if NotFoundError(err, "cs") {
+			return nil
+		}
+		return fmt.Errorf("Uninstalling addon %s got an error: %#v", d.Id(), err)
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeCsKubernetesAddon(clusterId, name); err != nil {
+			if NotFoundError(err, "cs") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Addon %s is being uninstalled - trying again while it is uninstalled.", d.Id()))
+	})
+}
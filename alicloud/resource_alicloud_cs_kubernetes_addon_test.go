@@ -0,0 +1,108 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCSKubernetesAddon_basic(t *testing.T) {
+	var addon CsKubernetesAddon
+	clusterId := os.Getenv("ALICLOUD_CS_CLUSTER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithKubernetesCluster(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cs_kubernetes_addon.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCSKubernetesAddonDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCSKubernetesAddonConfig(clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCSKubernetesAddonExists(
+						"alicloud_cs_kubernetes_addon.foo", &addon),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_addon.foo",
+						"name",
+						"logtail-ds"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_cs_kubernetes_addon.foo",
+						"version"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_addon.foo",
+						"required",
+						"false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCSKubernetesAddonExists(n string, d *CsKubernetesAddon) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No addon ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		addon, err := client.DescribeCsKubernetesAddon(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *addon
+		return nil
+	}
+}
+
+func testAccCheckCSKubernetesAddonDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cs_kubernetes_addon" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCsKubernetesAddon(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "cs") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Addon %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCSKubernetesAddonConfig(clusterId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cs_kubernetes_addon" "foo" {
+  cluster_id = "%s"
+  name = "logtail-ds"
+}
+`, clusterId)
+}
@@ -0,0 +1,531 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCSKubernetesNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCSKubernetesNodePoolCreate,
+		Read:   resourceAlicloudCSKubernetesNodePoolRead,
+		Update: resourceAlicloudCSKubernetesNodePoolUpdate,
+		Delete: resourceAlicloudCSKubernetesNodePoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vswitch_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+			},
+			"instance_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MaxItems: 10,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"system_disk_category": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "cloud_efficiency",
+				ValidateFunc: validateAllowedStringValue([]string{"cloud_efficiency", "cloud_ssd", "cloud_essd"}),
+			},
+			"system_disk_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      40,
+				ValidateFunc: validateIntegerInRange(40, 500),
+			},
+			"key_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"password": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"key_name"},
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "PostPaid",
+				ValidateFunc: validateAllowedStringValue([]string{"PostPaid", "PrePaid"}),
+			},
+			"node_count": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateIntegerInRange(0, 1000),
+				ConflictsWith: []string{"scaling_config"},
+			},
+			"scaling_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_size": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(0, 1000),
+						},
+						"max_size": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(0, 1000),
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "cpu",
+							ValidateFunc: validateAllowedStringValue([]string{"cpu", "gpu", "gpushare", "spot"}),
+						},
+					},
+				},
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"taints": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"effect": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NoSchedule",
+							ValidateFunc: validateAllowedStringValue(CsTaintEffects),
+						},
+					},
+				},
+			},
+			"spot_strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(EssNoSpot),
+				ValidateFunc: validateAllowedStringValue([]string{string(EssNoSpot), string(EssSpotWithPriceLimit), string(EssSpotAsPriceGo)}),
+			},
+			"spot_price_limit": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"price_limit": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"management": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_repair": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"auto_upgrade": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"surge": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateIntegerInRange(0, 1000),
+						},
+						"max_unavailable": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateIntegerInRange(0, 1000),
+						},
+					},
+				},
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCSKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	clusterId := d.Get("cluster_id").(string)
+
+	args := &CsNodePoolArgs{
+		NodePoolInfo: &CsNodePoolInfo{
+			Name: d.Get("name").(string),
+		},
+		ScalingGroup:     buildCsNodePoolScalingGroup(d, client.DefaultTags),
+		AutoScaling:      buildCsNodePoolAutoScaling(d),
+		KubernetesConfig: buildCsNodePoolKubernetesConfig(d),
+		Management:       buildCsNodePoolManagement(d),
+	}
+	if args.ScalingGroup.InstanceChargeType == "PrePaid" {
+		return fmt.Errorf("PrePaid node pool is not supported yet, please use PostPaid instead.")
+	}
+
+	resp := CreateCsNodePoolResponse{}
+	path := fmt.Sprintf("/clusters/%s/nodepools", clusterId)
+	if err := client.csconn().Invoke(client.Region, http.MethodPost, path, nil, args, &resp); err != nil {
+		return fmt.Errorf("Creating node pool of cluster %s got an error: %#v", clusterId, err)
+	}
+	if resp.NodePoolId == "" {
+		return fmt.Errorf("Creating node pool of cluster %s got an empty node pool id.", clusterId)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, resp.NodePoolId))
+
+	if err := client.WaitForCsKubernetesNodePool(clusterId, resp.NodePoolId, CsNodePoolActive, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForCsKubernetesNodePool %s got an error: %#v", CsNodePoolActive, err)
+	}
+
+	return resourceAlicloudCSKubernetesNodePoolRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	nodePool, err := meta.(*AliyunClient).DescribeCsKubernetesNodePool(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "cs") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Describing node pool %s got an error: %#v", d.Id(), err)
+	}
+
+	group := nodePool.ScalingGroup
+	d.Set("cluster_id", parts[0])
+	d.Set("name", nodePool.NodePoolInfo.Name)
+	if err := d.Set("vswitch_ids", group.VSwitchIds); err != nil {
+		return err
+	}
+	if err := d.Set("instance_types", group.InstanceTypes); err != nil {
+		return err
+	}
+	d.Set("security_group_id", group.SecurityGroupId)
+	d.Set("image_id", group.ImageId)
+	d.Set("system_disk_category", group.SystemDiskCategory)
+	d.Set("system_disk_size", group.SystemDiskSize)
+	d.Set("key_name", group.KeyPair)
+	d.Set("scaling_group_id", group.ScalingGroupId)
+	if group.InstanceChargeType != "" {
+		d.Set("instance_charge_type", group.InstanceChargeType)
+	}
+	if group.SpotStrategy != "" {
+		d.Set("spot_strategy", group.SpotStrategy)
+	}
+
+	var limits []map[string]interface{}
+	for _, l := range group.SpotPriceLimit {
+		limits = append(limits, map[string]interface{}{
+			"instance_type": l.InstanceType,
+			"price_limit":   l.PriceLimit,
+		})
+	}
+	if err := d.Set("spot_price_limit", limits); err != nil {
+		return err
+	}
+
+	tags := make(map[string]string)
+	for _, t := range group.Tags {
+		tags[t.Key] = t.Value
+	}
+	if err := d.Set("tags", ignoreDefaultTags(meta.(*AliyunClient), d, tags)); err != nil {
+		return err
+	}
+
+	if nodePool.AutoScaling.Enable {
+		if err := d.Set("scaling_config", []map[string]interface{}{
+			{
+				"min_size": nodePool.AutoScaling.MinInstances,
+				"max_size": nodePool.AutoScaling.MaxInstances,
+				"type":     nodePool.AutoScaling.Type,
+			},
+		}); err != nil {
+			return err
+		}
+	} else {
+		if err := d.Set("scaling_config", nil); err != nil {
+			return err
+		}
+		d.Set("node_count", nodePool.Status.TotalNodes)
+	}
+
+	labels := make(map[string]string)
+	for _, l := range nodePool.KubernetesConfig.Labels {
+		labels[l.Key] = l.Value
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return err
+	}
+
+	var taints []map[string]interface{}
+	for _, t := range nodePool.KubernetesConfig.Taints {
+		taints = append(taints, map[string]interface{}{
+			"key":    t.Key,
+			"value":  t.Value,
+			"effect": t.Effect,
+		})
+	}
+	if err := d.Set("taints", taints); err != nil {
+		return err
+	}
+
+	if m := nodePool.Management; m.Enable {
+		if err := d.Set("management", []map[string]interface{}{
+			{
+				"auto_repair":     m.AutoRepair,
+				"auto_upgrade":    m.UpgradeConfig.AutoUpgrade,
+				"surge":           m.UpgradeConfig.Surge,
+				"max_unavailable": m.UpgradeConfig.MaxUnavailable,
+			},
+		}); err != nil {
+			return err
+		}
+	} else {
+		if err := d.Set("management", nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAlicloudCSKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := &CsNodePoolArgs{}
+	update := false
+
+	if d.HasChange("name") {
+		args.NodePoolInfo = &CsNodePoolInfo{
+			Name: d.Get("name").(string),
+		}
+		update = true
+	}
+
+	if d.HasChange("vswitch_ids") || d.HasChange("instance_types") || d.HasChange("image_id") ||
+		d.HasChange("system_disk_category") || d.HasChange("system_disk_size") || d.HasChange("key_name") ||
+		d.HasChange("password") || d.HasChange("node_count") || d.HasChange("spot_strategy") ||
+		d.HasChange("spot_price_limit") || d.HasChange("tags") {
+		args.ScalingGroup = buildCsNodePoolScalingGroup(d, client.DefaultTags)
+		update = true
+	}
+
+	if d.HasChange("scaling_config") {
+		args.AutoScaling = buildCsNodePoolAutoScaling(d)
+		update = true
+	}
+
+	if d.HasChange("labels") || d.HasChange("taints") {
+		args.KubernetesConfig = buildCsNodePoolKubernetesConfig(d)
+		update = true
+	}
+
+	if d.HasChange("management") {
+		args.Management = buildCsNodePoolManagement(d)
+		update = true
+	}
+
+	if update {
+		path := fmt.Sprintf("/clusters/%s/nodepools/%s", parts[0], parts[1])
+		if err := client.csconn().Invoke(client.Region, http.MethodPut, path, nil, args, nil); err != nil {
+			return fmt.Errorf("Modifying node pool %s got an error: %#v", d.Id(), err)
+		}
+
+		// The node pool state changes a while later after modifying it.
+		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := client.WaitForCsKubernetesNodePool(parts[0], parts[1], CsNodePoolActive, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForCsKubernetesNodePool %s got an error: %#v", CsNodePoolActive, err)
+		}
+	}
+
+	return resourceAlicloudCSKubernetesNodePoolRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/clusters/%s/nodepools/%s", parts[0], parts[1])
+	query := url.Values{}
+	query.Set("force", "true")
+
+	if err := client.csconn().Invoke(client.Region, http.MethodDelete, path, query, nil, nil); err != nil {
+		if NotFoundError(err, "cs") {
+			return nil
+		}
+		return fmt.Errorf("Deleting node pool %s got an error: %#v", d.Id(), err)
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeCsKubernetesNodePool(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "cs") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Node pool %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildCsNodePoolScalingGroup(d *schema.ResourceData, defaultTags map[string]string) *CsNodePoolScalingGroup {
+	group := &CsNodePoolScalingGroup{
+		VSwitchIds:         expandStringList(d.Get("vswitch_ids").([]interface{})),
+		InstanceTypes:      expandStringList(d.Get("instance_types").([]interface{})),
+		InstanceChargeType: d.Get("instance_charge_type").(string),
+		SecurityGroupId:    d.Get("security_group_id").(string),
+		ImageId:            d.Get("image_id").(string),
+		SystemDiskCategory: d.Get("system_disk_category").(string),
+		SystemDiskSize:     d.Get("system_disk_size").(int),
+		KeyPair:            d.Get("key_name").(string),
+		LoginPassword:      d.Get("password").(string),
+		SpotStrategy:       d.Get("spot_strategy").(string),
+	}
+
+	if _, ok := d.GetOk("scaling_config"); !ok {
+		if v, ok := d.GetOk("node_count"); ok {
+			count := v.(int)
+			group.DesiredSize = &count
+		}
+	}
+
+	for _, v := range d.Get("spot_price_limit").([]interface{}) {
+		l := v.(map[string]interface{})
+		group.SpotPriceLimit = append(group.SpotPriceLimit, CsSpotPriceLimit{
+			InstanceType: l["instance_type"].(string),
+			PriceLimit:   l["price_limit"].(string),
+		})
+	}
+
+	for k, v := range mergeDefaultTags(defaultTags, d.Get("tags").(map[string]interface{})) {
+		group.Tags = append(group.Tags, CsTag{Key: k, Value: v.(string)})
+	}
+
+	return group
+}
+
+func buildCsNodePoolAutoScaling(d *schema.ResourceData) *CsNodePoolAutoScaling {
+	scaling := &CsNodePoolAutoScaling{}
+	if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 {
+		config := v.([]interface{})[0].(map[string]interface{})
+		scaling.Enable = true
+		scaling.MinInstances = config["min_size"].(int)
+		scaling.MaxInstances = config["max_size"].(int)
+		scaling.Type = config["type"].(string)
+	}
+	return scaling
+}
+
+func buildCsNodePoolKubernetesConfig(d *schema.ResourceData) *CsNodePoolKubernetesConfig {
+	config := &CsNodePoolKubernetesConfig{
+		Labels: []CsTag{},
+		Taints: []CsTaint{},
+	}
+
+	for k, v := range d.Get("labels").(map[string]interface{}) {
+		config.Labels = append(config.Labels, CsTag{Key: k, Value: v.(string)})
+	}
+
+	for _, v := range d.Get("taints").([]interface{}) {
+		t := v.(map[string]interface{})
+		config.Taints = append(config.Taints, CsTaint{
+			Key:    t["key"].(string),
+			Value:  t["value"].(string),
+			Effect: t["effect"].(string),
+		})
+	}
+
+	return config
+}
+
+func buildCsNodePoolManagement(d *schema.ResourceData) *CsNodePoolManagement {
+	management := &CsNodePoolManagement{}
+	if v, ok := d.GetOk("management"); ok && len(v.([]interface{})) > 0 {
+		m := v.([]interface{})[0].(map[string]interface{})
+		management.Enable = true
+		management.AutoRepair = m["auto_repair"].(bool)
+		management.UpgradeConfig = CsNodePoolUpgradeConfig{
+			AutoUpgrade:    m["auto_upgrade"].(bool),
+			Surge:          m["surge"].(int),
+			MaxUnavailable: m["max_unavailable"].(int),
+		}
+	}
+	return management
+}
@@ -0,0 +1,229 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCSKubernetesNodePool_basic(t *testing.T) {
+	var nodePool CsNodePool
+	clusterId := os.Getenv("ALICLOUD_CS_CLUSTER_ID")
+	vswitchId := os.Getenv("ALICLOUD_CS_VSWITCH_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithKubernetesCluster(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cs_kubernetes_node_pool.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCSKubernetesNodePoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCSKubernetesNodePoolConfig(clusterId, vswitchId, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCSKubernetesNodePoolExists(
+						"alicloud_cs_kubernetes_node_pool.foo", &nodePool),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"name",
+						"tf-testAccNodePool"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"node_count",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"labels.%",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"taints.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"taints.0.effect",
+						"NoSchedule"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"scaling_group_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCSKubernetesNodePoolConfig(clusterId, vswitchId, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCSKubernetesNodePoolExists(
+						"alicloud_cs_kubernetes_node_pool.foo", &nodePool),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"node_count",
+						"2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudCSKubernetesNodePool_autoScaling(t *testing.T) {
+	var nodePool CsNodePool
+	clusterId := os.Getenv("ALICLOUD_CS_CLUSTER_ID")
+	vswitchId := os.Getenv("ALICLOUD_CS_VSWITCH_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithKubernetesCluster(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cs_kubernetes_node_pool.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCSKubernetesNodePoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCSKubernetesNodePoolAutoScaling(clusterId, vswitchId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCSKubernetesNodePoolExists(
+						"alicloud_cs_kubernetes_node_pool.foo", &nodePool),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"scaling_config.0.min_size",
+						"0"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"scaling_config.0.max_size",
+						"3"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"spot_strategy",
+						"SpotAsPriceGo"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"management.0.auto_repair",
+						"true"),
+					resource.TestCheckResourceAttr(
+						"alicloud_cs_kubernetes_node_pool.foo",
+						"management.0.max_unavailable",
+						"1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCSKubernetesNodePoolExists(n string, d *CsNodePool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No node pool ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		nodePool, err := client.DescribeCsKubernetesNodePool(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *nodePool
+		return nil
+	}
+}
+
+func testAccCheckCSKubernetesNodePoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cs_kubernetes_node_pool" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCsKubernetesNodePool(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "cs") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Node pool %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCSKubernetesNodePoolConfig(clusterId, vswitchId string, count int) string {
+	return fmt.Sprintf(`
+data "alicloud_instance_types" "default" {
+  cpu_core_count = 2
+  memory_size = 4
+}
+
+resource "alicloud_cs_kubernetes_node_pool" "foo" {
+  cluster_id = "%s"
+  name = "tf-testAccNodePool"
+  vswitch_ids = ["%s"]
+  instance_types = ["${data.alicloud_instance_types.default.instance_types.0.id}"]
+  system_disk_category = "cloud_efficiency"
+  system_disk_size = 40
+  password = "Test12345"
+  node_count = %d
+
+  labels {
+    test = "nodepool"
+  }
+
+  taints = [{
+    key = "tf"
+    value = "test"
+  }]
+}
+`, clusterId, vswitchId, count)
+}
+
+func testAccCSKubernetesNodePoolAutoScaling(clusterId, vswitchId string) string {
+	return fmt.Sprintf(`
+data "alicloud_instance_types" "default" {
+  cpu_core_count = 2
+  memory_size = 4
+}
+
+resource "alicloud_cs_kubernetes_node_pool" "foo" {
+  cluster_id = "%s"
+  name = "tf-testAccNodePoolAutoScaling"
+  vswitch_ids = ["%s"]
+  instance_types = ["${data.alicloud_instance_types.default.instance_types.0.id}"]
+  password = "Test12345"
+  spot_strategy = "SpotAsPriceGo"
+
+  scaling_config {
+    min_size = 0
+    max_size = 3
+  }
+
+  management {
+    auto_repair = true
+    auto_upgrade = true
+    surge = 1
+    max_unavailable = 1
+  }
+}
+`, clusterId, vswitchId)
+}
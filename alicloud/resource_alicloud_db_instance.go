@@ -25,6 +25,12 @@ func resourceAlicloudDBInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"engine": &schema.Schema{
 				Type:         schema.TypeString,
@@ -32,12 +38,19 @@ func resourceAlicloudDBInstance() *schema.Resource {
 				ForceNew:     true,
 				Required:     true,
 			},
+			// The engine_version can only be upgraded in place. A downgrade can not be rejected by the plan in
+			// this Terraform version, so it is rejected with an error when it is applied.
 			"engine_version": &schema.Schema{
 				Type:         schema.TypeString,
 				ValidateFunc: validateAllowedStringValue([]string{"5.5", "5.6", "5.7", "2008r2", "2012", "9.4", "9.3"}),
-				ForceNew:     true,
 				Required:     true,
 			},
+			"upgrade_effective_time": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{RdsEffectiveImmediately, RdsEffectiveInMaintenance}),
+				Optional:     true,
+				Default:      RdsEffectiveImmediately,
+			},
 			"db_instance_class": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -181,6 +194,9 @@ func resourceAlicloudDBInstance() *schema.Resource {
 				Optional: true,
 				Set:      resourceAlicloudDatabaseHash,
 			},
+			"resource_group_id": resourceGroupIdSchema(),
+
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -197,7 +213,8 @@ func resourceAlicloudDatabaseHash(v interface{}) int {
 
 func resourceAlicloudDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.rdsconn
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	conn := client.rdsconn()
 
 	args, err := buildDBCreateOrderArgs(d, meta)
 	if err != nil {
@@ -218,32 +235,32 @@ func resourceAlicloudDBInstanceCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(instanceId)
 
 	// wait instance status change from Creating to running
-	if err := conn.WaitForInstanceAsyn(d.Id(), rds.Running, defaultLongTimeout); err != nil {
+	if err := conn.WaitForInstanceAsyn(d.Id(), rds.Running, deadline.Seconds()); err != nil {
 		return fmt.Errorf("WaitForInstance %s got error: %#v", rds.Running, err)
 	}
 
-	if err := modifySecurityIps(d.Id(), d.Get("security_ips"), meta); err != nil {
+	if err := modifySecurityIps(d.Id(), d.Get("security_ips"), meta, deadline.Seconds()); err != nil {
 		return err
 	}
 
 	masterUserName := d.Get("master_user_name").(string)
 	masterUserPwd := d.Get("master_user_password").(string)
 	if masterUserName != "" && masterUserPwd != "" {
-		if err := client.CreateAccountByInfo(d.Id(), masterUserName, masterUserPwd); err != nil {
+		if err := client.CreateAccountByInfo(d.Id(), masterUserName, masterUserPwd, deadline.Seconds()); err != nil {
 			return fmt.Errorf("Create db account %s error: %v", masterUserName, err)
 		}
 	}
 
 	if d.Get("allocate_public_connection").(bool) {
-		if err := client.AllocateDBPublicConnection(d.Id(), DB_DEFAULT_CONNECT_PORT); err != nil {
+		if err := client.AllocateDBPublicConnection(d.Id(), DB_DEFAULT_CONNECT_PORT, deadline.Seconds()); err != nil {
 			return fmt.Errorf("Allocate public connection error: %v", err)
 		}
 	}
 
-	return resourceAlicloudDBInstanceUpdate(d, meta)
+	return updateDBInstance(d, meta, deadline)
 }
 
-func modifySecurityIps(id string, ips interface{}, meta interface{}) error {
+func modifySecurityIps(id string, ips interface{}, meta interface{}, timeout int) error {
 	client := meta.(*AliyunClient)
 	ipList := expandStringList(ips.([]interface{}))
 
@@ -253,15 +270,21 @@ func modifySecurityIps(id string, ips interface{}, meta interface{}) error {
 		ipstr = LOCAL_HOST_IP
 	}
 
-	if err := client.ModifyDBSecurityIps(id, ipstr); err != nil {
+	if err := client.ModifyDBSecurityIps(id, ipstr, timeout); err != nil {
 		return fmt.Errorf("Error modify security ips %s: %#v", ipstr, err)
 	}
 	return nil
 }
 
 func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	return updateDBInstance(d, meta, newOperationDeadline(d, schema.TimeoutUpdate))
+}
+
+// updateDBInstance updates the db instance before the deadline, which is the one of the Create when the db
+// instance is just created, so that the create timeout covers the whole creation.
+func updateDBInstance(d *schema.ResourceData, meta interface{}, deadline operationDeadline) error {
 	client := meta.(*AliyunClient)
-	conn := client.rdsconn
+	conn := client.rdsconn()
 	d.Partial(true)
 
 	if d.HasChange("db_mappings") {
@@ -299,13 +322,13 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 			}
 		}
 
-		if err := conn.WaitForAllDatabase(d.Id(), allDbs, rds.Running, 600); err != nil {
+		if err := conn.WaitForAllDatabase(d.Id(), allDbs, rds.Running, deadline.Seconds()); err != nil {
 			return fmt.Errorf("Failure create database %#v", err)
 		}
 
 		if user := d.Get("master_user_name").(string); user != "" {
 			for _, dbName := range allDbs {
-				if err := client.GrantDBPrivilege2Account(d.Id(), user, dbName); err != nil {
+				if err := client.GrantDBPrivilege2Account(d.Id(), user, dbName, deadline.Seconds()); err != nil {
 					return fmt.Errorf("Failed to grant database %s readwrite privilege to account %s: %#v", dbName, user, err)
 				}
 			}
@@ -321,12 +344,12 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		retention := d.Get("backup_retention_period").(int)
 
 		if time == "" || retention == 0 || len(periodList) < 1 {
-			return fmt.Errorf("Both backup_time, backup_period and retention_period are required to set backup policy.")
+			return ConfigErrorf(ErrorCodeMissingArgument, "Both backup_time, backup_period and retention_period are required to set backup policy.")
 		}
 
 		ps := strings.Join(periodList[:], COMMA_SEPARATED)
 
-		if err := client.ConfigDBBackup(d.Id(), time, ps, retention); err != nil {
+		if err := client.ConfigDBBackup(d.Id(), time, ps, retention, deadline.Seconds()); err != nil {
 			return fmt.Errorf("Error set backup policy: %#v", err)
 		}
 		d.SetPartial("preferred_backup_period")
@@ -335,7 +358,7 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("security_ips") {
-		if err := modifySecurityIps(d.Id(), d.Get("security_ips"), meta); err != nil {
+		if err := modifySecurityIps(d.Id(), d.Get("security_ips"), meta, deadline.Seconds()); err != nil {
 			return err
 		}
 		d.SetPartial("security_ips")
@@ -356,31 +379,55 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 				return fmt.Errorf("Prepaid db instance does not support modify db_instance_class or db_instance_storage")
 			}
 
-			if err := client.ModifyDBClassStorage(d.Id(), classNew, strconv.Itoa(storageNew)); err != nil {
+			if err := client.ModifyDBClassStorage(d.Id(), classNew, strconv.Itoa(storageNew), deadline.Seconds()); err != nil {
 				return fmt.Errorf("Error modify db instance class or storage error: %#v", err)
 			}
 		}
 	}
 
+	if d.HasChange("engine_version") && !d.IsNewResource() {
+		o, n := d.GetChange("engine_version")
+		engine := d.Get("engine").(string)
+		if !IsDBEngineVersionUpgrade(o.(string), n.(string)) {
+			return ConfigErrorf(ErrorCodeInvalidArgument, "The engine version of %s db instance can only be upgraded, and it does not support changing from %s to %s. Please recreate the db instance to use an older engine version.", engine, o.(string), n.(string))
+		}
+
+		if err := client.UpgradeDBEngineVersion(d.Id(), n.(string), d.Get("upgrade_effective_time").(string), deadline.Seconds()); err != nil {
+			return fmt.Errorf("Error upgrade db instance engine version to %s: %#v", n.(string), err)
+		}
+		d.SetPartial("engine_version")
+		d.SetPartial("upgrade_effective_time")
+	}
+
 	if d.HasChange("master_user_password") && !d.IsNewResource() {
 		d.SetPartial("master_user_password")
-		if _, err := client.rdsconn.ResetAccountPassword(d.Id(), d.Get("master_user_name").(string), d.Get("master_user_password").(string)); err != nil {
+		if _, err := client.rdsconn().ResetAccountPassword(d.Id(), d.Get("master_user_name").(string), d.Get("master_user_password").(string)); err != nil {
 			return fmt.Errorf("Error reset db account password error: %#v", err)
 		}
 
 	}
 
+	if err := setRdsTags(client, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
+	if err := setResourceGroup(client, ResourceGroupResourceDBInstance, d); err != nil {
+		return err
+	}
+	d.SetPartial("resource_group_id")
+
 	d.Partial(false)
 	return resourceAlicloudDBInstanceRead(d, meta)
 }
 
 func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.rdsconn
+	conn := client.rdsconn()
 
 	instance, err := client.DescribeDBInstanceById(d.Id())
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "rds") {
 			d.SetId("")
 			return nil
 		}
@@ -400,7 +447,9 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 		return nil
 	}
 
-	d.Set("db_mappings", flattenDatabaseMappings(resp.Databases.Database))
+	if err := d.Set("db_mappings", flattenDatabaseMappings(resp.Databases.Database)); err != nil {
+		return err
+	}
 
 	argn := rds.DescribeDBInstanceNetInfoArgs{
 		DBInstanceId: d.Id(),
@@ -410,16 +459,24 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	d.Set("connections", flattenDBConnections(resn.DBInstanceNetInfos.DBInstanceNetInfo))
+	if err := d.Set("connections", flattenDBConnections(resn.DBInstanceNetInfos.DBInstanceNetInfo)); err != nil {
+		return err
+	}
 
 	ips, err := client.GetSecurityIps(d.Id(), d.Get("security_ips"))
 	if err != nil {
 		log.Printf("Describe DB security ips error: %#v", err)
 	}
-	d.Set("security_ips", ips)
+	if err := d.Set("security_ips", ips); err != nil {
+		return err
+	}
 
 	d.Set("engine", instance.Engine)
-	d.Set("engine_version", instance.EngineVersion)
+	// The upgrade taking effect in the maintenance window is still pending, keep the version in the config.
+	if d.Get("upgrade_effective_time").(string) != RdsEffectiveInMaintenance ||
+		!IsDBEngineVersionUpgrade(instance.EngineVersion, d.Get("engine_version").(string)) {
+		d.Set("engine_version", instance.EngineVersion)
+	}
 	d.Set("db_instance_class", instance.DBInstanceClass)
 	d.Set("port", instance.Port)
 	d.Set("db_instance_storage", instance.DBInstanceStorage)
@@ -444,17 +501,34 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 	backup, err := conn.DescribeBackupPolicy(&rds.DescribeBackupPolicyArgs{
 		DBInstanceId: d.Id(),
 	})
-	d.Set("preferred_backup_period", strings.Split(backup.PreferredBackupPeriod, COMMA_SEPARATED))
+	if err := d.Set("preferred_backup_period", strings.Split(backup.PreferredBackupPeriod, COMMA_SEPARATED)); err != nil {
+		return err
+	}
 	d.Set("preferred_backup_time", backup.PreferredBackupTime)
 	d.Set("backup_retention_period", backup.BackupRetentionPeriod)
 
+	tags, err := describeRdsTags(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("Describing tags of %s got an error: %#v", d.Id(), err)
+	}
+	if err := d.Set("tags", ignoreDefaultTags(client, d, tags)); err != nil {
+		return err
+	}
+
+	groupId, err := describeResourceGroupId(client, ResourceGroupResourceDBInstance, d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeResourceGroup", d.Id())
+	}
+	d.Set("resource_group_id", groupId)
+
 	return nil
 }
 
 func resourceAlicloudDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).rdsconn
+	conn := meta.(*AliyunClient).rdsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DeleteInstance(d.Id())
 
 		if err != nil {
@@ -504,7 +578,7 @@ func buildDBCreateOrderArgs(d *schema.ResourceData, meta interface{}) (*rds.Crea
 	multiAZ := d.Get("multi_az").(bool)
 	if multiAZ {
 		if zoneId != "" {
-			return nil, fmt.Errorf("You cannot set the ZoneId parameter when the MultiAZ parameter is set to true")
+			return nil, ConfigErrorf(ErrorCodeConflictingArguments, "You cannot set the ZoneId parameter when the MultiAZ parameter is set to true")
 		}
 		izs, err := client.DescribeMultiIZByRegion()
 		if err != nil {
@@ -10,6 +10,59 @@ import (
 	"testing"
 )
 
+func init() {
+	addTestSweepers("alicloud_db_instance", &testSweeper{
+		F: testSweepDBInstances,
+	})
+}
+
+// testSweepDBInstances deletes the RDS instances in the VPCs of the acceptance tests, because the instances
+// have no name to tell whether they are created by the tests.
+func testSweepDBInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.rdsconn()
+
+	vpcIds, err := sweepableVpcIds(client)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	args := DescribeDBInstancesArgs{
+		RegionId:   client.Region,
+		PageSize:   50,
+		PageNumber: 1,
+	}
+	for {
+		resp := DescribeDBInstancesResponse{}
+		if err := conn.Invoke("DescribeDBInstances", &args, &resp); err != nil {
+			return WrapError(err, "DescribeDBInstances", "")
+		}
+		for _, instance := range resp.Items.DBInstance {
+			if !vpcIds[instance.VpcId] {
+				log.Printf("[INFO] Skipping RDS instance %s (%s)", instance.DBInstanceDescription, instance.DBInstanceId)
+				continue
+			}
+			ids = append(ids, instance.DBInstanceId)
+		}
+		if len(resp.Items.DBInstance) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting RDS instance %s", id)
+		if err := conn.DeleteInstance(id); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteInstance", id))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudDBInstance_basic(t *testing.T) {
 	var instance rds.DBInstanceAttribute
 
@@ -379,6 +432,42 @@ func TestAccAlicloudDBInstance_upgradeClass(t *testing.T) {
 
 }
 
+func TestAccAlicloudDBInstance_upgradeEngineVersion(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBInstance_class,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "engine_version", "5.6"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccDBInstance_engineVersionUpgrade,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "engine_version", "5.7"),
+				),
+			},
+		},
+	})
+
+}
+
 func testAccCheckSecurityIpExists(n string, ips []map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -390,7 +479,7 @@ func testAccCheckSecurityIpExists(n string, ips []map[string]interface{}) resour
 			return fmt.Errorf("No DB Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AliyunClient).rdsconn
+		conn := testAccProvider.Meta().(*AliyunClient).rdsconn()
 		args := rds.DescribeDBInstanceIPsArgs{
 			DBInstanceId: rs.Primary.ID,
 		}
@@ -433,7 +522,7 @@ func testAccCheckAccountHasPrivilege2Database(n, accountName, dbName, privilege
 			return fmt.Errorf("No DB instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AliyunClient).rdsconn
+		conn := testAccProvider.Meta().(*AliyunClient).rdsconn()
 		if err := conn.WaitForAccountPrivilege(rs.Primary.ID, accountName, dbName, rds.AccountPrivilege(privilege), 50); err != nil {
 			return fmt.Errorf("Failed to grant database %s privilege to account %s: %v", dbName, accountName, err)
 		}
@@ -452,7 +541,7 @@ func testAccCheckHasPublicConnection(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No DB instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AliyunClient).rdsconn
+		conn := testAccProvider.Meta().(*AliyunClient).rdsconn()
 		if err := conn.WaitForPublicConnection(rs.Primary.ID, 50); err != nil {
 			return fmt.Errorf("Failed to allocate public connection: %v", err)
 		}
@@ -499,7 +588,7 @@ func testAccCheckBackupPolicyExists(n string, ps []map[string]interface{}) resou
 			return fmt.Errorf("No DB Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AliyunClient).rdsconn
+		conn := testAccProvider.Meta().(*AliyunClient).rdsconn()
 
 		args := rds.DescribeBackupPolicyArgs{
 			DBInstanceId: rs.Primary.ID,
@@ -547,7 +636,7 @@ func testAccCheckDBInstanceDestroy(s *terraform.State) error {
 		// Verify the error is what we want
 		if err != nil {
 			// Verify the error is what we want
-			if NotFoundError(err) {
+			if NotFoundError(err, "rds") {
 				continue
 			}
 			return err
@@ -761,3 +850,32 @@ resource "alicloud_db_instance" "foo" {
 	db_instance_net_type = "Intranet"
 }
 `
+const testAccDBInstance_engineVersionUpgrade = `
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.7"
+	db_instance_class = "rds.mysql.t1.small"
+	db_instance_storage = "10"
+	db_instance_net_type = "Intranet"
+}
+`
+
+func TestIsDBEngineVersionUpgrade(t *testing.T) {
+	cases := []struct {
+		oldVersion, newVersion string
+		upgrade                bool
+	}{
+		{"5.6", "5.7", true},
+		{"5.7", "5.6", false},
+		{"5.7", "8.0", true},
+		{"5.7", "5.7", false},
+		{"2008r2", "2012", true},
+		{"2012", "2008r2", false},
+		{"9.4", "10", true},
+	}
+	for _, c := range cases {
+		if got := IsDBEngineVersionUpgrade(c.oldVersion, c.newVersion); got != c.upgrade {
+			t.Errorf("IsDBEngineVersionUpgrade(%s, %s) = %t, want %t", c.oldVersion, c.newVersion, got, c.upgrade)
+		}
+	}
+}
@@ -0,0 +1,455 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDcdnDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDcdnDomainCreate,
+		Read:   resourceAlicloudDcdnDomainRead,
+		Update: resourceAlicloudDcdnDomainUpdate,
+		Delete: resourceAlicloudDcdnDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDomainName,
+			},
+			"sources": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(DcdnSourceTypes),
+						},
+						"port": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      80,
+							ValidateFunc: validateAllowedIntValue([]int{80, 443}),
+						},
+						"priority": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "20",
+							ValidateFunc: validateAllowedStringValue([]string{"20", "30"}),
+						},
+						"weight": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "10",
+						},
+					},
+				},
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(DcdnScopes),
+			},
+			"check_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(CdnDomainOnline),
+				ValidateFunc: validateAllowedStringValue([]string{string(CdnDomainOnline), string(CdnDomainOffline)}),
+			},
+
+			// SSL
+			"ssl_protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "off",
+				ValidateFunc: validateCdnEnable,
+			},
+			"cert_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cert_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(CdnCertTypes),
+			},
+			"ssl_pub": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ssl_pri": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			// The config rules are set by the function names and arguments of DCDN.
+			"domain_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"function_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"function_args": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arg_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"arg_value": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"config_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"cname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDcdnDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	sources, err := expandDcdnSources(d.Get("sources").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	args := AddDcdnDomainArgs{
+		DomainName: d.Get("domain_name").(string),
+		Sources:    sources,
+		Scope:      d.Get("scope").(string),
+		CheckUrl:   d.Get("check_url").(string),
+	}
+
+	if err := client.dcdnconn().Invoke("AddDcdnDomain", &args, &common.Response{}); err != nil {
+		if IsExceptedError(err, InvalidDomainNotICP) {
+			return fmt.Errorf("The domain %s has not been filed for ICP, and it can not be added into DCDN before the ICP filing is completed.", args.DomainName)
+		}
+		if IsExceptedError(err, DomainOwnerVerifyFail) {
+			return fmt.Errorf("The ownership of the domain %s has not been verified, please add the verification DNS record and try again.", args.DomainName)
+		}
+		return WrapError(err, "AddDcdnDomain", d.Id())
+	}
+
+	d.SetId(args.DomainName)
+
+	if err := client.WaitForDcdnDomain(args.DomainName, CdnDomainOnline, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForDcdnDomain %s got an error: %#v", CdnDomainOnline, err)
+	}
+
+	return resourceAlicloudDcdnDomainUpdate(d, meta)
+}
+
+func resourceAlicloudDcdnDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	domain, err := client.DescribeDcdnDomain(d.Id())
+	if err != nil {
+		if NotFoundError(err, "dcdn") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDcdnDomainDetail", d.Id())
+	}
+
+	d.Set("domain_name", domain.DomainName)
+	d.Set("scope", domain.Scope)
+	d.Set("cname", domain.Cname)
+	d.Set("ssl_protocol", domain.SSLProtocol)
+	d.Set("ssl_pub", domain.SSLPub)
+	d.Set("cert_name", domain.CertName)
+	if CdnDomainStatus(domain.DomainStatus) == CdnDomainOnline || CdnDomainStatus(domain.DomainStatus) == CdnDomainOffline {
+		d.Set("status", domain.DomainStatus)
+	}
+
+	var sources []map[string]interface{}
+	for _, source := range domain.Sources.Source {
+		sources = append(sources, map[string]interface{}{
+			"content":  source.Content,
+			"type":     source.Type,
+			"port":     source.Port,
+			"priority": source.Priority,
+			"weight":   source.Weight,
+		})
+	}
+	if err := d.Set("sources", sources); err != nil {
+		return err
+	}
+
+	var functionNames []string
+	for _, v := range d.Get("domain_config").([]interface{}) {
+		functionNames = append(functionNames, v.(map[string]interface{})["function_name"].(string))
+	}
+	if len(functionNames) > 0 {
+		configs, err := client.DescribeDcdnDomainConfigs(d.Id(), functionNames)
+		if err != nil {
+			return WrapError(err, "DescribeDcdnDomainConfigs", d.Id())
+		}
+
+		var domainConfigs []map[string]interface{}
+		for _, config := range configs {
+			var args []map[string]interface{}
+			for _, arg := range config.FunctionArgs.FunctionArg {
+				args = append(args, map[string]interface{}{
+					"arg_name":  arg.ArgName,
+					"arg_value": arg.ArgValue,
+				})
+			}
+			domainConfigs = append(domainConfigs, map[string]interface{}{
+				"function_name": config.FunctionName,
+				"function_args": args,
+				"config_id":     config.ConfigId,
+			})
+		}
+		if err := d.Set("domain_config", domainConfigs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAlicloudDcdnDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if !d.IsNewResource() && d.HasChange("sources") {
+		sources, err := expandDcdnSources(d.Get("sources").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		args := UpdateDcdnDomainArgs{
+			DomainName: d.Id(),
+			Sources:    sources,
+		}
+		if err := client.dcdnconn().Invoke("UpdateDcdnDomain", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateDcdnDomain", d.Id())
+		}
+		d.SetPartial("sources")
+	}
+
+	// A new domain is online and its SSL is off by default.
+	if (d.HasChange("ssl_protocol") || d.HasChange("cert_name") || d.HasChange("cert_type") ||
+		d.HasChange("ssl_pub") || d.HasChange("ssl_pri")) && !(d.IsNewResource() && d.Get("ssl_protocol").(string) == "off") {
+		if err := dcdnCertificateUpdate(client, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("domain_config") {
+		if err := dcdnDomainConfigUpdate(client, d); err != nil {
+			return err
+		}
+		d.SetPartial("domain_config")
+	}
+
+	if d.HasChange("status") && !(d.IsNewResource() && d.Get("status").(string) == string(CdnDomainOnline)) {
+		action := "StartDcdnDomain"
+		if d.Get("status").(string) == string(CdnDomainOffline) {
+			action = "StopDcdnDomain"
+		}
+		args := DcdnDomainArgs{
+			DomainName: d.Id(),
+		}
+		if err := client.dcdnconn().Invoke(action, &args, &common.Response{}); err != nil {
+			return fmt.Errorf("%s got an error: %#v", action, err)
+		}
+		if err := client.WaitForDcdnDomain(d.Id(), CdnDomainStatus(d.Get("status").(string)), deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForDcdnDomain %s got an error: %#v", d.Get("status").(string), err)
+		}
+		d.SetPartial("status")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudDcdnDomainRead(d, meta)
+}
+
+func resourceAlicloudDcdnDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := DcdnDomainArgs{
+		DomainName: d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.dcdnconn().Invoke("DeleteDcdnDomain", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "dcdn") {
+				return nil
+			}
+			if IsExceptedError(err, ServiceBusy) {
+				return resource.RetryableError(fmt.Errorf("The specified DCDN domain is configuring, please retry later."))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting DCDN domain %s: %#v.", d.Id(), err))
+		}
+
+		if _, err := client.DescribeDcdnDomain(d.Id()); err != nil {
+			if NotFoundError(err, "dcdn") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("DCDN domain %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func expandDcdnSources(list []interface{}) (string, error) {
+	var sources []DcdnSource
+	for _, v := range list {
+		source := v.(map[string]interface{})
+		sources = append(sources, DcdnSource{
+			Content:  source["content"].(string),
+			Type:     source["type"].(string),
+			Port:     source["port"].(int),
+			Priority: source["priority"].(string),
+			Weight:   source["weight"].(string),
+		})
+	}
+
+	bytes, err := json.Marshal(sources)
+	if err != nil {
+		return "", fmt.Errorf("Marshaling DCDN sources got an error: %#v", err)
+	}
+	return string(bytes), nil
+}
+
+func dcdnCertificateUpdate(client *AliyunClient, d *schema.ResourceData) error {
+	args := SetDcdnDomainCertificateArgs{
+		DomainName:  d.Id(),
+		SSLProtocol: d.Get("ssl_protocol").(string),
+	}
+
+	if args.SSLProtocol == "on" {
+		args.CertName = d.Get("cert_name").(string)
+		args.CertType = d.Get("cert_type").(string)
+		switch args.CertType {
+		case "upload":
+			args.SSLPub = d.Get("ssl_pub").(string)
+			args.SSLPri = d.Get("ssl_pri").(string)
+			if args.SSLPub == "" || args.SSLPri == "" {
+				return ConfigErrorf(ErrorCodeMissingArgument, "If 'cert_type' value is 'upload', you must set 'ssl_pub' and 'ssl_pri' at one time.")
+			}
+			args.ForceSet = "1"
+		case "cas":
+			if args.CertName == "" {
+				return ConfigErrorf(ErrorCodeMissingArgument, "If 'cert_type' value is 'cas', you must set 'cert_name' with the name of the certificate in the SSL certificates service.")
+			}
+		case "":
+			return ConfigErrorf(ErrorCodeMissingArgument, "'cert_type' is required when 'ssl_protocol' is on.")
+		}
+	}
+
+	if err := client.dcdnconn().Invoke("SetDcdnDomainCertificate", &args, &common.Response{}); err != nil {
+		return WrapError(err, "SetDcdnDomainCertificate", d.Id())
+	}
+
+	for _, key := range []string{"ssl_protocol", "cert_name", "cert_type", "ssl_pub", "ssl_pri"} {
+		d.SetPartial(key)
+	}
+	return nil
+}
+
+func dcdnDomainConfigUpdate(client *AliyunClient, d *schema.ResourceData) error {
+	ov, nv := d.GetChange("domain_config")
+
+	for _, v := range ov.([]interface{}) {
+		configId := v.(map[string]interface{})["config_id"].(string)
+		if configId == "" {
+			continue
+		}
+		args := DeleteDcdnSpecificConfigArgs{
+			DomainName: d.Id(),
+			ConfigId:   configId,
+		}
+		if err := client.dcdnconn().Invoke("DeleteDcdnSpecificConfig", &args, &common.Response{}); err != nil {
+			return WrapError(err, "DeleteDcdnSpecificConfig", d.Id())
+		}
+	}
+
+	if len(nv.([]interface{})) == 0 {
+		return nil
+	}
+
+	var functions []CdnFunction
+	for _, v := range nv.([]interface{}) {
+		config := v.(map[string]interface{})
+		function := CdnFunction{
+			FunctionName: config["function_name"].(string),
+		}
+		for _, a := range config["function_args"].([]interface{}) {
+			arg := a.(map[string]interface{})
+			function.FunctionArgs = append(function.FunctionArgs, CdnFunctionArg{
+				ArgName:  arg["arg_name"].(string),
+				ArgValue: arg["arg_value"].(string),
+			})
+		}
+		functions = append(functions, function)
+	}
+
+	bytes, err := json.Marshal(functions)
+	if err != nil {
+		return fmt.Errorf("Marshaling DCDN domain configs got an error: %#v", err)
+	}
+
+	args := BatchSetDcdnDomainConfigsArgs{
+		DomainNames: d.Id(),
+		Functions:   string(bytes),
+	}
+	if err := client.dcdnconn().Invoke("BatchSetDcdnDomainConfigs", &args, &common.Response{}); err != nil {
+		return WrapError(err, "BatchSetDcdnDomainConfigs", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDdoscooDomainResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDdoscooDomainResourceCreate,
+		Read:   resourceAlicloudDdoscooDomainResourceRead,
+		Update: resourceAlicloudDdoscooDomainResourceUpdate,
+		Delete: resourceAlicloudDdoscooDomainResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rs_type": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateAllowedIntValue(DdoscooRsTypes),
+			},
+			"real_servers": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"proxy_types": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"proxy_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(DdoscooProxyTypes),
+						},
+						"proxy_ports": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"cname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDdoscooDomainResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	instanceIds, err := json.Marshal(expandStringList(d.Get("instance_ids").([]interface{})))
+	if err != nil {
+		return err
+	}
+	realServers := expandStringList(d.Get("real_servers").([]interface{}))
+
+	// The Rules are the real servers of each proxy type and port.
+	type proxyRule struct {
+		ProxyPort   int
+		RealServers []string
+	}
+	type rule struct {
+		ProxyType  string
+		ProxyRules []proxyRule
+	}
+	var rules []rule
+	for _, proxyType := range expandDdoscooProxyTypes(d.Get("proxy_types").(*schema.Set)) {
+		r := rule{ProxyType: proxyType.ProxyType}
+		for _, port := range proxyType.ProxyPorts {
+			r.ProxyRules = append(r.ProxyRules, proxyRule{ProxyPort: port, RealServers: realServers})
+		}
+		rules = append(rules, r)
+	}
+	rulesJson, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	args := DdoscooWebRuleArgs{
+		Domain:      d.Get("domain").(string),
+		RsType:      d.Get("rs_type").(int),
+		InstanceIds: string(instanceIds),
+		Rules:       string(rulesJson),
+	}
+	if err := client.ddoscooconn().Invoke("CreateWebRule", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateWebRule", d.Id())
+	}
+
+	d.SetId(args.Domain)
+
+	return resourceAlicloudDdoscooDomainResourceRead(d, meta)
+}
+
+func resourceAlicloudDdoscooDomainResourceRead(d *schema.ResourceData, meta interface{}) error {
+	rule, err := meta.(*AliyunClient).DescribeDdoscooWebRule(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ddoscoo") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeWebRules", d.Id())
+	}
+
+	var realServers []string
+	for _, server := range rule.RealServers {
+		realServers = append(realServers, server.RealServer)
+	}
+	var proxyTypes []map[string]interface{}
+	for _, proxyType := range rule.ProxyTypes {
+		// The proxy types without ports are not enabled.
+		if len(proxyType.ProxyPorts) == 0 {
+			continue
+		}
+		proxyTypes = append(proxyTypes, map[string]interface{}{
+			"proxy_type":  proxyType.ProxyType,
+			"proxy_ports": proxyType.ProxyPorts,
+		})
+	}
+
+	d.Set("domain", rule.Domain)
+	d.Set("rs_type", rule.RsType)
+	d.Set("cname", rule.Cname)
+	if err := d.Set("instance_ids", rule.InstanceIds); err != nil {
+		return err
+	}
+	if err := d.Set("real_servers", realServers); err != nil {
+		return err
+	}
+	if err := d.Set("proxy_types", proxyTypes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudDdoscooDomainResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// The ModifyWebRule replaces the real servers and the proxy types at once.
+	if d.HasChange("rs_type") || d.HasChange("real_servers") || d.HasChange("proxy_types") {
+		proxyTypes, err := json.Marshal(expandDdoscooProxyTypes(d.Get("proxy_types").(*schema.Set)))
+		if err != nil {
+			return err
+		}
+		args := DdoscooWebRuleArgs{
+			Domain:      d.Id(),
+			RsType:      d.Get("rs_type").(int),
+			ProxyTypes:  string(proxyTypes),
+			RealServers: expandStringList(d.Get("real_servers").([]interface{})),
+		}
+		if err := client.ddoscooconn().Invoke("ModifyWebRule", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyWebRule", d.Id())
+		}
+	}
+
+	return resourceAlicloudDdoscooDomainResourceRead(d, meta)
+}
+
+func resourceAlicloudDdoscooDomainResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := DdoscooWebRuleArgs{
+		Domain: d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.ddoscooconn().Invoke("DeleteWebRule", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeDdoscooWebRule(d.Id()); e != nil && NotFoundError(e, "ddoscoo") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteWebRule", d.Id()))
+		}
+
+		if _, err := client.DescribeDdoscooWebRule(d.Id()); err != nil {
+			if NotFoundError(err, "ddoscoo") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Anti-DDoS Pro domain resource %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func expandDdoscooProxyTypes(set *schema.Set) []DdoscooWebRuleProxyType {
+	var proxyTypes []DdoscooWebRuleProxyType
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		proxyType := DdoscooWebRuleProxyType{ProxyType: m["proxy_type"].(string)}
+		for _, port := range m["proxy_ports"].([]interface{}) {
+			proxyType.ProxyPorts = append(proxyType.ProxyPorts, port.(int))
+		}
+		proxyTypes = append(proxyTypes, proxyType)
+	}
+	return proxyTypes
+}
@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDdoscooDomainResource_basic(t *testing.T) {
+	var v DdoscooWebRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithDdoscooInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ddoscoo_domain_resource.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDdoscooDomainResourceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDdoscooDomainResourceConfig("177.167.32.11"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdoscooDomainResourceExists(
+						"alicloud_ddoscoo_domain_resource.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_domain_resource.foo",
+						"real_servers.0",
+						"177.167.32.11"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_domain_resource.foo",
+						"proxy_types.#",
+						"1"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_ddoscoo_domain_resource.foo",
+						"cname"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDdoscooDomainResourceConfig("177.167.32.12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdoscooDomainResourceExists(
+						"alicloud_ddoscoo_domain_resource.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_domain_resource.foo",
+						"real_servers.0",
+						"177.167.32.12"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDdoscooDomainResourceExists(n string, d *DdoscooWebRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Anti-DDoS Pro domain resource ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		rule, err := client.DescribeDdoscooWebRule(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *rule
+		return nil
+	}
+}
+
+func testAccCheckDdoscooDomainResourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ddoscoo_domain_resource" {
+			continue
+		}
+
+		if _, err := client.DescribeDdoscooWebRule(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "ddoscoo") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Anti-DDoS Pro domain resource %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDdoscooDomainResourceConfig(realServer string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ddoscoo_domain_resource" "foo" {
+  domain = "tf-testaccddoscoo.example.com"
+  instance_ids = ["%s"]
+  rs_type = 0
+  real_servers = ["%s"]
+  proxy_types {
+    proxy_type = "http"
+    proxy_ports = [80]
+  }
+}
+`, os.Getenv("ALICLOUD_DDOSCOO_INSTANCE_ID"), realServer)
+}
@@ -0,0 +1,239 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The specification arguments of the instance and their parameter codes of the BSS order.
+var ddoscooInstanceSpecParameters = map[string]string{
+	"base_bandwidth":    "BaseBandwidth",
+	"bandwidth":         "Bandwidth",
+	"service_bandwidth": "ServiceBandwidth",
+	"port_count":        "PortCount",
+	"domain_count":      "DomainCount",
+}
+
+func resourceAlicloudDdoscooInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDdoscooInstanceCreate,
+		Read:   resourceAlicloudDdoscooInstanceRead,
+		Update: resourceAlicloudDdoscooInstanceUpdate,
+		Delete: resourceAlicloudDdoscooInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 63),
+			},
+			"base_bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"service_bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"port_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"domain_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 6, 12, 24, 36}),
+			},
+			"renewal_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ManualRenewal",
+				ValidateFunc: validateAllowedStringValue([]string{"AutoRenewal", "ManualRenewal", "NotRenewal"}),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDdoscooInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CreateBssInstanceArgs{
+		ProductCode:      "ddos",
+		ProductType:      ddoscooProductType(client),
+		SubscriptionType: "Subscription",
+		Period:           d.Get("period").(int),
+		RenewalStatus:    d.Get("renewal_status").(string),
+		Parameter:        buildDdoscooInstanceParameters(d),
+	}
+	if args.RenewalStatus == "AutoRenewal" {
+		args.RenewPeriod = args.Period
+	}
+
+	resp := CreateBssInstanceResponse{}
+	if err := client.bssconn().Invoke("CreateInstance", &args, &resp); err != nil {
+		return WrapError(err, "CreateInstance", d.Id())
+	}
+	if !resp.Success {
+		return fmt.Errorf("CreateInstance got an error: %s %s", resp.Code, resp.Message)
+	}
+
+	d.SetId(resp.Data.InstanceId)
+
+	if err := client.WaitForDdoscooInstance(d.Id(), DdoscooInstanceNormal, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForDdoscooInstance", d.Id())
+	}
+
+	return resourceAlicloudDdoscooInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudDdoscooInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	instance, err := client.DescribeDdoscooInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ddoscoo") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeInstances", d.Id())
+	}
+	spec, err := client.DescribeDdoscooInstanceSpec(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeInstanceSpecs", d.Id())
+	}
+
+	d.Set("name", instance.Remark)
+	d.Set("status", strconv.Itoa(instance.Status))
+	d.Set("base_bandwidth", spec.BaseBandwidth)
+	d.Set("bandwidth", spec.ElasticBandwidth)
+	d.Set("service_bandwidth", spec.BandwidthMbps)
+	d.Set("port_count", spec.PortLimit)
+	d.Set("domain_count", spec.DomainLimit)
+
+	return nil
+}
+
+func resourceAlicloudDdoscooInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		args := ModifyDdoscooInstanceRemarkArgs{
+			InstanceId: d.Id(),
+			Remark:     d.Get("name").(string),
+		}
+		if err := client.ddoscooconn().Invoke("ModifyInstanceRemark", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyInstanceRemark", d.Id())
+		}
+		d.SetPartial("name")
+	}
+
+	// The specification of a new instance is set by the order.
+	if !d.IsNewResource() {
+		modifyType, err := ddoscooInstanceModifyType(d)
+		if err != nil {
+			return err
+		}
+		if modifyType != "" {
+			args := ModifyBssInstanceArgs{
+				ProductCode:      "ddos",
+				ProductType:      ddoscooProductType(client),
+				SubscriptionType: "Subscription",
+				ModifyType:       modifyType,
+				InstanceId:       d.Id(),
+				Parameter:        buildDdoscooInstanceParameters(d),
+			}
+			resp := ModifyBssInstanceResponse{}
+			if err := client.bssconn().Invoke("ModifyInstance", &args, &resp); err != nil {
+				return WrapError(err, "ModifyInstance", d.Id())
+			}
+			if !resp.Success {
+				return fmt.Errorf("ModifyInstance got an error: %s %s", resp.Code, resp.Message)
+			}
+			for attribute := range ddoscooInstanceSpecParameters {
+				d.SetPartial(attribute)
+			}
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlicloudDdoscooInstanceRead(d, meta)
+}
+
+func resourceAlicloudDdoscooInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	// The subscription instance can not be released by API, and it is released automatically after it is expired.
+	log.Printf("[WARN] Anti-DDoS Pro instance %s can not be deleted and it is only removed from the state. "+
+		"It will be released automatically after it is expired.", d.Id())
+	return nil
+}
+
+func ddoscooProductType(client *AliyunClient) string {
+	if centralServiceRegion(client.Region) == "cn-hangzhou" {
+		return "ddoscoo"
+	}
+	return "ddoscoo_intl"
+}
+
+func buildDdoscooInstanceParameters(d *schema.ResourceData) []BssInstanceParameter {
+	parameters := []BssInstanceParameter{
+		{Code: "Edition", Value: "coop"},
+		{Code: "ServicePartner", Value: "coop-line-001"},
+		{Code: "FunctionVersion", Value: "0"},
+	}
+	for attribute, code := range ddoscooInstanceSpecParameters {
+		parameters = append(parameters, BssInstanceParameter{Code: code, Value: strconv.Itoa(d.Get(attribute).(int))})
+	}
+	return parameters
+}
+
+// ddoscooInstanceModifyType returns Upgrade when the specification is increased, Downgrade when it is decreased,
+// and an empty string when it is not changed. The specification can not be increased and decreased at once.
+func ddoscooInstanceModifyType(d *schema.ResourceData) (string, error) {
+	upgrade, downgrade := false, false
+	for attribute := range ddoscooInstanceSpecParameters {
+		o, n := d.GetChange(attribute)
+		if n.(int) > o.(int) {
+			upgrade = true
+		} else if n.(int) < o.(int) {
+			downgrade = true
+		}
+	}
+
+	switch {
+	case upgrade && downgrade:
+		return "", ConfigErrorf(ErrorCodeUnsupportedOperation, "The specification of Anti-DDoS Pro instance %s can not be increased and decreased at once.", d.Id())
+	case upgrade:
+		return "Upgrade", nil
+	case downgrade:
+		return "Downgrade", nil
+	}
+	return "", nil
+}
@@ -0,0 +1,98 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The subscription Anti-DDoS Pro instance can not be released by API, so there is no destroy check and
+// the instance is released automatically after it is expired.
+func TestAccAlicloudDdoscooInstance_basic(t *testing.T) {
+	var v DdoscooInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ddoscoo_instance.foo",
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDdoscooInstanceConfig("tf-testAccDdoscooInstance", 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdoscooInstanceExists(
+						"alicloud_ddoscoo_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_instance.foo",
+						"name",
+						"tf-testAccDdoscooInstance"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_instance.foo",
+						"port_count",
+						"50"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_instance.foo",
+						"status",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDdoscooInstanceConfig("tf-testAccDdoscooInstanceUpdate", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdoscooInstanceExists(
+						"alicloud_ddoscoo_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_instance.foo",
+						"name",
+						"tf-testAccDdoscooInstanceUpdate"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_instance.foo",
+						"port_count",
+						"60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDdoscooInstanceExists(n string, d *DdoscooInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Anti-DDoS Pro instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instance, err := client.DescribeDdoscooInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *instance
+		return nil
+	}
+}
+
+func testAccDdoscooInstanceConfig(name string, portCount int) string {
+	return fmt.Sprintf(`
+resource "alicloud_ddoscoo_instance" "foo" {
+  name = "%s"
+  base_bandwidth = 30
+  bandwidth = 30
+  service_bandwidth = 100
+  port_count = %d
+  domain_count = 50
+  period = 1
+}
+`, name, portCount)
+}
@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDdoscooPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDdoscooPortCreate,
+		Read:   resourceAlicloudDdoscooPortRead,
+		Update: resourceAlicloudDdoscooPortUpdate,
+		Delete: resourceAlicloudDdoscooPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"frontend_port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+			"backend_port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+			"frontend_protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(DdoscooPortProtocols),
+			},
+			"real_servers": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAlicloudDdoscooPortCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildDdoscooPortArgs(d.Get("instance_id").(string), d.Get("frontend_port").(int), d.Get("frontend_protocol").(string), d)
+	if err := client.ddoscooconn().Invoke("CreatePort", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreatePort", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", args.InstanceId, COLON_SEPARATED, args.FrontendPort, COLON_SEPARATED, args.FrontendProtocol))
+
+	return resourceAlicloudDdoscooPortRead(d, meta)
+}
+
+func resourceAlicloudDdoscooPortRead(d *schema.ResourceData, meta interface{}) error {
+	instanceId, frontendPort, frontendProtocol, err := parseDdoscooPortId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	port, err := meta.(*AliyunClient).DescribeDdoscooPort(instanceId, frontendPort, frontendProtocol)
+	if err != nil {
+		if NotFoundError(err, "ddoscoo") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribePort", d.Id())
+	}
+
+	d.Set("instance_id", instanceId)
+	d.Set("frontend_port", port.FrontendPort)
+	d.Set("backend_port", port.BackendPort)
+	d.Set("frontend_protocol", port.FrontendProtocol)
+	if err := d.Set("real_servers", port.RealServers); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudDdoscooPortUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	instanceId, frontendPort, frontendProtocol, err := parseDdoscooPortId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("real_servers") {
+		args := buildDdoscooPortArgs(instanceId, frontendPort, frontendProtocol, d)
+		if err := client.ddoscooconn().Invoke("ConfigPort", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ConfigPort", d.Id())
+		}
+	}
+
+	return resourceAlicloudDdoscooPortRead(d, meta)
+}
+
+func resourceAlicloudDdoscooPortDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	instanceId, frontendPort, frontendProtocol, err := parseDdoscooPortId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The DeletePort requires the backend port and the real servers of the rule.
+	args := buildDdoscooPortArgs(instanceId, frontendPort, frontendProtocol, d)
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.ddoscooconn().Invoke("DeletePort", &args, &common.Response{}); err != nil {
+			if _, e := client.DescribeDdoscooPort(instanceId, frontendPort, frontendProtocol); e != nil && NotFoundError(e, "ddoscoo") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeletePort", d.Id()))
+		}
+
+		if _, err := client.DescribeDdoscooPort(instanceId, frontendPort, frontendProtocol); err != nil {
+			if NotFoundError(err, "ddoscoo") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Anti-DDoS Pro port %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildDdoscooPortArgs(instanceId string, frontendPort int, frontendProtocol string, d *schema.ResourceData) DdoscooPortArgs {
+	args := DdoscooPortArgs{
+		InstanceId:       instanceId,
+		FrontendPort:     strconv.Itoa(frontendPort),
+		BackendPort:      strconv.Itoa(frontendPort),
+		FrontendProtocol: frontendProtocol,
+		RealServers:      expandStringList(d.Get("real_servers").([]interface{})),
+	}
+	// The backend port is the same as the frontend one by default.
+	if backendPort := d.Get("backend_port").(int); backendPort > 0 {
+		args.BackendPort = strconv.Itoa(backendPort)
+	}
+	return args
+}
+
+// parseDdoscooPortId parses the id of the port, which is <instance_id>:<frontend_port>:<frontend_protocol>.
+func parseDdoscooPortId(id string) (string, int, string, error) {
+	parts, err := parseResourceId(id, 3)
+	if err != nil {
+		return "", 0, "", err
+	}
+	port, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid frontend port %s of Anti-DDoS Pro port %s.", parts[1], id)
+	}
+	return parts[0], port, parts[2], nil
+}
@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDdoscooPort_basic(t *testing.T) {
+	var v DdoscooPort
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithDdoscooInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ddoscoo_port.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDdoscooPortDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDdoscooPortConfig("192.168.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdoscooPortExists(
+						"alicloud_ddoscoo_port.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_port.foo",
+						"frontend_port",
+						"7001"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_port.foo",
+						"backend_port",
+						"7002"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_port.foo",
+						"real_servers.0",
+						"192.168.0.1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDdoscooPortConfig("192.168.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdoscooPortExists(
+						"alicloud_ddoscoo_port.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ddoscoo_port.foo",
+						"real_servers.0",
+						"192.168.0.2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDdoscooPortExists(n string, d *DdoscooPort) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Anti-DDoS Pro port ID is set")
+		}
+
+		instanceId, frontendPort, frontendProtocol, err := parseDdoscooPortId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		port, err := client.DescribeDdoscooPort(instanceId, frontendPort, frontendProtocol)
+		if err != nil {
+			return err
+		}
+
+		*d = *port
+		return nil
+	}
+}
+
+func testAccCheckDdoscooPortDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ddoscoo_port" {
+			continue
+		}
+
+		instanceId, frontendPort, frontendProtocol, err := parseDdoscooPortId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeDdoscooPort(instanceId, frontendPort, frontendProtocol); err != nil {
+			if NotFoundError(err, "ddoscoo") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Anti-DDoS Pro port %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDdoscooPortConfig(realServer string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ddoscoo_port" "foo" {
+  instance_id = "%s"
+  frontend_port = 7001
+  backend_port = 7002
+  frontend_protocol = "tcp"
+  real_servers = ["%s"]
+}
+`, os.Getenv("ALICLOUD_DDOSCOO_INSTANCE_ID"), realServer)
+}
@@ -0,0 +1,101 @@
+package alicloud
+
+import (
+	"log"
+
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAlicloudDefaultSecurityGroup adopts the security group created by the system in the default VPC, or in
+// the vpc_id, into the state instead of creating a new one. The rules are kept as they are until the ingress or
+// egress ones are declared, and then the rules that are not declared are revoked, the same as
+// alicloud_security_group_rules. The description is not managed, as the default security group is identified by it.
+func resourceAlicloudDefaultSecurityGroup() *schema.Resource {
+	group := resourceAliyunSecurityGroup()
+	group.Schema["vpc_id"].Computed = true
+	group.Schema["name"].Computed = true
+	group.Schema["description"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	ingress := securityGroupRulesSchema()
+	ingress.Computed = true
+	group.Schema["ingress"] = ingress
+	egress := securityGroupRulesSchema()
+	egress.Computed = true
+	group.Schema["egress"] = egress
+
+	return &schema.Resource{
+		Create: resourceAlicloudDefaultSecurityGroupCreate,
+		Read:   resourceAlicloudDefaultSecurityGroupRead,
+		Update: resourceAlicloudDefaultSecurityGroupUpdate,
+		Delete: resourceAlicloudDefaultSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: group.Timeouts,
+
+		Schema: group.Schema,
+	}
+}
+
+func resourceAlicloudDefaultSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	vpcId := d.Get("vpc_id").(string)
+	if vpcId == "" {
+		id, err := client.DescribeDefaultVpcId()
+		if err != nil {
+			return WrapError(err, "DescribeVpcs", "")
+		}
+		vpcId = id
+	}
+
+	groupId, err := client.DescribeDefaultSecurityGroupId(vpcId)
+	if err != nil {
+		return WrapError(err, "DescribeSecurityGroups", vpcId)
+	}
+
+	d.SetId(groupId)
+
+	// The name of a new security group is not modified by its update.
+	if v, ok := d.GetOk("name"); ok {
+		args := &ecs.ModifySecurityGroupAttributeArgs{
+			SecurityGroupId:   groupId,
+			RegionId:          client.Region,
+			SecurityGroupName: v.(string),
+		}
+		if err := client.ecsconn().ModifySecurityGroupAttribute(args); err != nil {
+			return WrapError(err, "ModifySecurityGroupAttribute", groupId)
+		}
+	}
+
+	return resourceAlicloudDefaultSecurityGroupUpdate(d, meta)
+}
+
+func resourceAlicloudDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAliyunSecurityGroupRead(d, meta); err != nil || d.Id() == "" {
+		return err
+	}
+
+	return setSecurityGroupRules(meta.(*AliyunClient), d, d.Get("vpc_id").(string))
+}
+
+func resourceAlicloudDefaultSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAliyunSecurityGroupUpdate(d, meta); err != nil {
+		return err
+	}
+
+	if err := updateSecurityGroupRules(meta.(*AliyunClient), d); err != nil {
+		return err
+	}
+
+	return resourceAlicloudDefaultSecurityGroupRead(d, meta)
+}
+
+func resourceAlicloudDefaultSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] The default security group %s is not deleted and it is only removed from the state.", d.Id())
+	return nil
+}
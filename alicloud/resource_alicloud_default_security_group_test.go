@@ -0,0 +1,44 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDefaultSecurityGroup_basic(t *testing.T) {
+	var sg ecs.DescribeSecurityGroupAttributeResponse
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithDefaultVpc(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_default_security_group.default",
+		Providers:     testAccProviders,
+		// The default security group is only removed from the state, so there is no destroy check, and its rules
+		// are not declared as the undeclared ones would be revoked.
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDefaultSecurityGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists("alicloud_default_security_group.default", &sg),
+					resource.TestCheckResourceAttrSet("alicloud_default_security_group.default", "vpc_id"),
+					resource.TestCheckResourceAttr("alicloud_default_security_group.default", "description",
+						DefaultSecurityGroupDescription),
+				),
+			},
+		},
+	})
+}
+
+const testAccDefaultSecurityGroupConfig = `
+resource "alicloud_default_vpc" "default" {
+}
+
+resource "alicloud_default_security_group" "default" {
+  vpc_id = "${alicloud_default_vpc.default.id}"
+}
+`
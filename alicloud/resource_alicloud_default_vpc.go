@@ -0,0 +1,48 @@
+package alicloud
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAlicloudDefaultVpc adopts the default VPC of the region into the state instead of creating a new one. It
+// shares the schema of alicloud_vpc, except that the cidr_block is computed.
+func resourceAlicloudDefaultVpc() *schema.Resource {
+	vpc := resourceAliyunVpc()
+	vpc.Schema["cidr_block"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	vpc.Schema["name"].Computed = true
+	vpc.Schema["description"].Computed = true
+
+	return &schema.Resource{
+		Create: resourceAlicloudDefaultVpcCreate,
+		Read:   resourceAliyunVpcRead,
+		Update: resourceAliyunVpcUpdate,
+		Delete: resourceAlicloudDefaultVpcDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: vpc.Timeouts,
+
+		Schema: vpc.Schema,
+	}
+}
+
+func resourceAlicloudDefaultVpcCreate(d *schema.ResourceData, meta interface{}) error {
+	vpcId, err := meta.(*AliyunClient).DescribeDefaultVpcId()
+	if err != nil {
+		return WrapError(err, "DescribeVpcs", "")
+	}
+
+	d.SetId(vpcId)
+
+	return resourceAliyunVpcUpdate(d, meta)
+}
+
+func resourceAlicloudDefaultVpcDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] The default VPC %s is not deleted and it is only removed from the state.", d.Id())
+	return nil
+}
@@ -0,0 +1,39 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDefaultVpc_basic(t *testing.T) {
+	var vpc ecs.VpcSetType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithDefaultVpc(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_default_vpc.default",
+		Providers:     testAccProviders,
+		// The default VPC is only removed from the state, so there is no destroy check, and it is adopted
+		// without changes as it is shared by the other tests in the region.
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDefaultVpcConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("alicloud_default_vpc.default", &vpc),
+					resource.TestCheckResourceAttrSet("alicloud_default_vpc.default", "cidr_block"),
+					resource.TestCheckResourceAttrSet("alicloud_default_vpc.default", "router_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDefaultVpcConfig = `
+resource "alicloud_default_vpc" "default" {
+}
+`
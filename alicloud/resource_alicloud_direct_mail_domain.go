@@ -0,0 +1,139 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDirectMailDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDirectMailDomainCreate,
+		Read:   resourceAlicloudDirectMailDomainRead,
+		Delete: resourceAlicloudDirectMailDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The status is "0" after the domain is verified by its DNS records.
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// The following are the DNS records to verify the domain, which are added to the DNS of the
+			// domain_name, like by the alicloud_dns_record.
+			"ownership_record": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spf_record": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mx_record": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dkim_host_record": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dkim_public_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host_record": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"spf_auth_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mx_auth_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cname_auth_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dkim_auth_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDirectMailDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CreateDirectMailDomainArgs{
+		DomainName: d.Get("domain_name").(string),
+	}
+	resp := CreateDirectMailDomainResponse{}
+	if err := client.dmconn().Invoke("CreateDomain", &args, &resp); err != nil {
+		return WrapError(err, "CreateDomain", args.DomainName)
+	}
+
+	d.SetId(resp.DomainId.String())
+
+	return resourceAlicloudDirectMailDomainRead(d, meta)
+}
+
+func resourceAlicloudDirectMailDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	domain, err := client.DescribeDirectMailDomain(d.Id())
+	if err != nil {
+		if NotFoundError(err, "dm") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "QueryDomainByParam", d.Id())
+	}
+
+	d.Set("domain_name", domain.DomainName)
+	d.Set("status", domain.DomainStatus.String())
+	d.Set("ownership_record", domain.DomainRecord)
+	d.Set("spf_auth_status", domain.SpfAuthStatus.String())
+	d.Set("mx_auth_status", domain.MxAuthStatus.String())
+	d.Set("cname_auth_status", domain.CnameAuthStatus.String())
+
+	records, err := client.DescribeDirectMailDomainRecords(d.Id())
+	if err != nil {
+		return WrapError(err, "DescDomain", d.Id())
+	}
+	d.Set("spf_record", records.SpfRecord)
+	d.Set("mx_record", records.MxRecord)
+	d.Set("dkim_host_record", records.DkimRR)
+	d.Set("dkim_public_key", records.DkimPublicKey)
+	d.Set("host_record", records.HostRecord)
+	d.Set("dkim_auth_status", records.DkimAuthStatus.String())
+
+	return nil
+}
+
+func resourceAlicloudDirectMailDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := DirectMailDomainArgs{
+		DomainId: d.Id(),
+	}
+	if err := client.dmconn().Invoke("DeleteDomain", &args, &common.Response{}); err != nil {
+		if _, e := client.DescribeDirectMailDomain(d.Id()); NotFoundError(e, "dm") {
+			return nil
+		}
+		return WrapError(err, "DeleteDomain", d.Id())
+	}
+
+	return nil
+}
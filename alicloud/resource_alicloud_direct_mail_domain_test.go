@@ -0,0 +1,97 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDirectMailDomain_basic(t *testing.T) {
+	var v DirectMailDomain
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_direct_mail_domain.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDirectMailDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDirectMailDomainConfig(acctest.RandInt()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectMailDomainExists(
+						"alicloud_direct_mail_domain.foo", &v),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_direct_mail_domain.foo",
+						"status"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_direct_mail_domain.foo",
+						"ownership_record"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_direct_mail_domain.foo",
+						"spf_record"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_direct_mail_domain.foo",
+						"mx_record"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectMailDomainExists(n string, d *DirectMailDomain) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DirectMail domain ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		domain, err := client.DescribeDirectMailDomain(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *domain
+		return nil
+	}
+}
+
+func testAccCheckDirectMailDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_direct_mail_domain" {
+			continue
+		}
+
+		if _, err := client.DescribeDirectMailDomain(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "dm") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("DirectMail domain %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDirectMailDomainConfig(rand int) string {
+	return fmt.Sprintf(`
+resource "alicloud_direct_mail_domain" "foo" {
+  domain_name = "tf-testacc-%d.example.com"
+}
+`, rand)
+}
@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDirectMailMailAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDirectMailMailAddressCreate,
+		Read:   resourceAlicloudDirectMailMailAddressRead,
+		Update: resourceAlicloudDirectMailMailAddressUpdate,
+		Delete: resourceAlicloudDirectMailMailAddressDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// The account_name is the sender address, whose domain must be an alicloud_direct_mail_domain.
+			"account_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sendtype": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{DirectMailSendTypeBatch, DirectMailSendTypeTrigger}),
+			},
+			"reply_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// The password is the SMTP password of the sender address, which can not be read back.
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reply_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDirectMailMailAddressCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CreateDirectMailAddressArgs{
+		AccountName:  d.Get("account_name").(string),
+		Sendtype:     d.Get("sendtype").(string),
+		ReplyAddress: d.Get("reply_address").(string),
+	}
+	resp := CreateDirectMailAddressResponse{}
+	if err := client.dmconn().Invoke("CreateMailAddress", &args, &resp); err != nil {
+		return WrapError(err, "CreateMailAddress", args.AccountName)
+	}
+
+	d.SetId(resp.MailAddressId.String())
+
+	return resourceAlicloudDirectMailMailAddressUpdate(d, meta)
+}
+
+func resourceAlicloudDirectMailMailAddressRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	address, err := client.DescribeDirectMailAddress(d.Id())
+	if err != nil {
+		if NotFoundError(err, "dm") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "QueryMailAddressByParam", d.Id())
+	}
+
+	d.Set("account_name", address.AccountName)
+	d.Set("sendtype", address.Sendtype)
+	d.Set("reply_address", address.ReplyAddress)
+	d.Set("status", address.AccountStatus.String())
+	d.Set("reply_status", address.ReplyStatus.String())
+
+	return nil
+}
+
+func resourceAlicloudDirectMailMailAddressUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// The reply_address is set by the CreateMailAddress at the creation.
+	if (!d.IsNewResource() && d.HasChange("reply_address")) || d.HasChange("password") {
+		args := ModifyDirectMailAddressArgs{
+			MailAddressId: d.Id(),
+			ReplyAddress:  d.Get("reply_address").(string),
+			Password:      d.Get("password").(string),
+		}
+		if err := client.dmconn().Invoke("ModifyMailAddress", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyMailAddress", d.Id())
+		}
+	}
+
+	return resourceAlicloudDirectMailMailAddressRead(d, meta)
+}
+
+func resourceAlicloudDirectMailMailAddressDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := DirectMailAddressArgs{
+		MailAddressId: d.Id(),
+	}
+	if err := client.dmconn().Invoke("DeleteMailAddress", &args, &common.Response{}); err != nil {
+		if _, e := client.DescribeDirectMailAddress(d.Id()); NotFoundError(e, "dm") {
+			return nil
+		}
+		return WrapError(err, "DeleteMailAddress", d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDirectMailMailAddress_basic(t *testing.T) {
+	var v DirectMailAddress
+	domain := os.Getenv("ALICLOUD_DIRECT_MAIL_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithDirectMailDomain(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_direct_mail_mail_address.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDirectMailMailAddressDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDirectMailMailAddressConfig(domain, "reply"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectMailMailAddressExists(
+						"alicloud_direct_mail_mail_address.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_direct_mail_mail_address.foo",
+						"account_name",
+						fmt.Sprintf("tf-testacc@%s", domain)),
+					resource.TestCheckResourceAttr(
+						"alicloud_direct_mail_mail_address.foo",
+						"sendtype",
+						"batch"),
+					resource.TestCheckResourceAttr(
+						"alicloud_direct_mail_mail_address.foo",
+						"reply_address",
+						fmt.Sprintf("reply@%s", domain)),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDirectMailMailAddressConfig(domain, "reply-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectMailMailAddressExists(
+						"alicloud_direct_mail_mail_address.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_direct_mail_mail_address.foo",
+						"reply_address",
+						fmt.Sprintf("reply-update@%s", domain)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectMailMailAddressExists(n string, d *DirectMailAddress) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DirectMail mail address ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		address, err := client.DescribeDirectMailAddress(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *address
+		return nil
+	}
+}
+
+func testAccCheckDirectMailMailAddressDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_direct_mail_mail_address" {
+			continue
+		}
+
+		if _, err := client.DescribeDirectMailAddress(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "dm") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("DirectMail mail address %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDirectMailMailAddressConfig(domain, reply string) string {
+	return fmt.Sprintf(`
+resource "alicloud_direct_mail_mail_address" "foo" {
+  account_name = "tf-testacc@%s"
+  sendtype = "batch"
+  reply_address = "%s@%s"
+}
+`, domain, reply, domain)
+}
@@ -0,0 +1,95 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDirectMailReceivers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDirectMailReceiversCreate,
+		Read:   resourceAlicloudDirectMailReceiversRead,
+		Delete: resourceAlicloudDirectMailReceiversDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"receivers_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The receivers_alias is the address of the receiver list, like list@example.com, which the batch
+			// mails are sent to.
+			"receivers_alias": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDirectMailReceiversCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CreateDirectMailReceiversArgs{
+		ReceiversName:  d.Get("receivers_name").(string),
+		ReceiversAlias: d.Get("receivers_alias").(string),
+		Desc:           d.Get("description").(string),
+	}
+	resp := CreateDirectMailReceiversResponse{}
+	if err := client.dmconn().Invoke("CreateReceiver", &args, &resp); err != nil {
+		return WrapError(err, "CreateReceiver", args.ReceiversName)
+	}
+
+	d.SetId(resp.ReceiverId.String())
+
+	return resourceAlicloudDirectMailReceiversRead(d, meta)
+}
+
+func resourceAlicloudDirectMailReceiversRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	receivers, err := client.DescribeDirectMailReceivers(d.Id())
+	if err != nil {
+		if NotFoundError(err, "dm") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "QueryReceiverByParam", d.Id())
+	}
+
+	d.Set("receivers_name", receivers.ReceiversName)
+	d.Set("receivers_alias", receivers.ReceiversAlias)
+	d.Set("description", receivers.Desc)
+	d.Set("status", receivers.ReceiversStatus.String())
+
+	return nil
+}
+
+func resourceAlicloudDirectMailReceiversDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := DirectMailReceiversArgs{
+		ReceiverId: d.Id(),
+	}
+	if err := client.dmconn().Invoke("DeleteReceiver", &args, &common.Response{}); err != nil {
+		if _, e := client.DescribeDirectMailReceivers(d.Id()); NotFoundError(e, "dm") {
+			return nil
+		}
+		return WrapError(err, "DeleteReceiver", d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDirectMailReceivers_basic(t *testing.T) {
+	var v DirectMailReceivers
+	domain := os.Getenv("ALICLOUD_DIRECT_MAIL_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithDirectMailDomain(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_direct_mail_receivers.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDirectMailReceiversDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDirectMailReceiversConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectMailReceiversExists(
+						"alicloud_direct_mail_receivers.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_direct_mail_receivers.foo",
+						"receivers_name",
+						"tf-testAccDirectMailReceivers"),
+					resource.TestCheckResourceAttr(
+						"alicloud_direct_mail_receivers.foo",
+						"receivers_alias",
+						fmt.Sprintf("tf-testacc-list@%s", domain)),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_direct_mail_receivers.foo",
+						"status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectMailReceiversExists(n string, d *DirectMailReceivers) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DirectMail receiver list ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		receivers, err := client.DescribeDirectMailReceivers(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *receivers
+		return nil
+	}
+}
+
+func testAccCheckDirectMailReceiversDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_direct_mail_receivers" {
+			continue
+		}
+
+		if _, err := client.DescribeDirectMailReceivers(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "dm") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("DirectMail receiver list %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDirectMailReceiversConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "alicloud_direct_mail_receivers" "foo" {
+  receivers_name = "tf-testAccDirectMailReceivers"
+  receivers_alias = "tf-testacc-list@%s"
+  description = "tf-testAccDirectMailReceivers"
+}
+`, domain)
+}
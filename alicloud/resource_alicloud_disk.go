@@ -21,6 +21,12 @@ func resourceAliyunDisk() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": &schema.Schema{
 				Type:     schema.TypeString,
@@ -47,14 +53,26 @@ func resourceAliyunDisk() *schema.Resource {
 				Default:      "cloud_efficiency",
 			},
 
+			// The size of the disk created from a snapshot is the size of the snapshot by default. It can only be
+			// increased, and the disk is resized online without being detached when it is in use.
 			"size": &schema.Schema{
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
 			},
 
 			"snapshot_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				ForceNew: true,
+			},
+
+			// The performance_level is only valid for the cloud_essd disks, and it is modified online.
+			"performance_level": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(DiskPerformanceLevels),
 			},
 
 			"status": &schema.Schema{
@@ -69,17 +87,17 @@ func resourceAliyunDisk() *schema.Resource {
 
 func resourceAliyunDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-
-	conn := client.ecsconn
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
 
 	availabilityZone, err := client.DescribeZone(d.Get("availability_zone").(string))
 	if err != nil {
 		return err
 	}
 
-	args := &ecs.CreateDiskArgs{
-		RegionId: getRegion(d, meta),
-		ZoneId:   availabilityZone.ZoneId,
+	args := &CreateEcsDiskArgs{
+		RegionId:    getRegion(d, meta),
+		ZoneId:      availabilityZone.ZoneId,
+		ClientToken: buildClientToken("tf-disk-"),
 	}
 
 	if v, ok := d.GetOk("category"); ok && v.(string) != "" {
@@ -92,17 +110,10 @@ func resourceAliyunDiskCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("size"); ok {
 		size := v.(int)
-		if args.DiskCategory == ecs.DiskCategoryCloud && (size < 5 || size > 2000) {
-			return fmt.Errorf("the size of cloud disk must between 5 to 2000")
-		}
-
-		if (args.DiskCategory == ecs.DiskCategoryCloudEfficiency ||
-			args.DiskCategory == ecs.DiskCategoryCloudSSD) && (size < 20 || size > 32768) {
-			return fmt.Errorf("the size of %s disk must between 20 to 32768", args.DiskCategory)
+		if err := validateDiskSize(args.DiskCategory, size); err != nil {
+			return err
 		}
 		args.Size = size
-
-		d.Set("size", args.Size)
 	}
 
 	if v, ok := d.GetOk("snapshot_id"); ok && v.(string) != "" {
@@ -110,7 +121,14 @@ func resourceAliyunDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if args.Size <= 0 && args.SnapshotId == "" {
-		return fmt.Errorf("One of size or snapshot_id is required when specifying an ECS disk.")
+		return ConfigErrorf(ErrorCodeMissingArgument, "One of size or snapshot_id is required when specifying an ECS disk.")
+	}
+
+	if v, ok := d.GetOk("performance_level"); ok && v.(string) != "" {
+		if args.DiskCategory != DiskCategoryCloudESSD {
+			return ConfigErrorf(ErrorCodeInvalidArgument, "performance_level is only valid when the category is %s.", DiskCategoryCloudESSD)
+		}
+		args.PerformanceLevel = v.(string)
 	}
 
 	if v, ok := d.GetOk("name"); ok && v.(string) != "" {
@@ -121,39 +139,36 @@ func resourceAliyunDiskCreate(d *schema.ResourceData, meta interface{}) error {
 		args.Description = v.(string)
 	}
 
-	diskID, err := conn.CreateDisk(args)
-	if err != nil {
-		return fmt.Errorf("CreateDisk got a error: %#v", err)
+	resp := CreateEcsDiskResponse{}
+	if err := client.ecsconn().Invoke("CreateDisk", args, &resp); err != nil {
+		return WrapError(err, "CreateDisk", d.Id())
 	}
 
-	d.SetId(diskID)
+	d.SetId(resp.DiskId)
+
+	// The disk created from a snapshot keeps creating while the data of the snapshot is loaded.
+	err = client.WaitForEcsDisk(d.Id(), string(ecs.DiskStatusAvailable), func(disk *EcsDisk) bool {
+		return disk.Status == ecs.DiskStatusAvailable
+	}, deadline.Remaining())
+	if err != nil {
+		return err
+	}
 
 	return resourceAliyunDiskUpdate(d, meta)
 }
 
 func resourceAliyunDiskRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
-
-	disks, _, err := conn.DescribeDisks(&ecs.DescribeDisksArgs{
-		RegionId: getRegion(d, meta),
-		DiskIds:  []string{d.Id()},
-	})
+	client := meta.(*AliyunClient)
 
+	disk, err := client.DescribeEcsDisk(d.Id())
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "ecs") {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error DescribeDiskAttribute: %#v", err)
+		return WrapError(err, "DescribeDisks", d.Id())
 	}
 
-	log.Printf("[DEBUG] DescribeDiskAttribute for instance: %#v", disks)
-
-	if disks == nil || len(disks) <= 0 {
-		return fmt.Errorf("No disks found.")
-	}
-
-	disk := disks[0]
 	d.Set("availability_zone", disk.ZoneId)
 	d.Set("category", disk.Category)
 	d.Set("size", disk.Size)
@@ -161,8 +176,9 @@ func resourceAliyunDiskRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", disk.DiskName)
 	d.Set("description", disk.Description)
 	d.Set("snapshot_id", disk.SourceSnapshotId)
+	d.Set("performance_level", disk.PerformanceLevel)
 
-	tags, _, err := conn.DescribeTags(&ecs.DescribeTagsArgs{
+	tags, _, err := client.ecsconn().DescribeTags(&ecs.DescribeTagsArgs{
 		RegionId:     getRegion(d, meta),
 		ResourceType: ecs.TagResourceDisk,
 		ResourceId:   d.Id(),
@@ -172,14 +188,17 @@ func resourceAliyunDiskRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] DescribeTags for disk got error: %#v", err)
 	}
 
-	d.Set("tags", tagsToMap(tags))
+	if err := d.Set("tags", ignoreDefaultTags(client, d, tagsToMap(tags))); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 func resourceAliyunDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	conn := client.ecsconn()
 
 	d.Partial(true)
 
@@ -215,19 +234,93 @@ func resourceAliyunDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("size") && !d.IsNewResource() {
+		if err := resizeDisk(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("size")
+	}
+
+	if d.HasChange("performance_level") && !d.IsNewResource() {
+		level := d.Get("performance_level").(string)
+		args := ModifyEcsDiskSpecArgs{
+			DiskId:           d.Id(),
+			PerformanceLevel: level,
+		}
+		if err := conn.Invoke("ModifyDiskSpec", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyDiskSpec", d.Id())
+		}
+
+		err := client.WaitForEcsDisk(d.Id(), level, func(disk *EcsDisk) bool {
+			return disk.PerformanceLevel == level
+		}, deadline.Remaining())
+		if err != nil {
+			return err
+		}
+		d.SetPartial("performance_level")
+	}
+
 	d.Partial(false)
 
 	return resourceAliyunDiskRead(d, meta)
 }
 
+// resizeDisk increases the size of the disk. The disk in use is resized online, so that the new size takes effect
+// without detaching it or restarting its instance, and the available one is resized offline.
+func resizeDisk(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	o, n := d.GetChange("size")
+	oldSize, newSize := o.(int), n.(int)
+	if newSize < oldSize {
+		return ConfigErrorf(ErrorCodeInvalidArgument, "The size of disk %s can not be decreased from %d to %d.", d.Id(), oldSize, newSize)
+	}
+	if err := validateDiskSize(ecs.DiskCategory(d.Get("category").(string)), newSize); err != nil {
+		return err
+	}
+
+	disk, err := client.DescribeEcsDisk(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeDisks", d.Id())
+	}
+
+	args := ResizeEcsDiskArgs{
+		DiskId:  d.Id(),
+		NewSize: newSize,
+		Type:    DiskResizeOffline,
+	}
+	if disk.Status == ecs.DiskStatusInUse {
+		args.Type = DiskResizeOnline
+	}
+	if err := client.ecsconn().Invoke("ResizeDisk", &args, &common.Response{}); err != nil {
+		return WrapError(err, "ResizeDisk", d.Id())
+	}
+
+	return client.WaitForEcsDisk(d.Id(), fmt.Sprintf("resized to %dGiB", newSize), func(disk *EcsDisk) bool {
+		return disk.Size == newSize
+	}, d.Timeout(schema.TimeoutUpdate))
+}
+
+func validateDiskSize(category ecs.DiskCategory, size int) error {
+	if category == ecs.DiskCategoryCloud && (size < 5 || size > 2000) {
+		return ConfigErrorf(ErrorCodeInvalidArgument, "the size of cloud disk must between 5 to 2000")
+	}
+
+	if (category == ecs.DiskCategoryCloudEfficiency || category == ecs.DiskCategoryCloudSSD ||
+		category == DiskCategoryCloudESSD) && (size < 20 || size > 32768) {
+		return ConfigErrorf(ErrorCodeInvalidArgument, "the size of %s disk must between 20 to 32768", category)
+	}
+	return nil
+}
+
 func resourceAliyunDiskDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DeleteDisk(d.Id())
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == DiskIncorrectStatus || e.ErrorResponse.Code == DiskCreatingSnapshot {
+			if IsExceptedError(err, DiskIncorrectStatus) || IsExceptedError(err, DiskCreatingSnapshot) {
 				return resource.RetryableError(fmt.Errorf("Disk in use - trying again while it is deleted."))
 			}
 		}
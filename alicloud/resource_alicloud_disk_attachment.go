@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,6 +16,14 @@ func resourceAliyunDiskAttachment() *schema.Resource {
 		Create: resourceAliyunDiskAttachmentCreate,
 		Read:   resourceAliyunDiskAttachmentRead,
 		Delete: resourceAliyunDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
@@ -59,7 +66,7 @@ func resourceAliyunDiskAttachmentRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 	disks, _, err := conn.DescribeDisks(&ecs.DescribeDisksArgs{
 		RegionId:   getRegion(d, meta),
 		InstanceId: instanceId,
@@ -67,7 +74,7 @@ func resourceAliyunDiskAttachmentRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "ecs") {
 			d.SetId("")
 			return nil
 		}
@@ -88,17 +95,17 @@ func resourceAliyunDiskAttachmentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAliyunDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 	diskID, instanceID, err := getDiskIDAndInstanceID(d, meta)
 	if err != nil {
 		return err
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DetachDisk(instanceID, diskID)
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == DiskIncorrectStatus || e.ErrorResponse.Code == InstanceLockedForSecurity {
+			if IsExceptedError(err, DiskIncorrectStatus) || IsExceptedError(err, InstanceLockedForSecurity) {
 				return resource.RetryableError(fmt.Errorf("Disk in use - trying again while it detaches"))
 			}
 		}
@@ -126,12 +133,12 @@ func getDiskIDAndInstanceID(d *schema.ResourceData, meta interface{}) (string, s
 	parts := strings.Split(d.Id(), ":")
 
 	if len(parts) != 2 {
-		return "", "", fmt.Errorf("invalid resource id")
+		return "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "invalid resource id")
 	}
 	return parts[0], parts[1], nil
 }
 func diskAttachment(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	diskID := d.Get("disk_id").(string)
 	instanceID := d.Get("instance_id").(string)
@@ -141,14 +148,11 @@ func diskAttachment(d *schema.ResourceData, meta interface{}) error {
 		DiskId:     diskID,
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		err := conn.AttachDisk(args)
-		log.Printf("error : %s", err)
-
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == DiskIncorrectStatus || e.ErrorResponse.Code == InstanceIncorrectStatus ||
-				e.ErrorResponse.Code == DiskOperationConflict {
+			if IsExceptedError(err, DiskIncorrectStatus) || IsExceptedError(err, InstanceIncorrectStatus) ||
+				IsExceptedError(err, DiskOperationConflict) {
 				return resource.RetryableError(fmt.Errorf("Disk or Instance status is incorrect - trying again while it attaches"))
 			}
 			return resource.NonRetryableError(err)
@@ -105,7 +105,7 @@ func testAccCheckDiskAttachmentExists(n string, instance *ecs.InstanceAttributes
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		request := &ecs.DescribeDisksArgs{
 			RegionId: client.Region,
@@ -142,7 +142,7 @@ func testAccCheckDiskAttachmentDestroy(s *terraform.State) error {
 		}
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		request := &ecs.DescribeDisksArgs{
 			RegionId: client.Region,
@@ -4,12 +4,62 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
+	"os"
 )
 
+func init() {
+	addTestSweepers("alicloud_disk", &testSweeper{
+		Dependencies: []string{
+			"alicloud_instance",
+		},
+		F: testSweepDisks,
+	})
+}
+
+func testSweepDisks(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.ecsconn()
+
+	var ids []string
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		disks, _, err := conn.DescribeDisks(&ecs.DescribeDisksArgs{
+			RegionId:   client.Region,
+			DiskType:   ecs.DiskTypeAllData,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeDisks", "")
+		}
+		for _, disk := range disks {
+			if !isSweepable(disk.DiskName) {
+				log.Printf("[INFO] Skipping disk %s (%s)", disk.DiskName, disk.DiskId)
+				continue
+			}
+			ids = append(ids, disk.DiskId)
+		}
+		return len(disks), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting disk %s", id)
+		if err := conn.DeleteDisk(id); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteDisk", id))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudDisk_basic(t *testing.T) {
 	var v ecs.DiskItemType
 
@@ -72,6 +122,66 @@ func TestAccAlicloudDisk_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudDisk_resize(t *testing.T) {
+	var v ecs.DiskItemType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_disk.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDiskConfigEssd(40, "PL1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists("alicloud_disk.foo", &v),
+					resource.TestCheckResourceAttr("alicloud_disk.foo", "size", "40"),
+					resource.TestCheckResourceAttr("alicloud_disk.foo", "performance_level", "PL1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDiskConfigEssd(60, "PL2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists("alicloud_disk.foo", &v),
+					resource.TestCheckResourceAttr("alicloud_disk.foo", "size", "60"),
+					resource.TestCheckResourceAttr("alicloud_disk.foo", "performance_level", "PL2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudDisk_snapshot(t *testing.T) {
+	var v ecs.DiskItemType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithSnapshot(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_disk.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDiskConfigSnapshot(os.Getenv("ALICLOUD_SNAPSHOT_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists("alicloud_disk.foo", &v),
+					resource.TestCheckResourceAttr("alicloud_disk.foo", "snapshot_id", os.Getenv("ALICLOUD_SNAPSHOT_ID")),
+					resource.TestCheckResourceAttrSet("alicloud_disk.foo", "size"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDiskExists(n string, disk *ecs.DiskItemType) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -84,7 +194,7 @@ func testAccCheckDiskExists(n string, disk *ecs.DiskItemType) resource.TestCheck
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		request := &ecs.DescribeDisksArgs{
 			RegionId: client.Region,
@@ -113,7 +223,7 @@ func testAccCheckDiskDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		request := &ecs.DescribeDisksArgs{
 			RegionId: client.Region,
@@ -164,3 +274,34 @@ resource "alicloud_disk" "bar" {
         }
 }
 `
+
+func testAccDiskConfigEssd(size int, level string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_essd"
+}
+
+resource "alicloud_disk" "foo" {
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	name = "tf-testAccDiskResize"
+	category = "cloud_essd"
+	size = %d
+	performance_level = "%s"
+}
+`, size, level)
+}
+
+func testAccDiskConfigSnapshot(snapshotId string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+}
+
+resource "alicloud_disk" "foo" {
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	name = "tf-testAccDiskSnapshot"
+	category = "cloud_efficiency"
+	snapshot_id = "%s"
+}
+`, snapshotId)
+}
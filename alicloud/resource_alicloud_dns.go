@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/dns"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +19,10 @@ func resourceAlicloudDns() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -42,7 +45,7 @@ func resourceAlicloudDns() *schema.Resource {
 }
 
 func resourceAlicloudDnsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.AddDomainArgs{
 		DomainName: d.Get("name").(string),
@@ -50,7 +53,7 @@ func resourceAlicloudDnsCreate(d *schema.ResourceData, meta interface{}) error {
 
 	response, err := conn.AddDomain(args)
 	if err != nil {
-		return fmt.Errorf("AddDomain got an error: %#v", err)
+		return WrapError(err, "AddDomain", d.Id())
 	}
 
 	d.SetId(response.DomainName)
@@ -58,7 +61,7 @@ func resourceAlicloudDnsCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAlicloudDnsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	d.Partial(true)
 
@@ -72,7 +75,7 @@ func resourceAlicloudDnsUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, err := conn.ChangeDomainGroup(args)
 		if err != nil {
-			return fmt.Errorf("ChangeDomainGroup got an error: %#v", err)
+			return WrapError(err, "ChangeDomainGroup", d.Id())
 		}
 	}
 
@@ -81,7 +84,7 @@ func resourceAlicloudDnsUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAlicloudDnsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainInfoArgs{
 		DomainName: d.Id(),
@@ -89,31 +92,33 @@ func resourceAlicloudDnsRead(d *schema.ResourceData, meta interface{}) error {
 
 	domain, err := conn.DescribeDomainInfo(args)
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "dns") {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("DescribeDomainInfo got an error: %#v", err)
+		return WrapError(err, "DescribeDomainInfo", d.Id())
 	}
 
 	d.Set("group_id", domain.GroupId)
 	d.Set("name", domain.DomainName)
-	d.Set("dns_server", domain.DnsServers.DnsServer)
+	if err := d.Set("dns_server", domain.DnsServers.DnsServer); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceAlicloudDnsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := &dns.DeleteDomainArgs{
 		DomainName: d.Id(),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		_, err := conn.DeleteDomain(args)
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == RecordForbiddenDNSChange {
+			if IsExceptedError(err, RecordForbiddenDNSChange) {
 				return resource.RetryableError(fmt.Errorf("Operation forbidden because DNS is changing - trying again after change complete."))
 			}
 			return resource.NonRetryableError(fmt.Errorf("Error deleting domain %s: %#v", d.Id(), err))
@@ -16,6 +16,13 @@ func resourceAlicloudDnsGroup() *schema.Resource {
 		Read:   resourceAlicloudDnsGroupRead,
 		Update: resourceAlicloudDnsGroupUpdate,
 		Delete: resourceAlicloudDnsGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -27,14 +34,14 @@ func resourceAlicloudDnsGroup() *schema.Resource {
 }
 
 func resourceAlicloudDnsGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 	args := &dns.AddDomainGroupArgs{
 		GroupName: d.Get("name").(string),
 	}
 
 	response, err := conn.AddDomainGroup(args)
 	if err != nil {
-		return fmt.Errorf("AddDomainGroup got a error: %#v", err)
+		return WrapError(err, "AddDomainGroup", d.Id())
 	}
 
 	d.SetId(response.GroupId)
@@ -43,7 +50,7 @@ func resourceAlicloudDnsGroupCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudDnsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	d.Partial(true)
 	args := &dns.UpdateDomainGroupArgs{
@@ -54,7 +61,7 @@ func resourceAlicloudDnsGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		d.SetPartial("name")
 		args.GroupName = d.Get("name").(string)
 		if _, err := conn.UpdateDomainGroup(args); err != nil {
-			return fmt.Errorf("UpdateDomainGroup got an error: %#v", err)
+			return WrapError(err, "UpdateDomainGroup", d.Id())
 		}
 	}
 
@@ -63,43 +70,49 @@ func resourceAlicloudDnsGroupUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudDnsGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
+	// The name is empty when the group is imported, so all the groups are described and matched by the id.
 	args := &dns.DescribeDomainGroupsArgs{
 		KeyWord: d.Get("name").(string),
 	}
 
-	groups, err := conn.DescribeDomainGroups(args)
+	var group *dns.DomainGroupType
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		groups, err := conn.DescribeDomainGroups(args)
+		for i, v := range groups {
+			if v.GroupId == d.Id() {
+				group = &groups[i]
+			}
+		}
+		return len(groups), err
+	})
 	if err != nil {
 		return err
 	}
 
-	if groups == nil || len(groups) <= 0 {
-		return fmt.Errorf("No domain groups found.")
-	}
-	for _, v := range groups {
-		if v.GroupName == d.Get("name").(string) {
-			d.Set("name", v.GroupName)
-			return nil
-		}
+	if group == nil {
+		d.SetId("")
+		return nil
 	}
 
-	d.SetId("")
+	d.Set("name", group.GroupName)
 	return nil
 }
 
 func resourceAlicloudDnsGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := &dns.DeleteDomainGroupArgs{
 		GroupId: d.Id(),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		_, err := conn.DeleteDomainGroup(args)
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == FobiddenNotEmptyGroup {
+			if IsExceptedError(err, FobiddenNotEmptyGroup) {
 				return resource.RetryableError(fmt.Errorf("The domain group can’t be deleted because it is not empty - trying again after it empty."))
 			}
 			return resource.NonRetryableError(fmt.Errorf("Error deleting group %s: %#v", d.Id(), err))
@@ -0,0 +1,234 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDnsGtmAccessStrategy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDnsGtmAccessStrategyCreate,
+		Read:   resourceAlicloudDnsGtmAccessStrategyRead,
+		Update: resourceAlicloudDnsGtmAccessStrategyUpdate,
+		Delete: resourceAlicloudDnsGtmAccessStrategyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"strategy_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"strategy_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      DnsGtmStrategyModeGeo,
+				ValidateFunc: validateAllowedStringValue([]string{DnsGtmStrategyModeGeo, DnsGtmStrategyModeLatency}),
+			},
+			// The lines are the codes of the lines the strategy serves, like default and cn_region_huadong, and
+			// they are only used by the GEO strategies.
+			"lines": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			// The AUTO access_mode switches to the failover address pools when the available addresses of the
+			// default ones are less than the default_min_available_addr_num.
+			"access_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AUTO",
+				ValidateFunc: validateAllowedStringValue([]string{"AUTO", "DEFAULT", "FAILOVER"}),
+			},
+			"default_addr_pool_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{DnsGtmAddrPoolIpv4, DnsGtmAddrPoolIpv6, DnsGtmAddrPoolDomain}),
+			},
+			"default_lba_strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DnsGtmLbaAllRr,
+				ValidateFunc: validateAllowedStringValue([]string{DnsGtmLbaAllRr, DnsGtmLbaRatio}),
+			},
+			"default_min_available_addr_num": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"default_addr_pool": dnsGtmStrategyAddrPoolSchema(true),
+			"failover_addr_pool_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{DnsGtmAddrPoolIpv4, DnsGtmAddrPoolIpv6, DnsGtmAddrPoolDomain}),
+			},
+			"failover_lba_strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{DnsGtmLbaAllRr, DnsGtmLbaRatio}),
+			},
+			"failover_min_available_addr_num": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"failover_addr_pool": dnsGtmStrategyAddrPoolSchema(false),
+			// The effective_addr_pool_group_type is DEFAULT or FAILOVER, which is the address pools in service.
+			"effective_addr_pool_group_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dnsGtmStrategyAddrPoolSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"addr_pool_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"lba_weight": &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validateIntegerInRange(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudDnsGtmAccessStrategyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildDnsGtmAccessStrategyArgs(d)
+	args.InstanceId = d.Get("instance_id").(string)
+	args.StrategyMode = d.Get("strategy_mode").(string)
+	resp := AddDnsGtmAccessStrategyResponse{}
+	if err := client.dnsconn().Invoke("AddDnsGtmAccessStrategy", &args, &resp); err != nil {
+		return WrapError(err, "AddDnsGtmAccessStrategy", d.Id())
+	}
+
+	d.SetId(resp.StrategyId)
+
+	return resourceAlicloudDnsGtmAccessStrategyRead(d, meta)
+}
+
+func resourceAlicloudDnsGtmAccessStrategyRead(d *schema.ResourceData, meta interface{}) error {
+	strategy, err := meta.(*AliyunClient).DescribeDnsGtmAccessStrategy(d.Id())
+	if err != nil {
+		if NotFoundError(err, "dns") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDnsGtmAccessStrategy", d.Id())
+	}
+
+	d.Set("instance_id", strategy.InstanceId)
+	d.Set("strategy_name", strategy.StrategyName)
+	d.Set("strategy_mode", strategy.StrategyMode)
+	d.Set("access_mode", strategy.AccessMode)
+	d.Set("default_addr_pool_type", strategy.DefaultAddrPoolType)
+	d.Set("default_lba_strategy", strategy.DefaultLbaStrategy)
+	d.Set("default_min_available_addr_num", strategy.DefaultMinAvailableAddrNum)
+	d.Set("failover_addr_pool_type", strategy.FailoverAddrPoolType)
+	d.Set("failover_lba_strategy", strategy.FailoverLbaStrategy)
+	d.Set("failover_min_available_addr_num", strategy.FailoverMinAvailableAddrNum)
+	d.Set("effective_addr_pool_group_type", strategy.EffectiveAddrPoolGroupType)
+
+	var lines []string
+	for _, line := range strategy.Lines.Line {
+		lines = append(lines, line.LineCode)
+	}
+	if err := d.Set("lines", lines); err != nil {
+		return err
+	}
+	if err := d.Set("default_addr_pool", flattenDnsGtmStrategyAddrPools(strategy.DefaultAddrPools.DefaultAddrPool)); err != nil {
+		return err
+	}
+	if err := d.Set("failover_addr_pool", flattenDnsGtmStrategyAddrPools(strategy.FailoverAddrPools.FailoverAddrPool)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudDnsGtmAccessStrategyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// UpdateDnsGtmAccessStrategy overwrites all the arguments of the strategy.
+	args := buildDnsGtmAccessStrategyArgs(d)
+	args.StrategyId = d.Id()
+	if err := client.dnsconn().Invoke("UpdateDnsGtmAccessStrategy", &args, &common.Response{}); err != nil {
+		return WrapError(err, "UpdateDnsGtmAccessStrategy", d.Id())
+	}
+
+	return resourceAlicloudDnsGtmAccessStrategyRead(d, meta)
+}
+
+func resourceAlicloudDnsGtmAccessStrategyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := DnsGtmStrategyIdArgs{
+		StrategyId: d.Id(),
+	}
+	if err := client.dnsconn().Invoke("DeleteDnsGtmAccessStrategy", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "dns") {
+			return nil
+		}
+		return WrapError(err, "DeleteDnsGtmAccessStrategy", d.Id())
+	}
+	return nil
+}
+
+func buildDnsGtmAccessStrategyArgs(d *schema.ResourceData) DnsGtmAccessStrategyArgs {
+	return DnsGtmAccessStrategyArgs{
+		StrategyName:                d.Get("strategy_name").(string),
+		Lines:                       convertListToJsonString(d.Get("lines").(*schema.Set).List()),
+		AccessMode:                  d.Get("access_mode").(string),
+		DefaultAddrPoolType:         d.Get("default_addr_pool_type").(string),
+		DefaultLbaStrategy:          d.Get("default_lba_strategy").(string),
+		DefaultMinAvailableAddrNum:  d.Get("default_min_available_addr_num").(int),
+		DefaultAddrPool:             expandDnsGtmStrategyAddrPools(d.Get("default_addr_pool").([]interface{})),
+		FailoverAddrPoolType:        d.Get("failover_addr_pool_type").(string),
+		FailoverLbaStrategy:         d.Get("failover_lba_strategy").(string),
+		FailoverMinAvailableAddrNum: d.Get("failover_min_available_addr_num").(int),
+		FailoverAddrPool:            expandDnsGtmStrategyAddrPools(d.Get("failover_addr_pool").([]interface{})),
+	}
+}
+
+func expandDnsGtmStrategyAddrPools(configured []interface{}) []DnsGtmStrategyAddrPool {
+	var pools []DnsGtmStrategyAddrPool
+	for _, v := range configured {
+		pool := v.(map[string]interface{})
+		pools = append(pools, DnsGtmStrategyAddrPool{
+			Id:        pool["addr_pool_id"].(string),
+			LbaWeight: pool["lba_weight"].(int),
+		})
+	}
+	return pools
+}
+
+func flattenDnsGtmStrategyAddrPools(pools []DnsGtmStrategyAddrPool) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, pool := range pools {
+		result = append(result, map[string]interface{}{
+			"addr_pool_id": pool.Id,
+			"lba_weight":   pool.LbaWeight,
+		})
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDnsGtmAccessStrategy_basic(t *testing.T) {
+	var v DnsGtmAccessStrategy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithGtmInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dns_gtm_access_strategy.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsGtmAccessStrategyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDnsGtmAccessStrategyConfig("tf-testAccGtmStrategy", "AUTO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsGtmAccessStrategyExists(
+						"alicloud_dns_gtm_access_strategy.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_access_strategy.foo",
+						"strategy_name",
+						"tf-testAccGtmStrategy"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_access_strategy.foo",
+						"default_addr_pool.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_access_strategy.foo",
+						"failover_addr_pool.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_access_strategy.foo",
+						"effective_addr_pool_group_type",
+						"DEFAULT"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDnsGtmAccessStrategyConfig("tf-testAccGtmStrategy-update", "FAILOVER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsGtmAccessStrategyExists(
+						"alicloud_dns_gtm_access_strategy.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_access_strategy.foo",
+						"strategy_name",
+						"tf-testAccGtmStrategy-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_access_strategy.foo",
+						"effective_addr_pool_group_type",
+						"FAILOVER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDnsGtmAccessStrategyExists(n string, d *DnsGtmAccessStrategy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GTM access strategy ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		strategy, err := client.DescribeDnsGtmAccessStrategy(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *strategy
+		return nil
+	}
+}
+
+func testAccCheckDnsGtmAccessStrategyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_dns_gtm_access_strategy" {
+			continue
+		}
+
+		if _, err := client.DescribeDnsGtmAccessStrategy(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "dns") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("GTM access strategy %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDnsGtmAccessStrategyConfig(name, accessMode string) string {
+	return fmt.Sprintf(`
+variable "instance_id" {
+  default = "%s"
+}
+
+resource "alicloud_dns_gtm_address_pool" "primary" {
+  instance_id = "${var.instance_id}"
+  name = "tf-testAccGtmStrategy-primary"
+  type = "IPV4"
+  address {
+    address = "1.1.1.1"
+    attribute_info = "{\"lineCodeRectifyType\":\"RECTIFIED\",\"lineCodes\":[\"default\"]}"
+    mode = "SMART"
+  }
+}
+
+resource "alicloud_dns_gtm_address_pool" "secondary" {
+  instance_id = "${var.instance_id}"
+  name = "tf-testAccGtmStrategy-secondary"
+  type = "IPV4"
+  address {
+    address = "2.2.2.2"
+    attribute_info = "{\"lineCodeRectifyType\":\"RECTIFIED\",\"lineCodes\":[\"default\"]}"
+    mode = "SMART"
+  }
+}
+
+resource "alicloud_dns_gtm_access_strategy" "foo" {
+  instance_id = "${var.instance_id}"
+  strategy_name = "%s"
+  lines = ["default"]
+  access_mode = "%s"
+  default_addr_pool_type = "IPV4"
+  default_addr_pool {
+    addr_pool_id = "${alicloud_dns_gtm_address_pool.primary.id}"
+  }
+  failover_addr_pool_type = "IPV4"
+  failover_lba_strategy = "ALL_RR"
+  failover_min_available_addr_num = 1
+  failover_addr_pool {
+    addr_pool_id = "${alicloud_dns_gtm_address_pool.secondary.id}"
+  }
+}
+`, os.Getenv("ALICLOUD_GTM_INSTANCE_ID"), name, accessMode)
+}
@@ -0,0 +1,179 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDnsGtmAddressPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDnsGtmAddressPoolCreate,
+		Read:   resourceAlicloudDnsGtmAddressPoolRead,
+		Update: resourceAlicloudDnsGtmAddressPoolUpdate,
+		Delete: resourceAlicloudDnsGtmAddressPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{DnsGtmAddrPoolIpv4, DnsGtmAddrPoolIpv6, DnsGtmAddrPoolDomain}),
+			},
+			"lba_strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DnsGtmLbaAllRr,
+				ValidateFunc: validateAllowedStringValue([]string{DnsGtmLbaAllRr, DnsGtmLbaRatio}),
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// The attribute_info is the JSON of the lines the address serves, like
+						// {"lineCodeRectifyType":"RECTIFIED","lineCodes":["os_namerica_us"]}.
+						"attribute_info": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateJsonString,
+							StateFunc: func(v interface{}) string {
+								json, _ := normalizeJsonString(v)
+								return json
+							},
+						},
+						// The lba_weight only works for the RATIO lba_strategy.
+						"lba_weight": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateIntegerInRange(1, 100),
+						},
+						"mode": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "SMART",
+							ValidateFunc: validateAllowedStringValue([]string{"SMART", "ONLINE", "OFFLINE"}),
+						},
+						"remark": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudDnsGtmAddressPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildDnsGtmAddressPoolArgs(d)
+	args.InstanceId = d.Get("instance_id").(string)
+	args.Type = d.Get("type").(string)
+	args.MonitorStatus = "CLOSE"
+	resp := AddDnsGtmAddressPoolResponse{}
+	if err := client.dnsconn().Invoke("AddDnsGtmAddressPool", &args, &resp); err != nil {
+		return WrapError(err, "AddDnsGtmAddressPool", d.Id())
+	}
+
+	d.SetId(resp.AddrPoolId)
+
+	return resourceAlicloudDnsGtmAddressPoolRead(d, meta)
+}
+
+func resourceAlicloudDnsGtmAddressPoolRead(d *schema.ResourceData, meta interface{}) error {
+	pool, err := meta.(*AliyunClient).DescribeDnsGtmAddressPool(d.Id())
+	if err != nil {
+		if NotFoundError(err, "dns") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDnsGtmInstanceAddressPool", d.Id())
+	}
+
+	d.Set("name", pool.Name)
+	d.Set("type", pool.Type)
+	d.Set("lba_strategy", pool.LbaStrategy)
+
+	var addresses []map[string]interface{}
+	for _, addr := range pool.Addrs.Addr {
+		info, _ := normalizeJsonString(addr.AttributeInfo)
+		addresses = append(addresses, map[string]interface{}{
+			"address":        addr.Addr,
+			"attribute_info": info,
+			"lba_weight":     addr.LbaWeight,
+			"mode":           addr.Mode,
+			"remark":         addr.Remark,
+		})
+	}
+	if err := d.Set("address", addresses); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudDnsGtmAddressPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("lba_strategy") || d.HasChange("address") {
+		// UpdateDnsGtmAddressPool overwrites all the addresses of the address pool.
+		args := buildDnsGtmAddressPoolArgs(d)
+		args.AddrPoolId = d.Id()
+		if err := client.dnsconn().Invoke("UpdateDnsGtmAddressPool", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateDnsGtmAddressPool", d.Id())
+		}
+	}
+
+	return resourceAlicloudDnsGtmAddressPoolRead(d, meta)
+}
+
+func resourceAlicloudDnsGtmAddressPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := DnsGtmAddrPoolIdArgs{
+		AddrPoolId: d.Id(),
+	}
+	if err := client.dnsconn().Invoke("DeleteDnsGtmAddressPool", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "dns") {
+			return nil
+		}
+		return WrapError(err, "DeleteDnsGtmAddressPool", d.Id())
+	}
+	return nil
+}
+
+func buildDnsGtmAddressPoolArgs(d *schema.ResourceData) DnsGtmAddressPoolArgs {
+	args := DnsGtmAddressPoolArgs{
+		Name:        d.Get("name").(string),
+		LbaStrategy: d.Get("lba_strategy").(string),
+	}
+	for _, v := range d.Get("address").([]interface{}) {
+		addr := v.(map[string]interface{})
+		args.Addr = append(args.Addr, DnsGtmAddr{
+			Addr:          addr["address"].(string),
+			AttributeInfo: addr["attribute_info"].(string),
+			LbaWeight:     addr["lba_weight"].(int),
+			Mode:          addr["mode"].(string),
+			Remark:        addr["remark"].(string),
+		})
+	}
+	return args
+}
@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDnsGtmAddressPool_basic(t *testing.T) {
+	var v DnsGtmAddressPool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithGtmInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dns_gtm_address_pool.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsGtmAddressPoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDnsGtmAddressPoolConfig("tf-testAccGtmPool", DnsGtmLbaAllRr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsGtmAddressPoolExists(
+						"alicloud_dns_gtm_address_pool.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_address_pool.foo",
+						"name",
+						"tf-testAccGtmPool"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_address_pool.foo",
+						"address.#",
+						"2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDnsGtmAddressPoolConfig("tf-testAccGtmPool-update", DnsGtmLbaRatio),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsGtmAddressPoolExists(
+						"alicloud_dns_gtm_address_pool.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_address_pool.foo",
+						"name",
+						"tf-testAccGtmPool-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_address_pool.foo",
+						"lba_strategy",
+						DnsGtmLbaRatio),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDnsGtmAddressPoolExists(n string, d *DnsGtmAddressPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GTM address pool ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		pool, err := client.DescribeDnsGtmAddressPool(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *pool
+		return nil
+	}
+}
+
+func testAccCheckDnsGtmAddressPoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_dns_gtm_address_pool" {
+			continue
+		}
+
+		if _, err := client.DescribeDnsGtmAddressPool(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "dns") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("GTM address pool %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDnsGtmAddressPoolConfig(name, lbaStrategy string) string {
+	return fmt.Sprintf(`
+resource "alicloud_dns_gtm_address_pool" "foo" {
+  instance_id = "%s"
+  name = "%s"
+  type = "IPV4"
+  lba_strategy = "%s"
+  address {
+    address = "1.1.1.1"
+    attribute_info = "{\"lineCodeRectifyType\":\"RECTIFIED\",\"lineCodes\":[\"default\"]}"
+    lba_weight = 1
+    mode = "SMART"
+  }
+  address {
+    address = "2.2.2.2"
+    attribute_info = "{\"lineCodeRectifyType\":\"RECTIFIED\",\"lineCodes\":[\"default\"]}"
+    lba_weight = 2
+    mode = "SMART"
+  }
+}
+`, os.Getenv("ALICLOUD_GTM_INSTANCE_ID"), name, lbaStrategy)
+}
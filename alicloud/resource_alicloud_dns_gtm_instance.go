@@ -0,0 +1,239 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDnsGtmInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDnsGtmInstanceCreate,
+		Read:   resourceAlicloudDnsGtmInstanceRead,
+		Update: resourceAlicloudDnsGtmInstanceUpdate,
+		Delete: resourceAlicloudDnsGtmInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"package_edition": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "standard",
+				ValidateFunc: validateAllowedStringValue([]string{"standard", "ultimate"}),
+			},
+			"health_check_task_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  0,
+			},
+			"sms_notification_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  0,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+			},
+			"renewal_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ManualRenewal",
+				ValidateFunc: validateAllowedStringValue([]string{"AutoRenewal", "ManualRenewal", "NotRenewal"}),
+			},
+			// The public_user_domain_name is the domain the users access, which is a CNAME of the domain
+			// assigned by the GTM instance.
+			"public_user_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// The CUSTOM mode uses the public_rr in the public_zone_name hosted by the DNS as the domain of the
+			// GTM instance, rather than the one assigned by the system.
+			"public_cname_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SYSTEM_ASSIGN",
+				ValidateFunc: validateAllowedStringValue([]string{"SYSTEM_ASSIGN", "CUSTOM"}),
+			},
+			"public_zone_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"public_rr": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validateAllowedIntValue([]int{1, 5, 10, 30, 60, 120, 300, 600}),
+			},
+			"alert_group": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"strategy_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DnsGtmStrategyModeGeo,
+				ValidateFunc: validateAllowedStringValue([]string{DnsGtmStrategyModeGeo, DnsGtmStrategyModeLatency}),
+			},
+			"version_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDnsGtmInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	productType := DnsGtmProductType
+	if centralServiceRegion(client.Region) != "cn-hangzhou" {
+		productType = DnsGtmProductTypeIntl
+	}
+	args := CreateBssInstanceArgs{
+		ProductCode:      DnsGtmProductCode,
+		ProductType:      productType,
+		SubscriptionType: "Subscription",
+		Period:           d.Get("period").(int),
+		RenewalStatus:    d.Get("renewal_status").(string),
+		Parameter: []BssInstanceParameter{
+			{Code: "PackageEdition", Value: d.Get("package_edition").(string)},
+			{Code: "HealthcheckTaskCount", Value: strconv.Itoa(d.Get("health_check_task_count").(int))},
+			{Code: "SmsNotificationCount", Value: strconv.Itoa(d.Get("sms_notification_count").(int))},
+		},
+	}
+	if args.RenewalStatus == "AutoRenewal" {
+		args.RenewPeriod = args.Period
+	}
+
+	resp := CreateBssInstanceResponse{}
+	if err := client.bssconn().Invoke("CreateInstance", &args, &resp); err != nil {
+		return WrapError(err, "CreateInstance", d.Id())
+	}
+	if !resp.Success {
+		return fmt.Errorf("CreateInstance got an error: %s %s", resp.Code, resp.Message)
+	}
+
+	d.SetId(resp.Data.InstanceId)
+
+	// The bought instance has no configuration, and it is configured by the update.
+	return resourceAlicloudDnsGtmInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudDnsGtmInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeDnsGtmInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err, "dns") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDnsGtmInstance", d.Id())
+	}
+
+	d.Set("instance_name", instance.Config.InstanceName)
+	d.Set("public_user_domain_name", instance.Config.PublicUserDomainName)
+	d.Set("public_cname_mode", instance.Config.PublicCnameMode)
+	d.Set("public_zone_name", instance.Config.PublicZoneName)
+	d.Set("public_rr", instance.Config.PublicRr)
+	d.Set("ttl", instance.Config.Ttl)
+	d.Set("strategy_mode", instance.Config.StrategyMode)
+	d.Set("version_code", instance.VersionCode)
+	d.Set("expire_time", instance.ExpireTime)
+
+	var groups []string
+	if instance.Config.AlertGroup != "" {
+		if err := json.Unmarshal([]byte(instance.Config.AlertGroup), &groups); err != nil {
+			return WrapError(err, "DescribeDnsGtmInstance", d.Id())
+		}
+	}
+	if err := d.Set("alert_group", groups); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudDnsGtmInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	if d.IsNewResource() || d.HasChange("instance_name") || d.HasChange("public_user_domain_name") ||
+		d.HasChange("public_cname_mode") || d.HasChange("public_zone_name") || d.HasChange("public_rr") ||
+		d.HasChange("ttl") || d.HasChange("alert_group") {
+		// UpdateDnsGtmInstanceGlobalConfig overwrites all the global configuration of the instance.
+		args := UpdateDnsGtmInstanceGlobalConfigArgs{
+			InstanceId:           d.Id(),
+			InstanceName:         d.Get("instance_name").(string),
+			Ttl:                  d.Get("ttl").(int),
+			CnameType:            "PUBLIC",
+			PublicCnameMode:      d.Get("public_cname_mode").(string),
+			PublicUserDomainName: d.Get("public_user_domain_name").(string),
+			PublicZoneName:       d.Get("public_zone_name").(string),
+			PublicRr:             d.Get("public_rr").(string),
+			AlertGroup:           convertListToJsonString(d.Get("alert_group").(*schema.Set).List()),
+		}
+		if args.PublicCnameMode == "CUSTOM" && (args.PublicZoneName == "" || args.PublicRr == "") {
+			return ConfigErrorf(ErrorCodeMissingArgument, "The public_zone_name and public_rr are required for the CUSTOM public_cname_mode.")
+		}
+		if err := client.dnsconn().Invoke("UpdateDnsGtmInstanceGlobalConfig", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateDnsGtmInstanceGlobalConfig", d.Id())
+		}
+		for _, key := range []string{"instance_name", "public_user_domain_name", "public_cname_mode", "public_zone_name",
+			"public_rr", "ttl", "alert_group"} {
+			d.SetPartial(key)
+		}
+	}
+
+	// The new instance is in the GEO strategy mode.
+	if d.HasChange("strategy_mode") && !(d.IsNewResource() && d.Get("strategy_mode").(string) == DnsGtmStrategyModeGeo) {
+		args := DnsGtmInstanceArgs{
+			InstanceId:   d.Id(),
+			StrategyMode: d.Get("strategy_mode").(string),
+		}
+		if err := client.dnsconn().Invoke("SwitchDnsGtmInstanceStrategyMode", &args, &common.Response{}); err != nil {
+			return WrapError(err, "SwitchDnsGtmInstanceStrategyMode", d.Id())
+		}
+		d.SetPartial("strategy_mode")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudDnsGtmInstanceRead(d, meta)
+}
+
+func resourceAlicloudDnsGtmInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	// The subscription instance can not be released by API, and it is released automatically after it is expired.
+	log.Printf("[WARN] GTM instance %s can not be deleted and it is only removed from the state. "+
+		"It will be released automatically after it is expired.", d.Id())
+	return nil
+}
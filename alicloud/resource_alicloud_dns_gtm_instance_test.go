@@ -0,0 +1,95 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The subscription GTM instance can not be released by API, so there is no destroy check and
+// the instance is released automatically after it is expired.
+func TestAccAlicloudDnsGtmInstance_basic(t *testing.T) {
+	var v DnsGtmInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dns_gtm_instance.foo",
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDnsGtmInstanceConfig("tf-testAccGtmInstance", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsGtmInstanceExists(
+						"alicloud_dns_gtm_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_instance.foo",
+						"instance_name",
+						"tf-testAccGtmInstance"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_instance.foo",
+						"alert_group.#",
+						"1"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_dns_gtm_instance.foo",
+						"expire_time"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDnsGtmInstanceConfig("tf-testAccGtmInstance-update", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsGtmInstanceExists(
+						"alicloud_dns_gtm_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_instance.foo",
+						"instance_name",
+						"tf-testAccGtmInstance-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_gtm_instance.foo",
+						"ttl",
+						"300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDnsGtmInstanceExists(n string, d *DnsGtmInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GTM instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instance, err := client.DescribeDnsGtmInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *instance
+		return nil
+	}
+}
+
+func testAccDnsGtmInstanceConfig(name string, ttl int) string {
+	return fmt.Sprintf(`
+resource "alicloud_dns_gtm_instance" "foo" {
+  instance_name = "%s"
+  public_user_domain_name = "gtm.heguimin.top"
+  ttl = %d
+  alert_group = ["default"]
+  period = 1
+}
+`, name, ttl)
+}
@@ -21,6 +21,10 @@ func resourceAlicloudDnsRecord() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -56,6 +60,14 @@ func resourceAlicloudDnsRecord() *schema.Resource {
 				ValidateFunc: validateDomainRecordLine,
 				Default:      "default",
 			},
+			// The weight enables the weighted round robin of the sub domain of the record, and the records of the
+			// sub domain are returned by their weights.
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 100),
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -69,7 +81,7 @@ func resourceAlicloudDnsRecord() *schema.Resource {
 }
 
 func resourceAlicloudDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.AddDomainRecordArgs{
 		DomainName: d.Get("name").(string),
@@ -88,14 +100,14 @@ func resourceAlicloudDnsRecordCreate(d *schema.ResourceData, meta interface{}) e
 
 	response, err := conn.AddDomainRecord(args)
 	if err != nil {
-		return fmt.Errorf("AddDomainRecord got a error: %#v", err)
+		return WrapError(err, "AddDomainRecord", d.Id())
 	}
 	d.SetId(response.RecordId)
 	return resourceAlicloudDnsRecordUpdate(d, meta)
 }
 
 func resourceAlicloudDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	d.Partial(true)
 	attributeUpdate := false
@@ -136,8 +148,26 @@ func resourceAlicloudDnsRecordUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if attributeUpdate {
 		if _, err := conn.UpdateDomainRecord(args); err != nil {
-			return fmt.Errorf("UpdateDomainRecord got an error: %#v", err)
+			return WrapError(err, "UpdateDomainRecord", d.Id())
+		}
+	}
+
+	if v, ok := d.GetOk("weight"); ok && d.HasChange("weight") {
+		slbArgs := SetDnsSlbStatusArgs{
+			SubDomain: dnsRecordSubDomain(d.Get("host_record").(string), d.Get("name").(string)),
+			Open:      true,
 		}
+		if err := conn.Invoke("SetDNSSLBStatus", &slbArgs, &common.Response{}); err != nil {
+			return WrapError(err, "SetDNSSLBStatus", d.Id())
+		}
+		weightArgs := UpdateDnsSlbWeightArgs{
+			RecordId: d.Id(),
+			Weight:   v.(int),
+		}
+		if err := conn.Invoke("UpdateDNSSLBWeight", &weightArgs, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateDNSSLBWeight", d.Id())
+		}
+		d.SetPartial("weight")
 	}
 
 	d.Partial(false)
@@ -146,14 +176,14 @@ func resourceAlicloudDnsRecordUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainRecordInfoNewArgs{
 		RecordId: d.Id(),
 	}
 	response, err := conn.DescribeDomainRecordInfoNew(args)
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "dns") {
 			d.SetId("")
 			return nil
 		}
@@ -172,19 +202,25 @@ func resourceAlicloudDnsRecordRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("status", record.Status)
 	d.Set("locked", record.Locked)
 
+	weight, err := meta.(*AliyunClient).DescribeDnsRecordWeight(dnsRecordSubDomain(record.RR, record.DomainName), d.Id())
+	if err != nil && !NotFoundError(err, "dns") {
+		return WrapError(err, "DescribeSubDomainRecords", d.Id())
+	}
+	d.Set("weight", weight)
+
 	return nil
 }
 
 func resourceAlicloudDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 	args := &dns.DeleteDomainRecordArgs{
 		RecordId: d.Id(),
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		_, err := conn.DeleteDomainRecord(args)
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == RecordForbiddenDNSChange {
+			if IsExceptedError(err, RecordForbiddenDNSChange) {
 				return resource.RetryableError(fmt.Errorf("Operation forbidden because DNS is changing - trying again after change complete."))
 			}
 			return resource.NonRetryableError(fmt.Errorf("Error deleting domain record %s: %#v", d.Id(), err))
@@ -192,3 +228,11 @@ func resourceAlicloudDnsRecordDelete(d *schema.ResourceData, meta interface{}) e
 		return nil
 	})
 }
+
+// dnsRecordSubDomain returns the sub domain of the record, and the "@" host record stands for the domain itself.
+func dnsRecordSubDomain(rr, domainName string) string {
+	if rr == "@" {
+		return domainName
+	}
+	return fmt.Sprintf("%s.%s", rr, domainName)
+}
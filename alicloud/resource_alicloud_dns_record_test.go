@@ -44,6 +44,51 @@ func TestAccAlicloudDnsRecord_basic(t *testing.T) {
 
 }
 
+func TestAccAlicloudDnsRecord_weight(t *testing.T) {
+	var v dns.RecordTypeNew
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dns_record.record",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDnsRecordWeightConfig(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsRecordExists(
+						"alicloud_dns_record.record", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_record.record",
+						"weight",
+						"10"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_record.backup",
+						"weight",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDnsRecordWeightConfig(20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsRecordExists(
+						"alicloud_dns_record.record", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dns_record.record",
+						"weight",
+						"20"),
+				),
+			},
+		},
+	})
+
+}
+
 func testAccCheckDnsRecordExists(n string, record *dns.RecordTypeNew) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -56,7 +101,7 @@ func testAccCheckDnsRecordExists(n string, record *dns.RecordTypeNew) resource.T
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.dnsconn
+		conn := client.dnsconn()
 
 		request := &dns.DescribeDomainRecordInfoNewArgs{
 			RecordId: rs.Primary.ID,
@@ -82,7 +127,7 @@ func testAccCheckDnsRecordDestroy(s *terraform.State) error {
 
 		// Try to find the domain record
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.dnsconn
+		conn := client.dnsconn()
 
 		request := &dns.DescribeDomainRecordInfoNewArgs{
 			RecordId: rs.Primary.ID,
@@ -107,3 +152,23 @@ resource "alicloud_dns_record" "record" {
   count = 1
 }
 `
+
+func testAccDnsRecordWeightConfig(weight int) string {
+	return fmt.Sprintf(`
+resource "alicloud_dns_record" "record" {
+  name = "heguimin.top"
+  host_record = "tf-testacc-weight"
+  type = "A"
+  value = "192.168.0.1"
+  weight = %d
+}
+
+resource "alicloud_dns_record" "backup" {
+  name = "heguimin.top"
+  host_record = "tf-testacc-weight"
+  type = "A"
+  value = "192.168.0.2"
+  weight = 1
+}
+`, weight)
+}
@@ -0,0 +1,199 @@
+package alicloud
+
+import (
+	"log"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDtsInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDtsInstanceCreate,
+		Read:   resourceAlicloudDtsInstanceRead,
+		Update: resourceAlicloudDtsInstanceUpdate,
+		Delete: resourceAlicloudDtsInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(DtsJobTypes),
+			},
+			"source_region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_endpoint_engine_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_endpoint_engine_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_class": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(DtsInstanceClasses),
+			},
+			"payment_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      DtsPayTypePostPaid,
+				ValidateFunc: validateAllowedStringValue(DtsPayTypes),
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Month",
+				ValidateFunc: validateAllowedStringValue([]string{"Year", "Month"}),
+			},
+			"used_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  1,
+			},
+			// The sync_architecture is only used by the synchronization instances.
+			"sync_architecture": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"oneway", "bidirectional"}),
+			},
+			"dts_job_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDtsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CreateDtsInstanceArgs{
+		RegionId:                      client.Region,
+		Type:                          d.Get("type").(string),
+		SourceRegion:                  d.Get("source_region").(string),
+		DestinationRegion:             d.Get("destination_region").(string),
+		SourceEndpointEngineName:      d.Get("source_endpoint_engine_name").(string),
+		DestinationEndpointEngineName: d.Get("destination_endpoint_engine_name").(string),
+		InstanceClass:                 d.Get("instance_class").(string),
+		PayType:                       d.Get("payment_type").(string),
+		SyncArchitecture:              d.Get("sync_architecture").(string),
+		AutoPay:                       true,
+		Quantity:                      1,
+	}
+	if args.SyncArchitecture != "" && args.Type != DtsJobTypeSync {
+		return ConfigErrorf(ErrorCodeInvalidArgument, "sync_architecture can only be set when type is %s.", DtsJobTypeSync)
+	}
+	if args.PayType == DtsPayTypePrePaid {
+		args.Period = d.Get("period").(string)
+		args.UsedTime = d.Get("used_time").(int)
+	}
+
+	resp := CreateDtsInstanceResponse{}
+	if err := client.dtsconn().Invoke("CreateDtsInstance", &args, &resp); err != nil {
+		return WrapError(err, "CreateDtsInstance", d.Id())
+	}
+
+	d.SetId(resp.InstanceId)
+
+	return resourceAlicloudDtsInstanceRead(d, meta)
+}
+
+func resourceAlicloudDtsInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	job, err := meta.(*AliyunClient).DescribeDtsJob("", d.Id())
+	if err != nil {
+		if NotFoundError(err, "dts") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDtsJobDetail", d.Id())
+	}
+
+	d.Set("source_region", job.SourceEndpoint.Region)
+	d.Set("destination_region", job.DestinationEndpoint.Region)
+	d.Set("source_endpoint_engine_name", job.SourceEndpoint.EngineName)
+	d.Set("destination_endpoint_engine_name", job.DestinationEndpoint.EngineName)
+	d.Set("instance_class", job.DtsJobClass)
+	d.Set("payment_type", job.PayType)
+	d.Set("dts_job_id", job.DtsJobId)
+	d.Set("status", job.Status)
+
+	return nil
+}
+
+func resourceAlicloudDtsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("instance_class") {
+		o, n := d.GetChange("instance_class")
+		args := TransferDtsInstanceClassArgs{
+			RegionId:      client.Region,
+			DtsJobId:      d.Get("dts_job_id").(string),
+			InstanceClass: n.(string),
+			OrderType:     dtsInstanceClassOrderType(o.(string), n.(string)),
+		}
+		if err := client.dtsconn().Invoke("TransferInstanceClass", &args, &common.Response{}); err != nil {
+			return WrapError(err, "TransferInstanceClass", d.Id())
+		}
+	}
+
+	return resourceAlicloudDtsInstanceRead(d, meta)
+}
+
+func resourceAlicloudDtsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.Get("payment_type").(string) == DtsPayTypePrePaid {
+		log.Printf("[WARN] DTS instance %s is a subscription one which can not be released by API, and it is only removed from the state.", d.Id())
+		return nil
+	}
+
+	args := DtsJobArgs{
+		RegionId:      client.Region,
+		DtsJobId:      d.Get("dts_job_id").(string),
+		DtsInstanceId: d.Id(),
+	}
+	if err := client.dtsconn().Invoke("DeleteDtsJob", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "dts") {
+			return nil
+		}
+		return WrapError(err, "DeleteDtsJob", d.Id())
+	}
+	return nil
+}
+
+// dtsInstanceClassOrderType returns UPGRADE when the instance class is changed into a larger one, or DOWNGRADE.
+func dtsInstanceClassOrderType(o, n string) string {
+	for _, class := range DtsInstanceClasses {
+		if class == o {
+			return "UPGRADE"
+		}
+		if class == n {
+			return "DOWNGRADE"
+		}
+	}
+	return "UPGRADE"
+}
@@ -0,0 +1,112 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDtsInstance_basic(t *testing.T) {
+	var v DtsJobDetail
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dts_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDtsInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDtsInstanceConfig("small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtsInstanceExists(
+						"alicloud_dts_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_instance.foo",
+						"instance_class",
+						"small"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_instance.foo",
+						"payment_type",
+						DtsPayTypePostPaid),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_dts_instance.foo",
+						"dts_job_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDtsInstanceConfig("medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtsInstanceExists(
+						"alicloud_dts_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_instance.foo",
+						"instance_class",
+						"medium"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDtsInstanceExists(n string, d *DtsJobDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DTS instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		job, err := client.DescribeDtsJob("", rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *job
+		return nil
+	}
+}
+
+func testAccCheckDtsInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_dts_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeDtsJob("", rs.Primary.ID); err != nil {
+			if NotFoundError(err, "dts") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("DTS instance %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDtsInstanceConfig(class string) string {
+	return fmt.Sprintf(`
+resource "alicloud_dts_instance" "foo" {
+  type = "MIGRATION"
+  source_region = "%s"
+  destination_region = "%s"
+  source_endpoint_engine_name = "MySQL"
+  destination_endpoint_engine_name = "MySQL"
+  instance_class = "%s"
+}
+`, os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_REGION"), class)
+}
@@ -0,0 +1,151 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The schema and the functions shared by the DTS migration job and the DTS synchronization job.
+
+// dtsEndpointSchema returns the schema of the source_endpoint and the destination_endpoint of the DTS jobs. The
+// instance_id is used by the cloud instances like RDS, and the ip and the port by the self-managed databases.
+func dtsEndpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance_type": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateAllowedStringValue(DtsEndpointInstanceTypes),
+				},
+				"engine_name": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"region": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"instance_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"ip": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"port": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"database_name": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"user_name": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"password": &schema.Schema{
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+// buildConfigureDtsJobArgs returns the args of the ConfigureDtsJob by the endpoints, the initialization and the
+// db_list of the job.
+func buildConfigureDtsJobArgs(client *AliyunClient, d *schema.ResourceData, jobType string) ConfigureDtsJobArgs {
+	source := d.Get("source_endpoint").([]interface{})[0].(map[string]interface{})
+	destination := d.Get("destination_endpoint").([]interface{})[0].(map[string]interface{})
+
+	return ConfigureDtsJobArgs{
+		RegionId:                        client.Region,
+		DtsInstanceId:                   d.Get("dts_instance_id").(string),
+		DtsJobName:                      d.Get("dts_job_name").(string),
+		JobType:                         jobType,
+		SourceEndpointInstanceType:      source["instance_type"].(string),
+		SourceEndpointEngineName:        source["engine_name"].(string),
+		SourceEndpointRegion:            source["region"].(string),
+		SourceEndpointInstanceID:        source["instance_id"].(string),
+		SourceEndpointIP:                source["ip"].(string),
+		SourceEndpointPort:              source["port"].(string),
+		SourceEndpointDatabaseName:      source["database_name"].(string),
+		SourceEndpointUserName:          source["user_name"].(string),
+		SourceEndpointPassword:          source["password"].(string),
+		DestinationEndpointInstanceType: destination["instance_type"].(string),
+		DestinationEndpointEngineName:   destination["engine_name"].(string),
+		DestinationEndpointRegion:       destination["region"].(string),
+		DestinationEndpointInstanceID:   destination["instance_id"].(string),
+		DestinationEndpointIP:           destination["ip"].(string),
+		DestinationEndpointPort:         destination["port"].(string),
+		DestinationEndpointDataBaseName: destination["database_name"].(string),
+		DestinationEndpointUserName:     destination["user_name"].(string),
+		DestinationEndpointPassword:     destination["password"].(string),
+		StructureInitialization:         d.Get("structure_initialization").(bool),
+		DataInitialization:              d.Get("data_initialization").(bool),
+		DbList:                          d.Get("db_list").(string),
+	}
+}
+
+// flattenDtsEndpoint returns the endpoint of the job to set into the state. The password is not returned by the
+// API, so it is kept as configured.
+func flattenDtsEndpoint(d *schema.ResourceData, key string, endpoint DtsEndpoint) []map[string]interface{} {
+	password := ""
+	if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			password = m["password"].(string)
+		}
+	}
+	return []map[string]interface{}{{
+		"instance_type": endpoint.InstanceType,
+		"engine_name":   endpoint.EngineName,
+		"region":        endpoint.Region,
+		"instance_id":   endpoint.InstanceID,
+		"ip":            endpoint.Ip,
+		"port":          endpoint.Port,
+		"database_name": endpoint.DatabaseName,
+		"user_name":     endpoint.UserName,
+		"password":      password,
+	}}
+}
+
+// updateDtsJobName renames the DTS job when the dts_job_name is changed.
+func updateDtsJobName(client *AliyunClient, d *schema.ResourceData) error {
+	if !d.HasChange("dts_job_name") {
+		return nil
+	}
+	args := ModifyDtsJobNameArgs{
+		RegionId:   client.Region,
+		DtsJobId:   d.Id(),
+		DtsJobName: d.Get("dts_job_name").(string),
+	}
+	if err := client.dtsconn().Invoke("ModifyDtsJobName", &args, &common.Response{}); err != nil {
+		return WrapError(err, "ModifyDtsJobName", d.Id())
+	}
+	return nil
+}
+
+// resetDtsJob resets the DTS job into the NotConfigured status. The job is a part of the DTS instance and is
+// released together with the instance, so the instance can be configured with another job after it is reset.
+func resetDtsJob(client *AliyunClient, d *schema.ResourceData) error {
+	args := DtsJobArgs{
+		RegionId:      client.Region,
+		DtsJobId:      d.Id(),
+		DtsInstanceId: d.Get("dts_instance_id").(string),
+	}
+	if err := client.dtsconn().Invoke("ResetDtsJob", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "dts") {
+			return nil
+		}
+		return WrapError(err, "ResetDtsJob", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDtsMigrationJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDtsMigrationJobCreate,
+		Read:   resourceAlicloudDtsMigrationJobRead,
+		Update: resourceAlicloudDtsMigrationJobUpdate,
+		Delete: resourceAlicloudDtsMigrationJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dts_instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dts_job_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_endpoint":      dtsEndpointSchema(),
+			"destination_endpoint": dtsEndpointSchema(),
+			// The db_list is the object mapping of the databases and the tables to migrate, like
+			// {"dtstest":{"name":"dtstest","all":true}}.
+			"db_list": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"structure_initialization": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"data_initialization": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			// The data_synchronization migrates the incremental data after the full data.
+			"data_synchronization": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDtsMigrationJobCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := buildConfigureDtsJobArgs(client, d, DtsJobTypeMigration)
+	args.DataSynchronization = d.Get("data_synchronization").(bool)
+
+	resp := ConfigureDtsJobResponse{}
+	if err := client.dtsconn().Invoke("ConfigureDtsJob", &args, &resp); err != nil {
+		return WrapError(err, "ConfigureDtsJob", d.Id())
+	}
+
+	d.SetId(resp.DtsJobId)
+
+	// The job starts after the precheck passes, and it keeps migrating the incremental data when the
+	// data_synchronization is enabled or it is finished after the full data is migrated.
+	if err := client.WaitForDtsJob(d.Id(), []string{DtsJobMigrating, DtsJobFinished}, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForDtsJob", d.Id())
+	}
+
+	return resourceAlicloudDtsMigrationJobRead(d, meta)
+}
+
+func resourceAlicloudDtsMigrationJobRead(d *schema.ResourceData, meta interface{}) error {
+	job, err := meta.(*AliyunClient).DescribeDtsJob(d.Id(), "")
+	if err != nil {
+		if NotFoundError(err, "dts") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDtsJobDetail", d.Id())
+	}
+	if job.Status == DtsJobNotConfigured {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("dts_instance_id", job.DtsInstanceID)
+	d.Set("dts_job_name", job.DtsJobName)
+	dbList, _ := normalizeJsonString(job.DbObject)
+	d.Set("db_list", dbList)
+	d.Set("structure_initialization", job.MigrationMode.StructureInitialization)
+	d.Set("data_initialization", job.MigrationMode.DataInitialization)
+	d.Set("data_synchronization", job.MigrationMode.DataSynchronization)
+	d.Set("status", job.Status)
+	if err := d.Set("source_endpoint", flattenDtsEndpoint(d, "source_endpoint", job.SourceEndpoint)); err != nil {
+		return err
+	}
+	if err := d.Set("destination_endpoint", flattenDtsEndpoint(d, "destination_endpoint", job.DestinationEndpoint)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudDtsMigrationJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateDtsJobName(meta.(*AliyunClient), d); err != nil {
+		return err
+	}
+
+	return resourceAlicloudDtsMigrationJobRead(d, meta)
+}
+
+func resourceAlicloudDtsMigrationJobDelete(d *schema.ResourceData, meta interface{}) error {
+	return resetDtsJob(meta.(*AliyunClient), d)
+}
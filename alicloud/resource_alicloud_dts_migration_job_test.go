@@ -0,0 +1,146 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDtsMigrationJob_basic(t *testing.T) {
+	var v DtsJobDetail
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithDtsRdsInstances(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dts_migration_job.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDtsJobDestroy("alicloud_dts_migration_job"),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDtsMigrationJobConfig("tf-testAccDtsMigrationJob"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtsJobExists(
+						"alicloud_dts_migration_job.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_migration_job.foo",
+						"dts_job_name",
+						"tf-testAccDtsMigrationJob"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_migration_job.foo",
+						"source_endpoint.0.instance_type",
+						"RDS"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_migration_job.foo",
+						"data_synchronization",
+						"false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDtsMigrationJobConfig("tf-testAccDtsMigrationJobUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtsJobExists(
+						"alicloud_dts_migration_job.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_migration_job.foo",
+						"dts_job_name",
+						"tf-testAccDtsMigrationJobUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDtsJobExists(n string, d *DtsJobDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DTS job ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		job, err := client.DescribeDtsJob(rs.Primary.ID, "")
+		if err != nil {
+			return err
+		}
+
+		*d = *job
+		return nil
+	}
+}
+
+// testAccCheckDtsJobDestroy checks the jobs are reset or released together with the DTS instances.
+func testAccCheckDtsJobDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*AliyunClient)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			job, err := client.DescribeDtsJob(rs.Primary.ID, "")
+			if err != nil {
+				if NotFoundError(err, "dts") {
+					continue
+				}
+				return err
+			}
+			if job.Status == DtsJobNotConfigured {
+				continue
+			}
+			return fmt.Errorf("DTS job %s still exists.", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDtsMigrationJobConfig(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_dts_instance" "foo" {
+  type = "MIGRATION"
+  source_region = "%s"
+  destination_region = "%s"
+  source_endpoint_engine_name = "MySQL"
+  destination_endpoint_engine_name = "MySQL"
+  instance_class = "small"
+}
+
+resource "alicloud_dts_migration_job" "foo" {
+  dts_instance_id = "${alicloud_dts_instance.foo.id}"
+  dts_job_name = "%s"
+  source_endpoint {
+    instance_type = "RDS"
+    engine_name = "MySQL"
+    region = "%s"
+    instance_id = "%s"
+    database_name = "dtstest"
+    user_name = "%s"
+    password = "%s"
+  }
+  destination_endpoint {
+    instance_type = "RDS"
+    engine_name = "MySQL"
+    region = "%s"
+    instance_id = "%s"
+    database_name = "dtstest"
+    user_name = "%s"
+    password = "%s"
+  }
+  db_list = "{\"dtstest\":{\"name\":\"dtstest\",\"all\":true}}"
+}
+`, os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_REGION"), name,
+		os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_DTS_SOURCE_RDS_ID"), os.Getenv("ALICLOUD_DTS_DB_USER"), os.Getenv("ALICLOUD_DTS_DB_PASSWORD"),
+		os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_DTS_DESTINATION_RDS_ID"), os.Getenv("ALICLOUD_DTS_DB_USER"), os.Getenv("ALICLOUD_DTS_DB_PASSWORD"))
+}
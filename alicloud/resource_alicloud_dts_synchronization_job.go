@@ -0,0 +1,201 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDtsSynchronizationJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDtsSynchronizationJobCreate,
+		Read:   resourceAlicloudDtsSynchronizationJobRead,
+		Update: resourceAlicloudDtsSynchronizationJobUpdate,
+		Delete: resourceAlicloudDtsSynchronizationJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dts_instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dts_job_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_endpoint":      dtsEndpointSchema(),
+			"destination_endpoint": dtsEndpointSchema(),
+			// The db_list is the object mapping of the databases and the tables to synchronize, and the objects
+			// can be changed while the job is running.
+			"db_list": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"structure_initialization": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"data_initialization": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			// The Reverse direction is only used by the bidirectional synchronization instances.
+			"synchronization_direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Forward",
+				ValidateFunc: validateAllowedStringValue(DtsSynchronizationDirections),
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{DtsJobSynchronizing, DtsJobSuspending}),
+			},
+		},
+	}
+}
+
+func resourceAlicloudDtsSynchronizationJobCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := buildConfigureDtsJobArgs(client, d, DtsJobTypeSync)
+	args.DataSynchronization = true
+	args.SynchronizationDirection = d.Get("synchronization_direction").(string)
+
+	resp := ConfigureDtsJobResponse{}
+	if err := client.dtsconn().Invoke("ConfigureDtsJob", &args, &resp); err != nil {
+		return WrapError(err, "ConfigureDtsJob", d.Id())
+	}
+
+	d.SetId(resp.DtsJobId)
+
+	// The job starts to synchronize after the precheck and the initialization pass.
+	if err := client.WaitForDtsJob(d.Id(), []string{DtsJobSynchronizing}, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForDtsJob", d.Id())
+	}
+
+	if d.Get("status").(string) == DtsJobSuspending {
+		if err := setDtsSynchronizationJobStatus(client, d, deadline); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudDtsSynchronizationJobRead(d, meta)
+}
+
+func resourceAlicloudDtsSynchronizationJobRead(d *schema.ResourceData, meta interface{}) error {
+	job, err := meta.(*AliyunClient).DescribeDtsJob(d.Id(), "")
+	if err != nil {
+		if NotFoundError(err, "dts") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDtsJobDetail", d.Id())
+	}
+	if job.Status == DtsJobNotConfigured {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("dts_instance_id", job.DtsInstanceID)
+	d.Set("dts_job_name", job.DtsJobName)
+	dbList, _ := normalizeJsonString(job.DbObject)
+	d.Set("db_list", dbList)
+	d.Set("structure_initialization", job.MigrationMode.StructureInitialization)
+	d.Set("data_initialization", job.MigrationMode.DataInitialization)
+	if job.DtsJobDirection != "" {
+		d.Set("synchronization_direction", job.DtsJobDirection)
+	}
+	d.Set("status", job.Status)
+	if err := d.Set("source_endpoint", flattenDtsEndpoint(d, "source_endpoint", job.SourceEndpoint)); err != nil {
+		return err
+	}
+	if err := d.Set("destination_endpoint", flattenDtsEndpoint(d, "destination_endpoint", job.DestinationEndpoint)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudDtsSynchronizationJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+
+	d.Partial(true)
+
+	if err := updateDtsJobName(client, d); err != nil {
+		return err
+	}
+	d.SetPartial("dts_job_name")
+
+	if d.HasChange("db_list") {
+		args := ModifyDtsJobArgs{
+			RegionId:                 client.Region,
+			DtsInstanceId:            d.Get("dts_instance_id").(string),
+			SynchronizationDirection: d.Get("synchronization_direction").(string),
+			DbList:                   d.Get("db_list").(string),
+		}
+		if err := client.dtsconn().Invoke("ModifyDtsJob", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyDtsJob", d.Id())
+		}
+		if err := client.WaitForDtsJob(d.Id(), []string{DtsJobSynchronizing}, deadline.Seconds()); err != nil {
+			return WrapError(err, "WaitForDtsJob", d.Id())
+		}
+		d.SetPartial("db_list")
+	}
+
+	if d.HasChange("status") {
+		if err := setDtsSynchronizationJobStatus(client, d, deadline); err != nil {
+			return err
+		}
+		d.SetPartial("status")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudDtsSynchronizationJobRead(d, meta)
+}
+
+func resourceAlicloudDtsSynchronizationJobDelete(d *schema.ResourceData, meta interface{}) error {
+	return resetDtsJob(meta.(*AliyunClient), d)
+}
+
+// setDtsSynchronizationJobStatus suspends or starts the synchronization job by the "status" of it, and waits for
+// the status until the deadline.
+func setDtsSynchronizationJobStatus(client *AliyunClient, d *schema.ResourceData, deadline operationDeadline) error {
+	action := "StartDtsJob"
+	if d.Get("status").(string) == DtsJobSuspending {
+		action = "SuspendDtsJob"
+	}
+	args := DtsJobArgs{
+		RegionId:                 client.Region,
+		DtsJobId:                 d.Id(),
+		DtsInstanceId:            d.Get("dts_instance_id").(string),
+		SynchronizationDirection: d.Get("synchronization_direction").(string),
+	}
+	if err := client.dtsconn().Invoke(action, &args, &common.Response{}); err != nil {
+		return WrapError(err, action, d.Id())
+	}
+	return client.WaitForDtsJob(d.Id(), []string{d.Get("status").(string)}, deadline.Seconds())
+}
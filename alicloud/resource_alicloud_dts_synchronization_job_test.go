@@ -0,0 +1,95 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDtsSynchronizationJob_basic(t *testing.T) {
+	var v DtsJobDetail
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithDtsRdsInstances(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dts_synchronization_job.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDtsJobDestroy("alicloud_dts_synchronization_job"),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDtsSynchronizationJobConfig("Synchronizing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtsJobExists(
+						"alicloud_dts_synchronization_job.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_synchronization_job.foo",
+						"synchronization_direction",
+						"Forward"),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_synchronization_job.foo",
+						"status",
+						DtsJobSynchronizing),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDtsSynchronizationJobConfig("Suspending"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtsJobExists(
+						"alicloud_dts_synchronization_job.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_dts_synchronization_job.foo",
+						"status",
+						DtsJobSuspending),
+				),
+			},
+		},
+	})
+}
+
+func testAccDtsSynchronizationJobConfig(status string) string {
+	return fmt.Sprintf(`
+resource "alicloud_dts_instance" "foo" {
+  type = "SYNC"
+  source_region = "%s"
+  destination_region = "%s"
+  source_endpoint_engine_name = "MySQL"
+  destination_endpoint_engine_name = "MySQL"
+  instance_class = "small"
+  sync_architecture = "oneway"
+}
+
+resource "alicloud_dts_synchronization_job" "foo" {
+  dts_instance_id = "${alicloud_dts_instance.foo.id}"
+  dts_job_name = "tf-testAccDtsSynchronizationJob"
+  source_endpoint {
+    instance_type = "RDS"
+    engine_name = "MySQL"
+    region = "%s"
+    instance_id = "%s"
+    database_name = "dtstest"
+    user_name = "%s"
+    password = "%s"
+  }
+  destination_endpoint {
+    instance_type = "RDS"
+    engine_name = "MySQL"
+    region = "%s"
+    instance_id = "%s"
+    database_name = "dtstest"
+    user_name = "%s"
+    password = "%s"
+  }
+  db_list = "{\"dtstest\":{\"name\":\"dtstest\",\"all\":true}}"
+  status = "%s"
+}
+`, os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_REGION"),
+		os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_DTS_SOURCE_RDS_ID"), os.Getenv("ALICLOUD_DTS_DB_USER"), os.Getenv("ALICLOUD_DTS_DB_PASSWORD"),
+		os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_DTS_DESTINATION_RDS_ID"), os.Getenv("ALICLOUD_DTS_DB_USER"), os.Getenv("ALICLOUD_DTS_DB_PASSWORD"),
+		status)
+}
@@ -0,0 +1,147 @@
+package alicloud
+
+import (
+	"encoding/base64"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEcsCommand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEcsCommandCreate,
+		Read:   resourceAlicloudEcsCommandRead,
+		Update: resourceAlicloudEcsCommandUpdate,
+		Delete: resourceAlicloudEcsCommandDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 128),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(EcsCommandTypes),
+			},
+			// The command_content is the plain script, and it is encoded in base64 by the provider. The custom
+			// parameters are referenced like {{name}} in it when the enable_parameter is true.
+			"command_content": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"working_dir": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validateIntegerInRange(10, 86400),
+			},
+			"enable_parameter": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAlicloudEcsCommandCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := EcsCommandArgs{
+		RegionId:        client.Region,
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		Type:            d.Get("type").(string),
+		CommandContent:  base64.StdEncoding.EncodeToString([]byte(d.Get("command_content").(string))),
+		WorkingDir:      d.Get("working_dir").(string),
+		Timeout:         d.Get("timeout").(int),
+		EnableParameter: d.Get("enable_parameter").(bool),
+	}
+	resp := CreateEcsCommandResponse{}
+	if err := client.ecsconn().Invoke("CreateCommand", &args, &resp); err != nil {
+		return WrapError(err, "CreateCommand", d.Id())
+	}
+
+	d.SetId(resp.CommandId)
+
+	return resourceAlicloudEcsCommandRead(d, meta)
+}
+
+func resourceAlicloudEcsCommandRead(d *schema.ResourceData, meta interface{}) error {
+	command, err := meta.(*AliyunClient).DescribeEcsCommand(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ecs") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeCommands", d.Id())
+	}
+
+	content, err := base64.StdEncoding.DecodeString(command.CommandContent)
+	if err != nil {
+		return WrapError(err, "DescribeCommands", d.Id())
+	}
+
+	d.Set("name", command.Name)
+	d.Set("description", command.Description)
+	d.Set("type", command.Type)
+	d.Set("command_content", string(content))
+	d.Set("working_dir", command.WorkingDir)
+	d.Set("timeout", command.Timeout)
+	d.Set("enable_parameter", command.EnableParameter)
+
+	return nil
+}
+
+func resourceAlicloudEcsCommandUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("command_content") ||
+		d.HasChange("working_dir") || d.HasChange("timeout") {
+		args := EcsCommandArgs{
+			RegionId:       client.Region,
+			CommandId:      d.Id(),
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			CommandContent: base64.StdEncoding.EncodeToString([]byte(d.Get("command_content").(string))),
+			WorkingDir:     d.Get("working_dir").(string),
+			Timeout:        d.Get("timeout").(int),
+		}
+		if err := client.ecsconn().Invoke("ModifyCommand", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyCommand", d.Id())
+		}
+	}
+
+	return resourceAlicloudEcsCommandRead(d, meta)
+}
+
+func resourceAlicloudEcsCommandDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := EcsCommandArgs{
+		RegionId:  client.Region,
+		CommandId: d.Id(),
+	}
+	if err := client.ecsconn().Invoke("DeleteCommand", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "ecs") {
+			return nil
+		}
+		return WrapError(err, "DeleteCommand", d.Id())
+	}
+	return nil
+}
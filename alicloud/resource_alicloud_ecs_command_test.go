@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEcsCommand_basic(t *testing.T) {
+	var v EcsCommand
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ecs_command.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEcsCommandDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEcsCommandConfig("echo hello", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsCommandExists(
+						"alicloud_ecs_command.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_command.foo",
+						"type",
+						EcsCommandRunShellScript),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_command.foo",
+						"command_content",
+						"echo hello"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_command.foo",
+						"timeout",
+						"60"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEcsCommandConfig("echo world", 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsCommandExists(
+						"alicloud_ecs_command.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_command.foo",
+						"command_content",
+						"echo world"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_command.foo",
+						"timeout",
+						"120"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEcsCommandExists(n string, d *EcsCommand) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cloud Assistant command ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		command, err := client.DescribeEcsCommand(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *command
+		return nil
+	}
+}
+
+func testAccCheckEcsCommandDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ecs_command" {
+			continue
+		}
+
+		if _, err := client.DescribeEcsCommand(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "ecs") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cloud Assistant command %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEcsCommandConfig(content string, timeout int) string {
+	return fmt.Sprintf(`
+resource "alicloud_ecs_command" "foo" {
+  name = "tf-testAccEcsCommand"
+  description = "tf-testAccEcsCommand"
+  type = "RunShellScript"
+  command_content = "%s"
+  working_dir = "/root"
+  timeout = %d
+}
+`, content, timeout)
+}
@@ -0,0 +1,118 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEcsDeploymentSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEcsDeploymentSetCreate,
+		Read:   resourceAlicloudEcsDeploymentSetRead,
+		Update: resourceAlicloudEcsDeploymentSetUpdate,
+		Delete: resourceAlicloudEcsDeploymentSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_set_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 256),
+			},
+			// The instances of the Availability deployment set are spread across the physical servers, and the
+			// ones of the LowLatency deployment set are placed close to each other in the network.
+			"strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      EcsDeploymentSetAvailability,
+				ValidateFunc: validateAllowedStringValue([]string{EcsDeploymentSetAvailability, EcsDeploymentSetLowLatency}),
+			},
+			"instance_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAlicloudEcsDeploymentSetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := EcsDeploymentSetArgs{
+		RegionId:          client.Region,
+		DeploymentSetName: d.Get("deployment_set_name").(string),
+		Description:       d.Get("description").(string),
+		Strategy:          d.Get("strategy").(string),
+	}
+	resp := CreateEcsDeploymentSetResponse{}
+	if err := client.ecsconn().Invoke("CreateDeploymentSet", &args, &resp); err != nil {
+		return WrapError(err, "CreateDeploymentSet", d.Id())
+	}
+
+	d.SetId(resp.DeploymentSetId)
+
+	return resourceAlicloudEcsDeploymentSetRead(d, meta)
+}
+
+func resourceAlicloudEcsDeploymentSetRead(d *schema.ResourceData, meta interface{}) error {
+	set, err := meta.(*AliyunClient).DescribeEcsDeploymentSet(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ecs") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDeploymentSets", d.Id())
+	}
+
+	d.Set("deployment_set_name", set.DeploymentSetName)
+	d.Set("description", set.DeploymentSetDescription)
+	d.Set("strategy", set.DeploymentStrategy)
+	if err := d.Set("instance_ids", set.InstanceIds.InstanceId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudEcsDeploymentSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("deployment_set_name") || d.HasChange("description") {
+		args := EcsDeploymentSetArgs{
+			RegionId:          client.Region,
+			DeploymentSetId:   d.Id(),
+			DeploymentSetName: d.Get("deployment_set_name").(string),
+			Description:       d.Get("description").(string),
+		}
+		if err := client.ecsconn().Invoke("ModifyDeploymentSetAttribute", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyDeploymentSetAttribute", d.Id())
+		}
+	}
+
+	return resourceAlicloudEcsDeploymentSetRead(d, meta)
+}
+
+func resourceAlicloudEcsDeploymentSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := EcsDeploymentSetArgs{
+		RegionId:        client.Region,
+		DeploymentSetId: d.Id(),
+	}
+	if err := client.ecsconn().Invoke("DeleteDeploymentSet", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "ecs") {
+			return nil
+		}
+		return WrapError(err, "DeleteDeploymentSet", d.Id())
+	}
+	return nil
+}
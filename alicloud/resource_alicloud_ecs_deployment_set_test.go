@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEcsDeploymentSet_basic(t *testing.T) {
+	var v EcsDeploymentSet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ecs_deployment_set.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEcsDeploymentSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEcsDeploymentSetConfig("tf-testAccEcsDeploymentSet"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsDeploymentSetExists("alicloud_ecs_deployment_set.foo", &v),
+					resource.TestCheckResourceAttr("alicloud_ecs_deployment_set.foo", "strategy", EcsDeploymentSetAvailability),
+					resource.TestCheckResourceAttr("alicloud_ecs_deployment_set.foo", "instance_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("alicloud_instance.foo", "deployment_set_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEcsDeploymentSetConfig("tf-testAccEcsDeploymentSetUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsDeploymentSetExists("alicloud_ecs_deployment_set.foo", &v),
+					resource.TestCheckResourceAttr("alicloud_ecs_deployment_set.foo", "deployment_set_name",
+						"tf-testAccEcsDeploymentSetUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEcsDeploymentSetExists(n string, d *EcsDeploymentSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No deployment set ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		set, err := client.DescribeEcsDeploymentSet(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *set
+		return nil
+	}
+}
+
+func testAccCheckEcsDeploymentSetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ecs_deployment_set" {
+			continue
+		}
+
+		if _, err := client.DescribeEcsDeploymentSet(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "ecs") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Deployment set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEcsDeploymentSetConfig(name string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf-testAccEcsDeploymentSet"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+	vpc_id = "${alicloud_vpc.foo.id}"
+	cidr_block = "172.16.0.0/21"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+	name = "tf-testAccEcsDeploymentSet"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_ecs_deployment_set" "foo" {
+	deployment_set_name = "%s"
+	description = "tf-testAccEcsDeploymentSet"
+}
+
+resource "alicloud_instance" "foo" {
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	image_id = "ubuntu_140405_32_40G_cloudinit_20161115.vhd"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	instance_type = "ecs.n4.large"
+	system_disk_category = "cloud_efficiency"
+	security_groups = ["${alicloud_security_group.foo.id}"]
+	instance_name = "tf-testAccEcsDeploymentSet"
+	deployment_set_id = "${alicloud_ecs_deployment_set.foo.id}"
+}
+`, name)
+}
@@ -0,0 +1,226 @@
+package alicloud
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEcsInvocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEcsInvocationCreate,
+		Read:   resourceAlicloudEcsInvocationRead,
+		Delete: resourceAlicloudEcsInvocationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"command_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			// The timed invocation runs by the frequency, like the cron expression 0 */20 * * * *, and the
+			// others run once and are waited for until they are finished.
+			"timed": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"frequency": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The results are the outputs of the last run of the command on the instances.
+			"results": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exit_code": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"output": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_info": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudEcsInvocationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := InvokeEcsCommandArgs{
+		RegionId:   client.Region,
+		CommandId:  d.Get("command_id").(string),
+		InstanceId: expandStringList(d.Get("instance_ids").(*schema.Set).List()),
+		Timed:      d.Get("timed").(bool),
+		Frequency:  d.Get("frequency").(string),
+	}
+	if args.Timed && args.Frequency == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "frequency is required when timed is true.")
+	}
+	if !args.Timed && args.Frequency != "" {
+		return ConfigErrorf(ErrorCodeInvalidArgument, "frequency can only be set when timed is true.")
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		parameters, err := json.Marshal(v.(map[string]interface{}))
+		if err != nil {
+			return WrapError(err, "InvokeCommand", d.Id())
+		}
+		args.Parameters = string(parameters)
+	}
+
+	resp := InvokeEcsCommandResponse{}
+	if err := client.ecsconn().Invoke("InvokeCommand", &args, &resp); err != nil {
+		return WrapError(err, "InvokeCommand", d.Id())
+	}
+
+	d.SetId(resp.InvokeId)
+
+	// The invocation which runs once is waited for until it is finished, so that the outputs are captured in
+	// the results. A failed command does not fail the resource, and its exit code and error are in the results.
+	if !args.Timed {
+		finished := []string{EcsInvocationSuccess, EcsInvocationFailed, EcsInvocationPartialFailed, EcsInvocationStopped}
+		if err := client.WaitForEcsInvocation(d.Id(), finished, deadline.Seconds()); err != nil {
+			return WrapError(err, "WaitForEcsInvocation", d.Id())
+		}
+	}
+
+	return resourceAlicloudEcsInvocationRead(d, meta)
+}
+
+func resourceAlicloudEcsInvocationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	invocation, err := client.DescribeEcsInvocation(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ecs") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeInvocations", d.Id())
+	}
+
+	d.Set("command_id", invocation.CommandId)
+	d.Set("timed", invocation.Timed)
+	d.Set("frequency", invocation.Frequency)
+	d.Set("status", invocation.InvocationStatus)
+	if invocation.Parameters != "" {
+		parameters := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(invocation.Parameters), &parameters); err != nil {
+			return WrapError(err, "DescribeInvocations", d.Id())
+		}
+		if err := d.Set("parameters", parameters); err != nil {
+			return err
+		}
+	}
+
+	results, err := client.DescribeEcsInvocationResults(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeInvocationResults", d.Id())
+	}
+	var instanceIds []string
+	var items []map[string]interface{}
+	for _, result := range results {
+		output, err := base64.StdEncoding.DecodeString(result.Output)
+		if err != nil {
+			return WrapError(err, "DescribeInvocationResults", d.Id())
+		}
+		instanceIds = append(instanceIds, result.InstanceId)
+		items = append(items, map[string]interface{}{
+			"instance_id": result.InstanceId,
+			"status":      result.InvocationStatus,
+			"exit_code":   result.ExitCode,
+			"output":      string(output),
+			"error_info":  result.ErrorInfo,
+		})
+	}
+	if err := d.Set("instance_ids", instanceIds); err != nil {
+		return err
+	}
+	if err := d.Set("results", items); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudEcsInvocationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	invocation, err := client.DescribeEcsInvocation(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ecs") {
+			return nil
+		}
+		return WrapError(err, "DescribeInvocations", d.Id())
+	}
+
+	// The invocations are kept as the records of the Cloud Assistant, and only the timed and the running ones
+	// are stopped.
+	switch invocation.InvocationStatus {
+	case EcsInvocationPending, EcsInvocationScheduled, EcsInvocationRunning:
+	default:
+		log.Printf("[WARN] Cloud Assistant invocation %s is %s and can not be deleted, and it is only removed from the state.", d.Id(), invocation.InvocationStatus)
+		return nil
+	}
+
+	args := EcsInvocationArgs{
+		RegionId: client.Region,
+		InvokeId: d.Id(),
+	}
+	if err := client.ecsconn().Invoke("StopInvocation", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "ecs") {
+			return nil
+		}
+		return WrapError(err, "StopInvocation", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEcsInvocation_basic(t *testing.T) {
+	var v EcsInvocation
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ecs_invocation.foo",
+
+		Providers: testAccProviders,
+		// The finished invocations are kept by the Cloud Assistant, so there is no destroy check.
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEcsInvocationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsInvocationExists(
+						"alicloud_ecs_invocation.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_invocation.foo",
+						"status",
+						EcsInvocationSuccess),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_invocation.foo",
+						"results.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_invocation.foo",
+						"results.0.exit_code",
+						"0"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ecs_invocation.foo",
+						"results.0.output",
+						"hello terraform\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEcsInvocationExists(n string, d *EcsInvocation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cloud Assistant invocation ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		invocation, err := client.DescribeEcsInvocation(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *invocation
+		return nil
+	}
+}
+
+const testAccEcsInvocationConfig = `
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+	"available_resource_creation"= "VSwitch"
+}
+
+data "alicloud_images" "default" {
+	most_recent = true
+	owners = "system"
+	name_regex = "^centos_7"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf-testAccEcsInvocation"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+	vpc_id = "${alicloud_vpc.foo.id}"
+	cidr_block = "172.16.0.0/21"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+	name = "tf-testAccEcsInvocation"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_instance" "foo" {
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	image_id = "${data.alicloud_images.default.images.0.id}"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	instance_type = "ecs.n4.large"
+	system_disk_category = "cloud_efficiency"
+	security_groups = ["${alicloud_security_group.foo.id}"]
+	instance_name = "tf-testAccEcsInvocation"
+}
+
+resource "alicloud_ecs_command" "foo" {
+	name = "tf-testAccEcsInvocation"
+	type = "RunShellScript"
+	command_content = "echo hello {{name}}"
+	enable_parameter = true
+}
+
+resource "alicloud_ecs_invocation" "foo" {
+	command_id = "${alicloud_ecs_command.foo.id}"
+	instance_ids = ["${alicloud_instance.foo.id}"]
+	parameters {
+		name = "terraform"
+	}
+}
+`
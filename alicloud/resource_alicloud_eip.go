@@ -21,6 +21,12 @@ func resourceAliyunEip() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bandwidth": &schema.Schema{
 				Type:     schema.TypeInt,
@@ -34,6 +40,32 @@ func resourceAliyunEip() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateInternetChargeType,
 			},
+			"isp": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(EipISPBGP), string(EipISPBGPPro)}),
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(common.PostPaid),
+				ValidateFunc: validateInstanceChargeType,
+			},
+			// The period of the PrePaid EIP in months.
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+			},
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 
 			"ip_address": &schema.Schema{
 				Type:     schema.TypeString,
@@ -50,29 +82,31 @@ func resourceAliyunEip() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunEipCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
 
 	args, err := buildAliyunEipArgs(d, meta)
 	if err != nil {
 		return err
 	}
 
-	_, allocationID, err := conn.AllocateEipAddress(args)
-	if err != nil {
-		return err
+	resp := AllocateVpcEipAddressResponse{}
+	if err := client.vpcconn().Invoke("AllocateEipAddress", args, &resp); err != nil {
+		return WrapError(err, "AllocateEipAddress", "")
 	}
 
-	err = conn.WaitForEip(getRegion(d, meta), allocationID, ecs.EipStatusAvailable, 60)
+	err = client.ecsconn().WaitForEip(getRegion(d, meta), resp.AllocationId, ecs.EipStatusAvailable, deadline.Seconds())
 	if err != nil {
 		return fmt.Errorf("Error Waitting for EIP available: %#v", err)
 	}
 
-	d.SetId(allocationID)
+	d.SetId(resp.AllocationId)
 
 	return resourceAliyunEipUpdate(d, meta)
 }
@@ -80,9 +114,9 @@ func resourceAliyunEipCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAliyunEipRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
-	eip, err := client.DescribeEipAddress(d.Id())
+	eip, err := client.DescribeVpcEipAddress(d.Id())
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			d.SetId("")
 			return nil
 		}
@@ -99,43 +133,102 @@ func resourceAliyunEipRead(d *schema.ResourceData, meta interface{}) error {
 	bandwidth, _ := strconv.Atoi(eip.Bandwidth)
 	d.Set("bandwidth", bandwidth)
 	d.Set("internet_charge_type", eip.InternetChargeType)
+	d.Set("isp", eip.ISP)
+	d.Set("instance_charge_type", eip.ChargeType)
+	d.Set("deletion_protection", eip.DeletionProtection)
 	d.Set("ip_address", eip.IpAddress)
 	d.Set("status", eip.Status)
 
+	tags, err := describeVpcTags(client, VpcTagResourceEip, d.Id())
+	if err != nil {
+		return fmt.Errorf("Describing tags of %s got an error: %#v", d.Id(), err)
+	}
+	if err := d.Set("tags", ignoreDefaultTags(client, d, tags)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceAliyunEipUpdate(d *schema.ResourceData, meta interface{}) error {
-
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
 
 	d.Partial(true)
 
 	if d.HasChange("bandwidth") && !d.IsNewResource() {
-		err := conn.ModifyEipAddressAttribute(d.Id(), d.Get("bandwidth").(int))
-		if err != nil {
-			return err
+		bandwidth := d.Get("bandwidth").(int)
+		if err := client.ecsconn().ModifyEipAddressAttribute(d.Id(), bandwidth); err != nil {
+			return WrapError(err, "ModifyEipAddressAttribute", d.Id())
+		}
+
+		// The bandwidth of the PrePaid EIP is changed after its order is paid.
+		waiter := &stateWaiter{
+			Refresh: func() (interface{}, string, error) {
+				eip, err := client.DescribeVpcEipAddress(d.Id())
+				if err != nil {
+					return nil, "", WrapError(err, "DescribeEipAddresses", d.Id())
+				}
+				return eip, eip.Bandwidth, nil
+			},
+			Target:  []string{strconv.Itoa(bandwidth)},
+			Timeout: deadline.Remaining(),
+		}
+		if _, err := waiter.Wait(); err != nil {
+			return fmt.Errorf("Waiting for the bandwidth of EIP %s to be modified got an error: %s", d.Id(), err)
 		}
 
 		d.SetPartial("bandwidth")
 	}
 
+	// The deletion protection is off when the EIP is allocated.
+	if d.HasChange("deletion_protection") && (!d.IsNewResource() || d.Get("deletion_protection").(bool)) {
+		args := DeletionProtectionArgs{
+			RegionId:         client.Region,
+			InstanceId:       d.Id(),
+			Type:             "EIP",
+			ProtectionEnable: d.Get("deletion_protection").(bool),
+		}
+		if err := client.vpcconn().Invoke("DeletionProtection", &args, &common.Response{}); err != nil {
+			return WrapError(err, "DeletionProtection", d.Id())
+		}
+
+		d.SetPartial("deletion_protection")
+	}
+
+	if err := setVpcTags(client, VpcTagResourceEip, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
 	d.Partial(false)
 
 	return resourceAliyunEipRead(d, meta)
 }
 
 func resourceAliyunEipDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	if d.Get("instance_charge_type").(string) == string(common.PrePaid) {
+		return ConfigErrorf(ErrorCodeUnsupportedOperation, "At present, 'PrePaid' EIP %s cannot be deleted and must wait it to be expired and release it automatically.", d.Id())
+	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.ReleaseEipAddress(d.Id())
 
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == EipIncorrectStatus {
+			if IsExceptedError(err, EipIncorrectStatus) {
 				return resource.RetryableError(fmt.Errorf("EIP in use - trying again while it is deleted."))
 			}
+			if NotFoundError(err, "vpc") {
+				return nil
+			}
+			if d.Get("deletion_protection").(bool) {
+				return resource.NonRetryableError(fmt.Errorf("EIP %s is protected from deletion, and deletion_protection "+
+					"must be turned off before it is deleted: %#v", d.Id(), err))
+			}
+			return resource.NonRetryableError(WrapError(err, "ReleaseEipAddress", d.Id()))
 		}
 
 		args := &ecs.DescribeEipAddressesArgs{
@@ -153,12 +246,32 @@ func resourceAliyunEipDelete(d *schema.ResourceData, meta interface{}) error {
 	})
 }
 
-func buildAliyunEipArgs(d *schema.ResourceData, meta interface{}) (*ecs.AllocateEipAddressArgs, error) {
+func buildAliyunEipArgs(d *schema.ResourceData, meta interface{}) (*AllocateVpcEipAddressArgs, error) {
 
-	args := &ecs.AllocateEipAddressArgs{
+	args := &AllocateVpcEipAddressArgs{
 		RegionId:           getRegion(d, meta),
-		Bandwidth:          d.Get("bandwidth").(int),
-		InternetChargeType: common.InternetChargeType(d.Get("internet_charge_type").(string)),
+		Bandwidth:          strconv.Itoa(d.Get("bandwidth").(int)),
+		InternetChargeType: d.Get("internet_charge_type").(string),
+		InstanceChargeType: d.Get("instance_charge_type").(string),
+		ISP:                d.Get("isp").(string),
+		ClientToken:        buildClientToken("tf-eip-"),
+	}
+
+	if args.InstanceChargeType == string(common.PrePaid) {
+		if common.InternetChargeType(args.InternetChargeType) != common.PayByBandwidth {
+			return nil, ConfigErrorf(ErrorCodeInvalidArgument, "internet_charge_type must be %s when instance_charge_type is PrePaid", common.PayByBandwidth)
+		}
+		period := d.Get("period").(int)
+		if period == 0 {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "period is required for instance_charge_type is PrePaid")
+		}
+		args.Period = period
+		args.PricingCycle = "Month"
+		if period >= 12 {
+			args.Period = period / 12
+			args.PricingCycle = "Year"
+		}
+		args.AutoPay = true
 	}
 
 	return args, nil
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -16,6 +15,14 @@ func resourceAliyunEipAssociation() *schema.Resource {
 		Create: resourceAliyunEipAssociationCreate,
 		Read:   resourceAliyunEipAssociationRead,
 		Delete: resourceAliyunEipAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": &schema.Schema{
@@ -37,7 +44,7 @@ func resourceAliyunEipAssociation() *schema.Resource {
 
 func resourceAliyunEipAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	allocationId := d.Get("allocation_id").(string)
 	instanceId := d.Get("instance_id").(string)
@@ -46,7 +53,7 @@ func resourceAliyunEipAssociationCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	if err := conn.WaitForEip(getRegion(d, meta), allocationId, ecs.EipStatusInUse, 60); err != nil {
+	if err := conn.WaitForEip(getRegion(d, meta), allocationId, ecs.EipStatusInUse, int(d.Timeout(schema.TimeoutCreate)/time.Second)); err != nil {
 		return fmt.Errorf("Error Waitting for EIP allocated: %#v", err)
 	}
 
@@ -66,7 +73,7 @@ func resourceAliyunEipAssociationRead(d *schema.ResourceData, meta interface{})
 	eip, err := client.DescribeEipAddress(allocationId)
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			d.SetId("")
 			return nil
 		}
@@ -84,21 +91,20 @@ func resourceAliyunEipAssociationRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAliyunEipAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	allocationId, instanceId, err := getAllocationIdAndInstanceId(d, meta)
 	if err != nil {
 		return err
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.UnassociateEipAddress(allocationId, instanceId)
 
 		if err != nil {
-			e, _ := err.(*common.Error)
-			errCode := e.ErrorResponse.Code
-			if errCode == InstanceIncorrectStatus || errCode == HaVipIncorrectStatus {
+			if IsExceptedError(err, InstanceIncorrectStatus) || IsExceptedError(err, HaVipIncorrectStatus) {
 				return resource.RetryableError(fmt.Errorf("Eip in use - trying again while make it unassociated."))
 			}
 		}
@@ -129,7 +135,7 @@ func getAllocationIdAndInstanceId(d *schema.ResourceData, meta interface{}) (str
 	parts := strings.Split(d.Id(), ":")
 
 	if len(parts) != 2 {
-		return "", "", fmt.Errorf("invalid resource id")
+		return "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "invalid resource id")
 	}
 	return parts[0], parts[1], nil
 }
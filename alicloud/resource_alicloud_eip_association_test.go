@@ -87,7 +87,7 @@ func testAccCheckEIPAssociationDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the EIP
-		eips, _, err := client.ecsconn.DescribeEipAddresses(&ecs.DescribeEipAddressesArgs{
+		eips, _, err := client.ecsconn().DescribeEipAddresses(&ecs.DescribeEipAddressesArgs{
 			RegionId:     client.Region,
 			AllocationId: rs.Primary.Attributes["allocation_id"],
 		})
@@ -142,6 +142,9 @@ resource "alicloud_instance" "instance" {
 }
 
 resource "alicloud_eip" "eip" {
+  tags {
+    Name = "tf-testAccEipAssociation"
+  }
 }
 
 resource "alicloud_eip_association" "foo" {
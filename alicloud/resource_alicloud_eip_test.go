@@ -4,12 +4,67 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
 )
 
+func init() {
+	addTestSweepers("alicloud_eip", &testSweeper{
+		Dependencies: []string{
+			"alicloud_instance",
+			"alicloud_slb",
+			"alicloud_nat_gateway",
+		},
+		F: testSweepEips,
+	})
+}
+
+// testSweepEips releases the EIPs tagged with a Name of the acceptance tests, because the EIPs have no name.
+func testSweepEips(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.ecsconn()
+
+	var ids []string
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		eips, _, err := conn.DescribeEipAddresses(&ecs.DescribeEipAddressesArgs{
+			RegionId:   client.Region,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeEipAddresses", "")
+		}
+		for _, eip := range eips {
+			tags, err := describeVpcTags(client, VpcTagResourceEip, eip.AllocationId)
+			if err != nil {
+				return 0, WrapError(err, "ListTagResources", eip.AllocationId)
+			}
+			if !isSweepable(tags["Name"]) {
+				log.Printf("[INFO] Skipping EIP %s (%s)", eip.IpAddress, eip.AllocationId)
+				continue
+			}
+			ids = append(ids, eip.AllocationId)
+		}
+		return len(eips), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Releasing EIP %s", id)
+		if err := conn.ReleaseEipAddress(id); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "ReleaseEipAddress", id))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudEIP_basic(t *testing.T) {
 	var eip ecs.EipAddressSetType
 
@@ -49,6 +104,40 @@ func TestAccAlicloudEIP_basic(t *testing.T) {
 
 }
 
+func TestAccAlicloudEIP_deletionProtection(t *testing.T) {
+	var eip ecs.EipAddressSetType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_eip.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEIPConfigDeletionProtection(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPExists("alicloud_eip.foo", &eip),
+					resource.TestCheckResourceAttr("alicloud_eip.foo", "isp", "BGP"),
+					resource.TestCheckResourceAttr("alicloud_eip.foo", "instance_charge_type", "PostPaid"),
+					resource.TestCheckResourceAttr("alicloud_eip.foo", "deletion_protection", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEIPConfigDeletionProtection(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPExists("alicloud_eip.foo", &eip),
+					resource.TestCheckResourceAttr("alicloud_eip.foo", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEIPExists(n string, eip *ecs.EipAddressSetType) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -97,7 +186,7 @@ func testAccCheckEIPDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the EIP
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		args := &ecs.DescribeEipAddressesArgs{
 			RegionId:     client.Region,
@@ -120,6 +209,9 @@ func testAccCheckEIPDestroy(s *terraform.State) error {
 
 const testAccEIPConfig = `
 resource "alicloud_eip" "foo" {
+    tags {
+        Name = "tf-testAccEIPConfig"
+    }
 }
 `
 
@@ -127,5 +219,20 @@ const testAccEIPConfigTwo = `
 resource "alicloud_eip" "foo" {
     bandwidth = "10"
     internet_charge_type = "PayByBandwidth"
+    tags {
+        Name = "tf-testAccEIPConfigTwo"
+    }
 }
 `
+
+func testAccEIPConfigDeletionProtection(protected bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_eip" "foo" {
+    isp = "BGP"
+    deletion_protection = %t
+    tags {
+        Name = "tf-testAccEIPConfigDeletionProtection"
+    }
+}
+`, protected)
+}
@@ -0,0 +1,510 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEmrCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEmrClusterCreate,
+		Read:   resourceAlicloudEmrClusterRead,
+		Update: resourceAlicloudEmrClusterUpdate,
+		Delete: resourceAlicloudEmrClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 64),
+			},
+			"emr_ver": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(EmrClusterTypes),
+			},
+			"charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "PostPaid",
+				ValidateFunc: validateAllowedStringValue([]string{"PostPaid", "PrePaid"}),
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_open_public_ip": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"ssh_enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"master_pwd": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			// The high available clusters have 2 master nodes, or 3 ones for the HADOOP clusters of EMR-4.x.
+			"high_availability_enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"use_local_meta_db": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"deposit_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "HALF_MANAGED",
+				ValidateFunc: validateAllowedStringValue([]string{"HALF_MANAGED", "FULL_MANAGED"}),
+			},
+			"user_defined_emr_ecs_role": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// The host groups can be resized by adding the nodes or the new host groups only, and the other
+			// arguments of them can not be changed.
+			"host_group": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_group_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"host_group_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(EmrHostGroupTypes),
+						},
+						"node_count": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(1, 1000),
+						},
+						"instance_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"disk_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(EmrDiskTypes),
+						},
+						"disk_capacity": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"disk_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"sys_disk_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "cloud_efficiency",
+							ValidateFunc: validateAllowedStringValue(EmrDiskTypes),
+						},
+						"sys_disk_capacity": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  120,
+						},
+						"host_group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"bootstrap_action": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// The path is the oss path of the script, like oss://bucket/bootstrap.sh.
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"arg": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudEmrClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CreateEmrClusterArgs{
+		RegionId:               client.Region,
+		Name:                   d.Get("name").(string),
+		ClusterType:            d.Get("cluster_type").(string),
+		EmrVer:                 d.Get("emr_ver").(string),
+		ChargeType:             d.Get("charge_type").(string),
+		NetType:                "vpc",
+		VSwitchId:              d.Get("vswitch_id").(string),
+		SecurityGroupId:        d.Get("security_group_id").(string),
+		IsOpenPublicIp:         d.Get("is_open_public_ip").(bool),
+		SshEnable:              d.Get("ssh_enable").(bool),
+		MasterPwd:              d.Get("master_pwd").(string),
+		HighAvailabilityEnable: d.Get("high_availability_enable").(bool),
+		UseLocalMetaDb:         d.Get("use_local_meta_db").(bool),
+		DepositType:            d.Get("deposit_type").(string),
+		MachineType:            "ECS",
+		UserDefinedEmrEcsRole:  d.Get("user_defined_emr_ecs_role").(string),
+	}
+	if args.ChargeType == "PrePaid" {
+		args.Period = d.Get("period").(int)
+		if args.Period == 0 {
+			return ConfigErrorf(ErrorCodeMissingArgument, "period is required when charge_type is PrePaid.")
+		}
+	}
+
+	vpcId, zoneId, err := client.DescribeVSwitchPlacement(args.VSwitchId, "")
+	if err != nil {
+		return err
+	}
+	args.VpcId = vpcId
+	args.ZoneId = zoneId
+
+	masters := 0
+	for _, v := range d.Get("host_group").([]interface{}) {
+		group := expandEmrHostGroup(v.(map[string]interface{}))
+		group.ChargeType = args.ChargeType
+		group.Period = args.Period
+		if group.HostGroupType == EmrHostGroupMaster {
+			masters += group.NodeCount
+		}
+		args.HostGroup = append(args.HostGroup, group)
+	}
+	if masters == 0 {
+		return ConfigErrorf(ErrorCodeMissingArgument, "A host_group of the %s type is required.", EmrHostGroupMaster)
+	}
+	if args.HighAvailabilityEnable && masters < 2 {
+		return ConfigErrorf(ErrorCodeInvalidArgument, "At least 2 master nodes are required when high_availability_enable is true.")
+	}
+
+	for _, v := range d.Get("bootstrap_action").([]interface{}) {
+		action := v.(map[string]interface{})
+		args.BootstrapAction = append(args.BootstrapAction, EmrBootstrapAction{
+			Name: action["name"].(string),
+			Path: action["path"].(string),
+			Arg:  action["arg"].(string),
+		})
+	}
+
+	resp := CreateEmrClusterResponse{}
+	if err := client.emrconn().Invoke("CreateClusterV2", &args, &resp); err != nil {
+		return WrapError(err, "CreateClusterV2", d.Id())
+	}
+
+	d.SetId(resp.ClusterId)
+
+	if err := client.WaitForEmrCluster(d.Id(), deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForEmrCluster", d.Id())
+	}
+
+	return resourceAlicloudEmrClusterRead(d, meta)
+}
+
+func resourceAlicloudEmrClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	cluster, err := client.DescribeEmrCluster(d.Id())
+	if err != nil {
+		if NotFoundError(err, "emr") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeClusterV2", d.Id())
+	}
+
+	d.Set("name", cluster.Name)
+	d.Set("emr_ver", cluster.SoftwareInfo.EmrVer)
+	d.Set("cluster_type", cluster.SoftwareInfo.ClusterType)
+	d.Set("charge_type", cluster.ChargeType)
+	d.Set("vswitch_id", cluster.VSwitchId)
+	d.Set("security_group_id", cluster.SecurityGroupId)
+	d.Set("zone_id", cluster.ZoneId)
+	d.Set("high_availability_enable", cluster.HighAvailabilityEnable)
+	d.Set("use_local_meta_db", cluster.LocalMetaDb)
+	d.Set("deposit_type", cluster.DepositType)
+	d.Set("user_defined_emr_ecs_role", cluster.UserDefinedEmrEcsRole)
+	d.Set("status", cluster.Status)
+	if cluster.ChargeType == "PrePaid" {
+		d.Set("period", cluster.Period)
+	}
+
+	var actions []map[string]interface{}
+	for _, action := range cluster.BootstrapActionList.BootstrapAction {
+		actions = append(actions, map[string]interface{}{
+			"name": action.Name,
+			"path": action.Path,
+			"arg":  action.Arg,
+		})
+	}
+	if err := d.Set("bootstrap_action", actions); err != nil {
+		return err
+	}
+
+	groups, err := client.DescribeEmrClusterHostGroups(d.Id())
+	if err != nil {
+		return WrapError(err, "ListClusterHostGroup", d.Id())
+	}
+	if err := d.Set("host_group", flattenEmrHostGroups(d, groups)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudEmrClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		args := EmrClusterArgs{
+			RegionId: client.Region,
+			Id:       d.Id(),
+			Name:     d.Get("name").(string),
+		}
+		if err := client.emrconn().Invoke("ModifyClusterName", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyClusterName", d.Id())
+		}
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("host_group") {
+		groups, err := buildEmrResizeHostGroups(d)
+		if err != nil {
+			return err
+		}
+		if len(groups) > 0 {
+			args := ResizeEmrClusterArgs{
+				RegionId:       client.Region,
+				ClusterId:      d.Id(),
+				VswitchId:      d.Get("vswitch_id").(string),
+				IsOpenPublicIp: d.Get("is_open_public_ip").(bool),
+				HostGroup:      groups,
+			}
+			if err := client.emrconn().Invoke("ResizeClusterV2", &args, &common.Response{}); err != nil {
+				return WrapError(err, "ResizeClusterV2", d.Id())
+			}
+			// The cluster turns into RESIZING after a while.
+			time.Sleep(DefaultIntervalShort * time.Second)
+			if err := client.WaitForEmrCluster(d.Id(), deadline.Seconds()); err != nil {
+				return WrapError(err, "WaitForEmrCluster", d.Id())
+			}
+		}
+		d.SetPartial("host_group")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudEmrClusterRead(d, meta)
+}
+
+func resourceAlicloudEmrClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := EmrClusterArgs{
+		RegionId:     client.Region,
+		Id:           d.Id(),
+		ForceRelease: true,
+	}
+	if err := client.emrconn().Invoke("ReleaseCluster", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "emr") {
+			return nil
+		}
+		return WrapError(err, "ReleaseCluster", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeEmrCluster(d.Id()); err != nil {
+			if NotFoundError(err, "emr") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DescribeClusterV2", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("EMR cluster %s is still being released", d.Id()))
+	})
+}
+
+func expandEmrHostGroup(group map[string]interface{}) EmrHostGroup {
+	return EmrHostGroup{
+		HostGroupName:   group["host_group_name"].(string),
+		HostGroupType:   group["host_group_type"].(string),
+		NodeCount:       group["node_count"].(int),
+		InstanceType:    group["instance_type"].(string),
+		DiskType:        group["disk_type"].(string),
+		DiskCapacity:    group["disk_capacity"].(int),
+		DiskCount:       group["disk_count"].(int),
+		SysDiskType:     group["sys_disk_type"].(string),
+		SysDiskCapacity: group["sys_disk_capacity"].(int),
+	}
+}
+
+// flattenEmrHostGroups returns the host groups in the order of the configured ones, and the host groups added
+// out of band are put at the end.
+func flattenEmrHostGroups(d *schema.ResourceData, groups []EmrHostGroupInfo) []map[string]interface{} {
+	byName := make(map[string]EmrHostGroupInfo)
+	for _, group := range groups {
+		byName[group.HostGroupName] = group
+	}
+
+	var names []string
+	for _, v := range d.Get("host_group").([]interface{}) {
+		name := v.(map[string]interface{})["host_group_name"].(string)
+		if _, ok := byName[name]; ok {
+			names = append(names, name)
+		}
+	}
+	for _, group := range groups {
+		found := false
+		for _, name := range names {
+			if name == group.HostGroupName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			names = append(names, group.HostGroupName)
+		}
+	}
+
+	var result []map[string]interface{}
+	for _, name := range names {
+		group := byName[name]
+		result = append(result, map[string]interface{}{
+			"host_group_name":   group.HostGroupName,
+			"host_group_type":   group.HostGroupType,
+			"node_count":        group.NodeCount,
+			"instance_type":     group.InstanceType,
+			"disk_type":         group.DataDiskCategory,
+			"disk_capacity":     group.DataDiskSize,
+			"disk_count":        group.DataDiskCount,
+			"sys_disk_type":     group.SystemDiskCategory,
+			"sys_disk_capacity": group.SystemDiskSize,
+			"host_group_id":     group.HostGroupId,
+		})
+	}
+	return result
+}
+
+// buildEmrResizeHostGroups returns the host groups of the ResizeClusterV2 by the changes of the host_group. The
+// existing host groups can only be scaled out, and the new ones are added as they are.
+func buildEmrResizeHostGroups(d *schema.ResourceData) ([]EmrHostGroup, error) {
+	o, n := d.GetChange("host_group")
+	existing := make(map[string]map[string]interface{})
+	for _, v := range o.([]interface{}) {
+		group := v.(map[string]interface{})
+		existing[group["host_group_name"].(string)] = group
+	}
+
+	var groups []EmrHostGroup
+	for _, v := range n.([]interface{}) {
+		group := v.(map[string]interface{})
+		name := group["host_group_name"].(string)
+		old, ok := existing[name]
+		if !ok {
+			newGroup := expandEmrHostGroup(group)
+			newGroup.ChargeType = d.Get("charge_type").(string)
+			newGroup.Period = d.Get("period").(int)
+			groups = append(groups, newGroup)
+			continue
+		}
+		delete(existing, name)
+
+		for _, k := range []string{"host_group_type", "instance_type", "disk_type", "disk_capacity", "disk_count", "sys_disk_type", "sys_disk_capacity"} {
+			if old[k] != group[k] {
+				return nil, ConfigErrorf(ErrorCodeUnsupportedOperation, "The %s of the host group %s can not be changed.", k, name)
+			}
+		}
+		added := group["node_count"].(int) - old["node_count"].(int)
+		if added < 0 {
+			return nil, ConfigErrorf(ErrorCodeUnsupportedOperation, "The node_count of the host group %s can not be decreased.", name)
+		}
+		if added > 0 {
+			resized := expandEmrHostGroup(group)
+			resized.HostGroupId = old["host_group_id"].(string)
+			resized.NodeCount = added
+			resized.ChargeType = d.Get("charge_type").(string)
+			resized.Period = d.Get("period").(int)
+			groups = append(groups, resized)
+		}
+	}
+	for name := range existing {
+		return nil, ConfigErrorf(ErrorCodeUnsupportedOperation, "The host group %s can not be removed.", name)
+	}
+	return groups, nil
+}
@@ -0,0 +1,265 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEmrCluster_basic(t *testing.T) {
+	var v EmrCluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_emr_cluster.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEmrClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEmrClusterConfig("tf-testAccEmrCluster", 2, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmrClusterExists(
+						"alicloud_emr_cluster.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"cluster_type",
+						"HADOOP"),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"host_group.#",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"host_group.1.node_count",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"bootstrap_action.#",
+						"0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEmrClusterConfig("tf-testAccEmrClusterUpdate", 3, testAccEmrClusterTaskGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmrClusterExists(
+						"alicloud_emr_cluster.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"name",
+						"tf-testAccEmrClusterUpdate"),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"host_group.#",
+						"3"),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"host_group.1.node_count",
+						"3"),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"host_group.2.host_group_type",
+						EmrHostGroupTask),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudEmrCluster_highAvailability(t *testing.T) {
+	var v EmrCluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_emr_cluster.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEmrClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEmrClusterHighAvailabilityConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmrClusterExists(
+						"alicloud_emr_cluster.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"high_availability_enable",
+						"true"),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"host_group.0.node_count",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_emr_cluster.foo",
+						"bootstrap_action.#",
+						"1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmrClusterExists(n string, d *EmrCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR cluster ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		cluster, err := client.DescribeEmrCluster(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *cluster
+		return nil
+	}
+}
+
+func testAccCheckEmrClusterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_emr_cluster" {
+			continue
+		}
+
+		if _, err := client.DescribeEmrCluster(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "emr") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("EMR cluster %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccEmrClusterBase = `
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf-testAccEmrCluster"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+	vpc_id = "${alicloud_vpc.foo.id}"
+	cidr_block = "172.16.0.0/21"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+	name = "tf-testAccEmrCluster"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+`
+
+const testAccEmrClusterTaskGroup = `
+  host_group {
+    host_group_name = "task"
+    host_group_type = "TASK"
+    node_count = 1
+    instance_type = "ecs.g6.xlarge"
+    disk_type = "cloud_efficiency"
+    disk_capacity = 80
+    disk_count = 4
+  }
+`
+
+func testAccEmrClusterConfig(name string, cores int, taskGroup string) string {
+	return testAccEmrClusterBase + fmt.Sprintf(`
+resource "alicloud_emr_cluster" "foo" {
+  name = "%s"
+  emr_ver = "EMR-3.22.0"
+  cluster_type = "HADOOP"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  security_group_id = "${alicloud_security_group.foo.id}"
+  master_pwd = "Tf-testAcc123"
+
+  host_group {
+    host_group_name = "master"
+    host_group_type = "MASTER"
+    node_count = 1
+    instance_type = "ecs.g6.xlarge"
+    disk_type = "cloud_efficiency"
+    disk_capacity = 80
+    disk_count = 1
+  }
+  host_group {
+    host_group_name = "core"
+    host_group_type = "CORE"
+    node_count = %d
+    instance_type = "ecs.g6.xlarge"
+    disk_type = "cloud_efficiency"
+    disk_capacity = 80
+    disk_count = 4
+  }
+%s}
+`, name, cores, taskGroup)
+}
+
+const testAccEmrClusterHighAvailabilityConfig = testAccEmrClusterBase + `
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "tf-test-acc-emr-cluster"
+}
+
+resource "alicloud_oss_bucket_object" "foo" {
+  bucket = "${alicloud_oss_bucket.foo.bucket}"
+  key = "bootstrap.sh"
+  content = "echo $1"
+}
+
+resource "alicloud_emr_cluster" "foo" {
+  name = "tf-testAccEmrClusterHighAvailability"
+  emr_ver = "EMR-3.22.0"
+  cluster_type = "HADOOP"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  security_group_id = "${alicloud_security_group.foo.id}"
+  master_pwd = "Tf-testAcc123"
+  high_availability_enable = true
+
+  host_group {
+    host_group_name = "master"
+    host_group_type = "MASTER"
+    node_count = 2
+    instance_type = "ecs.g6.xlarge"
+    disk_type = "cloud_efficiency"
+    disk_capacity = 80
+    disk_count = 1
+  }
+  host_group {
+    host_group_name = "core"
+    host_group_type = "CORE"
+    node_count = 2
+    instance_type = "ecs.g6.xlarge"
+    disk_type = "cloud_efficiency"
+    disk_capacity = 80
+    disk_count = 4
+  }
+
+  bootstrap_action {
+    name = "hello"
+    path = "oss://${alicloud_oss_bucket.foo.bucket}/${alicloud_oss_bucket_object.foo.key}"
+    arg = "hello"
+  }
+}
+`
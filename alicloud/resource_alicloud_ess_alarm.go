@@ -0,0 +1,274 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEssAlarm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunEssAlarmCreate,
+		Read:   resourceAliyunEssAlarmRead,
+		Update: resourceAliyunEssAlarmUpdate,
+		Delete: resourceAliyunEssAlarmDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alarm_actions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MaxItems: 5,
+				Set:      schema.HashString,
+			},
+			"metric_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "system",
+				ValidateFunc: validateAllowedStringValue([]string{"system", "custom"}),
+			},
+			"metric_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validateAllowedIntValue([]int{60, 120, 300, 900}),
+			},
+			"statistics": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Average",
+				ValidateFunc: validateAllowedStringValue([]string{"Average", "Minimum", "Maximum"}),
+			},
+			"threshold": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"comparison_operator": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ">=",
+				ValidateFunc: validateAllowedStringValue([]string{">=", "<=", ">", "<"}),
+			},
+			"evaluation_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validateIntegerInRange(1, 100),
+			},
+			"cloud_monitor_group_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"dimensions": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunEssAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := CreateEssAlarmArgs{
+		RegionId:           client.Region,
+		ScalingGroupId:     d.Get("scaling_group_id").(string),
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		MetricType:         d.Get("metric_type").(string),
+		MetricName:         d.Get("metric_name").(string),
+		Period:             d.Get("period").(int),
+		Statistics:         d.Get("statistics").(string),
+		Threshold:          d.Get("threshold").(float64),
+		ComparisonOperator: d.Get("comparison_operator").(string),
+		EvaluationCount:    d.Get("evaluation_count").(int),
+		GroupId:            d.Get("cloud_monitor_group_id").(int),
+		AlarmAction:        expandStringList(d.Get("alarm_actions").(*schema.Set).List()),
+		Dimension:          expandEssAlarmDimensions(d.Get("dimensions").(map[string]interface{})),
+	}
+
+	if args.MetricType == "custom" && args.GroupId == 0 {
+		return ConfigErrorf(ErrorCodeMissingArgument, "'cloud_monitor_group_id' is required when 'metric_type' is custom.")
+	}
+
+	resp := CreateEssAlarmResponse{}
+	if err := client.essconn().Invoke("CreateAlarm", &args, &resp); err != nil {
+		return WrapError(err, "CreateAlarm", d.Id())
+	}
+
+	d.SetId(resp.AlarmTaskId)
+
+	return resourceAliyunEssAlarmUpdate(d, meta)
+}
+
+func resourceAliyunEssAlarmRead(d *schema.ResourceData, meta interface{}) error {
+	alarm, err := meta.(*AliyunClient).DescribeEssAlarmById(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ess") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeAlarms", d.Id())
+	}
+
+	d.Set("name", alarm.Name)
+	d.Set("description", alarm.Description)
+	d.Set("enable", alarm.Enable)
+	d.Set("scaling_group_id", alarm.ScalingGroupId)
+	if err := d.Set("alarm_actions", alarm.AlarmActions.AlarmAction); err != nil {
+		return err
+	}
+	d.Set("metric_type", alarm.MetricType)
+	d.Set("metric_name", alarm.MetricName)
+	d.Set("period", alarm.Period)
+	d.Set("statistics", alarm.Statistics)
+	d.Set("threshold", alarm.Threshold)
+	d.Set("comparison_operator", alarm.ComparisonOperator)
+	d.Set("evaluation_count", alarm.EvaluationCount)
+	d.Set("cloud_monitor_group_id", alarm.GroupId)
+	d.Set("state", alarm.State)
+
+	dims := make(map[string]string)
+	for _, dim := range alarm.Dimensions.Dimension {
+		// The scaling group dimension is added by ESS automatically.
+		if dim.DimensionKey == "scaling_group" {
+			continue
+		}
+		dims[dim.DimensionKey] = dim.DimensionValue
+	}
+	if err := d.Set("dimensions", dims); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAliyunEssAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if !d.IsNewResource() && (d.HasChange("name") || d.HasChange("description") || d.HasChange("metric_name") ||
+		d.HasChange("period") || d.HasChange("statistics") || d.HasChange("threshold") ||
+		d.HasChange("comparison_operator") || d.HasChange("evaluation_count") || d.HasChange("cloud_monitor_group_id") ||
+		d.HasChange("alarm_actions") || d.HasChange("dimensions")) {
+		args := ModifyEssAlarmArgs{
+			RegionId:           client.Region,
+			AlarmTaskId:        d.Id(),
+			Name:               d.Get("name").(string),
+			Description:        d.Get("description").(string),
+			MetricType:         d.Get("metric_type").(string),
+			MetricName:         d.Get("metric_name").(string),
+			Period:             d.Get("period").(int),
+			Statistics:         d.Get("statistics").(string),
+			Threshold:          d.Get("threshold").(float64),
+			ComparisonOperator: d.Get("comparison_operator").(string),
+			EvaluationCount:    d.Get("evaluation_count").(int),
+			GroupId:            d.Get("cloud_monitor_group_id").(int),
+			AlarmAction:        expandStringList(d.Get("alarm_actions").(*schema.Set).List()),
+			Dimension:          expandEssAlarmDimensions(d.Get("dimensions").(map[string]interface{})),
+		}
+		if err := client.essconn().Invoke("ModifyAlarm", &args, &EssResponse{}); err != nil {
+			return WrapError(err, "ModifyAlarm", d.Id())
+		}
+		for _, key := range []string{"name", "description", "metric_name", "period", "statistics", "threshold",
+			"comparison_operator", "evaluation_count", "cloud_monitor_group_id", "alarm_actions", "dimensions"} {
+			d.SetPartial(key)
+		}
+	}
+
+	// A new alarm is enabled by default.
+	if d.HasChange("enable") && !(d.IsNewResource() && d.Get("enable").(bool)) {
+		action := "DisableAlarm"
+		if d.Get("enable").(bool) {
+			action = "EnableAlarm"
+		}
+		args := EssAlarmArgs{
+			RegionId:    client.Region,
+			AlarmTaskId: d.Id(),
+		}
+		if err := client.essconn().Invoke(action, &args, &EssResponse{}); err != nil {
+			return fmt.Errorf("%s got an error: %#v", action, err)
+		}
+		d.SetPartial("enable")
+	}
+
+	d.Partial(false)
+	return resourceAliyunEssAlarmRead(d, meta)
+}
+
+func resourceAliyunEssAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := EssAlarmArgs{
+		RegionId:    client.Region,
+		AlarmTaskId: d.Id(),
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.essconn().Invoke("DeleteAlarm", &args, &EssResponse{}); err != nil {
+			if _, e := client.DescribeEssAlarmById(d.Id()); e != nil && NotFoundError(e, "ess") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteAlarm", d.Id()))
+		}
+
+		if _, err := client.DescribeEssAlarmById(d.Id()); err != nil {
+			if NotFoundError(err, "ess") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("ESS alarm %s is still in use - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func expandEssAlarmDimensions(m map[string]interface{}) []EssAlarmDimension {
+	var dims []EssAlarmDimension
+	for k, v := range m {
+		dims = append(dims, EssAlarmDimension{
+			DimensionKey:   k,
+			DimensionValue: v.(string),
+		})
+	}
+	return dims
+}
@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEssAlarm_basic(t *testing.T) {
+	var alarm EssAlarm
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_alarm.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssAlarmDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssAlarmConfig(">=", 80, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssAlarmExists("alicloud_ess_alarm.foo", &alarm),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "metric_name", "CpuUtilization"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "comparison_operator", ">="),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "threshold", "80"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "enable", "true"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "alarm_actions.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssAlarmConfig("<=", 20, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssAlarmExists("alicloud_ess_alarm.foo", &alarm),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "comparison_operator", "<="),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "threshold", "20"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEssAlarmExists(n string, d *EssAlarm) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ESS alarm ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		alarm, err := client.DescribeEssAlarmById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *alarm
+		return nil
+	}
+}
+
+func testAccCheckEssAlarmDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ess_alarm" {
+			continue
+		}
+
+		if _, err := client.DescribeEssAlarmById(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "ess") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error ESS alarm %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEssAlarmConfig(operator string, threshold int, enable bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 1
+	max_size = 1
+	scaling_group_name = "tf-test-alarm"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	adjustment_type = "TotalCapacity"
+	adjustment_value = 1
+}
+
+resource "alicloud_ess_alarm" "foo" {
+	name = "tf-test-alarm"
+	description = "tf test alarm"
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	alarm_actions = ["${alicloud_ess_scaling_rule.foo.ari}"]
+	metric_type = "system"
+	metric_name = "CpuUtilization"
+	period = 300
+	statistics = "Average"
+	comparison_operator = "%s"
+	threshold = %d
+	evaluation_count = 2
+	enable = %t
+}
+`, operator, threshold, enable)
+}
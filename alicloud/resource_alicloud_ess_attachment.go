@@ -0,0 +1,164 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEssAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunEssAttachmentCreate,
+		Read:   resourceAliyunEssAttachmentRead,
+		Update: resourceAliyunEssAttachmentUpdate,
+		Delete: resourceAliyunEssAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MaxItems: 20,
+				Set:      schema.HashString,
+			},
+			// Attached instances are never released by the scaling group, so removing them
+			// from the group keeps them running.
+			"remove_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAliyunEssAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	sgId := d.Get("scaling_group_id").(string)
+
+	if _, err := meta.(*AliyunClient).DescribeScalingGroupById(sgId); err != nil {
+		return fmt.Errorf("DescribeScalingGroups %s got an error: %#v", sgId, err)
+	}
+
+	d.SetId(sgId)
+
+	return updateEssAttachment(d, meta, newOperationDeadline(d, schema.TimeoutCreate))
+}
+
+func resourceAliyunEssAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if _, err := client.DescribeScalingGroupById(d.Id()); err != nil {
+		if NotFoundError(err, "ess") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("DescribeScalingGroups %s got an error: %#v", d.Id(), err)
+	}
+
+	instances, err := client.DescribeScalingInstancesByType(d.Id(), EssAttached)
+	if err != nil {
+		return WrapError(err, "DescribeScalingInstances", d.Id())
+	}
+
+	var ids []string
+	for _, inst := range instances {
+		ids = append(ids, inst.InstanceId)
+	}
+
+	d.Set("scaling_group_id", d.Id())
+	if err := d.Set("instance_ids", ids); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	return updateEssAttachment(d, meta, newOperationDeadline(d, schema.TimeoutUpdate))
+}
+
+// updateEssAttachment updates the attached instances before the deadline, which is the one of the Create when the
+// attachment is just created.
+func updateEssAttachment(d *schema.ResourceData, meta interface{}, deadline operationDeadline) error {
+	if d.HasChange("instance_ids") {
+		o, n := d.GetChange("instance_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := expandStringList(os.Difference(ns).List())
+		add := expandStringList(ns.Difference(os).List())
+
+		if len(remove) > 0 {
+			if err := modifyEssAttachedInstances(d, meta, "RemoveInstances", remove, deadline); err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			if err := modifyEssAttachedInstances(d, meta, "AttachInstances", add, deadline); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAliyunEssAttachmentRead(d, meta)
+}
+
+func resourceAliyunEssAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("remove_on_destroy").(bool) {
+		return nil
+	}
+
+	if _, err := meta.(*AliyunClient).DescribeScalingGroupById(d.Id()); err != nil {
+		if NotFoundError(err, "ess") {
+			return nil
+		}
+		return fmt.Errorf("DescribeScalingGroups %s got an error: %#v", d.Id(), err)
+	}
+
+	ids := expandStringList(d.Get("instance_ids").(*schema.Set).List())
+	if len(ids) < 1 {
+		return nil
+	}
+
+	return modifyEssAttachedInstances(d, meta, "RemoveInstances", ids, newOperationDeadline(d, schema.TimeoutDelete))
+}
+
+// modifyEssAttachedInstances attaches or removes the instances, and retries while the scaling group
+// is running a scaling activity until the deadline.
+func modifyEssAttachedInstances(d *schema.ResourceData, meta interface{}, action string, ids []string, deadline operationDeadline) error {
+	client := meta.(*AliyunClient)
+
+	args := EssInstancesArgs{
+		RegionId:       client.Region,
+		ScalingGroupId: d.Id(),
+		InstanceId:     ids,
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.essconn().Invoke(action, &args, &EssResponse{}); err != nil {
+			if IsExceptedError(err, ScalingActivityInProgress) {
+				return resource.RetryableError(fmt.Errorf("Scaling group %s is running a scaling activity - trying again while it is done.", d.Id()))
+			}
+			if IsExceptedError(err, IncorrectScalingGroupStatus) {
+				return resource.NonRetryableError(fmt.Errorf("%s got an error: scaling group %s must be enabled (active) before attaching or removing instances: %#v", action, d.Id(), err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("%s got an error: %#v", action, err))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,158 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEssAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_attachment.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssAttachmentConfig(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssAttachmentExists("alicloud_ess_attachment.foo", 1),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_attachment.foo",
+						"instance_ids.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssAttachmentConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssAttachmentExists("alicloud_ess_attachment.foo", 2),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_attachment.foo",
+						"instance_ids.#",
+						"2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEssAttachmentExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ESS attachment ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instances, err := client.DescribeScalingInstancesByType(rs.Primary.ID, EssAttached)
+		if err != nil {
+			return err
+		}
+
+		if len(instances) != count {
+			return fmt.Errorf("Expected %d attached instances, got %d", count, len(instances))
+		}
+		return nil
+	}
+}
+
+func testAccCheckEssAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ess_attachment" {
+			continue
+		}
+
+		instances, err := client.DescribeScalingInstancesByType(rs.Primary.ID, EssAttached)
+		if err != nil {
+			if NotFoundError(err, "ess") || IsExceptedError(err, InvalidScalingGroupIdNotFound) {
+				continue
+			}
+			return err
+		}
+
+		if len(instances) > 0 {
+			return fmt.Errorf("Error ESS attached instances still exist")
+		}
+	}
+
+	return nil
+}
+
+func testAccEssAttachmentConfig(count int) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+	"available_resource_creation"= "VSwitch"
+}
+
+data "alicloud_images" "ecs_image" {
+	most_recent = true
+	name_regex =  "^centos_6\\w{1,5}[64].*"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+	vpc_id = "${alicloud_vpc.foo.id}"
+	cidr_block = "172.16.0.0/21"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "tf_test_foo" {
+	name = "tf_test_foo"
+	description = "foo"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 0
+	max_size = 2
+	scaling_group_name = "tf-test-attachment"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_configuration" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	enable = true
+	active = true
+
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	instance_type = "ecs.n4.large"
+	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
+}
+
+resource "alicloud_instance" "foo" {
+	count = %d
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	instance_type = "ecs.n4.large"
+	system_disk_category = "cloud_efficiency"
+	security_groups = ["${alicloud_security_group.tf_test_foo.id}"]
+	instance_name = "tf-test-ess-attachment"
+}
+
+resource "alicloud_ess_attachment" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_configuration.foo.scaling_group_id}"
+	instance_ids = ["${alicloud_instance.foo.*.id}"]
+}
+`, count)
+}
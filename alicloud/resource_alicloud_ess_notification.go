@@ -0,0 +1,155 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEssNotification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunEssNotificationCreate,
+		Read:   resourceAliyunEssNotificationRead,
+		Update: resourceAliyunEssNotificationUpdate,
+		Delete: resourceAliyunEssNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"notification_arn": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEssNotificationArn,
+			},
+			"notification_types": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateAllowedStringValue(EssNotificationTypes)},
+				Required: true,
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAliyunEssNotificationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := EssNotificationConfigurationArgs{
+		RegionId:         client.Region,
+		ScalingGroupId:   d.Get("scaling_group_id").(string),
+		NotificationArn:  d.Get("notification_arn").(string),
+		NotificationType: expandStringList(d.Get("notification_types").(*schema.Set).List()),
+	}
+
+	if err := client.essconn().Invoke("CreateNotificationConfiguration", &args, &EssResponse{}); err != nil {
+		return WrapError(err, "CreateNotificationConfiguration", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.ScalingGroupId, COLON_SEPARATED, args.NotificationArn))
+
+	return resourceAliyunEssNotificationRead(d, meta)
+}
+
+func resourceAliyunEssNotificationRead(d *schema.ResourceData, meta interface{}) error {
+	sgId, arn, err := parseEssNotificationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	notification, err := meta.(*AliyunClient).DescribeEssNotificationById(sgId, arn)
+	if err != nil {
+		if NotFoundError(err, "ess") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeNotificationConfigurations", d.Id())
+	}
+
+	d.Set("scaling_group_id", notification.ScalingGroupId)
+	d.Set("notification_arn", notification.NotificationArn)
+	if err := d.Set("notification_types", notification.NotificationTypes.NotificationType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAliyunEssNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("notification_types") {
+		sgId, arn, err := parseEssNotificationId(d.Id())
+		if err != nil {
+			return err
+		}
+
+		args := EssNotificationConfigurationArgs{
+			RegionId:         client.Region,
+			ScalingGroupId:   sgId,
+			NotificationArn:  arn,
+			NotificationType: expandStringList(d.Get("notification_types").(*schema.Set).List()),
+		}
+		if err := client.essconn().Invoke("ModifyNotificationConfiguration", &args, &EssResponse{}); err != nil {
+			return WrapError(err, "ModifyNotificationConfiguration", d.Id())
+		}
+	}
+
+	return resourceAliyunEssNotificationRead(d, meta)
+}
+
+func resourceAliyunEssNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	sgId, arn, err := parseEssNotificationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	args := EssNotificationConfigurationArgs{
+		RegionId:        client.Region,
+		ScalingGroupId:  sgId,
+		NotificationArn: arn,
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.essconn().Invoke("DeleteNotificationConfiguration", &args, &EssResponse{}); err != nil {
+			if NotFoundError(err, "ess") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteNotificationConfiguration", d.Id()))
+		}
+
+		if _, err := client.DescribeEssNotificationById(sgId, arn); err != nil {
+			if NotFoundError(err, "ess") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Notification %s is still in use - trying again while it is deleted.", d.Id()))
+	})
+}
+
+// parseEssNotificationId splits the ID '<scaling group id>:<notification arn>', and the arn itself contains ':'.
+func parseEssNotificationId(id string) (sgId, arn string, err error) {
+	parts := strings.SplitN(id, COLON_SEPARATED, 2)
+	if len(parts) != 2 {
+		return "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid ESS notification ID %s, expected '<scaling group id>:<notification arn>'.", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEssNotification_basic(t *testing.T) {
+	var notification EssNotificationConfiguration
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithAccountId(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_notification.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssNotificationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssNotificationConfig(`"AUTOSCALING:SCALE_OUT_SUCCESS", "AUTOSCALING:SCALE_OUT_ERROR"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssNotificationExists("alicloud_ess_notification.foo", &notification),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_notification.foo",
+						"notification_types.#",
+						"2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssNotificationConfig(`"AUTOSCALING:SCALE_OUT_SUCCESS", "AUTOSCALING:SCALE_OUT_ERROR", "AUTOSCALING:SCALE_REJECT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssNotificationExists("alicloud_ess_notification.foo", &notification),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_notification.foo",
+						"notification_types.#",
+						"3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEssNotificationExists(n string, d *EssNotificationConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ESS notification ID is set")
+		}
+
+		sgId, arn, err := parseEssNotificationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		notification, err := client.DescribeEssNotificationById(sgId, arn)
+		if err != nil {
+			return err
+		}
+
+		*d = *notification
+		return nil
+	}
+}
+
+func testAccCheckEssNotificationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ess_notification" {
+			continue
+		}
+
+		sgId, arn, err := parseEssNotificationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeEssNotificationById(sgId, arn); err != nil {
+			if NotFoundError(err, "ess") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error ESS notification %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEssNotificationConfig(types string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 1
+	max_size = 1
+	scaling_group_name = "tf-test-notification"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_notification" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	notification_arn = "acs:ess:%s:%s:cloudmonitor"
+	notification_types = [%s]
+}
+`, os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_ACCOUNT_ID"), types)
+}
@@ -1,12 +1,14 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"log"
 	"strings"
 	"time"
 )
@@ -17,6 +19,13 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 		Read:   resourceAliyunEssScalingConfigurationRead,
 		Update: resourceAliyunEssScalingConfigurationUpdate,
 		Delete: resourceAliyunEssScalingConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"active": &schema.Schema{
@@ -41,7 +50,16 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 			"instance_type": &schema.Schema{
 				Type:     schema.TypeString,
 				ForceNew: true,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				MaxItems: 10,
 			},
 			"io_optimized": &schema.Schema{
 				Type:       schema.TypeString,
@@ -121,6 +139,65 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 				Optional: true,
 				MaxItems: 20,
 			},
+			"user_data": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"role_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"spot_strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(EssNoSpot),
+				ValidateFunc: validateAllowedStringValue([]string{string(EssNoSpot), string(EssSpotWithPriceLimit), string(EssSpotAsPriceGo)}),
+			},
+			"spot_price_limit": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"price_limit": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
+			"deployment_set_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"substitute": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -142,14 +219,12 @@ func resourceAliyunEssScalingConfigurationCreate(d *schema.ResourceData, meta in
 		args.IoOptimized = ecs.IoOptimizedOptimized
 	}
 
-	essconn := meta.(*AliyunClient).essconn
-
-	scaling, err := essconn.CreateScalingConfiguration(args)
-	if err != nil {
-		return err
+	resp := CreateEssScalingConfigurationResponse{}
+	if err := meta.(*AliyunClient).essconn().Invoke("CreateScalingConfiguration", args, &resp); err != nil {
+		return WrapError(err, "CreateScalingConfiguration", d.Id())
 	}
 
-	d.SetId(d.Get("scaling_group_id").(string) + COLON_SEPARATED + scaling.ScalingConfigurationId)
+	d.SetId(d.Get("scaling_group_id").(string) + COLON_SEPARATED + resp.ScalingConfigurationId)
 
 	return resourceAliyunEssScalingConfigurationUpdate(d, meta)
 }
@@ -212,7 +287,7 @@ func resourceAliyunEssScalingConfigurationRead(d *schema.ResourceData, meta inte
 	ids := strings.Split(d.Id(), COLON_SEPARATED)
 	c, err := client.DescribeScalingConfigurationById(ids[0], ids[1])
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "ess") {
 			d.SetId("")
 			return nil
 		}
@@ -230,32 +305,87 @@ func resourceAliyunEssScalingConfigurationRead(d *schema.ResourceData, meta inte
 	d.Set("internet_max_bandwidth_out", c.InternetMaxBandwidthOut)
 	d.Set("system_disk_category", c.SystemDiskCategory)
 	d.Set("data_disk", flattenDataDiskMappings(c.DataDisks.DataDisk))
+	if err := d.Set("instance_types", c.InstanceTypes.InstanceType); err != nil {
+		return err
+	}
+	d.Set("user_data", c.UserData)
+	d.Set("key_name", c.KeyPairName)
+	d.Set("role_name", c.RamRoleName)
+	d.Set("instance_name", c.InstanceName)
+	d.Set("deployment_set_id", c.DeploymentSetId)
+	if c.SpotStrategy != "" {
+		d.Set("spot_strategy", string(c.SpotStrategy))
+	}
+
+	tags := make(map[string]string)
+	for _, t := range c.Tags.Tag {
+		tags[t.Key] = t.Value
+	}
+	if err := d.Set("tags", ignoreDefaultTags(client, d, tags)); err != nil {
+		return err
+	}
+
+	var limits []map[string]interface{}
+	for _, l := range c.SpotPriceLimit.SpotPriceModel {
+		limits = append(limits, map[string]interface{}{
+			"instance_type": l.InstanceType,
+			"price_limit":   l.PriceLimit,
+		})
+	}
+	if err := d.Set("spot_price_limit", limits); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	ids := strings.Split(d.Id(), COLON_SEPARATED)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		ids := strings.Split(d.Id(), COLON_SEPARATED)
-		err := client.DeleteScalingConfigurationById(ids[0], ids[1])
+	c, err := client.DescribeScalingConfigurationById(ids[0], ids[1])
+	if err != nil {
+		if NotFoundError(err, "ess") {
+			return nil
+		}
+		return fmt.Errorf("Error Describe ESS scaling configuration Attribute: %#v", err)
+	}
 
-		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == IncorrectScalingConfigurationLifecycleState {
-				return resource.NonRetryableError(
-					fmt.Errorf("Scaling configuration is active - please active another one and trying again."))
+	// The active scaling configuration can not be deleted directly, so the substitute
+	// is activated before deleting it. The last one of the scaling group is removed with its group.
+	if c.LifecycleState == ess.Active {
+		if substitute := d.Get("substitute").(string); substitute != "" {
+			if err := client.ActiveScalingConfigurationById(ids[0], substitute); err != nil {
+				return fmt.Errorf("Active substitute scaling configuration %s got an error: %#v", substitute, err)
+			}
+		} else {
+			configs, err := client.DescribeScalingConfigurationsByGroup(ids[0])
+			if err != nil {
+				return WrapError(err, "DescribeScalingConfigurations", d.Id())
+			}
+			if len(configs) > 1 {
+				return fmt.Errorf("Scaling configuration %s is active - please set 'substitute' to another one of the scaling group %s and trying again.", ids[1], ids[0])
+			}
+			log.Printf("[WARN] Scaling configuration %s is the last one of the scaling group %s and it will be deleted with the scaling group.", ids[1], ids[0])
+			return nil
+		}
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.DeleteScalingConfigurationById(ids[0], ids[1]); err != nil {
+			if IsExceptedError(err, IncorrectScalingConfigurationLifecycleState) {
+				return resource.RetryableError(
+					fmt.Errorf("Scaling configuration is still active - trying again while the substitute is activated."))
 			}
-			if e.ErrorResponse.Code != InvalidScalingGroupIdNotFound {
+			if !NotFoundError(err, "ess") {
 				return resource.RetryableError(
 					fmt.Errorf("Scaling configuration in use - trying again while it is deleted."))
 			}
 		}
 
-		_, err = client.DescribeScalingConfigurationById(ids[0], ids[1])
-		if err != nil {
-			if NotFoundError(err) {
+		if _, err := client.DescribeScalingConfigurationById(ids[0], ids[1]); err != nil {
+			if NotFoundError(err, "ess") {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -266,12 +396,47 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 	})
 }
 
-func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingConfigurationArgs, error) {
-	args := &ess.CreateScalingConfigurationArgs{
+func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta interface{}) (*CreateEssScalingConfigurationArgs, error) {
+	args := &CreateEssScalingConfigurationArgs{
 		ScalingGroupId:  d.Get("scaling_group_id").(string),
 		ImageId:         d.Get("image_id").(string),
 		InstanceType:    d.Get("instance_type").(string),
+		InstanceTypes:   expandStringList(d.Get("instance_types").([]interface{})),
 		SecurityGroupId: d.Get("security_group_id").(string),
+		UserData:        d.Get("user_data").(string),
+		KeyPairName:     d.Get("key_name").(string),
+		RamRoleName:     d.Get("role_name").(string),
+		InstanceName:    d.Get("instance_name").(string),
+		SpotStrategy:    EssSpotStrategy(d.Get("spot_strategy").(string)),
+		DeploymentSetId: d.Get("deployment_set_id").(string),
+	}
+
+	if args.InstanceType == "" && len(args.InstanceTypes) < 1 {
+		return nil, ConfigErrorf(ErrorCodeMissingArgument, "One of 'instance_type' and 'instance_types' is required.")
+	}
+	if args.InstanceType != "" && len(args.InstanceTypes) > 0 {
+		return nil, ConfigErrorf(ErrorCodeConflictingArguments, "'instance_type' and 'instance_types' can not be set at the same time.")
+	}
+
+	if v := mergeDefaultTags(meta.(*AliyunClient).DefaultTags, d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		tags, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("Marshaling scaling configuration tags got an error: %#v", err)
+		}
+		args.Tags = string(tags)
+	}
+
+	if v, ok := d.GetOk("spot_price_limit"); ok {
+		if args.SpotStrategy != EssSpotWithPriceLimit {
+			return nil, fmt.Errorf("'spot_price_limit' is only valid when 'spot_strategy' is %s.", EssSpotWithPriceLimit)
+		}
+		for _, l := range v.([]interface{}) {
+			limit := l.(map[string]interface{})
+			args.SpotPriceLimit = append(args.SpotPriceLimit, EssSpotPriceLimit{
+				InstanceType: limit["instance_type"].(string),
+				PriceLimit:   limit["price_limit"].(float64),
+			})
+		}
 	}
 
 	if v := d.Get("scaling_configuration_name").(string); v != "" {
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"fmt"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
@@ -13,7 +12,7 @@ import (
 )
 
 func TestAccAlicloudEssScalingConfiguration_basic(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -46,7 +45,7 @@ func TestAccAlicloudEssScalingConfiguration_basic(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingConfiguration_multiConfig(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -82,8 +81,57 @@ func TestAccAlicloudEssScalingConfiguration_multiConfig(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudEssScalingConfiguration_instanceTypesAndSpot(t *testing.T) {
+	var sc EssScalingConfiguration
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_configuration.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingConfiguration_instanceTypesAndSpot,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingConfigurationExists(
+						"alicloud_ess_scaling_configuration.foo", &sc),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_configuration.foo",
+						"instance_types.#",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_configuration.foo",
+						"spot_strategy",
+						"SpotWithPriceLimit"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_configuration.foo",
+						"spot_price_limit.#",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_configuration.foo",
+						"tags.%",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_configuration.foo",
+						"user_data",
+						"echo hello"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_configuration.foo",
+						"instance_name",
+						"tf-test-ess"),
+				),
+			},
+		},
+	})
+}
+
 func SkipTestAccAlicloudEssScalingConfiguration_active(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -140,7 +188,7 @@ func SkipTestAccAlicloudEssScalingConfiguration_active(t *testing.T) {
 }
 
 func SkipTestAccAlicloudEssScalingConfiguration_enable(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -196,7 +244,7 @@ func SkipTestAccAlicloudEssScalingConfiguration_enable(t *testing.T) {
 	})
 }
 
-func testAccCheckEssScalingConfigurationExists(n string, d *ess.ScalingConfigurationItemType) resource.TestCheckFunc {
+func testAccCheckEssScalingConfigurationExists(n string, d *EssScalingConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -277,7 +325,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -314,7 +362,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -359,7 +407,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -397,7 +445,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -435,7 +483,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -473,7 +521,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -486,3 +534,46 @@ resource "alicloud_ess_scaling_configuration" "foo" {
 	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
 }
 `
+
+const testAccEssScalingConfiguration_instanceTypesAndSpot = `
+data "alicloud_images" "ecs_image" {
+  most_recent = true
+  name_regex =  "^centos_6\\w{1,5}[64].*"
+}
+
+resource "alicloud_security_group" "tf_test_foo" {
+	description = "foo"
+}
+
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 1
+	max_size = 1
+	scaling_group_name = "tf-test-foo"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_configuration" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	instance_types = ["ecs.n4.large", "ecs.sn1ne.large"]
+	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
+	instance_name = "tf-test-ess"
+	user_data = "echo hello"
+	spot_strategy = "SpotWithPriceLimit"
+	spot_price_limit = [
+	  {
+	    instance_type = "ecs.n4.large"
+	    price_limit = 1.1
+	  },
+	  {
+	    instance_type = "ecs.sn1ne.large"
+	    price_limit = 1.2
+	  }
+	]
+	tags {
+	  name = "tf-test"
+	  usage = "ess"
+	}
+}
+`
@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +19,10 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"min_size": &schema.Schema{
 				Type:         schema.TypeInt,
@@ -62,6 +65,33 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
 			},
+			"launch_template_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"launch_template_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"launch_template_override": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weighted_capacity": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateIntegerInRange(1, 500),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -73,7 +103,7 @@ func resourceAliyunEssScalingGroupCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	essconn := meta.(*AliyunClient).essconn
+	essconn := meta.(*AliyunClient).essconn()
 
 	scaling, err := essconn.CreateScalingGroup(args)
 	if err != nil {
@@ -91,7 +121,7 @@ func resourceAliyunEssScalingGroupRead(d *schema.ResourceData, meta interface{})
 
 	scaling, err := client.DescribeScalingGroupById(d.Id())
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "ess") {
 			d.SetId("")
 			return nil
 		}
@@ -102,16 +132,40 @@ func resourceAliyunEssScalingGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("max_size", scaling.MaxSize)
 	d.Set("scaling_group_name", scaling.ScalingGroupName)
 	d.Set("default_cooldown", scaling.DefaultCooldown)
-	d.Set("removal_policies", scaling.RemovalPolicies.RemovalPolicy)
-	d.Set("db_instance_ids", scaling.DBInstanceIds)
-	d.Set("loadbalancer_ids", scaling.LoadBalancerId)
+	if err := d.Set("removal_policies", scaling.RemovalPolicies.RemovalPolicy); err != nil {
+		return err
+	}
+	if err := d.Set("db_instance_ids", scaling.DBInstanceIds); err != nil {
+		return err
+	}
+	if err := d.Set("loadbalancer_ids", scaling.LoadBalancerId); err != nil {
+		return err
+	}
+
+	template, err := client.DescribeScalingGroupLaunchTemplate(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error Describe ESS scaling group launch template: %#v", err)
+	}
+	d.Set("launch_template_id", template.LaunchTemplateId)
+	d.Set("launch_template_version", template.LaunchTemplateVersion)
+
+	var overrides []map[string]interface{}
+	for _, o := range template.LaunchTemplateOverrides.LaunchTemplateOverride {
+		overrides = append(overrides, map[string]interface{}{
+			"instance_type":     o.InstanceType,
+			"weighted_capacity": o.WeightedCapacity,
+		})
+	}
+	if err := d.Set("launch_template_override", overrides); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).essconn
+	conn := meta.(*AliyunClient).essconn()
 	args := &ess.ModifyScalingGroupArgs{
 		ScalingGroupId: d.Id(),
 	}
@@ -141,25 +195,31 @@ func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if d.HasChange("launch_template_id") || d.HasChange("launch_template_version") || d.HasChange("launch_template_override") {
+		if err := modifyEssScalingGroupLaunchTemplate(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceAliyunEssScalingGroupRead(d, meta)
 }
 
 func resourceAliyunEssScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := client.DeleteScalingGroupById(d.Id())
 
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code != InvalidScalingGroupIdNotFound {
+			if !NotFoundError(err, "ess") {
 				return resource.RetryableError(fmt.Errorf("Scaling group in use - trying again while it is deleted."))
 			}
 		}
 
 		_, err = client.DescribeScalingGroupById(d.Id())
 		if err != nil {
-			if NotFoundError(err) {
+			if NotFoundError(err, "ess") {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -210,3 +270,36 @@ func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{})
 
 	return args, nil
 }
+
+// modifyEssScalingGroupLaunchTemplate makes the scaling group create instances by the ECS launch template,
+// and the overrides replace the instance type of the template to diversify the spot instances.
+func modifyEssScalingGroupLaunchTemplate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := &ModifyEssScalingGroupLaunchTemplateArgs{
+		RegionId:              client.Region,
+		ScalingGroupId:        d.Id(),
+		LaunchTemplateId:      d.Get("launch_template_id").(string),
+		LaunchTemplateVersion: d.Get("launch_template_version").(string),
+	}
+
+	if args.LaunchTemplateId == "" {
+		if d.IsNewResource() {
+			return nil
+		}
+		return ConfigErrorf(ErrorCodeUnsupportedOperation, "The launch template of scaling group %s can not be removed. Please recreate the scaling group to use scaling configurations instead.", d.Id())
+	}
+
+	for _, v := range d.Get("launch_template_override").([]interface{}) {
+		o := v.(map[string]interface{})
+		args.LaunchTemplateOverride = append(args.LaunchTemplateOverride, EssLaunchTemplateOverride{
+			InstanceType:     o["instance_type"].(string),
+			WeightedCapacity: o["weighted_capacity"].(int),
+		})
+	}
+
+	if err := client.essconn().Invoke("ModifyScalingGroup", args, &EssResponse{}); err != nil {
+		return fmt.Errorf("ModifyScalingGroup launch template got an error: %#v", err)
+	}
+	return nil
+}
@@ -7,9 +7,55 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
+	"os"
 	"testing"
 )
 
+func init() {
+	addTestSweepers("alicloud_ess_scaling_group", &testSweeper{
+		F: testSweepEssScalingGroups,
+	})
+}
+
+func testSweepEssScalingGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.essconn()
+
+	var ids []string
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		groups, _, err := conn.DescribeScalingGroups(&ess.DescribeScalingGroupsArgs{
+			RegionId:   client.Region,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeScalingGroups", "")
+		}
+		for _, group := range groups {
+			if !isSweepable(group.ScalingGroupName) {
+				log.Printf("[INFO] Skipping scaling group %s (%s)", group.ScalingGroupName, group.ScalingGroupId)
+				continue
+			}
+			ids = append(ids, group.ScalingGroupId)
+		}
+		return len(groups), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting scaling group %s", id)
+		// The scaling group is deleted by force with its scaling configurations, rules and instances.
+		if err := client.DeleteScalingGroupById(id); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteScalingGroup", id))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudEssScalingGroup_basic(t *testing.T) {
 	var sg ess.ScalingGroupItemType
 
@@ -40,7 +86,7 @@ func TestAccAlicloudEssScalingGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"alicloud_ess_scaling_group.foo",
 						"scaling_group_name",
-						"foo"),
+						"tf-test-foo"),
 					resource.TestCheckResourceAttr(
 						"alicloud_ess_scaling_group.foo",
 						"removal_policies.#",
@@ -83,7 +129,7 @@ func TestAccAlicloudEssScalingGroup_update(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"alicloud_ess_scaling_group.foo",
 						"scaling_group_name",
-						"foo"),
+						"tf-test-foo"),
 					resource.TestCheckResourceAttr(
 						"alicloud_ess_scaling_group.foo",
 						"removal_policies.#",
@@ -108,7 +154,7 @@ func TestAccAlicloudEssScalingGroup_update(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"alicloud_ess_scaling_group.foo",
 						"scaling_group_name",
-						"update"),
+						"tf-test-update"),
 					resource.TestCheckResourceAttr(
 						"alicloud_ess_scaling_group.foo",
 						"removal_policies.#",
@@ -151,7 +197,7 @@ func SkipTestAccAlicloudEssScalingGroup_vpc(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"alicloud_ess_scaling_group.foo",
 						"scaling_group_name",
-						"foo"),
+						"tf-test-foo"),
 					resource.TestCheckResourceAttr(
 						"alicloud_ess_scaling_group.foo",
 						"removal_policies.#",
@@ -164,6 +210,44 @@ func SkipTestAccAlicloudEssScalingGroup_vpc(t *testing.T) {
 
 }
 
+func TestAccAlicloudEssScalingGroup_launchTemplate(t *testing.T) {
+	var sg ess.ScalingGroupItemType
+	templateId := os.Getenv("ALICLOUD_LAUNCH_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithLaunchTemplate(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingGroupLaunchTemplate(templateId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingGroupExists(
+						"alicloud_ess_scaling_group.foo", &sg),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_group.foo",
+						"launch_template_id",
+						templateId),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_group.foo",
+						"launch_template_version",
+						"Latest"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_group.foo",
+						"launch_template_override.#",
+						"2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEssScalingGroupExists(n string, d *ess.ScalingGroupItemType) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -224,7 +308,7 @@ const testAccEssScalingGroupConfig = `
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 `
@@ -233,7 +317,7 @@ const testAccEssScalingGroup = `
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 `
@@ -242,7 +326,7 @@ const testAccEssScalingGroup_update = `
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 2
 	max_size = 2
-	scaling_group_name = "update"
+	scaling_group_name = "tf-test-update"
 	removal_policies = ["OldestInstance"]
 }
 `
@@ -276,7 +360,7 @@ resource "alicloud_security_group" "tf_test_foo" {
 resource "alicloud_ess_scaling_group" "foo" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "foo"
+	scaling_group_name = "tf-test-foo"
 	default_cooldown = 20
 	vswitch_id = "${alicloud_vswitch.foo.id}"
 	removal_policies = ["OldestInstance", "NewestInstance"]
@@ -294,3 +378,26 @@ resource "alicloud_ess_scaling_configuration" "foo" {
 	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
 }
 `
+
+func testAccEssScalingGroupLaunchTemplate(templateId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 0
+	max_size = 2
+	scaling_group_name = "tf-test-launch-template"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+	launch_template_id = "%s"
+	launch_template_version = "Latest"
+	launch_template_override = [
+	  {
+	    instance_type = "ecs.n4.large"
+	    weighted_capacity = 1
+	  },
+	  {
+	    instance_type = "ecs.sn1ne.large"
+	    weighted_capacity = 1
+	  }
+	]
+}
+`, templateId)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -15,21 +16,39 @@ func resourceAlicloudEssScalingRule() *schema.Resource {
 		Read:   resourceAliyunEssScalingRuleRead,
 		Update: resourceAliyunEssScalingRuleUpdate,
 		Delete: resourceAliyunEssScalingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
+			},
+			"scaling_rule_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(EssSimpleScalingRule),
+				ValidateFunc: validateAllowedStringValue([]string{string(EssSimpleScalingRule),
+					string(EssTargetTrackingScalingRule), string(EssStepScalingRule)}),
 			},
 			"adjustment_type": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ValidateFunc: validateAllowedStringValue([]string{string(ess.QuantityChangeInCapacity),
 					string(ess.PercentChangeInCapacity), string(ess.TotalCapacity)}),
 			},
 			"adjustment_value": &schema.Schema{
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"scaling_rule_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -45,6 +64,46 @@ func resourceAlicloudEssScalingRule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateIntegerInRange(0, 86400),
 			},
+			"metric_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(EssTargetTrackingMetricNames),
+			},
+			"target_value": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"disable_scale_in": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"estimated_instance_warmup": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 86400),
+			},
+			"step_adjustment": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_interval_lower_bound": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metric_interval_upper_bound": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"scaling_adjustment": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -56,14 +115,12 @@ func resourceAliyunEssScalingRuleCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	essconn := meta.(*AliyunClient).essconn
-
-	rule, err := essconn.CreateScalingRule(args)
-	if err != nil {
-		return err
+	resp := CreateEssScalingRuleResponse{}
+	if err := meta.(*AliyunClient).essconn().Invoke("CreateScalingRule", args, &resp); err != nil {
+		return WrapError(err, "CreateScalingRule", d.Id())
 	}
 
-	d.SetId(d.Get("scaling_group_id").(string) + COLON_SEPARATED + rule.ScalingRuleId)
+	d.SetId(d.Get("scaling_group_id").(string) + COLON_SEPARATED + resp.ScalingRuleId)
 
 	return resourceAliyunEssScalingRuleUpdate(d, meta)
 }
@@ -75,7 +132,7 @@ func resourceAliyunEssScalingRuleRead(d *schema.ResourceData, meta interface{})
 
 	rule, err := client.DescribeScalingRuleById(ids[0], ids[1])
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "ess") {
 			d.SetId("")
 			return nil
 		}
@@ -88,15 +145,26 @@ func resourceAliyunEssScalingRuleRead(d *schema.ResourceData, meta interface{})
 	d.Set("adjustment_value", rule.AdjustmentValue)
 	d.Set("scaling_rule_name", rule.ScalingRuleName)
 	d.Set("cooldown", rule.Cooldown)
+	if rule.ScalingRuleType != "" {
+		d.Set("scaling_rule_type", string(rule.ScalingRuleType))
+	}
+	d.Set("metric_name", rule.MetricName)
+	d.Set("target_value", rule.TargetValue)
+	d.Set("disable_scale_in", rule.DisableScaleIn)
+	d.Set("estimated_instance_warmup", rule.EstimatedInstanceWarmup)
+	if err := d.Set("step_adjustment", flattenEssStepAdjustments(rule.StepAdjustments.StepAdjustment)); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 func resourceAliyunEssScalingRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 	ids := strings.Split(d.Id(), COLON_SEPARATED)
 
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := client.DeleteScalingRuleById(ids[1])
 
 		if err != nil {
@@ -105,7 +173,7 @@ func resourceAliyunEssScalingRuleDelete(d *schema.ResourceData, meta interface{}
 
 		_, err = client.DescribeScalingRuleById(ids[0], ids[1])
 		if err != nil {
-			if NotFoundError(err) {
+			if NotFoundError(err, "ess") {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -116,52 +184,139 @@ func resourceAliyunEssScalingRuleDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAliyunEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.IsNewResource() {
+		return resourceAliyunEssScalingRuleRead(d, meta)
+	}
 
-	conn := meta.(*AliyunClient).essconn
+	client := meta.(*AliyunClient)
 	ids := strings.Split(d.Id(), COLON_SEPARATED)
 
-	args := &ess.ModifyScalingRuleArgs{
+	args := &ModifyEssScalingRuleArgs{
+		RegionId:      client.Region,
 		ScalingRuleId: ids[1],
 	}
+	update := false
 
 	if d.HasChange("adjustment_type") {
 		args.AdjustmentType = ess.AdjustmentType(d.Get("adjustment_type").(string))
+		update = true
 	}
 
 	if d.HasChange("adjustment_value") {
 		args.AdjustmentValue = d.Get("adjustment_value").(int)
+		update = true
 	}
 
 	if d.HasChange("scaling_rule_name") {
 		args.ScalingRuleName = d.Get("scaling_rule_name").(string)
+		update = true
 	}
 
 	if d.HasChange("cooldown") {
 		args.Cooldown = d.Get("cooldown").(int)
+		update = true
 	}
 
-	if _, err := conn.ModifyScalingRule(args); err != nil {
-		return err
+	if d.HasChange("metric_name") || d.HasChange("target_value") || d.HasChange("disable_scale_in") {
+		args.MetricName = d.Get("metric_name").(string)
+		args.TargetValue = d.Get("target_value").(float64)
+		args.DisableScaleIn = strconv.FormatBool(d.Get("disable_scale_in").(bool))
+		update = true
+	}
+
+	if d.HasChange("estimated_instance_warmup") {
+		args.EstimatedInstanceWarmup = d.Get("estimated_instance_warmup").(int)
+		update = true
+	}
+
+	if d.HasChange("step_adjustment") {
+		args.StepAdjustment = expandEssStepAdjustments(d.Get("step_adjustment").([]interface{}))
+		update = true
+	}
+
+	if update {
+		if err := client.essconn().Invoke("ModifyScalingRule", args, &EssResponse{}); err != nil {
+			return WrapError(err, "ModifyScalingRule", d.Id())
+		}
 	}
 
 	return resourceAliyunEssScalingRuleRead(d, meta)
 }
 
-func buildAlicloudEssScalingRuleArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingRuleArgs, error) {
-	args := &ess.CreateScalingRuleArgs{
+func buildAlicloudEssScalingRuleArgs(d *schema.ResourceData, meta interface{}) (*CreateEssScalingRuleArgs, error) {
+	args := &CreateEssScalingRuleArgs{
 		RegionId:        getRegion(d, meta),
 		ScalingGroupId:  d.Get("scaling_group_id").(string),
-		AdjustmentType:  ess.AdjustmentType(d.Get("adjustment_type").(string)),
-		AdjustmentValue: d.Get("adjustment_value").(int),
+		ScalingRuleType: EssScalingRuleType(d.Get("scaling_rule_type").(string)),
 	}
 
 	if v := d.Get("scaling_rule_name").(string); v != "" {
 		args.ScalingRuleName = v
 	}
 
-	if v := d.Get("cooldown").(int); v != 0 {
-		args.Cooldown = v
+	switch args.ScalingRuleType {
+	case EssTargetTrackingScalingRule:
+		metric, ok := d.GetOk("metric_name")
+		if !ok {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'metric_name' is required when 'scaling_rule_type' is %s.", args.ScalingRuleType)
+		}
+		args.MetricName = metric.(string)
+		args.TargetValue = d.Get("target_value").(float64)
+		args.DisableScaleIn = strconv.FormatBool(d.Get("disable_scale_in").(bool))
+		if v := d.Get("estimated_instance_warmup").(int); v != 0 {
+			args.EstimatedInstanceWarmup = v
+		}
+	case EssStepScalingRule:
+		adjustments := d.Get("step_adjustment").([]interface{})
+		if len(adjustments) < 1 {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'step_adjustment' is required when 'scaling_rule_type' is %s.", args.ScalingRuleType)
+		}
+		args.AdjustmentType = ess.AdjustmentType(d.Get("adjustment_type").(string))
+		args.StepAdjustment = expandEssStepAdjustments(adjustments)
+		if v := d.Get("estimated_instance_warmup").(int); v != 0 {
+			args.EstimatedInstanceWarmup = v
+		}
+	default:
+		adjustmentType, ok := d.GetOk("adjustment_type")
+		if !ok {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'adjustment_type' and 'adjustment_value' are required when 'scaling_rule_type' is %s.", args.ScalingRuleType)
+		}
+		args.AdjustmentType = ess.AdjustmentType(adjustmentType.(string))
+		args.AdjustmentValue = d.Get("adjustment_value").(int)
+		if v := d.Get("cooldown").(int); v != 0 {
+			args.Cooldown = v
+		}
 	}
 
 	return args, nil
 }
+
+func expandEssStepAdjustments(list []interface{}) []EssStepAdjustmentArgs {
+	var adjustments []EssStepAdjustmentArgs
+	for _, v := range list {
+		step := v.(map[string]interface{})
+		adjustments = append(adjustments, EssStepAdjustmentArgs{
+			MetricIntervalLowerBound: step["metric_interval_lower_bound"].(string),
+			MetricIntervalUpperBound: step["metric_interval_upper_bound"].(string),
+			ScalingAdjustment:        step["scaling_adjustment"].(int),
+		})
+	}
+	return adjustments
+}
+
+func flattenEssStepAdjustments(list []EssStepAdjustment) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, step := range list {
+		l := map[string]interface{}{
+			"scaling_adjustment": step.ScalingAdjustment,
+		}
+		if step.MetricIntervalLowerBound != nil {
+			l["metric_interval_lower_bound"] = strconv.FormatFloat(*step.MetricIntervalLowerBound, 'f', -1, 64)
+		}
+		if step.MetricIntervalUpperBound != nil {
+			l["metric_interval_upper_bound"] = strconv.FormatFloat(*step.MetricIntervalUpperBound, 'f', -1, 64)
+		}
+		result = append(result, l)
+	}
+	return result
+}
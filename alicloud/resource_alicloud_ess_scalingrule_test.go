@@ -3,7 +3,6 @@ package alicloud
 import (
 	"fmt"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
@@ -12,7 +11,7 @@ import (
 )
 
 func TestAccAlicloudEssScalingRule_basic(t *testing.T) {
-	var sc ess.ScalingRuleItemType
+	var sc EssScalingRule
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -45,7 +44,7 @@ func TestAccAlicloudEssScalingRule_basic(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingRule_update(t *testing.T) {
-	var sc ess.ScalingRuleItemType
+	var sc EssScalingRule
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -97,7 +96,84 @@ func TestAccAlicloudEssScalingRule_update(t *testing.T) {
 	})
 }
 
-func testAccCheckEssScalingRuleExists(n string, d *ess.ScalingRuleItemType) resource.TestCheckFunc {
+func TestAccAlicloudEssScalingRule_targetTracking(t *testing.T) {
+	var sc EssScalingRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingRule_targetTracking,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingRuleExists(
+						"alicloud_ess_scaling_rule.foo", &sc),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_rule.foo",
+						"scaling_rule_type",
+						"TargetTrackingScalingRule"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_rule.foo",
+						"metric_name",
+						"CpuUtilization"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_rule.foo",
+						"target_value",
+						"80"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_ess_scaling_rule.foo",
+						"ari"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudEssScalingRule_step(t *testing.T) {
+	var sc EssScalingRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingRule_step,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingRuleExists(
+						"alicloud_ess_scaling_rule.foo", &sc),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_rule.foo",
+						"scaling_rule_type",
+						"StepScalingRule"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_rule.foo",
+						"step_adjustment.#",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_scaling_rule.foo",
+						"step_adjustment.1.metric_interval_lower_bound",
+						"10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEssScalingRuleExists(n string, d *EssScalingRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -178,7 +254,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "bar" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "bar"
+	scaling_group_name = "tf-test-bar"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -222,7 +298,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "bar" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "bar"
+	scaling_group_name = "tf-test-bar"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -266,7 +342,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "bar" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "bar"
+	scaling_group_name = "tf-test-bar"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -285,3 +361,46 @@ resource "alicloud_ess_scaling_rule" "foo" {
 	cooldown = 60
 }
 `
+
+const testAccEssScalingRule_targetTracking = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 1
+	max_size = 1
+	scaling_group_name = "tf-test-bar"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	scaling_rule_type = "TargetTrackingScalingRule"
+	metric_name = "CpuUtilization"
+	target_value = 80
+	disable_scale_in = true
+	estimated_instance_warmup = 300
+}
+`
+
+const testAccEssScalingRule_step = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 1
+	max_size = 1
+	scaling_group_name = "tf-test-bar"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	scaling_rule_type = "StepScalingRule"
+	adjustment_type = "QuantityChangeInCapacity"
+	step_adjustment = [
+	  {
+	    metric_interval_upper_bound = "10"
+	    scaling_adjustment = 1
+	  },
+	  {
+	    metric_interval_lower_bound = "10"
+	    scaling_adjustment = 2
+	  }
+	]
+}
+`
@@ -5,6 +5,8 @@ import (
 	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -18,10 +20,15 @@ func resourceAlicloudEssSchedule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scheduled_action": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEssScheduledAction,
 			},
 			"launch_time": &schema.Schema{
 				Type:     schema.TypeString,
@@ -47,7 +54,7 @@ func resourceAlicloudEssSchedule() *schema.Resource {
 				Computed: true,
 				Optional: true,
 				ValidateFunc: validateAllowedStringValue([]string{string(ess.Daily),
-					string(ess.Weekly), string(ess.Monthly)}),
+					string(ess.Weekly), string(ess.Monthly), string(EssRecurrenceCron)}),
 			},
 			"recurrence_value": &schema.Schema{
 				Type:     schema.TypeString,
@@ -75,7 +82,7 @@ func resourceAliyunEssScheduleCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	essconn := meta.(*AliyunClient).essconn
+	essconn := meta.(*AliyunClient).essconn()
 
 	rule, err := essconn.CreateScheduledTask(args)
 	if err != nil {
@@ -93,7 +100,7 @@ func resourceAliyunEssScheduleRead(d *schema.ResourceData, meta interface{}) err
 
 	rule, err := client.DescribeScheduleById(d.Id())
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "ess") {
 			d.SetId("")
 			return nil
 		}
@@ -114,51 +121,62 @@ func resourceAliyunEssScheduleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAliyunEssScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.IsNewResource() {
+		return resourceAliyunEssScheduleRead(d, meta)
+	}
 
-	conn := meta.(*AliyunClient).essconn
+	client := meta.(*AliyunClient)
 
-	args := &ess.ModifyScheduledTaskArgs{
+	args := &ModifyEssScheduledTaskArgs{
+		RegionId:        client.Region,
 		ScheduledTaskId: d.Id(),
 	}
+	update := false
 
 	if d.HasChange("scheduled_task_name") {
 		args.ScheduledTaskName = d.Get("scheduled_task_name").(string)
+		update = true
 	}
 
 	if d.HasChange("description") {
 		args.Description = d.Get("description").(string)
+		update = true
 	}
 
 	if d.HasChange("scheduled_action") {
 		args.ScheduledAction = d.Get("scheduled_action").(string)
+		update = true
 	}
 
 	if d.HasChange("launch_time") {
 		args.LaunchTime = d.Get("launch_time").(string)
+		update = true
 	}
 
 	if d.HasChange("launch_expiration_time") {
 		args.LaunchExpirationTime = d.Get("launch_expiration_time").(int)
+		update = true
 	}
 
-	if d.HasChange("recurrence_type") {
+	if d.HasChange("recurrence_type") || d.HasChange("recurrence_value") || d.HasChange("recurrence_end_time") {
+		if err := checkEssScheduleRecurrence(d); err != nil {
+			return err
+		}
 		args.RecurrenceType = ess.RecurrenceType(d.Get("recurrence_type").(string))
-	}
-
-	if d.HasChange("recurrence_value") {
 		args.RecurrenceValue = d.Get("recurrence_value").(string)
-	}
-
-	if d.HasChange("recurrence_end_time") {
 		args.RecurrenceEndTime = d.Get("recurrence_end_time").(string)
+		update = true
 	}
 
 	if d.HasChange("task_enabled") {
-		args.TaskEnabled = d.Get("task_enabled").(bool)
+		args.TaskEnabled = strconv.FormatBool(d.Get("task_enabled").(bool))
+		update = true
 	}
 
-	if _, err := conn.ModifyScheduledTask(args); err != nil {
-		return err
+	if update {
+		if err := client.essconn().Invoke("ModifyScheduledTask", args, &EssResponse{}); err != nil {
+			return WrapError(err, "ModifyScheduledTask", d.Id())
+		}
 	}
 
 	return resourceAliyunEssScheduleRead(d, meta)
@@ -166,8 +184,9 @@ func resourceAliyunEssScheduleUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceAliyunEssScheduleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := client.DeleteScheduleById(d.Id())
 
 		if err != nil {
@@ -176,7 +195,7 @@ func resourceAliyunEssScheduleDelete(d *schema.ResourceData, meta interface{}) e
 
 		_, err = client.DescribeScheduleById(d.Id())
 		if err != nil {
-			if NotFoundError(err) {
+			if NotFoundError(err, "ess") {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -202,6 +221,10 @@ func buildAlicloudEssScheduleArgs(d *schema.ResourceData, meta interface{}) (*es
 		args.Description = v
 	}
 
+	if err := checkEssScheduleRecurrence(d); err != nil {
+		return nil, err
+	}
+
 	if v := d.Get("recurrence_type").(string); v != "" {
 		args.RecurrenceType = ess.RecurrenceType(v)
 	}
@@ -220,3 +243,19 @@ func buildAlicloudEssScheduleArgs(d *schema.ResourceData, meta interface{}) (*es
 
 	return args, nil
 }
+
+// checkEssScheduleRecurrence ensures the recurrence type, value and end time are set together.
+func checkEssScheduleRecurrence(d *schema.ResourceData) error {
+	var set, unset []string
+	for _, key := range []string{"recurrence_type", "recurrence_value", "recurrence_end_time"} {
+		if v, ok := d.GetOk(key); ok && v.(string) != "" {
+			set = append(set, key)
+		} else {
+			unset = append(unset, key)
+		}
+	}
+	if len(set) > 0 && len(unset) > 0 {
+		return ConfigErrorf(ErrorCodeConflictingArguments, "%s must be set together with %s.", strings.Join(unset, ", "), strings.Join(set, ", "))
+	}
+	return nil
+}
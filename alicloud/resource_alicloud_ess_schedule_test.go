@@ -43,6 +43,58 @@ func TestAccAlicloudEssSchedule_basic(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudEssSchedule_recurrence(t *testing.T) {
+	var sc ess.ScheduledTaskItemType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_schedule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScheduleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScheduleRecurrence,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScheduleExists(
+						"alicloud_ess_schedule.foo", &sc),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_schedule.foo",
+						"recurrence_type",
+						"Daily"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_schedule.foo",
+						"launch_expiration_time",
+						"300"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssScheduleRecurrence_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScheduleExists(
+						"alicloud_ess_schedule.foo", &sc),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_schedule.foo",
+						"recurrence_type",
+						"Cron"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_schedule.foo",
+						"recurrence_value",
+						"0 2 * * *"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ess_schedule.foo",
+						"task_enabled",
+						"false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEssScheduleExists(n string, d *ess.ScheduledTaskItemType) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -123,7 +175,7 @@ resource "alicloud_security_group_rule" "ssh-in" {
 resource "alicloud_ess_scaling_group" "bar" {
 	min_size = 1
 	max_size = 1
-	scaling_group_name = "bar"
+	scaling_group_name = "tf-test-bar"
 	removal_policies = ["OldestInstance", "NewestInstance"]
 }
 
@@ -148,3 +200,54 @@ resource "alicloud_ess_schedule" "foo" {
 	scheduled_task_name = "tf-foo"
 }
 `
+
+const testAccEssScheduleRecurrence = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 1
+	max_size = 1
+	scaling_group_name = "tf-test-bar"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	adjustment_type = "TotalCapacity"
+	adjustment_value = 1
+}
+
+resource "alicloud_ess_schedule" "foo" {
+	scheduled_action = "${alicloud_ess_scaling_rule.foo.ari}"
+	launch_time = "2027-05-12T08:18Z"
+	scheduled_task_name = "tf-foo"
+	launch_expiration_time = 300
+	recurrence_type = "Daily"
+	recurrence_value = "1"
+	recurrence_end_time = "2027-05-20T08:18Z"
+}
+`
+
+const testAccEssScheduleRecurrence_update = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 1
+	max_size = 1
+	scaling_group_name = "tf-test-bar"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	adjustment_type = "TotalCapacity"
+	adjustment_value = 1
+}
+
+resource "alicloud_ess_schedule" "foo" {
+	scheduled_action = "${alicloud_ess_scaling_rule.foo.ari}"
+	launch_time = "2027-05-12T08:18Z"
+	scheduled_task_name = "tf-foo"
+	launch_expiration_time = 300
+	recurrence_type = "Cron"
+	recurrence_value = "0 2 * * *"
+	recurrence_end_time = "2027-05-20T08:18Z"
+	task_enabled = false
+}
+`
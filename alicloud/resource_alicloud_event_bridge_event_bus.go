@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEventBridgeEventBus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEventBridgeEventBusCreate,
+		Read:   resourceAlicloudEventBridgeEventBusRead,
+		Update: resourceAlicloudEventBridgeEventBusUpdate,
+		Delete: resourceAlicloudEventBridgeEventBusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_bus_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 127),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudEventBridgeEventBusCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := EventBusArgs{
+		EventBusName: d.Get("event_bus_name").(string),
+		Description:  d.Get("description").(string),
+	}
+	if err := client.invokeEventBridge("CreateEventBus", &args, &EventBridgeResponse{}); err != nil {
+		return WrapError(err, "CreateEventBus", d.Id())
+	}
+
+	d.SetId(args.EventBusName)
+
+	return resourceAlicloudEventBridgeEventBusRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeEventBusRead(d *schema.ResourceData, meta interface{}) error {
+	bus, err := meta.(*AliyunClient).DescribeEventBus(d.Id())
+	if err != nil {
+		if NotFoundError(err, "eventbridge") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetEventBus", d.Id())
+	}
+
+	d.Set("event_bus_name", bus.EventBusName)
+	d.Set("description", bus.Description)
+	d.Set("arn", bus.EventBusARN)
+
+	return nil
+}
+
+func resourceAlicloudEventBridgeEventBusUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("description") {
+		args := EventBusArgs{
+			EventBusName: d.Id(),
+			Description:  d.Get("description").(string),
+		}
+		if err := client.invokeEventBridge("UpdateEventBus", &args, &EventBridgeResponse{}); err != nil {
+			return WrapError(err, "UpdateEventBus", d.Id())
+		}
+	}
+
+	return resourceAlicloudEventBridgeEventBusRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeEventBusDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := EventBusArgs{
+		EventBusName: d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeEventBridge("DeleteEventBus", &args, &EventBridgeResponse{}); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteEventBus", d.Id()))
+		}
+
+		if _, err := client.DescribeEventBus(d.Id()); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Event bus %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
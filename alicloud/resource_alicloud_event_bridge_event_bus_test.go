@@ -0,0 +1,107 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEventBridgeEventBus_basic(t *testing.T) {
+	var v EventBus
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_event_bridge_event_bus.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEventBridgeEventBusDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEventBridgeEventBusConfig("test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeEventBusExists(
+						"alicloud_event_bridge_event_bus.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_event_bus.foo",
+						"event_bus_name",
+						"tf-testAccEventBridgeEventBus"),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_event_bus.foo",
+						"description",
+						"test description"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_event_bridge_event_bus.foo",
+						"arn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEventBridgeEventBusConfig("test description updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeEventBusExists(
+						"alicloud_event_bridge_event_bus.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_event_bus.foo",
+						"description",
+						"test description updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventBridgeEventBusExists(n string, d *EventBus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No event bus ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		bus, err := client.DescribeEventBus(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *bus
+		return nil
+	}
+}
+
+func testAccCheckEventBridgeEventBusDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_event_bridge_event_bus" {
+			continue
+		}
+
+		if _, err := client.DescribeEventBus(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Event bus %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEventBridgeEventBusConfig(description string) string {
+	return fmt.Sprintf(`
+resource "alicloud_event_bridge_event_bus" "foo" {
+  event_bus_name = "tf-testAccEventBridgeEventBus"
+  description = "%s"
+}
+`, description)
+}
@@ -0,0 +1,170 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEventBridgeEventSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEventBridgeEventSourceCreate,
+		Read:   resourceAlicloudEventBridgeEventSourceRead,
+		Update: resourceAlicloudEventBridgeEventSourceUpdate,
+		Delete: resourceAlicloudEventBridgeEventSourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_source_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 127),
+			},
+			"event_bus_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"linked_external_source": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"external_source_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(EventBridgeExternalSourceTypes),
+			},
+			"external_source_config": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudEventBridgeEventSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args, err := buildEventBridgeEventSourceArgs(d)
+	if err != nil {
+		return err
+	}
+	args.EventSourceName = d.Get("event_source_name").(string)
+	if err := client.invokeEventBridge("CreateEventSource", &args, &EventBridgeResponse{}); err != nil {
+		return WrapError(err, "CreateEventSource", d.Id())
+	}
+
+	d.SetId(args.EventSourceName)
+
+	return resourceAlicloudEventBridgeEventSourceRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeEventSourceRead(d *schema.ResourceData, meta interface{}) error {
+	source, err := meta.(*AliyunClient).DescribeEventSource(d.Id())
+	if err != nil {
+		if NotFoundError(err, "eventbridge") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListUserDefinedEventSources", d.Id())
+	}
+
+	d.Set("event_source_name", source.Name)
+	d.Set("event_bus_name", source.EventBusName)
+	d.Set("description", source.Description)
+	d.Set("linked_external_source", source.ExternalSourceType != "")
+	d.Set("external_source_type", source.ExternalSourceType)
+
+	config := make(map[string]string)
+	for k, v := range source.ExternalSourceConfig {
+		config[k] = fmt.Sprint(v)
+	}
+	if err := d.Set("external_source_config", config); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudEventBridgeEventSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("description") || d.HasChange("linked_external_source") ||
+		d.HasChange("external_source_type") || d.HasChange("external_source_config") {
+		args, err := buildEventBridgeEventSourceArgs(d)
+		if err != nil {
+			return err
+		}
+		args.EventSourceName = d.Id()
+		if err := client.invokeEventBridge("UpdateEventSource", &args, &EventBridgeResponse{}); err != nil {
+			return WrapError(err, "UpdateEventSource", d.Id())
+		}
+	}
+
+	return resourceAlicloudEventBridgeEventSourceRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeEventSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := EventSourceArgs{
+		EventSourceName: d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeEventBridge("DeleteEventSource", &args, &EventBridgeResponse{}); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteEventSource", d.Id()))
+		}
+
+		if _, err := client.DescribeEventSource(d.Id()); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Event source %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildEventBridgeEventSourceArgs(d *schema.ResourceData) (EventSourceArgs, error) {
+	args := EventSourceArgs{
+		EventBusName:         d.Get("event_bus_name").(string),
+		Description:          d.Get("description").(string),
+		LinkedExternalSource: strconv.FormatBool(d.Get("linked_external_source").(bool)),
+	}
+
+	if !d.Get("linked_external_source").(bool) {
+		return args, nil
+	}
+
+	args.ExternalSourceType = d.Get("external_source_type").(string)
+	if args.ExternalSourceType == "" {
+		return args, ConfigErrorf(ErrorCodeMissingArgument, "'external_source_type' is required when 'linked_external_source' is true.")
+	}
+	config, err := json.Marshal(d.Get("external_source_config").(map[string]interface{}))
+	if err != nil {
+		return args, fmt.Errorf("Marshaling external source config got an error: %#v", err)
+	}
+	args.ExternalSourceConfig = string(config)
+	return args, nil
+}
@@ -0,0 +1,113 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEventBridgeEventSource_basic(t *testing.T) {
+	var v EventSource
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_event_bridge_event_source.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEventBridgeEventSourceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEventBridgeEventSourceConfig("test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeEventSourceExists(
+						"alicloud_event_bridge_event_source.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_event_source.foo",
+						"event_source_name",
+						"tf-testAccEventBridgeEventSource"),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_event_source.foo",
+						"event_bus_name",
+						"tf-testAccEventBridgeEventSource"),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_event_source.foo",
+						"linked_external_source",
+						"false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEventBridgeEventSourceConfig("test description updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeEventSourceExists(
+						"alicloud_event_bridge_event_source.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_event_source.foo",
+						"description",
+						"test description updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventBridgeEventSourceExists(n string, d *EventSource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No event source ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		source, err := client.DescribeEventSource(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *source
+		return nil
+	}
+}
+
+func testAccCheckEventBridgeEventSourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_event_bridge_event_source" {
+			continue
+		}
+
+		if _, err := client.DescribeEventSource(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Event source %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEventBridgeEventSourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "alicloud_event_bridge_event_bus" "foo" {
+  event_bus_name = "tf-testAccEventBridgeEventSource"
+}
+
+resource "alicloud_event_bridge_event_source" "foo" {
+  event_source_name = "tf-testAccEventBridgeEventSource"
+  event_bus_name = "${alicloud_event_bridge_event_bus.foo.event_bus_name}"
+  description = "%s"
+}
+`, description)
+}
@@ -0,0 +1,338 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEventBridgeRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEventBridgeRuleCreate,
+		Read:   resourceAlicloudEventBridgeRuleRead,
+		Update: resourceAlicloudEventBridgeRuleUpdate,
+		Delete: resourceAlicloudEventBridgeRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_bus_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 127),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_pattern": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      EventBridgeRuleEnable,
+				ValidateFunc: validateAllowedStringValue([]string{EventBridgeRuleEnable, EventBridgeRuleDisable}),
+			},
+			"targets": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(EventBridgeTargetTypes),
+						},
+						"endpoint": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"push_retry_strategy": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateAllowedStringValue(EventBridgePushRetryStrategies),
+						},
+						"param_list": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_key": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"form": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAllowedStringValue(EventBridgeParamForms),
+									},
+									"value": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"template": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudEventBridgeRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	targets, err := expandEventBridgeRuleTargets(d.Get("targets").([]interface{}))
+	if err != nil {
+		return err
+	}
+	args := EventRuleArgs{
+		EventBusName:  d.Get("event_bus_name").(string),
+		RuleName:      d.Get("rule_name").(string),
+		Description:   d.Get("description").(string),
+		FilterPattern: d.Get("filter_pattern").(string),
+		Status:        d.Get("status").(string),
+		Targets:       targets,
+	}
+	if err := client.invokeEventBridge("CreateRule", &args, &EventBridgeResponse{}); err != nil {
+		return WrapError(err, "CreateRule", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.EventBusName, COLON_SEPARATED, args.RuleName))
+
+	return resourceAlicloudEventBridgeRuleRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeRuleRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	rule, err := meta.(*AliyunClient).DescribeEventRule(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "eventbridge") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetRule", d.Id())
+	}
+
+	d.Set("event_bus_name", rule.EventBusName)
+	d.Set("rule_name", rule.RuleName)
+	d.Set("description", rule.Description)
+	d.Set("filter_pattern", rule.FilterPattern)
+	d.Set("status", rule.Status)
+
+	var targets []map[string]interface{}
+	for _, target := range rule.Targets {
+		var params []map[string]interface{}
+		for _, param := range target.ParamList {
+			params = append(params, map[string]interface{}{
+				"resource_key": param.ResourceKey,
+				"form":         param.Form,
+				"value":        param.Value,
+				"template":     param.Template,
+			})
+		}
+		targets = append(targets, map[string]interface{}{
+			"target_id":           target.Id,
+			"type":                target.Type,
+			"endpoint":            target.Endpoint,
+			"push_retry_strategy": target.PushRetryStrategy,
+			"param_list":          params,
+		})
+	}
+	if err := d.Set("targets", targets); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudEventBridgeRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	d.Partial(true)
+
+	if d.HasChange("description") || d.HasChange("filter_pattern") {
+		args := EventRuleArgs{
+			EventBusName:  parts[0],
+			RuleName:      parts[1],
+			Description:   d.Get("description").(string),
+			FilterPattern: d.Get("filter_pattern").(string),
+		}
+		if err := client.invokeEventBridge("UpdateRule", &args, &EventBridgeResponse{}); err != nil {
+			return WrapError(err, "UpdateRule", d.Id())
+		}
+		d.SetPartial("description")
+		d.SetPartial("filter_pattern")
+	}
+
+	if d.HasChange("targets") {
+		if err := eventBridgeRuleTargetsUpdate(client, d, parts[0], parts[1]); err != nil {
+			return err
+		}
+		d.SetPartial("targets")
+	}
+
+	if d.HasChange("status") {
+		action := "EnableRule"
+		if d.Get("status").(string) == EventBridgeRuleDisable {
+			action = "DisableRule"
+		}
+		args := EventRuleArgs{
+			EventBusName: parts[0],
+			RuleName:     parts[1],
+		}
+		if err := client.invokeEventBridge(action, &args, &EventBridgeResponse{}); err != nil {
+			return fmt.Errorf("%s got an error: %#v", action, err)
+		}
+		d.SetPartial("status")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudEventBridgeRuleRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := EventRuleArgs{
+		EventBusName: parts[0],
+		RuleName:     parts[1],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeEventBridge("DeleteRule", &args, &EventBridgeResponse{}); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteRule", d.Id()))
+		}
+
+		if _, err := client.DescribeEventRule(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Event rule %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func expandEventBridgeRuleTargets(list []interface{}) (string, error) {
+	var targets []EventRuleTarget
+	for _, v := range list {
+		t := v.(map[string]interface{})
+		target := EventRuleTarget{
+			Id:                t["target_id"].(string),
+			Type:              t["type"].(string),
+			Endpoint:          t["endpoint"].(string),
+			PushRetryStrategy: t["push_retry_strategy"].(string),
+		}
+		for _, p := range t["param_list"].([]interface{}) {
+			param := p.(map[string]interface{})
+			target.ParamList = append(target.ParamList, EventRuleTargetParam{
+				ResourceKey: param["resource_key"].(string),
+				Form:        param["form"].(string),
+				Value:       param["value"].(string),
+				Template:    param["template"].(string),
+			})
+		}
+		targets = append(targets, target)
+	}
+
+	bytes, err := json.Marshal(targets)
+	if err != nil {
+		return "", fmt.Errorf("Marshaling event rule targets got an error: %#v", err)
+	}
+	return string(bytes), nil
+}
+
+// eventBridgeRuleTargetsUpdate puts all the targets, which overwrites the existing ones with the same ids,
+// and then deletes the targets which are removed.
+func eventBridgeRuleTargetsUpdate(client *AliyunClient, d *schema.ResourceData, busName, ruleName string) error {
+	ov, nv := d.GetChange("targets")
+
+	targets, err := expandEventBridgeRuleTargets(nv.([]interface{}))
+	if err != nil {
+		return err
+	}
+	args := EventRuleArgs{
+		EventBusName: busName,
+		RuleName:     ruleName,
+		Targets:      targets,
+	}
+	if err := client.invokeEventBridge("PutTargets", &args, &EventBridgeResponse{}); err != nil {
+		return WrapError(err, "PutTargets", d.Id())
+	}
+
+	ids := make(map[string]bool)
+	for _, v := range nv.([]interface{}) {
+		ids[v.(map[string]interface{})["target_id"].(string)] = true
+	}
+	var removed []string
+	for _, v := range ov.([]interface{}) {
+		if id := v.(map[string]interface{})["target_id"].(string); !ids[id] {
+			removed = append(removed, id)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	bytes, err := json.Marshal(removed)
+	if err != nil {
+		return fmt.Errorf("Marshaling event rule target ids got an error: %#v", err)
+	}
+	args = EventRuleArgs{
+		EventBusName: busName,
+		RuleName:     ruleName,
+		TargetIds:    string(bytes),
+	}
+	if err := client.invokeEventBridge("DeleteTargets", &args, &EventBridgeResponse{}); err != nil {
+		return WrapError(err, "DeleteTargets", d.Id())
+	}
+	return nil
+}
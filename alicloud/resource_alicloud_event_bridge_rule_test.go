@@ -0,0 +1,151 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEventBridgeRule_basic(t *testing.T) {
+	var v EventRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_event_bridge_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEventBridgeRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEventBridgeRuleConfig("ENABLE", "http://www.aliyun.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeRuleExists(
+						"alicloud_event_bridge_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_rule.foo",
+						"rule_name",
+						"tf-testAccEventBridgeRule"),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_rule.foo",
+						"status",
+						"ENABLE"),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_rule.foo",
+						"targets.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_rule.foo",
+						"targets.0.endpoint",
+						"http://www.aliyun.com"),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_rule.foo",
+						"targets.0.param_list.#",
+						"2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEventBridgeRuleConfig("DISABLE", "http://www.taobao.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeRuleExists(
+						"alicloud_event_bridge_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_rule.foo",
+						"status",
+						"DISABLE"),
+					resource.TestCheckResourceAttr(
+						"alicloud_event_bridge_rule.foo",
+						"targets.0.endpoint",
+						"http://www.taobao.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventBridgeRuleExists(n string, d *EventRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No event rule ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		rule, err := client.DescribeEventRule(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *rule
+		return nil
+	}
+}
+
+func testAccCheckEventBridgeRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_event_bridge_rule" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeEventRule(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "eventbridge") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Event rule %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEventBridgeRuleConfig(status, endpoint string) string {
+	return fmt.Sprintf(`
+resource "alicloud_event_bridge_event_bus" "foo" {
+  event_bus_name = "tf-testAccEventBridgeRule"
+}
+
+resource "alicloud_event_bridge_rule" "foo" {
+  event_bus_name = "${alicloud_event_bridge_event_bus.foo.event_bus_name}"
+  rule_name = "tf-testAccEventBridgeRule"
+  description = "test description"
+  filter_pattern = "{\"source\":[\"crmabc.newsletter\"]}"
+  status = "%s"
+
+  targets = [{
+    target_id = "tf-test"
+    type = "http"
+    endpoint = "%s"
+    param_list = [{
+      resource_key = "url"
+      form = "CONSTANT"
+      value = "%s"
+    }, {
+      resource_key = "Body"
+      form = "ORIGINAL"
+    }]
+  }]
+}
+`, status, endpoint, endpoint)
+}
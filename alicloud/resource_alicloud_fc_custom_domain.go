@@ -0,0 +1,237 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcCustomDomainCreate,
+		Read:   resourceAlicloudFcCustomDomainRead,
+		Update: resourceAlicloudFcCustomDomainUpdate,
+		Delete: resourceAlicloudFcCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HTTP",
+				ValidateFunc: validateAllowedStringValue(FcCustomDomainProtocols),
+			},
+			"route_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"function_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"qualifier": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"methods": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAllowedStringValue([]string{"GET", "POST", "PUT", "DELETE", "HEAD"}),
+							},
+						},
+					},
+				},
+			},
+			"cert_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cert_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"certificate": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// The private key is not returned by the API, so it is kept as it is configured.
+						"private_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"account_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	domain, err := buildFcCustomDomain(d)
+	if err != nil {
+		return err
+	}
+	domain.DomainName = d.Get("domain_name").(string)
+	if err := client.invokeFc(http.MethodPost, "/custom-domains", domain, nil); err != nil {
+		return WrapError(err, "CreateCustomDomain", d.Id())
+	}
+
+	d.SetId(domain.DomainName)
+
+	return resourceAlicloudFcCustomDomainRead(d, meta)
+}
+
+func resourceAlicloudFcCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	domain, err := meta.(*AliyunClient).DescribeFcCustomDomain(d.Id())
+	if err != nil {
+		if NotFoundError(err, "fc") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetCustomDomain", d.Id())
+	}
+
+	d.Set("domain_name", domain.DomainName)
+	d.Set("protocol", domain.Protocol)
+	d.Set("account_id", domain.AccountId)
+	d.Set("last_modified", domain.LastModifiedTime)
+
+	var routes []map[string]interface{}
+	if domain.RouteConfig != nil {
+		for _, r := range domain.RouteConfig.Routes {
+			routes = append(routes, map[string]interface{}{
+				"path":          r.Path,
+				"service_name":  r.ServiceName,
+				"function_name": r.FunctionName,
+				"qualifier":     r.Qualifier,
+				"methods":       r.Methods,
+			})
+		}
+	}
+	if err := d.Set("route_config", routes); err != nil {
+		return err
+	}
+
+	var certConfig []map[string]interface{}
+	if domain.CertConfig != nil && domain.CertConfig.CertName != "" {
+		certConfig = append(certConfig, map[string]interface{}{
+			"cert_name":   domain.CertConfig.CertName,
+			"certificate": domain.CertConfig.Certificate,
+			"private_key": d.Get("cert_config.0.private_key").(string),
+		})
+	}
+	if err := d.Set("cert_config", certConfig); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudFcCustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("protocol") || d.HasChange("route_config") || d.HasChange("cert_config") {
+		domain, err := buildFcCustomDomain(d)
+		if err != nil {
+			return err
+		}
+		if err := client.invokeFc(http.MethodPut, fmt.Sprintf("/custom-domains/%s", d.Id()), domain, nil); err != nil {
+			return WrapError(err, "UpdateCustomDomain", d.Id())
+		}
+	}
+
+	return resourceAlicloudFcCustomDomainRead(d, meta)
+}
+
+func resourceAlicloudFcCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeFc(http.MethodDelete, fmt.Sprintf("/custom-domains/%s", d.Id()), nil, nil); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteCustomDomain", d.Id()))
+		}
+
+		if _, err := client.DescribeFcCustomDomain(d.Id()); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("FC custom domain %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildFcCustomDomain(d *schema.ResourceData) (*FcCustomDomain, error) {
+	domain := &FcCustomDomain{
+		Protocol:    d.Get("protocol").(string),
+		RouteConfig: &FcRouteConfig{Routes: []FcPathConfig{}},
+	}
+
+	for _, r := range d.Get("route_config").([]interface{}) {
+		route := r.(map[string]interface{})
+		domain.RouteConfig.Routes = append(domain.RouteConfig.Routes, FcPathConfig{
+			Path:         route["path"].(string),
+			ServiceName:  route["service_name"].(string),
+			FunctionName: route["function_name"].(string),
+			Qualifier:    route["qualifier"].(string),
+			Methods:      expandStringList(route["methods"].([]interface{})),
+		})
+	}
+
+	if v := d.Get("cert_config").([]interface{}); len(v) > 0 && v[0] != nil {
+		if domain.Protocol != "HTTP,HTTPS" {
+			return nil, fmt.Errorf("'cert_config' is only available when 'protocol' is 'HTTP,HTTPS'.")
+		}
+		cert := v[0].(map[string]interface{})
+		domain.CertConfig = &FcCertConfig{
+			CertName:    cert["cert_name"].(string),
+			Certificate: cert["certificate"].(string),
+			PrivateKey:  cert["private_key"].(string),
+		}
+	}
+
+	return domain, nil
+}
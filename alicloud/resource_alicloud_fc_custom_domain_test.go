@@ -0,0 +1,137 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFcCustomDomain_basic(t *testing.T) {
+	var v FcCustomDomain
+
+	filename, err := testAccFcFunctionZipFile("def handler(environ, start_response):\n    start_response('200 OK', [])\n    return [b'hello']\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filename)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithFcCustomDomain(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fc_custom_domain.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFcCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFcCustomDomainConfig(filename, "/*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcCustomDomainExists(
+						"alicloud_fc_custom_domain.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_custom_domain.foo",
+						"protocol",
+						"HTTP"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_custom_domain.foo",
+						"route_config.0.path",
+						"/*"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFcCustomDomainConfig(filename, "/login/*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcCustomDomainExists(
+						"alicloud_fc_custom_domain.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_custom_domain.foo",
+						"route_config.0.path",
+						"/login/*"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFcCustomDomainExists(n string, d *FcCustomDomain) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC custom domain ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		domain, err := client.DescribeFcCustomDomain(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *domain
+		return nil
+	}
+}
+
+func testAccCheckFcCustomDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_custom_domain" {
+			continue
+		}
+
+		if _, err := client.DescribeFcCustomDomain(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "fc") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("FC custom domain %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFcCustomDomainConfig(filename, path string) string {
+	return fmt.Sprintf(`
+resource "alicloud_fc_service" "foo" {
+  name = "tf-testAccFcCustomDomain"
+}
+
+resource "alicloud_fc_function" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "tf-testAccFcCustomDomain"
+  runtime = "python3"
+  handler = "index.handler"
+  filename = "%s"
+}
+
+resource "alicloud_fc_trigger" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  function = "${alicloud_fc_function.foo.name}"
+  name = "tf-testAccFcCustomDomain"
+  type = "http"
+  config = "{\"authType\":\"anonymous\",\"methods\":[\"GET\"]}"
+}
+
+resource "alicloud_fc_custom_domain" "foo" {
+  domain_name = "%s"
+  route_config = [{
+    path = "%s"
+    service_name = "${alicloud_fc_service.foo.name}"
+    function_name = "${alicloud_fc_function.foo.name}"
+    methods = ["GET"]
+  }]
+  depends_on = ["alicloud_fc_trigger.foo"]
+}
+`, filename, os.Getenv("ALICLOUD_FC_CUSTOM_DOMAIN"), path)
+}
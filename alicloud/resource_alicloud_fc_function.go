@@ -0,0 +1,266 @@
+package alicloud
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcFunction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcFunctionCreate,
+		Read:   resourceAlicloudFcFunctionRead,
+		Update: resourceAlicloudFcFunctionUpdate,
+		Delete: resourceAlicloudFcFunctionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtime": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(FcFunctionRuntimes),
+			},
+			"handler": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"memory_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      128,
+				ValidateFunc: validateIntegerInRange(128, 3072),
+			},
+			"timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validateIntegerInRange(1, 600),
+			},
+			"environment_variables": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"filename": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"oss_bucket", "oss_key"},
+			},
+			"oss_bucket": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename"},
+			},
+			"oss_key": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename"},
+			},
+			// The code is uploaded again when the hash changes, and it is usually set by the
+			// interpolation like "${base64sha256(file("code.zip"))}".
+			"source_code_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"code_checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcFunctionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	function := buildFcFunction(d)
+	function.FunctionName = d.Get("name").(string)
+	code, err := buildFcCode(d)
+	if err != nil {
+		return err
+	}
+	function.Code = code
+
+	service := d.Get("service").(string)
+	if err := client.invokeFc(http.MethodPost, fmt.Sprintf("/services/%s/functions", service), function, nil); err != nil {
+		return WrapError(err, "CreateFunction", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", service, COLON_SEPARATED, function.FunctionName))
+
+	return resourceAlicloudFcFunctionRead(d, meta)
+}
+
+func resourceAlicloudFcFunctionRead(d *schema.ResourceData, meta interface{}) error {
+	service, name, err := parseFcFunctionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	function, err := meta.(*AliyunClient).DescribeFcFunction(service, name)
+	if err != nil {
+		if NotFoundError(err, "fc") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetFunction", d.Id())
+	}
+
+	d.Set("service", service)
+	d.Set("name", function.FunctionName)
+	d.Set("description", function.Description)
+	d.Set("runtime", function.Runtime)
+	d.Set("handler", function.Handler)
+	d.Set("memory_size", function.MemorySize)
+	d.Set("timeout", function.Timeout)
+	if err := d.Set("environment_variables", function.EnvironmentVariables); err != nil {
+		return err
+	}
+	d.Set("code_checksum", function.CodeChecksum)
+	d.Set("function_id", function.FunctionId)
+	d.Set("last_modified", function.LastModifiedTime)
+
+	return nil
+}
+
+func resourceAlicloudFcFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	service, name, err := parseFcFunctionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	update := false
+	function := buildFcFunction(d)
+	if d.HasChange("description") || d.HasChange("runtime") || d.HasChange("handler") || d.HasChange("memory_size") ||
+		d.HasChange("timeout") || d.HasChange("environment_variables") {
+		update = true
+	}
+	if d.HasChange("filename") || d.HasChange("oss_bucket") || d.HasChange("oss_key") || d.HasChange("source_code_hash") {
+		code, err := buildFcCode(d)
+		if err != nil {
+			return err
+		}
+		function.Code = code
+		update = true
+	}
+
+	if update {
+		if err := client.invokeFc(http.MethodPut, fmt.Sprintf("/services/%s/functions/%s", service, name), function, nil); err != nil {
+			return WrapError(err, "UpdateFunction", d.Id())
+		}
+	}
+
+	return resourceAlicloudFcFunctionRead(d, meta)
+}
+
+func resourceAlicloudFcFunctionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	service, name, err := parseFcFunctionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeFc(http.MethodDelete, fmt.Sprintf("/services/%s/functions/%s", service, name), nil, nil); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			// The triggers of the function may be still being deleted.
+			if IsExceptedError(err, FcFunctionNotEmpty) {
+				return resource.RetryableError(fmt.Errorf("FC function %s is not empty - trying again while it is deleted.", d.Id()))
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteFunction", d.Id()))
+		}
+
+		if _, err := client.DescribeFcFunction(service, name); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("FC function %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildFcFunction(d *schema.ResourceData) *FcFunction {
+	function := &FcFunction{
+		Description:          d.Get("description").(string),
+		Runtime:              d.Get("runtime").(string),
+		Handler:              d.Get("handler").(string),
+		MemorySize:           d.Get("memory_size").(int),
+		Timeout:              d.Get("timeout").(int),
+		EnvironmentVariables: make(map[string]string),
+	}
+	for k, v := range d.Get("environment_variables").(map[string]interface{}) {
+		function.EnvironmentVariables[k] = v.(string)
+	}
+	return function
+}
+
+// buildFcCode reads the code from the OSS object or the local zip file.
+func buildFcCode(d *schema.ResourceData) (*FcCode, error) {
+	if filename, ok := d.GetOk("filename"); ok {
+		content, err := ioutil.ReadFile(filename.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Reading the function code file %s got an error: %#v", filename, err)
+		}
+		return &FcCode{
+			ZipFile: base64.StdEncoding.EncodeToString(content),
+		}, nil
+	}
+
+	bucket, key := d.Get("oss_bucket").(string), d.Get("oss_key").(string)
+	if bucket == "" || key == "" {
+		return nil, ConfigErrorf(ErrorCodeMissingArgument, "Either 'filename' or both 'oss_bucket' and 'oss_key' are required.")
+	}
+	return &FcCode{
+		OssBucketName: bucket,
+		OssObjectName: key,
+	}, nil
+}
+
+func parseFcFunctionId(id string) (service, function string, err error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != 2 {
+		return "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid FC function ID %s, expected '<service>:<function>'.", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,219 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcFunctionAsyncInvokeConfig() *schema.Resource {
+	destination := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// The destination is the arn of a MNS queue or topic, a function or an EventBridge event bus.
+				"destination": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAlicloudFcFunctionAsyncInvokeConfigCreate,
+		Read:   resourceAlicloudFcFunctionAsyncInvokeConfigRead,
+		Update: resourceAlicloudFcFunctionAsyncInvokeConfigUpdate,
+		Delete: resourceAlicloudFcFunctionAsyncInvokeConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"qualifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "LATEST",
+			},
+			"maximum_event_age_in_seconds": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerInRange(1, 2592000),
+			},
+			"maximum_retry_attempts": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validateIntegerInRange(0, 8),
+			},
+			"stateful_invocation": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"destination_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"on_success": destination,
+						"on_failure": destination,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcFunctionAsyncInvokeConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	service, qualifier, function := d.Get("service_name").(string), d.Get("qualifier").(string), d.Get("function_name").(string)
+	if err := putFcAsyncInvokeConfig(meta.(*AliyunClient), d, service, qualifier, function); err != nil {
+		return err
+	}
+
+	d.SetId(strings.Join([]string{service, qualifier, function}, COLON_SEPARATED))
+
+	return resourceAlicloudFcFunctionAsyncInvokeConfigRead(d, meta)
+}
+
+func resourceAlicloudFcFunctionAsyncInvokeConfigRead(d *schema.ResourceData, meta interface{}) error {
+	service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	config, err := meta.(*AliyunClient).DescribeFcAsyncInvokeConfig(service, qualifier, function)
+	if err != nil {
+		if NotFoundError(err, "fc") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetFunctionAsyncInvokeConfig", d.Id())
+	}
+
+	d.Set("service_name", service)
+	d.Set("qualifier", qualifier)
+	d.Set("function_name", function)
+	d.Set("stateful_invocation", config.StatefulInvocation)
+	if config.MaxAsyncEventAgeInSeconds != nil {
+		d.Set("maximum_event_age_in_seconds", *config.MaxAsyncEventAgeInSeconds)
+	}
+	if config.MaxAsyncRetryAttempts != nil {
+		d.Set("maximum_retry_attempts", *config.MaxAsyncRetryAttempts)
+	}
+
+	var destinationConfig []map[string]interface{}
+	if c := config.DestinationConfig; c != nil && (c.OnSuccess != nil || c.OnFailure != nil) {
+		m := make(map[string]interface{})
+		if c.OnSuccess != nil {
+			m["on_success"] = []map[string]interface{}{{"destination": c.OnSuccess.Destination}}
+		}
+		if c.OnFailure != nil {
+			m["on_failure"] = []map[string]interface{}{{"destination": c.OnFailure.Destination}}
+		}
+		destinationConfig = append(destinationConfig, m)
+	}
+	if err := d.Set("destination_config", destinationConfig); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudFcFunctionAsyncInvokeConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("maximum_event_age_in_seconds") || d.HasChange("maximum_retry_attempts") ||
+		d.HasChange("stateful_invocation") || d.HasChange("destination_config") {
+		service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+		if err != nil {
+			return err
+		}
+		if err := putFcAsyncInvokeConfig(meta.(*AliyunClient), d, service, qualifier, function); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudFcFunctionAsyncInvokeConfigRead(d, meta)
+}
+
+func resourceAlicloudFcFunctionAsyncInvokeConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/services/%s.%s/functions/%s/async-invoke-config", service, qualifier, function)
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeFc(http.MethodDelete, path, nil, nil); err != nil {
+			if NotFoundError(err, "fc") ||
+				NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteFunctionAsyncInvokeConfig", d.Id()))
+		}
+
+		if _, err := client.DescribeFcAsyncInvokeConfig(service, qualifier, function); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Async invoke config %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func putFcAsyncInvokeConfig(client *AliyunClient, d *schema.ResourceData, service, qualifier, function string) error {
+	retryAttempts := d.Get("maximum_retry_attempts").(int)
+	config := FcAsyncInvokeConfig{
+		MaxAsyncRetryAttempts: &retryAttempts,
+		StatefulInvocation:    d.Get("stateful_invocation").(bool),
+		DestinationConfig:     &FcDestinationConfig{},
+	}
+	if v, ok := d.GetOk("maximum_event_age_in_seconds"); ok {
+		eventAge := v.(int)
+		config.MaxAsyncEventAgeInSeconds = &eventAge
+	}
+	if v := d.Get("destination_config").([]interface{}); len(v) > 0 && v[0] != nil {
+		destinations := v[0].(map[string]interface{})
+		if s := destinations["on_success"].([]interface{}); len(s) > 0 && s[0] != nil {
+			config.DestinationConfig.OnSuccess = &FcDestination{
+				Destination: s[0].(map[string]interface{})["destination"].(string),
+			}
+		}
+		if f := destinations["on_failure"].([]interface{}); len(f) > 0 && f[0] != nil {
+			config.DestinationConfig.OnFailure = &FcDestination{
+				Destination: f[0].(map[string]interface{})["destination"].(string),
+			}
+		}
+	}
+
+	path := fmt.Sprintf("/services/%s.%s/functions/%s/async-invoke-config", service, qualifier, function)
+	if err := client.invokeFc(http.MethodPut, path, &config, nil); err != nil {
+		return WrapError(err, "PutFunctionAsyncInvokeConfig", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFcFunctionAsyncInvokeConfig_basic(t *testing.T) {
+	var v FcAsyncInvokeConfig
+
+	filename, err := testAccFcFunctionZipFile("def handler(event, context):\n    return 'hello'\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filename)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fc_function_async_invoke_config.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFcFunctionAsyncInvokeConfigDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFcFunctionAsyncInvokeConfigConfig(filename, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcFunctionAsyncInvokeConfigExists(
+						"alicloud_fc_function_async_invoke_config.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_function_async_invoke_config.foo",
+						"maximum_retry_attempts",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_function_async_invoke_config.foo",
+						"destination_config.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFcFunctionAsyncInvokeConfigConfig(filename, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcFunctionAsyncInvokeConfigExists(
+						"alicloud_fc_function_async_invoke_config.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_function_async_invoke_config.foo",
+						"maximum_retry_attempts",
+						"0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFcFunctionAsyncInvokeConfigExists(n string, d *FcAsyncInvokeConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No async invoke config ID is set")
+		}
+
+		service, qualifier, function, err := parseFcFunctionQualifierId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		config, err := client.DescribeFcAsyncInvokeConfig(service, qualifier, function)
+		if err != nil {
+			return err
+		}
+
+		*d = *config
+		return nil
+	}
+}
+
+func testAccCheckFcFunctionAsyncInvokeConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_function_async_invoke_config" {
+			continue
+		}
+
+		service, qualifier, function, err := parseFcFunctionQualifierId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeFcAsyncInvokeConfig(service, qualifier, function); err != nil {
+			if NotFoundError(err, "fc") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Async invoke config %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFcFunctionAsyncInvokeConfigConfig(filename string, retryAttempts int) string {
+	return fmt.Sprintf(`
+resource "alicloud_fc_service" "foo" {
+  name = "tf-testAccFcAsyncInvokeConfig"
+}
+
+resource "alicloud_fc_function" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "tf-testAccFcAsyncInvokeConfig"
+  runtime = "python2.7"
+  handler = "index.handler"
+  filename = "%s"
+}
+
+resource "alicloud_fc_function" "destination" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "tf-testAccFcAsyncInvokeConfig-destination"
+  runtime = "python2.7"
+  handler = "index.handler"
+  filename = "%s"
+}
+
+resource "alicloud_fc_function_async_invoke_config" "foo" {
+  service_name = "${alicloud_fc_service.foo.name}"
+  function_name = "${alicloud_fc_function.foo.name}"
+  maximum_retry_attempts = %d
+  destination_config = {
+    on_failure = {
+      destination = "acs:fc:::services/${alicloud_fc_service.foo.name}/functions/${alicloud_fc_function.destination.name}"
+    }
+  }
+}
+`, filename, filename, retryAttempts)
+}
@@ -0,0 +1,160 @@
+package alicloud
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFcFunction_basic(t *testing.T) {
+	var v FcFunction
+
+	filename, err := testAccFcFunctionZipFile("def handler(event, context):\n    return 'hello'\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filename)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fc_function.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFcFunctionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFcFunctionConfig(filename, 128),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcFunctionExists(
+						"alicloud_fc_function.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_function.foo",
+						"runtime",
+						"python2.7"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_function.foo",
+						"memory_size",
+						"128"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_fc_function.foo",
+						"code_checksum"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFcFunctionConfig(filename, 256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcFunctionExists(
+						"alicloud_fc_function.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_function.foo",
+						"memory_size",
+						"256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFcFunctionExists(n string, d *FcFunction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC function ID is set")
+		}
+
+		service, name, err := parseFcFunctionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		function, err := client.DescribeFcFunction(service, name)
+		if err != nil {
+			return err
+		}
+
+		*d = *function
+		return nil
+	}
+}
+
+func testAccCheckFcFunctionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_function" {
+			continue
+		}
+
+		service, name, err := parseFcFunctionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeFcFunction(service, name); err != nil {
+			if NotFoundError(err, "fc") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("FC function %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccFcFunctionZipFile writes the python code into a temporary zip file as index.py.
+func testAccFcFunctionZipFile(code string) (string, error) {
+	f, err := ioutil.TempFile("", "tf-testAccFcFunction")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	entry, err := w.Create("index.py")
+	if err != nil {
+		return "", err
+	}
+	if _, err := entry.Write([]byte(code)); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func testAccFcFunctionConfig(filename string, memorySize int) string {
+	return fmt.Sprintf(`
+resource "alicloud_fc_service" "foo" {
+  name = "tf-testAccFcFunction"
+}
+
+resource "alicloud_fc_function" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "tf-testAccFcFunction"
+  runtime = "python2.7"
+  handler = "index.handler"
+  memory_size = %d
+  filename = "%s"
+  source_code_hash = "${base64sha256(file("%s"))}"
+  environment_variables = {
+    prefix = "terraform"
+  }
+}
+`, memorySize, filename, filename)
+}
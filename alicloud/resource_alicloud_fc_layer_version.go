@@ -0,0 +1,174 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcLayerVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcLayerVersionCreate,
+		Read:   resourceAlicloudFcLayerVersionRead,
+		Delete: resourceAlicloudFcLayerVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// A layer version can not be modified, and a new version is published for any change.
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"layer_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"compatible_runtime": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateAllowedStringValue(FcFunctionRuntimes)},
+				Set:      schema.HashString,
+			},
+			"filename": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"oss_bucket", "oss_key"},
+			},
+			"oss_bucket": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename"},
+			},
+			"oss_key": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename"},
+			},
+			"source_code_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"code_checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"acl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcLayerVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	code, err := buildFcCode(d)
+	if err != nil {
+		return err
+	}
+	name := d.Get("layer_name").(string)
+	args := FcLayer{
+		Description:       d.Get("description").(string),
+		Code:              code,
+		CompatibleRuntime: expandStringList(d.Get("compatible_runtime").(*schema.Set).List()),
+	}
+	layer := FcLayer{}
+	if err := client.invokeFc(http.MethodPost, fmt.Sprintf("/layers/%s/versions", name), &args, &layer); err != nil {
+		return WrapError(err, "PublishLayerVersion", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%d", name, COLON_SEPARATED, layer.Version))
+
+	return resourceAlicloudFcLayerVersionRead(d, meta)
+}
+
+func resourceAlicloudFcLayerVersionRead(d *schema.ResourceData, meta interface{}) error {
+	name, version, err := parseFcLayerVersionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	layer, err := meta.(*AliyunClient).DescribeFcLayerVersion(name, version)
+	if err != nil {
+		if NotFoundError(err, "fc") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetLayerVersion", d.Id())
+	}
+
+	d.Set("layer_name", layer.LayerName)
+	d.Set("description", layer.Description)
+	if err := d.Set("compatible_runtime", layer.CompatibleRuntime); err != nil {
+		return err
+	}
+	d.Set("version", layer.Version)
+	d.Set("code_checksum", layer.CodeChecksum)
+	d.Set("arn", layer.Arn)
+	d.Set("acl", layer.Acl)
+
+	return nil
+}
+
+func resourceAlicloudFcLayerVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	name, version, err := parseFcLayerVersionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeFc(http.MethodDelete, fmt.Sprintf("/layers/%s/versions/%s", name, version), nil, nil); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteLayerVersion", d.Id()))
+		}
+
+		if _, err := client.DescribeFcLayerVersion(name, version); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("FC layer version %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func parseFcLayerVersionId(id string) (name, version string, err error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != 2 {
+		return "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid FC layer version ID %s, expected '<layer name>:<version>'.", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,125 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFcLayerVersion_basic(t *testing.T) {
+	var v FcLayer
+
+	filename, err := testAccFcFunctionZipFile("def greet():\n    return 'hello'\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filename)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fc_layer_version.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFcLayerVersionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFcLayerVersionConfig(filename, "tf-testAccFcLayerVersion"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcLayerVersionExists(
+						"alicloud_fc_layer_version.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_layer_version.foo",
+						"compatible_runtime.#",
+						"1"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_fc_layer_version.foo",
+						"version"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_fc_layer_version.foo",
+						"arn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFcLayerVersionConfig(filename, "tf-testAccFcLayerVersion-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcLayerVersionExists(
+						"alicloud_fc_layer_version.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_layer_version.foo",
+						"description",
+						"tf-testAccFcLayerVersion-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFcLayerVersionExists(n string, d *FcLayer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC layer version ID is set")
+		}
+
+		name, version, err := parseFcLayerVersionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		layer, err := client.DescribeFcLayerVersion(name, version)
+		if err != nil {
+			return err
+		}
+
+		*d = *layer
+		return nil
+	}
+}
+
+func testAccCheckFcLayerVersionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_layer_version" {
+			continue
+		}
+
+		name, version, err := parseFcLayerVersionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeFcLayerVersion(name, version); err != nil {
+			if NotFoundError(err, "fc") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("FC layer version %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFcLayerVersionConfig(filename, description string) string {
+	return fmt.Sprintf(`
+resource "alicloud_fc_layer_version" "foo" {
+  layer_name = "tf-testAccFcLayerVersion"
+  description = "%s"
+  compatible_runtime = ["python3"]
+  filename = "%s"
+}
+`, description, filename)
+}
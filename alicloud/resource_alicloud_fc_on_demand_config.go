@@ -0,0 +1,136 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcOnDemandConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcOnDemandConfigCreate,
+		Read:   resourceAlicloudFcOnDemandConfigRead,
+		Update: resourceAlicloudFcOnDemandConfigUpdate,
+		Delete: resourceAlicloudFcOnDemandConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"qualifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "LATEST",
+			},
+			"function": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"maximum_instance_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcOnDemandConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	service, qualifier, function := d.Get("service").(string), d.Get("qualifier").(string), d.Get("function").(string)
+	if err := putFcOnDemandConfig(meta.(*AliyunClient), service, qualifier, function, d.Get("maximum_instance_count").(int)); err != nil {
+		return err
+	}
+
+	d.SetId(strings.Join([]string{service, qualifier, function}, COLON_SEPARATED))
+
+	return resourceAlicloudFcOnDemandConfigRead(d, meta)
+}
+
+func resourceAlicloudFcOnDemandConfigRead(d *schema.ResourceData, meta interface{}) error {
+	service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	config, err := meta.(*AliyunClient).DescribeFcOnDemandConfig(service, qualifier, function)
+	if err != nil {
+		if NotFoundError(err, "fc") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetOnDemandConfig", d.Id())
+	}
+
+	d.Set("service", service)
+	d.Set("qualifier", qualifier)
+	d.Set("function", function)
+	d.Set("maximum_instance_count", config.MaximumInstanceCount)
+
+	return nil
+}
+
+func resourceAlicloudFcOnDemandConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("maximum_instance_count") {
+		service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+		if err != nil {
+			return err
+		}
+		if err := putFcOnDemandConfig(meta.(*AliyunClient), service, qualifier, function, d.Get("maximum_instance_count").(int)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudFcOnDemandConfigRead(d, meta)
+}
+
+func resourceAlicloudFcOnDemandConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/services/%s.%s/functions/%s/on-demand-config", service, qualifier, function)
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeFc(http.MethodDelete, path, nil, nil); err != nil {
+			if NotFoundError(err, "fc") ||
+				NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteOnDemandConfig", d.Id()))
+		}
+
+		if _, err := client.DescribeFcOnDemandConfig(service, qualifier, function); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("On-demand config %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func putFcOnDemandConfig(client *AliyunClient, service, qualifier, function string, count int) error {
+	path := fmt.Sprintf("/services/%s.%s/functions/%s/on-demand-config", service, qualifier, function)
+	if err := client.invokeFc(http.MethodPut, path, &FcOnDemandConfig{MaximumInstanceCount: int64(count)}, nil); err != nil {
+		return WrapError(err, "PutOnDemandConfig", "")
+	}
+	return nil
+}
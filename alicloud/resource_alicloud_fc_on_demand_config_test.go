@@ -0,0 +1,134 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFcOnDemandConfig_basic(t *testing.T) {
+	var v FcOnDemandConfig
+
+	filename, err := testAccFcFunctionZipFile("def handler(event, context):\n    return 'hello'\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filename)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fc_on_demand_config.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFcOnDemandConfigDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFcOnDemandConfigConfig(filename, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcOnDemandConfigExists(
+						"alicloud_fc_on_demand_config.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_on_demand_config.foo",
+						"qualifier",
+						"LATEST"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_on_demand_config.foo",
+						"maximum_instance_count",
+						"5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFcOnDemandConfigConfig(filename, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcOnDemandConfigExists(
+						"alicloud_fc_on_demand_config.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_on_demand_config.foo",
+						"maximum_instance_count",
+						"10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFcOnDemandConfigExists(n string, d *FcOnDemandConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC on-demand config ID is set")
+		}
+
+		service, qualifier, function, err := parseFcFunctionQualifierId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		config, err := client.DescribeFcOnDemandConfig(service, qualifier, function)
+		if err != nil {
+			return err
+		}
+
+		*d = *config
+		return nil
+	}
+}
+
+func testAccCheckFcOnDemandConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_on_demand_config" {
+			continue
+		}
+
+		service, qualifier, function, err := parseFcFunctionQualifierId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeFcOnDemandConfig(service, qualifier, function); err != nil {
+			if NotFoundError(err, "fc") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("FC on-demand config %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFcOnDemandConfigConfig(filename string, count int) string {
+	return fmt.Sprintf(`
+resource "alicloud_fc_service" "foo" {
+  name = "tf-testAccFcOnDemandConfig"
+}
+
+resource "alicloud_fc_function" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "tf-testAccFcOnDemandConfig"
+  runtime = "python2.7"
+  handler = "index.handler"
+  filename = "%s"
+}
+
+resource "alicloud_fc_on_demand_config" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  function = "${alicloud_fc_function.foo.name}"
+  maximum_instance_count = %d
+}
+`, filename, count)
+}
@@ -0,0 +1,132 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcProvisionConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcProvisionConfigCreate,
+		Read:   resourceAlicloudFcProvisionConfigRead,
+		Update: resourceAlicloudFcProvisionConfigUpdate,
+		Delete: resourceAlicloudFcProvisionConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The provisioned instances are only available for a published version or an alias.
+			"qualifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(1, 10000),
+			},
+			"current": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcProvisionConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	service, qualifier, function := d.Get("service").(string), d.Get("qualifier").(string), d.Get("function").(string)
+	if err := putFcProvisionConfig(meta.(*AliyunClient), service, qualifier, function, d.Get("target").(int)); err != nil {
+		return err
+	}
+
+	d.SetId(strings.Join([]string{service, qualifier, function}, COLON_SEPARATED))
+
+	return resourceAlicloudFcProvisionConfigRead(d, meta)
+}
+
+func resourceAlicloudFcProvisionConfigRead(d *schema.ResourceData, meta interface{}) error {
+	service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	config, err := meta.(*AliyunClient).DescribeFcProvisionConfig(service, qualifier, function)
+	if err != nil {
+		if NotFoundError(err, "fc") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetProvisionConfig", d.Id())
+	}
+
+	d.Set("service", service)
+	d.Set("qualifier", qualifier)
+	d.Set("function", function)
+	d.Set("target", config.Target)
+	d.Set("current", config.Current)
+
+	return nil
+}
+
+func resourceAlicloudFcProvisionConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("target") {
+		service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+		if err != nil {
+			return err
+		}
+		if err := putFcProvisionConfig(meta.(*AliyunClient), service, qualifier, function, d.Get("target").(int)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudFcProvisionConfigRead(d, meta)
+}
+
+func resourceAlicloudFcProvisionConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	service, qualifier, function, err := parseFcFunctionQualifierId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The provision config can not be deleted, and it is released by setting the target to 0.
+	if err := putFcProvisionConfig(meta.(*AliyunClient), service, qualifier, function, 0); err != nil {
+		if NotFoundError(err, "fc") {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func putFcProvisionConfig(client *AliyunClient, service, qualifier, function string, target int) error {
+	path := fmt.Sprintf("/services/%s.%s/functions/%s/provision-config", service, qualifier, function)
+	if err := client.invokeFc(http.MethodPut, path, &FcProvisionConfig{Target: int64(target)}, nil); err != nil {
+		return WrapError(err, "PutProvisionConfig", "")
+	}
+	return nil
+}
+
+// parseFcFunctionQualifierId splits the ID '<service>:<qualifier>:<function>' of the configs of a function
+// version or alias.
+func parseFcFunctionQualifierId(id string) (service, qualifier, function string, err error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != 3 {
+		return "", "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid ID %s, expected '<service>:<qualifier>:<function>'.", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,113 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFcProvisionConfig_basic(t *testing.T) {
+	var v FcProvisionConfig
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithFcAlias(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fc_provision_config.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFcProvisionConfigDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFcProvisionConfigConfig(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcProvisionConfigExists(
+						"alicloud_fc_provision_config.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_provision_config.foo",
+						"target",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFcProvisionConfigConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcProvisionConfigExists(
+						"alicloud_fc_provision_config.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_provision_config.foo",
+						"target",
+						"2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFcProvisionConfigExists(n string, d *FcProvisionConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC provision config ID is set")
+		}
+
+		service, qualifier, function, err := parseFcFunctionQualifierId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		config, err := client.DescribeFcProvisionConfig(service, qualifier, function)
+		if err != nil {
+			return err
+		}
+
+		*d = *config
+		return nil
+	}
+}
+
+func testAccCheckFcProvisionConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_provision_config" {
+			continue
+		}
+
+		service, qualifier, function, err := parseFcFunctionQualifierId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeFcProvisionConfig(service, qualifier, function); err != nil {
+			if NotFoundError(err, "fc") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("FC provision config %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFcProvisionConfigConfig(target int) string {
+	return fmt.Sprintf(`
+resource "alicloud_fc_provision_config" "foo" {
+  service = "%s"
+  qualifier = "%s"
+  function = "%s"
+  target = %d
+}
+`, os.Getenv("ALICLOUD_FC_SERVICE"), os.Getenv("ALICLOUD_FC_ALIAS"), os.Getenv("ALICLOUD_FC_FUNCTION"), target)
+}
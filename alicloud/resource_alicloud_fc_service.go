@@ -0,0 +1,239 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcServiceCreate,
+		Read:   resourceAlicloudFcServiceRead,
+		Update: resourceAlicloudFcServiceUpdate,
+		Delete: resourceAlicloudFcServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"internet_access": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"role": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn("ram"),
+			},
+			"log_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"logstore": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"vpc_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vswitch_ids": &schema.Schema{
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"security_group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"vpc_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	service, err := buildFcService(client, d)
+	if err != nil {
+		return err
+	}
+	service.ServiceName = d.Get("name").(string)
+	if err := client.invokeFc(http.MethodPost, "/services", service, nil); err != nil {
+		return WrapError(err, "CreateService", d.Id())
+	}
+
+	d.SetId(service.ServiceName)
+
+	return resourceAlicloudFcServiceRead(d, meta)
+}
+
+func resourceAlicloudFcServiceRead(d *schema.ResourceData, meta interface{}) error {
+	service, err := meta.(*AliyunClient).DescribeFcService(d.Id())
+	if err != nil {
+		if NotFoundError(err, "fc") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetService", d.Id())
+	}
+
+	d.Set("name", service.ServiceName)
+	d.Set("description", service.Description)
+	d.Set("role", service.Role)
+	d.Set("service_id", service.ServiceId)
+	d.Set("last_modified", service.LastModifiedTime)
+	if service.InternetAccess != nil {
+		d.Set("internet_access", *service.InternetAccess)
+	}
+
+	var logConfig []map[string]interface{}
+	if service.LogConfig != nil && service.LogConfig.Project != "" {
+		logConfig = append(logConfig, map[string]interface{}{
+			"project":  service.LogConfig.Project,
+			"logstore": service.LogConfig.Logstore,
+		})
+	}
+	if err := d.Set("log_config", logConfig); err != nil {
+		return err
+	}
+
+	var vpcConfig []map[string]interface{}
+	if service.VpcConfig != nil && service.VpcConfig.VpcId != "" {
+		vpcConfig = append(vpcConfig, map[string]interface{}{
+			"vswitch_ids":       service.VpcConfig.VSwitchIds,
+			"security_group_id": service.VpcConfig.SecurityGroupId,
+			"vpc_id":            service.VpcConfig.VpcId,
+		})
+	}
+	if err := d.Set("vpc_config", vpcConfig); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudFcServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("description") || d.HasChange("internet_access") || d.HasChange("role") ||
+		d.HasChange("log_config") || d.HasChange("vpc_config") {
+		service, err := buildFcService(client, d)
+		if err != nil {
+			return err
+		}
+		if err := client.invokeFc(http.MethodPut, fmt.Sprintf("/services/%s", d.Id()), service, nil); err != nil {
+			return WrapError(err, "UpdateService", d.Id())
+		}
+	}
+
+	return resourceAlicloudFcServiceRead(d, meta)
+}
+
+func resourceAlicloudFcServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeFc(http.MethodDelete, fmt.Sprintf("/services/%s", d.Id()), nil, nil); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			// The functions in the service may be still being deleted.
+			if IsExceptedError(err, FcServiceNotEmpty) {
+				return resource.RetryableError(fmt.Errorf("FC service %s is not empty - trying again while it is deleted.", d.Id()))
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteService", d.Id()))
+		}
+
+		if _, err := client.DescribeFcService(d.Id()); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("FC service %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+// buildFcService builds the service arguments, and the empty log and vpc configs are sent to clear the
+// existing ones when they are removed.
+func buildFcService(client *AliyunClient, d *schema.ResourceData) (*FcService, error) {
+	internetAccess := d.Get("internet_access").(bool)
+	service := &FcService{
+		Description:    d.Get("description").(string),
+		Role:           d.Get("role").(string),
+		InternetAccess: &internetAccess,
+		LogConfig:      &FcLogConfig{},
+		VpcConfig:      &FcVpcConfig{},
+	}
+
+	if v := d.Get("log_config").([]interface{}); len(v) > 0 && v[0] != nil {
+		logConfig := v[0].(map[string]interface{})
+		service.LogConfig.Project = logConfig["project"].(string)
+		service.LogConfig.Logstore = logConfig["logstore"].(string)
+	}
+
+	if v := d.Get("vpc_config").([]interface{}); len(v) > 0 && v[0] != nil {
+		if service.Role == "" {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'role' is required when 'vpc_config' is set.")
+		}
+		vpcConfig := v[0].(map[string]interface{})
+		service.VpcConfig.VSwitchIds = expandStringList(vpcConfig["vswitch_ids"].(*schema.Set).List())
+		service.VpcConfig.SecurityGroupId = vpcConfig["security_group_id"].(string)
+		if len(service.VpcConfig.VSwitchIds) > 0 {
+			vpcId, err := client.GetVpcIdByVSwitchId(service.VpcConfig.VSwitchIds[0])
+			if err != nil {
+				return nil, err
+			}
+			service.VpcConfig.VpcId = vpcId
+		}
+	}
+
+	return service, nil
+}
@@ -0,0 +1,112 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFcService_basic(t *testing.T) {
+	var v FcService
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fc_service.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFcServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFcServiceConfig("tf-testAccFcService", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcServiceExists(
+						"alicloud_fc_service.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_service.foo",
+						"description",
+						"tf-testAccFcService"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_service.foo",
+						"internet_access",
+						"true"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_fc_service.foo",
+						"service_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFcServiceConfig("tf-testAccFcService-update", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcServiceExists(
+						"alicloud_fc_service.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_service.foo",
+						"description",
+						"tf-testAccFcService-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_service.foo",
+						"internet_access",
+						"false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFcServiceExists(n string, d *FcService) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC service ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		service, err := client.DescribeFcService(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *service
+		return nil
+	}
+}
+
+func testAccCheckFcServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_service" {
+			continue
+		}
+
+		if _, err := client.DescribeFcService(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "fc") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("FC service %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFcServiceConfig(description string, internetAccess bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_fc_service" "foo" {
+  name = "tf-testAccFcService"
+  description = "%s"
+  internet_access = %t
+}
+`, description, internetAccess)
+}
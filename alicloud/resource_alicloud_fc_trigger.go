@@ -0,0 +1,200 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcTrigger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcTriggerCreate,
+		Read:   resourceAlicloudFcTriggerRead,
+		Update: resourceAlicloudFcTriggerUpdate,
+		Delete: resourceAlicloudFcTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(FcTriggerTypes),
+			},
+			// The source arn is not required by the timer and http triggers.
+			"source_arn": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn(""),
+			},
+			"role": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn("ram"),
+			},
+			"config": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJsonDocument,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"last_modified": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	trigger, err := buildFcTrigger(d)
+	if err != nil {
+		return err
+	}
+	trigger.TriggerName = d.Get("name").(string)
+	trigger.TriggerType = d.Get("type").(string)
+	trigger.SourceArn = d.Get("source_arn").(string)
+
+	service, function := d.Get("service").(string), d.Get("function").(string)
+	path := fmt.Sprintf("/services/%s/functions/%s/triggers", service, function)
+	if err := client.invokeFc(http.MethodPost, path, trigger, nil); err != nil {
+		return WrapError(err, "CreateTrigger", d.Id())
+	}
+
+	d.SetId(strings.Join([]string{service, function, trigger.TriggerName}, COLON_SEPARATED))
+
+	return resourceAlicloudFcTriggerRead(d, meta)
+}
+
+func resourceAlicloudFcTriggerRead(d *schema.ResourceData, meta interface{}) error {
+	service, function, name, err := parseFcTriggerId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	trigger, err := meta.(*AliyunClient).DescribeFcTrigger(service, function, name)
+	if err != nil {
+		if NotFoundError(err, "fc") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetTrigger", d.Id())
+	}
+
+	d.Set("service", service)
+	d.Set("function", function)
+	d.Set("name", trigger.TriggerName)
+	d.Set("type", trigger.TriggerType)
+	d.Set("source_arn", trigger.SourceArn)
+	d.Set("role", trigger.InvocationRole)
+	d.Set("last_modified", trigger.LastModifiedTime)
+
+	config, err := json.Marshal(trigger.TriggerConfig)
+	if err != nil {
+		return err
+	}
+	d.Set("config", string(config))
+
+	return nil
+}
+
+func resourceAlicloudFcTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("role") || d.HasChange("config") {
+		service, function, name, err := parseFcTriggerId(d.Id())
+		if err != nil {
+			return err
+		}
+		trigger, err := buildFcTrigger(d)
+		if err != nil {
+			return err
+		}
+		path := fmt.Sprintf("/services/%s/functions/%s/triggers/%s", service, function, name)
+		if err := client.invokeFc(http.MethodPut, path, trigger, nil); err != nil {
+			return WrapError(err, "UpdateTrigger", d.Id())
+		}
+	}
+
+	return resourceAlicloudFcTriggerRead(d, meta)
+}
+
+func resourceAlicloudFcTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	service, function, name, err := parseFcTriggerId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/services/%s/functions/%s/triggers/%s", service, function, name)
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.invokeFc(http.MethodDelete, path, nil, nil); err != nil {
+			if NotFoundError(err, "fc") ||
+				NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteTrigger", d.Id()))
+		}
+
+		if _, err := client.DescribeFcTrigger(service, function, name); err != nil {
+			if NotFoundError(err, "fc") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("FC trigger %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildFcTrigger(d *schema.ResourceData) (*FcTrigger, error) {
+	trigger := &FcTrigger{
+		InvocationRole: d.Get("role").(string),
+	}
+	if err := json.Unmarshal([]byte(d.Get("config").(string)), &trigger.TriggerConfig); err != nil {
+		return nil, fmt.Errorf("Parsing 'config' got an error: %#v", err)
+	}
+	return trigger, nil
+}
+
+func parseFcTriggerId(id string) (service, function, trigger string, err error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != 3 {
+		return "", "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid FC trigger ID %s, expected '<service>:<function>:<trigger>'.", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
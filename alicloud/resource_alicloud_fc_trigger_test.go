@@ -0,0 +1,131 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFcTrigger_basic(t *testing.T) {
+	var v FcTrigger
+
+	filename, err := testAccFcFunctionZipFile("def handler(event, context):\n    return 'hello'\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filename)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fc_trigger.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFcTriggerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFcTriggerConfig(filename, "@every 5m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcTriggerExists(
+						"alicloud_fc_trigger.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fc_trigger.foo",
+						"type",
+						"timer"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFcTriggerConfig(filename, "@every 10m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFcTriggerExists(
+						"alicloud_fc_trigger.foo", &v),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_fc_trigger.foo",
+						"last_modified"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFcTriggerExists(n string, d *FcTrigger) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC trigger ID is set")
+		}
+
+		service, function, name, err := parseFcTriggerId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		trigger, err := client.DescribeFcTrigger(service, function, name)
+		if err != nil {
+			return err
+		}
+
+		*d = *trigger
+		return nil
+	}
+}
+
+func testAccCheckFcTriggerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_trigger" {
+			continue
+		}
+
+		service, function, name, err := parseFcTriggerId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeFcTrigger(service, function, name); err != nil {
+			if NotFoundError(err, "fc") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("FC trigger %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFcTriggerConfig(filename, cron string) string {
+	return fmt.Sprintf(`
+resource "alicloud_fc_service" "foo" {
+  name = "tf-testAccFcTrigger"
+}
+
+resource "alicloud_fc_function" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "tf-testAccFcTrigger"
+  runtime = "python2.7"
+  handler = "index.handler"
+  filename = "%s"
+}
+
+resource "alicloud_fc_trigger" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  function = "${alicloud_fc_function.foo.name}"
+  name = "tf-testAccFcTrigger"
+  type = "timer"
+  config = "{\"payload\":\"\",\"cronExpression\":\"%s\",\"enable\":true}"
+}
+`, filename, cron)
+}
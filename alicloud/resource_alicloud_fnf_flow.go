@@ -0,0 +1,130 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFnfFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFnfFlowCreate,
+		Read:   resourceAlicloudFnfFlowRead,
+		Update: resourceAlicloudFnfFlowUpdate,
+		Delete: resourceAlicloudFnfFlowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 128),
+			},
+			// The definition is the FDL of the flow, which calls the FC functions in the task steps.
+			"definition": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// The role_arn is assumed by the flow to call the FC functions and the other services.
+			"role_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      FnfFlowTypeFDL,
+				ValidateFunc: validateAllowedStringValue([]string{FnfFlowTypeFDL}),
+			},
+			"flow_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFnfFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := FnfFlowArgs{
+		Name:        d.Get("name").(string),
+		Definition:  d.Get("definition").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+		RoleArn:     d.Get("role_arn").(string),
+	}
+	if err := client.fnfconn().Invoke("CreateFlow", &args, &FnfFlowResponse{}); err != nil {
+		return WrapError(err, "CreateFlow", d.Id())
+	}
+
+	d.SetId(args.Name)
+
+	return resourceAlicloudFnfFlowRead(d, meta)
+}
+
+func resourceAlicloudFnfFlowRead(d *schema.ResourceData, meta interface{}) error {
+	flow, err := meta.(*AliyunClient).DescribeFnfFlow(d.Id())
+	if err != nil {
+		if NotFoundError(err, "fnf") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeFlow", d.Id())
+	}
+
+	d.Set("name", flow.Name)
+	d.Set("definition", flow.Definition)
+	d.Set("description", flow.Description)
+	d.Set("role_arn", flow.RoleArn)
+	d.Set("type", flow.Type)
+	d.Set("flow_id", flow.Id)
+	d.Set("last_modified_time", flow.LastModifiedTime)
+
+	return nil
+}
+
+func resourceAlicloudFnfFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("definition") || d.HasChange("description") || d.HasChange("role_arn") {
+		args := FnfFlowArgs{
+			Name:        d.Id(),
+			Definition:  d.Get("definition").(string),
+			Description: d.Get("description").(string),
+			Type:        d.Get("type").(string),
+			RoleArn:     d.Get("role_arn").(string),
+		}
+		if err := client.fnfconn().Invoke("UpdateFlow", &args, &FnfFlowResponse{}); err != nil {
+			return WrapError(err, "UpdateFlow", d.Id())
+		}
+	}
+
+	return resourceAlicloudFnfFlowRead(d, meta)
+}
+
+func resourceAlicloudFnfFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := FnfFlowArgs{
+		Name: d.Id(),
+	}
+	if err := client.fnfconn().Invoke("DeleteFlow", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "fnf") {
+			return nil
+		}
+		return WrapError(err, "DeleteFlow", d.Id())
+	}
+	return nil
+}
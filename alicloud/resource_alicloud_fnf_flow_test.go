@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFnfFlow_basic(t *testing.T) {
+	var v FnfFlowResponse
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fnf_flow.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFnfFlowDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFnfFlowConfig("hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFnfFlowExists(
+						"alicloud_fnf_flow.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fnf_flow.foo",
+						"name",
+						"tf-testAccFnfFlow"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fnf_flow.foo",
+						"type",
+						FnfFlowTypeFDL),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_fnf_flow.foo",
+						"flow_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFnfFlowConfig("world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFnfFlowExists(
+						"alicloud_fnf_flow.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fnf_flow.foo",
+						"description",
+						"tf-testAccFnfFlow world"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFnfFlowExists(n string, d *FnfFlowResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Serverless Workflow flow ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		flow, err := client.DescribeFnfFlow(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *flow
+		return nil
+	}
+}
+
+func testAccCheckFnfFlowDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fnf_flow" {
+			continue
+		}
+
+		if _, err := client.DescribeFnfFlow(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "fnf") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Serverless Workflow flow %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFnfFlowConfig(step string) string {
+	return fmt.Sprintf(`
+resource "alicloud_fnf_flow" "foo" {
+  name = "tf-testAccFnfFlow"
+  description = "tf-testAccFnfFlow %s"
+  definition = <<EOF
+version: v1beta1
+type: flow
+steps:
+  - type: pass
+    name: %s
+EOF
+}
+`, step, step)
+}
@@ -0,0 +1,143 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFnfSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFnfScheduleCreate,
+		Read:   resourceAlicloudFnfScheduleRead,
+		Update: resourceAlicloudFnfScheduleUpdate,
+		Delete: resourceAlicloudFnfScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"flow_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schedule_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 128),
+			},
+			// The cron_expression is like 30 9 * * * *, which has the second field at the beginning.
+			"cron_expression": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"payload": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"schedule_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFnfScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildFnfScheduleArgs(d)
+	if err := client.fnfconn().Invoke("CreateSchedule", &args, &FnfScheduleResponse{}); err != nil {
+		return WrapError(err, "CreateSchedule", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.FlowName, COLON_SEPARATED, args.ScheduleName))
+
+	return resourceAlicloudFnfScheduleRead(d, meta)
+}
+
+func resourceAlicloudFnfScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := meta.(*AliyunClient).DescribeFnfSchedule(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "fnf") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeSchedule", d.Id())
+	}
+
+	d.Set("flow_name", parts[0])
+	d.Set("schedule_name", schedule.ScheduleName)
+	d.Set("cron_expression", schedule.CronExpression)
+	d.Set("description", schedule.Description)
+	if schedule.Payload != "" {
+		payload, _ := normalizeJsonString(schedule.Payload)
+		d.Set("payload", payload)
+	}
+	d.Set("enable", schedule.Enable)
+	d.Set("schedule_id", schedule.ScheduleId)
+
+	return nil
+}
+
+func resourceAlicloudFnfScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("cron_expression") || d.HasChange("description") || d.HasChange("payload") || d.HasChange("enable") {
+		args := buildFnfScheduleArgs(d)
+		if err := client.fnfconn().Invoke("UpdateSchedule", &args, &FnfScheduleResponse{}); err != nil {
+			return WrapError(err, "UpdateSchedule", d.Id())
+		}
+	}
+
+	return resourceAlicloudFnfScheduleRead(d, meta)
+}
+
+func resourceAlicloudFnfScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := FnfScheduleArgs{
+		FlowName:     d.Get("flow_name").(string),
+		ScheduleName: d.Get("schedule_name").(string),
+	}
+	if err := client.fnfconn().Invoke("DeleteSchedule", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "fnf") {
+			return nil
+		}
+		return WrapError(err, "DeleteSchedule", d.Id())
+	}
+	return nil
+}
+
+func buildFnfScheduleArgs(d *schema.ResourceData) FnfScheduleArgs {
+	return FnfScheduleArgs{
+		FlowName:       d.Get("flow_name").(string),
+		ScheduleName:   d.Get("schedule_name").(string),
+		CronExpression: d.Get("cron_expression").(string),
+		Description:    d.Get("description").(string),
+		Payload:        d.Get("payload").(string),
+		Enable:         d.Get("enable").(bool),
+	}
+}
@@ -0,0 +1,133 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFnfSchedule_basic(t *testing.T) {
+	var v FnfScheduleResponse
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fnf_schedule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFnfScheduleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFnfScheduleConfig("30 9 * * * *", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFnfScheduleExists(
+						"alicloud_fnf_schedule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fnf_schedule.foo",
+						"cron_expression",
+						"30 9 * * * *"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fnf_schedule.foo",
+						"enable",
+						"true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFnfScheduleConfig("0 */10 * * * *", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFnfScheduleExists(
+						"alicloud_fnf_schedule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_fnf_schedule.foo",
+						"cron_expression",
+						"0 */10 * * * *"),
+					resource.TestCheckResourceAttr(
+						"alicloud_fnf_schedule.foo",
+						"enable",
+						"false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFnfScheduleExists(n string, d *FnfScheduleResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Serverless Workflow schedule ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		schedule, err := client.DescribeFnfSchedule(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *schedule
+		return nil
+	}
+}
+
+func testAccCheckFnfScheduleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fnf_schedule" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeFnfSchedule(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "fnf") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Serverless Workflow schedule %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFnfScheduleConfig(cron string, enable bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_fnf_flow" "foo" {
+  name = "tf-testAccFnfSchedule"
+  description = "tf-testAccFnfSchedule"
+  definition = <<EOF
+version: v1beta1
+type: flow
+steps:
+  - type: pass
+    name: hello
+EOF
+}
+
+resource "alicloud_fnf_schedule" "foo" {
+  flow_name = "${alicloud_fnf_flow.foo.name}"
+  schedule_name = "tf-testAccFnfSchedule"
+  cron_expression = "%s"
+  payload = "{\"tf-test\":\"test\"}"
+  enable = %t
+}
+`, cron, enable)
+}
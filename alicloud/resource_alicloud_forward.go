@@ -12,6 +12,9 @@ func resourceAliyunForwardEntry() *schema.Resource {
 		Read:   resourceAliyunForwardEntryRead,
 		Update: resourceAliyunForwardEntryUpdate,
 		Delete: resourceAliyunForwardEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunForwardEntryImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": &schema.Schema{
@@ -48,7 +51,7 @@ func resourceAliyunForwardEntry() *schema.Resource {
 }
 
 func resourceAliyunForwardEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	conn := meta.(*AliyunClient).vpcconn()
 
 	args := &ecs.CreateForwardEntryArgs{
 		RegionId:       getRegion(d, meta),
@@ -77,7 +80,7 @@ func resourceAliyunForwardEntryRead(d *schema.ResourceData, meta interface{}) er
 	forwardEntry, err := client.DescribeForwardEntry(d.Get("forward_table_id").(string), d.Id())
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			return nil
 		}
 		return err
@@ -95,7 +98,7 @@ func resourceAliyunForwardEntryRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceAliyunForwardEntryUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.vpcconn
+	conn := client.vpcconn()
 
 	forwardEntry, err := client.DescribeForwardEntry(d.Get("forward_table_id").(string), d.Id())
 	if err != nil {
@@ -146,7 +149,7 @@ func resourceAliyunForwardEntryUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceAliyunForwardEntryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.vpcconn
+	conn := client.vpcconn()
 
 	forwardEntryId := d.Id()
 	forwardTableId := d.Get("forward_table_id").(string)
@@ -163,3 +166,16 @@ func resourceAliyunForwardEntryDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+// resourceAliyunForwardEntryImport imports the forward entry by the id in the format <forward_table_id>:<forward_entry_id>,
+// because the forward entry can only be described in its forward table.
+func resourceAliyunForwardEntryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("forward_table_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
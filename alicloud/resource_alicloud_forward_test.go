@@ -2,12 +2,71 @@ package alicloud
 
 import (
 	"fmt"
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"log"
 	"testing"
 )
 
+func init() {
+	addTestSweepers("alicloud_forward_entry", &testSweeper{
+		F: testSweepForwardEntries,
+	})
+}
+
+// testSweepForwardEntries deletes the forward entries of the NAT gateways of the acceptance tests, which have
+// to be deleted before the NAT gateways.
+func testSweepForwardEntries(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.vpcconn()
+
+	gateways, err := sweepableNatGateways(client)
+	if err != nil {
+		return err
+	}
+
+	for _, gw := range gateways {
+		for _, tableId := range gw.ForwardTableIds.ForwardTableId {
+			var ids []string
+			err := describeAllPages(func(pagination common.Pagination) (int, error) {
+				entries, _, err := conn.DescribeForwardTableEntries(&ecs.DescribeForwardTableEntriesArgs{
+					RegionId:       client.Region,
+					ForwardTableId: tableId,
+					Pagination:     pagination,
+				})
+				if err != nil {
+					return 0, WrapError(err, "DescribeForwardTableEntries", tableId)
+				}
+				for _, entry := range entries {
+					ids = append(ids, entry.ForwardEntryId)
+				}
+				return len(entries), nil
+			})
+			if err != nil {
+				log.Printf("[ERROR] %s", err)
+				continue
+			}
+
+			for _, id := range ids {
+				log.Printf("[INFO] Deleting forward entry %s of NAT gateway %s", id, gw.NatGatewayId)
+				if err := conn.DeleteForwardEntry(&ecs.DeleteForwardEntryArgs{
+					RegionId:       client.Region,
+					ForwardTableId: tableId,
+					ForwardEntryId: id,
+				}); err != nil {
+					log.Printf("[ERROR] %s", WrapError(err, "DeleteForwardEntry", id))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudForward_basic(t *testing.T) {
 	var forward ecs.ForwardTableEntrySetType
 
@@ -61,7 +120,7 @@ func testAccCheckForwardEntryDestroy(s *terraform.State) error {
 			return fmt.Errorf("Forward entry still exist")
 		}
 
-		if err != nil && !NotFoundError(err) {
+		if err != nil && !NotFoundError(err, "vpc") {
 			// Verify the error is what we want
 			return err
 		}
@@ -0,0 +1,352 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHBaseInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudHBaseInstanceCreate,
+		Read:   resourceAlicloudHBaseInstanceRead,
+		Update: resourceAlicloudHBaseInstanceUpdate,
+		Delete: resourceAlicloudHBaseInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceName,
+				Required:     true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"engine_version": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(HBaseEngineVersions),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"master_instance_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"core_instance_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"core_instance_quantity": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateIntegerInRange(2, 100),
+				Optional:     true,
+				Default:      2,
+			},
+			"core_disk_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(HBaseDiskTypes),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "cloud_efficiency",
+			},
+			"core_disk_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateIntegerInRange(100, 8000),
+				Optional:     true,
+				Default:      400,
+			},
+			"cold_storage_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateHBaseColdStorageSize,
+				Optional:     true,
+				Default:      0,
+			},
+			"pay_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"PostPaid", "PrePaid"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "PostPaid",
+			},
+			"duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudHBaseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args, err := buildHBaseCreateArgs(d, meta)
+	if err != nil {
+		return err
+	}
+
+	resp := CreateHBaseInstanceResponse{}
+	if err := client.hbaseconn().Invoke("CreateCluster", args, &resp); err != nil {
+		return fmt.Errorf("Error creating Alicloud HBase instance: %#v", err)
+	}
+
+	if resp.ClusterId == "" {
+		return fmt.Errorf("Error get Alicloud HBase instance id")
+	}
+	d.SetId(resp.ClusterId)
+
+	if err := client.WaitForHBaseInstance(d.Id(), HBaseActivation, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForHBaseInstance %s got an error: %#v", HBaseActivation, err)
+	}
+
+	return resourceAlicloudHBaseInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudHBaseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+
+	if d.IsNewResource() {
+		return resourceAlicloudHBaseInstanceRead(d, meta)
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		args := ModifyHBaseInstanceNameArgs{
+			RegionId:    client.Region,
+			ClusterId:   d.Id(),
+			ClusterName: d.Get("name").(string),
+		}
+		if err := client.hbaseconn().Invoke("ModifyInstanceName", &args, &HBaseResponse{}); err != nil {
+			return WrapError(err, "ModifyInstanceName", d.Id())
+		}
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("master_instance_type") || d.HasChange("core_instance_type") {
+		args := ModifyHBaseInstanceTypeArgs{
+			RegionId:  client.Region,
+			ClusterId: d.Id(),
+		}
+		if d.HasChange("master_instance_type") {
+			args.MasterInstanceType = d.Get("master_instance_type").(string)
+		}
+		if d.HasChange("core_instance_type") {
+			args.CoreInstanceType = d.Get("core_instance_type").(string)
+		}
+		if err := client.hbaseconn().Invoke("ModifyInstanceType", &args, &HBaseResponse{}); err != nil {
+			return WrapError(err, "ModifyInstanceType", d.Id())
+		}
+		if err := waitForHBaseChanged(client, d.Id(), deadline); err != nil {
+			return err
+		}
+		d.SetPartial("master_instance_type")
+		d.SetPartial("core_instance_type")
+	}
+
+	if d.HasChange("core_instance_quantity") {
+		o, n := d.GetChange("core_instance_quantity")
+		if n.(int) < o.(int) {
+			return fmt.Errorf("HBase instance does not support to decrease 'core_instance_quantity' from %d to %d.", o.(int), n.(int))
+		}
+		args := ResizeHBaseNodeCountArgs{
+			RegionId:  client.Region,
+			ClusterId: d.Id(),
+			NodeCount: n.(int),
+		}
+		if err := client.hbaseconn().Invoke("ResizeNodeCount", &args, &HBaseResponse{}); err != nil {
+			return WrapError(err, "ResizeNodeCount", d.Id())
+		}
+		if err := waitForHBaseChanged(client, d.Id(), deadline); err != nil {
+			return err
+		}
+		d.SetPartial("core_instance_quantity")
+	}
+
+	if d.HasChange("core_disk_size") {
+		o, n := d.GetChange("core_disk_size")
+		if n.(int) < o.(int) {
+			return fmt.Errorf("HBase instance does not support to decrease 'core_disk_size' from %d to %d.", o.(int), n.(int))
+		}
+		args := ResizeHBaseDiskSizeArgs{
+			RegionId:     client.Region,
+			ClusterId:    d.Id(),
+			NodeDiskSize: n.(int),
+		}
+		if err := client.hbaseconn().Invoke("ResizeDiskSize", &args, &HBaseResponse{}); err != nil {
+			return WrapError(err, "ResizeDiskSize", d.Id())
+		}
+		if err := waitForHBaseChanged(client, d.Id(), deadline); err != nil {
+			return err
+		}
+		d.SetPartial("core_disk_size")
+	}
+
+	if d.HasChange("cold_storage_size") {
+		o, n := d.GetChange("cold_storage_size")
+		if n.(int) < o.(int) {
+			return fmt.Errorf("HBase instance does not support to decrease 'cold_storage_size' from %d to %d.", o.(int), n.(int))
+		}
+		args := ResizeHBaseColdStorageSizeArgs{
+			RegionId:        client.Region,
+			ClusterId:       d.Id(),
+			ColdStorageSize: n.(int),
+		}
+		if err := client.hbaseconn().Invoke("ResizeColdStorageSize", &args, &HBaseResponse{}); err != nil {
+			return WrapError(err, "ResizeColdStorageSize", d.Id())
+		}
+		if err := waitForHBaseChanged(client, d.Id(), deadline); err != nil {
+			return err
+		}
+		d.SetPartial("cold_storage_size")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudHBaseInstanceRead(d, meta)
+}
+
+func resourceAlicloudHBaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeHBaseInstanceById(d.Id())
+	if err != nil {
+		if NotFoundError(err, "hbase") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe HBase Instance Attribute: %#v", err)
+	}
+
+	d.Set("name", instance.InstanceName)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("engine_version", instance.MajorVersion)
+	d.Set("master_instance_type", instance.MasterInstanceType)
+	d.Set("core_instance_type", instance.CoreInstanceType)
+	d.Set("core_instance_quantity", instance.CoreNodeCount)
+	d.Set("core_disk_type", instance.CoreDiskType)
+	d.Set("core_disk_size", instance.CoreDiskSize)
+	d.Set("cold_storage_size", instance.ColdStorageSize)
+	d.Set("vswitch_id", instance.VswitchId)
+	d.Set("vpc_id", instance.VpcId)
+	if instance.PayType == "Prepaid" {
+		d.Set("pay_type", "PrePaid")
+	} else {
+		d.Set("pay_type", "PostPaid")
+	}
+
+	return nil
+}
+
+func resourceAlicloudHBaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	if d.Get("pay_type").(string) == "PrePaid" {
+		return ConfigErrorf(ErrorCodeUnsupportedOperation, "At present, 'PrePaid' HBase instance cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+
+	args := HBaseInstanceArgs{
+		RegionId:  client.Region,
+		ClusterId: d.Id(),
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.hbaseconn().Invoke("DeleteInstance", &args, &HBaseResponse{}); err != nil {
+			if NotFoundError(err, "hbase") {
+				return nil
+			}
+			if IsExceptedError(err, HBaseOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("HBase instance %s is busy - trying again while it is deleted.", d.Id()))
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteInstance", d.Id()))
+		}
+
+		if _, err := client.DescribeHBaseInstanceById(d.Id()); err != nil {
+			if NotFoundError(err, "hbase") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("HBase instance %s in use - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildHBaseCreateArgs(d *schema.ResourceData, meta interface{}) (*CreateHBaseInstanceArgs, error) {
+	client := meta.(*AliyunClient)
+
+	args := &CreateHBaseInstanceArgs{
+		RegionId:           client.Region,
+		Engine:             HBaseEngine,
+		EngineVersion:      d.Get("engine_version").(string),
+		ClusterName:        d.Get("name").(string),
+		MasterInstanceType: d.Get("master_instance_type").(string),
+		CoreInstanceType:   d.Get("core_instance_type").(string),
+		NodeCount:          d.Get("core_instance_quantity").(int),
+		CoreDiskType:       d.Get("core_disk_type").(string),
+		CoreDiskSize:       d.Get("core_disk_size").(int),
+		ColdStorageSize:    d.Get("cold_storage_size").(int),
+		NetType:            HBaseDefaultNetType,
+		PayType:            "Postpaid",
+	}
+
+	if d.Get("pay_type").(string) == "PrePaid" {
+		duration := d.Get("duration").(int)
+		if duration == 0 {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'duration' is required when 'pay_type' is PrePaid")
+		}
+		args.PayType = "Prepaid"
+		if duration > 9 {
+			args.PeriodUnit = "year"
+			args.Period = duration / 12
+		} else {
+			args.PeriodUnit = "month"
+			args.Period = duration
+		}
+	}
+
+	vswitchId := d.Get("vswitch_id").(string)
+	vpcId, zoneId, err := client.DescribeVSwitchPlacement(vswitchId, d.Get("zone_id").(string))
+	if err != nil {
+		return nil, err
+	}
+	args.ZoneId = zoneId
+	args.VpcId = vpcId
+	args.VSwitchId = vswitchId
+
+	return args, nil
+}
+
+// waitForHBaseChanged waits the instance turns into ACTIVATION again after resizing before the deadline,
+// as it needs a while to change the status after the resizing request.
+func waitForHBaseChanged(client *AliyunClient, id string, deadline operationDeadline) error {
+	time.Sleep(DefaultIntervalShort * time.Second)
+	if err := client.WaitForHBaseInstance(id, HBaseActivation, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForHBaseInstance %s got an error: %#v", HBaseActivation, err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudHBaseInstance_vpc(t *testing.T) {
+	var instance HBaseInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_hbase_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHBaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHBaseInstanceConfig("tf-test-hbase", 400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHBaseInstanceExists(
+						"alicloud_hbase_instance.foo", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbase_instance.foo",
+						"engine_version",
+						"2.0"),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbase_instance.foo",
+						"core_disk_size",
+						"400"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_hbase_instance.foo",
+						"vpc_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccHBaseInstanceConfig("tf-test-hbase-update", 480),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHBaseInstanceExists(
+						"alicloud_hbase_instance.foo", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbase_instance.foo",
+						"name",
+						"tf-test-hbase-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbase_instance.foo",
+						"core_disk_size",
+						"480"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckHBaseInstanceExists(n string, instance *HBaseInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HBase Instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		attr, err := client.DescribeHBaseInstanceById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*instance = *attr
+		return nil
+	}
+}
+
+func testAccCheckHBaseInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_hbase_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeHBaseInstanceById(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "hbase") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("HBase instance %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccHBaseInstanceConfig(name string, diskSize int) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_hbase_instance" "foo" {
+	name = "%s"
+	engine_version = "2.0"
+	master_instance_type = "hbase.sn1.large"
+	core_instance_type = "hbase.sn1.large"
+	core_disk_size = %d
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`, name, diskSize)
+}
@@ -0,0 +1,240 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// hbrBackupPlanSource is the data source of the backup plans, like the files of the ecs instances, the oss
+// buckets and the nas file systems. They share the vault, schedule and retention of the backup plan.
+type hbrBackupPlanSource struct {
+	SourceType string
+	Schema     map[string]*schema.Schema
+	Expand     func(d *schema.ResourceData, args *HbrBackupPlanArgs)
+	Flatten    func(d *schema.ResourceData, plan *HbrBackupPlan) error
+}
+
+// flattenHbrJsonList returns the strings of the json array, like the exclude and the include of the backup plans.
+func flattenHbrJsonList(s string) ([]string, error) {
+	list := []string{}
+	if s == "" {
+		return list, nil
+	}
+	if err := json.Unmarshal([]byte(s), &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// resourceAlicloudHbrBackupPlan returns the resource of the backup plans of the source.
+func resourceAlicloudHbrBackupPlan(source hbrBackupPlanSource) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"vault_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"backup_plan_name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateStringLengthInRange(1, 64),
+		},
+		"backup_type": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "COMPLETE",
+			ValidateFunc: validateAllowedStringValue([]string{"COMPLETE"}),
+		},
+		// The schedule is like I|1602673264|PT2H, which backups every 2 hours from the unix time 1602673264.
+		"schedule": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"retention": &schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validateIntegerInRange(1, 106751),
+		},
+		"disabled": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+	for k, v := range source.Schema {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAlicloudHbrBackupPlanCreate(source, d, meta)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAlicloudHbrBackupPlanRead(source, d, meta)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAlicloudHbrBackupPlanUpdate(source, d, meta)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAlicloudHbrBackupPlanDelete(source, d, meta)
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceAlicloudHbrBackupPlanCreate(source hbrBackupPlanSource, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := HbrBackupPlanArgs{
+		VaultId:    d.Get("vault_id").(string),
+		PlanName:   d.Get("backup_plan_name").(string),
+		SourceType: source.SourceType,
+		BackupType: d.Get("backup_type").(string),
+		Schedule:   d.Get("schedule").(string),
+		Retention:  d.Get("retention").(int),
+	}
+	source.Expand(d, &args)
+
+	resp := CreateHbrBackupPlanResponse{}
+	if err := client.hbrconn().Invoke("CreateBackupPlan", &args, &resp); err != nil {
+		return WrapError(err, "CreateBackupPlan", d.Id())
+	}
+
+	d.SetId(resp.PlanId)
+
+	if d.Get("disabled").(bool) {
+		if err := setHbrBackupPlanDisabled(client, source, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudHbrBackupPlanRead(source, d, meta)
+}
+
+func resourceAlicloudHbrBackupPlanRead(source hbrBackupPlanSource, d *schema.ResourceData, meta interface{}) error {
+	plan, err := meta.(*AliyunClient).DescribeHbrBackupPlan(d.Id(), source.SourceType)
+	if err != nil {
+		if NotFoundError(err, "hbr") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeBackupPlans", d.Id())
+	}
+
+	d.Set("vault_id", plan.VaultId)
+	d.Set("backup_plan_name", plan.PlanName)
+	d.Set("backup_type", plan.BackupType)
+	d.Set("schedule", plan.Schedule)
+	d.Set("retention", plan.Retention)
+	d.Set("disabled", plan.Disabled)
+
+	return source.Flatten(d, plan)
+}
+
+func resourceAlicloudHbrBackupPlanUpdate(source hbrBackupPlanSource, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	update := false
+	for k, v := range source.Schema {
+		if !v.ForceNew && d.HasChange(k) {
+			update = true
+		}
+	}
+	if update || d.HasChange("backup_plan_name") || d.HasChange("schedule") || d.HasChange("retention") {
+		args := HbrBackupPlanArgs{
+			PlanId:     d.Id(),
+			VaultId:    d.Get("vault_id").(string),
+			PlanName:   d.Get("backup_plan_name").(string),
+			SourceType: source.SourceType,
+			Schedule:   d.Get("schedule").(string),
+			Retention:  d.Get("retention").(int),
+		}
+		source.Expand(d, &args)
+		// The data source of the backup plan can not be changed, like the ecs instance and the oss bucket.
+		args.InstanceId = ""
+		args.Bucket = ""
+		args.FileSystemId = ""
+		args.CreateTime = ""
+
+		if err := client.hbrconn().Invoke("UpdateBackupPlan", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateBackupPlan", d.Id())
+		}
+		d.SetPartial("backup_plan_name")
+		d.SetPartial("schedule")
+		d.SetPartial("retention")
+		for k := range source.Schema {
+			d.SetPartial(k)
+		}
+	}
+
+	if d.HasChange("disabled") {
+		if err := setHbrBackupPlanDisabled(client, source, d); err != nil {
+			return err
+		}
+		d.SetPartial("disabled")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudHbrBackupPlanRead(source, d, meta)
+}
+
+func resourceAlicloudHbrBackupPlanDelete(source hbrBackupPlanSource, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := HbrBackupPlanArgs{
+		PlanId:     d.Id(),
+		VaultId:    d.Get("vault_id").(string),
+		SourceType: source.SourceType,
+	}
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := client.hbrconn().Invoke("DeleteBackupPlan", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "hbr") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteBackupPlan", d.Id()))
+		}
+
+		if _, err := client.DescribeHbrBackupPlan(d.Id(), source.SourceType); err != nil {
+			if NotFoundError(err, "hbr") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DescribeBackupPlans", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("HBR %s backup plan %s is still being deleted", source.SourceType, d.Id()))
+	})
+}
+
+// setHbrBackupPlanDisabled enables or disables the backup plan by the "disabled" of it.
+func setHbrBackupPlanDisabled(client *AliyunClient, source hbrBackupPlanSource, d *schema.ResourceData) error {
+	action := "EnableBackupPlan"
+	if d.Get("disabled").(bool) {
+		action = "DisableBackupPlan"
+	}
+	args := HbrBackupPlanArgs{
+		PlanId:     d.Id(),
+		VaultId:    d.Get("vault_id").(string),
+		SourceType: source.SourceType,
+	}
+	if err := client.hbrconn().Invoke(action, &args, &common.Response{}); err != nil {
+		return WrapError(err, action, d.Id())
+	}
+	return nil
+}
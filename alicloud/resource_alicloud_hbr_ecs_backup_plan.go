@@ -0,0 +1,66 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrEcsBackupPlan() *schema.Resource {
+	return resourceAlicloudHbrBackupPlan(hbrBackupPlanSource{
+		SourceType: HbrSourceEcsFile,
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"paths": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// The speed limit is like 0:24:5120, which limits the speed to 5120 KB/s from 0 to 24 o'clock.
+			"speed_limit": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Expand: func(d *schema.ResourceData, args *HbrBackupPlanArgs) {
+			args.InstanceId = d.Get("instance_id").(string)
+			args.Path = expandStringList(d.Get("paths").([]interface{}))
+			args.Exclude = convertListToJsonString(d.Get("exclude").([]interface{}))
+			args.Include = convertListToJsonString(d.Get("include").([]interface{}))
+			args.SpeedLimit = d.Get("speed_limit").(string)
+		},
+		Flatten: func(d *schema.ResourceData, plan *HbrBackupPlan) error {
+			d.Set("instance_id", plan.InstanceId)
+			d.Set("speed_limit", plan.SpeedLimit)
+			if err := d.Set("paths", plan.Paths.Path); err != nil {
+				return err
+			}
+
+			exclude, err := flattenHbrJsonList(plan.Exclude)
+			if err != nil {
+				return WrapError(err, "DescribeBackupPlans", d.Id())
+			}
+			if err := d.Set("exclude", exclude); err != nil {
+				return err
+			}
+
+			include, err := flattenHbrJsonList(plan.Include)
+			if err != nil {
+				return WrapError(err, "DescribeBackupPlans", d.Id())
+			}
+			return d.Set("include", include)
+		},
+	})
+}
@@ -0,0 +1,129 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudHbrEcsBackupPlan_basic(t *testing.T) {
+	var v HbrBackupPlan
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithHbrEcsInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_hbr_ecs_backup_plan.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHbrBackupPlanDestroy("alicloud_hbr_ecs_backup_plan", HbrSourceEcsFile),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHbrEcsBackupPlanConfig(7, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrBackupPlanExists(
+						"alicloud_hbr_ecs_backup_plan.foo", HbrSourceEcsFile, &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_ecs_backup_plan.foo",
+						"retention",
+						"7"),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_ecs_backup_plan.foo",
+						"paths.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_ecs_backup_plan.foo",
+						"exclude.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_ecs_backup_plan.foo",
+						"disabled",
+						"false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccHbrEcsBackupPlanConfig(30, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrBackupPlanExists(
+						"alicloud_hbr_ecs_backup_plan.foo", HbrSourceEcsFile, &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_ecs_backup_plan.foo",
+						"retention",
+						"30"),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_ecs_backup_plan.foo",
+						"disabled",
+						"true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHbrBackupPlanExists(n, sourceType string, d *HbrBackupPlan) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HBR backup plan ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		plan, err := client.DescribeHbrBackupPlan(rs.Primary.ID, sourceType)
+		if err != nil {
+			return err
+		}
+
+		*d = *plan
+		return nil
+	}
+}
+
+func testAccCheckHbrBackupPlanDestroy(resourceType, sourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*AliyunClient)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			if _, err := client.DescribeHbrBackupPlan(rs.Primary.ID, sourceType); err != nil {
+				if NotFoundError(err, "hbr") {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("HBR %s backup plan %s still exists.", sourceType, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccHbrEcsBackupPlanConfig(retention int, disabled bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_hbr_vault" "foo" {
+  vault_name = "tf-testAccHbrEcsBackupPlan"
+}
+
+resource "alicloud_hbr_ecs_backup_plan" "foo" {
+  vault_id = "${alicloud_hbr_vault.foo.id}"
+  backup_plan_name = "tf-testAccHbrEcsBackupPlan"
+  instance_id = "%s"
+  schedule = "I|1602673264|PT2H"
+  retention = %d
+  disabled = %t
+  paths = ["/home"]
+  exclude = ["*.log"]
+}
+`, os.Getenv("ALICLOUD_HBR_ECS_INSTANCE_ID"), retention, disabled)
+}
@@ -0,0 +1,42 @@
+package alicloud
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrNasBackupPlan() *schema.Resource {
+	return resourceAlicloudHbrBackupPlan(hbrBackupPlanSource{
+		SourceType: HbrSourceNas,
+		Schema: map[string]*schema.Schema{
+			"file_system_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The create_time is the unix time the nas file system was created, which identifies the file system
+			// together with the file_system_id.
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"paths": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Expand: func(d *schema.ResourceData, args *HbrBackupPlanArgs) {
+			args.FileSystemId = d.Get("file_system_id").(string)
+			args.CreateTime = d.Get("create_time").(string)
+			args.Path = expandStringList(d.Get("paths").([]interface{}))
+		},
+		Flatten: func(d *schema.ResourceData, plan *HbrBackupPlan) error {
+			d.Set("file_system_id", plan.FileSystemId)
+			d.Set("create_time", strconv.FormatInt(plan.CreateTime, 10))
+			return d.Set("paths", plan.Paths.Path)
+		},
+	})
+}
@@ -0,0 +1,71 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudHbrNasBackupPlan_basic(t *testing.T) {
+	var v HbrBackupPlan
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithHbrNasFileSystem(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_hbr_nas_backup_plan.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHbrBackupPlanDestroy("alicloud_hbr_nas_backup_plan", HbrSourceNas),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHbrNasBackupPlanConfig(`["/"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrBackupPlanExists(
+						"alicloud_hbr_nas_backup_plan.foo", HbrSourceNas, &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_nas_backup_plan.foo",
+						"file_system_id",
+						os.Getenv("ALICLOUD_HBR_NAS_FILE_SYSTEM_ID")),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_nas_backup_plan.foo",
+						"paths.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccHbrNasBackupPlanConfig(`["/data", "/logs"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrBackupPlanExists(
+						"alicloud_hbr_nas_backup_plan.foo", HbrSourceNas, &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_nas_backup_plan.foo",
+						"paths.#",
+						"2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHbrNasBackupPlanConfig(paths string) string {
+	return fmt.Sprintf(`
+resource "alicloud_hbr_vault" "foo" {
+  vault_name = "tf-testAccHbrNasBackupPlan"
+}
+
+resource "alicloud_hbr_nas_backup_plan" "foo" {
+  vault_id = "${alicloud_hbr_vault.foo.id}"
+  backup_plan_name = "tf-testAccHbrNasBackupPlan"
+  file_system_id = "%s"
+  create_time = "%s"
+  schedule = "I|1602673264|P1D"
+  retention = 7
+  paths = %s
+}
+`, os.Getenv("ALICLOUD_HBR_NAS_FILE_SYSTEM_ID"), os.Getenv("ALICLOUD_HBR_NAS_CREATE_TIME"), paths)
+}
@@ -0,0 +1,31 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrOssBackupPlan() *schema.Resource {
+	return resourceAlicloudHbrBackupPlan(hbrBackupPlanSource{
+		SourceType: HbrSourceOss,
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Expand: func(d *schema.ResourceData, args *HbrBackupPlanArgs) {
+			args.Bucket = d.Get("bucket").(string)
+			args.Prefix = d.Get("prefix").(string)
+		},
+		Flatten: func(d *schema.ResourceData, plan *HbrBackupPlan) error {
+			d.Set("bucket", plan.Bucket)
+			d.Set("prefix", plan.Prefix)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,73 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudHbrOssBackupPlan_basic(t *testing.T) {
+	var v HbrBackupPlan
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_hbr_oss_backup_plan.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHbrBackupPlanDestroy("alicloud_hbr_oss_backup_plan", HbrSourceOss),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHbrOssBackupPlanConfig("data/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrBackupPlanExists(
+						"alicloud_hbr_oss_backup_plan.foo", HbrSourceOss, &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_oss_backup_plan.foo",
+						"prefix",
+						"data/"),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_oss_backup_plan.foo",
+						"schedule",
+						"I|1602673264|P1D"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccHbrOssBackupPlanConfig("logs/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrBackupPlanExists(
+						"alicloud_hbr_oss_backup_plan.foo", HbrSourceOss, &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_oss_backup_plan.foo",
+						"prefix",
+						"logs/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHbrOssBackupPlanConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "alicloud_hbr_vault" "foo" {
+  vault_name = "tf-testAccHbrOssBackupPlan"
+}
+
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "tf-test-acc-hbr-oss-backup-plan"
+}
+
+resource "alicloud_hbr_oss_backup_plan" "foo" {
+  vault_id = "${alicloud_hbr_vault.foo.id}"
+  backup_plan_name = "tf-testAccHbrOssBackupPlan"
+  bucket = "${alicloud_oss_bucket.foo.bucket}"
+  prefix = "%s"
+  schedule = "I|1602673264|P1D"
+  retention = 7
+}
+`, prefix)
+}
@@ -0,0 +1,220 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrRestoreJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudHbrRestoreJobCreate,
+		Read:   resourceAlicloudHbrRestoreJobRead,
+		Delete: resourceAlicloudHbrRestoreJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"restore_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(HbrSourceTypes),
+			},
+			"vault_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(HbrSourceTypes),
+			},
+			"target_instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_file_system_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"include": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudHbrRestoreJobCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	restoreType := d.Get("restore_type").(string)
+	args := HbrRestoreJobArgs{
+		RestoreType:        restoreType,
+		VaultId:            d.Get("vault_id").(string),
+		SnapshotId:         d.Get("snapshot_id").(string),
+		SnapshotHash:       d.Get("snapshot_hash").(string),
+		SourceType:         d.Get("source_type").(string),
+		TargetInstanceId:   d.Get("target_instance_id").(string),
+		TargetPath:         d.Get("target_path").(string),
+		TargetBucket:       d.Get("target_bucket").(string),
+		TargetPrefix:       d.Get("target_prefix").(string),
+		TargetFileSystemId: d.Get("target_file_system_id").(string),
+		TargetCreateTime:   d.Get("target_create_time").(string),
+		Include:            convertListToJsonString(d.Get("include").([]interface{})),
+		Exclude:            convertListToJsonString(d.Get("exclude").([]interface{})),
+	}
+
+	switch restoreType {
+	case HbrSourceEcsFile:
+		if args.TargetInstanceId == "" || args.TargetPath == "" {
+			return ConfigErrorf(ErrorCodeMissingArgument, "target_instance_id and target_path are required when restore_type is %s.", restoreType)
+		}
+	case HbrSourceOss:
+		if args.TargetBucket == "" {
+			return ConfigErrorf(ErrorCodeMissingArgument, "target_bucket is required when restore_type is %s.", restoreType)
+		}
+	case HbrSourceNas:
+		if args.TargetFileSystemId == "" || args.TargetCreateTime == "" {
+			return ConfigErrorf(ErrorCodeMissingArgument, "target_file_system_id and target_create_time are required when restore_type is %s.", restoreType)
+		}
+	}
+
+	resp := CreateHbrRestoreJobResponse{}
+	if err := client.hbrconn().Invoke("CreateRestoreJob", &args, &resp); err != nil {
+		return WrapError(err, "CreateRestoreJob", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", resp.RestoreId, COLON_SEPARATED, restoreType))
+
+	if err := client.WaitForHbrRestoreJob(resp.RestoreId, restoreType, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForHbrRestoreJob", d.Id())
+	}
+
+	return resourceAlicloudHbrRestoreJobRead(d, meta)
+}
+
+func resourceAlicloudHbrRestoreJobRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	job, err := meta.(*AliyunClient).DescribeHbrRestoreJob(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "hbr") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeRestoreJobs2", d.Id())
+	}
+
+	d.Set("restore_type", parts[1])
+	d.Set("vault_id", job.VaultId)
+	d.Set("snapshot_id", job.SnapshotId)
+	d.Set("snapshot_hash", job.SnapshotHash)
+	d.Set("source_type", job.SourceType)
+	d.Set("target_instance_id", job.TargetInstanceId)
+	d.Set("target_path", job.TargetPath)
+	d.Set("target_bucket", job.TargetBucket)
+	d.Set("target_prefix", job.TargetPrefix)
+	d.Set("target_file_system_id", job.TargetFileSystemId)
+	if job.TargetCreateTime > 0 {
+		d.Set("target_create_time", strconv.FormatInt(job.TargetCreateTime, 10))
+	}
+	d.Set("status", job.Status)
+
+	return nil
+}
+
+func resourceAlicloudHbrRestoreJobDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	job, err := client.DescribeHbrRestoreJob(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "hbr") {
+			return nil
+		}
+		return WrapError(err, "DescribeRestoreJobs2", d.Id())
+	}
+
+	// The finished restore jobs are kept as the records of HBR and can not be deleted.
+	switch job.Status {
+	case HbrRestoreJobComplete, HbrRestoreJobPartialComplete, HbrRestoreJobFailed, HbrRestoreJobCanceled:
+		log.Printf("[WARN] HBR restore job %s is %s and can not be deleted, and it is only removed from the state.", d.Id(), job.Status)
+		return nil
+	}
+
+	args := HbrRestoreJobArgs{
+		RestoreId: parts[0],
+	}
+	if err := client.hbrconn().Invoke("CancelRestoreJob", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "hbr") {
+			return nil
+		}
+		return WrapError(err, "CancelRestoreJob", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudHbrRestoreJob_basic(t *testing.T) {
+	var v HbrRestoreJob
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithHbrSnapshot(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_hbr_restore_job.foo",
+
+		Providers: testAccProviders,
+		// The finished restore jobs are kept by HBR, so there is no destroy check.
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHbrRestoreJobConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrRestoreJobExists(
+						"alicloud_hbr_restore_job.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_restore_job.foo",
+						"restore_type",
+						HbrSourceOss),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_restore_job.foo",
+						"status",
+						HbrRestoreJobComplete),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHbrRestoreJobExists(n string, d *HbrRestoreJob) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HBR restore job ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		job, err := client.DescribeHbrRestoreJob(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *job
+		return nil
+	}
+}
+
+func testAccHbrRestoreJobConfig() string {
+	return fmt.Sprintf(`
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "tf-test-acc-hbr-restore-job"
+}
+
+resource "alicloud_hbr_restore_job" "foo" {
+  restore_type = "OSS"
+  vault_id = "%s"
+  snapshot_id = "%s"
+  snapshot_hash = "%s"
+  source_type = "OSS"
+  target_bucket = "${alicloud_oss_bucket.foo.bucket}"
+  target_prefix = "restore/"
+}
+`, os.Getenv("ALICLOUD_HBR_VAULT_ID"), os.Getenv("ALICLOUD_HBR_SNAPSHOT_ID"), os.Getenv("ALICLOUD_HBR_SNAPSHOT_HASH"))
+}
@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrVault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudHbrVaultCreate,
+		Read:   resourceAlicloudHbrVaultRead,
+		Update: resourceAlicloudHbrVaultUpdate,
+		Delete: resourceAlicloudHbrVaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vault_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 64),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vault_storage_class": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "STANDARD",
+				ValidateFunc: validateAllowedStringValue([]string{"STANDARD"}),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudHbrVaultCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := HbrVaultArgs{
+		VaultRegionId:     client.Region,
+		VaultName:         d.Get("vault_name").(string),
+		VaultType:         "STANDARD",
+		VaultStorageClass: d.Get("vault_storage_class").(string),
+		Description:       d.Get("description").(string),
+	}
+	resp := CreateHbrVaultResponse{}
+	if err := client.hbrconn().Invoke("CreateVault", &args, &resp); err != nil {
+		return WrapError(err, "CreateVault", d.Id())
+	}
+
+	d.SetId(resp.VaultId)
+
+	if err := client.WaitForHbrVault(d.Id(), HbrVaultCreated, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForHbrVault", d.Id())
+	}
+
+	return resourceAlicloudHbrVaultRead(d, meta)
+}
+
+func resourceAlicloudHbrVaultRead(d *schema.ResourceData, meta interface{}) error {
+	vault, err := meta.(*AliyunClient).DescribeHbrVault(d.Id())
+	if err != nil {
+		if NotFoundError(err, "hbr") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeVaults", d.Id())
+	}
+
+	d.Set("vault_name", vault.VaultName)
+	d.Set("description", vault.Description)
+	d.Set("vault_storage_class", vault.VaultStorageClass)
+	d.Set("status", vault.Status)
+
+	return nil
+}
+
+func resourceAlicloudHbrVaultUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("vault_name") || d.HasChange("description") {
+		args := HbrVaultArgs{
+			VaultId:     d.Id(),
+			VaultName:   d.Get("vault_name").(string),
+			Description: d.Get("description").(string),
+		}
+		if err := client.hbrconn().Invoke("UpdateVault", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateVault", d.Id())
+		}
+	}
+
+	return resourceAlicloudHbrVaultRead(d, meta)
+}
+
+func resourceAlicloudHbrVaultDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := HbrVaultArgs{
+		VaultId: d.Id(),
+	}
+	if err := client.hbrconn().Invoke("DeleteVault", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "hbr") {
+			return nil
+		}
+		return WrapError(err, "DeleteVault", d.Id())
+	}
+	return nil
+}
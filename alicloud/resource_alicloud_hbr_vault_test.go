@@ -0,0 +1,104 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudHbrVault_basic(t *testing.T) {
+	var v HbrVault
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_hbr_vault.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHbrVaultDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHbrVaultConfig("tf-testAccHbrVault"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrVaultExists(
+						"alicloud_hbr_vault.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_vault.foo",
+						"vault_name",
+						"tf-testAccHbrVault"),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_vault.foo",
+						"status",
+						HbrVaultCreated),
+				),
+			},
+			resource.TestStep{
+				Config: testAccHbrVaultConfig("tf-testAccHbrVaultUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrVaultExists(
+						"alicloud_hbr_vault.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_hbr_vault.foo",
+						"vault_name",
+						"tf-testAccHbrVaultUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHbrVaultExists(n string, d *HbrVault) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HBR vault ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		vault, err := client.DescribeHbrVault(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *vault
+		return nil
+	}
+}
+
+func testAccCheckHbrVaultDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_hbr_vault" {
+			continue
+		}
+
+		if _, err := client.DescribeHbrVault(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "hbr") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("HBR vault %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccHbrVaultConfig(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_hbr_vault" "foo" {
+  vault_name = "%s"
+  description = "tf-testAccHbrVault"
+}
+`, name)
+}
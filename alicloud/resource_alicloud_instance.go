@@ -9,6 +9,7 @@ import (
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -23,6 +24,12 @@ func resourceAliyunInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": &schema.Schema{
 				Type:     schema.TypeString,
@@ -36,6 +43,12 @@ func resourceAliyunInstance() *schema.Resource {
 				Required: true,
 			},
 
+			// The product code of the Marketplace image, which is checked against the image_id when it is set.
+			"marketplace_image_product_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"instance_type": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -67,22 +80,27 @@ func resourceAliyunInstance() *schema.Resource {
 				ValidateFunc: validateInstanceDescription,
 			},
 
+			// The instance is moved into the deployment set before it is started for the first time.
+			"deployment_set_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"internet_charge_type": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Computed:     true,
 				ValidateFunc: validateInternetChargeType,
 			},
 			"internet_max_bandwidth_in": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
 			},
 			"internet_max_bandwidth_out": &schema.Schema{
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validateInternetMaxBandWidthOut,
 			},
 			"host_name": &schema.Schema{
@@ -179,13 +197,16 @@ func resourceAliyunInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"resource_group_id": resourceGroupIdSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
 
 	// Ensure instance_type is generation three
 	validData, err := meta.(*AliyunClient).CheckParameterValidity(d, meta)
@@ -193,11 +214,16 @@ func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if err := checkMarketplaceImage(d, meta); err != nil {
+		return err
+	}
+
 	args, err := buildAliyunInstanceArgs(d, meta)
 	if err != nil {
 		return err
 	}
 	args.IoOptimized = validData[IoOptimizedKey].(ecs.IoOptimized)
+	args.ClientToken = buildClientToken("tf-instance-")
 
 	instanceID, err := conn.CreateInstance(args)
 	if err != nil {
@@ -208,10 +234,21 @@ func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	// after instance created, its status is pending,
 	// so we need to wait it become to stopped and then start it
-	if err := conn.WaitForInstanceAsyn(d.Id(), ecs.Stopped, defaultTimeout); err != nil {
+	if err := conn.WaitForInstanceAsyn(d.Id(), ecs.Stopped, deadline.Seconds()); err != nil {
 		return fmt.Errorf("WaitForInstance %s got error: %#v", ecs.Stopped, err)
 	}
 
+	if v := d.Get("deployment_set_id").(string); v != "" {
+		args := ModifyInstanceDeploymentArgs{
+			RegionId:        getRegion(d, meta),
+			InstanceId:      d.Id(),
+			DeploymentSetId: v,
+		}
+		if err := conn.Invoke("ModifyInstanceDeployment", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyInstanceDeployment", d.Id())
+		}
+	}
+
 	if err := allocateIpAndBandWidthRelative(d, meta); err != nil {
 		return fmt.Errorf("allocateIpAndBandWidthRelative err: %#v", err)
 	}
@@ -220,21 +257,21 @@ func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Start instance got error: %#v", err)
 	}
 
-	if err := conn.WaitForInstanceAsyn(d.Id(), ecs.Running, 500); err != nil {
+	if err := conn.WaitForInstanceAsyn(d.Id(), ecs.Running, deadline.Seconds()); err != nil {
 		return fmt.Errorf("WaitForInstance %s got error: %#v", ecs.Running, err)
 	}
 
-	return resourceAliyunInstanceUpdate(d, meta)
+	return updateInstance(d, meta, deadline)
 }
 
 func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
+	conn := client.ecsconn()
 
 	instance, err := client.QueryInstancesById(d.Id())
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "ecs") {
 			d.SetId("")
 			return nil
 		}
@@ -244,7 +281,7 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	disk, diskErr := client.QueryInstanceSystemDisk(d.Id())
 
 	if diskErr != nil {
-		if NotFoundError(diskErr) {
+		if NotFoundError(diskErr, "ecs") {
 			d.SetId("")
 			return nil
 		}
@@ -266,6 +303,12 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("instance_charge_type", instance.InstanceChargeType)
 	d.Set("key_name", instance.KeyPairName)
 
+	deploymentSetId, err := client.DescribeInstanceDeploymentSetId(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeInstances", d.Id())
+	}
+	d.Set("deployment_set_id", deploymentSetId)
+
 	// In VPC network, internet_charge_type is "" when instance without public ip.
 	d.Set("internet_charge_type", instance.InternetChargeType)
 
@@ -335,14 +378,28 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		log.Printf("[ERROR] DescribeTags for instance got error: %#v", err)
 	}
-	d.Set("tags", tagsToMap(tags))
+	if err := d.Set("tags", ignoreDefaultTags(meta.(*AliyunClient), d, tagsToMap(tags))); err != nil {
+		return err
+	}
+
+	groupId, err := describeResourceGroupId(client, ResourceGroupResourceInstance, d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeResourceGroup", d.Id())
+	}
+	d.Set("resource_group_id", groupId)
 
 	return nil
 }
 
 func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	return updateInstance(d, meta, newOperationDeadline(d, schema.TimeoutUpdate))
+}
+
+// updateInstance updates the instance before the deadline, which is the one of the Create when the instance is
+// just created, so that the create timeout covers the whole creation.
+func updateInstance(d *schema.ResourceData, meta interface{}, deadline operationDeadline) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
+	conn := client.ecsconn()
 
 	d.Partial(true)
 
@@ -355,6 +412,9 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	imageUpdate := false
 	if d.HasChange("image_id") && !d.IsNewResource() {
+		if err := checkMarketplaceImage(d, meta); err != nil {
+			return err
+		}
 		log.Printf("[DEBUG] Replace instance system disk via changing image_id")
 		replaceSystemArgs := &ecs.ReplaceSystemDiskArgs{
 			InstanceId: d.Id(),
@@ -370,7 +430,7 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 				if err := conn.StopInstance(d.Id(), true); err != nil {
 					return fmt.Errorf("Force Stop Instance got an error: %#v", err)
 				}
-				if err := conn.WaitForInstance(d.Id(), ecs.Stopped, 60); err != nil {
+				if err := conn.WaitForInstance(d.Id(), ecs.Stopped, deadline.Seconds()); err != nil {
 					return fmt.Errorf("WaitForInstance got error: %#v", err)
 				}
 			}
@@ -382,22 +442,20 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		// Ensure instance's image has been replaced successfully.
-		timeout := ecs.InstanceDefaultTimeout
-		for {
-			instance, errDesc := conn.DescribeInstanceAttribute(d.Id())
-			if errDesc != nil {
-				return fmt.Errorf("Describe instance got an error: %#v", errDesc)
-			}
-
-			if instance.ImageId == d.Get("image_id") {
-				break
-			}
-			time.Sleep(ecs.DefaultWaitForInterval * time.Second)
-
-			timeout = timeout - ecs.DefaultWaitForInterval
-			if timeout <= 0 {
-				return common.GetClientErrorFromString("Timeout")
-			}
+		waiter := &stateWaiter{
+			Refresh: func() (interface{}, string, error) {
+				instance, err := conn.DescribeInstanceAttribute(d.Id())
+				if err != nil {
+					return nil, "", WrapError(err, "DescribeInstanceAttribute", d.Id())
+				}
+				return instance, instance.ImageId, nil
+			},
+			Target:       []string{d.Get("image_id").(string)},
+			PollInterval: ecs.DefaultWaitForInterval * time.Second,
+			Timeout:      deadline.Remaining(),
+		}
+		if _, err := waiter.Wait(); err != nil {
+			return fmt.Errorf("Waiting for the image of instance %s to be replaced got an error: %s", d.Id(), err)
 		}
 
 		imageUpdate = true
@@ -474,12 +532,16 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		// Start instance sometimes costs more than 8 minutes when os type is centos.
-		if err := conn.WaitForInstance(d.Id(), ecs.Running, 500); err != nil {
+		if err := conn.WaitForInstance(d.Id(), ecs.Running, deadline.Seconds()); err != nil {
 			return fmt.Errorf("WaitForInstance got error: %#v", err)
 		}
 
 	}
 
+	if err := modifyInstanceNetworkSpec(d, meta, deadline); err != nil {
+		return err
+	}
+
 	if d.HasChange("security_groups") {
 		o, n := d.GetChange("security_groups")
 		os := o.(*schema.Set)
@@ -504,18 +566,24 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("security_groups")
 	}
 
+	if err := setResourceGroup(client, ResourceGroupResourceInstance, d); err != nil {
+		return err
+	}
+	d.SetPartial("resource_group_id")
+
 	d.Partial(false)
 	return resourceAliyunInstanceRead(d, meta)
 }
 
 func resourceAliyunInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	conn := client.ecsconn()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		instance, err := client.QueryInstancesById(d.Id())
 		if err != nil {
-			if NotFoundError(err) {
+			if NotFoundError(err, "ecs") {
 				return nil
 			}
 		}
@@ -525,7 +593,7 @@ func resourceAliyunInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 				return resource.RetryableError(fmt.Errorf("ECS stop error - trying again."))
 			}
 
-			if err := conn.WaitForInstance(d.Id(), ecs.Stopped, defaultTimeout); err != nil {
+			if err := conn.WaitForInstance(d.Id(), ecs.Stopped, deadline.Seconds()); err != nil {
 				return resource.RetryableError(fmt.Errorf("Waiting for ecs stopped timeout - trying again."))
 			}
 		}
@@ -539,11 +607,59 @@ func resourceAliyunInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 
 }
 
+func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}, deadline operationDeadline) error {
+	if d.IsNewResource() {
+		return nil
+	}
+	if !d.HasChange("internet_charge_type") && !d.HasChange("internet_max_bandwidth_out") && !d.HasChange("internet_max_bandwidth_in") {
+		return nil
+	}
+
+	client := meta.(*AliyunClient)
+	args := ModifyInstanceNetworkSpecArgs{
+		InstanceId: d.Id(),
+		// The bandwidth out is sent as a string, so that it can be reduced to 0.
+		InternetMaxBandwidthOut: strconv.Itoa(d.Get("internet_max_bandwidth_out").(int)),
+		InternetMaxBandwidthIn:  d.Get("internet_max_bandwidth_in").(string),
+		// The prepaid instances are charged for the new spec with the balance of the account.
+		AutoPay: d.Get("instance_charge_type").(string) == string(common.PrePaid),
+	}
+	if d.HasChange("internet_charge_type") {
+		args.NetworkChargeType = d.Get("internet_charge_type").(string)
+	}
+
+	if err := client.ecsconn().Invoke("ModifyInstanceNetworkSpec", &args, &common.Response{}); err != nil {
+		return WrapError(err, "ModifyInstanceNetworkSpec", d.Id())
+	}
+
+	// The spec is modified asynchronously, and it is done when the instance describes the new one.
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.ecsconn().DescribeInstanceAttribute(d.Id())
+			if err != nil {
+				return nil, "", WrapError(err, "DescribeInstanceAttribute", d.Id())
+			}
+			return instance, strconv.Itoa(instance.InternetMaxBandwidthOut), nil
+		},
+		Target:       []string{args.InternetMaxBandwidthOut},
+		PollInterval: ecs.DefaultWaitForInterval * time.Second,
+		Timeout:      deadline.Remaining(),
+	}
+	if _, err := waiter.Wait(); err != nil {
+		return fmt.Errorf("Waiting for the network spec of instance %s to be modified got an error: %s", d.Id(), err)
+	}
+
+	d.SetPartial("internet_charge_type")
+	d.SetPartial("internet_max_bandwidth_out")
+	d.SetPartial("internet_max_bandwidth_in")
+	return nil
+}
+
 func allocateIpAndBandWidthRelative(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 	if d.Get("allocate_public_ip").(bool) {
 		if d.Get("internet_max_bandwidth_out") == 0 {
-			return fmt.Errorf("Error: if allocate_public_ip is true than the internet_max_bandwidth_out cannot equal zero.")
+			return ConfigErrorf(ErrorCodeInvalidArgument, "Error: if allocate_public_ip is true than the internet_max_bandwidth_out cannot equal zero.")
 		}
 
 		_, err := conn.AllocatePublicIpAddress(d.Id())
@@ -554,6 +670,29 @@ func allocateIpAndBandWidthRelative(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// checkMarketplaceImage ensures the marketplace_image_product_code is the product of the Marketplace image_id, so
+// that the terms of the product are accepted for the image the instance is launched from. The instance subscribes
+// the product when it is launched, so the subscription of the image is not checked.
+func checkMarketplaceImage(d *schema.ResourceData, meta interface{}) error {
+	productCode := d.Get("marketplace_image_product_code").(string)
+	if productCode == "" {
+		return nil
+	}
+	imageId := d.Get("image_id").(string)
+
+	image, err := meta.(*AliyunClient).DescribeMarketplaceImage(imageId)
+	if err != nil {
+		return WrapError(err, "DescribeImages", imageId)
+	}
+	if image == nil {
+		return ConfigErrorf(ErrorCodeInvalidArgument, "The image %s is not from the Marketplace, and the marketplace_image_product_code %s can not be set.", imageId, productCode)
+	}
+	if productCode != image.ProductCode {
+		return ConfigErrorf(ErrorCodeInvalidArgument, "The marketplace_image_product_code %s does not match the product %s of the image %s.", productCode, image.ProductCode, imageId)
+	}
+	return nil
+}
+
 func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.CreateInstanceArgs, error) {
 	client := meta.(*AliyunClient)
 
@@ -649,7 +788,7 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Cre
 	if v := d.Get("period").(int); v != 0 {
 		args.Period = v
 	} else if args.InstanceChargeType == common.PrePaid {
-		return nil, fmt.Errorf("period is required for instance_charge_type is PrePaid")
+		return nil, ConfigErrorf(ErrorCodeMissingArgument, "period is required for instance_charge_type is PrePaid")
 	}
 
 	if v := d.Get("user_data").(string); v != "" {
@@ -6,13 +6,68 @@ import (
 
 	"log"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweepers("alicloud_instance", &testSweeper{
+		F: testSweepInstances,
+	})
+}
+
+func testSweepInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.ecsconn()
+
+	var ids []string
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		instances, _, err := conn.DescribeInstances(&ecs.DescribeInstancesArgs{
+			RegionId:   client.Region,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeInstances", "")
+		}
+		for _, inst := range instances {
+			if !isSweepable(inst.InstanceName) {
+				log.Printf("[INFO] Skipping instance %s (%s)", inst.InstanceName, inst.InstanceId)
+				continue
+			}
+			ids = append(ids, inst.InstanceId)
+		}
+		return len(instances), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting instance %s", id)
+		if err := conn.StopInstance(id, true); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "StopInstance", id))
+			continue
+		}
+		if err := conn.WaitForInstance(id, ecs.Stopped, defaultTimeout); err != nil {
+			log.Printf("[ERROR] Waiting for instance %s stopped got an error: %s", id, err)
+			continue
+		}
+		if err := conn.DeleteInstance(id); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteInstance", id))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudInstance_basic(t *testing.T) {
+	defer testAccRecorder(t)()
+
 	var instance ecs.InstanceAttributesType
 
 	testCheck := func(*terraform.State) error {
@@ -83,6 +138,8 @@ func TestAccAlicloudInstance_basic(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_vpc(t *testing.T) {
+	defer testAccRecorder(t)()
+
 	var instance ecs.InstanceAttributesType
 
 	resource.Test(t, resource.TestCase{
@@ -112,6 +169,43 @@ func TestAccAlicloudInstance_vpc(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudInstance_networkSpec(t *testing.T) {
+	var before, after ecs.InstanceAttributesType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "alicloud_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceConfigVPC,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("alicloud_instance.foo", &before),
+					resource.TestCheckResourceAttr("alicloud_instance.foo", "internet_max_bandwidth_out", "5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccInstanceConfigNetworkSpec,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("alicloud_instance.foo", &after),
+					resource.TestCheckResourceAttr("alicloud_instance.foo", "internet_charge_type", "PayByBandwidth"),
+					resource.TestCheckResourceAttr("alicloud_instance.foo", "internet_max_bandwidth_out", "10"),
+					resource.TestCheckResourceAttr("alicloud_instance.foo", "internet_max_bandwidth_in", "50"),
+					func(*terraform.State) error {
+						if before.InstanceId != after.InstanceId {
+							return fmt.Errorf("expected the instance to be updated in place, but it was recreated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAlicloudInstance_userData(t *testing.T) {
 	var instance ecs.InstanceAttributesType
 
@@ -606,7 +700,7 @@ func testAccCheckInstanceExistsWithProviders(n string, i *ecs.InstanceAttributes
 			}
 
 			// Verify the error is what we want
-			if NotFoundError(err) {
+			if NotFoundError(err, "ecs") {
 				continue
 			}
 			if err != nil {
@@ -654,7 +748,7 @@ func testAccCheckInstanceDestroyWithProvider(s *terraform.State, provider *schem
 		}
 
 		// Verify the error is what we want
-		if NotFoundError(err) {
+		if NotFoundError(err, "ecs") {
 			continue
 		}
 
@@ -764,6 +858,49 @@ resource "alicloud_instance" "foo" {
 
 `
 
+const testAccInstanceConfigNetworkSpec = `
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+ 	name = "tf_test_foo"
+ 	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "tf_test_foo" {
+	name = "tf_test_foo"
+	description = "foo"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_instance" "foo" {
+	# cn-beijing
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	image_id = "ubuntu_140405_32_40G_cloudinit_20161115.vhd"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+
+	# series III
+	instance_type = "ecs.n4.large"
+	system_disk_category = "cloud_efficiency"
+
+	internet_charge_type = "PayByBandwidth"
+	internet_max_bandwidth_out = 10
+	internet_max_bandwidth_in = "50"
+	allocate_public_ip = true
+	security_groups = ["${alicloud_security_group.tf_test_foo.id}"]
+	instance_name = "test_foo"
+}
+
+`
+
 const testAccInstanceConfigUserData = `
 data "alicloud_zones" "default" {
 	"available_disk_category"= "cloud_efficiency"
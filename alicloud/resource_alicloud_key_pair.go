@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"io/ioutil"
+	"log"
 	"strings"
 	"time"
 )
@@ -19,6 +20,10 @@ func resourceAlicloudKeyPair() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_name": &schema.Schema{
 				Type:          schema.TypeString,
@@ -61,7 +66,7 @@ func resourceAlicloudKeyPair() *schema.Resource {
 }
 
 func resourceAlicloudKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	var keyName string
 	if v, ok := d.GetOk("key_name"); ok {
@@ -102,31 +107,34 @@ func resourceAlicloudKeyPairCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudKeyPairRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	keypairs, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
 		RegionId:    getRegion(d, meta),
 		KeyPairName: d.Id(),
 	})
 	if err != nil {
-		if IsExceptedError(err, KeyPairNotFound) {
+		if NotFoundError(err, "ecs") {
 			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("Error Retrieving KeyPair: %s", err)
 	}
 
-	if len(keypairs) > 0 {
-		d.Set("key_name", keypairs[0].KeyPairName)
-		d.Set("fingerprint", keypairs[0].KeyPairFingerPrint)
+	if len(keypairs) < 1 {
+		log.Printf("[WARN] Key pair %s is not found, and it will be removed from the state.", d.Id())
+		d.SetId("")
 		return nil
 	}
 
-	return fmt.Errorf("Unable to find key pair within: %#v", keypairs)
+	d.Set("key_name", keypairs[0].KeyPairName)
+	d.Set("finger_print", keypairs[0].KeyPairFingerPrint)
+	return nil
 }
 
 func resourceAlicloudKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	instance_ids, _, err := client.QueryInstancesWithKeyPair(getRegion(d, meta), "", d.Id())
 	if err != nil {
@@ -137,12 +145,12 @@ func resourceAlicloudKeyPairDelete(d *schema.ResourceData, meta interface{}) err
 		KeyPairName: d.Id(),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 
 		// Detach keypair from its all instances before removing it.
 		if len(instance_ids) > 0 {
 			detachArgs.InstanceIds = convertListToJsonString(instance_ids)
-			if err := client.ecsconn.DetachKeyPair(detachArgs); err != nil {
+			if err := client.ecsconn().DetachKeyPair(detachArgs); err != nil {
 				return resource.NonRetryableError(fmt.Errorf("Error DetachKeyPair:%#v", err))
 			}
 		}
@@ -154,17 +162,17 @@ func resourceAlicloudKeyPairDelete(d *schema.ResourceData, meta interface{}) err
 			return resource.RetryableError(fmt.Errorf("There is still attached instances -- try again to detach them."))
 		}
 
-		err := client.ecsconn.DeleteKeyPairs(&ecs.DeleteKeyPairsArgs{
+		err := client.ecsconn().DeleteKeyPairs(&ecs.DeleteKeyPairsArgs{
 			RegionId:     getRegion(d, meta),
 			KeyPairNames: convertListToJsonString(append(make([]interface{}, 0, 1), d.Id())),
 		})
 		if err != nil {
-			if IsExceptedError(err, KeyPairNotFound) {
+			if NotFoundError(err, "ecs") {
 				return nil
 			}
 		}
 
-		keypairs, _, err := client.ecsconn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
+		keypairs, _, err := client.ecsconn().DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
 			RegionId:    getRegion(d, meta),
 			KeyPairName: d.Id(),
 		})
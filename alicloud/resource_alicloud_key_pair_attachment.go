@@ -18,6 +18,11 @@ func resourceAlicloudKeyPairAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -36,7 +41,8 @@ func resourceAlicloudKeyPairAttachment() *schema.Resource {
 }
 
 func resourceAlicloudKeyPairAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
 	instanceIds := convertListToJsonString(d.Get("instance_ids").(*schema.Set).List())
 
 	args := &ecs.AttachKeyPairArgs{
@@ -44,7 +50,7 @@ func resourceAlicloudKeyPairAttachmentCreate(d *schema.ResourceData, meta interf
 		KeyPairName: d.Get("key_name").(string),
 		InstanceIds: instanceIds,
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if er := conn.AttachKeyPair(args); er != nil {
 			if IsExceptedError(er, KeyPairServiceUnavailable) {
 				return resource.RetryableError(fmt.Errorf("Key Pair is attaching and gets an error: %#v -- try again...", er))
@@ -63,14 +69,14 @@ func resourceAlicloudKeyPairAttachmentCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAlicloudKeyPairAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 	keyname := strings.Split(d.Id(), ":")[0]
 	keypairs, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
 		RegionId:    getRegion(d, meta),
 		KeyPairName: keyname,
 	})
 	if err != nil {
-		if IsExceptedError(err, KeyPairNotFound) {
+		if NotFoundError(err, "ecs") {
 			d.SetId("")
 			return nil
 		}
@@ -79,7 +85,9 @@ func resourceAlicloudKeyPairAttachmentRead(d *schema.ResourceData, meta interfac
 
 	if len(keypairs) > 0 {
 		d.Set("key_name", keypairs[0].KeyPairName)
-		d.Set("instance_ids", d.Get("instance_ids"))
+		if err := d.Set("instance_ids", d.Get("instance_ids")); err != nil {
+			return err
+		}
 		return nil
 	}
 
@@ -88,11 +96,12 @@ func resourceAlicloudKeyPairAttachmentRead(d *schema.ResourceData, meta interfac
 
 func resourceAlicloudKeyPairAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 	keyname := strings.Split(d.Id(), ":")[0]
 	instanceIds := strings.Split(d.Id(), ":")[1]
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := client.ecsconn.DetachKeyPair(&ecs.DetachKeyPairArgs{
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		err := client.ecsconn().DetachKeyPair(&ecs.DetachKeyPairArgs{
 			RegionId:    getRegion(d, meta),
 			KeyPairName: keyname,
 			InstanceIds: instanceIds,
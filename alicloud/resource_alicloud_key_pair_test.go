@@ -104,7 +104,7 @@ func testAccCheckKeyPairExists(n string, keypair *ecs.KeyPairItemType) resource.
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		response, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
 			RegionId:    client.Region,
@@ -135,7 +135,7 @@ func testAccCheckKeyPairHasPrefix(n string, keypair *ecs.KeyPairItemType, prefix
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		response, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
 			RegionId:    client.Region,
@@ -164,7 +164,7 @@ func testAccCheckKeyPairDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		response, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
 			RegionId:    client.Region,
@@ -0,0 +1,217 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudKVStoreAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudKVStoreAccountCreate,
+		Read:   resourceAlicloudKVStoreAccountRead,
+		Update: resourceAlicloudKVStoreAccountUpdate,
+		Delete: resourceAlicloudKVStoreAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKVStoreAccountName,
+			},
+			"account_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"account_privilege": &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(KVStoreRoleReadOnly), string(KVStoreRoleReadWrite), string(KVStoreRoleRepl)}),
+				Optional: true,
+				Default:  string(KVStoreRoleReadWrite),
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateInstanceDescription,
+			},
+			"account_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudKVStoreAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("account_name").(string)
+
+	args := CreateKVStoreAccountArgs{
+		RegionId:           client.Region,
+		InstanceId:         instanceId,
+		AccountName:        name,
+		AccountPassword:    d.Get("account_password").(string),
+		AccountPrivilege:   KVStoreAccountPrivilege(d.Get("account_privilege").(string)),
+		AccountDescription: d.Get("description").(string),
+	}
+
+	// Only one account can be created at the same time, and the instance is Changing while creating.
+	err := resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.kvstoreconn().Invoke("CreateAccount", &args, &KVStoreResponse{}); err != nil {
+			if IsExceptedError(err, KVStoreIncorrectInstanceStatus) {
+				return resource.RetryableError(fmt.Errorf("KVStore instance %s is busy - trying again while it is Normal.", instanceId))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapError(err, "CreateAccount", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", instanceId, COLON_SEPARATED, name))
+
+	if err := client.WaitForKVStoreAccount(instanceId, name, KVStoreAccountAvailable, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForKVStoreAccount %s got an error: %#v", KVStoreAccountAvailable, err)
+	}
+
+	return resourceAlicloudKVStoreAccountUpdate(d, meta)
+}
+
+func resourceAlicloudKVStoreAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	instanceId, name := splitKVStoreAccountId(d.Id())
+
+	d.Partial(true)
+
+	if d.HasChange("description") && !d.IsNewResource() {
+		args := ModifyKVStoreAccountDescriptionArgs{
+			RegionId:           client.Region,
+			InstanceId:         instanceId,
+			AccountName:        name,
+			AccountDescription: d.Get("description").(string),
+		}
+		if err := client.kvstoreconn().Invoke("ModifyAccountDescription", &args, &KVStoreResponse{}); err != nil {
+			return WrapError(err, "ModifyAccountDescription", d.Id())
+		}
+		d.SetPartial("description")
+	}
+
+	if d.HasChange("account_privilege") && !d.IsNewResource() {
+		args := GrantKVStoreAccountPrivilegeArgs{
+			RegionId:         client.Region,
+			InstanceId:       instanceId,
+			AccountName:      name,
+			AccountPrivilege: KVStoreAccountPrivilege(d.Get("account_privilege").(string)),
+		}
+		if err := client.kvstoreconn().Invoke("GrantAccountPrivilege", &args, &KVStoreResponse{}); err != nil {
+			return WrapError(err, "GrantAccountPrivilege", d.Id())
+		}
+		if err := client.WaitForKVStoreAccount(instanceId, name, KVStoreAccountAvailable, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForKVStoreAccount %s got an error: %#v", KVStoreAccountAvailable, err)
+		}
+		d.SetPartial("account_privilege")
+	}
+
+	if d.HasChange("account_password") && !d.IsNewResource() {
+		args := ResetKVStoreAccountPasswordArgs{
+			RegionId:        client.Region,
+			InstanceId:      instanceId,
+			AccountName:     name,
+			AccountPassword: d.Get("account_password").(string),
+		}
+		if err := client.kvstoreconn().Invoke("ResetAccountPassword", &args, &KVStoreResponse{}); err != nil {
+			return WrapError(err, "ResetAccountPassword", d.Id())
+		}
+		d.SetPartial("account_password")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudKVStoreAccountRead(d, meta)
+}
+
+func resourceAlicloudKVStoreAccountRead(d *schema.ResourceData, meta interface{}) error {
+	instanceId, name := splitKVStoreAccountId(d.Id())
+
+	account, err := meta.(*AliyunClient).DescribeKVStoreAccount(instanceId, name)
+	if err != nil {
+		if NotFoundError(err, "kvstore") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeAccounts", d.Id())
+	}
+
+	d.Set("instance_id", instanceId)
+	d.Set("account_name", account.AccountName)
+	d.Set("description", account.AccountDescription)
+	d.Set("account_type", account.AccountType)
+	if len(account.DatabasePrivileges.DatabasePrivilege) > 0 {
+		d.Set("account_privilege", account.DatabasePrivileges.DatabasePrivilege[0].AccountPrivilege)
+	}
+
+	return nil
+}
+
+func resourceAlicloudKVStoreAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	instanceId, name := splitKVStoreAccountId(d.Id())
+
+	args := KVStoreAccountArgs{
+		RegionId:    client.Region,
+		InstanceId:  instanceId,
+		AccountName: name,
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.kvstoreconn().Invoke("DeleteAccount", &args, &KVStoreResponse{}); err != nil {
+			if IsExceptedError(err, KVStoreIncorrectInstanceStatus) {
+				return resource.RetryableError(fmt.Errorf("KVStore instance %s is busy - trying again while it is Normal.", instanceId))
+			}
+			if !NotFoundError(err, "kvstore") {
+				return resource.NonRetryableError(WrapError(err, "DeleteAccount", d.Id()))
+			}
+		}
+
+		if _, err := client.DescribeKVStoreAccount(instanceId, name); err != nil {
+			if NotFoundError(err, "kvstore") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete KVStore account %s timeout.", name))
+	})
+}
+
+func splitKVStoreAccountId(id string) (instanceId, name string) {
+	parts := strings.SplitN(id, COLON_SEPARATED, 2)
+	if len(parts) < 2 {
+		return id, ""
+	}
+	return parts[0], parts[1]
+}
@@ -0,0 +1,112 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudKVStoreAccount_basic(t *testing.T) {
+	var account KVStoreAccount
+	instanceId := os.Getenv("ALICLOUD_KVSTORE_INSTANCE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithKVStoreInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_kvstore_account.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKVStoreAccountDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKVStoreAccountConfig(instanceId, "RoleReadOnly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreAccountExists(
+						"alicloud_kvstore_account.foo", &account),
+					resource.TestCheckResourceAttr(
+						"alicloud_kvstore_account.foo",
+						"account_name",
+						"tf_test"),
+					resource.TestCheckResourceAttr(
+						"alicloud_kvstore_account.foo",
+						"account_privilege",
+						"RoleReadOnly"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKVStoreAccountConfig(instanceId, "RoleReadWrite"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreAccountExists(
+						"alicloud_kvstore_account.foo", &account),
+					resource.TestCheckResourceAttr(
+						"alicloud_kvstore_account.foo",
+						"account_privilege",
+						"RoleReadWrite"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckKVStoreAccountExists(n string, account *KVStoreAccount) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KVStore account ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instanceId, name := splitKVStoreAccountId(rs.Primary.ID)
+		a, err := client.DescribeKVStoreAccount(instanceId, name)
+		if err != nil {
+			return err
+		}
+
+		*account = *a
+		return nil
+	}
+}
+
+func testAccCheckKVStoreAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_kvstore_account" {
+			continue
+		}
+
+		instanceId, name := splitKVStoreAccountId(rs.Primary.ID)
+		if _, err := client.DescribeKVStoreAccount(instanceId, name); err != nil {
+			if NotFoundError(err, "kvstore") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("KVStore account %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccKVStoreAccountConfig(instanceId, privilege string) string {
+	return fmt.Sprintf(`
+resource "alicloud_kvstore_account" "foo" {
+	instance_id = "%s"
+	account_name = "tf_test"
+	account_password = "Test12345"
+	account_privilege = "%s"
+	description = "tf test account"
+}
+`, instanceId, privilege)
+}
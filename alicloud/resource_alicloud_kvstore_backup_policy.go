@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudKVStoreBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudKVStoreBackupPolicyCreate,
+		Read:   resourceAlicloudKVStoreBackupPolicyRead,
+		Update: resourceAlicloudKVStoreBackupPolicyUpdate,
+		Delete: resourceAlicloudKVStoreBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_time": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(KVStoreBackupTime),
+				Optional:     true,
+				Default:      "02:00Z-03:00Z",
+			},
+			"backup_period": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"backup_retention_period": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudKVStoreBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("instance_id").(string))
+
+	return resourceAlicloudKVStoreBackupPolicyUpdate(d, meta)
+}
+
+func resourceAlicloudKVStoreBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("backup_time") || d.HasChange("backup_period") {
+		periodList := expandStringList(d.Get("backup_period").(*schema.Set).List())
+		if len(periodList) < 1 {
+			periodList = KVStoreBackupPeriod
+		}
+		for _, p := range periodList {
+			if _, errs := validateAllowedStringValue(KVStoreBackupPeriod)(p, "backup_period"); len(errs) > 0 {
+				return errs[0]
+			}
+		}
+
+		args := ModifyKVStoreBackupPolicyArgs{
+			RegionId:              client.Region,
+			InstanceId:            d.Id(),
+			PreferredBackupTime:   d.Get("backup_time").(string),
+			PreferredBackupPeriod: strings.Join(periodList, COMMA_SEPARATED),
+		}
+		if err := client.kvstoreconn().Invoke("ModifyBackupPolicy", &args, &KVStoreResponse{}); err != nil {
+			return WrapError(err, "ModifyBackupPolicy", d.Id())
+		}
+	}
+
+	return resourceAlicloudKVStoreBackupPolicyRead(d, meta)
+}
+
+func resourceAlicloudKVStoreBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	policy, err := meta.(*AliyunClient).DescribeKVStoreBackupPolicy(d.Id())
+	if err != nil {
+		if NotFoundError(err, "kvstore") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeBackupPolicy", d.Id())
+	}
+
+	d.Set("instance_id", d.Id())
+	d.Set("backup_time", policy.PreferredBackupTime)
+	if err := d.Set("backup_period", strings.Split(policy.PreferredBackupPeriod, COMMA_SEPARATED)); err != nil {
+		return err
+	}
+	d.Set("backup_retention_period", policy.BackupRetentionPeriod)
+
+	return nil
+}
+
+// The backup policy can not be removed, so restore it to the default policy when deleting.
+func resourceAlicloudKVStoreBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := ModifyKVStoreBackupPolicyArgs{
+		RegionId:              client.Region,
+		InstanceId:            d.Id(),
+		PreferredBackupTime:   "02:00Z-03:00Z",
+		PreferredBackupPeriod: strings.Join(KVStoreBackupPeriod, COMMA_SEPARATED),
+	}
+	if err := client.kvstoreconn().Invoke("ModifyBackupPolicy", &args, &KVStoreResponse{}); err != nil {
+		if NotFoundError(err, "kvstore") {
+			return nil
+		}
+		return fmt.Errorf("Restoring default backup policy got an error: %#v", err)
+	}
+	return nil
+}
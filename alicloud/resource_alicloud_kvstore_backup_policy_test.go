@@ -0,0 +1,75 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudKVStoreBackupPolicy_basic(t *testing.T) {
+	var policy KVStoreBackupPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithKVStoreInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_kvstore_backup_policy.foo",
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKVStoreBackupPolicyConfig(os.Getenv("ALICLOUD_KVSTORE_INSTANCE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreBackupPolicyExists(
+						"alicloud_kvstore_backup_policy.foo", &policy),
+					resource.TestCheckResourceAttr(
+						"alicloud_kvstore_backup_policy.foo",
+						"backup_time",
+						"03:00Z-04:00Z"),
+					resource.TestCheckResourceAttr(
+						"alicloud_kvstore_backup_policy.foo",
+						"backup_period.#",
+						"2"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckKVStoreBackupPolicyExists(n string, policy *KVStoreBackupPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KVStore instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		p, err := client.DescribeKVStoreBackupPolicy(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*policy = *p
+		return nil
+	}
+}
+
+func testAccKVStoreBackupPolicyConfig(instanceId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_kvstore_backup_policy" "foo" {
+	instance_id = "%s"
+	backup_time = "03:00Z-04:00Z"
+	backup_period = ["Tuesday", "Wednesday"]
+}
+`, instanceId)
+}
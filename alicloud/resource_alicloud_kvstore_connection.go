@@ -0,0 +1,175 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudKVStoreConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudKVStoreConnectionCreate,
+		Read:   resourceAlicloudKVStoreConnectionRead,
+		Update: resourceAlicloudKVStoreConnectionUpdate,
+		Delete: resourceAlicloudKVStoreConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connection_string_prefix": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKVStoreConnectionPrefix,
+			},
+			"port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      6379,
+				ValidateFunc: validateIntegerInRange(1024, 65535),
+			},
+			"connection_string": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudKVStoreConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	instanceId := d.Get("instance_id").(string)
+
+	args := AllocateKVStorePublicConnectionArgs{
+		RegionId:               client.Region,
+		InstanceId:             instanceId,
+		ConnectionStringPrefix: d.Get("connection_string_prefix").(string),
+		Port:                   strconv.Itoa(d.Get("port").(int)),
+	}
+
+	err := resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.kvstoreconn().Invoke("AllocateInstancePublicConnection", &args, &KVStoreResponse{}); err != nil {
+			if IsExceptedError(err, KVStoreIncorrectInstanceStatus) {
+				return resource.RetryableError(fmt.Errorf("KVStore instance %s is busy - trying again while it is Normal.", instanceId))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapError(err, "AllocateInstancePublicConnection", d.Id())
+	}
+
+	d.SetId(instanceId)
+
+	if err := client.WaitForKVStoreInstance(instanceId, KVStoreNormal, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForKVStoreInstance %s got an error: %#v", KVStoreNormal, err)
+	}
+
+	return resourceAlicloudKVStoreConnectionRead(d, meta)
+}
+
+func resourceAlicloudKVStoreConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+
+	if d.HasChange("connection_string_prefix") || d.HasChange("port") {
+		args := ModifyKVStoreConnectionStringArgs{
+			RegionId:                client.Region,
+			DBInstanceId:            d.Id(),
+			CurrentConnectionString: d.Get("connection_string").(string),
+			IPType:                  "Public",
+		}
+		if d.HasChange("connection_string_prefix") {
+			args.NewConnectionString = d.Get("connection_string_prefix").(string)
+		}
+		if d.HasChange("port") {
+			args.Port = strconv.Itoa(d.Get("port").(int))
+		}
+
+		if err := client.kvstoreconn().Invoke("ModifyDBInstanceConnectionString", &args, &KVStoreResponse{}); err != nil {
+			return WrapError(err, "ModifyDBInstanceConnectionString", d.Id())
+		}
+
+		if err := client.WaitForKVStoreInstance(d.Id(), KVStoreNormal, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForKVStoreInstance %s got an error: %#v", KVStoreNormal, err)
+		}
+	}
+
+	return resourceAlicloudKVStoreConnectionRead(d, meta)
+}
+
+func resourceAlicloudKVStoreConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AliyunClient).DescribeKVStorePublicConnection(d.Id())
+	if err != nil {
+		if NotFoundError(err, "kvstore") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDBInstanceNetInfo", d.Id())
+	}
+
+	port, err := strconv.Atoi(conn.Port)
+	if err != nil {
+		return fmt.Errorf("Parsing KVStore connection port %s got an error: %#v", conn.Port, err)
+	}
+
+	d.Set("instance_id", d.Id())
+	d.Set("connection_string", conn.ConnectionString)
+	d.Set("connection_string_prefix", strings.Split(conn.ConnectionString, DOT_SEPARATED)[0])
+	d.Set("port", port)
+	d.Set("ip_address", conn.IPAddress)
+
+	return nil
+}
+
+func resourceAlicloudKVStoreConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := ReleaseKVStorePublicConnectionArgs{
+		RegionId:                client.Region,
+		InstanceId:              d.Id(),
+		CurrentConnectionString: d.Get("connection_string").(string),
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.kvstoreconn().Invoke("ReleaseInstancePublicConnection", &args, &KVStoreResponse{}); err != nil {
+			if IsExceptedError(err, KVStoreIncorrectInstanceStatus) {
+				return resource.RetryableError(fmt.Errorf("KVStore instance %s is busy - trying again while it is Normal.", d.Id()))
+			}
+			if !NotFoundError(err, "kvstore") {
+				return resource.NonRetryableError(WrapError(err, "ReleaseInstancePublicConnection", d.Id()))
+			}
+		}
+
+		if _, err := client.DescribeKVStorePublicConnection(d.Id()); err != nil {
+			if NotFoundError(err, "kvstore") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Release KVStore public connection of %s timeout.", d.Id()))
+	})
+}
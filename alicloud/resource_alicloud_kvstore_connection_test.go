@@ -0,0 +1,107 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudKVStoreConnection_basic(t *testing.T) {
+	var conn KVStoreNetInfo
+	instanceId := os.Getenv("ALICLOUD_KVSTORE_INSTANCE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithKVStoreInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_kvstore_connection.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKVStoreConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKVStoreConnectionConfig(instanceId, 6379),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreConnectionExists(
+						"alicloud_kvstore_connection.foo", &conn),
+					resource.TestCheckResourceAttr(
+						"alicloud_kvstore_connection.foo",
+						"port",
+						"6379"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_kvstore_connection.foo",
+						"connection_string"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKVStoreConnectionConfig(instanceId, 6380),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreConnectionExists(
+						"alicloud_kvstore_connection.foo", &conn),
+					resource.TestCheckResourceAttr(
+						"alicloud_kvstore_connection.foo",
+						"port",
+						"6380"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckKVStoreConnectionExists(n string, conn *KVStoreNetInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KVStore instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		c, err := client.DescribeKVStorePublicConnection(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*conn = *c
+		return nil
+	}
+}
+
+func testAccCheckKVStoreConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_kvstore_connection" {
+			continue
+		}
+
+		if _, err := client.DescribeKVStorePublicConnection(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "kvstore") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("KVStore public connection of %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccKVStoreConnectionConfig(instanceId string, port int) string {
+	return fmt.Sprintf(`
+resource "alicloud_kvstore_connection" "foo" {
+	instance_id = "%s"
+	connection_string_prefix = "tf-test-kvstore"
+	port = %d
+}
+`, instanceId, port)
+}
@@ -0,0 +1,308 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogAlertCreate,
+		Read:   resourceAlicloudLogAlertRead,
+		Update: resourceAlicloudLogAlertUpdate,
+		Delete: resourceAlicloudLogAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"condition": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dashboard": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mute_until": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"throttling": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "0s",
+			},
+			"notify_threshold": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"schedule_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FixedRate",
+				ValidateFunc: validateAllowedStringValue([]string{"FixedRate"}),
+			},
+			"schedule_interval": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+			"query_list": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"chart_title": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"logstore": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"query": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"start": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "-60s",
+						},
+						"end": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "now",
+						},
+						"time_span_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Custom",
+						},
+					},
+				},
+			},
+			"notification_list": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(LogAlertNotificationTypes),
+						},
+						"content": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_uri": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mobile_list": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"email_list": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogAlertCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	project := d.Get("project").(string)
+	alert := buildLogAlert(d)
+	if err := client.logconn().Invoke(http.MethodPost, project, "/jobs", nil, alert, nil); err != nil {
+		return WrapError(err, "CreateJob", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, alert.Name))
+
+	return resourceAlicloudLogAlertRead(d, meta)
+}
+
+func resourceAlicloudLogAlertRead(d *schema.ResourceData, meta interface{}) error {
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	alert, err := meta.(*AliyunClient).DescribeLogAlert(project, name)
+	if err != nil {
+		if NotFoundError(err, "log") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetJob", d.Id())
+	}
+
+	d.Set("project", project)
+	d.Set("name", alert.Name)
+	d.Set("display_name", alert.DisplayName)
+	d.Set("description", alert.Description)
+	d.Set("condition", alert.Configuration.Condition)
+	d.Set("dashboard", alert.Configuration.Dashboard)
+	d.Set("mute_until", alert.Configuration.MuteUntil)
+	d.Set("throttling", alert.Configuration.Throttling)
+	d.Set("notify_threshold", alert.Configuration.NotifyThreshold)
+	d.Set("schedule_type", alert.Schedule.Type)
+	d.Set("schedule_interval", alert.Schedule.Interval)
+
+	var queries []map[string]interface{}
+	for _, q := range alert.Configuration.QueryList {
+		queries = append(queries, map[string]interface{}{
+			"chart_title":    q.ChartTitle,
+			"logstore":       q.LogStore,
+			"query":          q.Query,
+			"start":          q.Start,
+			"end":            q.End,
+			"time_span_type": q.TimeSpanType,
+		})
+	}
+	if err := d.Set("query_list", queries); err != nil {
+		return err
+	}
+
+	var notifications []map[string]interface{}
+	for _, n := range alert.Configuration.NotificationList {
+		notifications = append(notifications, map[string]interface{}{
+			"type":        n.Type,
+			"content":     n.Content,
+			"service_uri": n.ServiceUri,
+			"mobile_list": n.MobileList,
+			"email_list":  n.EmailList,
+		})
+	}
+	if err := d.Set("notification_list", notifications); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudLogAlertUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+	// UpdateJob overwrites all the arguments of the existing alert.
+	if err := client.logconn().Invoke(http.MethodPut, project, fmt.Sprintf("/jobs/%s", name), nil, buildLogAlert(d), nil); err != nil {
+		return WrapError(err, "UpdateJob", d.Id())
+	}
+
+	return resourceAlicloudLogAlertRead(d, meta)
+}
+
+func resourceAlicloudLogAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.logconn().Invoke(http.MethodDelete, project, fmt.Sprintf("/jobs/%s", name), nil, nil, nil); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteJob", d.Id()))
+		}
+
+		if _, err := client.DescribeLogAlert(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Log alert %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildLogAlert(d *schema.ResourceData) *LogAlert {
+	alert := &LogAlert{
+		Name:        d.Get("name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Type:        "Alert",
+		State:       "Enabled",
+		Schedule: LogJobSchedule{
+			Type:     d.Get("schedule_type").(string),
+			Interval: d.Get("schedule_interval").(string),
+		},
+		Configuration: LogAlertConfiguration{
+			Condition:       d.Get("condition").(string),
+			Dashboard:       d.Get("dashboard").(string),
+			MuteUntil:       int64(d.Get("mute_until").(int)),
+			Throttling:      d.Get("throttling").(string),
+			NotifyThreshold: d.Get("notify_threshold").(int),
+		},
+	}
+
+	for _, q := range d.Get("query_list").([]interface{}) {
+		query := q.(map[string]interface{})
+		alert.Configuration.QueryList = append(alert.Configuration.QueryList, LogAlertQuery{
+			ChartTitle:   query["chart_title"].(string),
+			LogStore:     query["logstore"].(string),
+			Query:        query["query"].(string),
+			Start:        query["start"].(string),
+			End:          query["end"].(string),
+			TimeSpanType: query["time_span_type"].(string),
+		})
+	}
+
+	for _, n := range d.Get("notification_list").([]interface{}) {
+		notification := n.(map[string]interface{})
+		alert.Configuration.NotificationList = append(alert.Configuration.NotificationList, LogAlertNotification{
+			Type:       notification["type"].(string),
+			Content:    notification["content"].(string),
+			ServiceUri: notification["service_uri"].(string),
+			MobileList: expandStringList(notification["mobile_list"].([]interface{})),
+			EmailList:  expandStringList(notification["email_list"].([]interface{})),
+		})
+	}
+
+	return alert
+}
@@ -0,0 +1,134 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudLogAlert_basic(t *testing.T) {
+	var v LogAlert
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithLogStore(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_log_alert.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLogAlertDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogAlertConfig("count > 100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogAlertExists(
+						"alicloud_log_alert.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_alert.foo",
+						"condition",
+						"count > 100"),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_alert.foo",
+						"query_list.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_alert.foo",
+						"notification_list.0.type",
+						"Email"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLogAlertConfig("count > 200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogAlertExists(
+						"alicloud_log_alert.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_alert.foo",
+						"condition",
+						"count > 200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLogAlertExists(n string, d *LogAlert) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No log alert ID is set")
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		alert, err := client.DescribeLogAlert(project, name)
+		if err != nil {
+			return err
+		}
+
+		*d = *alert
+		return nil
+	}
+}
+
+func testAccCheckLogAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_log_alert" {
+			continue
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeLogAlert(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Log alert %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLogAlertConfig(condition string) string {
+	return fmt.Sprintf(`
+resource "alicloud_log_alert" "foo" {
+  project = "%s"
+  name = "tf-testacc-log-alert"
+  display_name = "tf-testAccLogAlert"
+  condition = "%s"
+  dashboard = "tf-testacc-log-alert"
+  query_list = [{
+    chart_title = "tf-testAccLogAlert"
+    logstore = "%s"
+    query = "* | select count(1) as count"
+    start = "-60s"
+    end = "now"
+  }]
+  notification_list = [{
+    type = "Email"
+    content = "alert content"
+    email_list = ["tf-testacc@example.com"]
+  }]
+}
+`, os.Getenv("ALICLOUD_LOG_PROJECT"), condition, os.Getenv("ALICLOUD_LOG_STORE"))
+}
@@ -0,0 +1,168 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogDashboard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogDashboardCreate,
+		Read:   resourceAlicloudLogDashboardRead,
+		Update: resourceAlicloudLogDashboardUpdate,
+		Delete: resourceAlicloudLogDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// The charts is a JSON array, and each chart in it contains the query of a logstore.
+			"charts": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJsonDocument,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogDashboardCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	project := d.Get("project").(string)
+	dashboard, err := buildLogDashboard(d)
+	if err != nil {
+		return err
+	}
+	if err := client.logconn().Invoke(http.MethodPost, project, "/dashboards", nil, dashboard, nil); err != nil {
+		return WrapError(err, "CreateDashboard", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, dashboard.Name))
+
+	return resourceAlicloudLogDashboardRead(d, meta)
+}
+
+func resourceAlicloudLogDashboardRead(d *schema.ResourceData, meta interface{}) error {
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dashboard, err := meta.(*AliyunClient).DescribeLogDashboard(project, name)
+	if err != nil {
+		if NotFoundError(err, "log") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetDashboard", d.Id())
+	}
+
+	d.Set("project", project)
+	d.Set("name", dashboard.Name)
+	d.Set("display_name", dashboard.DisplayName)
+	d.Set("description", dashboard.Description)
+
+	charts, err := json.Marshal(dashboard.Charts)
+	if err != nil {
+		return err
+	}
+	d.Set("charts", string(charts))
+
+	return nil
+}
+
+func resourceAlicloudLogDashboardUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("display_name") || d.HasChange("description") || d.HasChange("charts") {
+		project, name, err := parseLogResourceId(d.Id())
+		if err != nil {
+			return err
+		}
+		dashboard, err := buildLogDashboard(d)
+		if err != nil {
+			return err
+		}
+		if err := client.logconn().Invoke(http.MethodPut, project, fmt.Sprintf("/dashboards/%s", name), nil, dashboard, nil); err != nil {
+			return WrapError(err, "UpdateDashboard", d.Id())
+		}
+	}
+
+	return resourceAlicloudLogDashboardRead(d, meta)
+}
+
+func resourceAlicloudLogDashboardDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.logconn().Invoke(http.MethodDelete, project, fmt.Sprintf("/dashboards/%s", name), nil, nil, nil); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteDashboard", d.Id()))
+		}
+
+		if _, err := client.DescribeLogDashboard(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Log dashboard %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildLogDashboard(d *schema.ResourceData) (*LogDashboard, error) {
+	dashboard := &LogDashboard{
+		Name:        d.Get("name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+	}
+	if dashboard.DisplayName == "" {
+		dashboard.DisplayName = dashboard.Name
+	}
+	if err := json.Unmarshal([]byte(d.Get("charts").(string)), &dashboard.Charts); err != nil {
+		return nil, fmt.Errorf("Parsing 'charts' got an error: %#v", err)
+	}
+	return dashboard, nil
+}
@@ -0,0 +1,113 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudLogDashboard_basic(t *testing.T) {
+	var v LogDashboard
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithLogStore(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_log_dashboard.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLogDashboardDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogDashboardConfig("tf-testAccLogDashboard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogDashboardExists(
+						"alicloud_log_dashboard.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_dashboard.foo",
+						"display_name",
+						"tf-testAccLogDashboard"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLogDashboardConfig("tf-testAccLogDashboard-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogDashboardExists(
+						"alicloud_log_dashboard.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_dashboard.foo",
+						"display_name",
+						"tf-testAccLogDashboard-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLogDashboardExists(n string, d *LogDashboard) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No log dashboard ID is set")
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		v, err := client.DescribeLogDashboard(project, name)
+		if err != nil {
+			return err
+		}
+
+		*d = *v
+		return nil
+	}
+}
+
+func testAccCheckLogDashboardDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_log_dashboard" {
+			continue
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeLogDashboard(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Log dashboard %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLogDashboardConfig(displayName string) string {
+	return fmt.Sprintf(`
+resource "alicloud_log_dashboard" "foo" {
+  project = "%s"
+  name = "tf-testacc-log-dashboard"
+  display_name = "%s"
+  charts = "[{\"title\":\"count\",\"type\":\"number\",\"search\":{\"logstore\":\"%s\",\"topic\":\"\",\"query\":\"*|select count(1) as count\",\"start\":\"-86400s\",\"end\":\"now\"},\"display\":{\"xAxis\":[],\"yAxis\":[\"count\"],\"xPos\":0,\"yPos\":0,\"width\":5,\"height\":5,\"displayName\":\"count\"}}]"
+}
+`, os.Getenv("ALICLOUD_LOG_PROJECT"), displayName, os.Getenv("ALICLOUD_LOG_STORE"))
+}
@@ -0,0 +1,150 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogMachineGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogMachineGroupCreate,
+		Read:   resourceAlicloudLogMachineGroupRead,
+		Update: resourceAlicloudLogMachineGroupUpdate,
+		Delete: resourceAlicloudLogMachineGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identify_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ip",
+				ValidateFunc: validateAllowedStringValue(LogMachineGroupIdentifyTypes),
+			},
+			"topic": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"identify_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogMachineGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	project := d.Get("project").(string)
+	group := buildLogMachineGroup(d)
+	if err := client.logconn().Invoke(http.MethodPost, project, "/machinegroups", nil, group, nil); err != nil {
+		return WrapError(err, "CreateMachineGroup", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, group.Name))
+
+	return resourceAlicloudLogMachineGroupRead(d, meta)
+}
+
+func resourceAlicloudLogMachineGroupRead(d *schema.ResourceData, meta interface{}) error {
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	group, err := meta.(*AliyunClient).DescribeLogMachineGroup(project, name)
+	if err != nil {
+		if NotFoundError(err, "log") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetMachineGroup", d.Id())
+	}
+
+	d.Set("project", project)
+	d.Set("name", group.Name)
+	d.Set("identify_type", group.IdentifyType)
+	d.Set("topic", group.Attribute.TopicName)
+	if err := d.Set("identify_list", group.MachineIdList); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudLogMachineGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("identify_type") || d.HasChange("topic") || d.HasChange("identify_list") {
+		project, name, err := parseLogResourceId(d.Id())
+		if err != nil {
+			return err
+		}
+		if err := client.logconn().Invoke(http.MethodPut, project, fmt.Sprintf("/machinegroups/%s", name), nil, buildLogMachineGroup(d), nil); err != nil {
+			return WrapError(err, "UpdateMachineGroup", d.Id())
+		}
+	}
+
+	return resourceAlicloudLogMachineGroupRead(d, meta)
+}
+
+func resourceAlicloudLogMachineGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.logconn().Invoke(http.MethodDelete, project, fmt.Sprintf("/machinegroups/%s", name), nil, nil, nil); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteMachineGroup", d.Id()))
+		}
+
+		if _, err := client.DescribeLogMachineGroup(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Machine group %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildLogMachineGroup(d *schema.ResourceData) *LogMachineGroup {
+	return &LogMachineGroup{
+		Name:         d.Get("name").(string),
+		IdentifyType: d.Get("identify_type").(string),
+		Attribute: LogMachineGroupAttribute{
+			TopicName: d.Get("topic").(string),
+		},
+		MachineIdList: expandStringList(d.Get("identify_list").(*schema.Set).List()),
+	}
+}
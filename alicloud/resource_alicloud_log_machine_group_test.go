@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudLogMachineGroup_basic(t *testing.T) {
+	var v LogMachineGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithLogStore(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_log_machine_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLogMachineGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogMachineGroupConfig(`"10.0.0.1", "10.0.0.2"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogMachineGroupExists(
+						"alicloud_log_machine_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_machine_group.foo",
+						"identify_type",
+						"ip"),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_machine_group.foo",
+						"identify_list.#",
+						"2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLogMachineGroupConfig(`"10.0.0.1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogMachineGroupExists(
+						"alicloud_log_machine_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_machine_group.foo",
+						"identify_list.#",
+						"1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLogMachineGroupExists(n string, d *LogMachineGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No machine group ID is set")
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		group, err := client.DescribeLogMachineGroup(project, name)
+		if err != nil {
+			return err
+		}
+
+		*d = *group
+		return nil
+	}
+}
+
+func testAccCheckLogMachineGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_log_machine_group" {
+			continue
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeLogMachineGroup(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Machine group %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLogMachineGroupConfig(identifyList string) string {
+	return fmt.Sprintf(`
+resource "alicloud_log_machine_group" "foo" {
+  project = "%s"
+  name = "tf-testAccLogMachineGroup"
+  topic = "terraform"
+  identify_list = [%s]
+}
+`, os.Getenv("ALICLOUD_LOG_PROJECT"), identifyList)
+}
@@ -0,0 +1,153 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogSavedSearch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogSavedSearchCreate,
+		Read:   resourceAlicloudLogSavedSearchRead,
+		Update: resourceAlicloudLogSavedSearchUpdate,
+		Delete: resourceAlicloudLogSavedSearchDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"logstore": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search_query": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"topic": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogSavedSearchCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	project := d.Get("project").(string)
+	search := buildLogSavedSearch(d)
+	if err := client.logconn().Invoke(http.MethodPost, project, "/savedsearches", nil, search, nil); err != nil {
+		return WrapError(err, "CreateSavedSearch", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, search.Name))
+
+	return resourceAlicloudLogSavedSearchRead(d, meta)
+}
+
+func resourceAlicloudLogSavedSearchRead(d *schema.ResourceData, meta interface{}) error {
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	search, err := meta.(*AliyunClient).DescribeLogSavedSearch(project, name)
+	if err != nil {
+		if NotFoundError(err, "log") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetSavedSearch", d.Id())
+	}
+
+	d.Set("project", project)
+	d.Set("name", search.Name)
+	d.Set("display_name", search.DisplayName)
+	d.Set("logstore", search.LogStore)
+	d.Set("search_query", search.SearchQuery)
+	d.Set("topic", search.Topic)
+
+	return nil
+}
+
+func resourceAlicloudLogSavedSearchUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("display_name") || d.HasChange("logstore") || d.HasChange("search_query") || d.HasChange("topic") {
+		project, name, err := parseLogResourceId(d.Id())
+		if err != nil {
+			return err
+		}
+		if err := client.logconn().Invoke(http.MethodPut, project, fmt.Sprintf("/savedsearches/%s", name), nil, buildLogSavedSearch(d), nil); err != nil {
+			return WrapError(err, "UpdateSavedSearch", d.Id())
+		}
+	}
+
+	return resourceAlicloudLogSavedSearchRead(d, meta)
+}
+
+func resourceAlicloudLogSavedSearchDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.logconn().Invoke(http.MethodDelete, project, fmt.Sprintf("/savedsearches/%s", name), nil, nil, nil); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteSavedSearch", d.Id()))
+		}
+
+		if _, err := client.DescribeLogSavedSearch(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Log saved search %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildLogSavedSearch(d *schema.ResourceData) *LogSavedSearch {
+	search := &LogSavedSearch{
+		Name:        d.Get("name").(string),
+		DisplayName: d.Get("display_name").(string),
+		LogStore:    d.Get("logstore").(string),
+		SearchQuery: d.Get("search_query").(string),
+		Topic:       d.Get("topic").(string),
+	}
+	if search.DisplayName == "" {
+		search.DisplayName = search.Name
+	}
+	return search
+}
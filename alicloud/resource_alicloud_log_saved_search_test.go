@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudLogSavedSearch_basic(t *testing.T) {
+	var v LogSavedSearch
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithLogStore(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_log_saved_search.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLogSavedSearchDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogSavedSearchConfig("tf-testAccLogSavedSearch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogSavedSearchExists(
+						"alicloud_log_saved_search.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_saved_search.foo",
+						"display_name",
+						"tf-testAccLogSavedSearch"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLogSavedSearchConfig("tf-testAccLogSavedSearch-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogSavedSearchExists(
+						"alicloud_log_saved_search.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_log_saved_search.foo",
+						"display_name",
+						"tf-testAccLogSavedSearch-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLogSavedSearchExists(n string, d *LogSavedSearch) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No log saved search ID is set")
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		v, err := client.DescribeLogSavedSearch(project, name)
+		if err != nil {
+			return err
+		}
+
+		*d = *v
+		return nil
+	}
+}
+
+func testAccCheckLogSavedSearchDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_log_saved_search" {
+			continue
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeLogSavedSearch(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Log saved search %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLogSavedSearchConfig(displayName string) string {
+	return fmt.Sprintf(`
+resource "alicloud_log_saved_search" "foo" {
+  project = "%s"
+  name = "tf-testacc-log-saved-search"
+  display_name = "%s"
+  logstore = "%s"
+  search_query = "* | select count(1) as count"
+}
+`, os.Getenv("ALICLOUD_LOG_PROJECT"), displayName, os.Getenv("ALICLOUD_LOG_STORE"))
+}
@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogtailAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogtailAttachmentCreate,
+		Read:   resourceAlicloudLogtailAttachmentRead,
+		Delete: resourceAlicloudLogtailAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"logtail_config_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"machine_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogtailAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	project := d.Get("project").(string)
+	config := d.Get("logtail_config_name").(string)
+	group := d.Get("machine_group_name").(string)
+	if err := client.logconn().Invoke(http.MethodPut, project, fmt.Sprintf("/machinegroups/%s/configs/%s", group, config), nil, nil, nil); err != nil {
+		return WrapError(err, "ApplyConfigToMachineGroup", d.Id())
+	}
+
+	d.SetId(strings.Join([]string{project, config, group}, COLON_SEPARATED))
+
+	return resourceAlicloudLogtailAttachmentRead(d, meta)
+}
+
+func resourceAlicloudLogtailAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	project, config, group, err := parseLogtailAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := meta.(*AliyunClient).DescribeLogtailAttachment(project, config, group); err != nil {
+		if NotFoundError(err, "log") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListAppliedConfigs", d.Id())
+	}
+
+	d.Set("project", project)
+	d.Set("logtail_config_name", config)
+	d.Set("machine_group_name", group)
+
+	return nil
+}
+
+func resourceAlicloudLogtailAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	project, config, group, err := parseLogtailAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.logconn().Invoke(http.MethodDelete, project, fmt.Sprintf("/machinegroups/%s/configs/%s", group, config), nil, nil, nil); err != nil {
+			if NotFoundError(err, "log") ||
+				NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "RemoveConfigFromMachineGroup", d.Id()))
+		}
+
+		if err := client.DescribeLogtailAttachment(project, config, group); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Logtail attachment %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func parseLogtailAttachmentId(id string) (project, config, group string, err error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != 3 {
+		return "", "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid logtail attachment ID %s, expected '<project>:<logtail config name>:<machine group name>'.", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
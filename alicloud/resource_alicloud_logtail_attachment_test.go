@@ -0,0 +1,113 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudLogtailAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithLogStore(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_logtail_attachment.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLogtailAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogtailAttachmentConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogtailAttachmentExists("alicloud_logtail_attachment.foo"),
+					resource.TestCheckResourceAttr(
+						"alicloud_logtail_attachment.foo",
+						"logtail_config_name",
+						"tf-testAccLogtailAttachment"),
+					resource.TestCheckResourceAttr(
+						"alicloud_logtail_attachment.foo",
+						"machine_group_name",
+						"tf-testAccLogtailAttachment"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLogtailAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logtail attachment ID is set")
+		}
+
+		project, config, group, err := parseLogtailAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		return testAccProvider.Meta().(*AliyunClient).DescribeLogtailAttachment(project, config, group)
+	}
+}
+
+func testAccCheckLogtailAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_logtail_attachment" {
+			continue
+		}
+
+		project, config, group, err := parseLogtailAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := client.DescribeLogtailAttachment(project, config, group); err != nil {
+			if NotFoundError(err, "log") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Logtail attachment %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLogtailAttachmentConfig() string {
+	return fmt.Sprintf(`
+variable "project" {
+  default = "%s"
+}
+
+resource "alicloud_log_machine_group" "foo" {
+  project = "${var.project}"
+  name = "tf-testAccLogtailAttachment"
+  identify_list = ["10.0.0.1"]
+}
+
+resource "alicloud_logtail_config" "foo" {
+  project = "${var.project}"
+  logstore = "%s"
+  name = "tf-testAccLogtailAttachment"
+  input_type = "file"
+  input_detail = "{\"logType\":\"common_reg_log\",\"logPath\":\"/var/log\",\"filePattern\":\"*.log\"}"
+}
+
+resource "alicloud_logtail_attachment" "foo" {
+  project = "${var.project}"
+  logtail_config_name = "${alicloud_logtail_config.foo.name}"
+  machine_group_name = "${alicloud_log_machine_group.foo.name}"
+}
+`, os.Getenv("ALICLOUD_LOG_PROJECT"), os.Getenv("ALICLOUD_LOG_STORE"))
+}
@@ -0,0 +1,195 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogtailConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogtailConfigCreate,
+		Read:   resourceAlicloudLogtailConfigRead,
+		Update: resourceAlicloudLogtailConfigUpdate,
+		Delete: resourceAlicloudLogtailConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"logstore": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"input_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(LogtailInputTypes),
+			},
+			"input_detail": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"output_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "LogService",
+				ValidateFunc: validateAllowedStringValue([]string{"LogService"}),
+			},
+			"log_sample": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogtailConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	project := d.Get("project").(string)
+	config, err := buildLogtailConfig(d)
+	if err != nil {
+		return err
+	}
+	if err := client.logconn().Invoke(http.MethodPost, project, "/configs", nil, config, nil); err != nil {
+		return WrapError(err, "CreateConfig", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, config.Name))
+
+	return resourceAlicloudLogtailConfigRead(d, meta)
+}
+
+func resourceAlicloudLogtailConfigRead(d *schema.ResourceData, meta interface{}) error {
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	config, err := meta.(*AliyunClient).DescribeLogtailConfig(project, name)
+	if err != nil {
+		if NotFoundError(err, "log") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetConfig", d.Id())
+	}
+
+	d.Set("project", project)
+	d.Set("name", config.Name)
+	d.Set("logstore", config.OutputDetail.LogStoreName)
+	d.Set("input_type", config.InputType)
+	d.Set("output_type", config.OutputType)
+	d.Set("log_sample", config.LogSample)
+
+	// Log Service fills the default values into the input detail, so only the configured keys are kept
+	// to avoid a diff for the keys which are not set.
+	detail := config.InputDetail
+	configured := make(map[string]interface{})
+	if v, ok := d.GetOk("input_detail"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &configured); err == nil && len(configured) > 0 {
+			detail = make(map[string]interface{})
+			for k := range configured {
+				if value, ok := config.InputDetail[k]; ok {
+					detail[k] = value
+				}
+			}
+		}
+	}
+	inputDetail, err := json.Marshal(detail)
+	if err != nil {
+		return err
+	}
+	d.Set("input_detail", string(inputDetail))
+
+	return nil
+}
+
+func resourceAlicloudLogtailConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("logstore") || d.HasChange("input_type") || d.HasChange("input_detail") ||
+		d.HasChange("output_type") || d.HasChange("log_sample") {
+		project, name, err := parseLogResourceId(d.Id())
+		if err != nil {
+			return err
+		}
+		config, err := buildLogtailConfig(d)
+		if err != nil {
+			return err
+		}
+		if err := client.logconn().Invoke(http.MethodPut, project, fmt.Sprintf("/configs/%s", name), nil, config, nil); err != nil {
+			return WrapError(err, "UpdateConfig", d.Id())
+		}
+	}
+
+	return resourceAlicloudLogtailConfigRead(d, meta)
+}
+
+func resourceAlicloudLogtailConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	project, name, err := parseLogResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.logconn().Invoke(http.MethodDelete, project, fmt.Sprintf("/configs/%s", name), nil, nil, nil); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteConfig", d.Id()))
+		}
+
+		if _, err := client.DescribeLogtailConfig(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Logtail config %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildLogtailConfig(d *schema.ResourceData) (*LogtailConfig, error) {
+	config := &LogtailConfig{
+		Name:      d.Get("name").(string),
+		InputType: d.Get("input_type").(string),
+		OutputDetail: LogtailOutputDetail{
+			LogStoreName: d.Get("logstore").(string),
+		},
+		OutputType: d.Get("output_type").(string),
+		LogSample:  d.Get("log_sample").(string),
+	}
+	if err := json.Unmarshal([]byte(d.Get("input_detail").(string)), &config.InputDetail); err != nil {
+		return nil, fmt.Errorf("Parsing 'input_detail' got an error: %#v", err)
+	}
+	return config, nil
+}
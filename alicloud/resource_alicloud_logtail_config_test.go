@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudLogtailConfig_basic(t *testing.T) {
+	var v LogtailConfig
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithLogStore(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_logtail_config.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLogtailConfigDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogtailConfigConfig("/var/log"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogtailConfigExists(
+						"alicloud_logtail_config.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_logtail_config.foo",
+						"input_type",
+						"file"),
+					resource.TestCheckResourceAttr(
+						"alicloud_logtail_config.foo",
+						"logstore",
+						os.Getenv("ALICLOUD_LOG_STORE")),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLogtailConfigConfig("/var/log/app"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogtailConfigExists(
+						"alicloud_logtail_config.foo", &v),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_logtail_config.foo",
+						"input_detail"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLogtailConfigExists(n string, d *LogtailConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logtail config ID is set")
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		config, err := client.DescribeLogtailConfig(project, name)
+		if err != nil {
+			return err
+		}
+
+		*d = *config
+		return nil
+	}
+}
+
+func testAccCheckLogtailConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_logtail_config" {
+			continue
+		}
+
+		project, name, err := parseLogResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeLogtailConfig(project, name); err != nil {
+			if NotFoundError(err, "log") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Logtail config %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLogtailConfigConfig(logPath string) string {
+	return fmt.Sprintf(`
+resource "alicloud_logtail_config" "foo" {
+  project = "%s"
+  logstore = "%s"
+  name = "tf-testAccLogtailConfig"
+  input_type = "file"
+  input_detail = "{\"logType\":\"common_reg_log\",\"logPath\":\"%s\",\"filePattern\":\"*.log\",\"topicFormat\":\"none\"}"
+}
+`, os.Getenv("ALICLOUD_LOG_PROJECT"), os.Getenv("ALICLOUD_LOG_STORE"), logPath)
+}
@@ -0,0 +1,246 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudMemcacheInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMemcacheInstanceCreate,
+		Read:   resourceAlicloudMemcacheInstanceRead,
+		Update: resourceAlicloudMemcacheInstanceUpdate,
+		Delete: resourceAlicloudMemcacheInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceName,
+				Optional:     true,
+				Computed:     true,
+			},
+			"capacity": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue(OcsCapacities),
+				Required:     true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"security_ips": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"connection_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"private_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudMemcacheInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CreateOcsInstanceArgs{
+		RegionId:     client.Region,
+		ZoneId:       d.Get("zone_id").(string),
+		InstanceName: d.Get("instance_name").(string),
+		Capacity:     d.Get("capacity").(int),
+		Password:     d.Get("password").(string),
+		NetworkType:  "CLASSIC",
+	}
+
+	if vswitchId := d.Get("vswitch_id").(string); vswitchId != "" {
+		vpcId, zoneId, err := client.DescribeVSwitchPlacement(vswitchId, args.ZoneId)
+		if err != nil {
+			return err
+		}
+		args.ZoneId = zoneId
+		args.NetworkType = "VPC"
+		args.VpcId = vpcId
+		args.VSwitchId = vswitchId
+	}
+
+	resp := CreateOcsInstanceResponse{}
+	if err := client.ocsconn().Invoke("CreateInstance", &args, &resp); err != nil {
+		return fmt.Errorf("Error creating Alicloud memcache instance: %#v", err)
+	}
+
+	if resp.Instance.InstanceId == "" {
+		return fmt.Errorf("Error get Alicloud memcache instance id")
+	}
+	d.SetId(resp.Instance.InstanceId)
+
+	if err := client.WaitForOcsInstance(d.Id(), OcsNormal, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForOcsInstance %s got an error: %#v", OcsNormal, err)
+	}
+
+	return resourceAlicloudMemcacheInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudMemcacheInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if (d.HasChange("instance_name") || d.HasChange("password")) && !d.IsNewResource() {
+		args := ModifyOcsInstanceAttributeArgs{
+			RegionId:   client.Region,
+			InstanceId: d.Id(),
+		}
+		if d.HasChange("instance_name") {
+			args.InstanceName = d.Get("instance_name").(string)
+		}
+		if d.HasChange("password") {
+			args.NewPassword = d.Get("password").(string)
+		}
+		if err := client.ocsconn().Invoke("ModifyInstanceAttribute", &args, &OcsResponse{}); err != nil {
+			return WrapError(err, "ModifyInstanceAttribute", d.Id())
+		}
+		d.SetPartial("instance_name")
+		d.SetPartial("password")
+	}
+
+	if d.HasChange("capacity") && !d.IsNewResource() {
+		args := ModifyOcsInstanceCapacityArgs{
+			RegionId:   client.Region,
+			InstanceId: d.Id(),
+			Capacity:   d.Get("capacity").(int),
+		}
+		if err := client.ocsconn().Invoke("ModifyInstanceCapacity", &args, &OcsResponse{}); err != nil {
+			return WrapError(err, "ModifyInstanceCapacity", d.Id())
+		}
+		if err := client.WaitForOcsInstance(d.Id(), OcsNormal, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForOcsInstance %s got an error: %#v", OcsNormal, err)
+		}
+		d.SetPartial("capacity")
+	}
+
+	if d.HasChange("security_ips") {
+		o, n := d.GetChange("security_ips")
+		remove := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		add := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		for _, ip := range remove {
+			args := OcsAuthenticIPArgs{
+				RegionId:    client.Region,
+				InstanceId:  d.Id(),
+				AuthenticIP: ip,
+			}
+			if err := client.ocsconn().Invoke("RemoveAuthenticIP", &args, &OcsResponse{}); err != nil {
+				return fmt.Errorf("RemoveAuthenticIP %s got an error: %#v", ip, err)
+			}
+		}
+
+		for _, ip := range add {
+			args := OcsAuthenticIPArgs{
+				RegionId:    client.Region,
+				InstanceId:  d.Id(),
+				AuthenticIP: ip,
+			}
+			if err := client.ocsconn().Invoke("AddAuthenticIP", &args, &OcsResponse{}); err != nil {
+				return fmt.Errorf("AddAuthenticIP %s got an error: %#v", ip, err)
+			}
+		}
+		d.SetPartial("security_ips")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudMemcacheInstanceRead(d, meta)
+}
+
+func resourceAlicloudMemcacheInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	instance, err := client.DescribeOcsInstanceById(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ocs") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe memcache instance: %#v", err)
+	}
+
+	d.Set("instance_name", instance.InstanceName)
+	d.Set("capacity", instance.Capacity)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VSwitchId)
+	d.Set("connection_domain", instance.ConnectionDomain)
+	d.Set("port", instance.Port)
+	d.Set("private_ip", instance.PrivateIpAddress)
+
+	ips, err := client.DescribeOcsAuthenticIPs(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeAuthenticIP", d.Id())
+	}
+	if err := d.Set("security_ips", ips); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudMemcacheInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := OcsInstanceArgs{
+		RegionId:   client.Region,
+		InstanceId: d.Id(),
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.ocsconn().Invoke("DeleteInstance", &args, &OcsResponse{}); err != nil {
+			if NotFoundError(err, "ocs") {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Memcache instance in use - trying again while it is deleted."))
+		}
+
+		if _, err := client.DescribeOcsInstanceById(d.Id()); err != nil {
+			if NotFoundError(err, "ocs") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Memcache instance in use - trying again while it is deleted."))
+	})
+}
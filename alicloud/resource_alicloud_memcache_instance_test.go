@@ -0,0 +1,130 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudMemcacheInstance_vpc(t *testing.T) {
+	var instance OcsInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_memcache_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMemcacheInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMemcacheInstance_vpc(1024, `["10.0.0.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemcacheInstanceExists(
+						"alicloud_memcache_instance.foo", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_memcache_instance.foo",
+						"capacity",
+						"1024"),
+					resource.TestCheckResourceAttr(
+						"alicloud_memcache_instance.foo",
+						"security_ips.#",
+						"1"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_memcache_instance.foo",
+						"connection_domain"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMemcacheInstance_vpc(2048, `["10.0.0.1", "10.0.0.2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemcacheInstanceExists(
+						"alicloud_memcache_instance.foo", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_memcache_instance.foo",
+						"capacity",
+						"2048"),
+					resource.TestCheckResourceAttr(
+						"alicloud_memcache_instance.foo",
+						"security_ips.#",
+						"2"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMemcacheInstanceExists(n string, instance *OcsInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No memcache instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		attr, err := client.DescribeOcsInstanceById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*instance = *attr
+		return nil
+	}
+}
+
+func testAccCheckMemcacheInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_memcache_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeOcsInstanceById(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "ocs") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Memcache instance %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMemcacheInstance_vpc(capacity int, ips string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_memcache_instance" "foo" {
+	instance_name = "tf-test-memcache"
+	capacity = %d
+	password = "Test12345"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	security_ips = %s
+}
+`, capacity, ips)
+}
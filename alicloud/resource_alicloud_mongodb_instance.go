@@ -0,0 +1,277 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/rds"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudMongoDBInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMongoDBInstanceCreate,
+		Read:   resourceAlicloudMongoDBInstanceRead,
+		Update: resourceAlicloudMongoDBInstanceUpdate,
+		Delete: resourceAlicloudMongoDBInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine_version": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(MongoDBEngineVersions),
+				ForceNew:     true,
+				Required:     true,
+			},
+			"db_instance_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"db_instance_storage": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateIntegerInRange(10, 2000),
+				Required:     true,
+			},
+			"replication_factor": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue([]int{3, 5, 7}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      3,
+			},
+			"storage_engine": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(MongoDBStorageEngines),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "WiredTiger",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceName,
+				Optional:     true,
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"PostPaid", "PrePaid"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "PostPaid",
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"account_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"security_ip_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"backup_period": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"backup_time": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(rds.BACKUP_TIME),
+				Optional:     true,
+				Computed:     true,
+			},
+			"replica_set_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudMongoDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args, err := buildMongoDBCreateArgs(d, meta)
+	if err != nil {
+		return err
+	}
+
+	resp := CreateMongoDBInstanceResponse{}
+	if err := client.mongodbconn().Invoke("CreateDBInstance", args, &resp); err != nil {
+		return fmt.Errorf("Error creating Alicloud MongoDB instance: %#v", err)
+	}
+
+	if resp.DBInstanceId == "" {
+		return fmt.Errorf("Error get Alicloud MongoDB instance id")
+	}
+	d.SetId(resp.DBInstanceId)
+
+	if err := client.WaitForMongoDBInstance(d.Id(), MongoDBRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForMongoDBInstance %s got an error: %#v", MongoDBRunning, err)
+	}
+
+	return resourceAlicloudMongoDBInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudMongoDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if d.HasChange("name") && !d.IsNewResource() {
+		if err := modifyMongoDBDescription(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("security_ip_list") && !d.IsNewResource() {
+		if err := modifyMongoDBSecurityIps(d, meta, deadline); err != nil {
+			return err
+		}
+		d.SetPartial("security_ip_list")
+	}
+
+	if d.HasChange("backup_time") || d.HasChange("backup_period") {
+		if err := modifyMongoDBBackupPolicy(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("backup_time")
+		d.SetPartial("backup_period")
+	}
+
+	if d.HasChange("account_password") && !d.IsNewResource() {
+		if err := resetMongoDBAccountPassword(d, meta, deadline); err != nil {
+			return err
+		}
+		d.SetPartial("account_password")
+	}
+
+	if (d.HasChange("db_instance_class") || d.HasChange("db_instance_storage")) && !d.IsNewResource() {
+		if d.Get("instance_charge_type").(string) == "PrePaid" {
+			return fmt.Errorf("Prepaid MongoDB instance does not support modify db_instance_class or db_instance_storage")
+		}
+
+		args := ModifyMongoDBSpecArgs{
+			RegionId:          client.Region,
+			DBInstanceId:      d.Id(),
+			DBInstanceClass:   d.Get("db_instance_class").(string),
+			DBInstanceStorage: strconv.Itoa(d.Get("db_instance_storage").(int)),
+		}
+		if err := client.mongodbconn().Invoke("ModifyDBInstanceSpec", &args, &MongoDBResponse{}); err != nil {
+			return WrapError(err, "ModifyDBInstanceSpec", d.Id())
+		}
+
+		if err := client.WaitForMongoDBInstance(d.Id(), MongoDBRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForMongoDBInstance %s got an error: %#v", MongoDBRunning, err)
+		}
+		d.SetPartial("db_instance_class")
+		d.SetPartial("db_instance_storage")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudMongoDBInstanceRead(d, meta)
+}
+
+func resourceAlicloudMongoDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeMongoDBInstanceById(d.Id())
+	if err != nil {
+		if NotFoundError(err, "mongodb") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe MongoDB Instance Attribute: %#v", err)
+	}
+
+	d.Set("engine_version", instance.EngineVersion)
+	d.Set("db_instance_class", instance.DBInstanceClass)
+	d.Set("db_instance_storage", instance.DBInstanceStorage)
+	d.Set("storage_engine", instance.StorageEngine)
+	d.Set("name", instance.DBInstanceDescription)
+	d.Set("instance_charge_type", instance.ChargeType)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VSwitchId)
+	d.Set("replica_set_name", instance.ReplicaSetName)
+	if factor, err := strconv.Atoi(instance.ReplicationFactor); err == nil {
+		d.Set("replication_factor", factor)
+	}
+
+	return readMongoDBSecurityAndBackup(d, meta)
+}
+
+func resourceAlicloudMongoDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteMongoDBInstance(d, meta)
+}
+
+func buildMongoDBCreateArgs(d *schema.ResourceData, meta interface{}) (*CreateMongoDBInstanceArgs, error) {
+	client := meta.(*AliyunClient)
+
+	args := &CreateMongoDBInstanceArgs{
+		RegionId:              client.Region,
+		Engine:                MongoDBEngine,
+		EngineVersion:         d.Get("engine_version").(string),
+		DBInstanceClass:       d.Get("db_instance_class").(string),
+		DBInstanceStorage:     d.Get("db_instance_storage").(int),
+		DBInstanceDescription: d.Get("name").(string),
+		ReplicationFactor:     strconv.Itoa(d.Get("replication_factor").(int)),
+		StorageEngine:         d.Get("storage_engine").(string),
+		AccountPassword:       d.Get("account_password").(string),
+		ChargeType:            d.Get("instance_charge_type").(string),
+	}
+
+	if args.ChargeType == "PrePaid" {
+		args.Period = d.Get("period").(int)
+		if args.Period == 0 {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'period' is required when 'instance_charge_type' is PrePaid")
+		}
+	}
+
+	ips := expandStringList(d.Get("security_ip_list").(*schema.Set).List())
+	if len(ips) > 0 {
+		args.SecurityIPList = strings.Join(ips, COMMA_SEPARATED)
+	}
+
+	vpcId, zoneId, err := buildMongoDBNetworkArgs(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	args.ZoneId = zoneId
+	if vpcId != "" {
+		args.NetworkType = "VPC"
+		args.VpcId = vpcId
+		args.VSwitchId = d.Get("vswitch_id").(string)
+	} else {
+		args.NetworkType = "Classic"
+	}
+
+	return args, nil
+}
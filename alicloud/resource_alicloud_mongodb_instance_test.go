@@ -0,0 +1,222 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	addTestSweepers("alicloud_mongodb_instance", &testSweeper{
+		F: testSweepMongoDBInstances,
+	})
+}
+
+func testSweepMongoDBInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.mongodbconn()
+
+	var ids []string
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		args := DescribeMongoDBInstancesArgs{
+			RegionId:   client.Region,
+			PageNumber: pagination.PageNumber,
+			PageSize:   pagination.PageSize,
+		}
+		resp := DescribeMongoDBInstancesResponse{}
+		if err := conn.Invoke("DescribeDBInstances", &args, &resp); err != nil {
+			return 0, WrapError(err, "DescribeDBInstances", "")
+		}
+		for _, instance := range resp.DBInstances.DBInstance {
+			if !isSweepable(instance.DBInstanceDescription) {
+				log.Printf("[INFO] Skipping MongoDB instance %s (%s)", instance.DBInstanceDescription, instance.DBInstanceId)
+				continue
+			}
+			ids = append(ids, instance.DBInstanceId)
+		}
+		return len(resp.DBInstances.DBInstance), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting MongoDB instance %s", id)
+		args := MongoDBInstanceArgs{
+			RegionId:     client.Region,
+			DBInstanceId: id,
+		}
+		if err := conn.Invoke("DeleteDBInstance", &args, &MongoDBResponse{}); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteDBInstance", id))
+		}
+	}
+	return nil
+}
+
+func TestAccAlicloudMongoDBInstance_vpc(t *testing.T) {
+	var instance MongoDBInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_mongodb_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoDBInstance_vpc,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBInstanceExists(
+						"alicloud_mongodb_instance.foo", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_instance.foo",
+						"engine_version",
+						"3.4"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_instance.foo",
+						"db_instance_storage",
+						"10"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_instance.foo",
+						"security_ip_list.#",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_instance.foo",
+						"backup_time",
+						"02:00Z-03:00Z"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMongoDBInstance_vpc_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBInstanceExists(
+						"alicloud_mongodb_instance.foo", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_instance.foo",
+						"db_instance_storage",
+						"20"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_instance.foo",
+						"name",
+						"tf-test-mongodb-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_instance.foo",
+						"security_ip_list.#",
+						"1"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoDBInstanceExists(n string, instance *MongoDBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MongoDB Instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		attr, err := client.DescribeMongoDBInstanceById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*instance = *attr
+		return nil
+	}
+}
+
+func testAccCheckMongoDBInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_mongodb_instance" && rs.Type != "alicloud_mongodb_sharding_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeMongoDBInstanceById(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "mongodb") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("MongoDB instance %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccMongoDBInstance_vpc = `
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_mongodb_instance" "foo" {
+	engine_version = "3.4"
+	db_instance_class = "dds.mongo.mid"
+	db_instance_storage = 10
+	name = "tf-test-mongodb"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	account_password = "Test12345"
+	security_ip_list = ["10.168.1.12", "100.69.7.112"]
+	backup_time = "02:00Z-03:00Z"
+	backup_period = ["Monday", "Wednesday"]
+}
+`
+
+const testAccMongoDBInstance_vpc_update = `
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_mongodb_instance" "foo" {
+	engine_version = "3.4"
+	db_instance_class = "dds.mongo.mid"
+	db_instance_storage = 20
+	name = "tf-test-mongodb-update"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	account_password = "Test123456"
+	security_ip_list = ["10.168.1.12"]
+	backup_time = "02:00Z-03:00Z"
+	backup_period = ["Monday", "Wednesday"]
+}
+`
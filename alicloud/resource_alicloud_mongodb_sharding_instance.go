@@ -0,0 +1,396 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/rds"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudMongoDBShardingInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMongoDBShardingInstanceCreate,
+		Read:   resourceAlicloudMongoDBShardingInstanceRead,
+		Update: resourceAlicloudMongoDBShardingInstanceUpdate,
+		Delete: resourceAlicloudMongoDBShardingInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine_version": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(MongoDBEngineVersions),
+				ForceNew:     true,
+				Required:     true,
+			},
+			"storage_engine": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(MongoDBStorageEngines),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "WiredTiger",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceName,
+				Optional:     true,
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"PostPaid", "PrePaid"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "PostPaid",
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"account_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"security_ip_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"backup_period": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"backup_time": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(rds.BACKUP_TIME),
+				Optional:     true,
+				Computed:     true,
+			},
+			"mongo_list": &schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_class": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"node_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connect_string": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+				Required: true,
+				MinItems: 2,
+				MaxItems: 32,
+			},
+			"shard_list": &schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_class": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"node_storage": &schema.Schema{
+							Type:         schema.TypeInt,
+							ValidateFunc: validateIntegerInRange(10, 2000),
+							Required:     true,
+						},
+						"node_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Required: true,
+				MinItems: 2,
+				MaxItems: 32,
+			},
+		},
+	}
+}
+
+func resourceAlicloudMongoDBShardingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args, err := buildMongoDBShardingCreateArgs(d, meta)
+	if err != nil {
+		return err
+	}
+
+	resp := CreateMongoDBInstanceResponse{}
+	if err := client.mongodbconn().Invoke("CreateShardingDBInstance", args, &resp); err != nil {
+		return fmt.Errorf("Error creating Alicloud MongoDB sharding instance: %#v", err)
+	}
+
+	if resp.DBInstanceId == "" {
+		return fmt.Errorf("Error get Alicloud MongoDB sharding instance id")
+	}
+	d.SetId(resp.DBInstanceId)
+
+	if err := client.WaitForMongoDBInstance(d.Id(), MongoDBRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForMongoDBInstance %s got an error: %#v", MongoDBRunning, err)
+	}
+
+	return resourceAlicloudMongoDBShardingInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudMongoDBShardingInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if d.HasChange("name") && !d.IsNewResource() {
+		if err := modifyMongoDBDescription(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("security_ip_list") && !d.IsNewResource() {
+		if err := modifyMongoDBSecurityIps(d, meta, deadline); err != nil {
+			return err
+		}
+		d.SetPartial("security_ip_list")
+	}
+
+	if d.HasChange("backup_time") || d.HasChange("backup_period") {
+		if err := modifyMongoDBBackupPolicy(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("backup_time")
+		d.SetPartial("backup_period")
+	}
+
+	if d.HasChange("account_password") && !d.IsNewResource() {
+		if err := resetMongoDBAccountPassword(d, meta, deadline); err != nil {
+			return err
+		}
+		d.SetPartial("account_password")
+	}
+
+	if d.HasChange("mongo_list") && !d.IsNewResource() {
+		if err := modifyMongoDBShardingNodes(d, meta, "mongo_list", MongoDBNodeMongos, deadline); err != nil {
+			return err
+		}
+		d.SetPartial("mongo_list")
+	}
+
+	if d.HasChange("shard_list") && !d.IsNewResource() {
+		if err := modifyMongoDBShardingNodes(d, meta, "shard_list", MongoDBNodeShard, deadline); err != nil {
+			return err
+		}
+		d.SetPartial("shard_list")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudMongoDBShardingInstanceRead(d, meta)
+}
+
+func resourceAlicloudMongoDBShardingInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeMongoDBInstanceById(d.Id())
+	if err != nil {
+		if NotFoundError(err, "mongodb") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe MongoDB Instance Attribute: %#v", err)
+	}
+
+	d.Set("engine_version", instance.EngineVersion)
+	d.Set("storage_engine", instance.StorageEngine)
+	d.Set("name", instance.DBInstanceDescription)
+	d.Set("instance_charge_type", instance.ChargeType)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VSwitchId)
+
+	var mongos []map[string]interface{}
+	for _, m := range instance.MongosList.MongosAttribute {
+		mongos = append(mongos, map[string]interface{}{
+			"node_class":     m.NodeClass,
+			"node_id":        m.NodeId,
+			"connect_string": m.ConnectSting,
+			"port":           m.Port,
+		})
+	}
+	if err := d.Set("mongo_list", mongos); err != nil {
+		return err
+	}
+
+	var shards []map[string]interface{}
+	for _, s := range instance.ShardList.ShardAttribute {
+		shards = append(shards, map[string]interface{}{
+			"node_class":   s.NodeClass,
+			"node_storage": s.NodeStorage,
+			"node_id":      s.NodeId,
+		})
+	}
+	if err := d.Set("shard_list", shards); err != nil {
+		return err
+	}
+
+	return readMongoDBSecurityAndBackup(d, meta)
+}
+
+func resourceAlicloudMongoDBShardingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteMongoDBInstance(d, meta)
+}
+
+// modifyMongoDBShardingNodes compares the node list by position: the existing nodes are modified in place,
+// the extra new ones are created and the removed tail nodes are deleted.
+func modifyMongoDBShardingNodes(d *schema.ResourceData, meta interface{}, key string, nodeType MongoDBNodeType, deadline operationDeadline) error {
+	client := meta.(*AliyunClient)
+
+	o, n := d.GetChange(key)
+	oldNodes := o.([]interface{})
+	newNodes := n.([]interface{})
+
+	for i, raw := range newNodes {
+		node := raw.(map[string]interface{})
+		storage := 0
+		if nodeType == MongoDBNodeShard {
+			storage = node["node_storage"].(int)
+		}
+
+		if i >= len(oldNodes) {
+			args := CreateMongoDBNodeArgs{
+				RegionId:     client.Region,
+				DBInstanceId: d.Id(),
+				NodeType:     nodeType,
+				NodeClass:    node["node_class"].(string),
+				NodeStorage:  storage,
+			}
+			if err := client.mongodbconn().Invoke("CreateNode", &args, &MongoDBResponse{}); err != nil {
+				return WrapError(err, "CreateNode", d.Id())
+			}
+		} else {
+			oldNode := oldNodes[i].(map[string]interface{})
+			if oldNode["node_class"].(string) == node["node_class"].(string) &&
+				(nodeType == MongoDBNodeMongos || oldNode["node_storage"].(int) == storage) {
+				continue
+			}
+			args := ModifyMongoDBNodeSpecArgs{
+				RegionId:     client.Region,
+				DBInstanceId: d.Id(),
+				NodeId:       oldNode["node_id"].(string),
+				NodeClass:    node["node_class"].(string),
+				NodeStorage:  storage,
+			}
+			if err := client.mongodbconn().Invoke("ModifyNodeSpec", &args, &MongoDBResponse{}); err != nil {
+				return fmt.Errorf("ModifyNodeSpec %s got an error: %#v", args.NodeId, err)
+			}
+		}
+
+		if err := client.WaitForMongoDBInstance(d.Id(), MongoDBRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForMongoDBInstance %s got an error: %#v", MongoDBRunning, err)
+		}
+	}
+
+	for i := len(newNodes); i < len(oldNodes); i++ {
+		oldNode := oldNodes[i].(map[string]interface{})
+		args := DeleteMongoDBNodeArgs{
+			RegionId:     client.Region,
+			DBInstanceId: d.Id(),
+			NodeId:       oldNode["node_id"].(string),
+		}
+		if err := client.mongodbconn().Invoke("DeleteNode", &args, &MongoDBResponse{}); err != nil {
+			return fmt.Errorf("DeleteNode %s got an error: %#v", args.NodeId, err)
+		}
+
+		if err := client.WaitForMongoDBInstance(d.Id(), MongoDBRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForMongoDBInstance %s got an error: %#v", MongoDBRunning, err)
+		}
+	}
+
+	return nil
+}
+
+func buildMongoDBShardingCreateArgs(d *schema.ResourceData, meta interface{}) (*CreateMongoDBShardingInstanceArgs, error) {
+	client := meta.(*AliyunClient)
+
+	args := &CreateMongoDBShardingInstanceArgs{
+		RegionId:              client.Region,
+		Engine:                MongoDBEngine,
+		EngineVersion:         d.Get("engine_version").(string),
+		DBInstanceDescription: d.Get("name").(string),
+		StorageEngine:         d.Get("storage_engine").(string),
+		AccountPassword:       d.Get("account_password").(string),
+		ChargeType:            d.Get("instance_charge_type").(string),
+	}
+
+	if args.ChargeType == "PrePaid" {
+		args.Period = d.Get("period").(int)
+		if args.Period == 0 {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'period' is required when 'instance_charge_type' is PrePaid")
+		}
+	}
+
+	for _, raw := range d.Get("mongo_list").([]interface{}) {
+		node := raw.(map[string]interface{})
+		args.Mongos = append(args.Mongos, MongoDBNodeArgs{
+			Class: node["node_class"].(string),
+		})
+	}
+
+	for _, raw := range d.Get("shard_list").([]interface{}) {
+		node := raw.(map[string]interface{})
+		args.ReplicaSet = append(args.ReplicaSet, MongoDBNodeArgs{
+			Class:   node["node_class"].(string),
+			Storage: node["node_storage"].(int),
+		})
+	}
+
+	ips := expandStringList(d.Get("security_ip_list").(*schema.Set).List())
+	if len(ips) > 0 {
+		args.SecurityIPList = strings.Join(ips, COMMA_SEPARATED)
+	}
+
+	vpcId, zoneId, err := buildMongoDBNetworkArgs(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	args.ZoneId = zoneId
+	if vpcId != "" {
+		args.NetworkType = "VPC"
+		args.VpcId = vpcId
+		args.VSwitchId = d.Get("vswitch_id").(string)
+	} else {
+		args.NetworkType = "Classic"
+	}
+
+	return args, nil
+}
@@ -0,0 +1,141 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudMongoDBShardingInstance_vpc(t *testing.T) {
+	var instance MongoDBInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_mongodb_sharding_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoDBShardingInstance_vpc,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBInstanceExists(
+						"alicloud_mongodb_sharding_instance.foo", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_sharding_instance.foo",
+						"mongo_list.#",
+						"2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_sharding_instance.foo",
+						"shard_list.#",
+						"2"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_mongodb_sharding_instance.foo",
+						"mongo_list.0.node_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMongoDBShardingInstance_vpc_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBInstanceExists(
+						"alicloud_mongodb_sharding_instance.foo", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_sharding_instance.foo",
+						"shard_list.#",
+						"3"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mongodb_sharding_instance.foo",
+						"shard_list.0.node_storage",
+						"20"),
+				),
+			},
+		},
+	})
+
+}
+
+const testAccMongoDBShardingInstance_vpc = `
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_mongodb_sharding_instance" "foo" {
+	engine_version = "3.4"
+	name = "tf-test-mongodb-sharding"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	account_password = "Test12345"
+	security_ip_list = ["10.168.1.12"]
+	mongo_list = [
+		{ node_class = "dds.mongos.mid" },
+		{ node_class = "dds.mongos.mid" },
+	]
+	shard_list = [
+		{
+			node_class = "dds.shard.mid"
+			node_storage = 10
+		},
+		{
+			node_class = "dds.shard.mid"
+			node_storage = 10
+		},
+	]
+}
+`
+
+const testAccMongoDBShardingInstance_vpc_update = `
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_mongodb_sharding_instance" "foo" {
+	engine_version = "3.4"
+	name = "tf-test-mongodb-sharding"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	account_password = "Test12345"
+	security_ip_list = ["10.168.1.12"]
+	mongo_list = [
+		{ node_class = "dds.mongos.mid" },
+		{ node_class = "dds.mongos.mid" },
+	]
+	shard_list = [
+		{
+			node_class = "dds.shard.mid"
+			node_storage = 20
+		},
+		{
+			node_class = "dds.shard.mid"
+			node_storage = 10
+		},
+		{
+			node_class = "dds.shard.mid"
+			node_storage = 10
+		},
+	]
+}
+`
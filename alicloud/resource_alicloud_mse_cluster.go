@@ -0,0 +1,258 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudMseCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMseClusterCreate,
+		Read:   resourceAlicloudMseClusterRead,
+		Update: resourceAlicloudMseClusterUpdate,
+		Delete: resourceAlicloudMseClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{MseClusterTypeNacos, MseClusterTypeZooKeeper}),
+			},
+			// The cluster_version is the engine version of the cluster type, like NACOS_ANS_2_0_3 and
+			// ZooKeeper_3_8_0.
+			"cluster_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"mse_version": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "mse_basic",
+				ValidateFunc: validateAllowedStringValue([]string{"mse_dev", "mse_basic", "mse_pro"}),
+			},
+			"net_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "privatenet",
+				ValidateFunc: validateAllowedStringValue([]string{"privatenet", "pubnet"}),
+			},
+			// The pub_network_flow is the bandwidth in Mbps of the public network, and 0 means that the cluster
+			// can not be accessed through the public network.
+			"pub_network_flow": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  0,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"private_slb_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pub_slb_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"cluster_alias_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"acl_entry_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"intranet_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"internet_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"app_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudMseClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CreateMseClusterArgs{
+		Region:                  string(client.Region),
+		ClusterType:             d.Get("cluster_type").(string),
+		ClusterVersion:          d.Get("cluster_version").(string),
+		ClusterSpecification:    d.Get("cluster_specification").(string),
+		InstanceCount:           d.Get("instance_count").(int),
+		MseVersion:              d.Get("mse_version").(string),
+		NetType:                 d.Get("net_type").(string),
+		PubNetworkFlow:          strconv.Itoa(d.Get("pub_network_flow").(int)),
+		VSwitchId:               d.Get("vswitch_id").(string),
+		PrivateSlbSpecification: d.Get("private_slb_specification").(string),
+		PubSlbSpecification:     d.Get("pub_slb_specification").(string),
+	}
+	if args.PrivateSlbSpecification != "" || args.PubSlbSpecification != "" {
+		args.ConnectionType = "slb"
+	}
+	resp := CreateMseClusterResponse{}
+	if err := client.mseconn().Invoke("CreateCluster", &args, &resp); err != nil {
+		return WrapError(err, "CreateCluster", d.Id())
+	}
+	if !resp.Success {
+		return fmt.Errorf("CreateCluster got an error: %s", resp.Message)
+	}
+
+	d.SetId(resp.InstanceId)
+
+	if err := client.WaitForMseCluster(d.Id(), deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForMseCluster", d.Id())
+	}
+
+	return resourceAlicloudMseClusterUpdate(d, meta)
+}
+
+func resourceAlicloudMseClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	cluster, err := client.DescribeMseCluster(d.Id())
+	if err != nil {
+		if NotFoundError(err, "mse") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "QueryClusterDetail", d.Id())
+	}
+
+	d.Set("cluster_type", cluster.ClusterType)
+	d.Set("cluster_version", cluster.ClusterVersion)
+	d.Set("cluster_specification", cluster.ClusterSpecification)
+	d.Set("instance_count", cluster.InstanceCount)
+	d.Set("mse_version", cluster.MseVersion)
+	d.Set("net_type", cluster.NetType)
+	d.Set("vswitch_id", cluster.VSwitchId)
+	d.Set("cluster_alias_name", cluster.ClusterAliasName)
+	d.Set("vpc_id", cluster.VpcId)
+	d.Set("intranet_domain", cluster.IntranetDomain)
+	d.Set("internet_domain", cluster.InternetDomain)
+	d.Set("app_version", cluster.AppVersion)
+	d.Set("status", cluster.InitStatus)
+	if flow, err := strconv.Atoi(cluster.PubNetworkFlow); err == nil {
+		d.Set("pub_network_flow", flow)
+	}
+
+	acls, err := client.DescribeMseClusterAcl(d.Id())
+	if err != nil {
+		return WrapError(err, "QueryClusterAcl", d.Id())
+	}
+	if err := d.Set("acl_entry_list", acls); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	if v, ok := d.GetOk("cluster_alias_name"); ok && d.HasChange("cluster_alias_name") {
+		args := UpdateMseClusterArgs{
+			InstanceId:       d.Id(),
+			ClusterAliasName: v.(string),
+		}
+		if err := client.mseconn().Invoke("UpdateCluster", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateCluster", d.Id())
+		}
+		d.SetPartial("cluster_alias_name")
+	}
+
+	if d.HasChange("acl_entry_list") {
+		// UpdateAcl overwrites the whitelist, and the empty one removes all the entries.
+		args := UpdateMseAclArgs{
+			InstanceId:   d.Id(),
+			AclEntryList: strings.Join(expandStringList(d.Get("acl_entry_list").(*schema.Set).List()), ","),
+		}
+		if err := client.mseconn().Invoke("UpdateAcl", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateAcl", d.Id())
+		}
+		d.SetPartial("acl_entry_list")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudMseClusterRead(d, meta)
+}
+
+func resourceAlicloudMseClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := MseClusterArgs{
+		InstanceId: d.Id(),
+	}
+	if err := client.mseconn().Invoke("DeleteCluster", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "mse") {
+			return nil
+		}
+		return WrapError(err, "DeleteCluster", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeMseCluster(d.Id()); err != nil {
+			if NotFoundError(err, "mse") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "QueryClusterDetail", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("MSE cluster %s is still being deleted", d.Id()))
+	})
+}
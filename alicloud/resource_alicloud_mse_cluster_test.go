@@ -0,0 +1,133 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudMseCluster_basic(t *testing.T) {
+	var v MseCluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_mse_cluster.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMseClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMseClusterConfig("tf-testAccMseCluster", `["127.0.0.1/32"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMseClusterExists(
+						"alicloud_mse_cluster.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_mse_cluster.foo",
+						"cluster_alias_name",
+						"tf-testAccMseCluster"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mse_cluster.foo",
+						"acl_entry_list.#",
+						"1"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_mse_cluster.foo",
+						"intranet_domain"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMseClusterConfig("tf-testAccMseCluster-update", `["127.0.0.1/32", "10.0.0.0/8"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMseClusterExists(
+						"alicloud_mse_cluster.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_mse_cluster.foo",
+						"cluster_alias_name",
+						"tf-testAccMseCluster-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mse_cluster.foo",
+						"acl_entry_list.#",
+						"2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMseClusterExists(n string, d *MseCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSE cluster ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		cluster, err := client.DescribeMseCluster(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *cluster
+		return nil
+	}
+}
+
+func testAccCheckMseClusterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_mse_cluster" {
+			continue
+		}
+
+		if _, err := client.DescribeMseCluster(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "mse") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("MSE cluster %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMseClusterConfig(name, acls string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+  "available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf-testAccMseCluster"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_mse_cluster" "foo" {
+  cluster_type = "Nacos-Ans"
+  cluster_version = "NACOS_ANS_2_0_3"
+  cluster_specification = "MSE_SC_1_2_200_c"
+  instance_count = 1
+  mse_version = "mse_dev"
+  net_type = "privatenet"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  cluster_alias_name = "%s"
+  acl_entry_list = %s
+}
+`, name, acls)
+}
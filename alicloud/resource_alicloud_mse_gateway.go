@@ -0,0 +1,194 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudMseGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMseGatewayCreate,
+		Read:   resourceAlicloudMseGatewayRead,
+		Update: resourceAlicloudMseGatewayUpdate,
+		Delete: resourceAlicloudMseGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The backup_vswitch_id is in another zone, and the replicas of the gateway are spread over the
+			// two zones.
+			"backup_vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"spec": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"replica": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(2, 30),
+			},
+			// The slb_spec and internet_slb_spec are the specifications of the private and public SLB
+			// instances bought with the gateway, and no SLB instance is bought when they are not set.
+			"slb_spec": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"internet_slb_spec": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_security_group": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			// The SLB instances are deleted with the gateway when the delete_slb is true.
+			"delete_slb": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudMseGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := AddMseGatewayArgs{
+		Region:                  string(client.Region),
+		Name:                    d.Get("name").(string),
+		Vpc:                     d.Get("vpc_id").(string),
+		VSwitchId:               d.Get("vswitch_id").(string),
+		BackupVSwitchId:         d.Get("backup_vswitch_id").(string),
+		Spec:                    d.Get("spec").(string),
+		Replica:                 d.Get("replica").(int),
+		SlbSpec:                 d.Get("slb_spec").(string),
+		InternetSlbSpec:         d.Get("internet_slb_spec").(string),
+		EnterpriseSecurityGroup: d.Get("enterprise_security_group").(bool),
+	}
+	resp := AddMseGatewayResponse{}
+	if err := client.mseconn().Invoke("AddGateway", &args, &resp); err != nil {
+		return WrapError(err, "AddGateway", d.Id())
+	}
+	if !resp.Success {
+		return fmt.Errorf("AddGateway got an error: %s", resp.Message)
+	}
+
+	d.SetId(resp.Data.GatewayUniqueId)
+
+	if err := client.WaitForMseGateway(d.Id(), MseGatewayRunning, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForMseGateway", d.Id())
+	}
+
+	return resourceAlicloudMseGatewayRead(d, meta)
+}
+
+func resourceAlicloudMseGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	gateway, err := client.DescribeMseGateway(d.Id())
+	if err != nil {
+		if NotFoundError(err, "mse") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListGateway", d.Id())
+	}
+
+	d.Set("name", gateway.Name)
+	d.Set("vpc_id", gateway.Vpc)
+	d.Set("vswitch_id", gateway.Vswitch)
+	d.Set("backup_vswitch_id", gateway.Vswitch2)
+	d.Set("spec", gateway.Spec)
+	d.Set("replica", gateway.Replica)
+	d.Set("security_group_id", gateway.SecurityGroup)
+	d.Set("status", gateway.StatusDesc)
+
+	return nil
+}
+
+func resourceAlicloudMseGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") {
+		args := UpdateMseGatewayNameArgs{
+			GatewayUniqueId: d.Id(),
+			Name:            d.Get("name").(string),
+		}
+		if err := client.mseconn().Invoke("UpdateGatewayName", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateGatewayName", d.Id())
+		}
+	}
+
+	return resourceAlicloudMseGatewayRead(d, meta)
+}
+
+func resourceAlicloudMseGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := DeleteMseGatewayArgs{
+		GatewayUniqueId: d.Id(),
+		DeleteSlb:       d.Get("delete_slb").(bool),
+	}
+	if err := client.mseconn().Invoke("DeleteGateway", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "mse") {
+			return nil
+		}
+		return WrapError(err, "DeleteGateway", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeMseGateway(d.Id()); err != nil {
+			if NotFoundError(err, "mse") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "ListGateway", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("MSE gateway %s is still being deleted", d.Id()))
+	})
+}
@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudMseGateway_basic(t *testing.T) {
+	var v MseGateway
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_mse_gateway.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMseGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMseGatewayConfig("tf-testAccMseGateway"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMseGatewayExists(
+						"alicloud_mse_gateway.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_mse_gateway.foo",
+						"name",
+						"tf-testAccMseGateway"),
+					resource.TestCheckResourceAttr(
+						"alicloud_mse_gateway.foo",
+						"replica",
+						"2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMseGatewayConfig("tf-testAccMseGateway-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMseGatewayExists(
+						"alicloud_mse_gateway.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_mse_gateway.foo",
+						"name",
+						"tf-testAccMseGateway-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMseGatewayExists(n string, d *MseGateway) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSE gateway ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		gateway, err := client.DescribeMseGateway(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *gateway
+		return nil
+	}
+}
+
+func testAccCheckMseGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_mse_gateway" {
+			continue
+		}
+
+		if _, err := client.DescribeMseGateway(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "mse") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("MSE gateway %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMseGatewayConfig(name string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+  "available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf-testAccMseGateway"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_mse_gateway" "foo" {
+  name = "%s"
+  vpc_id = "${alicloud_vpc.foo.id}"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  spec = "MSE_GTW_2_4_200_c"
+  replica = 2
+}
+`, name)
+}
@@ -23,6 +23,10 @@ func resourceAliyunNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -89,7 +93,7 @@ func resourceAliyunNatGateway() *schema.Resource {
 }
 
 func resourceAliyunNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	conn := meta.(*AliyunClient).vpcconn()
 
 	args := &ecs.CreateNatGatewayArgs{
 		RegionId: getRegion(d, meta),
@@ -142,7 +146,7 @@ func resourceAliyunNatGatewayRead(d *schema.ResourceData, meta interface{}) erro
 
 	natGateway, err := client.DescribeNatGateway(d.Id())
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			d.SetId("")
 			return nil
 		}
@@ -160,7 +164,9 @@ func resourceAliyunNatGatewayRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		log.Printf("[ERROR] bindWidthPackages flattenBandWidthPackages failed. natgateway id is %#v", d.Id())
 	} else {
-		d.Set("bandwidth_packages", bindWidthPackages)
+		if err := d.Set("bandwidth_packages", bindWidthPackages); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -169,7 +175,7 @@ func resourceAliyunNatGatewayRead(d *schema.ResourceData, meta interface{}) erro
 func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*AliyunClient)
-	conn := client.vpcconn
+	conn := client.vpcconn()
 
 	natGateway, err := client.DescribeNatGateway(d.Id())
 	if err != nil {
@@ -244,11 +250,12 @@ func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	client := meta.(*AliyunClient)
-	conn := client.vpcconn
+	conn := client.vpcconn()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 
 		packages, err := conn.DescribeBandwidthPackages(&ecs.DescribeBandwidthPackagesArgs{
 			RegionId:     getRegion(d, meta),
@@ -267,8 +274,7 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 			})
 
 			if err != nil {
-				er, _ := err.(*common.Error)
-				if er.ErrorResponse.Code == NatGatewayInvalidRegionId {
+				if IsExceptedError(err, NatGatewayInvalidRegionId) {
 					log.Printf("[ERROR] Delete bandwidth package is failed, bandwidthPackageId: %#v", pack.BandwidthPackageId)
 					return resource.NonRetryableError(err)
 				}
@@ -287,8 +293,7 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 
 		err = conn.DeleteNatGateway(args)
 		if err != nil {
-			er, _ := err.(*common.Error)
-			if er.ErrorResponse.Code == DependencyViolationBandwidthPackages {
+			if IsExceptedError(err, DependencyViolationBandwidthPackages) {
 				return resource.RetryableError(fmt.Errorf("NatGateway in use - trying again while it is deleted."))
 			}
 		}
@@ -346,7 +351,7 @@ func flattenBandWidthPackages(bandWidthPackageIds []string, meta interface{}, d
 
 func getPackages(packageId string, meta interface{}, d *schema.ResourceData) (*ecs.DescribeBandwidthPackageType, error) {
 	client := meta.(*AliyunClient)
-	conn := client.vpcconn
+	conn := client.vpcconn()
 	packages, err := conn.DescribeBandwidthPackages(&ecs.DescribeBandwidthPackagesArgs{
 		RegionId:           getRegion(d, meta),
 		BandwidthPackageId: packageId,
@@ -6,9 +6,86 @@ import (
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"log"
 	"testing"
 )
 
+func init() {
+	addTestSweepers("alicloud_nat_gateway", &testSweeper{
+		Dependencies: []string{
+			"alicloud_snat_entry",
+			"alicloud_forward_entry",
+		},
+		F: testSweepNatGateways,
+	})
+}
+
+func testSweepNatGateways(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.vpcconn()
+
+	gateways, err := sweepableNatGateways(client)
+	if err != nil {
+		return err
+	}
+
+	for _, gw := range gateways {
+		id := gw.NatGatewayId
+		log.Printf("[INFO] Deleting NAT gateway %s", id)
+		// The bandwidth packages of the NAT gateway have to be deleted before it.
+		packages, err := conn.DescribeBandwidthPackages(&ecs.DescribeBandwidthPackagesArgs{
+			RegionId:     client.Region,
+			NatGatewayId: id,
+		})
+		if err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DescribeBandwidthPackages", id))
+			continue
+		}
+		for _, pack := range packages {
+			if err := conn.DeleteBandwidthPackage(&ecs.DeleteBandwidthPackageArgs{
+				RegionId:           client.Region,
+				BandwidthPackageId: pack.BandwidthPackageId,
+			}); err != nil {
+				log.Printf("[ERROR] %s", WrapError(err, "DeleteBandwidthPackage", pack.BandwidthPackageId))
+			}
+		}
+		if err := conn.DeleteNatGateway(&ecs.DeleteNatGatewayArgs{
+			RegionId:     client.Region,
+			NatGatewayId: id,
+		}); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteNatGateway", id))
+		}
+	}
+	return nil
+}
+
+// sweepableNatGateways returns the NAT gateways created by the acceptance tests, whose entries are swept
+// before them.
+func sweepableNatGateways(client *AliyunClient) ([]ecs.NatGatewaySetType, error) {
+	var result []ecs.NatGatewaySetType
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		gateways, _, err := client.vpcconn().DescribeNatGateways(&ecs.DescribeNatGatewaysArgs{
+			RegionId:   client.Region,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeNatGateways", "")
+		}
+		for _, gw := range gateways {
+			if !isSweepable(gw.Name) {
+				log.Printf("[INFO] Skipping NAT gateway %s (%s)", gw.Name, gw.NatGatewayId)
+				continue
+			}
+			result = append(result, gw)
+		}
+		return len(gateways), nil
+	})
+	return result, err
+}
+
 func TestAccAlicloudNatGateway_basic(t *testing.T) {
 	var nat ecs.NatGatewaySetType
 
@@ -162,7 +239,7 @@ func testAccCheckNatGatewayDestroy(s *terraform.State) error {
 			return fmt.Errorf("Nat gateway still exist")
 		}
 
-		if err != nil && !NotFoundError(err) {
+		if err != nil && !NotFoundError(err, "vpc") {
 			if e, ok := err.(*common.Error); ok && (e.Code == AliyunGoClientFailure || e.StatusCode == -1) {
 				return nil
 			}
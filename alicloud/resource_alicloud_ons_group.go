@@ -0,0 +1,154 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudOnsGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudOnsGroupCreate,
+		Read:   resourceAlicloudOnsGroupRead,
+		Update: resourceAlicloudOnsGroupUpdate,
+		Delete: resourceAlicloudOnsGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOnsGroupId,
+			},
+			"group_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "tcp",
+				ValidateFunc: validateAllowedStringValue(OnsGroupTypes),
+			},
+			"remark": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(0, 256),
+			},
+			// The read_enable is not returned by OnsGroupList, so it is only kept in the state.
+			"read_enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudOnsGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := OnsGroupArgs{
+		InstanceId: d.Get("instance_id").(string),
+		GroupId:    d.Get("group_id").(string),
+		GroupType:  d.Get("group_type").(string),
+		Remark:     d.Get("remark").(string),
+	}
+	if err := client.onsconn().Invoke("OnsGroupCreate", &args, &common.Response{}); err != nil {
+		return WrapError(err, "OnsGroupCreate", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.InstanceId, COLON_SEPARATED, args.GroupId))
+
+	return resourceAlicloudOnsGroupUpdate(d, meta)
+}
+
+func resourceAlicloudOnsGroupRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	group, err := meta.(*AliyunClient).DescribeOnsGroup(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "ons") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "OnsGroupList", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("group_id", group.GroupId)
+	d.Set("group_type", group.GroupType)
+	d.Set("remark", group.Remark)
+
+	return nil
+}
+
+func resourceAlicloudOnsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// A new group can consume messages by default.
+	if d.HasChange("read_enable") && !(d.IsNewResource() && d.Get("read_enable").(bool)) {
+		parts, err := parseResourceId(d.Id(), 2)
+		if err != nil {
+			return err
+		}
+		args := OnsGroupArgs{
+			InstanceId: parts[0],
+			GroupId:    parts[1],
+			ReadEnable: strconv.FormatBool(d.Get("read_enable").(bool)),
+		}
+		if err := client.onsconn().Invoke("OnsGroupConsumerUpdate", &args, &common.Response{}); err != nil {
+			return WrapError(err, "OnsGroupConsumerUpdate", d.Id())
+		}
+	}
+
+	return resourceAlicloudOnsGroupRead(d, meta)
+}
+
+func resourceAlicloudOnsGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := OnsGroupArgs{
+		InstanceId: parts[0],
+		GroupId:    parts[1],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.onsconn().Invoke("OnsGroupDelete", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "ons") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "OnsGroupDelete", d.Id()))
+		}
+
+		if _, err := client.DescribeOnsGroup(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "ons") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("ONS group %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
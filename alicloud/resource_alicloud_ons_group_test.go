@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudOnsGroup_basic(t *testing.T) {
+	var v OnsGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ons_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOnsGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOnsGroupConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnsGroupExists(
+						"alicloud_ons_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_group.foo",
+						"group_id",
+						"GID-tf-testAccOnsGroup"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_group.foo",
+						"group_type",
+						"tcp"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOnsGroupConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnsGroupExists(
+						"alicloud_ons_group.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_group.foo",
+						"read_enable",
+						"false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOnsGroupExists(n string, d *OnsGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ONS group ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		group, err := client.DescribeOnsGroup(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *group
+		return nil
+	}
+}
+
+func testAccCheckOnsGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ons_group" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeOnsGroup(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "ons") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ONS group %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOnsGroupConfig(readEnable bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_ons_instance" "foo" {
+  name = "tf-testAccOnsGroup"
+}
+
+resource "alicloud_ons_group" "foo" {
+  instance_id = "${alicloud_ons_instance.foo.id}"
+  group_id = "GID-tf-testAccOnsGroup"
+  remark = "test remark"
+  read_enable = %t
+}
+`, readEnable)
+}
@@ -0,0 +1,150 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudOnsInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudOnsInstanceCreate,
+		Read:   resourceAlicloudOnsInstanceRead,
+		Update: resourceAlicloudOnsInstanceUpdate,
+		Delete: resourceAlicloudOnsInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(3, 64),
+			},
+			"remark": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(0, 128),
+			},
+			"instance_type": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instance_status": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tcp_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_internet_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_internal_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudOnsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := OnsInstanceArgs{
+		InstanceName: d.Get("name").(string),
+		Remark:       d.Get("remark").(string),
+	}
+	resp := CreateOnsInstanceResponse{}
+	if err := client.onsconn().Invoke("OnsInstanceCreate", &args, &resp); err != nil {
+		return WrapError(err, "OnsInstanceCreate", d.Id())
+	}
+
+	d.SetId(resp.Data.InstanceId)
+
+	if err := client.WaitForOnsInstance(d.Id(), OnsInstanceRunning, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForOnsInstance", d.Id())
+	}
+
+	return resourceAlicloudOnsInstanceRead(d, meta)
+}
+
+func resourceAlicloudOnsInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeOnsInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ons") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "OnsInstanceBaseInfo", d.Id())
+	}
+
+	d.Set("name", instance.InstanceName)
+	d.Set("remark", instance.Remark)
+	d.Set("instance_type", instance.InstanceType)
+	d.Set("instance_status", int(instance.InstanceStatus))
+	d.Set("tcp_endpoint", instance.Endpoints.TcpEndpoint)
+	d.Set("http_internet_endpoint", instance.Endpoints.HttpInternetEndpoint)
+	d.Set("http_internal_endpoint", instance.Endpoints.HttpInternalEndpoint)
+
+	return nil
+}
+
+func resourceAlicloudOnsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("remark") {
+		args := OnsInstanceArgs{
+			InstanceId:   d.Id(),
+			InstanceName: d.Get("name").(string),
+			Remark:       d.Get("remark").(string),
+		}
+		if err := client.onsconn().Invoke("OnsInstanceUpdate", &args, &common.Response{}); err != nil {
+			return WrapError(err, "OnsInstanceUpdate", d.Id())
+		}
+	}
+
+	return resourceAlicloudOnsInstanceRead(d, meta)
+}
+
+func resourceAlicloudOnsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := OnsInstanceArgs{
+		InstanceId: d.Id(),
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.onsconn().Invoke("OnsInstanceDelete", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "ons") {
+				return nil
+			}
+			// The topics and groups in the instance are deleted asynchronously.
+			if IsExceptedError(err, OnsInstanceNotEmpty) {
+				return resource.RetryableError(fmt.Errorf("ONS instance %s is not empty - trying again while its topics and groups are deleted.", d.Id()))
+			}
+			return resource.NonRetryableError(WrapError(err, "OnsInstanceDelete", d.Id()))
+		}
+
+		if _, err := client.DescribeOnsInstance(d.Id()); err != nil {
+			if NotFoundError(err, "ons") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("ONS instance %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,112 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudOnsInstance_basic(t *testing.T) {
+	var v OnsInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ons_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOnsInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOnsInstanceConfig("tf-testAccOnsInstance", "test remark"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnsInstanceExists(
+						"alicloud_ons_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_instance.foo",
+						"name",
+						"tf-testAccOnsInstance"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_instance.foo",
+						"remark",
+						"test remark"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_instance.foo",
+						"instance_status",
+						"7"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOnsInstanceConfig("tf-testAccOnsInstanceUpdate", "test remark updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnsInstanceExists(
+						"alicloud_ons_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_instance.foo",
+						"name",
+						"tf-testAccOnsInstanceUpdate"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_instance.foo",
+						"remark",
+						"test remark updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOnsInstanceExists(n string, d *OnsInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ONS instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instance, err := client.DescribeOnsInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *instance
+		return nil
+	}
+}
+
+func testAccCheckOnsInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ons_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeOnsInstance(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "ons") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ONS instance %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOnsInstanceConfig(name, remark string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ons_instance" "foo" {
+  name = "%s"
+  remark = "%s"
+}
+`, name, remark)
+}
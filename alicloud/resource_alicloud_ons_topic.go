@@ -0,0 +1,162 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudOnsTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudOnsTopicCreate,
+		Read:   resourceAlicloudOnsTopicRead,
+		Update: resourceAlicloudOnsTopicUpdate,
+		Delete: resourceAlicloudOnsTopicDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOnsTopic,
+			},
+			"message_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "normal",
+				ValidateFunc: validateAllowedStringValue([]string{"normal", "partition_order", "order", "transaction", "delay"}),
+			},
+			"remark": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(0, 128),
+			},
+			"perm": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "pub_sub",
+				ValidateFunc: validateAllowedStringValue([]string{"sub", "pub", "pub_sub"}),
+			},
+		},
+	}
+}
+
+func resourceAlicloudOnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := OnsTopicArgs{
+		InstanceId:  d.Get("instance_id").(string),
+		Topic:       d.Get("topic").(string),
+		MessageType: OnsMessageTypes[d.Get("message_type").(string)],
+		Remark:      d.Get("remark").(string),
+	}
+	if err := client.onsconn().Invoke("OnsTopicCreate", &args, &common.Response{}); err != nil {
+		return WrapError(err, "OnsTopicCreate", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.InstanceId, COLON_SEPARATED, args.Topic))
+
+	return resourceAlicloudOnsTopicUpdate(d, meta)
+}
+
+func resourceAlicloudOnsTopicRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	topic, err := meta.(*AliyunClient).DescribeOnsTopic(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "ons") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "OnsTopicList", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("topic", topic.Topic)
+	d.Set("remark", topic.Remark)
+	for name, code := range OnsMessageTypes {
+		if code == topic.MessageType {
+			d.Set("message_type", name)
+		}
+	}
+	for name, code := range OnsTopicPerms {
+		if code == topic.Perm {
+			d.Set("perm", name)
+		}
+	}
+
+	return nil
+}
+
+func resourceAlicloudOnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// A new topic can publish and subscribe messages by default.
+	if d.HasChange("perm") && !(d.IsNewResource() && d.Get("perm").(string) == "pub_sub") {
+		parts, err := parseResourceId(d.Id(), 2)
+		if err != nil {
+			return err
+		}
+		args := OnsTopicArgs{
+			InstanceId: parts[0],
+			Topic:      parts[1],
+			Perm:       OnsTopicPerms[d.Get("perm").(string)],
+		}
+		if err := client.onsconn().Invoke("OnsTopicUpdate", &args, &common.Response{}); err != nil {
+			return WrapError(err, "OnsTopicUpdate", d.Id())
+		}
+	}
+
+	return resourceAlicloudOnsTopicRead(d, meta)
+}
+
+func resourceAlicloudOnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := OnsTopicArgs{
+		InstanceId: parts[0],
+		Topic:      parts[1],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.onsconn().Invoke("OnsTopicDelete", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "ons") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "OnsTopicDelete", d.Id()))
+		}
+
+		if _, err := client.DescribeOnsTopic(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "ons") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("ONS topic %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
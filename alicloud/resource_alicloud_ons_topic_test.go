@@ -0,0 +1,125 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudOnsTopic_basic(t *testing.T) {
+	var v OnsTopic
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ons_topic.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOnsTopicDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOnsTopicConfig("pub_sub"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnsTopicExists(
+						"alicloud_ons_topic.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_topic.foo",
+						"topic",
+						"tf-testAccOnsTopic"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_topic.foo",
+						"message_type",
+						"order"),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_topic.foo",
+						"perm",
+						"pub_sub"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOnsTopicConfig("sub"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOnsTopicExists(
+						"alicloud_ons_topic.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ons_topic.foo",
+						"perm",
+						"sub"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOnsTopicExists(n string, d *OnsTopic) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ONS topic ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		topic, err := client.DescribeOnsTopic(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *topic
+		return nil
+	}
+}
+
+func testAccCheckOnsTopicDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ons_topic" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeOnsTopic(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "ons") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ONS topic %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOnsTopicConfig(perm string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ons_instance" "foo" {
+  name = "tf-testAccOnsTopic"
+}
+
+resource "alicloud_ons_topic" "foo" {
+  instance_id = "${alicloud_ons_instance.foo.id}"
+  topic = "tf-testAccOnsTopic"
+  message_type = "order"
+  remark = "test remark"
+  perm = "%s"
+}
+`, perm)
+}
@@ -0,0 +1,195 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudOosExecution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudOosExecutionCreate,
+		Read:   resourceAlicloudOosExecutionRead,
+		Delete: resourceAlicloudOosExecutionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Automatic",
+				ValidateFunc: validateAllowedStringValue(OosExecutionModes),
+			},
+			// The parameters is a JSON object of the parameters of the template, like {"Status":"Running"}.
+			"parameters": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"safety_check": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ConfirmEveryHighRiskAction",
+				ValidateFunc: validateAllowedStringValue(OosSafetyChecks),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The outputs is a JSON object of the outputs of the template.
+			"outputs": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudOosExecutionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := StartOosExecutionArgs{
+		RegionId:        client.Region,
+		TemplateName:    d.Get("template_name").(string),
+		TemplateVersion: d.Get("template_version").(string),
+		Mode:            d.Get("mode").(string),
+		Parameters:      d.Get("parameters").(string),
+		SafetyCheck:     d.Get("safety_check").(string),
+		Description:     d.Get("description").(string),
+	}
+	resp := StartOosExecutionResponse{}
+	if err := client.oosconn().Invoke("StartExecution", &args, &resp); err != nil {
+		return WrapError(err, "StartExecution", d.Id())
+	}
+
+	d.SetId(resp.Execution.ExecutionId)
+
+	// The executions in the Debug mode are run step by step out of band, so only the automatic ones are
+	// waited for.
+	if args.Mode == "Automatic" {
+		if err := client.WaitForOosExecution(d.Id(), deadline.Seconds()); err != nil {
+			return WrapError(err, "WaitForOosExecution", d.Id())
+		}
+		execution, err := client.DescribeOosExecution(d.Id())
+		if err != nil {
+			return WrapError(err, "ListExecutions", d.Id())
+		}
+		if execution.Status != OosExecutionSuccess {
+			return fmt.Errorf("OOS execution %s is %s: %s", d.Id(), execution.Status, execution.StatusMessage)
+		}
+	}
+
+	return resourceAlicloudOosExecutionRead(d, meta)
+}
+
+func resourceAlicloudOosExecutionRead(d *schema.ResourceData, meta interface{}) error {
+	execution, err := meta.(*AliyunClient).DescribeOosExecution(d.Id())
+	if err != nil {
+		if NotFoundError(err, "oos") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListExecutions", d.Id())
+	}
+
+	outputs, err := json.Marshal(execution.Outputs)
+	if err != nil {
+		return WrapError(err, "ListExecutions", d.Id())
+	}
+
+	d.Set("template_name", execution.TemplateName)
+	d.Set("template_version", execution.TemplateVersion)
+	d.Set("mode", execution.Mode)
+	// The parameters of the execution contain the default values of the template, so they are only set when
+	// the execution is imported.
+	if _, ok := d.GetOk("parameters"); !ok && len(execution.Parameters) > 0 {
+		parameters, err := json.Marshal(execution.Parameters)
+		if err != nil {
+			return WrapError(err, "ListExecutions", d.Id())
+		}
+		d.Set("parameters", string(parameters))
+	}
+	d.Set("safety_check", execution.SafetyCheck)
+	d.Set("description", execution.Description)
+	d.Set("status", execution.Status)
+	d.Set("status_message", execution.StatusMessage)
+	d.Set("outputs", string(outputs))
+
+	return nil
+}
+
+func resourceAlicloudOosExecutionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	execution, err := client.DescribeOosExecution(d.Id())
+	if err != nil {
+		if NotFoundError(err, "oos") {
+			return nil
+		}
+		return WrapError(err, "ListExecutions", d.Id())
+	}
+
+	if execution.Status == OosExecutionRunning || execution.Status == OosExecutionWaiting {
+		args := OosExecutionArgs{
+			RegionId:    client.Region,
+			ExecutionId: d.Id(),
+		}
+		if err := client.oosconn().Invoke("CancelExecution", &args, &common.Response{}); err != nil {
+			return WrapError(err, "CancelExecution", d.Id())
+		}
+		if err := client.WaitForOosExecution(d.Id(), deadline.Seconds()); err != nil {
+			return WrapError(err, "WaitForOosExecution", d.Id())
+		}
+	}
+
+	args := OosExecutionArgs{
+		RegionId:     client.Region,
+		ExecutionIds: convertListToJsonString([]interface{}{d.Id()}),
+	}
+	if err := client.oosconn().Invoke("DeleteExecutions", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "oos") {
+			return nil
+		}
+		return WrapError(err, "DeleteExecutions", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudOosExecution_basic(t *testing.T) {
+	var v OosExecution
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_oos_execution.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOosExecutionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOosExecutionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOosExecutionExists(
+						"alicloud_oos_execution.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_oos_execution.foo",
+						"status",
+						OosExecutionSuccess),
+					resource.TestCheckResourceAttr(
+						"alicloud_oos_execution.foo",
+						"template_version",
+						"v1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_oos_execution.foo",
+						"outputs",
+						`{"Message":"tf-testAccOosExecution"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOosExecutionExists(n string, d *OosExecution) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OOS execution ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		execution, err := client.DescribeOosExecution(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *execution
+		return nil
+	}
+}
+
+func testAccCheckOosExecutionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_oos_execution" {
+			continue
+		}
+
+		if _, err := client.DescribeOosExecution(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "oos") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("OOS execution %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccOosExecutionConfig = `
+resource "alicloud_oos_template" "foo" {
+  template_name = "tf-testAccOosExecution"
+  content = <<EOF
+{
+  "FormatVersion": "OOS-2019-06-01",
+  "Parameters": {
+    "Message": {
+      "Type": "String"
+    }
+  },
+  "Tasks": [
+    {
+      "Name": "sleep",
+      "Action": "ACS::Sleep",
+      "Properties": {
+        "Duration": "PT1S"
+      }
+    }
+  ],
+  "Outputs": {
+    "Message": {
+      "Type": "String",
+      "Value": "{{ Message }}"
+    }
+  }
+}
+EOF
+}
+
+resource "alicloud_oos_execution" "foo" {
+  template_name = "${alicloud_oos_template.foo.template_name}"
+  parameters = "{\"Message\":\"tf-testAccOosExecution\"}"
+}
+`
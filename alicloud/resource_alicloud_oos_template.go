@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudOosTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudOosTemplateCreate,
+		Read:   resourceAlicloudOosTemplateRead,
+		Update: resourceAlicloudOosTemplateUpdate,
+		Delete: resourceAlicloudOosTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 200),
+			},
+			// The content is the JSON or the YAML of the template, and a new version of the template is created
+			// every time it is changed.
+			"content": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudOosTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := OosTemplateArgs{
+		RegionId:     client.Region,
+		TemplateName: d.Get("template_name").(string),
+		VersionName:  d.Get("version_name").(string),
+		Content:      d.Get("content").(string),
+	}
+	if err := client.oosconn().Invoke("CreateTemplate", &args, &OosTemplateResponse{}); err != nil {
+		return WrapError(err, "CreateTemplate", d.Id())
+	}
+
+	d.SetId(args.TemplateName)
+
+	return resourceAlicloudOosTemplateRead(d, meta)
+}
+
+func resourceAlicloudOosTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	resp, err := meta.(*AliyunClient).DescribeOosTemplate(d.Id())
+	if err != nil {
+		if NotFoundError(err, "oos") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetTemplate", d.Id())
+	}
+
+	d.Set("template_name", resp.Template.TemplateName)
+	d.Set("content", resp.Content)
+	d.Set("template_id", resp.Template.TemplateId)
+	d.Set("template_version", resp.Template.TemplateVersion)
+	d.Set("description", resp.Template.Description)
+
+	return nil
+}
+
+func resourceAlicloudOosTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("content") || d.HasChange("version_name") {
+		args := OosTemplateArgs{
+			RegionId:     client.Region,
+			TemplateName: d.Id(),
+			VersionName:  d.Get("version_name").(string),
+			Content:      d.Get("content").(string),
+		}
+		if err := client.oosconn().Invoke("UpdateTemplate", &args, &OosTemplateResponse{}); err != nil {
+			return WrapError(err, "UpdateTemplate", d.Id())
+		}
+	}
+
+	return resourceAlicloudOosTemplateRead(d, meta)
+}
+
+func resourceAlicloudOosTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := OosTemplateArgs{
+		RegionId:     client.Region,
+		TemplateName: d.Id(),
+	}
+	if err := client.oosconn().Invoke("DeleteTemplate", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "oos") {
+			return nil
+		}
+		return WrapError(err, "DeleteTemplate", d.Id())
+	}
+	return nil
+}
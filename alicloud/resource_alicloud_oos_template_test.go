@@ -0,0 +1,119 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudOosTemplate_basic(t *testing.T) {
+	var v OosTemplateResponse
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_oos_template.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOosTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOosTemplateConfig("PT1S"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOosTemplateExists(
+						"alicloud_oos_template.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_oos_template.foo",
+						"template_name",
+						"tf-testAccOosTemplate"),
+					resource.TestCheckResourceAttr(
+						"alicloud_oos_template.foo",
+						"template_version",
+						"v1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOosTemplateConfig("PT2S"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOosTemplateExists(
+						"alicloud_oos_template.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_oos_template.foo",
+						"template_version",
+						"v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOosTemplateExists(n string, d *OosTemplateResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OOS template ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		template, err := client.DescribeOosTemplate(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *template
+		return nil
+	}
+}
+
+func testAccCheckOosTemplateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_oos_template" {
+			continue
+		}
+
+		if _, err := client.DescribeOosTemplate(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "oos") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("OOS template %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOosTemplateConfig(duration string) string {
+	return fmt.Sprintf(`
+resource "alicloud_oos_template" "foo" {
+  template_name = "tf-testAccOosTemplate"
+  content = <<EOF
+{
+  "FormatVersion": "OOS-2019-06-01",
+  "Description": "tf-testAccOosTemplate",
+  "Parameters": {},
+  "Tasks": [
+    {
+      "Name": "sleep",
+      "Action": "ACS::Sleep",
+      "Properties": {
+        "Duration": "%s"
+      }
+    }
+  ]
+}
+EOF
+}
+`, duration)
+}
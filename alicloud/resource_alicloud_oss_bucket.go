@@ -21,6 +21,12 @@ func resourceAlicloudOssBucket() *schema.Resource {
 			State: resourceAlicloudOssBucketImportState,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": &schema.Schema{
 				Type:         schema.TypeString,
@@ -211,7 +217,12 @@ func resourceAlicloudOssBucket() *schema.Resource {
 }
 
 func resourceAlicloudOssBucketCreate(d *schema.ResourceData, meta interface{}) error {
-	ossconn := meta.(*AliyunClient).ossconn
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	ossconn, err := meta.(*AliyunClient).ossconn()
+	if err != nil {
+		return err
+	}
 
 	bucket := d.Get("bucket").(string)
 	isExist, err := ossconn.IsBucketExist(bucket)
@@ -229,7 +240,7 @@ func resourceAlicloudOssBucketCreate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OSS bucket: %#v", err)
 	}
 
-	retryErr := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	retryErr := resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		isExist, err := ossconn.IsBucketExist(bucket)
 
 		if err != nil {
@@ -253,7 +264,10 @@ func resourceAlicloudOssBucketCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) error {
-	ossconn := meta.(*AliyunClient).ossconn
+	ossconn, err := meta.(*AliyunClient).ossconn()
+	if err != nil {
+		return err
+	}
 
 	info, err := ossconn.GetBucketInfo(d.Id())
 	if err != nil {
@@ -336,7 +350,9 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 	if isEnable, ok := d.GetOk("logging_isenable"); ok {
 		d.Set("logging_isenable", isEnable.(bool))
 		if !isEnable.(bool) {
-			d.Set("logging", logging.XMLName)
+			if err := d.Set("logging", []map[string]interface{}{}); err != nil {
+				return err
+			}
 		} else {
 			lgs := make([]map[string]interface{}, 0, 1)
 			if &logging != nil {
@@ -430,7 +446,10 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	ossconn := meta.(*AliyunClient).ossconn
+	ossconn, err := meta.(*AliyunClient).ossconn()
+	if err != nil {
+		return err
+	}
 
 	d.Partial(true)
 
@@ -477,7 +496,7 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 func resourceAlicloudOssBucketCorsUpdate(ossconn *oss.Client, d *schema.ResourceData) error {
 	cors := d.Get("cors_rule").([]interface{})
 	if cors == nil || len(cors) == 0 {
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := ossconn.DeleteBucketCORS(d.Id()); err != nil {
 				return resource.NonRetryableError(err)
 			}
@@ -528,7 +547,7 @@ func resourceAlicloudOssBucketCorsUpdate(ossconn *oss.Client, d *schema.Resource
 func resourceAlicloudOssBucketWebsiteUpdate(ossconn *oss.Client, d *schema.ResourceData) error {
 	ws := d.Get("website").(*schema.Set)
 	if ws == nil || ws.Len() == 0 {
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := ossconn.DeleteBucketWebsite(d.Id()); err != nil {
 				return resource.NonRetryableError(err)
 			}
@@ -559,7 +578,7 @@ func resourceAlicloudOssBucketWebsiteUpdate(ossconn *oss.Client, d *schema.Resou
 func resourceAlicloudOssBucketLoggingUpdate(ossconn *oss.Client, d *schema.ResourceData) error {
 	logging := d.Get("logging").(*schema.Set)
 	if logging == nil || logging.Len() == 0 {
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := ossconn.DeleteBucketLogging(d.Id()); err != nil {
 				return resource.NonRetryableError(err)
 			}
@@ -619,7 +638,7 @@ func resourceAlicloudOssBucketLifecycleRuleUpdate(ossconn *oss.Client, d *schema
 	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
 
 	if lifecycleRules == nil || len(lifecycleRules) == 0 {
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := ossconn.DeleteBucketLifecycle(bucket); err != nil {
 				return resource.NonRetryableError(err)
 			}
@@ -672,7 +691,7 @@ func resourceAlicloudOssBucketLifecycleRuleUpdate(ossconn *oss.Client, d *schema
 		rules = append(rules, rule)
 	}
 
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		if err := ossconn.SetBucketLifecycle(bucket, rules); err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -685,9 +704,14 @@ func resourceAlicloudOssBucketLifecycleRuleUpdate(ossconn *oss.Client, d *schema
 	return nil
 }
 func resourceAlicloudOssBucketDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AliyunClient).ossconn
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	client, err := meta.(*AliyunClient).ossconn()
+	if err != nil {
+		return err
+	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		exist, err := client.IsBucketExist(d.Id())
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("OSS delete bucket got an error: %#v", err))
@@ -20,6 +20,13 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 		Read:   resourceAlicloudOssBucketObjectRead,
 		Update: resourceAlicloudOssBucketObjectPut,
 		Delete: resourceAlicloudOssBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudOssBucketObjectImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -106,7 +113,7 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 
 func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 
-	bucket, err := meta.(*AliyunClient).ossconn.Bucket(d.Get("bucket").(string))
+	bucket, err := meta.(*AliyunClient).ossBucket(d.Get("bucket").(string))
 	if err != nil {
 		return fmt.Errorf("Error getting bucket: %#v", err)
 	}
@@ -151,7 +158,7 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
-	bucket, err := meta.(*AliyunClient).ossconn.Bucket(d.Get("bucket").(string))
+	bucket, err := meta.(*AliyunClient).ossBucket(d.Get("bucket").(string))
 	if err != nil {
 		return fmt.Errorf("Error getting bucket: %#v", err)
 	}
@@ -186,11 +193,13 @@ func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAlicloudOssBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	bucket, err := meta.(*AliyunClient).ossconn.Bucket(d.Get("bucket").(string))
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	bucket, err := meta.(*AliyunClient).ossBucket(d.Get("bucket").(string))
 	if err != nil {
 		return fmt.Errorf("Error getting bucket: %#v", err)
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		exist, err := bucket.IsObjectExist(d.Id())
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("OSS delete object got an error: %#v", err))
@@ -247,3 +256,17 @@ func buildObjectHeaderOptions(d *schema.ResourceData) (options []oss.Option, err
 	}
 	return options, nil
 }
+
+// resourceAlicloudOssBucketObjectImport imports the object by the id in the format <bucket>:<key>.
+// The content and the source of the object can not be imported.
+func resourceAlicloudOssBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("bucket", parts[0])
+	d.Set("key", parts[1])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -141,7 +141,7 @@ func testAccCheckOssBucketObjectExistsWithProviders(n string, bucket string, obj
 			if provider.Meta() == nil {
 				continue
 			}
-			client, err := provider.Meta().(*AliyunClient).ossconn.Bucket(bucket)
+			client, err := provider.Meta().(*AliyunClient).ossBucket(bucket)
 			if err != nil {
 				return fmt.Errorf("Error getting bucket: %#v", err)
 			}
@@ -0,0 +1,190 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudPolarDBAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudPolarDBAccountCreate,
+		Read:   resourceAlicloudPolarDBAccountRead,
+		Update: resourceAlicloudPolarDBAccountUpdate,
+		Delete: resourceAlicloudPolarDBAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"account_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"Normal", "Super"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Normal",
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceDescription,
+				Optional:     true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudPolarDBAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	clusterId := d.Get("db_cluster_id").(string)
+	name := d.Get("account_name").(string)
+
+	args := CreatePolarDBAccountArgs{
+		RegionId:           client.Region,
+		DBClusterId:        clusterId,
+		AccountName:        name,
+		AccountPassword:    d.Get("account_password").(string),
+		AccountType:        d.Get("account_type").(string),
+		AccountDescription: d.Get("description").(string),
+	}
+
+	err := resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.polardbconn().Invoke("CreateAccount", &args, &PolarDBResponse{}); err != nil {
+			if IsExceptedError(err, PolarDBOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("PolarDB cluster %s is busy - trying again while it is Running.", clusterId))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapError(err, "CreateAccount", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, name))
+
+	if err := client.WaitForPolarDBAccount(clusterId, name, PolarDBAvailable, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForPolarDBAccount %s got an error: %#v", PolarDBAvailable, err)
+	}
+
+	return resourceAlicloudPolarDBAccountUpdate(d, meta)
+}
+
+func resourceAlicloudPolarDBAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("description") && !d.IsNewResource() {
+		args := ModifyPolarDBAccountDescriptionArgs{
+			RegionId:           client.Region,
+			DBClusterId:        parts[0],
+			AccountName:        parts[1],
+			AccountDescription: d.Get("description").(string),
+		}
+		if err := client.polardbconn().Invoke("ModifyAccountDescription", &args, &PolarDBResponse{}); err != nil {
+			return WrapError(err, "ModifyAccountDescription", d.Id())
+		}
+		d.SetPartial("description")
+	}
+
+	if d.HasChange("account_password") && !d.IsNewResource() {
+		args := ModifyPolarDBAccountPasswordArgs{
+			RegionId:           client.Region,
+			DBClusterId:        parts[0],
+			AccountName:        parts[1],
+			NewAccountPassword: d.Get("account_password").(string),
+		}
+		if err := client.polardbconn().Invoke("ModifyAccountPassword", &args, &PolarDBResponse{}); err != nil {
+			return WrapError(err, "ModifyAccountPassword", d.Id())
+		}
+		d.SetPartial("account_password")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudPolarDBAccountRead(d, meta)
+}
+
+func resourceAlicloudPolarDBAccountRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	account, err := meta.(*AliyunClient).DescribePolarDBAccount(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "polardb") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeAccounts", d.Id())
+	}
+
+	d.Set("db_cluster_id", parts[0])
+	d.Set("account_name", account.AccountName)
+	d.Set("account_type", account.AccountType)
+	d.Set("description", account.AccountDescription)
+
+	return nil
+}
+
+func resourceAlicloudPolarDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := PolarDBAccountArgs{
+		RegionId:    client.Region,
+		DBClusterId: parts[0],
+		AccountName: parts[1],
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.polardbconn().Invoke("DeleteAccount", &args, &PolarDBResponse{}); err != nil {
+			if IsExceptedError(err, PolarDBOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("PolarDB cluster %s is busy - trying again while it is Running.", parts[0]))
+			}
+			if !NotFoundError(err, "polardb") {
+				return resource.NonRetryableError(WrapError(err, "DeleteAccount", d.Id()))
+			}
+		}
+
+		if _, err := client.DescribePolarDBAccount(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "polardb") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete PolarDB account %s timeout.", parts[1]))
+	})
+}
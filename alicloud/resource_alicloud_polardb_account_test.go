@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudPolarDBAccount_basic(t *testing.T) {
+	var account PolarDBAccount
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_polardb_account.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPolarDBAccountDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPolarDBAccountConfig("from terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolarDBAccountExists(
+						"alicloud_polardb_account.foo", &account),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_account.foo",
+						"account_name",
+						"tftest"),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_account.foo",
+						"account_type",
+						"Normal"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccPolarDBAccountConfig("from terraform update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolarDBAccountExists(
+						"alicloud_polardb_account.foo", &account),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_account.foo",
+						"description",
+						"from terraform update"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckPolarDBAccountExists(n string, account *PolarDBAccount) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No PolarDB account ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		a, err := client.DescribePolarDBAccount(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*account = *a
+		return nil
+	}
+}
+
+func testAccCheckPolarDBAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_polardb_account" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribePolarDBAccount(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "polardb") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("PolarDB account %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPolarDBAccountConfig(description string) string {
+	return fmt.Sprintf(testAccPolarDBClusterForSub+`
+resource "alicloud_polardb_account" "foo" {
+	db_cluster_id = "${alicloud_polardb_cluster.foo.id}"
+	account_name = "tftest"
+	account_password = "Test12345"
+	description = "%s"
+}
+`, description)
+}
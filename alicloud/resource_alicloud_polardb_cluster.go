@@ -0,0 +1,321 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudPolarDBCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudPolarDBClusterCreate,
+		Read:   resourceAlicloudPolarDBClusterRead,
+		Update: resourceAlicloudPolarDBClusterUpdate,
+		Delete: resourceAlicloudPolarDBClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue(PolarDBTypes),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"db_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_node_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"modify_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"Upgrade", "Downgrade"}),
+				Optional:     true,
+				Default:      "Upgrade",
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceDescription,
+				Optional:     true,
+			},
+			"pay_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"PostPaid", "PrePaid"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "PostPaid",
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_ips": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"maintain_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTimeWindow,
+			},
+			"db_node_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudPolarDBClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args, err := buildPolarDBClusterCreateArgs(d, meta)
+	if err != nil {
+		return err
+	}
+
+	resp := CreatePolarDBClusterResponse{}
+	if err := client.polardbconn().Invoke("CreateDBCluster", args, &resp); err != nil {
+		return fmt.Errorf("Error creating Alicloud PolarDB cluster: %#v", err)
+	}
+
+	if resp.DBClusterId == "" {
+		return fmt.Errorf("Error get Alicloud PolarDB cluster id")
+	}
+	d.SetId(resp.DBClusterId)
+
+	if err := client.WaitForPolarDBCluster(d.Id(), PolarDBRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForPolarDBCluster %s got an error: %#v", PolarDBRunning, err)
+	}
+
+	return resourceAlicloudPolarDBClusterUpdate(d, meta)
+}
+
+func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if d.HasChange("description") && !d.IsNewResource() {
+		args := ModifyPolarDBClusterDescriptionArgs{
+			RegionId:             client.Region,
+			DBClusterId:          d.Id(),
+			DBClusterDescription: d.Get("description").(string),
+		}
+		if err := client.polardbconn().Invoke("ModifyDBClusterDescription", &args, &PolarDBResponse{}); err != nil {
+			return WrapError(err, "ModifyDBClusterDescription", d.Id())
+		}
+		d.SetPartial("description")
+	}
+
+	if d.HasChange("maintain_time") {
+		if maintainTime := d.Get("maintain_time").(string); maintainTime != "" {
+			args := ModifyPolarDBClusterMaintainTimeArgs{
+				RegionId:     client.Region,
+				DBClusterId:  d.Id(),
+				MaintainTime: maintainTime,
+			}
+			if err := client.polardbconn().Invoke("ModifyDBClusterMaintainTime", &args, &PolarDBResponse{}); err != nil {
+				return WrapError(err, "ModifyDBClusterMaintainTime", d.Id())
+			}
+		}
+		d.SetPartial("maintain_time")
+	}
+
+	if d.HasChange("security_ips") {
+		ips := expandStringList(d.Get("security_ips").(*schema.Set).List())
+		if len(ips) < 1 {
+			ips = []string{LOCAL_HOST_IP}
+		}
+		args := ModifyPolarDBAccessWhitelistArgs{
+			RegionId:             client.Region,
+			DBClusterId:          d.Id(),
+			DBClusterIPArrayName: "default",
+			SecurityIps:          strings.Join(ips, COMMA_SEPARATED),
+		}
+		if err := client.polardbconn().Invoke("ModifyDBClusterAccessWhitelist", &args, &PolarDBResponse{}); err != nil {
+			return WrapError(err, "ModifyDBClusterAccessWhitelist", d.Id())
+		}
+		if err := client.WaitForPolarDBCluster(d.Id(), PolarDBRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForPolarDBCluster %s got an error: %#v", PolarDBRunning, err)
+		}
+		d.SetPartial("security_ips")
+	}
+
+	if d.HasChange("db_node_class") && !d.IsNewResource() {
+		args := ModifyPolarDBNodeClassArgs{
+			RegionId:          client.Region,
+			DBClusterId:       d.Id(),
+			ModifyType:        d.Get("modify_type").(string),
+			DBNodeTargetClass: d.Get("db_node_class").(string),
+		}
+		if err := client.polardbconn().Invoke("ModifyDBNodeClass", &args, &PolarDBResponse{}); err != nil {
+			return WrapError(err, "ModifyDBNodeClass", d.Id())
+		}
+
+		// The cluster turns into ClassChanging after a while.
+		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := client.WaitForPolarDBCluster(d.Id(), PolarDBRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForPolarDBCluster %s got an error: %#v", PolarDBRunning, err)
+		}
+		d.SetPartial("db_node_class")
+		d.SetPartial("modify_type")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudPolarDBClusterRead(d, meta)
+}
+
+func resourceAlicloudPolarDBClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	cluster, err := client.DescribePolarDBClusterById(d.Id())
+	if err != nil {
+		if NotFoundError(err, "polardb") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe PolarDB Cluster Attribute: %#v", err)
+	}
+
+	d.Set("db_type", cluster.DBType)
+	d.Set("db_version", cluster.DBVersion)
+	d.Set("description", cluster.DBClusterDescription)
+	d.Set("zone_id", strings.Split(cluster.ZoneIds, COMMA_SEPARATED)[0])
+	d.Set("vswitch_id", cluster.VSwitchId)
+	d.Set("maintain_time", cluster.MaintainTime)
+	if cluster.PayType == "Prepaid" {
+		d.Set("pay_type", "PrePaid")
+	} else {
+		d.Set("pay_type", "PostPaid")
+	}
+
+	var nodeIds []string
+	for _, node := range cluster.DBNodes {
+		nodeIds = append(nodeIds, node.DBNodeId)
+		if node.DBNodeRole == "Writer" {
+			d.Set("db_node_class", node.DBNodeClass)
+		}
+	}
+	if err := d.Set("db_node_ids", nodeIds); err != nil {
+		return err
+	}
+
+	ips, err := client.DescribePolarDBSecurityIps(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeDBClusterAccessWhitelist", d.Id())
+	}
+	if err := d.Set("security_ips", ips); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudPolarDBClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	if d.Get("pay_type").(string) == "PrePaid" {
+		return ConfigErrorf(ErrorCodeUnsupportedOperation, "At present, 'PrePaid' PolarDB cluster cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+
+	args := PolarDBClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: d.Id(),
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.polardbconn().Invoke("DeleteDBCluster", &args, &PolarDBResponse{}); err != nil {
+			if NotFoundError(err, "polardb") {
+				return nil
+			}
+			if IsExceptedError(err, PolarDBOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("PolarDB cluster %s is busy - trying again while it is deleted.", d.Id()))
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteDBCluster", d.Id()))
+		}
+
+		if _, err := client.DescribePolarDBClusterById(d.Id()); err != nil {
+			if NotFoundError(err, "polardb") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("PolarDB cluster %s in use - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildPolarDBClusterCreateArgs(d *schema.ResourceData, meta interface{}) (*CreatePolarDBClusterArgs, error) {
+	client := meta.(*AliyunClient)
+
+	args := &CreatePolarDBClusterArgs{
+		RegionId:             client.Region,
+		DBType:               d.Get("db_type").(string),
+		DBVersion:            d.Get("db_version").(string),
+		DBNodeClass:          d.Get("db_node_class").(string),
+		DBClusterDescription: d.Get("description").(string),
+		ClusterNetworkType:   strings.ToUpper(string(VpcNet)),
+		PayType:              "Postpaid",
+	}
+
+	if d.Get("pay_type").(string) == "PrePaid" {
+		period := d.Get("period").(int)
+		if period == 0 {
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'period' is required when 'pay_type' is PrePaid")
+		}
+		args.PayType = "Prepaid"
+		if period > 9 {
+			args.Period = "Year"
+			args.UsedTime = strconv.Itoa(period / 12)
+		} else {
+			args.Period = "Month"
+			args.UsedTime = strconv.Itoa(period)
+		}
+	}
+
+	vswitchId := d.Get("vswitch_id").(string)
+	vpcId, zoneId, err := client.DescribeVSwitchPlacement(vswitchId, d.Get("zone_id").(string))
+	if err != nil {
+		return nil, err
+	}
+	args.ZoneId = zoneId
+	args.VPCId = vpcId
+	args.VSwitchId = vswitchId
+
+	return args, nil
+}
@@ -0,0 +1,144 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudPolarDBCluster_basic(t *testing.T) {
+	var cluster PolarDBCluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_polardb_cluster.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPolarDBClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPolarDBClusterConfig("tf-test-polardb", "\"10.168.1.12\", \"100.69.7.112\""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolarDBClusterExists(
+						"alicloud_polardb_cluster.foo", &cluster),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_cluster.foo",
+						"db_type",
+						"MySQL"),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_cluster.foo",
+						"description",
+						"tf-test-polardb"),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_cluster.foo",
+						"security_ips.#",
+						"2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccPolarDBClusterConfig("tf-test-polardb-update", "\"10.168.1.12\""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolarDBClusterExists(
+						"alicloud_polardb_cluster.foo", &cluster),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_cluster.foo",
+						"description",
+						"tf-test-polardb-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_cluster.foo",
+						"security_ips.#",
+						"1"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckPolarDBClusterExists(n string, cluster *PolarDBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No PolarDB cluster ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		attr, err := client.DescribePolarDBClusterById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*cluster = *attr
+		return nil
+	}
+}
+
+func testAccCheckPolarDBClusterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_polardb_cluster" {
+			continue
+		}
+
+		if _, err := client.DescribePolarDBClusterById(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "polardb") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("PolarDB cluster %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPolarDBClusterConfig(description, ips string) string {
+	return fmt.Sprintf(testAccPolarDBClusterBase+`
+resource "alicloud_polardb_cluster" "foo" {
+	db_type = "MySQL"
+	db_version = "8.0"
+	db_node_class = "polar.mysql.x4.large"
+	description = "%s"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+	security_ips = [%s]
+}
+`, description, ips)
+}
+
+const testAccPolarDBClusterBase = `
+data "alicloud_zones" "default" {
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+`
+
+const testAccPolarDBClusterForSub = testAccPolarDBClusterBase + `
+resource "alicloud_polardb_cluster" "foo" {
+	db_type = "MySQL"
+	db_version = "8.0"
+	db_node_class = "polar.mysql.x4.large"
+	description = "tf-test-polardb"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`
@@ -0,0 +1,166 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudPolarDBDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudPolarDBDatabaseCreate,
+		Read:   resourceAlicloudPolarDBDatabaseRead,
+		Update: resourceAlicloudPolarDBDatabaseUpdate,
+		Delete: resourceAlicloudPolarDBDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"character_set_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "utf8",
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateInstanceDescription,
+				Optional:     true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudPolarDBDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	clusterId := d.Get("db_cluster_id").(string)
+	name := d.Get("db_name").(string)
+
+	args := CreatePolarDBDatabaseArgs{
+		RegionId:         client.Region,
+		DBClusterId:      clusterId,
+		DBName:           name,
+		CharacterSetName: d.Get("character_set_name").(string),
+		DBDescription:    d.Get("description").(string),
+	}
+
+	err := resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.polardbconn().Invoke("CreateDatabase", &args, &PolarDBResponse{}); err != nil {
+			if IsExceptedError(err, PolarDBOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("PolarDB cluster %s is busy - trying again while it is Running.", clusterId))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapError(err, "CreateDatabase", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, name))
+
+	if err := client.WaitForPolarDBDatabase(clusterId, name, PolarDBRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForPolarDBDatabase %s got an error: %#v", PolarDBRunning, err)
+	}
+
+	return resourceAlicloudPolarDBDatabaseRead(d, meta)
+}
+
+func resourceAlicloudPolarDBDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("description") {
+		args := ModifyPolarDBDatabaseDescriptionArgs{
+			RegionId:      client.Region,
+			DBClusterId:   parts[0],
+			DBName:        parts[1],
+			DBDescription: d.Get("description").(string),
+		}
+		if err := client.polardbconn().Invoke("ModifyDBDescription", &args, &PolarDBResponse{}); err != nil {
+			return WrapError(err, "ModifyDBDescription", d.Id())
+		}
+	}
+
+	return resourceAlicloudPolarDBDatabaseRead(d, meta)
+}
+
+func resourceAlicloudPolarDBDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	db, err := meta.(*AliyunClient).DescribePolarDBDatabase(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "polardb") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDatabases", d.Id())
+	}
+
+	d.Set("db_cluster_id", parts[0])
+	d.Set("db_name", db.DBName)
+	d.Set("character_set_name", db.CharacterSetName)
+	d.Set("description", db.DBDescription)
+
+	return nil
+}
+
+func resourceAlicloudPolarDBDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := PolarDBDatabaseArgs{
+		RegionId:    client.Region,
+		DBClusterId: parts[0],
+		DBName:      parts[1],
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.polardbconn().Invoke("DeleteDatabase", &args, &PolarDBResponse{}); err != nil {
+			if IsExceptedError(err, PolarDBOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("PolarDB cluster %s is busy - trying again while it is Running.", parts[0]))
+			}
+			if !NotFoundError(err, "polardb") {
+				return resource.NonRetryableError(WrapError(err, "DeleteDatabase", d.Id()))
+			}
+		}
+
+		if _, err := client.DescribePolarDBDatabase(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "polardb") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete PolarDB database %s timeout.", parts[1]))
+	})
+}
@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudPolarDBDatabase_basic(t *testing.T) {
+	var db PolarDBDatabase
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_polardb_database.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPolarDBDatabaseDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPolarDBDatabaseConfig("from terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolarDBDatabaseExists(
+						"alicloud_polardb_database.foo", &db),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_database.foo",
+						"db_name",
+						"tftestdatabase"),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_database.foo",
+						"character_set_name",
+						"utf8"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccPolarDBDatabaseConfig("from terraform update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolarDBDatabaseExists(
+						"alicloud_polardb_database.foo", &db),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_database.foo",
+						"description",
+						"from terraform update"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckPolarDBDatabaseExists(n string, db *PolarDBDatabase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No PolarDB database ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		d, err := client.DescribePolarDBDatabase(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*db = *d
+		return nil
+	}
+}
+
+func testAccCheckPolarDBDatabaseDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_polardb_database" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribePolarDBDatabase(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "polardb") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("PolarDB database %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPolarDBDatabaseConfig(description string) string {
+	return fmt.Sprintf(testAccPolarDBClusterForSub+`
+resource "alicloud_polardb_database" "foo" {
+	db_cluster_id = "${alicloud_polardb_cluster.foo.id}"
+	db_name = "tftestdatabase"
+	description = "%s"
+}
+`, description)
+}
@@ -0,0 +1,284 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudPolarDBEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudPolarDBEndpointCreate,
+		Read:   resourceAlicloudPolarDBEndpointRead,
+		Update: resourceAlicloudPolarDBEndpointUpdate,
+		Delete: resourceAlicloudPolarDBEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"endpoint_type": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"Custom"}),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Custom",
+			},
+			"nodes": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"read_write_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"ReadWrite", "ReadOnly"}),
+				Optional:     true,
+				Default:      "ReadOnly",
+			},
+			"auto_add_new_nodes": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"Enable", "Disable"}),
+				Optional:     true,
+				Default:      "Disable",
+			},
+			"endpoint_config": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_string": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudPolarDBEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	clusterId := d.Get("db_cluster_id").(string)
+
+	args := CreatePolarDBEndpointArgs{
+		RegionId:        client.Region,
+		DBClusterId:     clusterId,
+		EndpointType:    d.Get("endpoint_type").(string),
+		Nodes:           strings.Join(expandStringList(d.Get("nodes").(*schema.Set).List()), COMMA_SEPARATED),
+		ReadWriteMode:   d.Get("read_write_mode").(string),
+		AutoAddNewNodes: d.Get("auto_add_new_nodes").(string),
+	}
+	config, err := buildPolarDBEndpointConfig(d)
+	if err != nil {
+		return err
+	}
+	args.EndpointConfig = config
+
+	before, err := describePolarDBEndpointIds(client, clusterId)
+	if err != nil {
+		return err
+	}
+
+	err = resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.polardbconn().Invoke("CreateDBClusterEndpoint", &args, &PolarDBResponse{}); err != nil {
+			if IsExceptedError(err, PolarDBOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("PolarDB cluster %s is busy - trying again while it is Running.", clusterId))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapError(err, "CreateDBClusterEndpoint", d.Id())
+	}
+
+	// CreateDBClusterEndpoint does not return the endpoint id, so find out the new one.
+	after, err := describePolarDBEndpointIds(client, clusterId)
+	if err != nil {
+		return err
+	}
+	for id := range after {
+		if _, ok := before[id]; !ok {
+			d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, id))
+			break
+		}
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("Error get the endpoint id of PolarDB cluster %s", clusterId)
+	}
+
+	if err := client.WaitForPolarDBCluster(clusterId, PolarDBRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForPolarDBCluster %s got an error: %#v", PolarDBRunning, err)
+	}
+
+	return resourceAlicloudPolarDBEndpointRead(d, meta)
+}
+
+func resourceAlicloudPolarDBEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("nodes") || d.HasChange("read_write_mode") || d.HasChange("auto_add_new_nodes") || d.HasChange("endpoint_config") {
+		args := ModifyPolarDBEndpointArgs{
+			RegionId:     client.Region,
+			DBClusterId:  parts[0],
+			DBEndpointId: parts[1],
+		}
+		if d.HasChange("nodes") {
+			args.Nodes = strings.Join(expandStringList(d.Get("nodes").(*schema.Set).List()), COMMA_SEPARATED)
+		}
+		if d.HasChange("read_write_mode") {
+			args.ReadWriteMode = d.Get("read_write_mode").(string)
+		}
+		if d.HasChange("auto_add_new_nodes") {
+			args.AutoAddNewNodes = d.Get("auto_add_new_nodes").(string)
+		}
+		if d.HasChange("endpoint_config") {
+			config, err := buildPolarDBEndpointConfig(d)
+			if err != nil {
+				return err
+			}
+			args.EndpointConfig = config
+		}
+
+		if err := client.polardbconn().Invoke("ModifyDBClusterEndpoint", &args, &PolarDBResponse{}); err != nil {
+			return WrapError(err, "ModifyDBClusterEndpoint", d.Id())
+		}
+
+		if err := client.WaitForPolarDBCluster(parts[0], PolarDBRunning, deadline.Seconds()); err != nil {
+			return fmt.Errorf("WaitForPolarDBCluster %s got an error: %#v", PolarDBRunning, err)
+		}
+	}
+
+	return resourceAlicloudPolarDBEndpointRead(d, meta)
+}
+
+func resourceAlicloudPolarDBEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := meta.(*AliyunClient).DescribePolarDBEndpoint(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "polardb") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDBClusterEndpoints", d.Id())
+	}
+
+	d.Set("db_cluster_id", parts[0])
+	d.Set("endpoint_type", endpoint.EndpointType)
+	if err := d.Set("nodes", strings.Split(endpoint.Nodes, COMMA_SEPARATED)); err != nil {
+		return err
+	}
+	d.Set("read_write_mode", endpoint.ReadWriteMode)
+	d.Set("auto_add_new_nodes", endpoint.AutoAddNewNodes)
+
+	config := make(map[string]interface{})
+	if endpoint.EndpointConfig != "" {
+		if err := json.Unmarshal([]byte(endpoint.EndpointConfig), &config); err != nil {
+			return fmt.Errorf("Unmarshalling PolarDB endpoint config %s got an error: %#v", endpoint.EndpointConfig, err)
+		}
+	}
+	if err := d.Set("endpoint_config", config); err != nil {
+		return err
+	}
+
+	for _, address := range endpoint.AddressItems {
+		if address.NetType == "Private" {
+			d.Set("connection_string", address.ConnectionString)
+			d.Set("port", address.Port)
+		}
+	}
+
+	return nil
+}
+
+func resourceAlicloudPolarDBEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := PolarDBEndpointArgs{
+		RegionId:     client.Region,
+		DBClusterId:  parts[0],
+		DBEndpointId: parts[1],
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.polardbconn().Invoke("DeleteDBClusterEndpoint", &args, &PolarDBResponse{}); err != nil {
+			if IsExceptedError(err, PolarDBOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("PolarDB cluster %s is busy - trying again while it is Running.", parts[0]))
+			}
+			if !NotFoundError(err, "polardb") {
+				return resource.NonRetryableError(WrapError(err, "DeleteDBClusterEndpoint", d.Id()))
+			}
+		}
+
+		if _, err := client.DescribePolarDBEndpoint(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "polardb") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete PolarDB endpoint %s timeout.", parts[1]))
+	})
+}
+
+func buildPolarDBEndpointConfig(d *schema.ResourceData) (string, error) {
+	config := d.Get("endpoint_config").(map[string]interface{})
+	if len(config) < 1 {
+		return "", nil
+	}
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("Marshalling PolarDB endpoint config got an error: %#v", err)
+	}
+	return string(b), nil
+}
+
+func describePolarDBEndpointIds(client *AliyunClient, clusterId string) (map[string]bool, error) {
+	args := PolarDBEndpointArgs{
+		RegionId:    client.Region,
+		DBClusterId: clusterId,
+	}
+	resp := DescribePolarDBEndpointsResponse{}
+	if err := client.polardbconn().Invoke("DescribeDBClusterEndpoints", &args, &resp); err != nil {
+		return nil, WrapError(err, "DescribeDBClusterEndpoints", "")
+	}
+
+	ids := make(map[string]bool)
+	for _, endpoint := range resp.Items {
+		ids[endpoint.DBEndpointId] = true
+	}
+	return ids, nil
+}
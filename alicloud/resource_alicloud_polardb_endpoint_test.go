@@ -0,0 +1,115 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudPolarDBEndpoint_basic(t *testing.T) {
+	var endpoint PolarDBEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_polardb_endpoint.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPolarDBEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPolarDBEndpointConfig("ReadOnly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolarDBEndpointExists(
+						"alicloud_polardb_endpoint.foo", &endpoint),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_endpoint.foo",
+						"read_write_mode",
+						"ReadOnly"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_polardb_endpoint.foo",
+						"connection_string"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccPolarDBEndpointConfig("ReadWrite"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolarDBEndpointExists(
+						"alicloud_polardb_endpoint.foo", &endpoint),
+					resource.TestCheckResourceAttr(
+						"alicloud_polardb_endpoint.foo",
+						"read_write_mode",
+						"ReadWrite"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckPolarDBEndpointExists(n string, endpoint *PolarDBEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No PolarDB endpoint ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		e, err := client.DescribePolarDBEndpoint(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*endpoint = *e
+		return nil
+	}
+}
+
+func testAccCheckPolarDBEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_polardb_endpoint" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribePolarDBEndpoint(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "polardb") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("PolarDB endpoint %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPolarDBEndpointConfig(mode string) string {
+	return fmt.Sprintf(testAccPolarDBClusterForSub+`
+resource "alicloud_polardb_endpoint" "foo" {
+	db_cluster_id = "${alicloud_polardb_cluster.foo.id}"
+	nodes = ["${alicloud_polardb_cluster.foo.db_node_ids}"]
+	read_write_mode = "%s"
+}
+`, mode)
+}
@@ -0,0 +1,225 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudPrivateLinkVpcEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudPrivateLinkVpcEndpointCreate,
+		Read:   resourceAlicloudPrivateLinkVpcEndpointRead,
+		Update: resourceAlicloudPrivateLinkVpcEndpointUpdate,
+		Delete: resourceAlicloudPrivateLinkVpcEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The endpoint service is found by the service_id or the service_name, and the service_name is
+			// used for the endpoint services of the other accounts.
+			"service_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"service_name"},
+			},
+			"service_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"service_id"},
+			},
+			"security_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"endpoint_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+			"endpoint_description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"endpoint_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CreateVpcEndpointArgs{
+		RegionId:            client.Region,
+		ClientToken:         buildClientToken("tf-privatelink-endpoint-"),
+		VpcId:               d.Get("vpc_id").(string),
+		ServiceId:           d.Get("service_id").(string),
+		ServiceName:         d.Get("service_name").(string),
+		EndpointName:        d.Get("endpoint_name").(string),
+		EndpointDescription: d.Get("endpoint_description").(string),
+		SecurityGroupId:     expandStringList(d.Get("security_group_ids").(*schema.Set).List()),
+	}
+	if args.ServiceId == "" && args.ServiceName == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "One of the service_id and service_name is required.")
+	}
+	resp := CreateVpcEndpointResponse{}
+	if err := client.privatelinkconn().Invoke("CreateVpcEndpoint", &args, &resp); err != nil {
+		return WrapError(err, "CreateVpcEndpoint", d.Id())
+	}
+
+	d.SetId(resp.EndpointId)
+
+	if err := client.WaitForPrivateLinkVpcEndpoint(d.Id(), PrivateLinkActive, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForPrivateLinkVpcEndpoint", d.Id())
+	}
+
+	return resourceAlicloudPrivateLinkVpcEndpointRead(d, meta)
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	endpoint, err := client.DescribePrivateLinkVpcEndpoint(d.Id())
+	if err != nil {
+		if NotFoundError(err, "privatelink") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetVpcEndpointAttribute", d.Id())
+	}
+
+	d.Set("vpc_id", endpoint.VpcId)
+	d.Set("service_id", endpoint.ServiceId)
+	d.Set("service_name", endpoint.ServiceName)
+	d.Set("endpoint_name", endpoint.EndpointName)
+	d.Set("endpoint_description", endpoint.EndpointDescription)
+	d.Set("endpoint_domain", endpoint.EndpointDomain)
+	d.Set("connection_status", endpoint.ConnectionStatus)
+	d.Set("bandwidth", endpoint.Bandwidth)
+	d.Set("status", endpoint.EndpointStatus)
+
+	groups, err := client.DescribePrivateLinkVpcEndpointSecurityGroups(d.Id())
+	if err != nil {
+		return WrapError(err, "ListVpcEndpointSecurityGroups", d.Id())
+	}
+	if err := d.Set("security_group_ids", groups); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	if d.HasChange("endpoint_name") || d.HasChange("endpoint_description") {
+		args := UpdateVpcEndpointAttributeArgs{
+			RegionId:            client.Region,
+			EndpointId:          d.Id(),
+			EndpointName:        d.Get("endpoint_name").(string),
+			EndpointDescription: d.Get("endpoint_description").(string),
+		}
+		if err := client.privatelinkconn().Invoke("UpdateVpcEndpointAttribute", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateVpcEndpointAttribute", d.Id())
+		}
+		d.SetPartial("endpoint_name")
+		d.SetPartial("endpoint_description")
+	}
+
+	if d.HasChange("security_group_ids") {
+		o, n := d.GetChange("security_group_ids")
+		remove := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		add := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		// Attaches the new security groups first, as the endpoint needs at least one of them.
+		for _, id := range add {
+			args := PrivateLinkEndpointSecurityGroupArgs{
+				RegionId:        client.Region,
+				EndpointId:      d.Id(),
+				SecurityGroupId: id,
+			}
+			if err := client.privatelinkconn().Invoke("AttachSecurityGroupToVpcEndpoint", &args, &common.Response{}); err != nil {
+				return WrapError(err, "AttachSecurityGroupToVpcEndpoint", d.Id())
+			}
+		}
+		for _, id := range remove {
+			args := PrivateLinkEndpointSecurityGroupArgs{
+				RegionId:        client.Region,
+				EndpointId:      d.Id(),
+				SecurityGroupId: id,
+			}
+			if err := client.privatelinkconn().Invoke("DetachSecurityGroupFromVpcEndpoint", &args, &common.Response{}); err != nil {
+				return WrapError(err, "DetachSecurityGroupFromVpcEndpoint", d.Id())
+			}
+		}
+		d.SetPartial("security_group_ids")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudPrivateLinkVpcEndpointRead(d, meta)
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := PrivateLinkEndpointArgs{
+		RegionId:   client.Region,
+		EndpointId: d.Id(),
+	}
+	if err := client.privatelinkconn().Invoke("DeleteVpcEndpoint", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "privatelink") {
+			return nil
+		}
+		return WrapError(err, "DeleteVpcEndpoint", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribePrivateLinkVpcEndpoint(d.Id()); err != nil {
+			if NotFoundError(err, "privatelink") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "GetVpcEndpointAttribute", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("PrivateLink endpoint %s is still being deleted", d.Id()))
+	})
+}
@@ -0,0 +1,262 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudPrivateLinkVpcEndpointService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudPrivateLinkVpcEndpointServiceCreate,
+		Read:   resourceAlicloudPrivateLinkVpcEndpointServiceRead,
+		Update: resourceAlicloudPrivateLinkVpcEndpointServiceUpdate,
+		Delete: resourceAlicloudPrivateLinkVpcEndpointServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// The load_balancer_ids are the intranet SLB instances which serve the requests to the endpoint service.
+			"load_balancer_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"service_description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auto_accept_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"payer": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      PrivateLinkPayerEndpoint,
+				ValidateFunc: validateAllowedStringValue([]string{PrivateLinkPayerEndpoint, PrivateLinkPayerEndpointService}),
+			},
+			"connect_bandwidth": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(100, 1024),
+			},
+			// The allowed_principals are the ids of the accounts which are allowed to create endpoints
+			// connecting to the endpoint service.
+			"allowed_principals": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"service_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CreateVpcEndpointServiceArgs{
+		RegionId:           client.Region,
+		ClientToken:        buildClientToken("tf-privatelink-service-"),
+		ServiceDescription: d.Get("service_description").(string),
+		AutoAcceptEnabled:  d.Get("auto_accept_enabled").(bool),
+		Payer:              d.Get("payer").(string),
+	}
+	for _, id := range expandStringList(d.Get("load_balancer_ids").(*schema.Set).List()) {
+		args.Resource = append(args.Resource, PrivateLinkServiceResource{
+			ResourceType: PrivateLinkResourceTypeSlb,
+			ResourceId:   id,
+		})
+	}
+	resp := CreateVpcEndpointServiceResponse{}
+	if err := client.privatelinkconn().Invoke("CreateVpcEndpointService", &args, &resp); err != nil {
+		return WrapError(err, "CreateVpcEndpointService", d.Id())
+	}
+
+	d.SetId(resp.ServiceId)
+
+	if err := client.WaitForPrivateLinkVpcEndpointService(d.Id(), PrivateLinkActive, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForPrivateLinkVpcEndpointService", d.Id())
+	}
+
+	return resourceAlicloudPrivateLinkVpcEndpointServiceUpdate(d, meta)
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	service, err := client.DescribePrivateLinkVpcEndpointService(d.Id())
+	if err != nil {
+		if NotFoundError(err, "privatelink") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetVpcEndpointServiceAttribute", d.Id())
+	}
+
+	d.Set("service_description", service.ServiceDescription)
+	d.Set("auto_accept_enabled", service.AutoAcceptEnabled)
+	d.Set("payer", service.Payer)
+	d.Set("connect_bandwidth", service.ConnectBandwidth)
+	d.Set("service_name", service.ServiceName)
+	d.Set("service_domain", service.ServiceDomain)
+	d.Set("status", service.ServiceStatus)
+
+	resources, err := client.DescribePrivateLinkVpcEndpointServiceResources(d.Id())
+	if err != nil {
+		return WrapError(err, "ListVpcEndpointServiceResources", d.Id())
+	}
+	if err := d.Set("load_balancer_ids", resources); err != nil {
+		return err
+	}
+
+	users, err := client.DescribePrivateLinkVpcEndpointServiceUsers(d.Id())
+	if err != nil {
+		return WrapError(err, "ListVpcEndpointServiceUsers", d.Id())
+	}
+	if err := d.Set("allowed_principals", users); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	if !d.IsNewResource() && (d.HasChange("service_description") || d.HasChange("auto_accept_enabled") ||
+		d.HasChange("connect_bandwidth")) {
+		args := UpdateVpcEndpointServiceAttributeArgs{
+			RegionId:           client.Region,
+			ServiceId:          d.Id(),
+			ServiceDescription: d.Get("service_description").(string),
+			AutoAcceptEnabled:  d.Get("auto_accept_enabled").(bool),
+			ConnectBandwidth:   d.Get("connect_bandwidth").(int),
+		}
+		if err := client.privatelinkconn().Invoke("UpdateVpcEndpointServiceAttribute", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateVpcEndpointServiceAttribute", d.Id())
+		}
+		d.SetPartial("service_description")
+		d.SetPartial("auto_accept_enabled")
+		d.SetPartial("connect_bandwidth")
+	}
+
+	// The load balancers are attached by the CreateVpcEndpointService at the creation.
+	if !d.IsNewResource() && d.HasChange("load_balancer_ids") {
+		o, n := d.GetChange("load_balancer_ids")
+		remove := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		add := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		// Attaches the new load balancers first, as the endpoint service needs at least one of them.
+		for _, id := range add {
+			args := PrivateLinkServiceResourceArgs{
+				RegionId:     client.Region,
+				ServiceId:    d.Id(),
+				ResourceType: PrivateLinkResourceTypeSlb,
+				ResourceId:   id,
+			}
+			if err := client.privatelinkconn().Invoke("AttachResourceToVpcEndpointService", &args, &common.Response{}); err != nil {
+				return WrapError(err, "AttachResourceToVpcEndpointService", d.Id())
+			}
+		}
+		for _, id := range remove {
+			args := PrivateLinkServiceResourceArgs{
+				RegionId:     client.Region,
+				ServiceId:    d.Id(),
+				ResourceType: PrivateLinkResourceTypeSlb,
+				ResourceId:   id,
+			}
+			if err := client.privatelinkconn().Invoke("DetachResourceFromVpcEndpointService", &args, &common.Response{}); err != nil {
+				return WrapError(err, "DetachResourceFromVpcEndpointService", d.Id())
+			}
+		}
+		d.SetPartial("load_balancer_ids")
+	}
+
+	if d.HasChange("allowed_principals") {
+		o, n := d.GetChange("allowed_principals")
+		remove := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		add := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		for _, user := range remove {
+			args := PrivateLinkServiceUserArgs{
+				RegionId:  client.Region,
+				ServiceId: d.Id(),
+				UserId:    user,
+			}
+			if err := client.privatelinkconn().Invoke("RemoveUserFromVpcEndpointService", &args, &common.Response{}); err != nil {
+				return WrapError(err, "RemoveUserFromVpcEndpointService", d.Id())
+			}
+		}
+		for _, user := range add {
+			args := PrivateLinkServiceUserArgs{
+				RegionId:  client.Region,
+				ServiceId: d.Id(),
+				UserId:    user,
+			}
+			if err := client.privatelinkconn().Invoke("AddUserToVpcEndpointService", &args, &common.Response{}); err != nil {
+				return WrapError(err, "AddUserToVpcEndpointService", d.Id())
+			}
+		}
+		d.SetPartial("allowed_principals")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudPrivateLinkVpcEndpointServiceRead(d, meta)
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	args := PrivateLinkServiceArgs{
+		RegionId:  client.Region,
+		ServiceId: d.Id(),
+	}
+	if err := client.privatelinkconn().Invoke("DeleteVpcEndpointService", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "privatelink") {
+			return nil
+		}
+		return WrapError(err, "DeleteVpcEndpointService", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribePrivateLinkVpcEndpointService(d.Id()); err != nil {
+			if NotFoundError(err, "privatelink") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "GetVpcEndpointServiceAttribute", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("PrivateLink endpoint service %s is still being deleted", d.Id()))
+	})
+}
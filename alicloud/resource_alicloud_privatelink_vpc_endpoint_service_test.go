@@ -0,0 +1,136 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudPrivateLinkVpcEndpointService_basic(t *testing.T) {
+	var v VpcEndpointServiceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_privatelink_vpc_endpoint_service.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPrivateLinkVpcEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPrivateLinkVpcEndpointServiceConfig("tf-testAccPrivateLinkVpcEndpointService", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateLinkVpcEndpointServiceExists(
+						"alicloud_privatelink_vpc_endpoint_service.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint_service.foo",
+						"load_balancer_ids.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint_service.foo",
+						"auto_accept_enabled",
+						"false"),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint_service.foo",
+						"status",
+						"Active"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_privatelink_vpc_endpoint_service.foo",
+						"service_name"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccPrivateLinkVpcEndpointServiceConfig("tf-testAccPrivateLinkVpcEndpointService-update", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateLinkVpcEndpointServiceExists(
+						"alicloud_privatelink_vpc_endpoint_service.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint_service.foo",
+						"service_description",
+						"tf-testAccPrivateLinkVpcEndpointService-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint_service.foo",
+						"auto_accept_enabled",
+						"true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPrivateLinkVpcEndpointServiceExists(n string, d *VpcEndpointServiceAttribute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No PrivateLink endpoint service ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		service, err := client.DescribePrivateLinkVpcEndpointService(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *service
+		return nil
+	}
+}
+
+func testAccCheckPrivateLinkVpcEndpointServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_privatelink_vpc_endpoint_service" {
+			continue
+		}
+
+		if _, err := client.DescribePrivateLinkVpcEndpointService(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "privatelink") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("PrivateLink endpoint service %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPrivateLinkVpcEndpointServiceConfig(description string, autoAccept bool) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+  "available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf-testAccPrivateLinkVpcEndpointService"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_slb" "foo" {
+  name = "tf-testAccPrivateLinkVpcEndpointService"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+
+resource "alicloud_privatelink_vpc_endpoint_service" "foo" {
+  load_balancer_ids = ["${alicloud_slb.foo.id}"]
+  service_description = "%s"
+  auto_accept_enabled = %t
+}
+`, description, autoAccept)
+}
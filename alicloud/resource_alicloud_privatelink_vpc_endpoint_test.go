@@ -0,0 +1,149 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudPrivateLinkVpcEndpoint_basic(t *testing.T) {
+	var v VpcEndpointAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_privatelink_vpc_endpoint.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPrivateLinkVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPrivateLinkVpcEndpointConfig("tf-testAccPrivateLinkVpcEndpoint"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateLinkVpcEndpointExists(
+						"alicloud_privatelink_vpc_endpoint.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint.foo",
+						"endpoint_name",
+						"tf-testAccPrivateLinkVpcEndpoint"),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint.foo",
+						"security_group_ids.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint.foo",
+						"status",
+						"Active"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_privatelink_vpc_endpoint.foo",
+						"service_name"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccPrivateLinkVpcEndpointConfig("tf-testAccPrivateLinkVpcEndpoint-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateLinkVpcEndpointExists(
+						"alicloud_privatelink_vpc_endpoint.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint.foo",
+						"endpoint_name",
+						"tf-testAccPrivateLinkVpcEndpoint-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPrivateLinkVpcEndpointExists(n string, d *VpcEndpointAttribute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No PrivateLink endpoint ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		endpoint, err := client.DescribePrivateLinkVpcEndpoint(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *endpoint
+		return nil
+	}
+}
+
+func testAccCheckPrivateLinkVpcEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_privatelink_vpc_endpoint" {
+			continue
+		}
+
+		if _, err := client.DescribePrivateLinkVpcEndpoint(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "privatelink") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("PrivateLink endpoint %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPrivateLinkVpcEndpointConfig(name string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+  "available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf-testAccPrivateLinkVpcEndpoint"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_slb" "foo" {
+  name = "tf-testAccPrivateLinkVpcEndpoint"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+
+resource "alicloud_privatelink_vpc_endpoint_service" "foo" {
+  load_balancer_ids = ["${alicloud_slb.foo.id}"]
+  service_description = "tf-testAccPrivateLinkVpcEndpoint"
+  auto_accept_enabled = true
+}
+
+resource "alicloud_vpc" "consumer" {
+  name = "tf-testAccPrivateLinkVpcEndpoint-consumer"
+  cidr_block = "192.168.0.0/16"
+}
+
+resource "alicloud_security_group" "foo" {
+  name = "tf-testAccPrivateLinkVpcEndpoint"
+  vpc_id = "${alicloud_vpc.consumer.id}"
+}
+
+resource "alicloud_privatelink_vpc_endpoint" "foo" {
+  vpc_id = "${alicloud_vpc.consumer.id}"
+  service_id = "${alicloud_privatelink_vpc_endpoint_service.foo.id}"
+  security_group_ids = ["${alicloud_security_group.foo.id}"]
+  endpoint_name = "%s"
+}
+`, name)
+}
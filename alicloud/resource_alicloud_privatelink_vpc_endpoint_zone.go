@@ -0,0 +1,145 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudPrivateLinkVpcEndpointZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudPrivateLinkVpcEndpointZoneCreate,
+		Read:   resourceAlicloudPrivateLinkVpcEndpointZoneRead,
+		Delete: resourceAlicloudPrivateLinkVpcEndpointZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"eni_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"eni_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := PrivateLinkEndpointZoneArgs{
+		RegionId:    client.Region,
+		ClientToken: buildClientToken("tf-privatelink-zone-"),
+		EndpointId:  d.Get("endpoint_id").(string),
+		ZoneId:      d.Get("zone_id").(string),
+		VSwitchId:   d.Get("vswitch_id").(string),
+	}
+	if err := client.privatelinkconn().Invoke("AddZoneToVpcEndpoint", &args, &common.Response{}); err != nil {
+		return WrapError(err, "AddZoneToVpcEndpoint", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.EndpointId, COLON_SEPARATED, args.ZoneId))
+
+	// The zone stays in Wait until the endpoint service accepts the connection, which is done automatically
+	// only when the auto_accept_enabled of the endpoint service is true.
+	if err := client.WaitForPrivateLinkVpcEndpointZone(args.EndpointId, args.ZoneId,
+		[]string{PrivateLinkZoneWait, PrivateLinkZoneConnected}, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForPrivateLinkVpcEndpointZone", d.Id())
+	}
+
+	return resourceAlicloudPrivateLinkVpcEndpointZoneRead(d, meta)
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointZoneRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	zone, err := meta.(*AliyunClient).DescribePrivateLinkVpcEndpointZone(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "privatelink") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "ListVpcEndpointZones", d.Id())
+	}
+
+	d.Set("endpoint_id", parts[0])
+	d.Set("zone_id", zone.ZoneId)
+	d.Set("vswitch_id", zone.VSwitchId)
+	d.Set("eni_id", zone.EniId)
+	d.Set("eni_ip", zone.EniIp)
+	d.Set("zone_domain", zone.ZoneDomain)
+	d.Set("status", zone.ZoneStatus)
+
+	return nil
+}
+
+func resourceAlicloudPrivateLinkVpcEndpointZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := PrivateLinkEndpointZoneArgs{
+		RegionId:   client.Region,
+		EndpointId: parts[0],
+		ZoneId:     parts[1],
+	}
+	if err := client.privatelinkconn().Invoke("RemoveZoneFromVpcEndpoint", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "privatelink") {
+			return nil
+		}
+		return WrapError(err, "RemoveZoneFromVpcEndpoint", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribePrivateLinkVpcEndpointZone(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "privatelink") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "ListVpcEndpointZones", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("PrivateLink endpoint zone %s is still being removed", d.Id()))
+	})
+}
@@ -0,0 +1,148 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudPrivateLinkVpcEndpointZone_basic(t *testing.T) {
+	var v VpcEndpointZone
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_privatelink_vpc_endpoint_zone.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPrivateLinkVpcEndpointZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPrivateLinkVpcEndpointZoneConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateLinkVpcEndpointZoneExists(
+						"alicloud_privatelink_vpc_endpoint_zone.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_privatelink_vpc_endpoint_zone.foo",
+						"status",
+						"Connected"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_privatelink_vpc_endpoint_zone.foo",
+						"eni_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPrivateLinkVpcEndpointZoneExists(n string, d *VpcEndpointZone) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No PrivateLink endpoint zone ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		zone, err := client.DescribePrivateLinkVpcEndpointZone(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *zone
+		return nil
+	}
+}
+
+func testAccCheckPrivateLinkVpcEndpointZoneDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_privatelink_vpc_endpoint_zone" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribePrivateLinkVpcEndpointZone(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "privatelink") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("PrivateLink endpoint zone %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccPrivateLinkVpcEndpointZoneConfig = `
+data "alicloud_zones" "default" {
+  "available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf-testAccPrivateLinkVpcEndpointZone"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_slb" "foo" {
+  name = "tf-testAccPrivateLinkVpcEndpointZone"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+
+resource "alicloud_privatelink_vpc_endpoint_service" "foo" {
+  load_balancer_ids = ["${alicloud_slb.foo.id}"]
+  auto_accept_enabled = true
+}
+
+resource "alicloud_vpc" "consumer" {
+  name = "tf-testAccPrivateLinkVpcEndpointZone-consumer"
+  cidr_block = "192.168.0.0/16"
+}
+
+resource "alicloud_vswitch" "consumer" {
+  vpc_id = "${alicloud_vpc.consumer.id}"
+  cidr_block = "192.168.0.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+  name = "tf-testAccPrivateLinkVpcEndpointZone"
+  vpc_id = "${alicloud_vpc.consumer.id}"
+}
+
+resource "alicloud_privatelink_vpc_endpoint" "foo" {
+  vpc_id = "${alicloud_vpc.consumer.id}"
+  service_id = "${alicloud_privatelink_vpc_endpoint_service.foo.id}"
+  security_group_ids = ["${alicloud_security_group.foo.id}"]
+}
+
+resource "alicloud_privatelink_vpc_endpoint_zone" "foo" {
+  endpoint_id = "${alicloud_privatelink_vpc_endpoint.foo.id}"
+  zone_id = "${data.alicloud_zones.default.zones.0.id}"
+  vswitch_id = "${alicloud_vswitch.consumer.id}"
+}
+`
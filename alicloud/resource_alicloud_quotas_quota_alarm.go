@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudQuotasQuotaAlarm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudQuotasQuotaAlarmCreate,
+		Read:   resourceAlicloudQuotasQuotaAlarmRead,
+		Update: resourceAlicloudQuotasQuotaAlarmUpdate,
+		Delete: resourceAlicloudQuotasQuotaAlarmDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"quota_alarm_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"product_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quota_action_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quota_dimensions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			// The alarm is triggered when the usage reaches either the threshold or the threshold_percent of
+			// the quota, and one of them is required.
+			"threshold": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"threshold_percent": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"web_hook": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudQuotasQuotaAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	threshold, thresholdPercent, err := quotaAlarmThresholds(d)
+	if err != nil {
+		return err
+	}
+
+	args := CreateQuotaAlarmArgs{
+		AlarmName:        d.Get("quota_alarm_name").(string),
+		ProductCode:      d.Get("product_code").(string),
+		QuotaActionCode:  d.Get("quota_action_code").(string),
+		QuotaDimensions:  expandQuotaDimensions(d.Get("quota_dimensions").([]interface{})),
+		Threshold:        formatQuotaThreshold(threshold),
+		ThresholdPercent: formatQuotaThreshold(thresholdPercent),
+		WebHook:          d.Get("web_hook").(string),
+	}
+	resp := CreateQuotaAlarmResponse{}
+	if err := client.quotasconn().Invoke("CreateQuotaAlarm", &args, &resp); err != nil {
+		return WrapError(err, "CreateQuotaAlarm", d.Id())
+	}
+
+	d.SetId(resp.AlarmId)
+
+	return resourceAlicloudQuotasQuotaAlarmRead(d, meta)
+}
+
+func resourceAlicloudQuotasQuotaAlarmRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	alarm, err := client.DescribeQuotaAlarm(d.Id())
+	if err != nil {
+		if NotFoundError(err, "quotas") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetQuotaAlarm", d.Id())
+	}
+
+	d.Set("quota_alarm_name", alarm.AlarmName)
+	d.Set("product_code", alarm.ProductCode)
+	d.Set("quota_action_code", alarm.QuotaActionCode)
+	d.Set("threshold", alarm.Threshold)
+	d.Set("threshold_percent", alarm.ThresholdPercent)
+	d.Set("web_hook", alarm.WebHook)
+	if err := d.Set("quota_dimensions", flattenQuotaDimensions(alarm.QuotaDimensions)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudQuotasQuotaAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("quota_alarm_name") || d.HasChange("threshold") || d.HasChange("threshold_percent") ||
+		d.HasChange("web_hook") {
+		threshold, thresholdPercent, err := quotaAlarmThresholds(d)
+		if err != nil {
+			return err
+		}
+
+		args := UpdateQuotaAlarmArgs{
+			AlarmId:          d.Id(),
+			AlarmName:        d.Get("quota_alarm_name").(string),
+			Threshold:        formatQuotaThreshold(threshold),
+			ThresholdPercent: formatQuotaThreshold(thresholdPercent),
+			WebHook:          d.Get("web_hook").(string),
+		}
+		if err := client.quotasconn().Invoke("UpdateQuotaAlarm", &args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateQuotaAlarm", d.Id())
+		}
+	}
+
+	return resourceAlicloudQuotasQuotaAlarmRead(d, meta)
+}
+
+func resourceAlicloudQuotasQuotaAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := QuotaAlarmArgs{
+		AlarmId: d.Id(),
+	}
+	if err := client.quotasconn().Invoke("DeleteQuotaAlarm", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "quotas") {
+			return nil
+		}
+		return WrapError(err, "DeleteQuotaAlarm", d.Id())
+	}
+
+	return nil
+}
+
+// quotaAlarmThresholds returns the threshold and the threshold_percent, one of which must be set.
+func quotaAlarmThresholds(d *schema.ResourceData) (float64, float64, error) {
+	threshold := d.Get("threshold").(float64)
+	thresholdPercent := d.Get("threshold_percent").(float64)
+	if threshold == 0 && thresholdPercent == 0 {
+		return 0, 0, ConfigErrorf(ErrorCodeMissingArgument, "One of threshold and threshold_percent is required.")
+	}
+	if thresholdPercent < 0 || thresholdPercent > 100 {
+		return 0, 0, ConfigErrorf(ErrorCodeInvalidArgument, "The threshold_percent %v must be between 0 and 100.", thresholdPercent)
+	}
+	return threshold, thresholdPercent, nil
+}
@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudQuotasQuotaAlarm_basic(t *testing.T) {
+	var v QuotaAlarm
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_quotas_quota_alarm.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuotasQuotaAlarmDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccQuotasQuotaAlarmConfig("tf-testAccQuotasQuotaAlarm", 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuotasQuotaAlarmExists(
+						"alicloud_quotas_quota_alarm.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_quotas_quota_alarm.foo",
+						"product_code",
+						"ecs"),
+					resource.TestCheckResourceAttr(
+						"alicloud_quotas_quota_alarm.foo",
+						"threshold_percent",
+						"80"),
+					resource.TestCheckResourceAttr(
+						"alicloud_quotas_quota_alarm.foo",
+						"quota_dimensions.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccQuotasQuotaAlarmConfig("tf-testAccQuotasQuotaAlarm-update", 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuotasQuotaAlarmExists(
+						"alicloud_quotas_quota_alarm.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_quotas_quota_alarm.foo",
+						"quota_alarm_name",
+						"tf-testAccQuotasQuotaAlarm-update"),
+					resource.TestCheckResourceAttr(
+						"alicloud_quotas_quota_alarm.foo",
+						"threshold_percent",
+						"90"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuotasQuotaAlarmExists(n string, d *QuotaAlarm) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No quota alarm ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		alarm, err := client.DescribeQuotaAlarm(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *alarm
+		return nil
+	}
+}
+
+func testAccCheckQuotasQuotaAlarmDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_quotas_quota_alarm" {
+			continue
+		}
+
+		if _, err := client.DescribeQuotaAlarm(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "quotas") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Quota alarm %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccQuotasQuotaAlarmConfig(name string, thresholdPercent int) string {
+	return fmt.Sprintf(`
+resource "alicloud_quotas_quota_alarm" "foo" {
+  quota_alarm_name = "%s"
+  product_code = "ecs"
+  quota_action_code = "q_prepaid-instance-count-per-once-purchase"
+  threshold_percent = %d
+  quota_dimensions = [
+    {
+      key = "regionId"
+      value = "cn-hangzhou"
+    },
+  ]
+}
+`, name, thresholdPercent)
+}
@@ -14,6 +14,13 @@ func resourceAlicloudRamAccessKey() *schema.Resource {
 		Read:   resourceAlicloudRamAccessKeyRead,
 		Update: resourceAlicloudRamAccessKeyUpdate,
 		Delete: resourceAlicloudRamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamAccessKeyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": &schema.Schema{
@@ -38,7 +45,7 @@ func resourceAlicloudRamAccessKey() *schema.Resource {
 }
 
 func resourceAlicloudRamAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{}
 	if v, ok := d.GetOk("user_name"); ok && v.(string) != "" {
@@ -47,7 +54,7 @@ func resourceAlicloudRamAccessKeyCreate(d *schema.ResourceData, meta interface{}
 
 	response, err := conn.CreateAccessKey(args)
 	if err != nil {
-		return fmt.Errorf("CreateAccessKey got an error: %#v", err)
+		return WrapError(err, "CreateAccessKey", d.Id())
 	}
 
 	// create a secret_file and write access key to it.
@@ -60,7 +67,7 @@ func resourceAlicloudRamAccessKeyCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAlicloudRamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -75,7 +82,7 @@ func resourceAlicloudRamAccessKeyUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("status") {
 		d.SetPartial("status")
 		if _, err := conn.UpdateAccessKey(args); err != nil {
-			return fmt.Errorf("UpdateAccessKey got an error: %#v", err)
+			return WrapError(err, "UpdateAccessKey", d.Id())
 		}
 	}
 
@@ -84,7 +91,7 @@ func resourceAlicloudRamAccessKeyUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAlicloudRamAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{}
 	if v, ok := d.GetOk("user_name"); ok && v.(string) != "" {
@@ -113,7 +120,8 @@ func resourceAlicloudRamAccessKeyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAlicloudRamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := ram.UpdateAccessKeyRequest{
 		UserAccessKeyId: d.Id(),
@@ -126,7 +134,7 @@ func resourceAlicloudRamAccessKeyDelete(d *schema.ResourceData, meta interface{}
 		queryArgs.UserName = v.(string)
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DeleteAccessKey(args); err != nil {
 			if RamEntityNotExist(err) {
 				return nil
@@ -149,3 +157,16 @@ func resourceAlicloudRamAccessKeyDelete(d *schema.ResourceData, meta interface{}
 		return resource.RetryableError(fmt.Errorf("Error deleting access key - trying again while it is deleted."))
 	})
 }
+
+// resourceAlicloudRamAccessKeyImport imports the access key by the id in the format <user_name>:<access_key_id>.
+// The secret of the access key can not be imported.
+func resourceAlicloudRamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("user_name", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -54,7 +54,7 @@ func testAccCheckRamAccessKeyExists(n string, ak *ram.AccessKey) resource.TestCh
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.UserQueryRequest{
 			UserName: rs.Primary.Attributes["user_name"],
@@ -86,7 +86,7 @@ func testAccCheckRamAccessKeyDestroy(s *terraform.State) error {
 
 		// Try to find the ak
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.UserQueryRequest{
 			UserName: rs.Primary.Attributes["user_name"],
@@ -18,6 +18,10 @@ func resourceAlicloudRamGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -39,7 +43,7 @@ func resourceAlicloudRamGroup() *schema.Resource {
 }
 
 func resourceAlicloudRamGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupRequest{
 		Group: ram.Group{
@@ -49,7 +53,7 @@ func resourceAlicloudRamGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	response, err := conn.CreateGroup(args)
 	if err != nil {
-		return fmt.Errorf("CreateGroup got an error: %#v", err)
+		return WrapError(err, "CreateGroup", d.Id())
 	}
 
 	d.SetId(response.Group.GroupName)
@@ -57,7 +61,7 @@ func resourceAlicloudRamGroupCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudRamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -91,7 +95,7 @@ func resourceAlicloudRamGroupUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudRamGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupQueryRequest{
 		GroupName: d.Id(),
@@ -102,7 +106,7 @@ func resourceAlicloudRamGroupRead(d *schema.ResourceData, meta interface{}) erro
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("GetGroup got an error: %#v", err)
+		return WrapError(err, "GetGroup", d.Id())
 	}
 
 	group := response.Group
@@ -112,7 +116,8 @@ func resourceAlicloudRamGroupRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAlicloudRamGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := ram.GroupQueryRequest{
 		GroupName: d.Id(),
@@ -159,7 +164,7 @@ func resourceAlicloudRamGroupDelete(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DeleteGroup(args); err != nil {
 			if IsExceptedError(err, DeleteConflictGroupUser) || IsExceptedError(err, DeleteConflictGroupPolicy) {
 				return resource.RetryableError(fmt.Errorf("The group can not has any user member or any attached policy while deleting the group.- you can set force with true to force delete the group."))
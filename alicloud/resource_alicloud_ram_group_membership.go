@@ -15,6 +15,9 @@ func resourceAlicloudRamGroupMembership() *schema.Resource {
 		Read:   resourceAlicloudRamGroupMembershipRead,
 		Update: resourceAlicloudRamGroupMembershipUpdate,
 		Delete: resourceAlicloudRamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_name": &schema.Schema{
@@ -37,14 +40,14 @@ func resourceAlicloudRamGroupMembership() *schema.Resource {
 }
 
 func resourceAlicloudRamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	group := d.Get("group_name").(string)
 	users := expandStringList(d.Get("user_names").(*schema.Set).List())
 
 	err := addUsersToGroup(conn, users, group)
 	if err != nil {
-		return fmt.Errorf("AddUserToGroup got an error: %#v", err)
+		return WrapError(err, "AddUserToGroup", d.Id())
 	}
 
 	var buf bytes.Buffer
@@ -57,7 +60,7 @@ func resourceAlicloudRamGroupMembershipCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAlicloudRamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -91,7 +94,7 @@ func resourceAlicloudRamGroupMembershipUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAlicloudRamGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupQueryRequest{
 		GroupName: d.Get("group_name").(string),
@@ -102,7 +105,7 @@ func resourceAlicloudRamGroupMembershipRead(d *schema.ResourceData, meta interfa
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("ListUsersForGroup got an error: %#v", err)
+		return WrapError(err, "ListUsersForGroup", d.Id())
 	}
 
 	var users []string
@@ -121,7 +124,7 @@ func resourceAlicloudRamGroupMembershipRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAlicloudRamGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	users := expandStringList(d.Get("user_names").(*schema.Set).List())
 	group := d.Get("group_name").(string)
@@ -160,3 +163,9 @@ func removeUsersFromGroup(conn ram.RamClientInterface, users []string, group str
 	}
 	return nil
 }
+
+// resourceAlicloudRamGroupMembershipImport imports the membership by the group name.
+func resourceAlicloudRamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("group_name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
@@ -54,7 +54,7 @@ func testAccCheckRamGroupMembershipExists(n string, user *ram.User, user1 *ram.U
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.GroupQueryRequest{
 			GroupName: group.GroupName,
@@ -94,7 +94,7 @@ func testAccCheckRamGroupMembershipDestroy(s *terraform.State) error {
 
 		// Try to find the membership
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.GroupQueryRequest{
 			GroupName: rs.Primary.Attributes["group_name"],
@@ -13,6 +13,13 @@ func resourceAlicloudRamGroupPolicyAtatchment() *schema.Resource {
 		Create: resourceAlicloudRamGroupPolicyAttachmentCreate,
 		Read:   resourceAlicloudRamGroupPolicyAttachmentRead,
 		Delete: resourceAlicloudRamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamGroupPolicyAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_name": &schema.Schema{
@@ -38,7 +45,7 @@ func resourceAlicloudRamGroupPolicyAtatchment() *schema.Resource {
 }
 
 func resourceAlicloudRamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.AttachPolicyToGroupRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -49,7 +56,7 @@ func resourceAlicloudRamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	if _, err := conn.AttachPolicyToGroup(args); err != nil {
-		return fmt.Errorf("AttachPolicyToGroup got an error: %#v", err)
+		return WrapError(err, "AttachPolicyToGroup", d.Id())
 	}
 	d.SetId("group" + args.PolicyName + string(args.PolicyType) + args.GroupName)
 
@@ -57,7 +64,7 @@ func resourceAlicloudRamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta
 }
 
 func resourceAlicloudRamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupQueryRequest{
 		GroupName: d.Get("group_name").(string),
@@ -87,7 +94,8 @@ func resourceAlicloudRamGroupPolicyAttachmentRead(d *schema.ResourceData, meta i
 }
 
 func resourceAlicloudRamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := ram.AttachPolicyToGroupRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -97,7 +105,7 @@ func resourceAlicloudRamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta
 		GroupName: d.Get("group_name").(string),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DetachPolicyFromGroup(args); err != nil {
 			if RamEntityNotExist(err) {
 				return nil
@@ -120,3 +128,18 @@ func resourceAlicloudRamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("Error deleting group policy attachment - trying again while it is deleted."))
 	})
 }
+
+// resourceAlicloudRamGroupPolicyAttachmentImport imports the attachment by the id in the format
+// <group_name>:<policy_name>:<policy_type>.
+func resourceAlicloudRamGroupPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("group_name", parts[0])
+	d.Set("policy_name", parts[1])
+	d.Set("policy_type", parts[2])
+	d.SetId("group" + parts[1] + parts[2] + parts[0])
+	return []*schema.ResourceData{d}, nil
+}
@@ -52,7 +52,7 @@ func testAccCheckRamGroupPolicyAttachmentExists(n string, policy *ram.Policy, gr
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.GroupQueryRequest{
 			GroupName: group.GroupName,
@@ -82,7 +82,7 @@ func testAccCheckRamGroupPolicyAttachmentDestroy(s *terraform.State) error {
 
 		// Try to find the attachment
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.GroupQueryRequest{
 			GroupName: rs.Primary.Attributes["group_name"],
@@ -18,6 +18,10 @@ func resourceAlicloudRamLoginProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"user_name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -45,7 +49,7 @@ func resourceAlicloudRamLoginProfile() *schema.Resource {
 }
 
 func resourceAlicloudRamLoginProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.ProfileRequest{
 		UserName:              d.Get("user_name").(string),
@@ -55,7 +59,7 @@ func resourceAlicloudRamLoginProfileCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if _, err := conn.CreateLoginProfile(args); err != nil {
-		return fmt.Errorf("CreateLoginProfile got an error: %#v", err)
+		return WrapError(err, "CreateLoginProfile", d.Id())
 	}
 
 	d.SetId(args.UserName)
@@ -63,7 +67,7 @@ func resourceAlicloudRamLoginProfileCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAlicloudRamLoginProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -101,7 +105,7 @@ func resourceAlicloudRamLoginProfileUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAlicloudRamLoginProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{
 		UserName: d.Id(),
@@ -112,7 +116,7 @@ func resourceAlicloudRamLoginProfileRead(d *schema.ResourceData, meta interface{
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("GetLoginProfile got an error: %#v", err)
+		return WrapError(err, "GetLoginProfile", d.Id())
 	}
 
 	profile := response.LoginProfile
@@ -123,13 +127,14 @@ func resourceAlicloudRamLoginProfileRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAlicloudRamLoginProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := ram.UserQueryRequest{
 		UserName: d.Id(),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DeleteLoginProfile(args); err != nil {
 			if RamEntityNotExist(err) {
 				return nil
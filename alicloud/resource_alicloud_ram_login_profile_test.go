@@ -50,7 +50,7 @@ func testAccCheckRamLoginProfileExists(n string, profile *ram.LoginProfile) reso
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.UserQueryRequest{
 			UserName: rs.Primary.Attributes["user_name"],
@@ -75,7 +75,7 @@ func testAccCheckRamLoginProfileDestroy(s *terraform.State) error {
 
 		// Try to find the login profile
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.UserQueryRequest{
 			UserName: rs.Primary.Attributes["user_name"],
@@ -19,6 +19,10 @@ func resourceAlicloudRamPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -106,7 +110,7 @@ func resourceAlicloudRamPolicy() *schema.Resource {
 }
 
 func resourceAlicloudRamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args, err := buildAlicloudRamPolicyCreateArgs(d, meta)
 	if err != nil {
@@ -115,7 +119,7 @@ func resourceAlicloudRamPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	response, err := conn.CreatePolicy(args)
 	if err != nil {
-		return fmt.Errorf("CreatePolicy got an error: %#v", err)
+		return WrapError(err, "CreatePolicy", d.Id())
 	}
 
 	d.SetId(response.Policy.PolicyName)
@@ -123,7 +127,7 @@ func resourceAlicloudRamPolicyCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudRamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	d.Partial(true)
 
 	args, attributeUpdate, err := buildAlicloudRamPolicyUpdateArgs(d, meta)
@@ -143,7 +147,7 @@ func resourceAlicloudRamPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudRamPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.PolicyRequest{
 		PolicyName: d.Id(),
@@ -155,14 +159,14 @@ func resourceAlicloudRamPolicyRead(d *schema.ResourceData, meta interface{}) err
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("GetPolicy got an error: %#v", err)
+		return WrapError(err, "GetPolicy", d.Id())
 	}
 	policy := policyResp.Policy
 
 	args.VersionId = policy.DefaultVersion
 	policyVersionResp, err := conn.GetPolicyVersionNew(args)
 	if err != nil {
-		return fmt.Errorf("GetPolicyVersion got an error: %#v", err)
+		return WrapError(err, "GetPolicyVersion", d.Id())
 	}
 
 	statement, version, err := ParsePolicyDocument(policyVersionResp.PolicyVersion.PolicyDocument)
@@ -175,14 +179,17 @@ func resourceAlicloudRamPolicyRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("description", policy.Description)
 	d.Set("attachment_count", policy.AttachmentCount)
 	d.Set("version", version)
-	d.Set("statement", statement)
+	if err := d.Set("statement", statement); err != nil {
+		return err
+	}
 	d.Set("document", policyVersionResp.PolicyVersion.PolicyDocument)
 
 	return nil
 }
 
 func resourceAlicloudRamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := ram.PolicyRequest{
 		PolicyName: d.Id(),
@@ -250,7 +257,7 @@ func resourceAlicloudRamPolicyDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DeletePolicy(args); err != nil {
 			if IsExceptedError(err, DeleteConflictPolicyUser) || IsExceptedError(err, DeleteConflictPolicyGroup) || IsExceptedError(err, DeleteConflictRolePolicy) {
 				return resource.RetryableError(fmt.Errorf("The policy can not been attached to any user or group or role while deleting the policy. - you can set force with true to force delete the policy."))
@@ -271,7 +278,7 @@ func buildAlicloudRamPolicyCreateArgs(d *schema.ResourceData, meta interface{})
 	statement, statementOk := d.GetOk("statement")
 
 	if !docOk && !statementOk {
-		return ram.PolicyRequest{}, fmt.Errorf("One of 'document' and 'statement' must be specified.")
+		return ram.PolicyRequest{}, ConfigErrorf(ErrorCodeMissingArgument, "One of 'document' and 'statement' must be specified.")
 	}
 
 	if docOk {
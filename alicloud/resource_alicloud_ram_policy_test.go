@@ -56,7 +56,7 @@ func testAccCheckRamPolicyExists(n string, policy *ram.Policy) resource.TestChec
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.PolicyRequest{
 			PolicyName: rs.Primary.ID,
@@ -83,7 +83,7 @@ func testAccCheckRamPolicyDestroy(s *terraform.State) error {
 
 		// Try to find the policy
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.PolicyRequest{
 			PolicyName: rs.Primary.ID,
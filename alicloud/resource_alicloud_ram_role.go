@@ -19,6 +19,10 @@ func resourceAlicloudRamRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -79,7 +83,7 @@ func resourceAlicloudRamRole() *schema.Resource {
 }
 
 func resourceAlicloudRamRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args, err := buildAlicloudRamRoleCreateArgs(d, meta)
 	if err != nil {
@@ -88,7 +92,7 @@ func resourceAlicloudRamRoleCreate(d *schema.ResourceData, meta interface{}) err
 
 	response, err := conn.CreateRole(args)
 	if err != nil {
-		return fmt.Errorf("CreateRole got an error: %#v", err)
+		return WrapError(err, "CreateRole", d.Id())
 	}
 
 	d.SetId(response.Role.RoleName)
@@ -96,7 +100,7 @@ func resourceAlicloudRamRoleCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudRamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -116,7 +120,7 @@ func resourceAlicloudRamRoleUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudRamRoleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.RoleQueryRequest{
 		RoleName: d.Id(),
@@ -137,8 +141,12 @@ func resourceAlicloudRamRoleRead(d *schema.ResourceData, meta interface{}) error
 	}
 	if len(rolePolicy.Statement) > 0 {
 		principal := rolePolicy.Statement[0].Principal
-		d.Set("services", principal.Service)
-		d.Set("ram_users", principal.RAM)
+		if err := d.Set("services", principal.Service); err != nil {
+			return err
+		}
+		if err := d.Set("ram_users", principal.RAM); err != nil {
+			return err
+		}
 	}
 
 	d.Set("name", role.RoleName)
@@ -150,7 +158,8 @@ func resourceAlicloudRamRoleRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAlicloudRamRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := ram.RoleQueryRequest{
 		RoleName: d.Id(),
@@ -178,7 +187,7 @@ func resourceAlicloudRamRoleDelete(d *schema.ResourceData, meta interface{}) err
 			}
 		}
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DeleteRole(args); err != nil {
 			if IsExceptedError(err, DeleteConflictRolePolicy) {
 				return resource.RetryableError(fmt.Errorf("The role can not has any attached policy while deleting the role. - you can set force with true to force delete the role."))
@@ -200,7 +209,7 @@ func buildAlicloudRamRoleCreateArgs(d *schema.ResourceData, meta interface{}) (r
 	document, documentOk := d.GetOk("document")
 
 	if !usersOk && !servicesOk && !documentOk {
-		return ram.RoleRequest{}, fmt.Errorf("At least one of 'ram_users', 'services' or 'document' must be set.")
+		return ram.RoleRequest{}, ConfigErrorf(ErrorCodeMissingArgument, "At least one of 'ram_users', 'services' or 'document' must be set.")
 	}
 
 	if documentOk {
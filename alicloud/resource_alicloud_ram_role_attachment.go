@@ -17,6 +17,15 @@ func resourceAlicloudRamRoleAttachment() *schema.Resource {
 		Create: resourceAlicloudInstanceRoleAttachmentCreate,
 		Read:   resourceAlicloudInstanceRoleAttachmentRead,
 		Delete: resourceAlicloudInstanceRoleAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": &schema.Schema{
@@ -37,7 +46,8 @@ func resourceAlicloudRamRoleAttachment() *schema.Resource {
 
 func resourceAlicloudInstanceRoleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+	conn := client.ecsconn()
 
 	instanceIds := convertListToJsonString(d.Get("instance_ids").(*schema.Set).List())
 
@@ -52,12 +62,12 @@ func resourceAlicloudInstanceRoleAttachmentCreate(d *schema.ResourceData, meta i
 		return err
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if err := conn.AttachInstanceRamRole(&args); err != nil {
 			if IsExceptedError(err, RoleAttachmentUnExpectedJson) {
 				return resource.RetryableError(fmt.Errorf("Please trying again."))
 			}
-			return resource.NonRetryableError(fmt.Errorf("AttachInstanceRamRole got an error: %#v", err))
+			return resource.NonRetryableError(WrapError(err, "AttachInstanceRamRole", d.Id()))
 		}
 		d.SetId(d.Get("role_name").(string) + ":" + instanceIds)
 		return resource.NonRetryableError(resourceAlicloudInstanceRoleAttachmentRead(d, meta))
@@ -65,7 +75,7 @@ func resourceAlicloudInstanceRoleAttachmentCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAlicloudInstanceRoleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 	roleName := strings.Split(d.Id(), ":")[0]
 	instanceIds := strings.Split(d.Id(), ":")[1]
 
@@ -74,17 +84,17 @@ func resourceAlicloudInstanceRoleAttachmentRead(d *schema.ResourceData, meta int
 		InstanceIds: instanceIds,
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		resp, err := conn.DescribeInstanceRamRole(&args)
 		if err != nil {
 			if IsExceptedError(err, RoleAttachmentUnExpectedJson) {
 				return resource.RetryableError(fmt.Errorf("Please trying again."))
 			}
-			if IsExceptedError(err, InvalidRamRoleNotFound) {
+			if NotFoundError(err, "ecs") {
 				d.SetId("")
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("DescribeInstanceRamRole got an error: %#v", err))
+			return resource.NonRetryableError(WrapError(err, "DescribeInstanceRamRole", d.Id()))
 		}
 
 		instRoleSets := resp.InstanceRamRoleSets.InstanceRamRoleSet
@@ -109,11 +119,12 @@ func resourceAlicloudInstanceRoleAttachmentRead(d *schema.ResourceData, meta int
 }
 
 func resourceAlicloudInstanceRoleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 	roleName := strings.Split(d.Id(), ":")[0]
 	instanceIds := strings.Split(d.Id(), ":")[1]
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DetachInstanceRamRole(&ecs.AttachInstancesArgs{
 			RegionId:    getRegion(d, meta),
 			RamRoleName: roleName,
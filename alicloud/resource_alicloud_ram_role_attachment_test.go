@@ -57,7 +57,7 @@ func testAccCheckRamRoleAttachmentExists(n string, instanceA *ecs.InstanceAttrib
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		request := &ecs.AttachInstancesArgs{
 			RegionId:    client.Region,
@@ -93,7 +93,7 @@ func testAccCheckRamRoleAttachmentDestroy(s *terraform.State) error {
 
 		// Try to find the attachment
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		request := &ecs.AttachInstancesArgs{
 			RegionId:    client.Region,
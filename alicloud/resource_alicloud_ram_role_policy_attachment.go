@@ -14,6 +14,13 @@ func resourceAlicloudRamRolePolicyAttachment() *schema.Resource {
 		Read:   resourceAlicloudRamRolePolicyAttachmentRead,
 		//Update: resourceAlicloudRamRolePolicyAttachmentUpdate,
 		Delete: resourceAlicloudRamRolePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamRolePolicyAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": &schema.Schema{
@@ -39,7 +46,7 @@ func resourceAlicloudRamRolePolicyAttachment() *schema.Resource {
 }
 
 func resourceAlicloudRamRolePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	args := ram.AttachPolicyToRoleRequest{
 		PolicyRequest: ram.PolicyRequest{
 			PolicyName: d.Get("policy_name").(string),
@@ -49,7 +56,7 @@ func resourceAlicloudRamRolePolicyAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	if _, err := conn.AttachPolicyToRole(args); err != nil {
-		return fmt.Errorf("AttachPolicyToRole got an error: %#v", err)
+		return WrapError(err, "AttachPolicyToRole", d.Id())
 	}
 	d.SetId("role" + args.PolicyName + string(args.PolicyType) + args.RoleName)
 
@@ -57,7 +64,7 @@ func resourceAlicloudRamRolePolicyAttachmentCreate(d *schema.ResourceData, meta
 }
 
 func resourceAlicloudRamRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.RoleQueryRequest{
 		RoleName: d.Get("role_name").(string),
@@ -84,7 +91,8 @@ func resourceAlicloudRamRolePolicyAttachmentRead(d *schema.ResourceData, meta in
 }
 
 func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := ram.AttachPolicyToRoleRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -94,7 +102,7 @@ func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta
 		RoleName: d.Get("role_name").(string),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DetachPolicyFromRole(args); err != nil {
 			if RamEntityNotExist(err) {
 				return nil
@@ -116,3 +124,18 @@ func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("Error deleting role policy attachment - trying again while it is deleted."))
 	})
 }
+
+// resourceAlicloudRamRolePolicyAttachmentImport imports the attachment by the id in the format
+// <role_name>:<policy_name>:<policy_type>.
+func resourceAlicloudRamRolePolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("role_name", parts[0])
+	d.Set("policy_name", parts[1])
+	d.Set("policy_type", parts[2])
+	d.SetId("role" + parts[1] + parts[2] + parts[0])
+	return []*schema.ResourceData{d}, nil
+}
@@ -56,7 +56,7 @@ func testAccCheckRamRoleExists(n string, role *ram.Role) resource.TestCheckFunc
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.RoleQueryRequest{
 			RoleName: rs.Primary.Attributes["name"],
@@ -82,7 +82,7 @@ func testAccCheckRamRoleDestroy(s *terraform.State) error {
 
 		// Try to find the role
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.RoleQueryRequest{
 			RoleName: rs.Primary.Attributes["name"],
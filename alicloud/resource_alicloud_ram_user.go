@@ -19,6 +19,10 @@ func resourceAlicloudRamUser() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -53,7 +57,7 @@ func resourceAlicloudRamUser() *schema.Resource {
 }
 
 func resourceAlicloudRamUserCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserRequest{
 		User: ram.User{
@@ -63,7 +67,7 @@ func resourceAlicloudRamUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	response, err := conn.CreateUser(args)
 	if err != nil {
-		return fmt.Errorf("CreateUser got an error: %#v", err)
+		return WrapError(err, "CreateUser", d.Id())
 	}
 
 	d.SetId(response.User.UserName)
@@ -71,7 +75,7 @@ func resourceAlicloudRamUserCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudRamUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -124,7 +128,7 @@ func resourceAlicloudRamUserUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudRamUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{
 		UserName: d.Id(),
@@ -136,7 +140,7 @@ func resourceAlicloudRamUserRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("GetUser got an error: %#v", err)
+		return WrapError(err, "GetUser", d.Id())
 	}
 
 	user := response.User
@@ -149,7 +153,8 @@ func resourceAlicloudRamUserRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	userName := d.Id()
 	args := ram.UserQueryRequest{
@@ -223,7 +228,7 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DeleteUser(args); err != nil {
 			if IsExceptedError(err, DeleteConflictUserAccessKey) || IsExceptedError(err, DeleteConflictUserGroup) ||
 				IsExceptedError(err, DeleteConflictUserPolicy) || IsExceptedError(err, DeleteConflictUserLoginProfile) ||
@@ -13,6 +13,13 @@ func resourceAlicloudRamUserPolicyAtatchment() *schema.Resource {
 		Create: resourceAlicloudRamUserPolicyAttachmentCreate,
 		Read:   resourceAlicloudRamUserPolicyAttachmentRead,
 		Delete: resourceAlicloudRamUserPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamUserPolicyAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": &schema.Schema{
@@ -38,7 +45,7 @@ func resourceAlicloudRamUserPolicyAtatchment() *schema.Resource {
 }
 
 func resourceAlicloudRamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.AttachPolicyRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -49,7 +56,7 @@ func resourceAlicloudRamUserPolicyAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	if _, err := conn.AttachPolicyToUser(args); err != nil {
-		return fmt.Errorf("AttachPolicyToUser got an error: %#v", err)
+		return WrapError(err, "AttachPolicyToUser", d.Id())
 	}
 
 	d.SetId("user" + args.PolicyName + string(args.PolicyType) + args.UserName)
@@ -57,7 +64,7 @@ func resourceAlicloudRamUserPolicyAttachmentCreate(d *schema.ResourceData, meta
 }
 
 func resourceAlicloudRamUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{
 		UserName: d.Get("user_name").(string),
@@ -84,7 +91,8 @@ func resourceAlicloudRamUserPolicyAttachmentRead(d *schema.ResourceData, meta in
 }
 
 func resourceAlicloudRamUserPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := ram.AttachPolicyRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -94,7 +102,7 @@ func resourceAlicloudRamUserPolicyAttachmentDelete(d *schema.ResourceData, meta
 		UserName: d.Get("user_name").(string),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DetachPolicyFromUser(args); err != nil {
 			if RamEntityNotExist(err) {
 				return nil
@@ -117,3 +125,18 @@ func resourceAlicloudRamUserPolicyAttachmentDelete(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("Error deleting user policy attachment - trying again while it is deleted."))
 	})
 }
+
+// resourceAlicloudRamUserPolicyAttachmentImport imports the attachment by the id in the format
+// <user_name>:<policy_name>:<policy_type>.
+func resourceAlicloudRamUserPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("user_name", parts[0])
+	d.Set("policy_name", parts[1])
+	d.Set("policy_type", parts[2])
+	d.SetId("user" + parts[1] + parts[2] + parts[0])
+	return []*schema.ResourceData{d}, nil
+}
@@ -52,7 +52,7 @@ func testAccCheckRamUserPolicyAttachmentExists(n string, policy *ram.Policy, use
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.UserQueryRequest{
 			UserName: user.UserName,
@@ -82,7 +82,7 @@ func testAccCheckRamUserPolicyAttachmentDestroy(s *terraform.State) error {
 
 		// Try to find the attachment
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.UserQueryRequest{
 			UserName: rs.Primary.Attributes["user_name"],
@@ -60,7 +60,7 @@ func testAccCheckRamUserExists(n string, user *ram.User) resource.TestCheckFunc
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.UserQueryRequest{
 			UserName: rs.Primary.Attributes["user_name"],
@@ -86,7 +86,7 @@ func testAccCheckRamUserDestroy(s *terraform.State) error {
 
 		// Try to find the user
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.UserQueryRequest{
 			UserName: rs.Primary.Attributes["user_name"],
@@ -0,0 +1,223 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudRosStack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudRosStackCreate,
+		Read:   resourceAlicloudRosStackRead,
+		Update: resourceAlicloudRosStackUpdate,
+		Delete: resourceAlicloudRosStackDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"stack_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The template is not read back, because ROS accepts both JSON and YAML templates.
+			"template_body": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_url"},
+			},
+			"template_url": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body"},
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"timeout_in_minutes": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validateIntegerInRange(10, 1440),
+			},
+			"disable_rollback": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_policy_body": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonDocument,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outputs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudRosStackCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildRosStackArgs(d, meta)
+	args.StackName = d.Get("stack_name").(string)
+	if v, ok := d.GetOk("stack_policy_body"); ok {
+		args.StackPolicyBody = v.(string)
+	}
+	if args.TemplateBody == "" && args.TemplateURL == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "One of 'template_body' and 'template_url' is required.")
+	}
+
+	resp := CreateRosStackResponse{}
+	if err := client.rosconn().Invoke("CreateStack", args, &resp); err != nil {
+		return WrapError(err, "CreateStack", d.Id())
+	}
+
+	d.SetId(resp.StackId)
+
+	if err := client.WaitForRosStack(d.Id(), RosStackCreateComplete,
+		[]RosStackStatus{RosStackCreateFailed, RosStackRollbackComplete, RosStackRollbackFailed}, args.TimeoutInMinutes*60); err != nil {
+		return fmt.Errorf("Waiting for ROS stack %s to be created got an error: %#v", d.Id(), err)
+	}
+
+	return resourceAlicloudRosStackRead(d, meta)
+}
+
+func resourceAlicloudRosStackRead(d *schema.ResourceData, meta interface{}) error {
+	stack, err := meta.(*AliyunClient).DescribeRosStack(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ros") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "GetStack", d.Id())
+	}
+
+	d.Set("stack_name", stack.StackName)
+	d.Set("status", stack.Status)
+	d.Set("timeout_in_minutes", stack.TimeoutInMinutes)
+	d.Set("disable_rollback", stack.DisableRollback)
+
+	parameters := make(map[string]string)
+	for _, p := range stack.Parameters {
+		// The pseudo parameters like 'ALIYUN::Region' are added by ROS.
+		if strings.HasPrefix(p.ParameterKey, "ALIYUN::") {
+			continue
+		}
+		parameters[p.ParameterKey] = p.ParameterValue
+	}
+	if err := d.Set("parameters", parameters); err != nil {
+		return err
+	}
+
+	outputs := make(map[string]string)
+	for _, o := range stack.Outputs {
+		if s, ok := o.OutputValue.(string); ok {
+			outputs[o.OutputKey] = s
+			continue
+		}
+		bytes, err := json.Marshal(o.OutputValue)
+		if err != nil {
+			return fmt.Errorf("Marshalling output %s of ROS stack %s got an error: %#v", o.OutputKey, d.Id(), err)
+		}
+		outputs[o.OutputKey] = string(bytes)
+	}
+	if err := d.Set("outputs", outputs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudRosStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("template_body") || d.HasChange("template_url") || d.HasChange("parameters") ||
+		d.HasChange("timeout_in_minutes") || d.HasChange("disable_rollback") || d.HasChange("stack_policy_body") {
+		args := buildRosStackArgs(d, meta)
+		args.StackId = d.Id()
+		if d.HasChange("stack_policy_body") {
+			args.StackPolicyBody = d.Get("stack_policy_body").(string)
+		}
+		if args.TemplateBody == "" && args.TemplateURL == "" {
+			return ConfigErrorf(ErrorCodeMissingArgument, "One of 'template_body' and 'template_url' is required.")
+		}
+
+		if err := client.rosconn().Invoke("UpdateStack", args, &common.Response{}); err != nil {
+			return WrapError(err, "UpdateStack", d.Id())
+		}
+
+		if err := client.WaitForRosStack(d.Id(), RosStackUpdateComplete,
+			[]RosStackStatus{RosStackUpdateFailed, RosStackRollbackComplete, RosStackRollbackFailed}, args.TimeoutInMinutes*60); err != nil {
+			return fmt.Errorf("Waiting for ROS stack %s to be updated got an error: %#v", d.Id(), err)
+		}
+	}
+
+	return resourceAlicloudRosStackRead(d, meta)
+}
+
+func resourceAlicloudRosStackDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := RosStackArgs{
+		RegionId: client.Region,
+		StackId:  d.Id(),
+	}
+	if err := client.rosconn().Invoke("DeleteStack", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "ros") {
+			return nil
+		}
+		return WrapError(err, "DeleteStack", d.Id())
+	}
+
+	timeout := time.Duration(d.Get("timeout_in_minutes").(int)) * time.Minute
+	return resource.Retry(timeout, func() *resource.RetryError {
+		stack, err := client.DescribeRosStack(d.Id())
+		if err != nil {
+			if NotFoundError(err, "ros") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if stack.Status == RosStackDeleteFailed {
+			return resource.NonRetryableError(fmt.Errorf("Deleting ROS stack %s failed: %s.", d.Id(), stack.StatusReason))
+		}
+		return resource.RetryableError(fmt.Errorf("ROS stack %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildRosStackArgs(d *schema.ResourceData, meta interface{}) *RosStackArgs {
+	args := RosStackArgs{
+		RegionId:         meta.(*AliyunClient).Region,
+		TemplateBody:     d.Get("template_body").(string),
+		TemplateURL:      d.Get("template_url").(string),
+		TimeoutInMinutes: d.Get("timeout_in_minutes").(int),
+		DisableRollback:  d.Get("disable_rollback").(bool),
+	}
+	for k, v := range d.Get("parameters").(map[string]interface{}) {
+		args.Parameters = append(args.Parameters, RosStackParameter{
+			ParameterKey:   k,
+			ParameterValue: v.(string),
+		})
+	}
+	return &args
+}
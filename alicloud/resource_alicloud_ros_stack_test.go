@@ -0,0 +1,140 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudRosStack_basic(t *testing.T) {
+	var v RosStack
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ros_stack.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRosStackDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRosStackConfig("10.0.0.0/8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRosStackExists(
+						"alicloud_ros_stack.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ros_stack.foo",
+						"status",
+						string(RosStackCreateComplete)),
+					resource.TestCheckResourceAttr(
+						"alicloud_ros_stack.foo",
+						"parameters.CidrBlock",
+						"10.0.0.0/8"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_ros_stack.foo",
+						"outputs.VpcId"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRosStackConfig("172.16.0.0/12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRosStackExists(
+						"alicloud_ros_stack.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_ros_stack.foo",
+						"status",
+						string(RosStackUpdateComplete)),
+					resource.TestCheckResourceAttr(
+						"alicloud_ros_stack.foo",
+						"parameters.CidrBlock",
+						"172.16.0.0/12"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRosStackExists(n string, d *RosStack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ROS stack ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		stack, err := client.DescribeRosStack(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *stack
+		return nil
+	}
+}
+
+func testAccCheckRosStackDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ros_stack" {
+			continue
+		}
+
+		if _, err := client.DescribeRosStack(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "ros") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ROS stack %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRosStackConfig(cidr string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ros_stack" "foo" {
+  stack_name = "tf-testAccRosStack"
+  timeout_in_minutes = 30
+
+  template_body = <<EOF
+{
+  "ROSTemplateFormatVersion": "2015-09-01",
+  "Parameters": {
+    "CidrBlock": {
+      "Type": "String"
+    }
+  },
+  "Resources": {
+    "Vpc": {
+      "Type": "ALIYUN::ECS::VPC",
+      "Properties": {
+        "CidrBlock": {"Ref": "CidrBlock"},
+        "VpcName": "tf-testAccRosStack"
+      }
+    }
+  },
+  "Outputs": {
+    "VpcId": {
+      "Value": {"Fn::GetAtt": ["Vpc", "VpcId"]}
+    }
+  }
+}
+EOF
+
+  parameters {
+    CidrBlock = "%s"
+  }
+}
+`, cidr)
+}
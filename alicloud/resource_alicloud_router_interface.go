@@ -16,6 +16,13 @@ func resourceAlicloudRouterInterface() *schema.Resource {
 		Read:   resourceAlicloudRouterInterfaceRead,
 		Update: resourceAlicloudRouterInterfaceUpdate,
 		Delete: resourceAlicloudRouterInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"opposite_region": &schema.Schema{
@@ -100,7 +107,7 @@ func resourceAlicloudRouterInterface() *schema.Resource {
 }
 
 func resourceAlicloudRouterInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 	args, err := buildAlicloudRouterInterfaceCreateArgs(d, meta)
 	if err != nil {
 		return err
@@ -108,7 +115,7 @@ func resourceAlicloudRouterInterfaceCreate(d *schema.ResourceData, meta interfac
 
 	response, err := conn.CreateRouterInterface(args)
 	if err != nil {
-		return fmt.Errorf("CreateRouterInterface got an error: %#v", err)
+		return WrapError(err, "CreateRouterInterface", d.Id())
 	}
 
 	d.SetId(response.RouterInterfaceId)
@@ -116,7 +123,7 @@ func resourceAlicloudRouterInterfaceCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAlicloudRouterInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	d.Partial(true)
 
@@ -127,7 +134,7 @@ func resourceAlicloudRouterInterfaceUpdate(d *schema.ResourceData, meta interfac
 
 	if attributeUpdate {
 		if _, err := conn.ModifyRouterInterfaceAttribute(args); err != nil {
-			return fmt.Errorf("ModifyRouterInterfaceAttribute got an error: %#v", err)
+			return WrapError(err, "ModifyRouterInterfaceAttribute", d.Id())
 		}
 	}
 
@@ -138,7 +145,7 @@ func resourceAlicloudRouterInterfaceUpdate(d *schema.ResourceData, meta interfac
 			RegionId:          getRegion(d, meta),
 			Spec:              ecs.Spec(d.Get("specification").(string)),
 		}); err != nil {
-			return fmt.Errorf("ModifyRouterInterfaceSpec got an error: %#v", err)
+			return WrapError(err, "ModifyRouterInterfaceSpec", d.Id())
 		}
 	}
 
@@ -147,7 +154,7 @@ func resourceAlicloudRouterInterfaceUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAlicloudRouterInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	filter := ecs.Filter{Key: "RouterInterfaceId", Value: []string{d.Id()}}
 	args := &ecs.DescribeRouterInterfacesArgs{
@@ -156,7 +163,7 @@ func resourceAlicloudRouterInterfaceRead(d *schema.ResourceData, meta interface{
 	}
 	resp, err := conn.DescribeRouterInterfaces(args)
 	if err != nil {
-		return fmt.Errorf("DescribeRouterInterfaces got an error: %#v", err)
+		return WrapError(err, "DescribeRouterInterfaces", d.Id())
 	}
 
 	routerInterface := resp.RouterInterfaceSet.RouterInterfaceType
@@ -189,14 +196,15 @@ func resourceAlicloudRouterInterfaceRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAlicloudRouterInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
 	args := &ecs.OperateRouterInterfaceArgs{
 		RegionId:          getRegion(d, meta),
 		RouterInterfaceId: d.Id(),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		if _, err := conn.DeleteRouterInterface(args); err != nil {
 			if IsExceptedError(err, RouterInterfaceIncorrectStatus) || IsExceptedError(err, DependencyViolationRouterInterfaceReferedByRouteEntry) {
 				return resource.RetryableError(fmt.Errorf("Router interface in use - trying again while it is deleted."))
@@ -236,7 +244,7 @@ func buildAlicloudRouterInterfaceCreateArgs(d *schema.ResourceData, meta interfa
 
 		v, ok := d.GetOk("access_point_id")
 		if !ok {
-			return nil, fmt.Errorf("'access_point_id': required field is not set when 'router_type' is 'VBR'.")
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'access_point_id': required field is not set when 'router_type' is 'VBR'.")
 		}
 		args.AccessPointId = v.(string)
 	} else if args.OppositeRouterType == ecs.VBR {
@@ -246,7 +254,7 @@ func buildAlicloudRouterInterfaceCreateArgs(d *schema.ResourceData, meta interfa
 
 		v, ok := d.GetOk("opposite_access_point_id")
 		if !ok {
-			return nil, fmt.Errorf("'opposite_access_point_id':required field is not set when 'opposite_router_type' is 'VBR'.")
+			return nil, ConfigErrorf(ErrorCodeMissingArgument, "'opposite_access_point_id':required field is not set when 'opposite_router_type' is 'VBR'.")
 		}
 		args.OppositeAccessPointId = v.(string)
 	}
@@ -279,7 +287,7 @@ func buildAlicloudRouterInterfaceModifyAttrArgs(d *schema.ResourceData, meta int
 	sourceIp, sourceOk := d.GetOk("health_check_source_ip")
 	targetIp, targetOk := d.GetOk("health_check_target_ip")
 	if sourceOk && !targetOk || !sourceOk && targetOk {
-		return nil, false, fmt.Errorf("The 'health_check_source_ip' and 'health_check_target_ip' should be specified or not at one time.")
+		return nil, false, ConfigErrorf(ErrorCodeConflictingArguments, "The 'health_check_source_ip' and 'health_check_target_ip' should be specified or not at one time.")
 	}
 
 	args := &ecs.ModifyRouterInterfaceAttributeArgs{
@@ -48,7 +48,7 @@ func testAccCheckRouterInterfaceExists(n string) resource.TestCheckFunc {
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		filter := ecs.Filter{Key: "RouterInterfaceId", Value: []string{rs.Primary.ID}}
 		request := &ecs.DescribeRouterInterfacesArgs{
@@ -79,7 +79,7 @@ func testAccCheckRouterInterfaceDestroy(s *terraform.State) error {
 
 		// Try to find the interface
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 
 		filter := ecs.Filter{Key: "RouterInterfaceId", Value: []string{rs.Primary.ID}}
 		request := &ecs.DescribeRouterInterfacesArgs{
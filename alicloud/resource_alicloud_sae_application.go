@@ -0,0 +1,426 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSaeApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSaeApplicationCreate,
+		Read:   resourceAlicloudSaeApplicationRead,
+		Update: resourceAlicloudSaeApplicationUpdate,
+		Delete: resourceAlicloudSaeApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"namespace_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(SaePackageTypes),
+			},
+			"image_url": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"package_url"},
+			},
+			"package_url": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"image_url"},
+			},
+			"package_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"jdk": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"command": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"envs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"replicas": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(0, 50),
+			},
+			"cpu": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      500,
+				ValidateFunc: validateAllowedIntValue([]int{500, 1000, 2000, 4000, 8000, 16000, 32000}),
+			},
+			"memory": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1024,
+				ValidateFunc: validateAllowedIntValue([]int{1024, 2048, 4096, 8192, 12288, 16384, 24576, 32768, 65536, 131072}),
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_rules": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(SaeScalingRuleTypes),
+						},
+						// The timer and metric are JSON documents, see the API CreateApplicationScalingRule.
+						"timer": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateJsonDocument,
+							StateFunc: func(v interface{}) string {
+								json, _ := normalizeJsonString(v)
+								return json
+							},
+						},
+						"metric": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateJsonDocument,
+							StateFunc: func(v interface{}) string {
+								json, _ := normalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudSaeApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	query, err := buildSaeApplicationDeployQuery(d)
+	if err != nil {
+		return err
+	}
+	query.Set("AppName", d.Get("app_name").(string))
+	query.Set("AppDescription", d.Get("description").(string))
+	query.Set("NamespaceId", d.Get("namespace_id").(string))
+	query.Set("PackageType", d.Get("package_type").(string))
+	query.Set("Replicas", strconv.Itoa(d.Get("replicas").(int)))
+	query.Set("Cpu", strconv.Itoa(d.Get("cpu").(int)))
+	query.Set("Memory", strconv.Itoa(d.Get("memory").(int)))
+	for key, arg := range map[string]string{"vpc_id": "VpcId", "vswitch_id": "VSwitchId", "security_group_id": "SecurityGroupId"} {
+		if v, ok := d.GetOk(key); ok {
+			query.Set(arg, v.(string))
+		}
+	}
+
+	resp := SaeChangeOrderResponse{}
+	if err := client.saeconn().Invoke(client.Region, http.MethodPost, "/pop/v1/sam/app/createApplication", query, nil, &resp); err != nil {
+		return WrapError(err, "CreateApplication", d.Id())
+	}
+
+	d.SetId(resp.Data.AppId)
+
+	if err := client.WaitForSaeChangeOrder(resp.Data.ChangeOrderId, deadline.Seconds()); err != nil {
+		return fmt.Errorf("Waiting for SAE application %s to be created got an error: %#v", d.Id(), err)
+	}
+
+	return resourceAlicloudSaeApplicationUpdate(d, meta)
+}
+
+func resourceAlicloudSaeApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	app, err := client.DescribeSaeApplication(d.Id())
+	if err != nil {
+		if NotFoundError(err, "sae") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeApplicationConfig", d.Id())
+	}
+
+	d.Set("app_name", app.AppName)
+	d.Set("description", app.AppDescription)
+	d.Set("namespace_id", app.NamespaceId)
+	d.Set("package_type", app.PackageType)
+	d.Set("image_url", app.ImageUrl)
+	d.Set("package_url", app.PackageUrl)
+	d.Set("package_version", app.PackageVersion)
+	d.Set("jdk", app.Jdk)
+	d.Set("command", app.Command)
+	d.Set("replicas", app.Replicas)
+	d.Set("cpu", app.Cpu)
+	d.Set("memory", app.Memory)
+	d.Set("vpc_id", app.VpcId)
+	d.Set("vswitch_id", app.VSwitchId)
+	d.Set("security_group_id", app.SecurityGroupId)
+
+	envs := make(map[string]string)
+	if app.Envs != "" {
+		var items []SaeEnv
+		if err := json.Unmarshal([]byte(app.Envs), &items); err != nil {
+			return fmt.Errorf("Parsing envs %s of SAE application %s got an error: %#v", app.Envs, d.Id(), err)
+		}
+		for _, item := range items {
+			envs[item.Name] = item.Value
+		}
+	}
+	if err := d.Set("envs", envs); err != nil {
+		return err
+	}
+
+	rules, err := client.DescribeSaeScalingRules(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeApplicationScalingRules", d.Id())
+	}
+	var scalingRules []map[string]interface{}
+	for _, rule := range rules {
+		m := map[string]interface{}{
+			"name": rule.ScalingRuleName,
+			"type": rule.ScalingRuleType,
+		}
+		if rule.Timer != nil {
+			timer, _ := json.Marshal(rule.Timer)
+			m["timer"] = string(timer)
+		}
+		if rule.Metric != nil {
+			metric, _ := json.Marshal(rule.Metric)
+			m["metric"] = string(metric)
+		}
+		scalingRules = append(scalingRules, m)
+	}
+	if err := d.Set("scaling_rules", scalingRules); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudSaeApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+	d.Partial(true)
+
+	if !d.IsNewResource() && (d.HasChange("image_url") || d.HasChange("package_url") || d.HasChange("package_version") ||
+		d.HasChange("jdk") || d.HasChange("command") || d.HasChange("envs")) {
+		query, err := buildSaeApplicationDeployQuery(d)
+		if err != nil {
+			return err
+		}
+		query.Set("AppId", d.Id())
+		if err := changeSaeApplication(client, http.MethodPost, "/pop/v1/sam/app/deployApplication", query, deadline); err != nil {
+			return WrapError(err, "DeployApplication", d.Id())
+		}
+		for _, key := range []string{"image_url", "package_url", "package_version", "jdk", "command", "envs"} {
+			d.SetPartial(key)
+		}
+	}
+
+	if !d.IsNewResource() && (d.HasChange("cpu") || d.HasChange("memory")) {
+		query := url.Values{
+			"AppId":  []string{d.Id()},
+			"Cpu":    []string{strconv.Itoa(d.Get("cpu").(int))},
+			"Memory": []string{strconv.Itoa(d.Get("memory").(int))},
+		}
+		if err := changeSaeApplication(client, http.MethodPost, "/pop/v1/sam/app/rescaleApplicationVertically", query, deadline); err != nil {
+			return WrapError(err, "RescaleApplicationVertically", d.Id())
+		}
+		d.SetPartial("cpu")
+		d.SetPartial("memory")
+	}
+
+	if !d.IsNewResource() && d.HasChange("replicas") {
+		query := url.Values{
+			"AppId":    []string{d.Id()},
+			"Replicas": []string{strconv.Itoa(d.Get("replicas").(int))},
+		}
+		if err := changeSaeApplication(client, http.MethodPut, "/pop/v1/sam/app/scale", query, deadline); err != nil {
+			return WrapError(err, "ScaleApplication", d.Id())
+		}
+		d.SetPartial("replicas")
+	}
+
+	if d.HasChange("scaling_rules") {
+		if err := updateSaeScalingRules(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("scaling_rules")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudSaeApplicationRead(d, meta)
+}
+
+func resourceAlicloudSaeApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	query := url.Values{"AppId": []string{d.Id()}}
+
+	if err := changeSaeApplication(client, http.MethodDelete, "/pop/v1/sam/app/deleteApplication", query, deadline); err != nil {
+		if NotFoundError(err, "sae") {
+			return nil
+		}
+		return WrapError(err, "DeleteApplication", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeSaeApplication(d.Id()); err != nil {
+			if NotFoundError(err, "sae") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("SAE application %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+// changeSaeApplication invokes the operation on an application and waits for its change order to be finished
+// before the deadline.
+func changeSaeApplication(client *AliyunClient, method, path string, query url.Values, deadline operationDeadline) error {
+	resp := SaeChangeOrderResponse{}
+	if err := client.saeconn().Invoke(client.Region, method, path, query, nil, &resp); err != nil {
+		return err
+	}
+	return client.WaitForSaeChangeOrder(resp.Data.ChangeOrderId, deadline.Seconds())
+}
+
+func buildSaeApplicationDeployQuery(d *schema.ResourceData) (url.Values, error) {
+	query := url.Values{}
+	for key, arg := range map[string]string{"image_url": "ImageUrl", "package_url": "PackageUrl", "package_version": "PackageVersion",
+		"jdk": "Jdk", "command": "Command"} {
+		if v, ok := d.GetOk(key); ok {
+			query.Set(arg, v.(string))
+		}
+	}
+
+	var envs []SaeEnv
+	for k, v := range d.Get("envs").(map[string]interface{}) {
+		envs = append(envs, SaeEnv{Name: k, Value: v.(string)})
+	}
+	if len(envs) > 0 {
+		bytes, err := json.Marshal(envs)
+		if err != nil {
+			return nil, fmt.Errorf("Marshalling envs got an error: %#v", err)
+		}
+		query.Set("Envs", string(bytes))
+	}
+	return query, nil
+}
+
+// updateSaeScalingRules compares the scaling rules by their names, and deletes, updates or creates them.
+func updateSaeScalingRules(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	path := "/pop/v1/sam/scale/applicationScalingRule"
+
+	o, n := d.GetChange("scaling_rules")
+	oldRules := make(map[string]map[string]interface{})
+	for _, v := range o.([]interface{}) {
+		rule := v.(map[string]interface{})
+		oldRules[rule["name"].(string)] = rule
+	}
+	newRules := make(map[string]map[string]interface{})
+	for _, v := range n.([]interface{}) {
+		rule := v.(map[string]interface{})
+		newRules[rule["name"].(string)] = rule
+	}
+
+	for name := range oldRules {
+		if _, ok := newRules[name]; ok {
+			continue
+		}
+		query := url.Values{
+			"AppId":           []string{d.Id()},
+			"ScalingRuleName": []string{name},
+		}
+		if err := client.saeconn().Invoke(client.Region, http.MethodDelete, path, query, nil, &SaeResponse{}); err != nil {
+			return fmt.Errorf("DeleteApplicationScalingRule %s got an error: %#v", name, err)
+		}
+	}
+
+	for name, rule := range newRules {
+		query := url.Values{
+			"AppId":           []string{d.Id()},
+			"ScalingRuleName": []string{name},
+			"ScalingRuleType": []string{rule["type"].(string)},
+		}
+		if timer := rule["timer"].(string); timer != "" {
+			query.Set("ScalingRuleTimer", timer)
+		}
+		if metric := rule["metric"].(string); metric != "" {
+			query.Set("ScalingRuleMetric", metric)
+		}
+
+		method, action := http.MethodPost, "CreateApplicationScalingRule"
+		if old, ok := oldRules[name]; ok {
+			if old["type"] == rule["type"] && old["timer"] == rule["timer"] && old["metric"] == rule["metric"] {
+				continue
+			}
+			method, action = http.MethodPut, "UpdateApplicationScalingRule"
+		}
+		if err := client.saeconn().Invoke(client.Region, method, path, query, nil, &SaeResponse{}); err != nil {
+			return fmt.Errorf("%s %s got an error: %#v", action, name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSaeApplicationSlbAttachment() *schema.Resource {
+	listener := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+			"target_port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+			"protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(SaeSlbProtocols),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAlicloudSaeApplicationSlbAttachmentCreate,
+		Read:   resourceAlicloudSaeApplicationSlbAttachmentRead,
+		Update: resourceAlicloudSaeApplicationSlbAttachmentUpdate,
+		Delete: resourceAlicloudSaeApplicationSlbAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The SLB is created by SAE when its id is not specified.
+			"internet_slb_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"internet": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     listener,
+			},
+			"internet_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"intranet_slb_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"intranet": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     listener,
+			},
+			"intranet_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudSaeApplicationSlbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("app_id").(string))
+
+	return resourceAlicloudSaeApplicationSlbAttachmentUpdate(d, meta)
+}
+
+func resourceAlicloudSaeApplicationSlbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	slb, err := meta.(*AliyunClient).DescribeSaeApplicationSlb(d.Id())
+	if err != nil {
+		if NotFoundError(err, "sae") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeApplicationSlb", d.Id())
+	}
+
+	d.Set("app_id", d.Id())
+	d.Set("internet_slb_id", slb.InternetSlbId)
+	d.Set("internet_ip", slb.InternetIp)
+	d.Set("intranet_slb_id", slb.IntranetSlbId)
+	d.Set("intranet_ip", slb.IntranetIp)
+	if err := d.Set("internet", flattenSaeSlbListeners(slb.Internet)); err != nil {
+		return err
+	}
+	if err := d.Set("intranet", flattenSaeSlbListeners(slb.Intranet)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudSaeApplicationSlbAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// BindSlb replaces the listeners of the SLB, so it is used to update them as well.
+	query := url.Values{"AppId": []string{d.Id()}}
+	for key, arg := range map[string]string{"internet_slb_id": "InternetSlbId", "intranet_slb_id": "IntranetSlbId"} {
+		if v, ok := d.GetOk(key); ok {
+			query.Set(arg, v.(string))
+		}
+	}
+	for key, arg := range map[string]string{"internet": "Internet", "intranet": "Intranet"} {
+		listeners := expandSaeSlbListeners(d.Get(key).(*schema.Set).List())
+		if len(listeners) == 0 {
+			continue
+		}
+		bytes, err := json.Marshal(listeners)
+		if err != nil {
+			return fmt.Errorf("Marshalling %s got an error: %#v", key, err)
+		}
+		query.Set(arg, string(bytes))
+	}
+
+	if err := changeSaeApplication(client, http.MethodPost, "/pop/v1/sam/app/slb", query); err != nil {
+		return WrapError(err, "BindSlb", d.Id())
+	}
+
+	return resourceAlicloudSaeApplicationSlbAttachmentRead(d, meta)
+}
+
+func resourceAlicloudSaeApplicationSlbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	query := url.Values{
+		"AppId":    []string{d.Id()},
+		"Internet": []string{"true"},
+		"Intranet": []string{"true"},
+	}
+	if err := changeSaeApplication(client, http.MethodDelete, "/pop/v1/sam/app/slb", query); err != nil {
+		if NotFoundError(err, "sae") {
+			return nil
+		}
+		return WrapError(err, "UnbindSlb", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeSaeApplicationSlb(d.Id()); err != nil {
+			if NotFoundError(err, "sae") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("SLB of SAE application %s is being unbound - trying again while it is unbound.", d.Id()))
+	})
+}
+
+func expandSaeSlbListeners(list []interface{}) []SaeSlbListener {
+	var listeners []SaeSlbListener
+	for _, v := range list {
+		m := v.(map[string]interface{})
+		listeners = append(listeners, SaeSlbListener{
+			Port:       m["port"].(int),
+			TargetPort: m["target_port"].(int),
+			Protocol:   m["protocol"].(string),
+		})
+	}
+	return listeners
+}
+
+func flattenSaeSlbListeners(listeners []SaeSlbListener) []map[string]interface{} {
+	var list []map[string]interface{}
+	for _, l := range listeners {
+		list = append(list, map[string]interface{}{
+			"port":        l.Port,
+			"target_port": l.TargetPort,
+			"protocol":    l.Protocol,
+		})
+	}
+	return list
+}
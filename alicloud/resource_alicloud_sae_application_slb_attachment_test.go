@@ -0,0 +1,133 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSaeApplicationSlbAttachment_basic(t *testing.T) {
+	var v SaeApplicationSlb
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sae_application_slb_attachment.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSaeApplicationSlbAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSaeApplicationSlbAttachmentConfig(os.Getenv("ALICLOUD_REGION")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeApplicationSlbAttachmentExists(
+						"alicloud_sae_application_slb_attachment.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sae_application_slb_attachment.foo",
+						"internet.#",
+						"1"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_sae_application_slb_attachment.foo",
+						"internet_slb_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSaeApplicationSlbAttachmentExists(n string, d *SaeApplicationSlb) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAE application ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		slb, err := client.DescribeSaeApplicationSlb(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *slb
+		return nil
+	}
+}
+
+func testAccCheckSaeApplicationSlbAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sae_application_slb_attachment" {
+			continue
+		}
+
+		if _, err := client.DescribeSaeApplicationSlb(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "sae") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SLB of SAE application %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSaeApplicationSlbAttachmentConfig(region string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+  "available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+  name = "tf-testAccSaeApplicationSlbAttachment"
+  vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_sae_namespace" "foo" {
+  namespace_id = "%s:tfslbtest"
+  name = "tf-testAccSaeApplicationSlbAttachment"
+}
+
+resource "alicloud_sae_application" "foo" {
+  app_name = "tf-testaccsaeslb"
+  namespace_id = "${alicloud_sae_namespace.foo.id}"
+  package_type = "Image"
+  image_url = "registry-vpc.%s.aliyuncs.com/sae-demo-image/consumer:1.0"
+  replicas = 1
+  vpc_id = "${alicloud_vpc.foo.id}"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  security_group_id = "${alicloud_security_group.foo.id}"
+}
+
+resource "alicloud_sae_application_slb_attachment" "foo" {
+  app_id = "${alicloud_sae_application.foo.id}"
+
+  internet {
+    port = 80
+    target_port = 8080
+    protocol = "TCP"
+  }
+}
+`, region, region)
+}
@@ -0,0 +1,149 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSaeApplication_basic(t *testing.T) {
+	var v SaeApplication
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sae_application.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSaeApplicationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSaeApplicationConfig(os.Getenv("ALICLOUD_REGION"), 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeApplicationExists(
+						"alicloud_sae_application.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sae_application.foo",
+						"replicas",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_sae_application.foo",
+						"scaling_rules.#",
+						"1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSaeApplicationConfig(os.Getenv("ALICLOUD_REGION"), 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeApplicationExists(
+						"alicloud_sae_application.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sae_application.foo",
+						"replicas",
+						"2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSaeApplicationExists(n string, d *SaeApplication) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAE application ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		app, err := client.DescribeSaeApplication(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *app
+		return nil
+	}
+}
+
+func testAccCheckSaeApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sae_application" {
+			continue
+		}
+
+		if _, err := client.DescribeSaeApplication(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "sae") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAE application %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSaeApplicationConfig(region string, replicas int) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+  "available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+  name = "tf-testAccSaeApplication"
+  vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_sae_namespace" "foo" {
+  namespace_id = "%s:tfapptest"
+  name = "tf-testAccSaeApplication"
+}
+
+resource "alicloud_sae_application" "foo" {
+  app_name = "tf-testaccsaeapplication"
+  namespace_id = "${alicloud_sae_namespace.foo.id}"
+  package_type = "Image"
+  image_url = "registry-vpc.%s.aliyuncs.com/sae-demo-image/consumer:1.0"
+  replicas = %d
+  cpu = 500
+  memory = 1024
+  vpc_id = "${alicloud_vpc.foo.id}"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  security_group_id = "${alicloud_security_group.foo.id}"
+
+  envs {
+    ENV = "test"
+  }
+
+  scaling_rules = [
+    {
+      name = "timer-rule"
+      type = "timing"
+      timer = "{\"period\": \"* * *\", \"schedules\": [{\"atTime\": \"08:00\", \"targetReplicas\": 2}]}"
+    },
+  ]
+}
+`, region, region, replicas)
+}
@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSaeNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSaeNamespaceCreate,
+		Read:   resourceAlicloudSaeNamespaceRead,
+		Update: resourceAlicloudSaeNamespaceUpdate,
+		Delete: resourceAlicloudSaeNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// The namespace id is like '<region id>:<namespace>'.
+			"namespace_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudSaeNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	namespaceId := d.Get("namespace_id").(string)
+
+	query := url.Values{
+		"NamespaceId":          []string{namespaceId},
+		"NamespaceName":        []string{d.Get("name").(string)},
+		"NamespaceDescription": []string{d.Get("description").(string)},
+	}
+	if err := client.saeconn().Invoke(client.Region, http.MethodPost, "/pop/v1/paas/namespace", query, nil, &SaeNamespaceResponse{}); err != nil {
+		return WrapError(err, "CreateNamespace", d.Id())
+	}
+
+	d.SetId(namespaceId)
+
+	return resourceAlicloudSaeNamespaceRead(d, meta)
+}
+
+func resourceAlicloudSaeNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	namespace, err := meta.(*AliyunClient).DescribeSaeNamespace(d.Id())
+	if err != nil {
+		if NotFoundError(err, "sae") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeNamespace", d.Id())
+	}
+
+	d.Set("namespace_id", namespace.NamespaceId)
+	d.Set("name", namespace.NamespaceName)
+	d.Set("description", namespace.NamespaceDescription)
+
+	return nil
+}
+
+func resourceAlicloudSaeNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		query := url.Values{
+			"NamespaceId":          []string{d.Id()},
+			"NamespaceName":        []string{d.Get("name").(string)},
+			"NamespaceDescription": []string{d.Get("description").(string)},
+		}
+		if err := client.saeconn().Invoke(client.Region, http.MethodPut, "/pop/v1/paas/namespace", query, nil, &SaeNamespaceResponse{}); err != nil {
+			return WrapError(err, "UpdateNamespace", d.Id())
+		}
+	}
+
+	return resourceAlicloudSaeNamespaceRead(d, meta)
+}
+
+func resourceAlicloudSaeNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	query := url.Values{"NamespaceId": []string{d.Id()}}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.saeconn().Invoke(client.Region, http.MethodDelete, "/pop/v1/paas/namespace", query, nil, &SaeResponse{}); err != nil {
+			if NotFoundError(err, "sae") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteNamespace", d.Id()))
+		}
+
+		if _, err := client.DescribeSaeNamespace(d.Id()); err != nil {
+			if NotFoundError(err, "sae") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("SAE namespace %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
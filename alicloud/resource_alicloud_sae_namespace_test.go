@@ -0,0 +1,102 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSaeNamespace_basic(t *testing.T) {
+	var v SaeNamespace
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sae_namespace.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSaeNamespaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSaeNamespaceConfig(os.Getenv("ALICLOUD_REGION"), "tf-testAccSaeNamespace"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeNamespaceExists(
+						"alicloud_sae_namespace.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sae_namespace.foo",
+						"name",
+						"tf-testAccSaeNamespace"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSaeNamespaceConfig(os.Getenv("ALICLOUD_REGION"), "tf-testAccSaeNamespace-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeNamespaceExists(
+						"alicloud_sae_namespace.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sae_namespace.foo",
+						"name",
+						"tf-testAccSaeNamespace-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSaeNamespaceExists(n string, d *SaeNamespace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAE namespace ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		namespace, err := client.DescribeSaeNamespace(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *namespace
+		return nil
+	}
+}
+
+func testAccCheckSaeNamespaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sae_namespace" {
+			continue
+		}
+
+		if _, err := client.DescribeSaeNamespace(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "sae") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAE namespace %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSaeNamespaceConfig(region, name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_sae_namespace" "foo" {
+  namespace_id = "%s:tftest"
+  name = "%s"
+  description = "tf-testAccSaeNamespace"
+}
+`, region, name)
+}
@@ -0,0 +1,91 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSagAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSagAclCreate,
+		Read:   resourceAlicloudSagAclRead,
+		Update: resourceAlicloudSagAclUpdate,
+		Delete: resourceAlicloudSagAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+		},
+	}
+}
+
+func resourceAlicloudSagAclCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := SagAclArgs{
+		RegionId: client.Region,
+		Name:     d.Get("name").(string),
+	}
+	resp := CreateSagAclResponse{}
+	if err := client.sagconn().Invoke("CreateACL", &args, &resp); err != nil {
+		return WrapError(err, "CreateACL", d.Id())
+	}
+
+	d.SetId(resp.AclId)
+
+	return resourceAlicloudSagAclRead(d, meta)
+}
+
+func resourceAlicloudSagAclRead(d *schema.ResourceData, meta interface{}) error {
+	acl, err := meta.(*AliyunClient).DescribeSagAcl(d.Id())
+	if err != nil {
+		if NotFoundError(err, "sag") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeACLs", d.Id())
+	}
+
+	d.Set("name", acl.Name)
+
+	return nil
+}
+
+func resourceAlicloudSagAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") {
+		args := SagAclArgs{
+			RegionId: client.Region,
+			AclId:    d.Id(),
+			Name:     d.Get("name").(string),
+		}
+		if err := client.sagconn().Invoke("ModifyACL", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyACL", d.Id())
+		}
+	}
+
+	return resourceAlicloudSagAclRead(d, meta)
+}
+
+func resourceAlicloudSagAclDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := SagAclArgs{
+		RegionId: client.Region,
+		AclId:    d.Id(),
+	}
+	if err := client.sagconn().Invoke("DeleteACL", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "sag") {
+			return nil
+		}
+		return WrapError(err, "DeleteACL", d.Id())
+	}
+	return nil
+}
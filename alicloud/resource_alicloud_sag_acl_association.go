@@ -0,0 +1,104 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSagAclAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSagAclAssociationCreate,
+		Read:   resourceAlicloudSagAclAssociationRead,
+		Delete: resourceAlicloudSagAclAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sag_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudSagAclAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := SagAclAssociationArgs{
+		RegionId:  client.Region,
+		AclId:     d.Get("acl_id").(string),
+		SmartAGId: d.Get("sag_id").(string),
+	}
+	if err := client.sagconn().Invoke("AssociateACL", &args, &common.Response{}); err != nil {
+		return WrapError(err, "AssociateACL", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.AclId, COLON_SEPARATED, args.SmartAGId))
+
+	return resourceAlicloudSagAclAssociationRead(d, meta)
+}
+
+func resourceAlicloudSagAclAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	sag, err := meta.(*AliyunClient).DescribeSmartAccessGateway(parts[1])
+	if err != nil {
+		if NotFoundError(err, "sag") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeSmartAccessGatewayAttribute", d.Id())
+	}
+
+	associated := false
+	for _, id := range sag.AclIds.AclId {
+		if id == parts[0] {
+			associated = true
+			break
+		}
+	}
+	if !associated {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("acl_id", parts[0])
+	d.Set("sag_id", parts[1])
+
+	return nil
+}
+
+func resourceAlicloudSagAclAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := SagAclAssociationArgs{
+		RegionId:  client.Region,
+		AclId:     parts[0],
+		SmartAGId: parts[1],
+	}
+	if err := client.sagconn().Invoke("DisassociateACL", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "sag") {
+			return nil
+		}
+		return WrapError(err, "DisassociateACL", d.Id())
+	}
+	return nil
+}
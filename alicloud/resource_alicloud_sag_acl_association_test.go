@@ -0,0 +1,110 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSagAclAssociation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithSagInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sag_acl_association.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagAclAssociationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSagAclAssociationConfig(os.Getenv("ALICLOUD_SAG_INSTANCE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagAclAssociationExists("alicloud_sag_acl_association.foo"),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_acl_association.foo",
+						"sag_id",
+						os.Getenv("ALICLOUD_SAG_INSTANCE_ID")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSagAclAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAG ACL association ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		sag, err := client.DescribeSmartAccessGateway(parts[1])
+		if err != nil {
+			return err
+		}
+
+		for _, id := range sag.AclIds.AclId {
+			if id == parts[0] {
+				return nil
+			}
+		}
+		return fmt.Errorf("SAG ACL %s is not associated with smart access gateway %s.", parts[0], parts[1])
+	}
+}
+
+func testAccCheckSagAclAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sag_acl_association" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		sag, err := client.DescribeSmartAccessGateway(parts[1])
+		if err != nil {
+			if NotFoundError(err, "sag") {
+				continue
+			}
+			return err
+		}
+		for _, id := range sag.AclIds.AclId {
+			if id == parts[0] {
+				return fmt.Errorf("SAG ACL association %s still exists.", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccSagAclAssociationConfig(sagId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_sag_acl" "foo" {
+  name = "tf-testAccSagAclAssociation"
+}
+
+resource "alicloud_sag_acl_association" "foo" {
+  acl_id = "${alicloud_sag_acl.foo.id}"
+  sag_id = "%s"
+}
+`, sagId)
+}
@@ -0,0 +1,169 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSagAclRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSagAclRuleCreate,
+		Read:   resourceAlicloudSagAclRuleRead,
+		Update: resourceAlicloudSagAclRuleUpdate,
+		Delete: resourceAlicloudSagAclRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"accept", "drop"}),
+			},
+			"ip_protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"ALL", "TCP", "UDP", "ICMP"}),
+			},
+			"direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"in", "out"}),
+			},
+			"source_cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// The port ranges are like 80/80, and -1/-1 means all the ports.
+			"source_port_range": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dest_cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dest_port_range": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"priority": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 100),
+			},
+		},
+	}
+}
+
+func resourceAlicloudSagAclRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildSagAclRuleArgs(d, client)
+	resp := AddSagAclRuleResponse{}
+	if err := client.sagconn().Invoke("AddACLRule", &args, &resp); err != nil {
+		return WrapError(err, "AddACLRule", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.AclId, COLON_SEPARATED, resp.AcrId))
+
+	return resourceAlicloudSagAclRuleRead(d, meta)
+}
+
+func resourceAlicloudSagAclRuleRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	rule, err := meta.(*AliyunClient).DescribeSagAclRule(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "sag") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeACLAttribute", d.Id())
+	}
+
+	d.Set("acl_id", parts[0])
+	d.Set("description", rule.Description)
+	d.Set("policy", rule.Policy)
+	d.Set("ip_protocol", rule.IpProtocol)
+	d.Set("direction", rule.Direction)
+	d.Set("source_cidr", rule.SourceCidr)
+	d.Set("source_port_range", rule.SourcePortRange)
+	d.Set("dest_cidr", rule.DestCidr)
+	d.Set("dest_port_range", rule.DestPortRange)
+	d.Set("priority", rule.Priority)
+
+	return nil
+}
+
+func resourceAlicloudSagAclRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	// ModifyACLRule overwrites all the arguments of the rule.
+	args := buildSagAclRuleArgs(d, client)
+	args.AcrId = parts[1]
+	if err := client.sagconn().Invoke("ModifyACLRule", &args, &common.Response{}); err != nil {
+		return WrapError(err, "ModifyACLRule", d.Id())
+	}
+
+	return resourceAlicloudSagAclRuleRead(d, meta)
+}
+
+func resourceAlicloudSagAclRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := SagAclRuleArgs{
+		RegionId: client.Region,
+		AclId:    parts[0],
+		AcrId:    parts[1],
+	}
+	if err := client.sagconn().Invoke("DeleteACLRule", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "sag") {
+			return nil
+		}
+		return WrapError(err, "DeleteACLRule", d.Id())
+	}
+	return nil
+}
+
+func buildSagAclRuleArgs(d *schema.ResourceData, client *AliyunClient) SagAclRuleArgs {
+	return SagAclRuleArgs{
+		RegionId:        client.Region,
+		AclId:           d.Get("acl_id").(string),
+		Description:     d.Get("description").(string),
+		Policy:          d.Get("policy").(string),
+		IpProtocol:      d.Get("ip_protocol").(string),
+		Direction:       d.Get("direction").(string),
+		SourceCidr:      d.Get("source_cidr").(string),
+		SourcePortRange: d.Get("source_port_range").(string),
+		DestCidr:        d.Get("dest_cidr").(string),
+		DestPortRange:   d.Get("dest_port_range").(string),
+		Priority:        d.Get("priority").(int),
+	}
+}
@@ -0,0 +1,130 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSagAclRule_basic(t *testing.T) {
+	var v SagAclRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sag_acl_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagAclRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSagAclRuleConfig("accept", "22/22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagAclRuleExists(
+						"alicloud_sag_acl_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_acl_rule.foo",
+						"policy",
+						"accept"),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_acl_rule.foo",
+						"dest_port_range",
+						"22/22"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSagAclRuleConfig("drop", "80/80"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagAclRuleExists(
+						"alicloud_sag_acl_rule.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_acl_rule.foo",
+						"policy",
+						"drop"),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_acl_rule.foo",
+						"dest_port_range",
+						"80/80"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSagAclRuleExists(n string, d *SagAclRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAG ACL rule ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		rule, err := client.DescribeSagAclRule(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *rule
+		return nil
+	}
+}
+
+func testAccCheckSagAclRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sag_acl_rule" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeSagAclRule(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "sag") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAG ACL rule %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSagAclRuleConfig(policy, destPortRange string) string {
+	return fmt.Sprintf(`
+resource "alicloud_sag_acl" "foo" {
+  name = "tf-testAccSagAclRule"
+}
+
+resource "alicloud_sag_acl_rule" "foo" {
+  acl_id = "${alicloud_sag_acl.foo.id}"
+  description = "tf-testAccSagAclRule"
+  policy = "%s"
+  ip_protocol = "TCP"
+  direction = "in"
+  source_cidr = "10.10.1.0/24"
+  source_port_range = "-1/-1"
+  dest_cidr = "192.168.10.0/24"
+  dest_port_range = "%s"
+  priority = 10
+}
+`, policy, destPortRange)
+}
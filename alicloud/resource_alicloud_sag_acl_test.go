@@ -0,0 +1,99 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSagAcl_basic(t *testing.T) {
+	var v SagAcl
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sag_acl.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagAclDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSagAclConfig("tf-testAccSagAcl"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagAclExists(
+						"alicloud_sag_acl.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_acl.foo",
+						"name",
+						"tf-testAccSagAcl"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSagAclConfig("tf-testAccSagAcl-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagAclExists(
+						"alicloud_sag_acl.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_acl.foo",
+						"name",
+						"tf-testAccSagAcl-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSagAclExists(n string, d *SagAcl) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAG ACL ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		acl, err := client.DescribeSagAcl(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *acl
+		return nil
+	}
+}
+
+func testAccCheckSagAclDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sag_acl" {
+			continue
+		}
+
+		if _, err := client.DescribeSagAcl(rs.Primary.ID); err != nil {
+			if NotFoundError(err, "sag") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAG ACL %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSagAclConfig(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_sag_acl" "foo" {
+  name = "%s"
+}
+`, name)
+}
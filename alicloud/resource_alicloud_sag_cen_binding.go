@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAlicloudSagCenBinding attaches the CCN instance of the smart access gateways to a CEN instance, so the
+// branches connected by the smart access gateways reach the vpcs attached to the CEN instance.
+func resourceAlicloudSagCenBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSagCenBindingCreate,
+		Read:   resourceAlicloudSagCenBindingRead,
+		Delete: resourceAlicloudSagCenBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ccn_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cen_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudSagCenBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	args := CenChildInstanceArgs{
+		CenId:                 d.Get("cen_id").(string),
+		ChildInstanceId:       d.Get("ccn_id").(string),
+		ChildInstanceType:     CenChildInstanceCcn,
+		ChildInstanceRegionId: client.Region,
+	}
+	if err := client.cenconn().Invoke("AttachCenChildInstance", &args, &common.Response{}); err != nil {
+		return WrapError(err, "AttachCenChildInstance", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.ChildInstanceId, COLON_SEPARATED, args.CenId))
+
+	if err := client.WaitForCenChildInstance(args.CenId, args.ChildInstanceId, CenChildInstanceCcn,
+		CenChildInstanceAttached, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForCenChildInstance", d.Id())
+	}
+
+	return resourceAlicloudSagCenBindingRead(d, meta)
+}
+
+func resourceAlicloudSagCenBindingRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	child, err := meta.(*AliyunClient).DescribeCenChildInstance(parts[1], parts[0], CenChildInstanceCcn)
+	if err != nil {
+		if NotFoundError(err, "cen") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeCenAttachedChildInstanceAttribute", d.Id())
+	}
+
+	d.Set("ccn_id", parts[0])
+	d.Set("cen_id", parts[1])
+	d.Set("status", child.Status)
+
+	return nil
+}
+
+func resourceAlicloudSagCenBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := CenChildInstanceArgs{
+		CenId:                 parts[1],
+		ChildInstanceId:       parts[0],
+		ChildInstanceType:     CenChildInstanceCcn,
+		ChildInstanceRegionId: client.Region,
+	}
+	if err := client.cenconn().Invoke("DetachCenChildInstance", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "cen") {
+			return nil
+		}
+		return WrapError(err, "DetachCenChildInstance", d.Id())
+	}
+
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if _, err := client.DescribeCenChildInstance(parts[1], parts[0], CenChildInstanceCcn); err != nil {
+			if NotFoundError(err, "cen") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DescribeCenAttachedChildInstanceAttribute", d.Id()))
+		}
+		return resource.RetryableError(fmt.Errorf("CCN instance %s is still being detached from CEN instance %s", parts[0], parts[1]))
+	})
+}
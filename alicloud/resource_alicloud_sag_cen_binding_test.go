@@ -0,0 +1,100 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSagCenBinding_basic(t *testing.T) {
+	var v CenAttachedChildInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithSagCcnInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sag_cen_binding.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagCenBindingDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSagCenBindingConfig(os.Getenv("ALICLOUD_SAG_CCN_ID"), os.Getenv("ALICLOUD_CEN_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagCenBindingExists(
+						"alicloud_sag_cen_binding.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_cen_binding.foo",
+						"status",
+						"Attached"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSagCenBindingExists(n string, d *CenAttachedChildInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAG CEN binding ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		child, err := client.DescribeCenChildInstance(parts[1], parts[0], CenChildInstanceCcn)
+		if err != nil {
+			return err
+		}
+
+		*d = *child
+		return nil
+	}
+}
+
+func testAccCheckSagCenBindingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sag_cen_binding" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeCenChildInstance(parts[1], parts[0], CenChildInstanceCcn); err != nil {
+			if NotFoundError(err, "cen") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAG CEN binding %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSagCenBindingConfig(ccnId, cenId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_sag_cen_binding" "foo" {
+  ccn_id = "%s"
+  cen_id = "%s"
+}
+`, ccnId, cenId)
+}
@@ -0,0 +1,166 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSagClientUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSagClientUserCreate,
+		Read:   resourceAlicloudSagClientUserRead,
+		Update: resourceAlicloudSagClientUserUpdate,
+		Delete: resourceAlicloudSagClientUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// The sag_id is the id of the smart access gateway app instance the clients connect to.
+			"sag_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+			"user_mail": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The password is sent to the user_mail by the smart access gateway when it is not set.
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"client_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			// The bandwidth is in kbps.
+			"bandwidth": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(1, 2000),
+			},
+		},
+	}
+}
+
+func resourceAlicloudSagClientUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := SagClientUserArgs{
+		RegionId:  client.Region,
+		SmartAGId: d.Get("sag_id").(string),
+		UserName:  d.Get("user_name").(string),
+		UserMail:  d.Get("user_mail").(string),
+		Password:  d.Get("password").(string),
+		ClientIp:  d.Get("client_ip").(string),
+		Bandwidth: d.Get("bandwidth").(int),
+	}
+	if err := client.sagconn().Invoke("CreateSmartAccessGatewayClientUser", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateSmartAccessGatewayClientUser", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.SmartAGId, COLON_SEPARATED, args.UserName))
+
+	return resourceAlicloudSagClientUserRead(d, meta)
+}
+
+func resourceAlicloudSagClientUserRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	user, err := meta.(*AliyunClient).DescribeSagClientUser(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "sag") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeSmartAccessGatewayClientUsers", d.Id())
+	}
+
+	d.Set("sag_id", parts[0])
+	d.Set("user_name", user.UserName)
+	d.Set("user_mail", user.UserMail)
+	d.Set("client_ip", user.ClientIp)
+	d.Set("bandwidth", user.Bandwidth)
+
+	return nil
+}
+
+func resourceAlicloudSagClientUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("bandwidth") {
+		args := SagClientUserArgs{
+			RegionId:  client.Region,
+			SmartAGId: parts[0],
+			UserName:  parts[1],
+			Bandwidth: d.Get("bandwidth").(int),
+		}
+		if err := client.sagconn().Invoke("ModifySmartAccessGatewayClientUser", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifySmartAccessGatewayClientUser", d.Id())
+		}
+		d.SetPartial("bandwidth")
+	}
+
+	if d.HasChange("password") {
+		args := SagClientUserArgs{
+			RegionId:  client.Region,
+			SmartAGId: parts[0],
+			UserName:  parts[1],
+			Password:  d.Get("password").(string),
+		}
+		if err := client.sagconn().Invoke("ResetSmartAccessGatewayClientUserPassword", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ResetSmartAccessGatewayClientUserPassword", d.Id())
+		}
+		d.SetPartial("password")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudSagClientUserRead(d, meta)
+}
+
+func resourceAlicloudSagClientUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := SagClientUserArgs{
+		RegionId:  client.Region,
+		SmartAGId: parts[0],
+		UserName:  parts[1],
+	}
+	if err := client.sagconn().Invoke("DeleteSmartAccessGatewayClientUser", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "sag") {
+			return nil
+		}
+		return WrapError(err, "DeleteSmartAccessGatewayClientUser", d.Id())
+	}
+	return nil
+}
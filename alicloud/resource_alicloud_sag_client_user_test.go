@@ -0,0 +1,118 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSagClientUser_basic(t *testing.T) {
+	var v SagClientUser
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithSagAppInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sag_client_user.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagClientUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSagClientUserConfig(os.Getenv("ALICLOUD_SAG_APP_INSTANCE_ID"), 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagClientUserExists(
+						"alicloud_sag_client_user.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_client_user.foo",
+						"user_name",
+						"tftestAccSagClientUser"),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_client_user.foo",
+						"bandwidth",
+						"20"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSagClientUserConfig(os.Getenv("ALICLOUD_SAG_APP_INSTANCE_ID"), 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagClientUserExists(
+						"alicloud_sag_client_user.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_client_user.foo",
+						"bandwidth",
+						"50"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSagClientUserExists(n string, d *SagClientUser) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAG client user ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		user, err := client.DescribeSagClientUser(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *user
+		return nil
+	}
+}
+
+func testAccCheckSagClientUserDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sag_client_user" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeSagClientUser(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "sag") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAG client user %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSagClientUserConfig(sagId string, bandwidth int) string {
+	return fmt.Sprintf(`
+resource "alicloud_sag_client_user" "foo" {
+  sag_id = "%s"
+  user_name = "tftestAccSagClientUser"
+  user_mail = "tf-test@example.com"
+  password = "Test123456"
+  bandwidth = %d
+}
+`, sagId, bandwidth)
+}
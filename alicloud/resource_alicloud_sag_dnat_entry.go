@@ -0,0 +1,136 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSagDnatEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSagDnatEntryCreate,
+		Read:   resourceAlicloudSagDnatEntryRead,
+		Delete: resourceAlicloudSagDnatEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sag_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The Internet entries forward the traffic to the public ip of the smart access gateway, and the
+			// Intranet ones forward the traffic from the cloud to the external_ip.
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Intranet", "Internet"}),
+			},
+			"ip_protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"tcp", "udp", "any"}),
+			},
+			"external_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"external_port": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"internal_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"internal_port": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudSagDnatEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := SagDnatEntryArgs{
+		RegionId:     client.Region,
+		SagId:        d.Get("sag_id").(string),
+		Type:         d.Get("type").(string),
+		IpProtocol:   d.Get("ip_protocol").(string),
+		ExternalIp:   d.Get("external_ip").(string),
+		ExternalPort: d.Get("external_port").(string),
+		InternalIp:   d.Get("internal_ip").(string),
+		InternalPort: d.Get("internal_port").(string),
+	}
+	if args.Type == "Intranet" && args.ExternalIp == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "The external_ip is required for the Intranet DNAT entries.")
+	}
+	resp := AddSagDnatEntryResponse{}
+	if err := client.sagconn().Invoke("AddDnatEntry", &args, &resp); err != nil {
+		return WrapError(err, "AddDnatEntry", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.SagId, COLON_SEPARATED, resp.DnatEntryId))
+
+	return resourceAlicloudSagDnatEntryRead(d, meta)
+}
+
+func resourceAlicloudSagDnatEntryRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	entry, err := meta.(*AliyunClient).DescribeSagDnatEntry(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "sag") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDnatEntries", d.Id())
+	}
+
+	d.Set("sag_id", parts[0])
+	d.Set("type", entry.Type)
+	d.Set("ip_protocol", entry.IpProtocol)
+	d.Set("external_ip", entry.ExternalIp)
+	d.Set("external_port", entry.ExternalPort)
+	d.Set("internal_ip", entry.InternalIp)
+	d.Set("internal_port", entry.InternalPort)
+
+	return nil
+}
+
+func resourceAlicloudSagDnatEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := SagDnatEntryArgs{
+		RegionId:    client.Region,
+		SagId:       parts[0],
+		DnatEntryId: parts[1],
+	}
+	if err := client.sagconn().Invoke("DeleteDnatEntry", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "sag") {
+			return nil
+		}
+		return WrapError(err, "DeleteDnatEntry", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSagDnatEntry_basic(t *testing.T) {
+	var v SagDnatEntry
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithSagInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sag_dnat_entry.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagDnatEntryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSagDnatEntryConfig(os.Getenv("ALICLOUD_SAG_INSTANCE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagDnatEntryExists(
+						"alicloud_sag_dnat_entry.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_dnat_entry.foo",
+						"type",
+						"Intranet"),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_dnat_entry.foo",
+						"external_ip",
+						"1.0.0.2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_dnat_entry.foo",
+						"internal_port",
+						"8080"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSagDnatEntryExists(n string, d *SagDnatEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAG DNAT entry ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		entry, err := client.DescribeSagDnatEntry(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *entry
+		return nil
+	}
+}
+
+func testAccCheckSagDnatEntryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sag_dnat_entry" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeSagDnatEntry(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "sag") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAG DNAT entry %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSagDnatEntryConfig(sagId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_sag_dnat_entry" "foo" {
+  sag_id = "%s"
+  type = "Intranet"
+  ip_protocol = "tcp"
+  external_ip = "1.0.0.2"
+  external_port = "80"
+  internal_ip = "10.0.0.2"
+  internal_port = "8080"
+}
+`, sagId)
+}
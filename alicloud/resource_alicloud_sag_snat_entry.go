@@ -0,0 +1,101 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSagSnatEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSagSnatEntryCreate,
+		Read:   resourceAlicloudSagSnatEntryRead,
+		Delete: resourceAlicloudSagSnatEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sag_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The traffic from the cidr_block to the cloud is translated to the snat_ip.
+			"cidr_block": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snat_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudSagSnatEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := SagSnatEntryArgs{
+		RegionId:  client.Region,
+		SmartAGId: d.Get("sag_id").(string),
+		CidrBlock: d.Get("cidr_block").(string),
+		SnatIp:    d.Get("snat_ip").(string),
+	}
+	resp := AddSagSnatEntryResponse{}
+	if err := client.sagconn().Invoke("AddSnatEntry", &args, &resp); err != nil {
+		return WrapError(err, "AddSnatEntry", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.SmartAGId, COLON_SEPARATED, resp.InstanceId))
+
+	return resourceAlicloudSagSnatEntryRead(d, meta)
+}
+
+func resourceAlicloudSagSnatEntryRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	entry, err := meta.(*AliyunClient).DescribeSagSnatEntry(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "sag") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeSnatEntries", d.Id())
+	}
+
+	d.Set("sag_id", parts[0])
+	d.Set("cidr_block", entry.CidrBlock)
+	d.Set("snat_ip", entry.SnatIp)
+
+	return nil
+}
+
+func resourceAlicloudSagSnatEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := SagSnatEntryArgs{
+		RegionId:   client.Region,
+		SmartAGId:  parts[0],
+		InstanceId: parts[1],
+	}
+	if err := client.sagconn().Invoke("DeleteSnatEntry", &args, &common.Response{}); err != nil {
+		if NotFoundError(err, "sag") {
+			return nil
+		}
+		return WrapError(err, "DeleteSnatEntry", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSagSnatEntry_basic(t *testing.T) {
+	var v SagSnatEntry
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithSagInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sag_snat_entry.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagSnatEntryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSagSnatEntryConfig(os.Getenv("ALICLOUD_SAG_INSTANCE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagSnatEntryExists(
+						"alicloud_sag_snat_entry.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_snat_entry.foo",
+						"cidr_block",
+						"192.168.7.0/24"),
+					resource.TestCheckResourceAttr(
+						"alicloud_sag_snat_entry.foo",
+						"snat_ip",
+						"192.0.0.2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSagSnatEntryExists(n string, d *SagSnatEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAG SNAT entry ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		entry, err := client.DescribeSagSnatEntry(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *entry
+		return nil
+	}
+}
+
+func testAccCheckSagSnatEntryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sag_snat_entry" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeSagSnatEntry(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "sag") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAG SNAT entry %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSagSnatEntryConfig(sagId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_sag_snat_entry" "foo" {
+  sag_id = "%s"
+  cidr_block = "192.168.7.0/24"
+  snat_ip = "192.0.0.2"
+}
+`, sagId)
+}
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"fmt"
 
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +19,11 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Read:   schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -38,12 +42,13 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	args, err := buildAliyunSecurityGroupArgs(d, meta)
 	if err != nil {
@@ -60,17 +65,16 @@ func resourceAliyunSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAliyunSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	args := &ecs.DescribeSecurityGroupAttributeArgs{
 		SecurityGroupId: d.Id(),
 		RegionId:        getRegion(d, meta),
 	}
-	//err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 	var sg *ecs.DescribeSecurityGroupAttributeResponse
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		group, e := conn.DescribeSecurityGroupAttribute(args)
-		if e != nil && !NotFoundError(e) {
+		if e != nil && !NotFoundError(e, "ecs") {
 			return resource.NonRetryableError(fmt.Errorf("Error DescribeSecurityGroupAttribute: %#v", e))
 		}
 		if group != nil {
@@ -92,12 +96,24 @@ func resourceAliyunSecurityGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("description", sg.Description)
 	d.Set("vpc_id", sg.VpcId)
 
+	tags, _, err := conn.DescribeTags(&ecs.DescribeTagsArgs{
+		RegionId:     getRegion(d, meta),
+		ResourceType: TagResourceSecurityGroup,
+		ResourceId:   d.Id(),
+	})
+	if err != nil {
+		return fmt.Errorf("DescribeTags for security group got an error: %#v", err)
+	}
+	if err := d.Set("tags", ignoreDefaultTags(meta.(*AliyunClient), d, tagsToMap(tags))); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceAliyunSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	d.Partial(true)
 	attributeUpdate := false
@@ -125,21 +141,26 @@ func resourceAliyunSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if err := setTags(meta.(*AliyunClient), TagResourceSecurityGroup, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
 	d.Partial(false)
 
 	return nil
 }
 
 func resourceAliyunSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DeleteSecurityGroup(getRegion(d, meta), d.Id())
 
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == SgDependencyViolation {
+			if IsExceptedError(err, SgDependencyViolation) {
 				return resource.RetryableError(fmt.Errorf("Security group in use - trying again while it is deleted."))
 			}
 		}
@@ -150,8 +171,7 @@ func resourceAliyunSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 		})
 
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == InvalidSecurityGroupIdNotFound {
+			if NotFoundError(err, "ecs") {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -14,6 +14,13 @@ func resourceAliyunSecurityGroupRule() *schema.Resource {
 		Create: resourceAliyunSecurityGroupRuleCreate,
 		Read:   resourceAliyunSecurityGroupRuleRead,
 		Delete: resourceAliyunSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"type": &schema.Schema{
@@ -90,7 +97,7 @@ func resourceAliyunSecurityGroupRule() *schema.Resource {
 
 func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
+	conn := client.ecsconn()
 
 	direction := d.Get("type").(string)
 	sgId := d.Get("security_group_id").(string)
@@ -113,7 +120,7 @@ func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interfac
 		}
 		autherr = conn.AuthorizeSecurityGroupEgress(args)
 	default:
-		return fmt.Errorf("Security Group Rule must be type 'ingress' or type 'egress'")
+		return ConfigErrorf(ErrorCodeInvalidArgument, "Security Group Rule must be type 'ingress' or type 'egress'")
 	}
 
 	if autherr != nil {
@@ -145,7 +152,7 @@ func resourceAliyunSecurityGroupRuleRead(d *schema.ResourceData, meta interface{
 	rules, err := client.DescribeSecurityByAttr(sgId, direction, nic_type)
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "ecs") {
 			d.SetId("")
 			return nil
 		}
@@ -221,10 +228,11 @@ func deleteSecurityGroupRule(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAliyunSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 	parts := strings.Split(d.Id(), ":")
 	sgId, direction, ip_protocol, port_range, nic_type := parts[0], parts[1], parts[2], parts[3], parts[4]
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := deleteSecurityGroupRule(d, meta)
 
 		if err != nil {
@@ -233,7 +241,7 @@ func resourceAliyunSecurityGroupRuleDelete(d *schema.ResourceData, meta interfac
 
 		_, err = client.DescribeSecurityGroupRule(sgId, direction, nic_type, ip_protocol, port_range)
 		if err != nil {
-			if NotFoundError(err) {
+			if NotFoundError(err, "ecs") {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -245,7 +253,7 @@ func resourceAliyunSecurityGroupRuleDelete(d *schema.ResourceData, meta interfac
 }
 
 func buildAliyunSecurityIngressArgs(d *schema.ResourceData, meta interface{}) (*ecs.AuthorizeSecurityGroupArgs, error) {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	args := &ecs.AuthorizeSecurityGroupArgs{
 		RegionId: getRegion(d, meta),
@@ -306,7 +314,7 @@ func buildAliyunSecurityIngressArgs(d *schema.ResourceData, meta interface{}) (*
 }
 
 func buildAliyunSecurityEgressArgs(d *schema.ResourceData, meta interface{}) (*ecs.AuthorizeSecurityGroupEgressArgs, error) {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	args := &ecs.AuthorizeSecurityGroupEgressArgs{
 		RegionId: getRegion(d, meta),
@@ -0,0 +1,308 @@
+package alicloud
+
+import (
+	"strings"
+
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAlicloudSecurityGroupRules manages all of the rules of a security group exclusively. The rules that are
+// not declared, such as the ones added in the console, are revoked on apply. It must not be used together with the
+// alicloud_security_group_rule of the same security group.
+func resourceAlicloudSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSecurityGroupRulesCreate,
+		Read:   resourceAlicloudSecurityGroupRulesRead,
+		Update: resourceAlicloudSecurityGroupRulesUpdate,
+		Delete: resourceAlicloudSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ingress": securityGroupRulesSchema(),
+			"egress":  securityGroupRulesSchema(),
+		},
+	}
+}
+
+// securityGroupRulesSchema returns the schema of the rules of a direction. The source_security_group_id and the
+// source_group_owner_account are the destination ones of the egress rules, the same as alicloud_security_group_rule.
+func securityGroupRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip_protocol": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateSecurityRuleIpProtocol,
+				},
+				"port_range": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"nic_type": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      string(GroupRuleIntranet),
+					ValidateFunc: validateSecurityRuleNicType,
+				},
+				"policy": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      string(GroupRulePolicyAccept),
+					ValidateFunc: validateSecurityRulePolicy,
+				},
+				"priority": &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validateSecurityPriority,
+				},
+				"cidr_ip": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"source_security_group_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"source_group_owner_account": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudSecurityGroupRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.SetId(d.Get("security_group_id").(string))
+
+	group, err := client.DescribeSecurity(d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeSecurityGroupAttribute", d.Id())
+	}
+
+	// The security group may already have the rules which are not declared, so the declared rules are compared
+	// with the ones the security group has, rather than with the empty state.
+	declared := make(map[GroupRuleDirection]*schema.Set)
+	for _, direction := range []GroupRuleDirection{GroupRuleIngress, GroupRuleEgress} {
+		declared[direction] = d.Get(string(direction)).(*schema.Set)
+	}
+	if err := setSecurityGroupRules(client, d, group.VpcId); err != nil {
+		return err
+	}
+	for direction, rules := range declared {
+		if err := applySecurityGroupRules(client, d.Id(), direction, d.Get(string(direction)).(*schema.Set), rules); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAlicloudSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	group, err := client.DescribeSecurity(d.Id())
+	if err != nil {
+		if NotFoundError(err, "ecs") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeSecurityGroupAttribute", d.Id())
+	}
+
+	d.Set("security_group_id", d.Id())
+	return setSecurityGroupRules(client, d, group.VpcId)
+}
+
+func resourceAlicloudSecurityGroupRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateSecurityGroupRules(meta.(*AliyunClient), d); err != nil {
+		return err
+	}
+
+	return resourceAlicloudSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAlicloudSecurityGroupRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	for _, direction := range []GroupRuleDirection{GroupRuleIngress, GroupRuleEgress} {
+		for _, rule := range d.Get(string(direction)).(*schema.Set).List() {
+			if err := revokeSecurityGroupRule(client, d.Id(), direction, rule.(map[string]interface{})); err != nil {
+				if NotFoundError(err, "ecs") {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// setSecurityGroupRules sets the ingress and egress rules of the security group d. The rules are described by
+// the nic type, and the security group in the VPC only has the intranet ones.
+func setSecurityGroupRules(client *AliyunClient, d *schema.ResourceData, vpcId string) error {
+	nicTypes := []GroupRuleNicType{GroupRuleIntranet}
+	if vpcId == "" {
+		nicTypes = append(nicTypes, GroupRuleInternet)
+	}
+
+	var ingress, egress []map[string]interface{}
+	for _, nicType := range nicTypes {
+		sg, err := client.DescribeSecurityByAttr(d.Id(), "all", string(nicType))
+		if err != nil {
+			return WrapError(err, "DescribeSecurityGroupAttribute", d.Id())
+		}
+		for _, permission := range sg.Permissions.Permission {
+			if GroupRuleDirection(permission.Direction) == GroupRuleEgress {
+				egress = append(egress, flattenSecurityGroupPermission(permission, GroupRuleEgress))
+			} else {
+				ingress = append(ingress, flattenSecurityGroupPermission(permission, GroupRuleIngress))
+			}
+		}
+	}
+
+	if err := d.Set("ingress", ingress); err != nil {
+		return err
+	}
+	return d.Set("egress", egress)
+}
+
+// updateSecurityGroupRules authorizes the rules added to the security group d and revokes the removed ones.
+func updateSecurityGroupRules(client *AliyunClient, d *schema.ResourceData) error {
+	for _, direction := range []GroupRuleDirection{GroupRuleIngress, GroupRuleEgress} {
+		key := string(direction)
+		if !d.HasChange(key) {
+			continue
+		}
+		o, n := d.GetChange(key)
+		if err := applySecurityGroupRules(client, d.Id(), direction, o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applySecurityGroupRules revokes the rules of a direction in os but not in ns, and authorizes the ones in ns but
+// not in os.
+func applySecurityGroupRules(client *AliyunClient, securityGroupId string, direction GroupRuleDirection, os, ns *schema.Set) error {
+	// The rules are revoked first, as a changed rule may conflict with its old one.
+	for _, rule := range os.Difference(ns).List() {
+		if err := revokeSecurityGroupRule(client, securityGroupId, direction, rule.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+	for _, rule := range ns.Difference(os).List() {
+		if err := authorizeSecurityGroupRule(client, securityGroupId, direction, rule.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flattenSecurityGroupPermission(permission ecs.PermissionType, direction GroupRuleDirection) map[string]interface{} {
+	rule := map[string]interface{}{
+		"ip_protocol": strings.ToLower(string(permission.IpProtocol)),
+		"port_range":  permission.PortRange,
+		"nic_type":    string(permission.NicType),
+		"policy":      strings.ToLower(string(permission.Policy)),
+		"priority":    permission.Priority,
+	}
+	if direction == GroupRuleEgress {
+		rule["cidr_ip"] = permission.DestCidrIp
+		rule["source_security_group_id"] = permission.DestGroupId
+		rule["source_group_owner_account"] = permission.DestGroupOwnerAccount
+	} else {
+		rule["cidr_ip"] = permission.SourceCidrIp
+		rule["source_security_group_id"] = permission.SourceGroupId
+		rule["source_group_owner_account"] = permission.SourceGroupOwnerAccount
+	}
+	return rule
+}
+
+func buildSecurityGroupRuleArgs(client *AliyunClient, securityGroupId string, rule map[string]interface{}) (*ecs.AuthorizeSecurityGroupArgs, error) {
+	args := &ecs.AuthorizeSecurityGroupArgs{
+		RegionId:                client.Region,
+		SecurityGroupId:         securityGroupId,
+		IpProtocol:              ecs.IpProtocol(rule["ip_protocol"].(string)),
+		PortRange:               rule["port_range"].(string),
+		NicType:                 ecs.NicType(rule["nic_type"].(string)),
+		Policy:                  ecs.PermissionPolicy(rule["policy"].(string)),
+		Priority:                rule["priority"].(int),
+		SourceCidrIp:            rule["cidr_ip"].(string),
+		SourceGroupId:           rule["source_security_group_id"].(string),
+		SourceGroupOwnerAccount: rule["source_group_owner_account"].(string),
+	}
+	if args.SourceCidrIp != "" && args.SourceGroupId != "" {
+		return nil, ConfigErrorf(ErrorCodeInvalidArgument, "The rule %s %s of security group %s can not have both cidr_ip and source_security_group_id.",
+			args.IpProtocol, args.PortRange, securityGroupId)
+	}
+	return args, nil
+}
+
+func authorizeSecurityGroupRule(client *AliyunClient, securityGroupId string, direction GroupRuleDirection, rule map[string]interface{}) error {
+	args, err := buildSecurityGroupRuleArgs(client, securityGroupId, rule)
+	if err != nil {
+		return err
+	}
+	if direction == GroupRuleEgress {
+		if err := client.ecsconn().AuthorizeSecurityGroupEgress(buildSecurityGroupEgressRuleArgs(args)); err != nil {
+			return WrapError(err, "AuthorizeSecurityGroupEgress", securityGroupId)
+		}
+		return nil
+	}
+	if err := client.ecsconn().AuthorizeSecurityGroup(args); err != nil {
+		return WrapError(err, "AuthorizeSecurityGroup", securityGroupId)
+	}
+	return nil
+}
+
+func revokeSecurityGroupRule(client *AliyunClient, securityGroupId string, direction GroupRuleDirection, rule map[string]interface{}) error {
+	args, err := buildSecurityGroupRuleArgs(client, securityGroupId, rule)
+	if err != nil {
+		return err
+	}
+	if direction == GroupRuleEgress {
+		revokeArgs := &ecs.RevokeSecurityGroupEgressArgs{
+			AuthorizeSecurityGroupEgressArgs: *buildSecurityGroupEgressRuleArgs(args),
+		}
+		if err := client.RevokeSecurityGroupEgress(revokeArgs); err != nil {
+			return WrapError(err, "RevokeSecurityGroupEgress", securityGroupId)
+		}
+		return nil
+	}
+	if err := client.RevokeSecurityGroup(&ecs.RevokeSecurityGroupArgs{AuthorizeSecurityGroupArgs: *args}); err != nil {
+		return WrapError(err, "RevokeSecurityGroup", securityGroupId)
+	}
+	return nil
+}
+
+// buildSecurityGroupEgressRuleArgs converts the args of an ingress rule to the egress one, whose source is the
+// destination.
+func buildSecurityGroupEgressRuleArgs(args *ecs.AuthorizeSecurityGroupArgs) *ecs.AuthorizeSecurityGroupEgressArgs {
+	return &ecs.AuthorizeSecurityGroupEgressArgs{
+		RegionId:              args.RegionId,
+		SecurityGroupId:       args.SecurityGroupId,
+		IpProtocol:            args.IpProtocol,
+		PortRange:             args.PortRange,
+		NicType:               args.NicType,
+		Policy:                args.Policy,
+		Priority:              args.Priority,
+		DestCidrIp:            args.SourceCidrIp,
+		DestGroupId:           args.SourceGroupId,
+		DestGroupOwnerAccount: args.SourceGroupOwnerAccount,
+	}
+}
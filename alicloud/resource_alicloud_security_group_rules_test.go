@@ -0,0 +1,226 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSecurityGroupRules_basic(t *testing.T) {
+	var securityGroupId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_security_group_rules.default",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSecurityGroupRulesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount("alicloud_security_group_rules.default", 2, &securityGroupId),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.default", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.default", "egress.#", "1"),
+				),
+			},
+			resource.TestStep{
+				// The rule added out of Terraform is revoked on apply.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*AliyunClient)
+					err := client.ecsconn().AuthorizeSecurityGroup(&ecs.AuthorizeSecurityGroupArgs{
+						RegionId:        client.Region,
+						SecurityGroupId: securityGroupId,
+						IpProtocol:      ecs.IpProtocol(GroupRuleTcp),
+						PortRange:       "22/22",
+						NicType:         ecs.NicType(GroupRuleIntranet),
+						SourceCidrIp:    "0.0.0.0/0",
+					})
+					if err != nil {
+						t.Fatalf("Authorizing the drifted rule got an error: %#v", err)
+					}
+				},
+				Config: testAccSecurityGroupRulesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount("alicloud_security_group_rules.default", 2, &securityGroupId),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSecurityGroupRulesConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount("alicloud_security_group_rules.default", 2, &securityGroupId),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.default", "ingress.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.default", "egress.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudSecurityGroupRules_existingRules(t *testing.T) {
+	var sg ecs.DescribeSecurityGroupAttributeResponse
+	var securityGroupId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSecurityGroupRulesConfigGroup,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists("alicloud_security_group.default", &sg),
+				),
+			},
+			resource.TestStep{
+				// The rule the security group has before the resource is created is revoked on creation.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*AliyunClient)
+					err := client.ecsconn().AuthorizeSecurityGroup(&ecs.AuthorizeSecurityGroupArgs{
+						RegionId:        client.Region,
+						SecurityGroupId: sg.SecurityGroupId,
+						IpProtocol:      ecs.IpProtocol(GroupRuleTcp),
+						PortRange:       "22/22",
+						NicType:         ecs.NicType(GroupRuleIntranet),
+						SourceCidrIp:    "0.0.0.0/0",
+					})
+					if err != nil {
+						t.Fatalf("Authorizing the existing rule got an error: %#v", err)
+					}
+				},
+				Config: testAccSecurityGroupRulesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount("alicloud_security_group_rules.default", 2, &securityGroupId),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.default", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.default", "egress.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesCount(n string, count int, securityGroupId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Group ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		sg, err := client.DescribeSecurityByAttr(rs.Primary.ID, "all", string(GroupRuleIntranet))
+		if err != nil {
+			return err
+		}
+		if len(sg.Permissions.Permission) != count {
+			return fmt.Errorf("Expected %d rules of security group %s, got %d", count, rs.Primary.ID, len(sg.Permissions.Permission))
+		}
+
+		*securityGroupId = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_security_group_rules" {
+			continue
+		}
+
+		sg, err := client.DescribeSecurityByAttr(rs.Primary.ID, "all", string(GroupRuleIntranet))
+		if err != nil {
+			if NotFoundError(err, "ecs") {
+				continue
+			}
+			return err
+		}
+		if len(sg.Permissions.Permission) > 0 {
+			return fmt.Errorf("Security group %s still has %d rules", rs.Primary.ID, len(sg.Permissions.Permission))
+		}
+	}
+
+	return nil
+}
+
+const testAccSecurityGroupRulesConfig = `
+resource "alicloud_vpc" "default" {
+  name = "tf_test_security_group_rules"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_security_group" "default" {
+  name = "tf_test_security_group_rules"
+  vpc_id = "${alicloud_vpc.default.id}"
+}
+
+resource "alicloud_security_group_rules" "default" {
+  security_group_id = "${alicloud_security_group.default.id}"
+
+  ingress {
+    ip_protocol = "tcp"
+    port_range = "80/80"
+    cidr_ip = "0.0.0.0/0"
+  }
+
+  egress {
+    ip_protocol = "all"
+    port_range = "-1/-1"
+    cidr_ip = "10.0.0.0/8"
+  }
+}
+`
+
+const testAccSecurityGroupRulesConfigGroup = `
+resource "alicloud_vpc" "default" {
+  name = "tf_test_security_group_rules"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_security_group" "default" {
+  name = "tf_test_security_group_rules"
+  vpc_id = "${alicloud_vpc.default.id}"
+}
+`
+
+const testAccSecurityGroupRulesConfigUpdate = `
+resource "alicloud_vpc" "default" {
+  name = "tf_test_security_group_rules"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_security_group" "default" {
+  name = "tf_test_security_group_rules"
+  vpc_id = "${alicloud_vpc.default.id}"
+}
+
+resource "alicloud_security_group_rules" "default" {
+  security_group_id = "${alicloud_security_group.default.id}"
+
+  ingress {
+    ip_protocol = "tcp"
+    port_range = "80/80"
+    cidr_ip = "0.0.0.0/0"
+  }
+
+  ingress {
+    ip_protocol = "tcp"
+    port_range = "443/443"
+    cidr_ip = "0.0.0.0/0"
+    priority = 10
+  }
+}
+`
@@ -4,12 +4,61 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
 )
 
+func init() {
+	addTestSweepers("alicloud_security_group", &testSweeper{
+		Dependencies: []string{
+			"alicloud_instance",
+			"alicloud_ess_scaling_group",
+		},
+		F: testSweepSecurityGroups,
+	})
+}
+
+func testSweepSecurityGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.ecsconn()
+
+	var ids []string
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		groups, _, err := conn.DescribeSecurityGroups(&ecs.DescribeSecurityGroupsArgs{
+			RegionId:   client.Region,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeSecurityGroups", "")
+		}
+		for _, group := range groups {
+			if !isSweepable(group.SecurityGroupName) {
+				log.Printf("[INFO] Skipping security group %s (%s)", group.SecurityGroupName, group.SecurityGroupId)
+				continue
+			}
+			ids = append(ids, group.SecurityGroupId)
+		}
+		return len(groups), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting security group %s", id)
+		if err := conn.DeleteSecurityGroup(client.Region, id); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteSecurityGroup", id))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudSecurityGroup_basic(t *testing.T) {
 	var sg ecs.DescribeSecurityGroupAttributeResponse
 
@@ -81,7 +130,7 @@ func testAccCheckSecurityGroupExists(n string, sg *ecs.DescribeSecurityGroupAttr
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
+		conn := client.ecsconn()
 		args := &ecs.DescribeSecurityGroupAttributeArgs{
 			RegionId:        client.Region,
 			SecurityGroupId: rs.Primary.ID,
@@ -105,7 +154,7 @@ func testAccCheckSecurityGroupExists(n string, sg *ecs.DescribeSecurityGroupAttr
 
 func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*AliyunClient)
-	conn := client.ecsconn
+	conn := client.ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alicloud_security_group" {
@@ -6,12 +6,12 @@ import (
 	"strings"
 
 	"errors"
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/slb"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"reflect"
+	"sync"
 	"time"
 )
 
@@ -25,6 +25,12 @@ func resourceAliyunSlb() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -223,13 +229,21 @@ func resourceAliyunSlb() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"resource_group_id": resourceGroupIdSchema(),
+
+			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunSlbCreate(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	slbconn := meta.(*AliyunClient).slbconn()
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	if err := checkSlbArguments(d); err != nil {
+		return err
+	}
 
 	var slbName string
 	if v, ok := d.GetOk("name"); ok {
@@ -243,6 +257,7 @@ func resourceAliyunSlbCreate(d *schema.ResourceData, meta interface{}) error {
 		RegionId:         getRegion(d, meta),
 		LoadBalancerName: slbName,
 		AddressType:      slb.IntranetAddressType,
+		ClientToken:      buildClientToken("tf-lb-"),
 	}
 
 	if internet, ok := d.GetOk("internet"); ok && internet.(bool) {
@@ -267,14 +282,14 @@ func resourceAliyunSlbCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(slb.LoadBalancerId)
 
-	return resourceAliyunSlbUpdate(d, meta)
+	return updateSlb(d, meta, deadline)
 }
 
 func resourceAliyunSlbRead(d *schema.ResourceData, meta interface{}) error {
-	slbconn := meta.(*AliyunClient).slbconn
+	slbconn := meta.(*AliyunClient).slbconn()
 	loadBalancer, err := slbconn.DescribeLoadBalancerAttribute(d.Id())
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "slb") {
 			d.SetId("")
 			return nil
 		}
@@ -303,15 +318,41 @@ func resourceAliyunSlbRead(d *schema.ResourceData, meta interface{}) error {
 	if listeners, err := readListerners(slbconn, loadBalancer); err != nil {
 		return fmt.Errorf("Error reading listeners: %#v", err)
 	} else {
-		d.Set("listener", listeners)
+		if err := d.Set("listener", listeners); err != nil {
+			return err
+		}
+	}
+
+	tags, err := describeSlbTags(meta.(*AliyunClient), d.Id())
+	if err != nil {
+		return fmt.Errorf("Describing tags of %s got an error: %#v", d.Id(), err)
 	}
+	if err := d.Set("tags", ignoreDefaultTags(meta.(*AliyunClient), d, tags)); err != nil {
+		return err
+	}
+
+	groupId, err := describeResourceGroupId(meta.(*AliyunClient), ResourceGroupResourceLoadBalancer, d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeResourceGroup", d.Id())
+	}
+	d.Set("resource_group_id", groupId)
 
 	return nil
 }
 
 func resourceAliyunSlbUpdate(d *schema.ResourceData, meta interface{}) error {
+	return updateSlb(d, meta, newOperationDeadline(d, schema.TimeoutUpdate))
+}
 
-	slbconn := meta.(*AliyunClient).slbconn
+// updateSlb updates the load balancer before the deadline, which is the one of the Create when the load balancer
+// is just created.
+func updateSlb(d *schema.ResourceData, meta interface{}, deadline operationDeadline) error {
+
+	slbconn := meta.(*AliyunClient).slbconn()
+
+	if err := checkSlbArguments(d); err != nil {
+		return err
+	}
 
 	d.Partial(true)
 
@@ -359,7 +400,7 @@ func resourceAliyunSlbUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if len(add) > 0 {
 			for _, listener := range add {
-				err := createListener(slbconn, d.Id(), listener)
+				err := createListener(slbconn, d.Id(), listener, deadline)
 				if err != nil {
 					return fmt.Errorf("Failure add SLB listeners: %#v", err)
 				}
@@ -399,25 +440,38 @@ func resourceAliyunSlbUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("instances")
 	}
 
+	if err := setSlbTags(meta.(*AliyunClient), d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
+	if err := setResourceGroup(meta.(*AliyunClient), ResourceGroupResourceLoadBalancer, d); err != nil {
+		return err
+	}
+	d.SetPartial("resource_group_id")
+
 	d.Partial(false)
 
 	return resourceAliyunSlbRead(d, meta)
 }
 
 func resourceAliyunSlbDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).slbconn
+	conn := meta.(*AliyunClient).slbconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DeleteLoadBalancer(d.Id())
 
 		if err != nil {
-			return resource.NonRetryableError(err)
+			if NotFoundError(err, "slb") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteLoadBalancer", d.Id()))
 		}
 
 		loadBalancer, err := conn.DescribeLoadBalancerAttribute(d.Id())
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == LoadBalancerNotFound {
+			if NotFoundError(err, "slb") {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -429,6 +483,71 @@ func resourceAliyunSlbDelete(d *schema.ResourceData, meta interface{}) error {
 	})
 }
 
+// checkSlbArguments checks the constraints between the arguments of the load balancer and its listeners, which
+// cannot be checked by the ValidateFunc of a single argument. The helper/schema of the terraform version the
+// provider is built with has no CustomizeDiff, so they are checked before any API is called in the Create and
+// the Update instead of at plan time, and no listener is left half created by an invalid configuration.
+func checkSlbArguments(d *schema.ResourceData) error {
+	if d.HasChange("bandwidth") && d.Get("bandwidth").(int) != 0 {
+		if !d.Get("internet").(bool) || d.Get("internet_charge_type").(string) != "paybybandwidth" {
+			return ConfigErrorf(ErrorCodeConflictingArguments, "bandwidth of the load balancer can only be set when internet is true and internet_charge_type is paybybandwidth")
+		}
+	}
+
+	listeners, err := expandListeners(d.Get("listener").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+	for _, listener := range listeners {
+		if err := checkSlbListener(listener); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSlbListener checks the arguments required by the protocol, the health check and the sticky session
+// of the listener.
+func checkSlbListener(listener *Listener) error {
+	protocol := strings.ToLower(listener.Protocol)
+	if protocol != "http" && protocol != "https" {
+		return nil
+	}
+
+	if protocol == "https" && listener.SSLCertificateId == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "ssl_certificate_id is required for the https listener on port %d", listener.LoadBalancerPort)
+	}
+
+	if listener.HealthCheck == slb.OnFlag {
+		if listener.HealthCheckURI == "" || listener.HealthCheckDomain == "" || listener.HealthCheckConnectPort == 0 ||
+			listener.HealthyThreshold == 0 || listener.UnhealthyThreshold == 0 || listener.HealthCheckTimeout == 0 ||
+			listener.HealthCheckHttpCode == "" || listener.HealthCheckInterval == 0 {
+			return ConfigErrorf(ErrorCodeMissingArgument, "health_check_uri, health_check_domain, health_check_connect_port, healthy_threshold, "+
+				"unhealthy_threshold, health_check_timeout, health_check_http_code and health_check_interval are required "+
+				"when health_check is %s for the listener on port %d", slb.OnFlag, listener.LoadBalancerPort)
+		}
+	}
+
+	if listener.StickySession == slb.OnFlag {
+		switch listener.StickySessionType {
+		case "":
+			return ConfigErrorf(ErrorCodeMissingArgument, "sticky_session_type is required when sticky_session is %s for the listener on port %d",
+				slb.OnFlag, listener.LoadBalancerPort)
+		case slb.InsertStickySessionType:
+			if listener.CookieTimeout == 0 {
+				return ConfigErrorf(ErrorCodeMissingArgument, "cookie_timeout is required when sticky_session_type is %s for the listener on port %d",
+					slb.InsertStickySessionType, listener.LoadBalancerPort)
+			}
+		case slb.ServerStickySessionType:
+			if listener.Cookie == "" {
+				return ConfigErrorf(ErrorCodeMissingArgument, "cookie is required when sticky_session_type is %s for the listener on port %d",
+					slb.ServerStickySessionType, listener.LoadBalancerPort)
+			}
+		}
+	}
+	return nil
+}
+
 func resourceAliyunSlbListenerHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -446,7 +565,7 @@ func resourceAliyunSlbListenerHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func createListener(conn *slb.Client, loadBalancerId string, listener *Listener) error {
+func createListener(conn SLBService, loadBalancerId string, listener *Listener, deadline operationDeadline) error {
 
 	errTypeJudge := func(err error) error {
 		if err != nil {
@@ -512,7 +631,7 @@ func createListener(conn *slb.Client, loadBalancerId string, listener *Listener)
 		}
 	}
 
-	if err := conn.WaitForListenerAsyn(loadBalancerId, listener.LoadBalancerPort, slb.ListenerType(strings.ToUpper(listener.Protocol)), slb.Stopped, defaultTimeout); err != nil {
+	if err := conn.WaitForListenerAsyn(loadBalancerId, listener.LoadBalancerPort, slb.ListenerType(strings.ToUpper(listener.Protocol)), slb.Stopped, deadline.Seconds()); err != nil {
 		return fmt.Errorf("WaitForListener %s got error: %#v", slb.Stopped, err)
 	}
 
@@ -622,45 +741,94 @@ func getHttpListenerArgs(loadBalancerId string, listener *Listener) (listenType
 	return httpArgs, err
 }
 
-func readListerners(conn *slb.Client, loadBalancer *slb.LoadBalancerType) ([]map[string]interface{}, error) {
-	listeners := make([]map[string]interface{}, 0, len(loadBalancer.ListenerPortsAndProtocol.ListenerPortAndProtocol))
-	for _, port := range loadBalancer.ListenerPorts.ListenerPort {
-		http_ls, err := conn.DescribeLoadBalancerHTTPListenerAttribute(loadBalancer.LoadBalancerId, port)
-		if err != nil && !IsExceptedError(err, UnsupportedProtocalPort) {
-			return nil, fmt.Errorf("Error DescribeLoadBalancerHTTPListenerAttribute: %#v", err)
-		}
-		if http_ls != nil {
-			listeners = append(listeners, setListenerAttribute(http_ls, Http))
-		}
+// slbListenerReadConcurrency bounds the listener describes issued at the same time when reading a load balancer,
+// so that the ones with dozens of listeners refresh quickly without being throttled.
+const slbListenerReadConcurrency = 5
 
-		https_ls, err := conn.DescribeLoadBalancerHTTPSListenerAttribute(loadBalancer.LoadBalancerId, port)
-		if err != nil && !IsExceptedError(err, UnsupportedProtocalPort) {
-			return nil, fmt.Errorf("Error DescribeLoadBalancerHTTPSListenerAttribute: %#v", err)
-		}
-		if https_ls != nil {
-			listeners = append(listeners, setListenerAttribute(https_ls, Https))
-		}
+type slbListenerKey struct {
+	port      int
+	protocols []Protocol
+}
 
-		tcp_ls, err := conn.DescribeLoadBalancerTCPListenerAttribute(loadBalancer.LoadBalancerId, port)
-		if err != nil && !IsExceptedError(err, UnsupportedProtocalPort) {
-			return nil, fmt.Errorf("Error DescribeLoadBalancerTCPListenerAttribute: %#v", err)
-		}
-		if tcp_ls != nil {
-			listeners = append(listeners, setListenerAttribute(tcp_ls, Tcp))
+func readListerners(conn SLBService, loadBalancer *slb.LoadBalancerType) ([]map[string]interface{}, error) {
+	// The protocol of each port is known from the load balancer attribute, and only the listeners
+	// created before it was returned need to be probed with all of the protocols.
+	var keys []slbListenerKey
+	for _, listener := range loadBalancer.ListenerPortsAndProtocol.ListenerPortAndProtocol {
+		keys = append(keys, slbListenerKey{
+			port:      listener.ListenerPort,
+			protocols: []Protocol{Protocol(strings.ToLower(listener.ListenerProtocol))},
+		})
+	}
+	if len(keys) == 0 {
+		for _, port := range loadBalancer.ListenerPorts.ListenerPort {
+			keys = append(keys, slbListenerKey{port: port, protocols: []Protocol{Http, Https, Tcp, Udp}})
 		}
+	}
 
-		udp_ls, err := conn.DescribeLoadBalancerUDPListenerAttribute(loadBalancer.LoadBalancerId, port)
-		if err != nil && !IsExceptedError(err, UnsupportedProtocalPort) {
-			return nil, fmt.Errorf("Error DescribeLoadBalancerUDPListenerAttribute: %#v", err)
-		}
-		if udp_ls != nil {
-			listeners = append(listeners, setListenerAttribute(udp_ls, Udp))
-		}
+	results := make([][]map[string]interface{}, len(keys))
+	errs := make([]error, len(keys))
+	sem := make(chan struct{}, slbListenerReadConcurrency)
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key slbListenerKey) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			for _, protocol := range key.protocols {
+				listener, err := readListener(conn, loadBalancer.LoadBalancerId, key.port, protocol)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				if listener != nil {
+					results[i] = append(results[i], listener)
+				}
+			}
+		}(i, key)
 	}
+	wg.Wait()
 
+	listeners := make([]map[string]interface{}, 0, len(keys))
+	for i := range keys {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		listeners = append(listeners, results[i]...)
+	}
 	return listeners, nil
 }
 
+// readListener returns the listener on the port with the protocol, or nil if the port is not listened with it.
+func readListener(conn SLBService, loadBalancerId string, port int, protocol Protocol) (map[string]interface{}, error) {
+	var listen interface{}
+	var err error
+	switch protocol {
+	case Http:
+		listen, err = conn.DescribeLoadBalancerHTTPListenerAttribute(loadBalancerId, port)
+	case Https:
+		listen, err = conn.DescribeLoadBalancerHTTPSListenerAttribute(loadBalancerId, port)
+	case Tcp:
+		listen, err = conn.DescribeLoadBalancerTCPListenerAttribute(loadBalancerId, port)
+	case Udp:
+		listen, err = conn.DescribeLoadBalancerUDPListenerAttribute(loadBalancerId, port)
+	default:
+		return nil, fmt.Errorf("Unsupported protocol %s of the listener on port %d.", protocol, port)
+	}
+	if err != nil {
+		if IsExceptedError(err, UnsupportedProtocalPort) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error DescribeLoadBalancer%sListenerAttribute: %#v", strings.ToUpper(string(protocol)), err)
+	}
+	// The typed nil responses must not be flattened.
+	if reflect.ValueOf(listen).IsNil() {
+		return nil, nil
+	}
+	return setListenerAttribute(listen, protocol), nil
+}
+
 func setListenerAttribute(listen interface{}, protocol Protocol) map[string]interface{} {
 	listener := make(map[string]interface{})
 	v := reflect.ValueOf(listen).Elem()
@@ -12,6 +12,9 @@ func resourceAliyunSlbAttachment() *schema.Resource {
 		Read:   resourceAliyunSlbAttachmentRead,
 		Update: resourceAliyunSlbAttachmentUpdate,
 		Delete: resourceAliyunSlbAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -40,11 +43,11 @@ func resourceAliyunSlbAttachmentCreate(d *schema.ResourceData, meta interface{})
 
 	slbId := d.Get("slb_id").(string)
 
-	slbconn := meta.(*AliyunClient).slbconn
+	slbconn := meta.(*AliyunClient).slbconn()
 
 	loadBalancer, err := slbconn.DescribeLoadBalancerAttribute(slbId)
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "slb") {
 			d.SetId("")
 			return fmt.Errorf("Special SLB Id not found: %#v", err)
 		}
@@ -59,10 +62,10 @@ func resourceAliyunSlbAttachmentCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAliyunSlbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	slbconn := meta.(*AliyunClient).slbconn()
 	loadBalancer, err := slbconn.DescribeLoadBalancerAttribute(d.Id())
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "slb") {
 			d.SetId("")
 			return nil
 		}
@@ -87,7 +90,9 @@ func resourceAliyunSlbAttachmentRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("slb_id", d.Id())
-	d.Set("instances", instanceIds)
+	if err := d.Set("instances", instanceIds); err != nil {
+		return err
+	}
 	d.Set("backend_servers", strings.Join(instanceIds, ","))
 
 	return nil
@@ -95,7 +100,7 @@ func resourceAliyunSlbAttachmentRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	slbconn := meta.(*AliyunClient).slbconn()
 	if d.HasChange("instances") {
 		o, n := d.GetChange("instances")
 		os := o.(*schema.Set)
@@ -128,7 +133,7 @@ func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceAliyunSlbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	slbconn := meta.(*AliyunClient).slbconn()
 	o := d.Get("instances")
 	os := o.(*schema.Set)
 	remove := expandBackendServers(os.List())
@@ -5,12 +5,50 @@ import (
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/slb"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
+func init() {
+	addTestSweepers("alicloud_slb", &testSweeper{
+		F: testSweepSlbs,
+	})
+}
+
+func testSweepSlbs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.slbconn()
+
+	loadBalancers, err := conn.DescribeLoadBalancers(&slb.DescribeLoadBalancersArgs{
+		RegionId: client.Region,
+	})
+	if err != nil {
+		return WrapError(err, "DescribeLoadBalancers", "")
+	}
+
+	for _, lb := range loadBalancers {
+		if !isSweepable(lb.LoadBalancerName) {
+			log.Printf("[INFO] Skipping SLB %s (%s)", lb.LoadBalancerName, lb.LoadBalancerId)
+			continue
+		}
+		log.Printf("[INFO] Deleting SLB %s (%s)", lb.LoadBalancerName, lb.LoadBalancerId)
+		if err := conn.DeleteLoadBalancer(lb.LoadBalancerId); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteLoadBalancer", lb.LoadBalancerId))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudSlb_basic(t *testing.T) {
+	defer testAccRecorder(t)()
+
 	var slb slb.LoadBalancerType
 
 	testCheckAttr := func() resource.TestCheckFunc {
@@ -79,7 +117,35 @@ func TestAccAlicloudSlb_traffic(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudSlb_timeouts(t *testing.T) {
+	var slb slb.LoadBalancerType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_slb.timeouts",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSlbDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSlbTimeouts,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlbExists("alicloud_slb.timeouts", &slb),
+					resource.TestCheckResourceAttr(
+						"alicloud_slb.timeouts", "name", "tf_test_slb_timeouts"),
+					testAccCheckResourceTimeout("alicloud_slb.timeouts", schema.TimeoutDelete, 10*time.Minute),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAlicloudSlb_listener(t *testing.T) {
+	defer testAccRecorder(t)()
+
 	var slb slb.LoadBalancerType
 
 	testListener := func() resource.TestCheckFunc {
@@ -118,6 +184,8 @@ func TestAccAlicloudSlb_listener(t *testing.T) {
 }
 
 func TestAccAlicloudSlb_vpc(t *testing.T) {
+	defer testAccRecorder(t)()
+
 	var slb slb.LoadBalancerType
 
 	resource.Test(t, resource.TestCase{
@@ -142,6 +210,59 @@ func TestAccAlicloudSlb_vpc(t *testing.T) {
 	})
 }
 
+func TestResourceAlicloudSlbListener_validation(t *testing.T) {
+	var testCases = []struct {
+		Listener Listener
+		Valid    bool
+	}{
+		{
+			Listener: Listener{Protocol: "tcp", LoadBalancerPort: 22},
+			Valid:    true,
+		},
+		{
+			Listener: Listener{Protocol: "https", LoadBalancerPort: 443},
+			Valid:    false,
+		},
+		{
+			Listener: Listener{Protocol: "https", LoadBalancerPort: 443, SSLCertificateId: "1234567890_abcdef"},
+			Valid:    true,
+		},
+		{
+			Listener: Listener{Protocol: "http", LoadBalancerPort: 80, StickySession: slb.OnFlag},
+			Valid:    false,
+		},
+		{
+			Listener: Listener{Protocol: "http", LoadBalancerPort: 80, StickySession: slb.OnFlag,
+				StickySessionType: slb.InsertStickySessionType},
+			Valid: false,
+		},
+		{
+			Listener: Listener{Protocol: "http", LoadBalancerPort: 80, StickySession: slb.OnFlag,
+				StickySessionType: slb.InsertStickySessionType, CookieTimeout: 86400},
+			Valid: true,
+		},
+		{
+			Listener: Listener{Protocol: "http", LoadBalancerPort: 80, StickySession: slb.OnFlag,
+				StickySessionType: slb.ServerStickySessionType},
+			Valid: false,
+		},
+		{
+			Listener: Listener{Protocol: "http", LoadBalancerPort: 80, HealthCheck: slb.OnFlag, HealthCheckURI: "/"},
+			Valid:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := checkSlbListener(&tc.Listener)
+		if tc.Valid && err != nil {
+			t.Fatalf("Expected the listener %#v to be valid, but got: %s", tc.Listener, err)
+		}
+		if !tc.Valid && ErrorClass(err) != ErrorClassConfig {
+			t.Fatalf("Expected the listener %#v to trigger a config error, but got: %v", tc.Listener, err)
+		}
+	}
+}
+
 func testAccCheckSlbExists(n string, slb *slb.LoadBalancerType) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -248,6 +369,16 @@ resource "alicloud_slb" "traffic" {
 }
 `
 
+const testAccSlbTimeouts = `
+resource "alicloud_slb" "timeouts" {
+  name = "tf_test_slb_timeouts"
+
+  timeouts {
+    delete = "10m"
+  }
+}
+`
+
 const testAccSlbListener = `
 resource "alicloud_slb" "listener" {
   name = "tf_test_slb"
@@ -320,3 +451,140 @@ resource "alicloud_slb" "vpc" {
   vswitch_id = "${alicloud_vswitch.foo.id}"
 }
 `
+
+// fakeSLBService fakes the SLB APIs called by the unit tests, and the others panic as they are not implemented.
+type fakeSLBService struct {
+	SLBService
+
+	loadBalancers map[string]*slb.LoadBalancerType
+	// httpPorts are the ports of the HTTP listeners, and the other ports are not HTTP ones.
+	httpPorts map[int]bool
+	// tcpPorts are the ports of the TCP listeners.
+	tcpPorts map[int]bool
+	deleted  []string
+	// describes counts the listener describes, which are issued concurrently.
+	describes int32
+}
+
+func (s *fakeSLBService) DescribeLoadBalancerAttribute(loadBalancerId string) (*slb.LoadBalancerType, error) {
+	if lb, ok := s.loadBalancers[loadBalancerId]; ok {
+		return lb, nil
+	}
+	return nil, &common.Error{ErrorResponse: common.ErrorResponse{Code: LoadBalancerNotFound}}
+}
+
+func (s *fakeSLBService) DeleteLoadBalancer(loadBalancerId string) error {
+	if _, ok := s.loadBalancers[loadBalancerId]; !ok {
+		return &common.Error{ErrorResponse: common.ErrorResponse{Code: LoadBalancerNotFound}}
+	}
+	delete(s.loadBalancers, loadBalancerId)
+	s.deleted = append(s.deleted, loadBalancerId)
+	return nil
+}
+
+func (s *fakeSLBService) DescribeLoadBalancerHTTPListenerAttribute(loadBalancerId string, port int) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error) {
+	atomic.AddInt32(&s.describes, 1)
+	if s.httpPorts[port] {
+		return &slb.DescribeLoadBalancerHTTPListenerAttributeResponse{}, nil
+	}
+	return nil, fakeUnsupportedProtocalPortError()
+}
+
+func (s *fakeSLBService) DescribeLoadBalancerHTTPSListenerAttribute(loadBalancerId string, port int) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error) {
+	atomic.AddInt32(&s.describes, 1)
+	return nil, fakeUnsupportedProtocalPortError()
+}
+
+func (s *fakeSLBService) DescribeLoadBalancerTCPListenerAttribute(loadBalancerId string, port int) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error) {
+	atomic.AddInt32(&s.describes, 1)
+	if s.tcpPorts[port] {
+		resp := &slb.DescribeLoadBalancerTCPListenerAttributeResponse{}
+		resp.ListenerPort = port
+		return resp, nil
+	}
+	return nil, fakeUnsupportedProtocalPortError()
+}
+
+func (s *fakeSLBService) DescribeLoadBalancerUDPListenerAttribute(loadBalancerId string, port int) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error) {
+	atomic.AddInt32(&s.describes, 1)
+	return nil, fakeUnsupportedProtocalPortError()
+}
+
+func fakeUnsupportedProtocalPortError() error {
+	return &common.Error{ErrorResponse: common.ErrorResponse{Code: UnsupportedProtocalPort}}
+}
+
+func TestResourceAliyunSlbRead_notFound(t *testing.T) {
+	client := &AliyunClient{conns: map[string]interface{}{"slb": &fakeSLBService{}}}
+	d := resourceAliyunSlb().TestResourceData()
+	d.SetId("lb-notfound")
+
+	if err := resourceAliyunSlbRead(d, client); err != nil {
+		t.Fatalf("expected no error, got %#v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected the id to be cleared, got %q", d.Id())
+	}
+}
+
+func TestResourceAliyunSlbDelete(t *testing.T) {
+	fake := &fakeSLBService{
+		loadBalancers: map[string]*slb.LoadBalancerType{
+			"lb-foo": {LoadBalancerId: "lb-foo"},
+		},
+	}
+	client := &AliyunClient{conns: map[string]interface{}{"slb": fake}}
+	d := resourceAliyunSlb().TestResourceData()
+	d.SetId("lb-foo")
+
+	if err := resourceAliyunSlbDelete(d, client); err != nil {
+		t.Fatalf("expected no error, got %#v", err)
+	}
+	if len(fake.deleted) != 1 || fake.deleted[0] != "lb-foo" {
+		t.Fatalf("expected lb-foo to be deleted once, got %v", fake.deleted)
+	}
+}
+
+func TestReadListerners(t *testing.T) {
+	fake := &fakeSLBService{httpPorts: map[int]bool{80: true}}
+	lb := &slb.LoadBalancerType{LoadBalancerId: "lb-foo"}
+	lb.ListenerPorts.ListenerPort = []int{80, 443}
+
+	listeners, err := readListerners(fake, lb)
+	if err != nil {
+		t.Fatalf("expected no error, got %#v", err)
+	}
+	if len(listeners) != 1 {
+		t.Fatalf("expected 1 listener, got %d", len(listeners))
+	}
+	if listeners[0]["lb_protocol"] != string(Http) {
+		t.Fatalf("expected an http listener, got %v", listeners[0]["lb_protocol"])
+	}
+}
+
+func TestReadListerners_protocols(t *testing.T) {
+	fake := &fakeSLBService{tcpPorts: map[int]bool{}}
+	lb := &slb.LoadBalancerType{LoadBalancerId: "lb-foo"}
+	for port := 1000; port < 1020; port++ {
+		fake.tcpPorts[port] = true
+		lb.ListenerPorts.ListenerPort = append(lb.ListenerPorts.ListenerPort, port)
+		lb.ListenerPortsAndProtocol.ListenerPortAndProtocol = append(lb.ListenerPortsAndProtocol.ListenerPortAndProtocol,
+			slb.ListenerPortAndProtocolType{ListenerPort: port, ListenerProtocol: "tcp"})
+	}
+
+	listeners, err := readListerners(fake, lb)
+	if err != nil {
+		t.Fatalf("expected no error, got %#v", err)
+	}
+	if len(listeners) != 20 {
+		t.Fatalf("expected 20 listeners, got %d", len(listeners))
+	}
+	for i, listener := range listeners {
+		if listener["lb_port"] != 1000+i || listener["lb_protocol"] != string(Tcp) {
+			t.Fatalf("expected the tcp listener on port %d, got %v", 1000+i, listener)
+		}
+	}
+	if fake.describes != 20 {
+		t.Fatalf("expected one describe per listener, got %d", fake.describes)
+	}
+}
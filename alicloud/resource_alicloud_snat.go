@@ -12,6 +12,9 @@ func resourceAliyunSnatEntry() *schema.Resource {
 		Read:   resourceAliyunSnatEntryRead,
 		Update: resourceAliyunSnatEntryUpdate,
 		Delete: resourceAliyunSnatEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunSnatEntryImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": &schema.Schema{
@@ -33,7 +36,7 @@ func resourceAliyunSnatEntry() *schema.Resource {
 }
 
 func resourceAliyunSnatEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	conn := meta.(*AliyunClient).vpcconn()
 
 	args := &ecs.CreateSnatEntryArgs{
 		RegionId:        getRegion(d, meta),
@@ -59,7 +62,7 @@ func resourceAliyunSnatEntryRead(d *schema.ResourceData, meta interface{}) error
 	snatEntry, err := client.DescribeSnatEntry(d.Get("snat_table_id").(string), d.Id())
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			return nil
 		}
 		return err
@@ -74,7 +77,7 @@ func resourceAliyunSnatEntryRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceAliyunSnatEntryUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.vpcconn
+	conn := client.vpcconn()
 
 	snatEntry, err := client.DescribeSnatEntry(d.Get("snat_table_id").(string), d.Id())
 	if err != nil {
@@ -115,7 +118,7 @@ func resourceAliyunSnatEntryUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceAliyunSnatEntryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.vpcconn
+	conn := client.vpcconn()
 
 	snatEntryId := d.Id()
 	snatTableId := d.Get("snat_table_id").(string)
@@ -132,3 +135,16 @@ func resourceAliyunSnatEntryDelete(d *schema.ResourceData, meta interface{}) err
 
 	return nil
 }
+
+// resourceAliyunSnatEntryImport imports the snat entry by the id in the format <snat_table_id>:<snat_entry_id>,
+// because the snat entry can only be described in its snat table.
+func resourceAliyunSnatEntryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("snat_table_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,12 +2,71 @@ package alicloud
 
 import (
 	"fmt"
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"log"
 	"testing"
 )
 
+func init() {
+	addTestSweepers("alicloud_snat_entry", &testSweeper{
+		F: testSweepSnatEntries,
+	})
+}
+
+// testSweepSnatEntries deletes the snat entries of the NAT gateways of the acceptance tests, which have to be
+// deleted before the NAT gateways.
+func testSweepSnatEntries(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.vpcconn()
+
+	gateways, err := sweepableNatGateways(client)
+	if err != nil {
+		return err
+	}
+
+	for _, gw := range gateways {
+		for _, tableId := range gw.SnatTableIds.SnatTableId {
+			var ids []string
+			err := describeAllPages(func(pagination common.Pagination) (int, error) {
+				entries, _, err := conn.DescribeSnatTableEntries(&ecs.DescribeSnatTableEntriesArgs{
+					RegionId:    client.Region,
+					SnatTableId: tableId,
+					Pagination:  pagination,
+				})
+				if err != nil {
+					return 0, WrapError(err, "DescribeSnatTableEntries", tableId)
+				}
+				for _, entry := range entries {
+					ids = append(ids, entry.SnatEntryId)
+				}
+				return len(entries), nil
+			})
+			if err != nil {
+				log.Printf("[ERROR] %s", err)
+				continue
+			}
+
+			for _, id := range ids {
+				log.Printf("[INFO] Deleting snat entry %s of NAT gateway %s", id, gw.NatGatewayId)
+				if err := conn.DeleteSnatEntry(&ecs.DeleteSnatEntryArgs{
+					RegionId:    client.Region,
+					SnatTableId: tableId,
+					SnatEntryId: id,
+				}); err != nil {
+					log.Printf("[ERROR] %s", WrapError(err, "DeleteSnatEntry", id))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudSnat_basic(t *testing.T) {
 	var snat ecs.SnatEntrySetType
 
@@ -60,7 +119,7 @@ func testAccCheckSnatEntryDestroy(s *terraform.State) error {
 			return fmt.Errorf("Snat entry still exist")
 		}
 
-		if err != nil && !NotFoundError(err) {
+		if err != nil && !NotFoundError(err, "vpc") {
 			// Verify the error is what we want
 			return err
 		}
@@ -19,12 +19,17 @@ func resourceAliyunVpc() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cidr_block": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateCIDRNetworkAddress,
+				ValidateFunc: validateCIDRNetworkAddressWithMask(8, 24),
 			},
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -67,21 +72,26 @@ func resourceAliyunVpc() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"resource_group_id": resourceGroupIdSchema(),
+
+			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunVpcCreate(d *schema.ResourceData, meta interface{}) error {
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
 
 	args, err := buildAliyunVpcArgs(d, meta)
 	if err != nil {
 		return err
 	}
 
-	ecsconn := meta.(*AliyunClient).ecsconn
+	ecsconn := meta.(*AliyunClient).ecsconn()
+	args.ClientToken = buildClientToken("tf-vpc-")
 
 	var vpc *ecs.CreateVpcResponse
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		resp, err := ecsconn.CreateVpc(args)
 		if err != nil {
 			if IsExceptedError(err, VpcQuotaExceeded) {
@@ -101,7 +111,7 @@ func resourceAliyunVpcCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(vpc.VpcId)
 
-	err = ecsconn.WaitForVpcAvailable(args.RegionId, vpc.VpcId, 60)
+	err = ecsconn.WaitForVpcAvailable(args.RegionId, vpc.VpcId, deadline.Seconds())
 	if err != nil {
 		return fmt.Errorf("Timeout when WaitForVpcAvailable")
 	}
@@ -127,7 +137,7 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", vpc.VpcName)
 	d.Set("description", vpc.Description)
 	d.Set("router_id", vpc.VRouterId)
-	vrouters, _, err := client.vpcconn.DescribeVRouters(&ecs.DescribeVRoutersArgs{
+	vrouters, _, err := client.vpcconn().DescribeVRouters(&ecs.DescribeVRoutersArgs{
 		VRouterId: vpc.VRouterId,
 		RegionId:  getRegion(d, meta),
 	})
@@ -139,12 +149,26 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("route_table_id", "")
 	}
 
+	tags, err := describeVpcTags(meta.(*AliyunClient), VpcTagResourceVpc, d.Id())
+	if err != nil {
+		return fmt.Errorf("Describing tags of %s got an error: %#v", d.Id(), err)
+	}
+	if err := d.Set("tags", ignoreDefaultTags(meta.(*AliyunClient), d, tags)); err != nil {
+		return err
+	}
+
+	groupId, err := describeResourceGroupId(meta.(*AliyunClient), ResourceGroupResourceVpc, d.Id())
+	if err != nil {
+		return WrapError(err, "DescribeResourceGroup", d.Id())
+	}
+	d.Set("resource_group_id", groupId)
+
 	return nil
 }
 
 func resourceAliyunVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	d.Partial(true)
 
@@ -173,15 +197,26 @@ func resourceAliyunVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := setVpcTags(meta.(*AliyunClient), VpcTagResourceVpc, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
+	if err := setResourceGroup(meta.(*AliyunClient), ResourceGroupResourceVpc, d); err != nil {
+		return err
+	}
+	d.SetPartial("resource_group_id")
+
 	d.Partial(false)
 
 	return resourceAliyunVpcRead(d, meta)
 }
 
 func resourceAliyunVpcDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DeleteVpc(d.Id())
 
 		if err != nil {
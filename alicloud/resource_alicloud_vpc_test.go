@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
 	"github.com/denverdino/aliyungo/common"
@@ -10,7 +11,58 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweepers("alicloud_vpc", &testSweeper{
+		Dependencies: []string{
+			"alicloud_vswitch",
+			"alicloud_security_group",
+			"alicloud_nat_gateway",
+		},
+		F: testSweepVpcs,
+	})
+}
+
+func testSweepVpcs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.ecsconn()
+
+	var ids []string
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		vpcs, _, err := conn.DescribeVpcs(&ecs.DescribeVpcsArgs{
+			RegionId:   client.Region,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeVpcs", "")
+		}
+		for _, vpc := range vpcs {
+			if !isSweepable(vpc.VpcName) {
+				log.Printf("[INFO] Skipping VPC %s (%s)", vpc.VpcName, vpc.VpcId)
+				continue
+			}
+			ids = append(ids, vpc.VpcId)
+		}
+		return len(vpcs), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting VPC %s", id)
+		if err := conn.DeleteVpc(id); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteVpc", id))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudVpc_basic(t *testing.T) {
+	defer testAccRecorder(t)()
+
 	var vpc ecs.VpcSetType
 
 	resource.Test(t, resource.TestCase{
@@ -33,6 +85,8 @@ func TestAccAlicloudVpc_basic(t *testing.T) {
 						"alicloud_vpc.foo", "router_id"),
 					resource.TestCheckResourceAttrSet(
 						"alicloud_vpc.foo", "route_table_id"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_vpc.foo", "resource_group_id"),
 				),
 			},
 		},
@@ -41,6 +95,8 @@ func TestAccAlicloudVpc_basic(t *testing.T) {
 }
 
 func TestAccAlicloudVpc_update(t *testing.T) {
+	defer testAccRecorder(t)()
+
 	var vpc ecs.VpcSetType
 
 	resource.Test(t, resource.TestCase{
@@ -64,6 +120,10 @@ func TestAccAlicloudVpc_update(t *testing.T) {
 					testAccCheckVpcExists("alicloud_vpc.foo", &vpc),
 					resource.TestCheckResourceAttr(
 						"alicloud_vpc.foo", "name", "tf_test_bar"),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpc.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpc.foo", "tags.foo", "bar"),
 				),
 			},
 		},
@@ -136,5 +196,8 @@ const testAccVpcConfigUpdate = `
 resource "alicloud_vpc" "foo" {
 	cidr_block = "172.16.0.0/12"
 	name = "tf_test_bar"
+	tags {
+		foo = "bar"
+	}
 }
 `
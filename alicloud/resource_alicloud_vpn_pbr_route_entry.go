@@ -0,0 +1,195 @@
+package alicloud
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudVpnPbrRouteEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudVpnPbrRouteEntryCreate,
+		Read:   resourceAlicloudVpnPbrRouteEntryRead,
+		Update: resourceAlicloudVpnPbrRouteEntryUpdate,
+		Delete: resourceAlicloudVpnPbrRouteEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpn_connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The policy-based routes match the traffic from the route_source to the route_dest.
+			"route_source": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_dest": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The weight 0 makes the route the backup of the one of the weight 100 with the same cidr blocks.
+			"weight": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validateAllowedIntValue([]int{0, 100}),
+			},
+			"publish_vpc": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudVpnPbrRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := VpnRouteEntryArgs{
+		RegionId:     client.Region,
+		VpnGatewayId: d.Get("vpn_gateway_id").(string),
+		NextHop:      d.Get("vpn_connection_id").(string),
+		RouteSource:  d.Get("route_source").(string),
+		RouteDest:    d.Get("route_dest").(string),
+		Weight:       strconv.Itoa(d.Get("weight").(int)),
+		PublishVpc:   d.Get("publish_vpc").(bool),
+		Description:  d.Get("description").(string),
+	}
+	if err := client.invokeVpnGateway("CreateVpnPbrRouteEntry", &args, &common.Response{}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err, "CreateVpnPbrRouteEntry", d.Id())
+	}
+
+	d.SetId(strings.Join([]string{args.VpnGatewayId, args.NextHop, args.RouteSource, args.RouteDest}, COLON_SEPARATED))
+
+	return resourceAlicloudVpnPbrRouteEntryRead(d, meta)
+}
+
+func resourceAlicloudVpnPbrRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	entry, err := meta.(*AliyunClient).DescribeVpnPbrRouteEntry(parts[0], parts[1], parts[2], parts[3])
+	if err != nil {
+		if NotFoundError(err, "vpn") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeVpnPbrRouteEntries", d.Id())
+	}
+
+	d.Set("vpn_gateway_id", parts[0])
+	d.Set("vpn_connection_id", entry.NextHop)
+	d.Set("route_source", entry.RouteSource)
+	d.Set("route_dest", entry.RouteDest)
+	d.Set("weight", entry.Weight)
+	d.Set("publish_vpc", entry.State == VpnRouteEntryPublished)
+	d.Set("description", entry.Description)
+	d.Set("status", entry.State)
+
+	return nil
+}
+
+func resourceAlicloudVpnPbrRouteEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("weight") {
+		o, n := d.GetChange("weight")
+		args := VpnRouteEntryArgs{
+			RegionId:     client.Region,
+			VpnGatewayId: parts[0],
+			NextHop:      parts[1],
+			RouteSource:  parts[2],
+			RouteDest:    parts[3],
+			Weight:       strconv.Itoa(o.(int)),
+			NewWeight:    strconv.Itoa(n.(int)),
+		}
+		if err := client.invokeVpnGateway("ModifyVpnPbrRouteEntryWeight", &args, &common.Response{}, deadline.Remaining()); err != nil {
+			return WrapError(err, "ModifyVpnPbrRouteEntryWeight", d.Id())
+		}
+		d.SetPartial("weight")
+	}
+
+	if d.HasChange("publish_vpc") {
+		args := PublishVpnRouteEntryArgs{
+			RegionId:     client.Region,
+			VpnGatewayId: parts[0],
+			NextHop:      parts[1],
+			RouteDest:    parts[3],
+			RouteType:    VpnRouteTypePbr,
+			PublishVpc:   d.Get("publish_vpc").(bool),
+		}
+		if err := client.invokeVpnGateway("PublishVpnRouteEntry", &args, &common.Response{}, deadline.Remaining()); err != nil {
+			return WrapError(err, "PublishVpnRouteEntry", d.Id())
+		}
+		d.SetPartial("publish_vpc")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudVpnPbrRouteEntryRead(d, meta)
+}
+
+func resourceAlicloudVpnPbrRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 4)
+	if err != nil {
+		return err
+	}
+
+	args := VpnRouteEntryArgs{
+		RegionId:     client.Region,
+		VpnGatewayId: parts[0],
+		NextHop:      parts[1],
+		RouteSource:  parts[2],
+		RouteDest:    parts[3],
+		Weight:       strconv.Itoa(d.Get("weight").(int)),
+	}
+	if err := client.invokeVpnGateway("DeleteVpnPbrRouteEntry", &args, &common.Response{}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if NotFoundError(err, "vpn") {
+			return nil
+		}
+		return WrapError(err, "DeleteVpnPbrRouteEntry", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudVpnPbrRouteEntry_basic(t *testing.T) {
+	var v VpnRouteEntry
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithVpnConnection(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_vpn_pbr_route_entry.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnPbrRouteEntryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnPbrRouteEntryConfig(100, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnPbrRouteEntryExists(
+						"alicloud_vpn_pbr_route_entry.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpn_pbr_route_entry.foo",
+						"weight",
+						"100"),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpn_pbr_route_entry.foo",
+						"status",
+						"normal"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpnPbrRouteEntryConfig(0, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnPbrRouteEntryExists(
+						"alicloud_vpn_pbr_route_entry.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpn_pbr_route_entry.foo",
+						"weight",
+						"0"),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpn_pbr_route_entry.foo",
+						"publish_vpc",
+						"true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpnPbrRouteEntryExists(n string, d *VpnRouteEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No vpn pbr route entry ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 4)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		entry, err := client.DescribeVpnPbrRouteEntry(parts[0], parts[1], parts[2], parts[3])
+		if err != nil {
+			return err
+		}
+
+		*d = *entry
+		return nil
+	}
+}
+
+func testAccCheckVpnPbrRouteEntryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_vpn_pbr_route_entry" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 4)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeVpnPbrRouteEntry(parts[0], parts[1], parts[2], parts[3]); err != nil {
+			if NotFoundError(err, "vpn") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("vpn pbr route entry %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVpnPbrRouteEntryConfig(weight int, publishVpc bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_vpn_pbr_route_entry" "foo" {
+  vpn_gateway_id = "%s"
+  vpn_connection_id = "%s"
+  route_source = "192.168.1.0/24"
+  route_dest = "10.0.0.0/24"
+  weight = %d
+  publish_vpc = %t
+  description = "tf-testAccVpnPbrRouteEntry"
+}
+`, os.Getenv("ALICLOUD_VPN_GATEWAY_ID"), os.Getenv("ALICLOUD_VPN_CONNECTION_ID"), weight, publishVpc)
+}
@@ -0,0 +1,188 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudVpnRouteEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudVpnRouteEntryCreate,
+		Read:   resourceAlicloudVpnRouteEntryRead,
+		Update: resourceAlicloudVpnRouteEntryUpdate,
+		Delete: resourceAlicloudVpnRouteEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The vpn_connection_id is the next hop of the route.
+			"vpn_connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_dest": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The routes of the weight 100 are preferred to the ones of the weight 0 with the same route_dest,
+			// which are the backups of them.
+			"weight": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validateAllowedIntValue([]int{0, 100}),
+			},
+			// The publish_vpc publishes the route to the route table of the vpc of the vpn gateway.
+			"publish_vpc": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudVpnRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := VpnRouteEntryArgs{
+		RegionId:     client.Region,
+		VpnGatewayId: d.Get("vpn_gateway_id").(string),
+		NextHop:      d.Get("vpn_connection_id").(string),
+		RouteDest:    d.Get("route_dest").(string),
+		Weight:       strconv.Itoa(d.Get("weight").(int)),
+		PublishVpc:   d.Get("publish_vpc").(bool),
+		Description:  d.Get("description").(string),
+	}
+	if err := client.invokeVpnGateway("CreateVpnRouteEntry", &args, &common.Response{}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err, "CreateVpnRouteEntry", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", args.VpnGatewayId, COLON_SEPARATED, args.NextHop, COLON_SEPARATED, args.RouteDest))
+
+	return resourceAlicloudVpnRouteEntryRead(d, meta)
+}
+
+func resourceAlicloudVpnRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return err
+	}
+
+	entry, err := meta.(*AliyunClient).DescribeVpnRouteEntry(parts[0], parts[1], parts[2])
+	if err != nil {
+		if NotFoundError(err, "vpn") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeVpnRouteEntries", d.Id())
+	}
+
+	d.Set("vpn_gateway_id", parts[0])
+	d.Set("vpn_connection_id", entry.NextHop)
+	d.Set("route_dest", entry.RouteDest)
+	d.Set("weight", entry.Weight)
+	d.Set("publish_vpc", entry.State == VpnRouteEntryPublished)
+	d.Set("description", entry.Description)
+	d.Set("status", entry.State)
+
+	return nil
+}
+
+func resourceAlicloudVpnRouteEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutUpdate)
+
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("weight") {
+		o, n := d.GetChange("weight")
+		args := VpnRouteEntryArgs{
+			RegionId:     client.Region,
+			VpnGatewayId: parts[0],
+			NextHop:      parts[1],
+			RouteDest:    parts[2],
+			Weight:       strconv.Itoa(o.(int)),
+			NewWeight:    strconv.Itoa(n.(int)),
+		}
+		if err := client.invokeVpnGateway("ModifyVpnRouteEntryWeight", &args, &common.Response{}, deadline.Remaining()); err != nil {
+			return WrapError(err, "ModifyVpnRouteEntryWeight", d.Id())
+		}
+		d.SetPartial("weight")
+	}
+
+	if d.HasChange("publish_vpc") {
+		args := PublishVpnRouteEntryArgs{
+			RegionId:     client.Region,
+			VpnGatewayId: parts[0],
+			NextHop:      parts[1],
+			RouteDest:    parts[2],
+			RouteType:    VpnRouteTypeDbr,
+			PublishVpc:   d.Get("publish_vpc").(bool),
+		}
+		if err := client.invokeVpnGateway("PublishVpnRouteEntry", &args, &common.Response{}, deadline.Remaining()); err != nil {
+			return WrapError(err, "PublishVpnRouteEntry", d.Id())
+		}
+		d.SetPartial("publish_vpc")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudVpnRouteEntryRead(d, meta)
+}
+
+func resourceAlicloudVpnRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseResourceId(d.Id(), 3)
+	if err != nil {
+		return err
+	}
+
+	args := VpnRouteEntryArgs{
+		RegionId:     client.Region,
+		VpnGatewayId: parts[0],
+		NextHop:      parts[1],
+		RouteDest:    parts[2],
+		Weight:       strconv.Itoa(d.Get("weight").(int)),
+	}
+	if err := client.invokeVpnGateway("DeleteVpnRouteEntry", &args, &common.Response{}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if NotFoundError(err, "vpn") {
+			return nil
+		}
+		return WrapError(err, "DeleteVpnRouteEntry", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudVpnRouteEntry_basic(t *testing.T) {
+	var v VpnRouteEntry
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithVpnConnection(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_vpn_route_entry.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnRouteEntryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnRouteEntryConfig(100, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnRouteEntryExists(
+						"alicloud_vpn_route_entry.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpn_route_entry.foo",
+						"weight",
+						"100"),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpn_route_entry.foo",
+						"status",
+						"normal"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpnRouteEntryConfig(0, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnRouteEntryExists(
+						"alicloud_vpn_route_entry.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpn_route_entry.foo",
+						"weight",
+						"0"),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpn_route_entry.foo",
+						"publish_vpc",
+						"true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpnRouteEntryExists(n string, d *VpnRouteEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No vpn route entry ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 3)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		entry, err := client.DescribeVpnRouteEntry(parts[0], parts[1], parts[2])
+		if err != nil {
+			return err
+		}
+
+		*d = *entry
+		return nil
+	}
+}
+
+func testAccCheckVpnRouteEntryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_vpn_route_entry" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 3)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeVpnRouteEntry(parts[0], parts[1], parts[2]); err != nil {
+			if NotFoundError(err, "vpn") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("vpn route entry %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVpnRouteEntryConfig(weight int, publishVpc bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_vpn_route_entry" "foo" {
+  vpn_gateway_id = "%s"
+  vpn_connection_id = "%s"
+  route_dest = "10.0.0.0/24"
+  weight = %d
+  publish_vpc = %t
+  description = "tf-testAccVpnRouteEntry"
+}
+`, os.Getenv("ALICLOUD_VPN_GATEWAY_ID"), os.Getenv("ALICLOUD_VPN_CONNECTION_ID"), weight, publishVpc)
+}
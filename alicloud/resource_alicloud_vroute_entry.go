@@ -5,6 +5,7 @@ import (
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"strings"
+	"time"
 )
 
 func resourceAliyunRouteEntry() *schema.Resource {
@@ -16,6 +17,10 @@ func resourceAliyunRouteEntry() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"router_id": &schema.Schema{
 				Type:       schema.TypeString,
@@ -49,7 +54,8 @@ func resourceAliyunRouteEntry() *schema.Resource {
 }
 
 func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
 
 	rtId := d.Get("route_table_id").(string)
 	cidr := d.Get("destination_cidrblock").(string)
@@ -68,7 +74,7 @@ func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) er
 	table, err := meta.(*AliyunClient).QueryRouteTableById(rtId)
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			d.SetId("")
 			return nil
 		}
@@ -76,7 +82,7 @@ func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	d.SetId(rtId + ":" + table.VRouterId + ":" + cidr + ":" + nt + ":" + ni)
 
-	if err := conn.WaitForAllRouteEntriesAvailable(table.VRouterId, rtId, defaultTimeout); err != nil {
+	if err := conn.WaitForAllRouteEntriesAvailable(table.VRouterId, rtId, deadline.Seconds()); err != nil {
 		return fmt.Errorf("WaitFor route entry got error: %#v", err)
 	}
 	return resourceAliyunRouteEntryRead(d, meta)
@@ -94,7 +100,7 @@ func resourceAliyunRouteEntryRead(d *schema.ResourceData, meta interface{}) erro
 	en, err := client.QueryRouteEntry(rtId, cidr, nexthop_type, nexthop_id)
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			d.SetId("")
 			return nil
 		}
@@ -110,7 +116,7 @@ func resourceAliyunRouteEntryRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAliyunRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
-	con := meta.(*AliyunClient).ecsconn
+	con := meta.(*AliyunClient).ecsconn()
 	args, err := buildAliyunRouteEntryDeleteArgs(d, meta)
 
 	if err != nil {
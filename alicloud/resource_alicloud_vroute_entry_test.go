@@ -111,7 +111,7 @@ func testAccCheckRouteEntryDestroy(s *terraform.State) error {
 			return fmt.Errorf("Error Route Entry still exist")
 		}
 		// Verify the error is what we want
-		if err != nil && !NotFoundError(err) {
+		if err != nil && !NotFoundError(err, "vpc") {
 			return err
 		}
 	}
@@ -21,6 +21,11 @@ func resourceAliyunSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": &schema.Schema{
 				Type:     schema.TypeString,
@@ -46,13 +51,15 @@ func resourceAliyunSubnet() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunSwitchCreate(d *schema.ResourceData, meta interface{}) error {
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	args, err := buildAliyunSwitchArgs(d, meta)
 	if err != nil {
@@ -60,7 +67,7 @@ func resourceAliyunSwitchCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	var vswitchID string
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		vswId, err := conn.CreateVSwitch(args)
 		if err != nil {
 			if e, ok := err.(*common.Error); ok && (e.StatusCode == 400 || e.Code == UnknownError) {
@@ -78,7 +85,7 @@ func resourceAliyunSwitchCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(vswitchID)
 
-	err = conn.WaitForVSwitchAvailable(args.VpcId, vswitchID, 60)
+	err = conn.WaitForVSwitchAvailable(args.VpcId, vswitchID, deadline.Seconds())
 	if err != nil {
 		return fmt.Errorf("WaitForVSwitchAvailable got a error: %s", err)
 	}
@@ -88,7 +95,7 @@ func resourceAliyunSwitchCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAliyunSwitchRead(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	args := &ecs.DescribeVSwitchesArgs{
 		RegionId:  getRegion(d, meta),
@@ -99,7 +106,7 @@ func resourceAliyunSwitchRead(d *schema.ResourceData, meta interface{}) error {
 	vswitches, _, err := conn.DescribeVSwitches(args)
 
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			d.SetId("")
 			return nil
 		}
@@ -119,12 +126,20 @@ func resourceAliyunSwitchRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", vswitch.VSwitchName)
 	d.Set("description", vswitch.Description)
 
+	tags, err := describeVpcTags(meta.(*AliyunClient), VpcTagResourceVSwitch, d.Id())
+	if err != nil {
+		return fmt.Errorf("Describing tags of %s got an error: %#v", d.Id(), err)
+	}
+	if err := d.Set("tags", ignoreDefaultTags(meta.(*AliyunClient), d, tags)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceAliyunSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 
 	d.Partial(true)
 
@@ -153,20 +168,25 @@ func resourceAliyunSwitchUpdate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
+	if err := setVpcTags(meta.(*AliyunClient), VpcTagResourceVSwitch, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
 	d.Partial(false)
 
 	return resourceAliyunSwitchRead(d, meta)
 }
 
 func resourceAliyunSwitchDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
 		err := conn.DeleteVSwitch(d.Id())
 
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == VswitcInvalidRegionId {
+			if IsExceptedError(err, VswitcInvalidRegionId) {
 				log.Printf("[ERROR] Delete Switch is failed.")
 				return resource.NonRetryableError(err)
 			}
@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"log"
 	"testing"
 
 	"fmt"
@@ -10,6 +11,57 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweepers("alicloud_vswitch", &testSweeper{
+		Dependencies: []string{
+			"alicloud_instance",
+			"alicloud_slb",
+			"alicloud_mongodb_instance",
+			"alicloud_db_instance",
+			"alicloud_ess_scaling_group",
+		},
+		F: testSweepVSwitches,
+	})
+}
+
+func testSweepVSwitches(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	conn := client.vpcconn()
+
+	var ids []string
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		vsws, _, err := conn.DescribeVSwitches(&ecs.DescribeVSwitchesArgs{
+			RegionId:   client.Region,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeVSwitches", "")
+		}
+		for _, vsw := range vsws {
+			if !isSweepable(vsw.VSwitchName) {
+				log.Printf("[INFO] Skipping vswitch %s (%s)", vsw.VSwitchName, vsw.VSwitchId)
+				continue
+			}
+			ids = append(ids, vsw.VSwitchId)
+		}
+		return len(vsws), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting vswitch %s", id)
+		if err := conn.DeleteVSwitch(id); err != nil {
+			log.Printf("[ERROR] %s", WrapError(err, "DeleteVSwitch", id))
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudVswitch_basic(t *testing.T) {
 	var vsw ecs.VSwitchSetType
 
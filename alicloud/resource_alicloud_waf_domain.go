@@ -0,0 +1,310 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudWafDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudWafDomainCreate,
+		Read:   resourceAlicloudWafDomainRead,
+		Update: resourceAlicloudWafDomainUpdate,
+		Delete: resourceAlicloudWafDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_ips": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"is_access_product": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cluster_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      WafClusterPhysical,
+				ValidateFunc: validateAllowedStringValue([]string{WafClusterPhysical, WafClusterVirtual}),
+			},
+			"http_port": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"https_port": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"http2_port": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"https_redirect": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"http_to_user_ip": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"load_balancing": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "IpHash",
+				ValidateFunc: validateAllowedStringValue([]string{"IpHash", "RoundRobin"}),
+			},
+			"connection_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
+			"read_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  120,
+			},
+			"write_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  120,
+			},
+			"protection_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      WafProtectionModeBlock,
+				ValidateFunc: validateAllowedStringValue([]string{WafProtectionModeBlock, WafProtectionModeWarn}),
+			},
+			"cname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudWafDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args, err := buildWafDomainArgs(d)
+	if err != nil {
+		return err
+	}
+	if err := client.wafconn().Invoke("CreateDomain", &args, &common.Response{}); err != nil {
+		return WrapError(err, "CreateDomain", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", args.InstanceId, COLON_SEPARATED, args.Domain))
+
+	// The protection mode of a new domain is Block.
+	if d.Get("protection_mode").(string) != WafProtectionModeBlock {
+		if err := modifyWafProtectionMode(client, d, args.InstanceId, args.Domain); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudWafDomainRead(d, meta)
+}
+
+func resourceAlicloudWafDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	domain, err := client.DescribeWafDomain(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err, "waf") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeDomain", d.Id())
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("domain", parts[1])
+	if err := d.Set("source_ips", domain.SourceIps); err != nil {
+		return err
+	}
+	d.Set("is_access_product", domain.IsAccessProduct == 1)
+	for clusterType, value := range WafClusterTypes {
+		if value == domain.ClusterType {
+			d.Set("cluster_type", clusterType)
+		}
+	}
+	if err := d.Set("http_port", domain.HttpPort); err != nil {
+		return err
+	}
+	if err := d.Set("https_port", domain.HttpsPort); err != nil {
+		return err
+	}
+	if err := d.Set("http2_port", domain.Http2Port); err != nil {
+		return err
+	}
+	d.Set("https_redirect", domain.HttpsRedirect == 1)
+	d.Set("http_to_user_ip", domain.HttpToUserIp == 1)
+	if domain.LoadBalancing == 1 {
+		d.Set("load_balancing", "RoundRobin")
+	} else {
+		d.Set("load_balancing", "IpHash")
+	}
+	d.Set("connection_time", domain.ConnectionTime)
+	d.Set("read_time", domain.ReadTime)
+	d.Set("write_time", domain.WriteTime)
+	d.Set("cname", domain.Cname)
+
+	mode, err := client.DescribeWafProtectionMode(parts[0], parts[1])
+	if err != nil {
+		return WrapError(err, "DescribeProtectionModuleMode", d.Id())
+	}
+	d.Set("protection_mode", mode)
+
+	return nil
+}
+
+func resourceAlicloudWafDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+	d.Partial(true)
+
+	// The ModifyDomain replaces all the settings of the domain, so all of them are sent when any of them is changed.
+	attributes := []string{"source_ips", "is_access_product", "cluster_type", "http_port", "https_port", "http2_port",
+		"https_redirect", "http_to_user_ip", "load_balancing", "connection_time", "read_time", "write_time"}
+	update := false
+	for _, attribute := range attributes {
+		if d.HasChange(attribute) {
+			update = true
+		}
+	}
+	if update {
+		args, err := buildWafDomainArgs(d)
+		if err != nil {
+			return err
+		}
+		if err := client.wafconn().Invoke("ModifyDomain", &args, &common.Response{}); err != nil {
+			return WrapError(err, "ModifyDomain", d.Id())
+		}
+		for _, attribute := range attributes {
+			d.SetPartial(attribute)
+		}
+	}
+
+	if d.HasChange("protection_mode") {
+		if err := modifyWafProtectionMode(client, d, parts[0], parts[1]); err != nil {
+			return err
+		}
+		d.SetPartial("protection_mode")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudWafDomainRead(d, meta)
+}
+
+func resourceAlicloudWafDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutDelete)
+	parts, err := parseResourceId(d.Id(), 2)
+	if err != nil {
+		return err
+	}
+
+	args := WafDomainArgs{
+		InstanceId: parts[0],
+		Domain:     parts[1],
+	}
+	return resource.Retry(deadline.Remaining(), func() *resource.RetryError {
+		if err := client.wafconn().Invoke("DeleteDomain", &args, &common.Response{}); err != nil {
+			if NotFoundError(err, "waf") {
+				return nil
+			}
+			return resource.NonRetryableError(WrapError(err, "DeleteDomain", d.Id()))
+		}
+
+		if _, err := client.DescribeWafDomain(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "waf") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("WAF domain %s is being deleted - trying again while it is deleted.", d.Id()))
+	})
+}
+
+func buildWafDomainArgs(d *schema.ResourceData) (WafDomainArgs, error) {
+	args := WafDomainArgs{
+		InstanceId:     d.Get("instance_id").(string),
+		Domain:         d.Get("domain").(string),
+		SourceIps:      convertListToJsonString(d.Get("source_ips").([]interface{})),
+		ClusterType:    WafClusterTypes[d.Get("cluster_type").(string)],
+		HttpPort:       convertListToJsonString(d.Get("http_port").([]interface{})),
+		HttpsPort:      convertListToJsonString(d.Get("https_port").([]interface{})),
+		Http2Port:      convertListToJsonString(d.Get("http2_port").([]interface{})),
+		ConnectionTime: d.Get("connection_time").(int),
+		ReadTime:       d.Get("read_time").(int),
+		WriteTime:      d.Get("write_time").(int),
+	}
+	if args.HttpPort == "" && args.HttpsPort == "" {
+		return args, ConfigErrorf(ErrorCodeMissingArgument, "At least one of 'http_port' and 'https_port' is required.")
+	}
+	if args.Http2Port != "" && args.HttpsPort == "" {
+		return args, ConfigErrorf(ErrorCodeMissingArgument, "'https_port' is required when 'http2_port' is set.")
+	}
+	if d.Get("is_access_product").(bool) {
+		args.IsAccessProduct = 1
+	}
+	if d.Get("https_redirect").(bool) {
+		args.HttpsRedirect = 1
+	}
+	if d.Get("http_to_user_ip").(bool) {
+		args.HttpToUserIp = 1
+	}
+	if d.Get("load_balancing").(string) == "RoundRobin" {
+		args.LoadBalancing = 1
+	}
+	return args, nil
+}
+
+func modifyWafProtectionMode(client *AliyunClient, d *schema.ResourceData, instanceId, domain string) error {
+	args := WafProtectionModeArgs{
+		InstanceId:  instanceId,
+		Domain:      domain,
+		DefenseType: WafDefenseTypeWaf,
+		Mode:        WafProtectionModes[d.Get("protection_mode").(string)],
+	}
+	if err := client.wafconn().Invoke("ModifyProtectionModuleMode", &args, &common.Response{}); err != nil {
+		return WrapError(err, "ModifyProtectionModuleMode", d.Id())
+	}
+	return nil
+}
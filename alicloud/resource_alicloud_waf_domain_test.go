@@ -0,0 +1,134 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudWafDomain_basic(t *testing.T) {
+	var v WafDomain
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithWafInstance(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_waf_domain.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWafDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWafDomainConfig("1.1.1.1", "Block"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafDomainExists(
+						"alicloud_waf_domain.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_waf_domain.foo",
+						"source_ips.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_waf_domain.foo",
+						"source_ips.0",
+						"1.1.1.1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_waf_domain.foo",
+						"cluster_type",
+						"PhysicalCluster"),
+					resource.TestCheckResourceAttr(
+						"alicloud_waf_domain.foo",
+						"protection_mode",
+						"Block"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_waf_domain.foo",
+						"cname"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccWafDomainConfig("2.2.2.2", "Warn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafDomainExists(
+						"alicloud_waf_domain.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_waf_domain.foo",
+						"source_ips.0",
+						"2.2.2.2"),
+					resource.TestCheckResourceAttr(
+						"alicloud_waf_domain.foo",
+						"protection_mode",
+						"Warn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWafDomainExists(n string, d *WafDomain) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF domain ID is set")
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		domain, err := client.DescribeWafDomain(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *domain
+		return nil
+	}
+}
+
+func testAccCheckWafDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_waf_domain" {
+			continue
+		}
+
+		parts, err := parseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeWafDomain(parts[0], parts[1]); err != nil {
+			if NotFoundError(err, "waf") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("WAF domain %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccWafDomainConfig(sourceIp, mode string) string {
+	return fmt.Sprintf(`
+resource "alicloud_waf_domain" "foo" {
+  instance_id = "%s"
+  domain = "tf-testaccwafdomain.example.com"
+  source_ips = ["%s"]
+  cluster_type = "PhysicalCluster"
+  http_port = ["80"]
+  protection_mode = "%s"
+}
+`, os.Getenv("ALICLOUD_WAF_INSTANCE_ID"), sourceIp, mode)
+}
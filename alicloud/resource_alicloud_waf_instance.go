@@ -0,0 +1,187 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudWafInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudWafInstanceCreate,
+		Read:   resourceAlicloudWafInstanceRead,
+		Delete: resourceAlicloudWafInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"package_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					"version_3", "version_4", "version_5", "version_exclusive_cluster"}),
+			},
+			"ext_bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  0,
+			},
+			"ext_domain_package": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  0,
+			},
+			"exclusive_ip_package": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  0,
+			},
+			"waf_log": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"log_storage": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      3,
+				ValidateFunc: validateAllowedIntValue([]int{3, 5, 10, 20, 50, 100}),
+			},
+			"log_time": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      180,
+				ValidateFunc: validateAllowedIntValue([]int{180, 360}),
+			},
+			"big_screen": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"professional_service": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 6, 12, 24, 36}),
+			},
+			"renewal_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ManualRenewal",
+				ValidateFunc: validateAllowedStringValue([]string{"AutoRenewal", "ManualRenewal", "NotRenewal"}),
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudWafInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	deadline := newOperationDeadline(d, schema.TimeoutCreate)
+
+	// The instances out of the mainland are bought as the international product in ap-southeast-1.
+	region := centralServiceRegion(client.Region)
+	productType := "waf"
+	if region != "cn-hangzhou" {
+		productType = "waf_intl"
+	}
+
+	args := CreateBssInstanceArgs{
+		ProductCode:      "waf",
+		ProductType:      productType,
+		SubscriptionType: "Subscription",
+		Period:           d.Get("period").(int),
+		RenewalStatus:    d.Get("renewal_status").(string),
+		Parameter: []BssInstanceParameter{
+			{Code: "Region", Value: region},
+			{Code: "PackageCode", Value: d.Get("package_code").(string)},
+			{Code: "ExtBandwidth", Value: strconv.Itoa(d.Get("ext_bandwidth").(int))},
+			{Code: "ExtDomainPackage", Value: strconv.Itoa(d.Get("ext_domain_package").(int))},
+			{Code: "ExclusiveIpPackage", Value: strconv.Itoa(d.Get("exclusive_ip_package").(int))},
+			{Code: "WafLog", Value: strconv.FormatBool(d.Get("waf_log").(bool))},
+			{Code: "LogStorage", Value: strconv.Itoa(d.Get("log_storage").(int))},
+			{Code: "LogTime", Value: strconv.Itoa(d.Get("log_time").(int))},
+			{Code: "BigScreen", Value: strconv.FormatBool(d.Get("big_screen").(bool))},
+			// The code of the professional service is misspelled by the API.
+			{Code: "PrefessionalService", Value: strconv.FormatBool(d.Get("professional_service").(bool))},
+		},
+	}
+	if args.RenewalStatus == "AutoRenewal" {
+		args.RenewPeriod = args.Period
+	}
+
+	resp := CreateBssInstanceResponse{}
+	if err := client.bssconn().Invoke("CreateInstance", &args, &resp); err != nil {
+		return WrapError(err, "CreateInstance", d.Id())
+	}
+	if !resp.Success {
+		return fmt.Errorf("CreateInstance got an error: %s %s", resp.Code, resp.Message)
+	}
+
+	d.SetId(resp.Data.InstanceId)
+
+	if err := client.WaitForWafInstance(d.Id(), WafInstanceNormal, deadline.Seconds()); err != nil {
+		return WrapError(err, "WaitForWafInstance", d.Id())
+	}
+
+	return resourceAlicloudWafInstanceRead(d, meta)
+}
+
+func resourceAlicloudWafInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeWafInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err, "waf") {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err, "DescribeInstanceInfo", d.Id())
+	}
+
+	d.Set("version", instance.Version)
+	d.Set("status", strconv.Itoa(instance.Status))
+	d.Set("end_date", int(instance.EndDate))
+
+	return nil
+}
+
+func resourceAlicloudWafInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	// The subscription instance can not be released by API, and it is released automatically after it is expired.
+	log.Printf("[WARN] WAF instance %s can not be deleted and it is only removed from the state. "+
+		"It will be released automatically after it is expired.", d.Id())
+	return nil
+}
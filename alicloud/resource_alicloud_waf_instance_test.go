@@ -0,0 +1,73 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The subscription WAF instance can not be released by API, so there is no destroy check and
+// the instance is released automatically after it is expired.
+func TestAccAlicloudWafInstance_basic(t *testing.T) {
+	var v WafInstanceInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_waf_instance.foo",
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWafInstanceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafInstanceExists(
+						"alicloud_waf_instance.foo", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_waf_instance.foo",
+						"status",
+						"1"),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_waf_instance.foo",
+						"version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWafInstanceExists(n string, d *WafInstanceInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		instance, err := client.DescribeWafInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = *instance
+		return nil
+	}
+}
+
+const testAccWafInstanceConfig = `
+resource "alicloud_waf_instance" "foo" {
+  package_code = "version_3"
+  ext_bandwidth = 50
+  ext_domain_package = 1
+  period = 1
+}
+`
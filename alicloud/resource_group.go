@@ -0,0 +1,135 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceGroupIdSchema returns the schema to use for resource_group_id. It is computed because the
+// resources are put into the provider resource_group_id or the default resource group when it is not set.
+func resourceGroupIdSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+}
+
+// setResourceGroup moves the resource into the "resource_group_id" of it, or the provider resource_group_id
+// when it is not set, while the resource is created or the resource_group_id is changed.
+func setResourceGroup(client *AliyunClient, resourceType ResourceGroupResourceType, d *schema.ResourceData) error {
+	if !d.IsNewResource() && !d.HasChange("resource_group_id") {
+		return nil
+	}
+
+	groupId := d.Get("resource_group_id").(string)
+	if groupId == "" {
+		groupId = client.ResourceGroupId
+	}
+	if groupId == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Moving %s %s into resource group %s", resourceType, d.Id(), groupId)
+
+	var err error
+	switch resourceType {
+	case ResourceGroupResourceInstance:
+		args := JoinResourceGroupArgs{
+			RegionId:        client.Region,
+			ResourceGroupId: groupId,
+			ResourceId:      d.Id(),
+			ResourceType:    resourceType,
+		}
+		err = client.ecsconn().Invoke("JoinResourceGroup", &args, &common.Response{})
+	case ResourceGroupResourceVpc, ResourceGroupResourceLoadBalancer:
+		args := MoveResourceGroupArgs{
+			RegionId:           client.Region,
+			ResourceId:         d.Id(),
+			NewResourceGroupId: groupId,
+			ResourceType:       resourceType,
+		}
+		if resourceType == ResourceGroupResourceVpc {
+			err = client.vpcconn().Invoke("MoveResourceGroup", &args, &common.Response{})
+		} else {
+			err = client.slbconn().Invoke("MoveResourceGroup", &args, &common.Response{})
+		}
+	case ResourceGroupResourceDBInstance:
+		args := ModifyDBInstanceResourceGroupArgs{
+			RegionId:        client.Region,
+			DBInstanceId:    d.Id(),
+			ResourceGroupId: groupId,
+		}
+		err = client.rdsconn().Invoke("ModifyResourceGroup", &args, &common.Response{})
+	default:
+		return BugErrorf(ErrorCodeUnsupportedResourceType, "Resource type %s does not support resource group", resourceType)
+	}
+
+	if err != nil {
+		return WrapError(err, "MoveResourceGroup", d.Id())
+	}
+	return nil
+}
+
+// describeResourceGroupId returns the id of the resource group which the resource is in.
+func describeResourceGroupId(client *AliyunClient, resourceType ResourceGroupResourceType, resourceId string) (string, error) {
+	switch resourceType {
+	case ResourceGroupResourceInstance:
+		ids, err := json.Marshal([]string{resourceId})
+		if err != nil {
+			return "", err
+		}
+		args := DescribeInstanceResourceGroupArgs{
+			RegionId:    client.Region,
+			InstanceIds: string(ids),
+		}
+		resp := DescribeInstanceResourceGroupResponse{}
+		if err := client.ecsconn().Invoke("DescribeInstances", &args, &resp); err != nil {
+			return "", err
+		}
+		if len(resp.Instances.Instance) > 0 {
+			return resp.Instances.Instance[0].ResourceGroupId, nil
+		}
+	case ResourceGroupResourceVpc:
+		args := DescribeVpcResourceGroupArgs{
+			RegionId: client.Region,
+			VpcId:    resourceId,
+		}
+		resp := DescribeVpcResourceGroupResponse{}
+		if err := client.vpcconn().Invoke("DescribeVpcs", &args, &resp); err != nil {
+			return "", err
+		}
+		if len(resp.Vpcs.Vpc) > 0 {
+			return resp.Vpcs.Vpc[0].ResourceGroupId, nil
+		}
+	case ResourceGroupResourceLoadBalancer:
+		args := DescribeLoadBalancerResourceGroupArgs{
+			RegionId:       client.Region,
+			LoadBalancerId: resourceId,
+		}
+		resp := DescribeLoadBalancerResourceGroupResponse{}
+		if err := client.slbconn().Invoke("DescribeLoadBalancerAttribute", &args, &resp); err != nil {
+			return "", err
+		}
+		return resp.ResourceGroupId, nil
+	case ResourceGroupResourceDBInstance:
+		args := DescribeDBInstanceResourceGroupArgs{
+			DBInstanceId: resourceId,
+		}
+		resp := DescribeDBInstanceResourceGroupResponse{}
+		if err := client.rdsconn().Invoke("DescribeDBInstanceAttribute", &args, &resp); err != nil {
+			return "", err
+		}
+		if len(resp.Items.DBInstanceAttribute) > 0 {
+			return resp.Items.DBInstanceAttribute[0].ResourceGroupId, nil
+		}
+	default:
+		return "", BugErrorf(ErrorCodeUnsupportedResourceType, "Resource type %s does not support resource group", resourceType)
+	}
+
+	return "", GetNotFoundErrorFromString(fmt.Sprintf("%s %s not found", resourceType, resourceId))
+}
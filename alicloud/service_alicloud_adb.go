@@ -0,0 +1,66 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (client *AliyunClient) DescribeADBClusterById(id string) (*ADBCluster, error) {
+	args := ADBClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: id,
+	}
+	resp := DescribeADBClusterAttributeResponse{}
+	if err := client.adbconn().Invoke("DescribeDBClusterAttribute", &args, &resp); err != nil {
+		if NotFoundError(err, "adb") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("AnalyticDB cluster %s not found", id))
+		}
+		return nil, err
+	}
+
+	if len(resp.Items.DBCluster) <= 0 {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("AnalyticDB cluster %s not found", id))
+	}
+
+	return &resp.Items.DBCluster[0], nil
+}
+
+func (client *AliyunClient) DescribeADBSecurityIps(id string) ([]string, error) {
+	args := ADBClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: id,
+	}
+	resp := DescribeADBAccessWhiteListResponse{}
+	if err := client.adbconn().Invoke("DescribeDBClusterAccessWhiteList", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	var ips []string
+	for _, array := range resp.Items.IPArray {
+		if array.DBClusterIPArrayName != "default" {
+			continue
+		}
+		for _, ip := range strings.Split(array.SecurityIPList, COMMA_SEPARATED) {
+			if ip != "" {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips, nil
+}
+
+func (client *AliyunClient) WaitForADBCluster(id string, status ADBClusterStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := client.DescribeADBClusterById(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return cluster, string(cluster.DBClusterStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
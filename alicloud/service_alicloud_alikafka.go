@@ -0,0 +1,150 @@
+package alicloud
+
+import "fmt"
+
+func (client *AliyunClient) DescribeAlikafkaInstance(instanceId string) (*AlikafkaInstance, error) {
+	args := AlikafkaInstanceArgs{
+		RegionId:   client.Region,
+		InstanceId: instanceId,
+	}
+	resp := DescribeAlikafkaInstancesResponse{}
+	if err := client.alikafkaconn().Invoke("GetInstanceList", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, instance := range resp.InstanceList.InstanceVO {
+		if instance.InstanceId == instanceId {
+			return &instance, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Alikafka instance %s not found", instanceId))
+}
+
+// alikafkaInstanceListed is the state of the instance created by the order, which is listed after the order is paid.
+const alikafkaInstanceListed = "Listed"
+
+// DescribeAlikafkaInstanceByOrderId returns the instance which is created by the post pay order.
+func (client *AliyunClient) DescribeAlikafkaInstanceByOrderId(orderId string, timeout int) (*AlikafkaInstance, error) {
+	args := AlikafkaInstanceArgs{
+		RegionId: client.Region,
+		OrderId:  orderId,
+	}
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			resp := DescribeAlikafkaInstancesResponse{}
+			if err := client.alikafkaconn().Invoke("GetInstanceList", &args, &resp); err != nil {
+				return nil, "", err
+			}
+
+			// The instance is not listed until the order is paid.
+			if len(resp.InstanceList.InstanceVO) == 0 {
+				return nil, "", nil
+			}
+			return &resp.InstanceList.InstanceVO[0], alikafkaInstanceListed, nil
+		},
+		Target:  []string{alikafkaInstanceListed},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	instance, err := waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return instance.(*AlikafkaInstance), nil
+}
+
+func (client *AliyunClient) WaitForAlikafkaInstance(instanceId string, status AlikafkaInstanceStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeAlikafkaInstance(instanceId)
+			if err != nil {
+				return nil, "", err
+			}
+			return instance, string(instance.ServiceStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeAlikafkaTopic(instanceId, topic string) (*AlikafkaTopic, error) {
+	pagination := getPagination(1, 50)
+	args := DescribeAlikafkaTopicsArgs{
+		RegionId:    client.Region,
+		InstanceId:  instanceId,
+		CurrentPage: pagination.PageNumber,
+		PageSize:    pagination.PageSize,
+	}
+	for {
+		resp := DescribeAlikafkaTopicsResponse{}
+		if err := client.alikafkaconn().Invoke("GetTopicList", &args, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, t := range resp.TopicList.TopicVO {
+			if t.Topic == topic {
+				return &t, nil
+			}
+		}
+		if len(resp.TopicList.TopicVO) < args.PageSize {
+			break
+		}
+		args.CurrentPage += 1
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Alikafka topic %s of instance %s not found", topic, instanceId))
+}
+
+func (client *AliyunClient) DescribeAlikafkaConsumerGroup(instanceId, consumerId string) (*AlikafkaConsumerGroup, error) {
+	args := AlikafkaConsumerGroupArgs{
+		RegionId:   client.Region,
+		InstanceId: instanceId,
+	}
+	resp := DescribeAlikafkaConsumerGroupsResponse{}
+	if err := client.alikafkaconn().Invoke("GetConsumerList", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, g := range resp.ConsumerList.ConsumerVO {
+		if g.ConsumerId == consumerId {
+			return &g, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Alikafka consumer group %s of instance %s not found", consumerId, instanceId))
+}
+
+func (client *AliyunClient) DescribeAlikafkaSaslUser(instanceId, username string) (*AlikafkaSaslUser, error) {
+	args := AlikafkaSaslUserArgs{
+		RegionId:   client.Region,
+		InstanceId: instanceId,
+	}
+	resp := DescribeAlikafkaSaslUsersResponse{}
+	if err := client.alikafkaconn().Invoke("DescribeSaslUsers", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, u := range resp.SaslUserList.SaslUserVO {
+		if u.Username == username {
+			return &u, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Alikafka sasl user %s of instance %s not found", username, instanceId))
+}
+
+func (client *AliyunClient) DescribeAlikafkaAcl(args AlikafkaAclArgs) (*AlikafkaAcl, error) {
+	args.RegionId = client.Region
+	resp := DescribeAlikafkaAclsResponse{}
+	if err := client.alikafkaconn().Invoke("DescribeAcls", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, acl := range resp.KafkaAclList.KafkaAclVO {
+		if acl.Username == args.Username && acl.AclResourceType == args.AclResourceType &&
+			acl.AclResourceName == args.AclResourceName && acl.AclResourcePatternType == args.AclResourcePatternType &&
+			acl.AclOperationType == args.AclOperationType {
+			return &acl, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Alikafka acl %s of user %s on instance %s not found",
+		args.AclResourceName, args.Username, args.InstanceId))
+}
@@ -0,0 +1,147 @@
+package alicloud
+
+import "fmt"
+
+func (client *AliyunClient) DescribeAmqpInstance(instanceId string) (*AmqpInstance, error) {
+	args := AmqpListArgs{
+		MaxResults: 100,
+	}
+	for {
+		resp := DescribeAmqpInstancesResponse{}
+		if err := client.amqpconn().Invoke("ListInstances", &args, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, instance := range resp.Data.Instances {
+			if instance.InstanceId == instanceId {
+				return &instance, nil
+			}
+		}
+		if resp.Data.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.Data.NextToken
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("AMQP instance %s not found", instanceId))
+}
+
+func (client *AliyunClient) WaitForAmqpInstance(instanceId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeAmqpInstance(instanceId)
+			if err != nil {
+				// The instance bought by the order is not listed until it is deployed.
+				if NotFoundError(err, "amqp") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return instance, instance.Status, nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeAmqpVirtualHost(instanceId, virtualHost string) (*AmqpVirtualHost, error) {
+	args := AmqpListArgs{
+		InstanceId: instanceId,
+		MaxResults: 100,
+	}
+	for {
+		resp := DescribeAmqpVirtualHostsResponse{}
+		if err := client.amqpconn().Invoke("ListVirtualHosts", &args, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, v := range resp.Data.VirtualHosts {
+			if v.Name == virtualHost {
+				return &v, nil
+			}
+		}
+		if resp.Data.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.Data.NextToken
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("AMQP virtual host %s of instance %s not found", virtualHost, instanceId))
+}
+
+func (client *AliyunClient) DescribeAmqpQueue(instanceId, virtualHost, queue string) (*AmqpQueue, error) {
+	args := AmqpListArgs{
+		InstanceId:  instanceId,
+		VirtualHost: virtualHost,
+		MaxResults:  100,
+	}
+	for {
+		resp := DescribeAmqpQueuesResponse{}
+		if err := client.amqpconn().Invoke("ListQueues", &args, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, q := range resp.Data.Queues {
+			if q.Name == queue {
+				return &q, nil
+			}
+		}
+		if resp.Data.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.Data.NextToken
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("AMQP queue %s of virtual host %s not found", queue, virtualHost))
+}
+
+func (client *AliyunClient) DescribeAmqpExchange(instanceId, virtualHost, exchange string) (*AmqpExchange, error) {
+	args := AmqpListArgs{
+		InstanceId:  instanceId,
+		VirtualHost: virtualHost,
+		MaxResults:  100,
+	}
+	for {
+		resp := DescribeAmqpExchangesResponse{}
+		if err := client.amqpconn().Invoke("ListExchanges", &args, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, e := range resp.Data.Exchanges {
+			if e.Name == exchange {
+				return &e, nil
+			}
+		}
+		if resp.Data.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.Data.NextToken
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("AMQP exchange %s of virtual host %s not found", exchange, virtualHost))
+}
+
+func (client *AliyunClient) DescribeAmqpBinding(args AmqpBindingArgs) (*AmqpBinding, error) {
+	listArgs := AmqpListArgs{
+		InstanceId:  args.InstanceId,
+		VirtualHost: args.VirtualHost,
+		MaxResults:  100,
+	}
+	for {
+		resp := DescribeAmqpBindingsResponse{}
+		if err := client.amqpconn().Invoke("ListBindings", &listArgs, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, b := range resp.Data.Bindings {
+			if b.SourceExchange == args.SourceExchange && b.DestinationName == args.DestinationName &&
+				b.BindingType == args.BindingType && b.BindingKey == args.BindingKey {
+				return &b, nil
+			}
+		}
+		if resp.Data.NextToken == "" {
+			break
+		}
+		listArgs.NextToken = resp.Data.NextToken
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("AMQP binding from %s to %s of virtual host %s not found",
+		args.SourceExchange, args.DestinationName, args.VirtualHost))
+}
@@ -0,0 +1,90 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) DescribeBastionhostInstance(instanceId string) (*BastionhostInstance, error) {
+	args := BastionhostInstanceArgs{
+		RegionId:   client.Region,
+		InstanceId: instanceId,
+	}
+	resp := DescribeBastionhostInstanceAttributeResponse{}
+	if err := client.bastionhostconn().Invoke("DescribeInstanceAttribute", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.InstanceAttribute.InstanceId != instanceId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Bastionhost instance %s not found", instanceId))
+	}
+	return &resp.InstanceAttribute, nil
+}
+
+func (client *AliyunClient) WaitForBastionhostInstance(instanceId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeBastionhostInstance(instanceId)
+			if err != nil {
+				// The instance bought by the order is not returned until it is deployed.
+				if NotFoundError(err, "bastionhost") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return instance, instance.InstanceStatus, nil
+		},
+		Target:  []string{status},
+		Failed:  []string{BastionhostInstanceCreateFailed},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeBastionhostUser(instanceId, userId string) (*BastionhostUser, error) {
+	args := BastionhostUserArgs{
+		RegionId:   client.Region,
+		InstanceId: instanceId,
+		UserId:     userId,
+	}
+	resp := GetBastionhostUserResponse{}
+	if err := client.bastionhostconn().Invoke("GetUser", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.User.UserId != userId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Bastionhost user %s of instance %s not found", userId, instanceId))
+	}
+	return &resp.User, nil
+}
+
+func (client *AliyunClient) DescribeBastionhostHost(instanceId, hostId string) (*BastionhostHost, error) {
+	args := BastionhostHostArgs{
+		RegionId:   client.Region,
+		InstanceId: instanceId,
+		HostId:     hostId,
+	}
+	resp := GetBastionhostHostResponse{}
+	if err := client.bastionhostconn().Invoke("GetHost", &args, &resp); err != nil {
+		return nil, err
+	}
+	if strconv.Itoa(resp.Host.HostId) != hostId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Bastionhost host %s of instance %s not found", hostId, instanceId))
+	}
+	return &resp.Host, nil
+}
+
+func (client *AliyunClient) DescribeBastionhostHostAccount(instanceId, hostAccountId string) (*BastionhostHostAccount, error) {
+	args := BastionhostHostAccountArgs{
+		RegionId:      client.Region,
+		InstanceId:    instanceId,
+		HostAccountId: hostAccountId,
+	}
+	resp := GetBastionhostHostAccountResponse{}
+	if err := client.bastionhostconn().Invoke("GetHostAccount", &args, &resp); err != nil {
+		return nil, err
+	}
+	if strconv.Itoa(resp.HostAccount.HostAccountId) != hostAccountId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Bastionhost host account %s of instance %s not found", hostAccountId, instanceId))
+	}
+	return &resp.HostAccount, nil
+}
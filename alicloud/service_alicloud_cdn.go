@@ -0,0 +1,130 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/denverdino/aliyungo/cdn"
+)
+
+func (client *AliyunClient) DescribeCdnDomain(domainName string) (*cdn.DomainDetail, error) {
+	args := cdn.DescribeDomainRequest{
+		DomainName: domainName,
+	}
+	resp, err := client.cdnconn().DescribeCdnDomainDetail(args)
+	if err != nil {
+		if NotFoundError(err, "cdn") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("CDN domain %s not found", domainName))
+		}
+		return nil, err
+	}
+
+	if resp.GetDomainDetailModel.DomainName != domainName {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("CDN domain %s not found", domainName))
+	}
+
+	return &resp.GetDomainDetailModel, nil
+}
+
+func (client *AliyunClient) WaitForCdnDomain(domainName string, status CdnDomainStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			domain, err := client.DescribeCdnDomain(domainName)
+			if err != nil {
+				return nil, "", err
+			}
+			return domain, domain.DomainStatus, nil
+		},
+		Target:  []string{string(status)},
+		Failed:  []string{string(CdnDomainConfigureFailed), string(CdnDomainCheckFailed)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	if _, err := waiter.Wait(); err != nil {
+		return fmt.Errorf("Waiting for CDN domain %s to be %s got an error: %s", domainName, status, err)
+	}
+	return nil
+}
+
+// DescribeCdnDomainConfigs describes the configs in the comma separated configList, like 'ip_allow_list,force_redirect'.
+func (client *AliyunClient) DescribeCdnDomainConfigs(domainName, configList string) (*DescribeCdnDomainConfigsResponse, error) {
+	args := DescribeCdnDomainConfigsArgs{
+		DomainName: domainName,
+		ConfigList: configList,
+	}
+	resp := DescribeCdnDomainConfigsResponse{}
+	if err := client.cdnconn().Invoke("DescribeDomainConfigs", &args, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribeCdnDomainCertificate(domainName string) (*CdnCertInfo, error) {
+	args := DescribeCdnDomainCertificateInfoArgs{
+		DomainName: domainName,
+	}
+	resp := DescribeCdnDomainCertificateInfoResponse{}
+	if err := client.cdnconn().Invoke("DescribeDomainCertificateInfo", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, cert := range resp.CertInfos.CertInfo {
+		if cert.DomainName == domainName {
+			return &cert, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Certificate of CDN domain %s not found", domainName))
+}
+
+// DescribeCdnDomainTlsVersions returns the enabled TLS versions of the domain.
+func (client *AliyunClient) DescribeCdnDomainTlsVersions(domainName string) ([]string, error) {
+	args := DescribeCdnDomainFunctionsArgs{
+		DomainName:    domainName,
+		FunctionNames: "https_tls_version",
+	}
+	resp := DescribeCdnDomainFunctionsResponse{}
+	if err := client.cdnconn().Invoke("DescribeCdnDomainConfigs", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	versions := []string{}
+	for _, config := range resp.DomainConfigs.DomainConfig {
+		if config.FunctionName != "https_tls_version" {
+			continue
+		}
+		for _, arg := range config.FunctionArgs.FunctionArg {
+			for version, name := range CdnTlsVersions {
+				if arg.ArgName == name && arg.ArgValue == "on" {
+					versions = append(versions, version)
+				}
+			}
+		}
+	}
+	return versions, nil
+}
+
+// splitCdnConfigList splits the comma separated config value, and an empty value means an empty list.
+func splitCdnConfigList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, COMMA_SEPARATED)
+}
+
+func (client *AliyunClient) DescribeCdnRealTimeLogDelivery(domain string) (*DescribeCdnRealTimeLogDeliveryResponse, error) {
+	args := CdnDomainArgs{
+		Domain: domain,
+	}
+	resp := DescribeCdnRealTimeLogDeliveryResponse{}
+	if err := client.cdnNewconn().Invoke("DescribeDomainRealTimeLogDelivery", &args, &resp); err != nil {
+		if NotFoundError(err, "cdn") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Real-time log delivery of CDN domain %s not found", domain))
+		}
+		return nil, err
+	}
+
+	if resp.Project == "" {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Real-time log delivery of CDN domain %s not found", domain))
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+func (client *AliyunClient) DescribeCenRouteMap(cenId, routeMapId string) (*CenRouteMap, error) {
+	args := DescribeCenRouteMapsArgs{
+		CenId:      cenId,
+		RouteMapId: routeMapId,
+		PageNumber: 1,
+		PageSize:   10,
+	}
+	resp := DescribeCenRouteMapsResponse{}
+	if err := client.cenconn().Invoke("DescribeCenRouteMaps", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, routeMap := range resp.RouteMaps.RouteMap {
+		if routeMap.RouteMapId == routeMapId {
+			return &routeMap, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("CEN route map %s of CEN instance %s not found", routeMapId, cenId))
+}
+
+func (client *AliyunClient) WaitForCenRouteMap(cenId, routeMapId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			routeMap, err := client.DescribeCenRouteMap(cenId, routeMapId)
+			if err != nil {
+				if NotFoundError(err, "cen") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return routeMap, routeMap.Status, nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+// DescribeCenRouteEntry returns the route entry of the route table of the vpc attached to the CEN instance, and
+// the entries which are not published are returned as well, with the PublishStatus NonPublished.
+func (client *AliyunClient) DescribeCenRouteEntry(cenId, vpcId, routeTableId, cidrBlock string) (*CenPublishedRouteEntry, error) {
+	args := CenRouteEntryArgs{
+		CenId:                     cenId,
+		ChildInstanceId:           vpcId,
+		ChildInstanceType:         CenChildInstanceVpc,
+		ChildInstanceRegionId:     client.Region,
+		ChildInstanceRouteTableId: routeTableId,
+		DestinationCidrBlock:      cidrBlock,
+		PageNumber:                1,
+		PageSize:                  10,
+	}
+	resp := DescribePublishedRouteEntriesResponse{}
+	if err := client.cenconn().Invoke("DescribePublishedRouteEntries", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, entry := range resp.PublishedRouteEntries.PublishedRouteEntry {
+		if entry.DestinationCidrBlock == cidrBlock {
+			return &entry, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("route entry %s of route table %s not found in CEN instance %s", cidrBlock, routeTableId, cenId))
+}
+
+func (client *AliyunClient) WaitForCenRouteEntry(cenId, vpcId, routeTableId, cidrBlock, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			entry, err := client.DescribeCenRouteEntry(cenId, vpcId, routeTableId, cidrBlock)
+			if err != nil {
+				if NotFoundError(err, "cen") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return entry, entry.PublishStatus, nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeCenChildInstance(cenId, childInstanceId, childInstanceType string) (*CenAttachedChildInstance, error) {
+	args := CenChildInstanceArgs{
+		CenId:                 cenId,
+		ChildInstanceId:       childInstanceId,
+		ChildInstanceType:     childInstanceType,
+		ChildInstanceRegionId: client.Region,
+	}
+	resp := CenAttachedChildInstance{}
+	if err := client.cenconn().Invoke("DescribeCenAttachedChildInstanceAttribute", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.ChildInstanceId != childInstanceId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("%s instance %s not attached to CEN instance %s", childInstanceType, childInstanceId, cenId))
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) WaitForCenChildInstance(cenId, childInstanceId, childInstanceType, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			child, err := client.DescribeCenChildInstance(cenId, childInstanceId, childInstanceType)
+			if err != nil {
+				if NotFoundError(err, "cen") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return child, child.Status, nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
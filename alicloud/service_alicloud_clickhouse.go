@@ -0,0 +1,104 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (client *AliyunClient) DescribeClickHouseClusterById(id string) (*ClickHouseCluster, error) {
+	args := ClickHouseClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: id,
+	}
+	resp := DescribeClickHouseClusterAttributeResponse{}
+	if err := client.clickhouseconn().Invoke("DescribeDBClusterAttribute", &args, &resp); err != nil {
+		if NotFoundError(err, "clickhouse") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("ClickHouse cluster %s not found", id))
+		}
+		return nil, err
+	}
+
+	if resp.DBCluster.DBClusterId != id {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("ClickHouse cluster %s not found", id))
+	}
+
+	return &resp.DBCluster, nil
+}
+
+func (client *AliyunClient) DescribeClickHouseSecurityIps(id string) ([]string, error) {
+	args := ClickHouseClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: id,
+	}
+	resp := DescribeClickHouseAccessWhiteListResponse{}
+	if err := client.clickhouseconn().Invoke("DescribeDBClusterAccessWhiteList", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	var ips []string
+	for _, array := range resp.DBClusterAccessWhiteList.IPArray {
+		if array.DBClusterIPArrayName != "default" {
+			continue
+		}
+		for _, ip := range strings.Split(array.SecurityIPList, COMMA_SEPARATED) {
+			if ip != "" {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips, nil
+}
+
+func (client *AliyunClient) DescribeClickHouseAccount(clusterId, name string) (*ClickHouseAccount, error) {
+	args := ClickHouseAccountArgs{
+		RegionId:    client.Region,
+		DBClusterId: clusterId,
+		AccountName: name,
+	}
+	resp := DescribeClickHouseAccountsResponse{}
+	if err := client.clickhouseconn().Invoke("DescribeAccounts", &args, &resp); err != nil {
+		if NotFoundError(err, "clickhouse") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("ClickHouse account %s not found", name))
+		}
+		return nil, err
+	}
+
+	for _, account := range resp.Accounts.Account {
+		if account.AccountName == name {
+			return &account, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("ClickHouse account %s not found", name))
+}
+
+func (client *AliyunClient) WaitForClickHouseCluster(id string, status ClickHouseStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := client.DescribeClickHouseClusterById(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return cluster, string(cluster.DBClusterStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) WaitForClickHouseAccount(clusterId, name string, status ClickHouseStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			account, err := client.DescribeClickHouseAccount(clusterId, name)
+			if err != nil {
+				return nil, "", err
+			}
+			return account, string(account.AccountStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
@@ -0,0 +1,193 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) DescribeCloudApiGroup(groupId string) (*CloudApiGroup, error) {
+	args := CloudApiGroupArgs{
+		RegionId: client.Region,
+		GroupId:  groupId,
+	}
+	group := CloudApiGroup{}
+	if err := client.cloudapiconn().Invoke("DescribeApiGroup", &args, &group); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api group %s not found", groupId))
+		}
+		return nil, err
+	}
+
+	if group.GroupId != groupId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api group %s not found", groupId))
+	}
+	return &group, nil
+}
+
+func (client *AliyunClient) DescribeCloudApi(groupId, apiId string) (*CloudApi, error) {
+	args := CloudApiArgs{
+		RegionId: client.Region,
+		GroupId:  groupId,
+		ApiId:    apiId,
+	}
+	api := CloudApi{}
+	if err := client.cloudapiconn().Invoke("DescribeApi", &args, &api); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api %s of group %s not found", apiId, groupId))
+		}
+		return nil, err
+	}
+
+	if api.ApiId != apiId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api %s of group %s not found", apiId, groupId))
+	}
+	return &api, nil
+}
+
+// DescribeCloudApiDeployment returns the api deployed in the stage.
+func (client *AliyunClient) DescribeCloudApiDeployment(groupId, apiId, stageName string) (*CloudApiDeployedApi, error) {
+	args := CloudApiArgs{
+		RegionId:  client.Region,
+		GroupId:   groupId,
+		ApiId:     apiId,
+		StageName: stageName,
+	}
+	api := CloudApiDeployedApi{}
+	if err := client.cloudapiconn().Invoke("DescribeDeployedApi", &args, &api); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api %s is not deployed in stage %s", apiId, stageName))
+		}
+		return nil, err
+	}
+
+	if api.ApiId != apiId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api %s is not deployed in stage %s", apiId, stageName))
+	}
+	return &api, nil
+}
+
+func (client *AliyunClient) DescribeCloudApiVpcAccess(name, vpcId, instanceId string, port int) (*CloudApiVpcAccess, error) {
+	args := CloudApiVpcAccessArgs{
+		RegionId:   client.Region,
+		Name:       name,
+		Pagination: getPagination(1, 50),
+	}
+	for {
+		resp := DescribeCloudApiVpcAccessesResponse{}
+		if err := client.cloudapiconn().Invoke("DescribeVpcAccesses", &args, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, access := range resp.VpcAccessAttributes.VpcAccessAttribute {
+			if access.Name == name && access.VpcId == vpcId && access.InstanceId == instanceId && access.Port == port {
+				return &access, nil
+			}
+		}
+
+		if len(resp.VpcAccessAttributes.VpcAccessAttribute) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Vpc access %s not found", name))
+}
+
+func (client *AliyunClient) DescribeCloudApiApp(appId string) (*CloudApiApp, error) {
+	args := CloudApiAppArgs{
+		RegionId: client.Region,
+		AppId:    appId,
+	}
+	resp := DescribeCloudApiAppsResponse{}
+	if err := client.cloudapiconn().Invoke("DescribeAppAttributes", &args, &resp); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api app %s not found", appId))
+		}
+		return nil, err
+	}
+
+	for _, app := range resp.Apps.AppAttribute {
+		if strconv.FormatInt(app.AppId, 10) == appId {
+			return &app, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api app %s not found", appId))
+}
+
+// DescribeCloudApiAuthorization returns the app authorized to call the api in the stage.
+func (client *AliyunClient) DescribeCloudApiAuthorization(groupId, apiId, appId, stageName string) (*CloudApiAuthorizedApp, error) {
+	args := CloudApiAuthorityArgs{
+		RegionId:   client.Region,
+		GroupId:    groupId,
+		ApiId:      apiId,
+		StageName:  stageName,
+		Pagination: getPagination(1, 50),
+	}
+	for {
+		resp := DescribeCloudApiAuthorizedAppsResponse{}
+		if err := client.cloudapiconn().Invoke("DescribeAuthorizedApps", &args, &resp); err != nil {
+			if NotFoundError(err, "cloudapi") {
+				return nil, GetNotFoundErrorFromString(fmt.Sprintf("Api %s of group %s not found", apiId, groupId))
+			}
+			return nil, err
+		}
+
+		for _, app := range resp.AuthorizedApps.AuthorizedApp {
+			if strconv.FormatInt(app.AppId, 10) == appId && app.StageName == stageName {
+				return &app, nil
+			}
+		}
+
+		if len(resp.AuthorizedApps.AuthorizedApp) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("App %s is not authorized to api %s in stage %s", appId, apiId, stageName))
+}
+
+func (client *AliyunClient) DescribeCloudApiTrafficControl(trafficControlId string) (*CloudApiTrafficControl, error) {
+	args := CloudApiTrafficControlArgs{
+		RegionId:         client.Region,
+		TrafficControlId: trafficControlId,
+		Pagination:       getPagination(1, 50),
+	}
+	resp := DescribeCloudApiTrafficControlsResponse{}
+	if err := client.cloudapiconn().Invoke("DescribeTrafficControls", &args, &resp); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Traffic control %s not found", trafficControlId))
+		}
+		return nil, err
+	}
+
+	for _, control := range resp.TrafficControls.TrafficControl {
+		if control.TrafficControlId == trafficControlId {
+			return &control, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Traffic control %s not found", trafficControlId))
+}
+
+// DescribeCloudApiTrafficControlBinding returns the traffic control which is bound to the api in the stage.
+func (client *AliyunClient) DescribeCloudApiTrafficControlBinding(trafficControlId, groupId, apiId, stageName string) (*CloudApiTrafficControl, error) {
+	args := CloudApiTrafficControlArgs{
+		RegionId:   client.Region,
+		GroupId:    groupId,
+		ApiId:      apiId,
+		StageName:  stageName,
+		Pagination: getPagination(1, 50),
+	}
+	resp := DescribeCloudApiTrafficControlsResponse{}
+	if err := client.cloudapiconn().Invoke("DescribeTrafficControls", &args, &resp); err != nil {
+		if NotFoundError(err, "cloudapi") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Traffic control %s is not bound to api %s in stage %s", trafficControlId, apiId, stageName))
+		}
+		return nil, err
+	}
+
+	for _, control := range resp.TrafficControls.TrafficControl {
+		if control.TrafficControlId == trafficControlId {
+			return &control, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Traffic control %s is not bound to api %s in stage %s", trafficControlId, apiId, stageName))
+}
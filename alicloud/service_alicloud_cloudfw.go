@@ -0,0 +1,50 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) DescribeCloudfwControlPolicy(aclUuid, direction string) (*CloudfwControlPolicy, error) {
+	args := DescribeCloudfwControlPolicyArgs{
+		Direction:   direction,
+		AclUuid:     aclUuid,
+		CurrentPage: "1",
+		PageSize:    "10",
+	}
+	resp := DescribeCloudfwControlPolicyResponse{}
+	if err := client.cloudfwconn().Invoke("DescribeControlPolicy", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, policy := range resp.Policys {
+		if policy.AclUuid == aclUuid {
+			return &policy, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Cloud firewall control policy %s of direction %s not found", aclUuid, direction))
+}
+
+func (client *AliyunClient) DescribeCloudfwAddressBook(groupUuid string) (*CloudfwAddressBook, error) {
+	page := 1
+	for {
+		args := DescribeCloudfwAddressBookArgs{
+			CurrentPage: strconv.Itoa(page),
+			PageSize:    "50",
+		}
+		resp := DescribeCloudfwAddressBookResponse{}
+		if err := client.cloudfwconn().Invoke("DescribeAddressBook", &args, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, book := range resp.Acls {
+			if book.GroupUuid == groupUuid {
+				return &book, nil
+			}
+		}
+		if len(resp.Acls) < 50 {
+			break
+		}
+		page++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Cloud firewall address book %s not found", groupUuid))
+}
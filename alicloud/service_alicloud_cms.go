@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+type cmsResult interface {
+	result() *CmsResponse
+}
+
+func (resp *CmsResponse) result() *CmsResponse {
+	return resp
+}
+
+// invokeCms invokes the action and converts the failed result, whose http status is OK, into an error.
+func (client *AliyunClient) invokeCms(action string, args interface{}, resp cmsResult) error {
+	if err := client.cmsconn().Invoke(action, args, resp); err != nil {
+		return err
+	}
+	if r := resp.result(); !r.Success {
+		return &common.Error{
+			ErrorResponse: common.ErrorResponse{
+				Response: r.Response,
+				Code:     r.Code,
+				Message:  r.Message,
+			},
+		}
+	}
+	return nil
+}
+
+func (client *AliyunClient) DescribeCmsSiteMonitor(taskId string) (*CmsSiteMonitor, error) {
+	args := CmsSiteMonitorArgs{
+		TaskId: taskId,
+	}
+	resp := DescribeCmsSiteMonitorResponse{}
+	if err := client.invokeCms("DescribeSiteMonitorAttribute", &args, &resp); err != nil {
+		if NotFoundError(err, "cms") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Site monitor %s not found", taskId))
+		}
+		return nil, err
+	}
+
+	if resp.SiteMonitors.TaskId != taskId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Site monitor %s not found", taskId))
+	}
+	return &resp.SiteMonitors, nil
+}
+
+func (client *AliyunClient) DescribeCmsMonitorGroup(groupId string) (*CmsMonitorGroup, error) {
+	args := CmsMonitorGroupArgs{
+		GroupId: groupId,
+	}
+	resp := DescribeCmsMonitorGroupsResponse{}
+	if err := client.invokeCms("DescribeMonitorGroups", &args, &resp); err != nil {
+		if NotFoundError(err, "cms") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Monitor group %s not found", groupId))
+		}
+		return nil, err
+	}
+
+	for _, group := range resp.Resources.Resource {
+		if fmt.Sprint(group.GroupId) == groupId {
+			return &group, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Monitor group %s not found", groupId))
+}
+
+func (client *AliyunClient) DescribeCmsMetricRule(ruleId string) (*CmsMetricRule, error) {
+	args := CmsMetricRuleArgs{
+		RuleIds: ruleId,
+	}
+	resp := DescribeCmsMetricRulesResponse{}
+	if err := client.invokeCms("DescribeMetricRuleList", &args, &resp); err != nil {
+		if NotFoundError(err, "cms") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Metric rule %s not found", ruleId))
+		}
+		return nil, err
+	}
+
+	for _, rule := range resp.Alarms.Alarm {
+		if rule.RuleId == ruleId {
+			return &rule, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Metric rule %s not found", ruleId))
+}
+
+func (client *AliyunClient) DescribeCmsEventRule(name string) (*CmsEventRule, error) {
+	args := CmsEventRuleArgs{
+		NamePrefix: name,
+	}
+	resp := DescribeCmsEventRulesResponse{}
+	if err := client.invokeCms("DescribeEventRuleList", &args, &resp); err != nil {
+		if NotFoundError(err, "cms") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Event rule %s not found", name))
+		}
+		return nil, err
+	}
+
+	// The event rules are matched by the prefix of their names.
+	for _, rule := range resp.EventRules.EventRule {
+		if rule.Name == name {
+			return &rule, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Event rule %s not found", name))
+}
+
+func (client *AliyunClient) DescribeCmsEventRuleTargets(name string) ([]CmsContactParameter, error) {
+	args := CmsEventRuleTargetsArgs{
+		RuleName: name,
+	}
+	resp := DescribeCmsEventRuleTargetsResponse{}
+	if err := client.invokeCms("DescribeEventRuleTargetList", &args, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ContactParameters.ContactParameter, nil
+}
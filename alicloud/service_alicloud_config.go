@@ -0,0 +1,67 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) DescribeConfigRule(ruleId string) (*ConfigRule, error) {
+	args := ConfigRuleArgs{
+		ConfigRuleId: ruleId,
+	}
+	resp := GetConfigRuleResponse{}
+	if err := client.configconn().Invoke("GetConfigRule", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.ConfigRule.ConfigRuleId != ruleId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Config rule %s not found", ruleId))
+	}
+	return &resp.ConfigRule, nil
+}
+
+func (client *AliyunClient) DescribeConfigAggregator(aggregatorId string) (*ConfigAggregator, error) {
+	args := ConfigAggregatorArgs{
+		AggregatorId: aggregatorId,
+	}
+	resp := GetConfigAggregatorResponse{}
+	if err := client.configconn().Invoke("GetAggregator", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Aggregator.AggregatorId != aggregatorId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Config aggregator %s not found", aggregatorId))
+	}
+	return &resp.Aggregator, nil
+}
+
+func (client *AliyunClient) WaitForConfigAggregator(aggregatorId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			aggregator, err := client.DescribeConfigAggregator(aggregatorId)
+			if err != nil {
+				if NotFoundError(err, "config") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return aggregator, strconv.Itoa(aggregator.AggregatorStatus), nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeConfigDeliveryChannel(channelId string) (*ConfigDeliveryChannel, error) {
+	args := ConfigDeliveryChannelArgs{
+		DeliveryChannelId: channelId,
+	}
+	resp := GetConfigDeliveryChannelResponse{}
+	if err := client.configconn().Invoke("GetConfigDeliveryChannel", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.DeliveryChannel.DeliveryChannelId != channelId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Config delivery channel %s not found", channelId))
+	}
+	return &resp.DeliveryChannel, nil
+}
@@ -0,0 +1,40 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (client *AliyunClient) DescribeCrNamespace(name string) (*CrNamespace, error) {
+	resp := GetCrNamespaceResponse{}
+	path := fmt.Sprintf("/namespace/%s", name)
+	if err := client.crconn().Invoke(client.Region, http.MethodGet, path, nil, nil, &resp); err != nil {
+		if NotFoundError(err, "cr") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Container registry namespace %s not found", name))
+		}
+		return nil, err
+	}
+
+	if resp.Data.Namespace.Namespace != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Container registry namespace %s not found", name))
+	}
+
+	return &resp.Data.Namespace, nil
+}
+
+func (client *AliyunClient) DescribeCrRepo(namespace, name string) (*CrRepo, error) {
+	resp := GetCrRepoResponse{}
+	path := fmt.Sprintf("/repos/%s/%s", namespace, name)
+	if err := client.crconn().Invoke(client.Region, http.MethodGet, path, nil, nil, &resp); err != nil {
+		if NotFoundError(err, "cr") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Container registry repo %s/%s not found", namespace, name))
+		}
+		return nil, err
+	}
+
+	if resp.Data.Repo.RepoName != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Container registry repo %s/%s not found", namespace, name))
+	}
+
+	return &resp.Data.Repo, nil
+}
@@ -0,0 +1,156 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+func (client *AliyunClient) DescribeCsKubernetesNodePool(clusterId, nodePoolId string) (*CsNodePool, error) {
+	nodePool := CsNodePool{}
+	path := fmt.Sprintf("/clusters/%s/nodepools/%s", clusterId, nodePoolId)
+	if err := client.csconn().Invoke(client.Region, http.MethodGet, path, nil, nil, &nodePool); err != nil {
+		if NotFoundError(err, "cs") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Node pool %s of cluster %s not found", nodePoolId, clusterId))
+		}
+		if e, ok := err.(*common.Error); ok && e.StatusCode == http.StatusNotFound {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Node pool %s of cluster %s not found", nodePoolId, clusterId))
+		}
+		return nil, err
+	}
+
+	if nodePool.NodePoolInfo.NodePoolId == "" {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Node pool %s of cluster %s not found", nodePoolId, clusterId))
+	}
+
+	return &nodePool, nil
+}
+
+func (client *AliyunClient) WaitForCsKubernetesNodePool(clusterId, nodePoolId string, state CsNodePoolState, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			nodePool, err := client.DescribeCsKubernetesNodePool(clusterId, nodePoolId)
+			if err != nil {
+				return nil, "", err
+			}
+			return nodePool, string(nodePool.Status.State), nil
+		},
+		Target:  []string{string(state)},
+		Failed:  []string{string(CsNodePoolFailed)},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	if _, err := waiter.Wait(); err != nil {
+		return fmt.Errorf("Waiting for node pool %s of cluster %s to be %s got an error: %s", nodePoolId, clusterId, state, err)
+	}
+	return nil
+}
+
+func (client *AliyunClient) DescribeCsKubernetesCluster(clusterId string) (*CsKubernetesCluster, error) {
+	cluster := CsKubernetesCluster{}
+	path := fmt.Sprintf("/clusters/%s", clusterId)
+	if err := client.csconn().Invoke(client.Region, http.MethodGet, path, nil, nil, &cluster); err != nil {
+		if NotFoundError(err, "cs") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Cluster %s not found", clusterId))
+		}
+		if e, ok := err.(*common.Error); ok && e.StatusCode == http.StatusNotFound {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Cluster %s not found", clusterId))
+		}
+		return nil, err
+	}
+
+	if cluster.ClusterId == "" {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Cluster %s not found", clusterId))
+	}
+
+	return &cluster, nil
+}
+
+func (client *AliyunClient) DescribeCsKubernetesMasterUrl(clusterId string) (*CsMasterUrl, error) {
+	cluster, err := client.DescribeCsKubernetesCluster(clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	masterUrl := CsMasterUrl{}
+	if cluster.MasterUrl != "" {
+		if err := json.Unmarshal([]byte(cluster.MasterUrl), &masterUrl); err != nil {
+			return nil, fmt.Errorf("Parsing master url %s of cluster %s got an error: %#v", cluster.MasterUrl, clusterId, err)
+		}
+	}
+	return &masterUrl, nil
+}
+
+// DescribeCsKubernetesUserConfig returns the kubeconfig of the cluster, and the api server in it is
+// the intranet endpoint when privateIpAddress is true.
+func (client *AliyunClient) DescribeCsKubernetesUserConfig(clusterId string, privateIpAddress bool) (string, error) {
+	config := CsKubernetesUserConfig{}
+	path := fmt.Sprintf("/k8s/%s/user_config", clusterId)
+	query := url.Values{}
+	if privateIpAddress {
+		query.Set("PrivateIpAddress", "true")
+	}
+	if err := client.csconn().Invoke(client.Region, http.MethodGet, path, query, nil, &config); err != nil {
+		return "", err
+	}
+	return config.Config, nil
+}
+
+func (client *AliyunClient) DescribeCsKubernetesClusterCerts(clusterId string) (*CsKubernetesClusterCerts, error) {
+	certs := CsKubernetesClusterCerts{}
+	path := fmt.Sprintf("/k8s/%s/certs", clusterId)
+	if err := client.csconn().Invoke(client.Region, http.MethodGet, path, nil, nil, &certs); err != nil {
+		return nil, err
+	}
+	return &certs, nil
+}
+
+// DescribeCsKubernetesAddon returns the installed addon of the cluster, and the addons which are not installed
+// have an empty version.
+func (client *AliyunClient) DescribeCsKubernetesAddon(clusterId, name string) (*CsKubernetesAddon, error) {
+	addons := make(map[string]CsKubernetesAddon)
+	path := fmt.Sprintf("/clusters/%s/components/version", clusterId)
+	if err := client.csconn().Invoke(client.Region, http.MethodGet, path, nil, nil, &addons); err != nil {
+		if NotFoundError(err, "cs") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Cluster %s not found", clusterId))
+		}
+		return nil, err
+	}
+
+	addon, ok := addons[name]
+	if !ok || addon.Version == "" {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Addon %s of cluster %s not found", name, clusterId))
+	}
+	if addon.ComponentName == "" {
+		addon.ComponentName = name
+	}
+
+	return &addon, nil
+}
+
+func (client *AliyunClient) WaitForCsKubernetesAddon(clusterId, name string, timeout int) error {
+	path := fmt.Sprintf("/clusters/%s/components/%s/upgradestatus", clusterId, name)
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			status := make(map[string]CsKubernetesAddonUpgradeStatus)
+			if err := client.csconn().Invoke(client.Region, http.MethodGet, path, nil, nil, &status); err != nil {
+				return nil, "", err
+			}
+
+			s, ok := status[name]
+			if !ok || s.Tasks.State == "" {
+				return status, string(CsAddonTaskSuccess), nil
+			}
+			if s.Tasks.State == CsAddonTaskFailed {
+				return nil, "", fmt.Errorf("Addon %s of cluster %s is failed: %s.", name, clusterId, s.Tasks.Message)
+			}
+			return status, string(s.Tasks.State), nil
+		},
+		Target:  []string{string(CsAddonTaskSuccess)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
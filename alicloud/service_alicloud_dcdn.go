@@ -0,0 +1,56 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (client *AliyunClient) DescribeDcdnDomain(domainName string) (*DcdnDomainDetail, error) {
+	args := DcdnDomainArgs{
+		DomainName: domainName,
+	}
+	resp := DescribeDcdnDomainDetailResponse{}
+	if err := client.dcdnconn().Invoke("DescribeDcdnDomainDetail", &args, &resp); err != nil {
+		if NotFoundError(err, "dcdn") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("DCDN domain %s not found", domainName))
+		}
+		return nil, err
+	}
+
+	if resp.DomainDetail.DomainName != domainName {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("DCDN domain %s not found", domainName))
+	}
+
+	return &resp.DomainDetail, nil
+}
+
+func (client *AliyunClient) DescribeDcdnDomainConfigs(domainName string, functionNames []string) ([]DcdnDomainConfig, error) {
+	args := DescribeDcdnDomainConfigsArgs{
+		DomainName:    domainName,
+		FunctionNames: strings.Join(functionNames, COMMA_SEPARATED),
+	}
+	resp := DescribeDcdnDomainConfigsResponse{}
+	if err := client.dcdnconn().Invoke("DescribeDcdnDomainConfigs", &args, &resp); err != nil {
+		return nil, err
+	}
+	return resp.DomainConfigs.DomainConfig, nil
+}
+
+func (client *AliyunClient) WaitForDcdnDomain(domainName string, status CdnDomainStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			domain, err := client.DescribeDcdnDomain(domainName)
+			if err != nil {
+				return nil, "", err
+			}
+			return domain, domain.DomainStatus, nil
+		},
+		Target:  []string{string(status)},
+		Failed:  []string{string(CdnDomainConfigureFailed), string(CdnDomainCheckFailed)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	if _, err := waiter.Wait(); err != nil {
+		return fmt.Errorf("Waiting for DCDN domain %s to be %s got an error: %s", domainName, status, err)
+	}
+	return nil
+}
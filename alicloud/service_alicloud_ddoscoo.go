@@ -0,0 +1,107 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) DescribeDdoscooInstance(instanceId string) (*DdoscooInstance, error) {
+	args := DescribeDdoscooInstancesArgs{
+		InstanceIds: []string{instanceId},
+		PageNumber:  "1",
+		PageSize:    "10",
+	}
+	resp := DescribeDdoscooInstancesResponse{}
+	if err := client.ddoscooconn().Invoke("DescribeInstances", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, instance := range resp.Instances {
+		if instance.InstanceId == instanceId {
+			return &instance, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Anti-DDoS Pro instance %s not found", instanceId))
+}
+
+func (client *AliyunClient) DescribeDdoscooInstanceSpec(instanceId string) (*DdoscooInstanceSpec, error) {
+	args := DescribeDdoscooInstanceSpecsArgs{
+		InstanceIds: []string{instanceId},
+	}
+	resp := DescribeDdoscooInstanceSpecsResponse{}
+	if err := client.ddoscooconn().Invoke("DescribeInstanceSpecs", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, spec := range resp.InstanceSpecs {
+		if spec.InstanceId == instanceId {
+			return &spec, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Anti-DDoS Pro instance %s not found", instanceId))
+}
+
+func (client *AliyunClient) WaitForDdoscooInstance(instanceId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeDdoscooInstance(instanceId)
+			if err != nil {
+				// The instance bought by the order is not listed until it is deployed.
+				if NotFoundError(err, "ddoscoo") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return instance, strconv.Itoa(instance.Status), nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeDdoscooWebRule(domain string) (*DdoscooWebRule, error) {
+	args := DescribeDdoscooWebRulesArgs{
+		Domain:     domain,
+		PageNumber: 1,
+		PageSize:   10,
+	}
+	for {
+		resp := DescribeDdoscooWebRulesResponse{}
+		if err := client.ddoscooconn().Invoke("DescribeWebRules", &args, &resp); err != nil {
+			return nil, err
+		}
+
+		// The Domain filters the rules by fuzzy match.
+		for _, rule := range resp.WebRules {
+			if rule.Domain == domain {
+				return &rule, nil
+			}
+		}
+		if args.PageNumber*args.PageSize >= resp.TotalCount {
+			break
+		}
+		args.PageNumber++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Anti-DDoS Pro domain resource %s not found", domain))
+}
+
+func (client *AliyunClient) DescribeDdoscooPort(instanceId string, frontendPort int, frontendProtocol string) (*DdoscooPort, error) {
+	args := DescribeDdoscooPortArgs{
+		InstanceId:       instanceId,
+		FrontendPort:     frontendPort,
+		FrontendProtocol: frontendProtocol,
+		PageNumber:       "1",
+		PageSize:         "10",
+	}
+	resp := DescribeDdoscooPortResponse{}
+	if err := client.ddoscooconn().Invoke("DescribePort", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, port := range resp.NetworkRules {
+		if port.FrontendPort == frontendPort && port.FrontendProtocol == frontendProtocol {
+			return &port, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Anti-DDoS Pro port %d/%s of instance %s not found",
+		frontendPort, frontendProtocol, instanceId))
+}
@@ -0,0 +1,86 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+// DescribeDirectMailDomain returns the domain, which is found in all of the domains, as the DescDomain only
+// returns its DNS records.
+func (client *AliyunClient) DescribeDirectMailDomain(domainId string) (*DirectMailDomain, error) {
+	args := DirectMailPageArgs{
+		PageNo:   1,
+		PageSize: 50,
+	}
+	for {
+		resp := QueryDirectMailDomainsResponse{}
+		if err := client.dmconn().Invoke("QueryDomainByParam", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, domain := range resp.Data.Domain {
+			if domain.DomainId.String() == domainId {
+				return &domain, nil
+			}
+		}
+		if len(resp.Data.Domain) < args.PageSize {
+			break
+		}
+		args.PageNo++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("DirectMail domain %s not found", domainId))
+}
+
+func (client *AliyunClient) DescribeDirectMailDomainRecords(domainId string) (*DirectMailDomainRecords, error) {
+	args := DirectMailDomainArgs{
+		DomainId: domainId,
+	}
+	resp := DirectMailDomainRecords{}
+	if err := client.dmconn().Invoke("DescDomain", &args, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribeDirectMailAddress(mailAddressId string) (*DirectMailAddress, error) {
+	args := DirectMailPageArgs{
+		PageNo:   1,
+		PageSize: 50,
+	}
+	for {
+		resp := QueryDirectMailAddressesResponse{}
+		if err := client.dmconn().Invoke("QueryMailAddressByParam", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, address := range resp.Data.MailAddress {
+			if address.MailAddressId.String() == mailAddressId {
+				return &address, nil
+			}
+		}
+		if len(resp.Data.MailAddress) < args.PageSize {
+			break
+		}
+		args.PageNo++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("DirectMail mail address %s not found", mailAddressId))
+}
+
+func (client *AliyunClient) DescribeDirectMailReceivers(receiverId string) (*DirectMailReceivers, error) {
+	args := QueryDirectMailReceiversArgs{
+		PageSize: 50,
+	}
+	for {
+		resp := QueryDirectMailReceiversResponse{}
+		if err := client.dmconn().Invoke("QueryReceiverByParam", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, receivers := range resp.Data.Receiver {
+			if receivers.ReceiverId.String() == receiverId {
+				return &receivers, nil
+			}
+		}
+		if len(resp.Data.Receiver) < args.PageSize || resp.NextStart == "" || resp.NextStart == args.NextStart {
+			break
+		}
+		args.NextStart = resp.NextStart
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("DirectMail receiver list %s not found", receiverId))
+}
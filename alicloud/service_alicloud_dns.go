@@ -0,0 +1,72 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+func (client *AliyunClient) DescribeDnsGtmInstance(instanceId string) (*DnsGtmInstance, error) {
+	args := DnsGtmInstanceArgs{
+		InstanceId: instanceId,
+	}
+	resp := DnsGtmInstance{}
+	if err := client.dnsconn().Invoke("DescribeDnsGtmInstance", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.InstanceId != instanceId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("GTM instance %s not found", instanceId))
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribeDnsGtmAddressPool(addrPoolId string) (*DnsGtmAddressPool, error) {
+	args := DnsGtmAddrPoolIdArgs{
+		AddrPoolId: addrPoolId,
+	}
+	resp := DnsGtmAddressPool{}
+	if err := client.dnsconn().Invoke("DescribeDnsGtmInstanceAddressPool", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.AddrPoolId != addrPoolId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("GTM address pool %s not found", addrPoolId))
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribeDnsGtmAccessStrategy(strategyId string) (*DnsGtmAccessStrategy, error) {
+	args := DnsGtmStrategyIdArgs{
+		StrategyId: strategyId,
+	}
+	resp := DnsGtmAccessStrategy{}
+	if err := client.dnsconn().Invoke("DescribeDnsGtmAccessStrategy", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.StrategyId != strategyId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("GTM access strategy %s not found", strategyId))
+	}
+	return &resp, nil
+}
+
+// DescribeDnsRecordWeight returns the weight of the record in the weighted round robin of its sub domain.
+func (client *AliyunClient) DescribeDnsRecordWeight(subDomain, recordId string) (int, error) {
+	args := DescribeSubDomainRecordsArgs{
+		SubDomain:  subDomain,
+		PageNumber: 1,
+		PageSize:   100,
+	}
+	for {
+		resp := DescribeSubDomainRecordsResponse{}
+		if err := client.dnsconn().Invoke("DescribeSubDomainRecords", &args, &resp); err != nil {
+			return 0, err
+		}
+		for _, record := range resp.DomainRecords.Record {
+			if record.RecordId == recordId {
+				return record.Weight, nil
+			}
+		}
+		if len(resp.DomainRecords.Record) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+	return 0, GetNotFoundErrorFromString(fmt.Sprintf("record %s of sub domain %s not found", recordId, subDomain))
+}
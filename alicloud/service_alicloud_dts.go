@@ -0,0 +1,42 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+// DescribeDtsJob returns the job of the DTS instance by the job id, or by the instance id when the job id is empty.
+func (client *AliyunClient) DescribeDtsJob(dtsJobId, dtsInstanceId string) (*DtsJobDetail, error) {
+	args := DescribeDtsJobDetailArgs{
+		RegionId:      client.Region,
+		DtsJobId:      dtsJobId,
+		DtsInstanceID: dtsInstanceId,
+	}
+	resp := DescribeDtsJobDetailResponse{}
+	if err := client.dtsconn().Invoke("DescribeDtsJobDetail", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.DtsInstanceID == "" {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("DTS job %s of instance %s not found", dtsJobId, dtsInstanceId))
+	}
+	return &resp.DtsJobDetail, nil
+}
+
+func (client *AliyunClient) WaitForDtsJob(dtsJobId string, statuses []string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			job, err := client.DescribeDtsJob(dtsJobId, "")
+			if err != nil {
+				if NotFoundError(err, "dts") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return job, job.Status, nil
+		},
+		Target:  statuses,
+		Failed:  []string{DtsJobPrecheckFailed, DtsJobInitializeFailed, DtsJobFailed},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"strings"
+	"time"
 )
 
 func (client *AliyunClient) DescribeImage(imageId string) (*ecs.ImageType, error) {
@@ -23,7 +24,7 @@ func (client *AliyunClient) DescribeImage(imageId string) (*ecs.ImageType, error
 	var allImages []ecs.ImageType
 
 	for {
-		images, _, err := client.ecsconn.DescribeImages(&args)
+		images, _, err := client.ecsconn().DescribeImages(&args)
 		if err != nil {
 			break
 		}
@@ -59,7 +60,7 @@ func (client *AliyunClient) DescribeImage(imageId string) (*ecs.ImageType, error
 
 // DescribeZone validate zoneId is valid in region
 func (client *AliyunClient) DescribeZone(zoneID string) (*ecs.ZoneType, error) {
-	zones, err := client.ecsconn.DescribeZones(client.Region)
+	zones, err := client.ecsconn().DescribeZones(client.Region)
 	if err != nil {
 		return nil, fmt.Errorf("error to list zones not found")
 	}
@@ -87,7 +88,7 @@ func (client *AliyunClient) DescribeZone(zoneID string) (*ecs.ZoneType, error) {
 
 // return multiIZ list of current region
 func (client *AliyunClient) DescribeMultiIZByRegion() (izs []string, err error) {
-	resp, err := client.rdsconn.DescribeRegions()
+	resp, err := client.rdsconn().DescribeRegions()
 	if err != nil {
 		return nil, fmt.Errorf("error to list regions not found")
 	}
@@ -114,7 +115,12 @@ func (client *AliyunClient) QueryInstancesByIds(ids []string) (instances []ecs.I
 		InstanceIds: string(idsStr),
 	}
 
-	instances, _, errs := client.ecsconn.DescribeInstances(&args)
+	errs := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		insts, _, err := client.ecsconn().DescribeInstances(&args)
+		instances = append(instances, insts...)
+		return len(insts), err
+	})
 
 	if errs != nil {
 		return nil, errs
@@ -144,15 +150,23 @@ func (client *AliyunClient) QueryInstanceSystemDisk(id string) (disk *ecs.DiskIt
 		InstanceId: string(id),
 		DiskType:   ecs.DiskTypeAllSystem,
 	}
-	disks, _, err := client.ecsconn.DescribeDisks(&args)
+	var disks []ecs.DiskItemType
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		page, _, err := client.ecsconn().DescribeDisks(&args)
+		disks = append(disks, page...)
+		return len(page), err
+	})
 	if err != nil {
 		return nil, err
 	}
-	if len(disks) == 0 {
-		return nil, common.GetClientErrorFromString(SystemDiskNotFound)
+	for _, d := range disks {
+		if d.InstanceId == id {
+			return &d, nil
+		}
 	}
 
-	return &disks[0], nil
+	return nil, common.GetClientErrorFromString(SystemDiskNotFound)
 }
 
 // ResourceAvailable check resource available for zone
@@ -170,6 +184,49 @@ func (client *AliyunClient) ResourceAvailable(zone *ecs.ZoneType, resourceType e
 	return nil
 }
 
+func (client *AliyunClient) DescribeEcsDisk(diskId string) (*EcsDisk, error) {
+	ids, err := json.Marshal([]string{diskId})
+	if err != nil {
+		return nil, err
+	}
+	args := DescribeEcsDisksArgs{
+		RegionId: client.Region,
+		DiskIds:  string(ids),
+	}
+	resp := DescribeEcsDisksResponse{}
+	if err := client.ecsconn().Invoke("DescribeDisks", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, disk := range resp.Disks.Disk {
+		if disk.DiskId == diskId {
+			return &disk, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Disk %s not found", diskId))
+}
+
+// WaitForEcsDisk waits for the disk until the check returns true, such as the disk is available or resized.
+func (client *AliyunClient) WaitForEcsDisk(diskId string, target string, check func(disk *EcsDisk) bool, timeout time.Duration) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			disk, err := client.DescribeEcsDisk(diskId)
+			if err != nil {
+				return nil, "", WrapError(err, "DescribeDisks", diskId)
+			}
+			if check(disk) {
+				return disk, target, nil
+			}
+			return disk, string(disk.Status), nil
+		},
+		Target:  []string{target},
+		Timeout: timeout,
+	}
+	if _, err := waiter.Wait(); err != nil {
+		return fmt.Errorf("Waiting for disk %s to be %s got an error: %s", diskId, target, err)
+	}
+	return nil
+}
+
 func (client *AliyunClient) DiskAvailable(zone *ecs.ZoneType, diskCategory ecs.DiskCategory) error {
 	available := false
 	for _, dist := range zone.AvailableDiskCategories.DiskCategories {
@@ -186,10 +243,9 @@ func (client *AliyunClient) DiskAvailable(zone *ecs.ZoneType, diskCategory ecs.D
 // todo: support syc
 func (client *AliyunClient) JoinSecurityGroups(instanceId string, securityGroupIds []string) error {
 	for _, sid := range securityGroupIds {
-		err := client.ecsconn.JoinSecurityGroup(instanceId, sid)
+		err := client.ecsconn().JoinSecurityGroup(instanceId, sid)
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code != InvalidInstanceIdAlreadyExists {
+			if !IsExceptedError(err, InvalidInstanceIdAlreadyExists) {
 				return err
 			}
 		}
@@ -200,10 +256,9 @@ func (client *AliyunClient) JoinSecurityGroups(instanceId string, securityGroupI
 
 func (client *AliyunClient) LeaveSecurityGroups(instanceId string, securityGroupIds []string) error {
 	for _, sid := range securityGroupIds {
-		err := client.ecsconn.LeaveSecurityGroup(instanceId, sid)
+		err := client.ecsconn().LeaveSecurityGroup(instanceId, sid)
 		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code != InvalidSecurityGroupIdNotFound {
+			if !NotFoundError(err, "ecs") {
 				return err
 			}
 		}
@@ -219,7 +274,35 @@ func (client *AliyunClient) DescribeSecurity(securityGroupId string) (*ecs.Descr
 		SecurityGroupId: securityGroupId,
 	}
 
-	return client.ecsconn.DescribeSecurityGroupAttribute(args)
+	return client.ecsconn().DescribeSecurityGroupAttribute(args)
+}
+
+// DescribeDefaultSecurityGroupId returns the id of the security group created by the system in the VPC.
+func (client *AliyunClient) DescribeDefaultSecurityGroupId(vpcId string) (string, error) {
+	var groupId string
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		groups, _, err := client.ecsconn().DescribeSecurityGroups(&ecs.DescribeSecurityGroupsArgs{
+			RegionId:   client.Region,
+			VpcId:      vpcId,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, group := range groups {
+			if groupId == "" && group.Description == DefaultSecurityGroupDescription {
+				groupId = group.SecurityGroupId
+			}
+		}
+		return len(groups), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if groupId == "" {
+		return "", GetNotFoundErrorFromString(fmt.Sprintf("The default security group of VPC %s not found", vpcId))
+	}
+	return groupId, nil
 }
 
 func (client *AliyunClient) DescribeSecurityByAttr(securityGroupId, direction, nicType string) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
@@ -231,7 +314,7 @@ func (client *AliyunClient) DescribeSecurityByAttr(securityGroupId, direction, n
 		NicType:         ecs.NicType(nicType),
 	}
 
-	return client.ecsconn.DescribeSecurityGroupAttribute(args)
+	return client.ecsconn().DescribeSecurityGroupAttribute(args)
 }
 
 func (client *AliyunClient) DescribeSecurityGroupRule(securityGroupId, direction, nicType, ipProtocol, portRange string) (*ecs.PermissionType, error) {
@@ -251,18 +334,18 @@ func (client *AliyunClient) DescribeSecurityGroupRule(securityGroupId, direction
 
 func (client *AliyunClient) RevokeSecurityGroup(args *ecs.RevokeSecurityGroupArgs) error {
 	//when the rule is not exist, api will return success(200)
-	return client.ecsconn.RevokeSecurityGroup(args)
+	return client.ecsconn().RevokeSecurityGroup(args)
 }
 
 func (client *AliyunClient) RevokeSecurityGroupEgress(args *ecs.RevokeSecurityGroupEgressArgs) error {
 	//when the rule is not exist, api will return success(200)
-	return client.ecsconn.RevokeSecurityGroupEgress(args)
+	return client.ecsconn().RevokeSecurityGroupEgress(args)
 }
 
 func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta interface{}) (map[ResourceKeyType]interface{}, error) {
 	// Before creating resources, check input parameters validity according available zone.
 	// If availability zone is nil, it will return all of supported resources in the current.
-	conn := meta.(*AliyunClient).ecsconn
+	conn := meta.(*AliyunClient).ecsconn()
 	zones, err := conn.DescribeZones(getRegion(d, meta))
 	if err != nil {
 		return nil, fmt.Errorf("Error DescribeZone: %#v", err)
@@ -495,7 +578,7 @@ func (client *AliyunClient) FetchSpecifiedInstanceTypeFamily(regionId common.Reg
 	// Describe specified series instance type families
 	outdatedFamiliesMap := make(map[string]ecs.InstanceTypeFamily)
 	upgradedFamiliesMap := make(map[string]ecs.InstanceTypeFamily)
-	response, err := client.ecsconn.DescribeInstanceTypeFamilies(&ecs.DescribeInstanceTypeFamiliesArgs{
+	response, err := client.ecsconn().DescribeInstanceTypeFamilies(&ecs.DescribeInstanceTypeFamiliesArgs{
 		RegionId: regionId,
 	})
 	if err != nil {
@@ -541,7 +624,7 @@ func (client *AliyunClient) FetchSpecifiedInstanceTypeFamily(regionId common.Reg
 
 func (client *AliyunClient) FetchSpecifiedInstanceTypesByFamily(zoneId, instanceTypeFamily string, all_zones []ecs.ZoneType) (map[string]ecs.InstanceTypeItemType, error) {
 	// Describe all instance types of specified families
-	types, err := client.ecsconn.DescribeInstanceTypesNew(&ecs.DescribeInstanceTypesArgs{
+	types, err := client.ecsconn().DescribeInstanceTypesNew(&ecs.DescribeInstanceTypesArgs{
 		InstanceTypeFamily: instanceTypeFamily,
 	})
 	if err != nil {
@@ -577,19 +660,18 @@ func (client *AliyunClient) QueryInstancesWithKeyPair(region common.Region, inst
 	var instance_ids []interface{}
 	var instanceList []ecs.InstanceAttributesType
 
-	conn := client.ecsconn
+	conn := client.ecsconn()
 	args := &ecs.DescribeInstancesArgs{
 		RegionId: region,
 	}
-	pagination := getPagination(1, 50)
-	for true {
-		if instanceIds != "" {
-			args.InstanceIds = instanceIds
-		}
+	if instanceIds != "" {
+		args.InstanceIds = instanceIds
+	}
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
 		args.Pagination = pagination
 		instances, _, err := conn.DescribeInstances(args)
 		if err != nil {
-			return nil, nil, fmt.Errorf("Error DescribeInstances: %#v", err)
+			return 0, fmt.Errorf("Error DescribeInstances: %#v", err)
 		}
 		for _, inst := range instances {
 			if inst.KeyPairName == keypair {
@@ -597,10 +679,130 @@ func (client *AliyunClient) QueryInstancesWithKeyPair(region common.Region, inst
 				instanceList = append(instanceList, inst)
 			}
 		}
-		if len(instances) < pagination.PageSize {
+		return len(instances), nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return instance_ids, instanceList, nil
+}
+
+func (client *AliyunClient) DescribeEcsDeploymentSet(deploymentSetId string) (*EcsDeploymentSet, error) {
+	ids, err := json.Marshal([]string{deploymentSetId})
+	if err != nil {
+		return nil, err
+	}
+	args := DescribeEcsDeploymentSetsArgs{
+		RegionId:         client.Region,
+		DeploymentSetIds: string(ids),
+	}
+	resp := DescribeEcsDeploymentSetsResponse{}
+	if err := client.ecsconn().Invoke("DescribeDeploymentSets", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, set := range resp.DeploymentSets.DeploymentSet {
+		if set.DeploymentSetId == deploymentSetId {
+			return &set, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Deployment set %s not found", deploymentSetId))
+}
+
+// DescribeInstanceDeploymentSetId returns the id of the deployment set of the instance, which is empty when the
+// instance is not in any deployment set.
+func (client *AliyunClient) DescribeInstanceDeploymentSetId(instanceId string) (string, error) {
+	ids, err := json.Marshal([]string{instanceId})
+	if err != nil {
+		return "", err
+	}
+	args := DescribeInstanceDeploymentSetArgs{
+		RegionId:    client.Region,
+		InstanceIds: string(ids),
+	}
+	resp := DescribeInstanceDeploymentSetResponse{}
+	if err := client.ecsconn().Invoke("DescribeInstances", &args, &resp); err != nil {
+		return "", err
+	}
+	for _, instance := range resp.Instances.Instance {
+		if instance.InstanceId == instanceId {
+			return instance.DeploymentSetId, nil
+		}
+	}
+	return "", GetNotFoundErrorFromString(fmt.Sprintf("Instance %s not found", instanceId))
+}
+
+func (client *AliyunClient) DescribeEcsCommand(commandId string) (*EcsCommand, error) {
+	args := EcsCommandArgs{
+		RegionId:  client.Region,
+		CommandId: commandId,
+	}
+	resp := DescribeEcsCommandsResponse{}
+	if err := client.ecsconn().Invoke("DescribeCommands", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, command := range resp.Commands.Command {
+		if command.CommandId == commandId {
+			return &command, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Cloud Assistant command %s not found", commandId))
+}
+
+func (client *AliyunClient) DescribeEcsInvocation(invokeId string) (*EcsInvocation, error) {
+	args := EcsInvocationArgs{
+		RegionId: client.Region,
+		InvokeId: invokeId,
+	}
+	resp := DescribeEcsInvocationsResponse{}
+	if err := client.ecsconn().Invoke("DescribeInvocations", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, invocation := range resp.Invocations.Invocation {
+		if invocation.InvokeId == invokeId {
+			return &invocation, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Cloud Assistant invocation %s not found", invokeId))
+}
+
+// DescribeEcsInvocationResults returns the results of the invocation on all the instances.
+func (client *AliyunClient) DescribeEcsInvocationResults(invokeId string) ([]EcsInvocationResult, error) {
+	args := EcsInvocationArgs{
+		RegionId:   client.Region,
+		InvokeId:   invokeId,
+		PageNumber: 1,
+		PageSize:   50,
+	}
+	var results []EcsInvocationResult
+	for {
+		resp := DescribeEcsInvocationResultsResponse{}
+		if err := client.ecsconn().Invoke("DescribeInvocationResults", &args, &resp); err != nil {
+			return nil, err
+		}
+		results = append(results, resp.Invocation.InvocationResults.InvocationResult...)
+		if len(resp.Invocation.InvocationResults.InvocationResult) < args.PageSize || len(results) >= resp.Invocation.TotalCount {
 			break
 		}
-		pagination.PageNumber += 1
+		args.PageNumber++
 	}
-	return instance_ids, instanceList, nil
+	return results, nil
+}
+
+func (client *AliyunClient) WaitForEcsInvocation(invokeId string, statuses []string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			invocation, err := client.DescribeEcsInvocation(invokeId)
+			if err != nil {
+				if NotFoundError(err, "ecs") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return invocation, invocation.InvocationStatus, nil
+		},
+		Target:  statuses,
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
 }
@@ -0,0 +1,64 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+func (client *AliyunClient) DescribeEmrCluster(clusterId string) (*EmrCluster, error) {
+	args := EmrClusterArgs{
+		RegionId: client.Region,
+		Id:       clusterId,
+	}
+	resp := DescribeEmrClusterResponse{}
+	if err := client.emrconn().Invoke("DescribeClusterV2", &args, &resp); err != nil {
+		return nil, err
+	}
+	// The released clusters are still returned for a while.
+	if resp.ClusterInfo.Id != clusterId || resp.ClusterInfo.Status == EmrClusterReleased {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("EMR cluster %s not found", clusterId))
+	}
+	return &resp.ClusterInfo, nil
+}
+
+func (client *AliyunClient) DescribeEmrClusterHostGroups(clusterId string) ([]EmrHostGroupInfo, error) {
+	args := ListEmrClusterHostGroupArgs{
+		RegionId:   client.Region,
+		ClusterId:  clusterId,
+		PageNumber: 1,
+		PageSize:   50,
+	}
+	var groups []EmrHostGroupInfo
+	for {
+		resp := ListEmrClusterHostGroupResponse{}
+		if err := client.emrconn().Invoke("ListClusterHostGroup", &args, &resp); err != nil {
+			return nil, err
+		}
+		groups = append(groups, resp.HostGroupList.HostGroup...)
+		if len(resp.HostGroupList.HostGroup) < args.PageSize || len(groups) >= resp.TotalCount {
+			break
+		}
+		args.PageNumber++
+	}
+	return groups, nil
+}
+
+// WaitForEmrCluster waits for the cluster to be IDLE or RUNNING, which means that it is created or resized.
+func (client *AliyunClient) WaitForEmrCluster(clusterId string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := client.DescribeEmrCluster(clusterId)
+			if err != nil {
+				if NotFoundError(err, "emr") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return cluster, cluster.Status, nil
+		},
+		Target:  []string{EmrClusterIdle, EmrClusterRunning},
+		Failed:  []string{EmrClusterCreateFailed, EmrClusterAbnormal},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
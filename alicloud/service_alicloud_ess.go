@@ -1,6 +1,9 @@
 package alicloud
 
 import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ess"
 )
 
@@ -10,16 +13,41 @@ func (client *AliyunClient) DescribeScalingGroupById(sgId string) (*ess.ScalingG
 		ScalingGroupId: []string{sgId},
 	}
 
-	sgs, _, err := client.essconn.DescribeScalingGroups(&args)
+	var sgs []ess.ScalingGroupItemType
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		page, _, err := client.essconn().DescribeScalingGroups(&args)
+		sgs = append(sgs, page...)
+		return len(page), err
+	})
 	if err != nil {
 		return nil, err
 	}
+	for _, sg := range sgs {
+		if sg.ScalingGroupId == sgId {
+			return &sg, nil
+		}
+	}
+
+	return nil, GetNotFoundErrorFromString("Scaling group not found")
+}
 
-	if len(sgs) == 0 {
+func (client *AliyunClient) DescribeScalingGroupLaunchTemplate(sgId string) (*EssScalingGroupLaunchTemplate, error) {
+	args := DescribeEssScalingGroupsArgs{
+		RegionId:       client.Region,
+		ScalingGroupId: []string{sgId},
+	}
+
+	resp := DescribeEssScalingGroupsResponse{}
+	if err := client.essconn().Invoke("DescribeScalingGroups", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.ScalingGroups.ScalingGroup) == 0 {
 		return nil, GetNotFoundErrorFromString("Scaling group not found")
 	}
 
-	return &sgs[0], nil
+	return &resp.ScalingGroups.ScalingGroup[0], nil
 }
 
 func (client *AliyunClient) DeleteScalingGroupById(sgId string) error {
@@ -28,27 +56,44 @@ func (client *AliyunClient) DeleteScalingGroupById(sgId string) error {
 		ForceDelete:    true,
 	}
 
-	_, err := client.essconn.DeleteScalingGroup(&args)
+	_, err := client.essconn().DeleteScalingGroup(&args)
 	return err
 }
 
-func (client *AliyunClient) DescribeScalingConfigurationById(sgId, configId string) (*ess.ScalingConfigurationItemType, error) {
-	args := ess.DescribeScalingConfigurationsArgs{
+func (client *AliyunClient) DescribeScalingConfigurationById(sgId, configId string) (*EssScalingConfiguration, error) {
+	args := DescribeEssScalingConfigurationsArgs{
 		RegionId:               client.Region,
 		ScalingGroupId:         sgId,
 		ScalingConfigurationId: []string{configId},
 	}
 
-	cs, _, err := client.essconn.DescribeScalingConfigurations(&args)
-	if err != nil {
+	resp := DescribeEssScalingConfigurationsResponse{}
+	if err := client.essconn().Invoke("DescribeScalingConfigurations", &args, &resp); err != nil {
 		return nil, err
 	}
 
-	if len(cs) == 0 {
+	if len(resp.ScalingConfigurations.ScalingConfiguration) == 0 {
 		return nil, GetNotFoundErrorFromString("Scaling configuration not found")
 	}
 
-	return &cs[0], nil
+	return &resp.ScalingConfigurations.ScalingConfiguration[0], nil
+}
+
+// DescribeScalingConfigurationsByGroup returns all of the scaling configurations in the scaling group.
+// One scaling group has 10 configurations at most, so there is no need to fetch more pages.
+func (client *AliyunClient) DescribeScalingConfigurationsByGroup(sgId string) ([]EssScalingConfiguration, error) {
+	args := DescribeEssScalingConfigurationsArgs{
+		RegionId:       client.Region,
+		ScalingGroupId: sgId,
+		Pagination:     getPagination(1, 50),
+	}
+
+	resp := DescribeEssScalingConfigurationsResponse{}
+	if err := client.essconn().Invoke("DescribeScalingConfigurations", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.ScalingConfigurations.ScalingConfiguration, nil
 }
 
 func (client *AliyunClient) ActiveScalingConfigurationById(sgId, configId string) error {
@@ -57,7 +102,7 @@ func (client *AliyunClient) ActiveScalingConfigurationById(sgId, configId string
 		ActiveScalingConfigurationId: configId,
 	}
 
-	_, err := client.essconn.ModifyScalingGroup(&args)
+	_, err := client.essconn().ModifyScalingGroup(&args)
 	return err
 }
 
@@ -71,7 +116,7 @@ func (client *AliyunClient) EnableScalingConfigurationById(sgId, configId string
 		args.InstanceId = ids
 	}
 
-	_, err := client.essconn.EnableScalingGroup(&args)
+	_, err := client.essconn().EnableScalingGroup(&args)
 	return err
 }
 
@@ -80,7 +125,7 @@ func (client *AliyunClient) DisableScalingConfigurationById(sgId string) error {
 		ScalingGroupId: sgId,
 	}
 
-	_, err := client.essconn.DisableScalingGroup(&args)
+	_, err := client.essconn().DisableScalingGroup(&args)
 	return err
 }
 
@@ -90,7 +135,7 @@ func (client *AliyunClient) DeleteScalingConfigurationById(sgId, configId string
 		ScalingConfigurationId: configId,
 	}
 
-	_, err := client.essconn.DeleteScalingConfiguration(&args)
+	_, err := client.essconn().DeleteScalingConfiguration(&args)
 	return err
 }
 
@@ -109,23 +154,23 @@ func flattenDataDiskMappings(list []ess.DataDiskItemType) []map[string]interface
 	return result
 }
 
-func (client *AliyunClient) DescribeScalingRuleById(sgId, ruleId string) (*ess.ScalingRuleItemType, error) {
-	args := ess.DescribeScalingRulesArgs{
+func (client *AliyunClient) DescribeScalingRuleById(sgId, ruleId string) (*EssScalingRule, error) {
+	args := DescribeEssScalingRulesArgs{
 		RegionId:       client.Region,
 		ScalingGroupId: sgId,
 		ScalingRuleId:  []string{ruleId},
 	}
 
-	cs, _, err := client.essconn.DescribeScalingRules(&args)
-	if err != nil {
+	resp := DescribeEssScalingRulesResponse{}
+	if err := client.essconn().Invoke("DescribeScalingRules", &args, &resp); err != nil {
 		return nil, err
 	}
 
-	if len(cs) == 0 {
+	if len(resp.ScalingRules.ScalingRule) == 0 {
 		return nil, GetNotFoundErrorFromString("Scaling rule not found")
 	}
 
-	return &cs[0], nil
+	return &resp.ScalingRules.ScalingRule[0], nil
 }
 
 func (client *AliyunClient) DeleteScalingRuleById(ruleId string) error {
@@ -134,7 +179,7 @@ func (client *AliyunClient) DeleteScalingRuleById(ruleId string) error {
 		ScalingRuleId: ruleId,
 	}
 
-	_, err := client.essconn.DeleteScalingRule(&args)
+	_, err := client.essconn().DeleteScalingRule(&args)
 	return err
 }
 
@@ -144,7 +189,7 @@ func (client *AliyunClient) DescribeScheduleById(scheduleId string) (*ess.Schedu
 		ScheduledTaskId: []string{scheduleId},
 	}
 
-	cs, _, err := client.essconn.DescribeScheduledTasks(&args)
+	cs, _, err := client.essconn().DescribeScheduledTasks(&args)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +207,72 @@ func (client *AliyunClient) DeleteScheduleById(scheduleId string) error {
 		ScheduledTaskId: scheduleId,
 	}
 
-	_, err := client.essconn.DeleteScheduledTask(&args)
+	_, err := client.essconn().DeleteScheduledTask(&args)
 	return err
 }
+
+// DescribeScalingInstancesByType returns the instances of the scaling group filtered by creation type.
+func (client *AliyunClient) DescribeScalingInstancesByType(sgId string, creationType EssScalingInstanceCreationType) ([]EssScalingInstance, error) {
+	args := DescribeEssScalingInstancesArgs{
+		RegionId:       client.Region,
+		ScalingGroupId: sgId,
+		CreationType:   creationType,
+	}
+
+	var instances []EssScalingInstance
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		resp := DescribeEssScalingInstancesResponse{}
+		if err := client.essconn().Invoke("DescribeScalingInstances", &args, &resp); err != nil {
+			return 0, err
+		}
+		instances = append(instances, resp.ScalingInstances.ScalingInstance...)
+		return len(resp.ScalingInstances.ScalingInstance), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+func (client *AliyunClient) DescribeEssNotificationById(sgId, arn string) (*EssNotificationConfiguration, error) {
+	args := DescribeEssNotificationConfigurationsArgs{
+		RegionId:       client.Region,
+		ScalingGroupId: sgId,
+	}
+
+	resp := DescribeEssNotificationConfigurationsResponse{}
+	if err := client.essconn().Invoke("DescribeNotificationConfigurations", &args, &resp); err != nil {
+		if NotFoundError(err, "ess") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Scaling group %s not found", sgId))
+		}
+		return nil, err
+	}
+
+	for _, n := range resp.NotificationConfigurationModels.NotificationConfigurationModel {
+		if n.NotificationArn == arn {
+			return &n, nil
+		}
+	}
+
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Notification %s of scaling group %s not found", arn, sgId))
+}
+
+func (client *AliyunClient) DescribeEssAlarmById(alarmId string) (*EssAlarm, error) {
+	args := EssAlarmArgs{
+		RegionId:    client.Region,
+		AlarmTaskId: alarmId,
+	}
+
+	resp := DescribeEssAlarmsResponse{}
+	if err := client.essconn().Invoke("DescribeAlarms", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.AlarmList.Alarm) == 0 {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("ESS alarm %s not found", alarmId))
+	}
+
+	return &resp.AlarmList.Alarm[0], nil
+}
@@ -0,0 +1,83 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+type eventBridgeResult interface {
+	result() *EventBridgeResponse
+}
+
+func (resp *EventBridgeResponse) result() *EventBridgeResponse {
+	return resp
+}
+
+// invokeEventBridge invokes the action and converts the failed result, whose http status is OK, into an error.
+func (client *AliyunClient) invokeEventBridge(action string, args interface{}, resp eventBridgeResult) error {
+	if err := client.eventbridgeconn().Invoke(action, args, resp); err != nil {
+		return err
+	}
+	if r := resp.result(); !r.Success {
+		return &common.Error{
+			ErrorResponse: common.ErrorResponse{
+				Response: r.Response,
+				Code:     r.Code,
+				Message:  r.Message,
+			},
+		}
+	}
+	return nil
+}
+
+func (client *AliyunClient) DescribeEventBus(name string) (*EventBus, error) {
+	args := EventBusArgs{
+		EventBusName: name,
+	}
+	resp := DescribeEventBusResponse{}
+	if err := client.invokeEventBridge("GetEventBus", &args, &resp); err != nil {
+		if NotFoundError(err, "eventbridge") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Event bus %s not found", name))
+		}
+		return nil, err
+	}
+
+	if resp.Data.EventBusName != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Event bus %s not found", name))
+	}
+	return &resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeEventRule(busName, ruleName string) (*EventRule, error) {
+	args := EventRuleArgs{
+		EventBusName: busName,
+		RuleName:     ruleName,
+	}
+	resp := DescribeEventRuleResponse{}
+	if err := client.invokeEventBridge("GetRule", &args, &resp); err != nil {
+		if NotFoundError(err, "eventbridge") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Event rule %s of bus %s not found", ruleName, busName))
+		}
+		return nil, err
+	}
+
+	if resp.Data.RuleName != ruleName {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Event rule %s of bus %s not found", ruleName, busName))
+	}
+	return &resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeEventSource(name string) (*EventSource, error) {
+	resp := DescribeEventSourcesResponse{}
+	if err := client.invokeEventBridge("ListUserDefinedEventSources", &EventSourceArgs{}, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, source := range resp.Data.EventSourceList {
+		if source.Name == name {
+			return &source, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Event source %s not found", name))
+}
@@ -0,0 +1,138 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (client *AliyunClient) invokeFc(method, resource string, body interface{}, resp interface{}) error {
+	if client.fcconn().AccountId == "" {
+		accountId, err := client.DescribeAccountId()
+		if err != nil {
+			return err
+		}
+		client.fcconn().AccountId = accountId
+	}
+	return client.fcconn().Invoke(method, resource, body, resp)
+}
+
+func (client *AliyunClient) DescribeFcService(name string) (*FcService, error) {
+	service := FcService{}
+	if err := client.invokeFc(http.MethodGet, fmt.Sprintf("/services/%s", name), nil, &service); err != nil {
+		if NotFoundError(err, "fc") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("FC service %s not found", name))
+		}
+		return nil, err
+	}
+
+	if service.ServiceName != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("FC service %s not found", name))
+	}
+	return &service, nil
+}
+
+func (client *AliyunClient) DescribeFcFunction(service, name string) (*FcFunction, error) {
+	function := FcFunction{}
+	if err := client.invokeFc(http.MethodGet, fmt.Sprintf("/services/%s/functions/%s", service, name), nil, &function); err != nil {
+		if NotFoundError(err, "fc") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("FC function %s of service %s not found", name, service))
+		}
+		return nil, err
+	}
+
+	if function.FunctionName != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("FC function %s of service %s not found", name, service))
+	}
+	return &function, nil
+}
+
+func (client *AliyunClient) DescribeFcTrigger(service, function, name string) (*FcTrigger, error) {
+	trigger := FcTrigger{}
+	path := fmt.Sprintf("/services/%s/functions/%s/triggers/%s", service, function, name)
+	if err := client.invokeFc(http.MethodGet, path, nil, &trigger); err != nil {
+		if NotFoundError(err, "fc") ||
+			NotFoundError(err, "fc") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("FC trigger %s of function %s not found", name, function))
+		}
+		return nil, err
+	}
+
+	if trigger.TriggerName != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("FC trigger %s of function %s not found", name, function))
+	}
+	return &trigger, nil
+}
+
+func (client *AliyunClient) DescribeFcCustomDomain(name string) (*FcCustomDomain, error) {
+	domain := FcCustomDomain{}
+	if err := client.invokeFc(http.MethodGet, fmt.Sprintf("/custom-domains/%s", name), nil, &domain); err != nil {
+		if NotFoundError(err, "fc") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("FC custom domain %s not found", name))
+		}
+		return nil, err
+	}
+
+	if domain.DomainName != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("FC custom domain %s not found", name))
+	}
+	return &domain, nil
+}
+
+// DescribeFcProvisionConfig returns the provisioned concurrency of the function version or alias, and the
+// function without any provisioned instance is regarded as not found.
+func (client *AliyunClient) DescribeFcProvisionConfig(service, qualifier, function string) (*FcProvisionConfig, error) {
+	config := FcProvisionConfig{}
+	path := fmt.Sprintf("/services/%s.%s/functions/%s/provision-config", service, qualifier, function)
+	if err := client.invokeFc(http.MethodGet, path, nil, &config); err != nil {
+		if NotFoundError(err, "fc") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Provision config of FC function %s not found", function))
+		}
+		return nil, err
+	}
+
+	if config.Target == 0 {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Provision config of FC function %s not found", function))
+	}
+	return &config, nil
+}
+
+func (client *AliyunClient) DescribeFcOnDemandConfig(service, qualifier, function string) (*FcOnDemandConfig, error) {
+	config := FcOnDemandConfig{}
+	path := fmt.Sprintf("/services/%s.%s/functions/%s/on-demand-config", service, qualifier, function)
+	if err := client.invokeFc(http.MethodGet, path, nil, &config); err != nil {
+		if NotFoundError(err, "fc") ||
+			NotFoundError(err, "fc") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("On-demand config of FC function %s not found", function))
+		}
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (client *AliyunClient) DescribeFcLayerVersion(name, version string) (*FcLayer, error) {
+	layer := FcLayer{}
+	if err := client.invokeFc(http.MethodGet, fmt.Sprintf("/layers/%s/versions/%s", name, version), nil, &layer); err != nil {
+		if NotFoundError(err, "fc") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Version %s of FC layer %s not found", version, name))
+		}
+		return nil, err
+	}
+
+	if layer.LayerName != name || fmt.Sprint(layer.Version) != version {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Version %s of FC layer %s not found", version, name))
+	}
+	return &layer, nil
+}
+
+func (client *AliyunClient) DescribeFcAsyncInvokeConfig(service, qualifier, function string) (*FcAsyncInvokeConfig, error) {
+	config := FcAsyncInvokeConfig{}
+	path := fmt.Sprintf("/services/%s.%s/functions/%s/async-invoke-config", service, qualifier, function)
+	if err := client.invokeFc(http.MethodGet, path, nil, &config); err != nil {
+		if NotFoundError(err, "fc") ||
+			NotFoundError(err, "fc") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Async invoke config of FC function %s not found", function))
+		}
+		return nil, err
+	}
+	return &config, nil
+}
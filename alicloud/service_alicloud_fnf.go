@@ -0,0 +1,34 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+func (client *AliyunClient) DescribeFnfFlow(name string) (*FnfFlowResponse, error) {
+	args := FnfFlowArgs{
+		Name: name,
+	}
+	resp := FnfFlowResponse{}
+	if err := client.fnfconn().Invoke("DescribeFlow", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Name != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Serverless Workflow flow %s not found", name))
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribeFnfSchedule(flowName, scheduleName string) (*FnfScheduleResponse, error) {
+	args := FnfScheduleArgs{
+		FlowName:     flowName,
+		ScheduleName: scheduleName,
+	}
+	resp := FnfScheduleResponse{}
+	if err := client.fnfconn().Invoke("DescribeSchedule", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.ScheduleName != scheduleName {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Serverless Workflow schedule %s of flow %s not found", scheduleName, flowName))
+	}
+	return &resp, nil
+}
@@ -0,0 +1,39 @@
+package alicloud
+
+import "fmt"
+
+func (client *AliyunClient) DescribeHBaseInstanceById(id string) (*HBaseInstance, error) {
+	args := HBaseInstanceArgs{
+		RegionId:  client.Region,
+		ClusterId: id,
+	}
+	resp := DescribeHBaseInstanceResponse{}
+	if err := client.hbaseconn().Invoke("DescribeInstance", &args, &resp); err != nil {
+		if NotFoundError(err, "hbase") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("HBase instance %s not found", id))
+		}
+		return nil, err
+	}
+
+	if resp.InstanceId != id {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("HBase instance %s not found", id))
+	}
+
+	return &resp.HBaseInstance, nil
+}
+
+func (client *AliyunClient) WaitForHBaseInstance(id string, status HBaseInstanceStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeHBaseInstanceById(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return instance, string(instance.Status), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
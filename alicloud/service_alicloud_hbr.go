@@ -0,0 +1,100 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+func (client *AliyunClient) DescribeHbrVault(vaultId string) (*HbrVault, error) {
+	args := HbrVaultArgs{
+		VaultId:       vaultId,
+		VaultRegionId: client.Region,
+		PageNumber:    1,
+		PageSize:      10,
+	}
+	resp := DescribeHbrVaultsResponse{}
+	if err := client.hbrconn().Invoke("DescribeVaults", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, vault := range resp.Vaults.Vault {
+		if vault.VaultId == vaultId {
+			return &vault, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("HBR vault %s not found", vaultId))
+}
+
+func (client *AliyunClient) WaitForHbrVault(vaultId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			vault, err := client.DescribeHbrVault(vaultId)
+			if err != nil {
+				if NotFoundError(err, "hbr") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return vault, vault.Status, nil
+		},
+		Target:  []string{status},
+		Failed:  []string{HbrVaultError},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeHbrBackupPlan(planId, sourceType string) (*HbrBackupPlan, error) {
+	args := DescribeHbrBackupPlansArgs{
+		SourceType: sourceType,
+		Filters:    []HbrFilter{{Key: "planId", Values: []string{planId}}},
+		PageNumber: 1,
+		PageSize:   10,
+	}
+	resp := DescribeHbrBackupPlansResponse{}
+	if err := client.hbrconn().Invoke("DescribeBackupPlans", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, plan := range resp.BackupPlans.BackupPlan {
+		if plan.PlanId == planId {
+			return &plan, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("HBR %s backup plan %s not found", sourceType, planId))
+}
+
+func (client *AliyunClient) DescribeHbrRestoreJob(restoreId, restoreType string) (*HbrRestoreJob, error) {
+	args := DescribeHbrRestoreJobsArgs{
+		RestoreType: restoreType,
+		Filters:     []HbrFilter{{Key: "restoreId", Values: []string{restoreId}}},
+	}
+	resp := DescribeHbrRestoreJobsResponse{}
+	if err := client.hbrconn().Invoke("DescribeRestoreJobs2", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, job := range resp.RestoreJobs.RestoreJob {
+		if job.RestoreId == restoreId {
+			return &job, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("HBR restore job %s not found", restoreId))
+}
+
+func (client *AliyunClient) WaitForHbrRestoreJob(restoreId, restoreType string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			job, err := client.DescribeHbrRestoreJob(restoreId, restoreType)
+			if err != nil {
+				if NotFoundError(err, "hbr") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return job, job.Status, nil
+		},
+		Target:  []string{HbrRestoreJobComplete, HbrRestoreJobPartialComplete},
+		Failed:  []string{HbrRestoreJobFailed, HbrRestoreJobCanceled},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
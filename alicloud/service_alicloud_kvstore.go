@@ -0,0 +1,132 @@
+package alicloud
+
+import "fmt"
+
+func (client *AliyunClient) DescribeKVStoreInstanceById(id string) (*KVStoreInstanceAttribute, error) {
+	args := KVStoreInstanceArgs{
+		RegionId:   client.Region,
+		InstanceId: id,
+	}
+	resp := DescribeKVStoreInstanceAttributeResponse{}
+	if err := client.kvstoreconn().Invoke("DescribeInstanceAttribute", &args, &resp); err != nil {
+		if NotFoundError(err, "kvstore") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("KVStore instance %s not found", id))
+		}
+		return nil, err
+	}
+
+	if len(resp.Instances.DBInstanceAttribute) <= 0 {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("KVStore instance %s not found", id))
+	}
+
+	return &resp.Instances.DBInstanceAttribute[0], nil
+}
+
+func (client *AliyunClient) DescribeKVStoreBackupPolicy(id string) (*KVStoreBackupPolicy, error) {
+	args := KVStoreInstanceArgs{
+		RegionId:   client.Region,
+		InstanceId: id,
+	}
+	resp := DescribeKVStoreBackupPolicyResponse{}
+	if err := client.kvstoreconn().Invoke("DescribeBackupPolicy", &args, &resp); err != nil {
+		if NotFoundError(err, "kvstore") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("KVStore instance %s not found", id))
+		}
+		return nil, err
+	}
+
+	return &resp.KVStoreBackupPolicy, nil
+}
+
+func (client *AliyunClient) DescribeKVStoreAccount(instanceId, accountName string) (*KVStoreAccount, error) {
+	args := KVStoreAccountArgs{
+		RegionId:    client.Region,
+		InstanceId:  instanceId,
+		AccountName: accountName,
+	}
+	resp := DescribeKVStoreAccountsResponse{}
+	if err := client.kvstoreconn().Invoke("DescribeAccounts", &args, &resp); err != nil {
+		if NotFoundError(err, "kvstore") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("KVStore instance %s not found", instanceId))
+		}
+		return nil, err
+	}
+
+	for _, account := range resp.Accounts.Account {
+		if account.AccountName == accountName {
+			return &account, nil
+		}
+	}
+
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("KVStore account %s not found in the instance %s", accountName, instanceId))
+}
+
+func (client *AliyunClient) DescribeKVStoreNetInfo(instanceId string) ([]KVStoreNetInfo, error) {
+	args := KVStoreInstanceArgs{
+		RegionId:   client.Region,
+		InstanceId: instanceId,
+	}
+	resp := DescribeKVStoreNetInfoResponse{}
+	if err := client.kvstoreconn().Invoke("DescribeDBInstanceNetInfo", &args, &resp); err != nil {
+		if NotFoundError(err, "kvstore") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("KVStore instance %s not found", instanceId))
+		}
+		return nil, err
+	}
+
+	return resp.NetInfoItems.InstanceNetInfo, nil
+}
+
+// DescribeKVStorePublicConnection returns the public connection of the instance,
+// and returns a not found error when the instance does not allocate one.
+func (client *AliyunClient) DescribeKVStorePublicConnection(instanceId string) (*KVStoreNetInfo, error) {
+	infos, err := client.DescribeKVStoreNetInfo(instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if info.DBInstanceNetType == KVStoreNetTypeInternet {
+			return &info, nil
+		}
+	}
+
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("KVStore instance %s public connection not found", instanceId))
+}
+
+// Instance status will change to Changing when modifying its network and accounts,
+// and any other operation should be done after it comes back to Normal.
+func (client *AliyunClient) WaitForKVStoreInstance(instanceId string, status KVStoreInstanceStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeKVStoreInstanceById(instanceId)
+			if err != nil {
+				return nil, "", err
+			}
+			return instance, string(instance.InstanceStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) WaitForKVStoreAccount(instanceId, accountName string, status KVStoreAccountStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			account, err := client.DescribeKVStoreAccount(instanceId, accountName)
+			if err != nil {
+				if NotFoundError(err, "kvstore") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return account, string(account.AccountStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
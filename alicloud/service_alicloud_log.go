@@ -0,0 +1,109 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// parseLogResourceId splits the ID '<project>:<name>' of the resources under a log project.
+func parseLogResourceId(id string) (project, name string, err error) {
+	parts := strings.SplitN(id, COLON_SEPARATED, 2)
+	if len(parts) != 2 {
+		return "", "", ConfigErrorf(ErrorCodeInvalidResourceId, "Invalid log resource ID %s, expected '<project>:<name>'.", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func (client *AliyunClient) DescribeLogMachineGroup(project, name string) (*LogMachineGroup, error) {
+	group := LogMachineGroup{}
+	if err := client.logconn().Invoke(http.MethodGet, project, fmt.Sprintf("/machinegroups/%s", name), nil, nil, &group); err != nil {
+		if NotFoundError(err, "log") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Machine group %s of project %s not found", name, project))
+		}
+		return nil, err
+	}
+
+	if group.Name != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Machine group %s of project %s not found", name, project))
+	}
+	return &group, nil
+}
+
+func (client *AliyunClient) DescribeLogtailConfig(project, name string) (*LogtailConfig, error) {
+	config := LogtailConfig{}
+	if err := client.logconn().Invoke(http.MethodGet, project, fmt.Sprintf("/configs/%s", name), nil, nil, &config); err != nil {
+		if NotFoundError(err, "log") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Logtail config %s of project %s not found", name, project))
+		}
+		return nil, err
+	}
+
+	if config.Name != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Logtail config %s of project %s not found", name, project))
+	}
+	return &config, nil
+}
+
+// DescribeLogtailAttachment checks whether the logtail config is applied to the machine group.
+func (client *AliyunClient) DescribeLogtailAttachment(project, config, group string) error {
+	resp := ListLogMachineGroupConfigsResponse{}
+	if err := client.logconn().Invoke(http.MethodGet, project, fmt.Sprintf("/machinegroups/%s/configs", group), nil, nil, &resp); err != nil {
+		if NotFoundError(err, "log") {
+			return GetNotFoundErrorFromString(fmt.Sprintf("Machine group %s of project %s not found", group, project))
+		}
+		return err
+	}
+
+	for _, c := range resp.Configs {
+		if c == config {
+			return nil
+		}
+	}
+	return GetNotFoundErrorFromString(fmt.Sprintf("Logtail config %s is not applied to machine group %s of project %s", config, group, project))
+}
+
+func (client *AliyunClient) DescribeLogAlert(project, name string) (*LogAlert, error) {
+	alert := LogAlert{}
+	if err := client.logconn().Invoke(http.MethodGet, project, fmt.Sprintf("/jobs/%s", name), nil, nil, &alert); err != nil {
+		if NotFoundError(err, "log") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Log alert %s of project %s not found", name, project))
+		}
+		return nil, err
+	}
+
+	if alert.Name != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Log alert %s of project %s not found", name, project))
+	}
+	return &alert, nil
+}
+
+func (client *AliyunClient) DescribeLogDashboard(project, name string) (*LogDashboard, error) {
+	dashboard := LogDashboard{}
+	if err := client.logconn().Invoke(http.MethodGet, project, fmt.Sprintf("/dashboards/%s", name), nil, nil, &dashboard); err != nil {
+		if NotFoundError(err, "log") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Log dashboard %s of project %s not found", name, project))
+		}
+		return nil, err
+	}
+
+	if dashboard.Name != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Log dashboard %s of project %s not found", name, project))
+	}
+	return &dashboard, nil
+}
+
+func (client *AliyunClient) DescribeLogSavedSearch(project, name string) (*LogSavedSearch, error) {
+	search := LogSavedSearch{}
+	if err := client.logconn().Invoke(http.MethodGet, project, fmt.Sprintf("/savedsearches/%s", name), nil, nil, &search); err != nil {
+		if NotFoundError(err, "log") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("Log saved search %s of project %s not found", name, project))
+		}
+		return nil, err
+	}
+
+	if search.Name != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Log saved search %s of project %s not found", name, project))
+	}
+	return &search, nil
+}
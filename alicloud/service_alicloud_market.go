@@ -0,0 +1,47 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+// DescribeMarketProducts returns all of the Marketplace products matching the filters, whose keys are like
+// categoryId, productType, searchTerm and supplierId.
+func (client *AliyunClient) DescribeMarketProducts(filters []MarketProductFilter) ([]MarketProduct, error) {
+	var products []MarketProduct
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args := DescribeMarketProductsArgs{
+			Filter:     filters,
+			Pagination: pagination,
+		}
+		resp := DescribeMarketProductsResponse{}
+		if err := client.marketconn().Invoke("DescribeProducts", &args, &resp); err != nil {
+			return 0, err
+		}
+		products = append(products, resp.ProductItems.ProductItem...)
+		return len(resp.ProductItems.ProductItem), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// DescribeMarketplaceImage returns the image with its Marketplace product, or nil if the image is not from the
+// Marketplace.
+func (client *AliyunClient) DescribeMarketplaceImage(imageId string) (*MarketplaceImage, error) {
+	args := DescribeMarketplaceImageArgs{
+		RegionId:        client.Region,
+		ImageId:         imageId,
+		ImageOwnerAlias: MarketplaceImageOwnerAlias,
+	}
+	resp := DescribeMarketplaceImageResponse{}
+	if err := client.ecsconn().Invoke("DescribeImages", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, image := range resp.Images.Image {
+		if image.ImageId == imageId {
+			return &image, nil
+		}
+	}
+	return nil, nil
+}
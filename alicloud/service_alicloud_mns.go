@@ -0,0 +1,73 @@
+package alicloud
+
+import "net/http"
+
+// DescribeAccountId returns the account id of the caller, which is a part of the endpoints of some products like MNS.
+func (client *AliyunClient) DescribeAccountId() (string, error) {
+	identity, err := client.DescribeCallerIdentity()
+	if err != nil {
+		return "", err
+	}
+	return identity.AccountId, nil
+}
+
+func (client *AliyunClient) invokeMns(method, resource string, headers map[string]string, resp interface{}) error {
+	if client.mnsconn().AccountId == "" {
+		accountId, err := client.DescribeAccountId()
+		if err != nil {
+			return err
+		}
+		client.mnsconn().AccountId = accountId
+	}
+	return client.mnsconn().Invoke(method, resource, headers, resp)
+}
+
+// DescribeMnsQueues returns the queues whose names start with the prefix along with their attributes.
+func (client *AliyunClient) DescribeMnsQueues(prefix string) ([]MnsQueue, error) {
+	var queues []MnsQueue
+	headers := map[string]string{
+		"x-mns-ret-number": "1000",
+		"x-mns-with-meta":  "true",
+	}
+	if prefix != "" {
+		headers["x-mns-prefix"] = prefix
+	}
+	for {
+		resp := ListMnsQueuesResponse{}
+		if err := client.invokeMns(http.MethodGet, "/queues", headers, &resp); err != nil {
+			return nil, err
+		}
+		queues = append(queues, resp.Queues...)
+
+		if resp.NextMarker == "" {
+			break
+		}
+		headers["x-mns-marker"] = resp.NextMarker
+	}
+	return queues, nil
+}
+
+// DescribeMnsTopics returns the topics whose names start with the prefix along with their attributes.
+func (client *AliyunClient) DescribeMnsTopics(prefix string) ([]MnsTopic, error) {
+	var topics []MnsTopic
+	headers := map[string]string{
+		"x-mns-ret-number": "1000",
+		"x-mns-with-meta":  "true",
+	}
+	if prefix != "" {
+		headers["x-mns-prefix"] = prefix
+	}
+	for {
+		resp := ListMnsTopicsResponse{}
+		if err := client.invokeMns(http.MethodGet, "/topics", headers, &resp); err != nil {
+			return nil, err
+		}
+		topics = append(topics, resp.Topics...)
+
+		if resp.NextMarker == "" {
+			break
+		}
+		headers["x-mns-marker"] = resp.NextMarker
+	}
+	return topics, nil
+}
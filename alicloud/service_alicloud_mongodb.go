@@ -0,0 +1,225 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func (client *AliyunClient) DescribeMongoDBInstanceById(id string) (*MongoDBInstance, error) {
+	args := MongoDBInstanceArgs{
+		RegionId:     client.Region,
+		DBInstanceId: id,
+	}
+	resp := DescribeMongoDBInstanceAttributeResponse{}
+	if err := client.mongodbconn().Invoke("DescribeDBInstanceAttribute", &args, &resp); err != nil {
+		if NotFoundError(err, "mongodb") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("MongoDB instance %s not found", id))
+		}
+		return nil, err
+	}
+
+	if len(resp.DBInstances.DBInstance) <= 0 {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("MongoDB instance %s not found", id))
+	}
+
+	return &resp.DBInstances.DBInstance[0], nil
+}
+
+func (client *AliyunClient) DescribeMongoDBSecurityIps(id string) ([]string, error) {
+	args := MongoDBInstanceArgs{
+		RegionId:     client.Region,
+		DBInstanceId: id,
+	}
+	resp := DescribeMongoDBSecurityIpsResponse{}
+	if err := client.mongodbconn().Invoke("DescribeSecurityIps", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	var ips []string
+	for _, ip := range strings.Split(resp.SecurityIps, COMMA_SEPARATED) {
+		if ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	return ips, nil
+}
+
+func (client *AliyunClient) DescribeMongoDBBackupPolicy(id string) (*MongoDBBackupPolicy, error) {
+	args := MongoDBInstanceArgs{
+		RegionId:     client.Region,
+		DBInstanceId: id,
+	}
+	resp := DescribeMongoDBBackupPolicyResponse{}
+	if err := client.mongodbconn().Invoke("DescribeBackupPolicy", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.MongoDBBackupPolicy, nil
+}
+
+func (client *AliyunClient) WaitForMongoDBInstance(id string, status MongoDBInstanceStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeMongoDBInstanceById(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return instance, string(instance.DBInstanceStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+// The following functions are shared by the replica set and sharding instance,
+// and all of them expect the instance is Running after updating.
+
+func modifyMongoDBSecurityIps(d *schema.ResourceData, meta interface{}, deadline operationDeadline) error {
+	client := meta.(*AliyunClient)
+
+	ips := expandStringList(d.Get("security_ip_list").(*schema.Set).List())
+	if len(ips) < 1 {
+		ips = []string{LOCAL_HOST_IP}
+	}
+
+	args := ModifyMongoDBSecurityIpsArgs{
+		RegionId:     client.Region,
+		DBInstanceId: d.Id(),
+		SecurityIps:  strings.Join(ips, COMMA_SEPARATED),
+		ModifyMode:   "Cover",
+	}
+	if err := client.mongodbconn().Invoke("ModifySecurityIps", &args, &MongoDBResponse{}); err != nil {
+		return fmt.Errorf("ModifySecurityIps got an error: %#v", err)
+	}
+
+	if err := client.WaitForMongoDBInstance(d.Id(), MongoDBRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForMongoDBInstance %s got an error: %#v", MongoDBRunning, err)
+	}
+	return nil
+}
+
+func modifyMongoDBBackupPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	periods := expandStringList(d.Get("backup_period").(*schema.Set).List())
+	backupTime := d.Get("backup_time").(string)
+	if len(periods) < 1 || backupTime == "" {
+		return ConfigErrorf(ErrorCodeMissingArgument, "Both backup_time and backup_period are required to set backup policy.")
+	}
+
+	args := ModifyMongoDBBackupPolicyArgs{
+		RegionId:              client.Region,
+		DBInstanceId:          d.Id(),
+		PreferredBackupTime:   backupTime,
+		PreferredBackupPeriod: strings.Join(periods, COMMA_SEPARATED),
+	}
+	if err := client.mongodbconn().Invoke("ModifyBackupPolicy", &args, &MongoDBResponse{}); err != nil {
+		return fmt.Errorf("ModifyBackupPolicy got an error: %#v", err)
+	}
+	return nil
+}
+
+func resetMongoDBAccountPassword(d *schema.ResourceData, meta interface{}, deadline operationDeadline) error {
+	client := meta.(*AliyunClient)
+
+	args := ResetMongoDBAccountPasswordArgs{
+		RegionId:        client.Region,
+		DBInstanceId:    d.Id(),
+		AccountName:     MongoDBRootAccountName,
+		AccountPassword: d.Get("account_password").(string),
+	}
+	if err := client.mongodbconn().Invoke("ResetAccountPassword", &args, &MongoDBResponse{}); err != nil {
+		return fmt.Errorf("ResetAccountPassword got an error: %#v", err)
+	}
+
+	if err := client.WaitForMongoDBInstance(d.Id(), MongoDBRunning, deadline.Seconds()); err != nil {
+		return fmt.Errorf("WaitForMongoDBInstance %s got an error: %#v", MongoDBRunning, err)
+	}
+	return nil
+}
+
+func modifyMongoDBDescription(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := ModifyMongoDBDescriptionArgs{
+		RegionId:              client.Region,
+		DBInstanceId:          d.Id(),
+		DBInstanceDescription: d.Get("name").(string),
+	}
+	if err := client.mongodbconn().Invoke("ModifyDBInstanceDescription", &args, &MongoDBResponse{}); err != nil {
+		return fmt.Errorf("ModifyDBInstanceDescription got an error: %#v", err)
+	}
+	return nil
+}
+
+// readMongoDBSecurityAndBackup sets the security ips and backup policy attributes,
+// which are the same between the replica set and sharding instance.
+func readMongoDBSecurityAndBackup(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	ips, err := client.DescribeMongoDBSecurityIps(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeSecurityIps got an error: %#v", err)
+	}
+	if err := d.Set("security_ip_list", ips); err != nil {
+		return err
+	}
+
+	policy, err := client.DescribeMongoDBBackupPolicy(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeBackupPolicy got an error: %#v", err)
+	}
+	d.Set("backup_time", policy.PreferredBackupTime)
+	if err := d.Set("backup_period", strings.Split(policy.PreferredBackupPeriod, COMMA_SEPARATED)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// buildMongoDBNetworkArgs returns the vpc id and zone id of the vswitch when the instance
+// is placed in a VPC, and checks that the vswitch belongs to the specified zone.
+func buildMongoDBNetworkArgs(d *schema.ResourceData, meta interface{}) (vpcId, zoneId string, err error) {
+	zoneId = d.Get("zone_id").(string)
+
+	vswitchId := d.Get("vswitch_id").(string)
+	if vswitchId == "" {
+		return "", zoneId, nil
+	}
+
+	return meta.(*AliyunClient).DescribeVSwitchPlacement(vswitchId, zoneId)
+}
+
+func deleteMongoDBInstance(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := MongoDBInstanceArgs{
+		RegionId:     client.Region,
+		DBInstanceId: d.Id(),
+	}
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := client.mongodbconn().Invoke("DeleteDBInstance", &args, &MongoDBResponse{}); err != nil {
+			if NotFoundError(err, "mongodb") {
+				return nil
+			}
+			if IsExceptedError(err, MongoDBOperationDeniedStatus) {
+				return resource.RetryableError(fmt.Errorf("MongoDB instance %s is busy - trying again while it is deleted.", d.Id()))
+			}
+			return resource.NonRetryableError(fmt.Errorf("DeleteDBInstance got an error: %#v", err))
+		}
+
+		if _, err := client.DescribeMongoDBInstanceById(d.Id()); err != nil {
+			if NotFoundError(err, "mongodb") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("MongoDB instance %s in use - trying again while it is deleted.", d.Id()))
+	})
+}
@@ -0,0 +1,97 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) DescribeMseCluster(instanceId string) (*MseCluster, error) {
+	args := MseClusterArgs{
+		InstanceId: instanceId,
+	}
+	resp := QueryMseClusterDetailResponse{}
+	if err := client.mseconn().Invoke("QueryClusterDetail", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.InstanceId != instanceId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("MSE cluster %s not found", instanceId))
+	}
+	return &resp.Data, nil
+}
+
+// DescribeMseClusterAcl returns the CIDR blocks allowed to access the cluster through the public network.
+func (client *AliyunClient) DescribeMseClusterAcl(instanceId string) ([]string, error) {
+	args := MseClusterArgs{
+		InstanceId: instanceId,
+	}
+	resp := QueryMseAclResponse{}
+	if err := client.mseconn().Invoke("QueryClusterAcl", &args, &resp); err != nil {
+		return nil, err
+	}
+	var entries []string
+	for _, acl := range resp.Data.Acls {
+		entries = append(entries, acl.Ip)
+	}
+	return entries, nil
+}
+
+func (client *AliyunClient) WaitForMseCluster(instanceId string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := client.DescribeMseCluster(instanceId)
+			if err != nil {
+				if NotFoundError(err, "mse") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return cluster, cluster.InitStatus, nil
+		},
+		Target:  []string{MseClusterInitSuccess, MseClusterRestartSuccess},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeMseGateway(gatewayId string) (*MseGateway, error) {
+	filter, err := json.Marshal(map[string]string{"GatewayUniqueId": gatewayId})
+	if err != nil {
+		return nil, err
+	}
+	args := ListMseGatewayArgs{
+		FilterParams: string(filter),
+		PageNumber:   1,
+		PageSize:     10,
+	}
+	resp := ListMseGatewayResponse{}
+	if err := client.mseconn().Invoke("ListGateway", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, gateway := range resp.Data.Result {
+		if gateway.GatewayUniqueId == gatewayId {
+			return &gateway, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("MSE gateway %s not found", gatewayId))
+}
+
+func (client *AliyunClient) WaitForMseGateway(gatewayId string, status int, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			gateway, err := client.DescribeMseGateway(gatewayId)
+			if err != nil {
+				if NotFoundError(err, "mse") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return gateway, strconv.Itoa(gateway.Status), nil
+		},
+		Target:  []string{strconv.Itoa(status)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
@@ -0,0 +1,51 @@
+package alicloud
+
+import "fmt"
+
+func (client *AliyunClient) DescribeOcsInstanceById(id string) (*OcsInstance, error) {
+	args := DescribeOcsInstancesArgs{
+		RegionId:    client.Region,
+		InstanceIds: id,
+	}
+	resp := DescribeOcsInstancesResponse{}
+	if err := client.ocsconn().Invoke("DescribeInstances", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, instance := range resp.Instances.OcsInstance {
+		if instance.InstanceId == id {
+			return &instance, nil
+		}
+	}
+
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("Memcache instance %s not found", id))
+}
+
+func (client *AliyunClient) DescribeOcsAuthenticIPs(id string) ([]string, error) {
+	args := OcsInstanceArgs{
+		RegionId:   client.Region,
+		InstanceId: id,
+	}
+	resp := DescribeOcsAuthenticIPResponse{}
+	if err := client.ocsconn().Invoke("DescribeAuthenticIP", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.AuthenticIPs.IP, nil
+}
+
+func (client *AliyunClient) WaitForOcsInstance(id string, status OcsInstanceStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeOcsInstanceById(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return instance, string(instance.InstanceStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
@@ -0,0 +1,83 @@
+package alicloud
+
+import "fmt"
+
+func (client *AliyunClient) DescribeOnsInstance(instanceId string) (*OnsInstance, error) {
+	args := OnsInstanceArgs{
+		InstanceId: instanceId,
+	}
+	resp := DescribeOnsInstanceResponse{}
+	if err := client.onsconn().Invoke("OnsInstanceBaseInfo", &args, &resp); err != nil {
+		// The InstanceNotFound of the ONS is a not found error only when the instance is the one described.
+		if NotFoundError(err, "ons") || IsExceptedError(err, OnsInstanceNotFound) {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("ONS instance %s not found", instanceId))
+		}
+		return nil, err
+	}
+
+	if resp.InstanceBaseInfo.InstanceId != instanceId || resp.InstanceBaseInfo.InstanceStatus == OnsInstanceReleased {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("ONS instance %s not found", instanceId))
+	}
+
+	return &resp.InstanceBaseInfo, nil
+}
+
+func (client *AliyunClient) WaitForOnsInstance(instanceId string, status OnsInstanceStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeOnsInstance(instanceId)
+			if err != nil {
+				return nil, "", err
+			}
+			return instance, string(instance.InstanceStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeOnsTopic(instanceId, topic string) (*OnsTopic, error) {
+	args := OnsTopicArgs{
+		InstanceId: instanceId,
+		Topic:      topic,
+	}
+	resp := DescribeOnsTopicsResponse{}
+	if err := client.onsconn().Invoke("OnsTopicList", &args, &resp); err != nil {
+		if NotFoundError(err, "ons") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("ONS topic %s of instance %s not found", topic, instanceId))
+		}
+		return nil, err
+	}
+
+	// The topic is matched fuzzily by OnsTopicList.
+	for _, t := range resp.Data.PublishInfoDo {
+		if t.Topic == topic {
+			return &t, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("ONS topic %s of instance %s not found", topic, instanceId))
+}
+
+func (client *AliyunClient) DescribeOnsGroup(instanceId, groupId string) (*OnsGroup, error) {
+	args := OnsGroupArgs{
+		InstanceId: instanceId,
+		GroupId:    groupId,
+	}
+	resp := DescribeOnsGroupsResponse{}
+	if err := client.onsconn().Invoke("OnsGroupList", &args, &resp); err != nil {
+		if NotFoundError(err, "ons") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("ONS group %s of instance %s not found", groupId, instanceId))
+		}
+		return nil, err
+	}
+
+	// The group is matched fuzzily by OnsGroupList.
+	for _, g := range resp.Data.SubscribeInfoDo {
+		if g.GroupId == groupId {
+			return &g, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("ONS group %s of instance %s not found", groupId, instanceId))
+}
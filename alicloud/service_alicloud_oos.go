@@ -0,0 +1,58 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+// DescribeOosTemplate returns the latest version of the template and the content of it.
+func (client *AliyunClient) DescribeOosTemplate(name string) (*OosTemplateResponse, error) {
+	args := OosTemplateArgs{
+		RegionId:     client.Region,
+		TemplateName: name,
+	}
+	resp := OosTemplateResponse{}
+	if err := client.oosconn().Invoke("GetTemplate", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Template.TemplateName != name {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("OOS template %s not found", name))
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribeOosExecution(executionId string) (*OosExecution, error) {
+	args := OosExecutionArgs{
+		RegionId:    client.Region,
+		ExecutionId: executionId,
+	}
+	resp := ListOosExecutionsResponse{}
+	if err := client.oosconn().Invoke("ListExecutions", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, execution := range resp.Executions {
+		if execution.ExecutionId == executionId {
+			return &execution, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("OOS execution %s not found", executionId))
+}
+
+// WaitForOosExecution waits for the execution to be finished, whether it is succeeded, failed or cancelled.
+func (client *AliyunClient) WaitForOosExecution(executionId string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			execution, err := client.DescribeOosExecution(executionId)
+			if err != nil {
+				if NotFoundError(err, "oos") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return execution, execution.Status, nil
+		},
+		Target:  []string{OosExecutionSuccess, OosExecutionFailed, OosExecutionCancelled},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
@@ -0,0 +1,164 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (client *AliyunClient) DescribePolarDBClusterById(id string) (*PolarDBCluster, error) {
+	args := PolarDBClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: id,
+	}
+	resp := DescribePolarDBClusterAttributeResponse{}
+	if err := client.polardbconn().Invoke("DescribeDBClusterAttribute", &args, &resp); err != nil {
+		if NotFoundError(err, "polardb") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("PolarDB cluster %s not found", id))
+		}
+		return nil, err
+	}
+
+	if resp.DBClusterId != id {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("PolarDB cluster %s not found", id))
+	}
+
+	return &resp.PolarDBCluster, nil
+}
+
+func (client *AliyunClient) DescribePolarDBSecurityIps(id string) ([]string, error) {
+	args := PolarDBClusterArgs{
+		RegionId:    client.Region,
+		DBClusterId: id,
+	}
+	resp := DescribePolarDBAccessWhitelistResponse{}
+	if err := client.polardbconn().Invoke("DescribeDBClusterAccessWhitelist", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	var ips []string
+	for _, array := range resp.Items.DBClusterIPArray {
+		if array.DBClusterIPArrayName != "default" {
+			continue
+		}
+		for _, ip := range strings.Split(array.SecurityIps, COMMA_SEPARATED) {
+			if ip != "" {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips, nil
+}
+
+func (client *AliyunClient) DescribePolarDBEndpoint(clusterId, endpointId string) (*PolarDBEndpoint, error) {
+	args := PolarDBEndpointArgs{
+		RegionId:     client.Region,
+		DBClusterId:  clusterId,
+		DBEndpointId: endpointId,
+	}
+	resp := DescribePolarDBEndpointsResponse{}
+	if err := client.polardbconn().Invoke("DescribeDBClusterEndpoints", &args, &resp); err != nil {
+		if NotFoundError(err, "polardb") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("PolarDB endpoint %s not found", endpointId))
+		}
+		return nil, err
+	}
+
+	for _, endpoint := range resp.Items {
+		if endpoint.DBEndpointId == endpointId {
+			return &endpoint, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("PolarDB endpoint %s not found", endpointId))
+}
+
+func (client *AliyunClient) DescribePolarDBAccount(clusterId, name string) (*PolarDBAccount, error) {
+	args := PolarDBAccountArgs{
+		RegionId:    client.Region,
+		DBClusterId: clusterId,
+		AccountName: name,
+	}
+	resp := DescribePolarDBAccountsResponse{}
+	if err := client.polardbconn().Invoke("DescribeAccounts", &args, &resp); err != nil {
+		if NotFoundError(err, "polardb") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("PolarDB account %s not found", name))
+		}
+		return nil, err
+	}
+
+	for _, account := range resp.Accounts {
+		if account.AccountName == name {
+			return &account, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("PolarDB account %s not found", name))
+}
+
+func (client *AliyunClient) DescribePolarDBDatabase(clusterId, name string) (*PolarDBDatabase, error) {
+	args := PolarDBDatabaseArgs{
+		RegionId:    client.Region,
+		DBClusterId: clusterId,
+		DBName:      name,
+	}
+	resp := DescribePolarDBDatabasesResponse{}
+	if err := client.polardbconn().Invoke("DescribeDatabases", &args, &resp); err != nil {
+		if NotFoundError(err, "polardb") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("PolarDB database %s not found", name))
+		}
+		return nil, err
+	}
+
+	for _, db := range resp.Databases.Database {
+		if db.DBName == name {
+			return &db, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("PolarDB database %s not found", name))
+}
+
+func (client *AliyunClient) WaitForPolarDBCluster(id string, status PolarDBStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := client.DescribePolarDBClusterById(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return cluster, string(cluster.DBClusterStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) WaitForPolarDBAccount(clusterId, name string, status PolarDBStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			account, err := client.DescribePolarDBAccount(clusterId, name)
+			if err != nil {
+				return nil, "", err
+			}
+			return account, string(account.AccountStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) WaitForPolarDBDatabase(clusterId, name string, status PolarDBStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			db, err := client.DescribePolarDBDatabase(clusterId, name)
+			if err != nil {
+				return nil, "", err
+			}
+			return db, string(db.DBStatus), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
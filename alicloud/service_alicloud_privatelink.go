@@ -0,0 +1,188 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) DescribePrivateLinkVpcEndpointService(serviceId string) (*VpcEndpointServiceAttribute, error) {
+	args := PrivateLinkServiceArgs{
+		RegionId:  client.Region,
+		ServiceId: serviceId,
+	}
+	resp := VpcEndpointServiceAttribute{}
+	if err := client.privatelinkconn().Invoke("GetVpcEndpointServiceAttribute", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.ServiceId != serviceId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("PrivateLink endpoint service %s not found", serviceId))
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribePrivateLinkVpcEndpointServiceResources(serviceId string) ([]string, error) {
+	args := PrivateLinkListArgs{
+		RegionId:   client.Region,
+		ServiceId:  serviceId,
+		MaxResults: 50,
+	}
+	var ids []string
+	for {
+		resp := ListVpcEndpointServiceResourcesResponse{}
+		if err := client.privatelinkconn().Invoke("ListVpcEndpointServiceResources", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, r := range resp.Resources {
+			ids = append(ids, r.ResourceId)
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.NextToken
+	}
+	return ids, nil
+}
+
+func (client *AliyunClient) DescribePrivateLinkVpcEndpointServiceUsers(serviceId string) ([]string, error) {
+	args := PrivateLinkListArgs{
+		RegionId:   client.Region,
+		ServiceId:  serviceId,
+		MaxResults: 50,
+	}
+	var users []string
+	for {
+		resp := ListVpcEndpointServiceUsersResponse{}
+		if err := client.privatelinkconn().Invoke("ListVpcEndpointServiceUsers", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, u := range resp.Users {
+			users = append(users, strconv.FormatInt(u.UserId, 10))
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.NextToken
+	}
+	return users, nil
+}
+
+func (client *AliyunClient) WaitForPrivateLinkVpcEndpointService(serviceId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			service, err := client.DescribePrivateLinkVpcEndpointService(serviceId)
+			if err != nil {
+				if NotFoundError(err, "privatelink") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return service, service.ServiceStatus, nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribePrivateLinkVpcEndpoint(endpointId string) (*VpcEndpointAttribute, error) {
+	args := PrivateLinkEndpointArgs{
+		RegionId:   client.Region,
+		EndpointId: endpointId,
+	}
+	resp := VpcEndpointAttribute{}
+	if err := client.privatelinkconn().Invoke("GetVpcEndpointAttribute", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.EndpointId != endpointId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("PrivateLink endpoint %s not found", endpointId))
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribePrivateLinkVpcEndpointSecurityGroups(endpointId string) ([]string, error) {
+	args := PrivateLinkListArgs{
+		RegionId:   client.Region,
+		EndpointId: endpointId,
+		MaxResults: 50,
+	}
+	var ids []string
+	for {
+		resp := ListVpcEndpointSecurityGroupsResponse{}
+		if err := client.privatelinkconn().Invoke("ListVpcEndpointSecurityGroups", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, g := range resp.SecurityGroups {
+			ids = append(ids, g.SecurityGroupId)
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.NextToken
+	}
+	return ids, nil
+}
+
+func (client *AliyunClient) WaitForPrivateLinkVpcEndpoint(endpointId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			endpoint, err := client.DescribePrivateLinkVpcEndpoint(endpointId)
+			if err != nil {
+				if NotFoundError(err, "privatelink") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return endpoint, endpoint.EndpointStatus, nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribePrivateLinkVpcEndpointZone(endpointId, zoneId string) (*VpcEndpointZone, error) {
+	args := PrivateLinkListArgs{
+		RegionId:   client.Region,
+		EndpointId: endpointId,
+		MaxResults: 50,
+	}
+	for {
+		resp := ListVpcEndpointZonesResponse{}
+		if err := client.privatelinkconn().Invoke("ListVpcEndpointZones", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, z := range resp.Zones {
+			if z.ZoneId == zoneId {
+				return &z, nil
+			}
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.NextToken
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("PrivateLink endpoint zone %s of endpoint %s not found", zoneId, endpointId))
+}
+
+// WaitForPrivateLinkVpcEndpointZone waits until the zone leaves the Creating or Deleting status, and the zone is
+// in Wait rather than Connected when the endpoint service has not accepted the connection yet.
+func (client *AliyunClient) WaitForPrivateLinkVpcEndpointZone(endpointId, zoneId string, statuses []string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			zone, err := client.DescribePrivateLinkVpcEndpointZone(endpointId, zoneId)
+			if err != nil {
+				if NotFoundError(err, "privatelink") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return zone, zone.ZoneStatus, nil
+		},
+		Target:  statuses,
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
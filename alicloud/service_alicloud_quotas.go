@@ -0,0 +1,83 @@
+package alicloud
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// DescribeProductQuotas returns the quotas and their usage of the product, narrowed down by the quota action
+// code and the dimensions if they are not empty.
+func (client *AliyunClient) DescribeProductQuotas(productCode, quotaActionCode string, dimensions []QuotaDimension) ([]ProductQuota, error) {
+	args := ListProductQuotasArgs{
+		ProductCode:     productCode,
+		QuotaActionCode: quotaActionCode,
+		Dimensions:      dimensions,
+		MaxResults:      50,
+	}
+	var quotas []ProductQuota
+	for {
+		resp := ListProductQuotasResponse{}
+		if err := client.quotasconn().Invoke("ListProductQuotas", &args, &resp); err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, resp.Quotas...)
+		if resp.NextToken == "" {
+			break
+		}
+		args.NextToken = resp.NextToken
+	}
+	return quotas, nil
+}
+
+func (client *AliyunClient) DescribeQuotaAlarm(alarmId string) (*QuotaAlarm, error) {
+	args := QuotaAlarmArgs{
+		AlarmId: alarmId,
+	}
+	resp := GetQuotaAlarmResponse{}
+	if err := client.quotasconn().Invoke("GetQuotaAlarm", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.QuotaAlarm.AlarmId != alarmId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("Quota alarm %s not found", alarmId))
+	}
+	return &resp.QuotaAlarm, nil
+}
+
+func expandQuotaDimensions(configured []interface{}) []QuotaDimension {
+	var dimensions []QuotaDimension
+	for _, v := range configured {
+		m := v.(map[string]interface{})
+		dimensions = append(dimensions, QuotaDimension{
+			Key:   m["key"].(string),
+			Value: m["value"].(string),
+		})
+	}
+	return dimensions
+}
+
+// flattenQuotaDimensions returns the dimensions sorted by their keys, as they are maps in the responses.
+func flattenQuotaDimensions(dimensions map[string]string) []map[string]interface{} {
+	var keys []string
+	for k := range dimensions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var s []map[string]interface{}
+	for _, k := range keys {
+		s = append(s, map[string]interface{}{
+			"key":   k,
+			"value": dimensions[k],
+		})
+	}
+	return s
+}
+
+// formatQuotaThreshold returns an empty string for the zero threshold, so that it is not sent.
+func formatQuotaThreshold(threshold float64) string {
+	if threshold == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(threshold, 'f', -1, 64)
+}
@@ -42,6 +42,21 @@ type Policy struct {
 	Version   string
 }
 
+// PolicyDocumentStatement is the statement assembled by the alicloud_ram_policy_document, which may be a statement
+// of a policy with the Resource or a statement of the trust policy of a role with the Principal.
+type PolicyDocumentStatement struct {
+	Effect    Effect                         `json:"Effect"`
+	Action    []string                       `json:"Action"`
+	Resource  []string                       `json:"Resource,omitempty"`
+	Principal map[string][]string            `json:"Principal,omitempty"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+type PolicyDocument struct {
+	Version   string                    `json:"Version"`
+	Statement []PolicyDocumentStatement `json:"Statement"`
+}
+
 func ParseRolePolicyDocument(policyDocument string) (RolePolicy, error) {
 	var policy RolePolicy
 	err := json.Unmarshal([]byte(policyDocument), &policy)
@@ -136,7 +151,7 @@ func AssemblePolicyDocument(document []interface{}, version string) (string, err
 
 // Judge whether the role policy contains service "ecs.aliyuncs.com"
 func (client *AliyunClient) JudgeRolePolicyPrincipal(roleName string) error {
-	conn := client.ramconn
+	conn := client.ramconn()
 	resp, err := conn.GetRole(ram.RoleQueryRequest{RoleName: roleName})
 	if err != nil {
 		return fmt.Errorf("GetRole %s got an error: %#v", roleName, err)
@@ -1,9 +1,12 @@
 package alicloud
 
 import (
+	"fmt"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/rds"
+	"strconv"
 	"strings"
+	"time"
 )
 
 //
@@ -24,7 +27,7 @@ func (client *AliyunClient) DescribeDBInstanceById(id string) (instance *rds.DBI
 	arrtArgs := rds.DescribeDBInstancesArgs{
 		DBInstanceId: id,
 	}
-	resp, err := client.rdsconn.DescribeDBInstanceAttribute(&arrtArgs)
+	resp, err := client.rdsconn().DescribeDBInstanceAttribute(&arrtArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -38,8 +41,8 @@ func (client *AliyunClient) DescribeDBInstanceById(id string) (instance *rds.DBI
 	return &attr[0], nil
 }
 
-func (client *AliyunClient) CreateAccountByInfo(instanceId, username, pwd string) error {
-	conn := client.rdsconn
+func (client *AliyunClient) CreateAccountByInfo(instanceId, username, pwd string, timeout int) error {
+	conn := client.rdsconn()
 	args := rds.CreateAccountArgs{
 		DBInstanceId:    instanceId,
 		AccountName:     username,
@@ -50,14 +53,14 @@ func (client *AliyunClient) CreateAccountByInfo(instanceId, username, pwd string
 		return err
 	}
 
-	if err := conn.WaitForAccount(instanceId, username, rds.Available, 200); err != nil {
+	if err := conn.WaitForAccount(instanceId, username, rds.Available, timeout); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (client *AliyunClient) CreateDatabaseByInfo(instanceId, dbName, charset, desp string) error {
-	conn := client.rdsconn
+	conn := client.rdsconn()
 	args := rds.CreateDatabaseArgs{
 		DBInstanceId:     instanceId,
 		DBName:           dbName,
@@ -69,7 +72,7 @@ func (client *AliyunClient) CreateDatabaseByInfo(instanceId, dbName, charset, de
 }
 
 func (client *AliyunClient) DescribeDatabaseByName(instanceId, dbName string) (ds []rds.Database, err error) {
-	conn := client.rdsconn
+	conn := client.rdsconn()
 	args := rds.DescribeDatabasesArgs{
 		DBInstanceId: instanceId,
 		DBName:       dbName,
@@ -83,8 +86,8 @@ func (client *AliyunClient) DescribeDatabaseByName(instanceId, dbName string) (d
 	return resp.Databases.Database, nil
 }
 
-func (client *AliyunClient) GrantDBPrivilege2Account(instanceId, username, dbName string) error {
-	conn := client.rdsconn
+func (client *AliyunClient) GrantDBPrivilege2Account(instanceId, username, dbName string, timeout int) error {
+	conn := client.rdsconn()
 	pargs := rds.GrantAccountPrivilegeArgs{
 		DBInstanceId:     instanceId,
 		AccountName:      username,
@@ -95,14 +98,14 @@ func (client *AliyunClient) GrantDBPrivilege2Account(instanceId, username, dbNam
 		return err
 	}
 
-	if err := conn.WaitForAccountPrivilege(instanceId, username, dbName, rds.ReadWrite, 200); err != nil {
+	if err := conn.WaitForAccountPrivilege(instanceId, username, dbName, rds.ReadWrite, timeout); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (client *AliyunClient) AllocateDBPublicConnection(instanceId, port string) error {
-	conn := client.rdsconn
+func (client *AliyunClient) AllocateDBPublicConnection(instanceId, port string, timeout int) error {
+	conn := client.rdsconn()
 	args := rds.AllocateInstancePublicConnectionArgs{
 		DBInstanceId:           instanceId,
 		ConnectionStringPrefix: instanceId + "o",
@@ -113,13 +116,13 @@ func (client *AliyunClient) AllocateDBPublicConnection(instanceId, port string)
 		return err
 	}
 
-	if err := conn.WaitForPublicConnection(instanceId, 600); err != nil {
+	if err := conn.WaitForPublicConnection(instanceId, timeout); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (client *AliyunClient) ConfigDBBackup(instanceId, backupTime, backupPeriod string, retentionPeriod int) error {
+func (client *AliyunClient) ConfigDBBackup(instanceId, backupTime, backupPeriod string, retentionPeriod int, timeout int) error {
 	bargs := rds.BackupPolicy{
 		PreferredBackupTime:   backupTime,
 		PreferredBackupPeriod: backupPeriod,
@@ -130,17 +133,17 @@ func (client *AliyunClient) ConfigDBBackup(instanceId, backupTime, backupPeriod
 		BackupPolicy: bargs,
 	}
 
-	if _, err := client.rdsconn.ModifyBackupPolicy(&args); err != nil {
+	if _, err := client.rdsconn().ModifyBackupPolicy(&args); err != nil {
 		return err
 	}
 
-	if err := client.rdsconn.WaitForInstance(instanceId, rds.Running, 600); err != nil {
+	if err := client.rdsconn().WaitForInstance(instanceId, rds.Running, timeout); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (client *AliyunClient) ModifyDBSecurityIps(instanceId, ips string) error {
+func (client *AliyunClient) ModifyDBSecurityIps(instanceId, ips string, timeout int) error {
 	sargs := rds.DBInstanceIPArray{
 		SecurityIps: ips,
 	}
@@ -150,11 +153,11 @@ func (client *AliyunClient) ModifyDBSecurityIps(instanceId, ips string) error {
 		DBInstanceIPArray: sargs,
 	}
 
-	if _, err := client.rdsconn.ModifySecurityIps(&args); err != nil {
+	if _, err := client.rdsconn().ModifySecurityIps(&args); err != nil {
 		return err
 	}
 
-	if err := client.rdsconn.WaitForInstance(instanceId, rds.Running, 600); err != nil {
+	if err := client.rdsconn().WaitForInstance(instanceId, rds.Running, timeout); err != nil {
 		return err
 	}
 	return nil
@@ -165,7 +168,7 @@ func (client *AliyunClient) DescribeDBSecurityIps(instanceId string) (ips []rds.
 		DBInstanceId: instanceId,
 	}
 
-	resp, err := client.rdsconn.DescribeDBInstanceIPs(&args)
+	resp, err := client.rdsconn().DescribeDBInstanceIPs(&args)
 	if err != nil {
 		return nil, err
 	}
@@ -212,8 +215,8 @@ func (client *AliyunClient) GetSecurityIps(instanceId string, securityIps interf
 	return finalIps, nil
 }
 
-func (client *AliyunClient) ModifyDBClassStorage(instanceId, class, storage string) error {
-	conn := client.rdsconn
+func (client *AliyunClient) ModifyDBClassStorage(instanceId, class, storage string, timeout int) error {
+	conn := client.rdsconn()
 	args := rds.ModifyDBInstanceSpecArgs{
 		DBInstanceId:      instanceId,
 		PayType:           rds.Postpaid,
@@ -225,12 +228,62 @@ func (client *AliyunClient) ModifyDBClassStorage(instanceId, class, storage stri
 		return err
 	}
 
-	if err := conn.WaitForInstance(instanceId, rds.Running, 600); err != nil {
+	if err := conn.WaitForInstance(instanceId, rds.Running, timeout); err != nil {
 		return err
 	}
 	return nil
 }
 
+// UpgradeDBEngineVersion upgrades the engine version of the instance, and waits the instance
+// to be running when the upgrade takes effect immediately.
+func (client *AliyunClient) UpgradeDBEngineVersion(instanceId, version, effectiveTime string, timeout int) error {
+	args := UpgradeDBInstanceEngineVersionArgs{
+		RegionId:      client.Region,
+		DBInstanceId:  instanceId,
+		EngineVersion: version,
+		EffectiveTime: effectiveTime,
+	}
+
+	if err := client.rdsconn().Invoke("UpgradeDBInstanceEngineVersion", &args, &UpgradeDBInstanceEngineVersionResponse{}); err != nil {
+		return err
+	}
+
+	if effectiveTime != RdsEffectiveImmediately {
+		return nil
+	}
+
+	// The instance turns into EngineVersionUpgrading after a while.
+	time.Sleep(DefaultIntervalShort * time.Second)
+	return client.rdsconn().WaitForInstanceAsyn(instanceId, rds.Running, timeout)
+}
+
+// IsDBEngineVersionUpgrade checks whether the newVersion is newer than the oldVersion. The versions are compared
+// by their numeric parts, like 5.6 and 5.7 or 2008r2 and 2012, so that the new engine versions need no changes.
+func IsDBEngineVersionUpgrade(oldVersion, newVersion string) bool {
+	o, n := strings.Split(oldVersion, "."), strings.Split(newVersion, ".")
+	for i := 0; i < len(o) && i < len(n); i++ {
+		oNum, oSuffix := splitDBEngineVersionPart(o[i])
+		nNum, nSuffix := splitDBEngineVersionPart(n[i])
+		if oNum != nNum {
+			return nNum > oNum
+		}
+		if oSuffix != nSuffix {
+			return nSuffix > oSuffix
+		}
+	}
+	return len(n) > len(o)
+}
+
+// splitDBEngineVersionPart splits a part of the version into its leading number and the suffix, like 2008 and r2.
+func splitDBEngineVersionPart(part string) (int, string) {
+	i := 0
+	for i < len(part) && part[i] >= '0' && part[i] <= '9' {
+		i++
+	}
+	num, _ := strconv.Atoi(part[:i])
+	return num, part[i:]
+}
+
 // turn period to TimeType
 func TransformPeriod2Time(period int, chargeType string) (ut int, tt common.TimeType) {
 	if chargeType == string(rds.Postpaid) {
@@ -313,3 +366,76 @@ func flattenDBConnections(list []rds.DBInstanceNetInfo) []map[string]interface{}
 	}
 	return result
 }
+
+// DescribeRdsAvailableZones returns the zones in which rds resources can be purchased
+// with the specified charge type, and the zones are filtered by zoneId when it is not empty.
+func (client *AliyunClient) DescribeRdsAvailableZones(zoneId, chargeType string) ([]RdsAvailableZone, error) {
+	args := DescribeRdsAvailableResourceArgs{
+		RegionId:           client.Region,
+		ZoneId:             zoneId,
+		InstanceChargeType: string(rds.Postpaid),
+	}
+	if chargeType != "" {
+		args.InstanceChargeType = chargeType
+	}
+
+	resp := DescribeRdsAvailableResourceResponse{}
+	if err := client.rdsconn().Invoke("DescribeAvailableResource", &args, &resp); err != nil {
+		return nil, fmt.Errorf("DescribeAvailableResource got an error: %#v", err)
+	}
+
+	var zones []RdsAvailableZone
+	for _, zone := range resp.AvailableZones.AvailableZone {
+		if zone.Status != "Available" {
+			continue
+		}
+		zones = append(zones, zone)
+	}
+	return zones, nil
+}
+
+// RdsAvailableEntry is a flattened item of the tree returned by DescribeAvailableResource
+type RdsAvailableEntry struct {
+	ZoneId        string
+	Engine        string
+	EngineVersion string
+	Category      string
+	StorageType   string
+	InstanceClass string
+	StorageRange  RdsStorageRange
+}
+
+// FlattenRdsAvailableZones flattens the available zones and filters them by engine, engine version and category.
+func FlattenRdsAvailableZones(zones []RdsAvailableZone, engine, version, category string) (entries []RdsAvailableEntry) {
+	for _, zone := range zones {
+		for _, e := range zone.SupportedEngines.SupportedEngine {
+			if engine != "" && e.Engine != engine {
+				continue
+			}
+			for _, v := range e.SupportedEngineVersions.SupportedEngineVersion {
+				if version != "" && v.Version != version {
+					continue
+				}
+				for _, c := range v.SupportedCategorys.SupportedCategory {
+					if category != "" && c.Category != category {
+						continue
+					}
+					for _, st := range c.SupportedStorageTypes.SupportedStorageType {
+						for _, r := range st.AvailableResources.AvailableResource {
+							entries = append(entries, RdsAvailableEntry{
+								ZoneId:        zone.ZoneId,
+								Engine:        e.Engine,
+								EngineVersion: v.Version,
+								Category:      c.Category,
+								StorageType:   st.StorageType,
+								InstanceClass: r.DBInstanceClass,
+								StorageRange:  r.DBInstanceStorageRange,
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,45 @@
+package alicloud
+
+import "fmt"
+
+func (client *AliyunClient) DescribeRosStack(stackId string) (*RosStack, error) {
+	args := RosStackArgs{
+		RegionId: client.Region,
+		StackId:  stackId,
+	}
+	stack := RosStack{}
+	if err := client.rosconn().Invoke("GetStack", &args, &stack); err != nil {
+		if NotFoundError(err, "ros") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("ROS stack %s not found", stackId))
+		}
+		return nil, err
+	}
+
+	// A deleted stack can still be got for a while.
+	if stack.StackId != stackId || stack.Status == RosStackDeleteComplete {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("ROS stack %s not found", stackId))
+	}
+	return &stack, nil
+}
+
+// WaitForRosStack waits for the stack to be in the status, and returns an error when the stack is in one of the failed statuses.
+func (client *AliyunClient) WaitForRosStack(stackId string, status RosStackStatus, failed []RosStackStatus, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			stack, err := client.DescribeRosStack(stackId)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, s := range failed {
+				if stack.Status == s {
+					return nil, "", fmt.Errorf("ROS stack %s is %s: %s.", stackId, stack.Status, stack.StatusReason)
+				}
+			}
+			return stack, string(stack.Status), nil
+		},
+		Target:  []string{string(status)},
+		Timeout: waitTimeout(timeout, defaultLongTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
@@ -0,0 +1,90 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (client *AliyunClient) DescribeSaeNamespace(namespaceId string) (*SaeNamespace, error) {
+	resp := SaeNamespaceResponse{}
+	query := url.Values{"NamespaceId": []string{namespaceId}}
+	if err := client.saeconn().Invoke(client.Region, http.MethodGet, "/pop/v1/paas/namespace", query, nil, &resp); err != nil {
+		if NotFoundError(err, "sae") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAE namespace %s not found", namespaceId))
+		}
+		return nil, err
+	}
+
+	if resp.Data.NamespaceId != namespaceId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAE namespace %s not found", namespaceId))
+	}
+	return &resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeSaeApplication(appId string) (*SaeApplication, error) {
+	resp := DescribeSaeApplicationResponse{}
+	query := url.Values{"AppId": []string{appId}}
+	if err := client.saeconn().Invoke(client.Region, http.MethodGet, "/pop/v1/sam/app/describeApplicationConfig", query, nil, &resp); err != nil {
+		if NotFoundError(err, "sae") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAE application %s not found", appId))
+		}
+		return nil, err
+	}
+
+	if resp.Data.AppId != appId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAE application %s not found", appId))
+	}
+	return &resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeSaeScalingRules(appId string) ([]SaeScalingRule, error) {
+	resp := DescribeSaeScalingRulesResponse{}
+	query := url.Values{"AppId": []string{appId}}
+	if err := client.saeconn().Invoke(client.Region, http.MethodGet, "/pop/v1/sam/scale/applicationScalingRules", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data.ApplicationScalingRules, nil
+}
+
+func (client *AliyunClient) DescribeSaeApplicationSlb(appId string) (*SaeApplicationSlb, error) {
+	resp := DescribeSaeApplicationSlbResponse{}
+	query := url.Values{"AppId": []string{appId}}
+	if err := client.saeconn().Invoke(client.Region, http.MethodGet, "/pop/v1/sam/app/slb", query, nil, &resp); err != nil {
+		if NotFoundError(err, "sae") {
+			return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAE application %s not found", appId))
+		}
+		return nil, err
+	}
+
+	if resp.Data.InternetSlbId == "" && resp.Data.IntranetSlbId == "" {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("SLB of SAE application %s not found", appId))
+	}
+	return &resp.Data, nil
+}
+
+// WaitForSaeChangeOrder waits for the change order, which is returned by the operations on an application, to be finished.
+func (client *AliyunClient) WaitForSaeChangeOrder(changeOrderId string, timeout int) error {
+	if changeOrderId == "" {
+		return nil
+	}
+	query := url.Values{"ChangeOrderId": []string{changeOrderId}}
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			resp := DescribeSaeChangeOrderResponse{}
+			if err := client.saeconn().Invoke(client.Region, http.MethodGet, "/pop/v1/sam/changeorder/DescribeChangeOrder", query, nil, &resp); err != nil {
+				return nil, "", err
+			}
+
+			switch resp.Data.Status {
+			case SaeChangeOrderFailed, SaeChangeOrderTerminated:
+				return nil, "", fmt.Errorf("SAE change order %s is not finished successfully: %s.", changeOrderId, resp.Data.Description)
+			}
+			return &resp.Data, fmt.Sprint(resp.Data.Status), nil
+		},
+		Target:  []string{fmt.Sprint(SaeChangeOrderSuccess)},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
@@ -0,0 +1,134 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+func (client *AliyunClient) DescribeSagAcl(aclId string) (*SagAcl, error) {
+	args := DescribeSagAclsArgs{
+		RegionId:   client.Region,
+		AclIds:     aclId,
+		PageNumber: 1,
+		PageSize:   10,
+	}
+	resp := DescribeSagAclsResponse{}
+	if err := client.sagconn().Invoke("DescribeACLs", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, acl := range resp.Acls.Acl {
+		if acl.AclId == aclId {
+			return &acl, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAG ACL %s not found", aclId))
+}
+
+func (client *AliyunClient) DescribeSagAclRule(aclId, acrId string) (*SagAclRule, error) {
+	args := DescribeSagAclAttributeArgs{
+		RegionId:   client.Region,
+		AclId:      aclId,
+		PageNumber: 1,
+		PageSize:   50,
+	}
+	for {
+		resp := DescribeSagAclAttributeResponse{}
+		if err := client.sagconn().Invoke("DescribeACLAttribute", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, rule := range resp.Acrs.Acr {
+			if rule.AcrId == acrId {
+				return &rule, nil
+			}
+		}
+		if len(resp.Acrs.Acr) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAG ACL rule %s of ACL %s not found", acrId, aclId))
+}
+
+func (client *AliyunClient) DescribeSmartAccessGateway(sagId string) (*SmartAccessGatewayAttribute, error) {
+	args := DescribeSmartAccessGatewayAttributeArgs{
+		RegionId:  client.Region,
+		SmartAGId: sagId,
+	}
+	resp := SmartAccessGatewayAttribute{}
+	if err := client.sagconn().Invoke("DescribeSmartAccessGatewayAttribute", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.SmartAGId != sagId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("smart access gateway %s not found", sagId))
+	}
+	return &resp, nil
+}
+
+func (client *AliyunClient) DescribeSagClientUser(sagId, userName string) (*SagClientUser, error) {
+	args := SagClientUserArgs{
+		RegionId:   client.Region,
+		SmartAGId:  sagId,
+		UserName:   userName,
+		PageNumber: 1,
+		PageSize:   10,
+	}
+	resp := DescribeSagClientUsersResponse{}
+	if err := client.sagconn().Invoke("DescribeSmartAccessGatewayClientUsers", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, user := range resp.Users.User {
+		if user.UserName == userName {
+			return &user, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAG client user %s of smart access gateway %s not found", userName, sagId))
+}
+
+func (client *AliyunClient) DescribeSagSnatEntry(sagId, entryId string) (*SagSnatEntry, error) {
+	args := SagSnatEntryArgs{
+		RegionId:   client.Region,
+		SmartAGId:  sagId,
+		PageNumber: 1,
+		PageSize:   50,
+	}
+	for {
+		resp := DescribeSagSnatEntriesResponse{}
+		if err := client.sagconn().Invoke("DescribeSnatEntries", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, entry := range resp.SnatEntries.SnatEntry {
+			if entry.InstanceId == entryId {
+				return &entry, nil
+			}
+		}
+		if len(resp.SnatEntries.SnatEntry) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAG SNAT entry %s of smart access gateway %s not found", entryId, sagId))
+}
+
+func (client *AliyunClient) DescribeSagDnatEntry(sagId, entryId string) (*SagDnatEntry, error) {
+	args := SagDnatEntryArgs{
+		RegionId:   client.Region,
+		SagId:      sagId,
+		PageNumber: 1,
+		PageSize:   50,
+	}
+	for {
+		resp := DescribeSagDnatEntriesResponse{}
+		if err := client.sagconn().Invoke("DescribeDnatEntries", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, entry := range resp.DnatEntries.DnatEntry {
+			if entry.DnatEntryId == entryId {
+				return &entry, nil
+			}
+		}
+		if len(resp.DnatEntries.DnatEntry) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("SAG DNAT entry %s of smart access gateway %s not found", entryId, sagId))
+}
@@ -1,13 +1,47 @@
 package alicloud
 
 import (
+	"fmt"
+
 	"github.com/denverdino/aliyungo/slb"
 )
 
+// SLBService is the part of the SLB client used by the resources, so that their logic can be tested against
+// the fakes without the credentials. The fakes are set by the conns of the AliyunClient with the key "slb".
+// It is the only service interface so far, and the other resources are still tested by the acceptance tests.
+type SLBService interface {
+	CreateLoadBalancer(args *slb.CreateLoadBalancerArgs) (*slb.CreateLoadBalancerResponse, error)
+	DeleteLoadBalancer(loadBalancerId string) error
+	DescribeLoadBalancers(args *slb.DescribeLoadBalancersArgs) ([]slb.LoadBalancerType, error)
+	DescribeLoadBalancerAttribute(loadBalancerId string) (*slb.LoadBalancerType, error)
+	SetLoadBalancerName(loadBalancerId string, loadBalancerName string) error
+	ModifyLoadBalancerInternetSpec(args *slb.ModifyLoadBalancerInternetSpecArgs) error
+
+	CreateLoadBalancerHTTPListener(args *slb.CreateLoadBalancerHTTPListenerArgs) error
+	CreateLoadBalancerHTTPSListener(args *slb.CreateLoadBalancerHTTPSListenerArgs) error
+	CreateLoadBalancerTCPListener(args *slb.CreateLoadBalancerTCPListenerArgs) error
+	CreateLoadBalancerUDPListener(args *slb.CreateLoadBalancerUDPListenerArgs) error
+	DeleteLoadBalancerListener(loadBalancerId string, port int) error
+	StartLoadBalancerListener(loadBalancerId string, port int) error
+	WaitForListenerAsyn(loadBalancerId string, port int, listenerType slb.ListenerType, status slb.ListenerStatus, timeout int) error
+	DescribeLoadBalancerHTTPListenerAttribute(loadBalancerId string, port int) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error)
+	DescribeLoadBalancerHTTPSListenerAttribute(loadBalancerId string, port int) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error)
+	DescribeLoadBalancerTCPListenerAttribute(loadBalancerId string, port int) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error)
+	DescribeLoadBalancerUDPListenerAttribute(loadBalancerId string, port int) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error)
+
+	AddBackendServers(loadBalancerId string, backendServers []slb.BackendServerType) ([]slb.BackendServerType, error)
+	RemoveBackendServers(loadBalancerId string, backendServers []string) ([]slb.BackendServerType, error)
+
+	// Invoke calls the APIs which are not wrapped by the SDK, like the tags and the resource groups.
+	Invoke(action string, args interface{}, response interface{}) error
+}
+
+var _ SLBService = &slb.Client{}
+
 func (client *AliyunClient) DescribeLoadBalancerAttribute(slbId string) (*slb.LoadBalancerType, error) {
-	loadBalancer, err := client.slbconn.DescribeLoadBalancerAttribute(slbId)
+	loadBalancer, err := client.slbconn().DescribeLoadBalancerAttribute(slbId)
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "slb") {
 			return nil, nil
 		}
 		return nil, err
@@ -19,3 +53,18 @@ func (client *AliyunClient) DescribeLoadBalancerAttribute(slbId string) (*slb.Lo
 
 	return nil, nil
 }
+
+// DescribeSlbAvailableResources returns the master and slave zone pairs in which the load balancers of the address
+// type and IP version can be purchased, and the empty ones match all.
+func (client *AliyunClient) DescribeSlbAvailableResources(addressType, ipVersion string) ([]SlbAvailableResource, error) {
+	args := DescribeSlbAvailableResourceArgs{
+		RegionId:         client.Region,
+		AddressType:      addressType,
+		AddressIPVersion: ipVersion,
+	}
+	resp := DescribeSlbAvailableResourceResponse{}
+	if err := client.slbconn().Invoke("DescribeAvailableResource", &args, &resp); err != nil {
+		return nil, fmt.Errorf("DescribeAvailableResource got an error: %#v", err)
+	}
+	return resp.AvailableResources.AvailableResource, nil
+}
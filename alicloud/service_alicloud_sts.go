@@ -0,0 +1,10 @@
+package alicloud
+
+// DescribeCallerIdentity returns the account, the RAM user or the assumed role of the credentials of the provider.
+func (client *AliyunClient) DescribeCallerIdentity() (*GetCallerIdentityResponse, error) {
+	resp := GetCallerIdentityResponse{}
+	if err := client.stsconn().Invoke("GetCallerIdentity", &GetCallerIdentityArgs{}, &resp); err != nil {
+		return nil, WrapError(err, "GetCallerIdentity", "")
+	}
+	return &resp, nil
+}
@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"fmt"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"strings"
@@ -15,15 +16,61 @@ func (client *AliyunClient) DescribeEipAddress(allocationId string) (*ecs.EipAdd
 		AllocationId: allocationId,
 	}
 
-	eips, _, err := client.ecsconn.DescribeEipAddresses(&args)
+	var eips []ecs.EipAddressSetType
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		page, _, err := client.ecsconn().DescribeEipAddresses(&args)
+		eips = append(eips, page...)
+		return len(page), err
+	})
 	if err != nil {
 		return nil, err
 	}
-	if len(eips) == 0 {
-		return nil, common.GetClientErrorFromString("Not found")
+	for _, eip := range eips {
+		if eip.AllocationId == allocationId {
+			return &eip, nil
+		}
+	}
+
+	return nil, common.GetClientErrorFromString("Not found")
+}
+
+// DescribeDefaultVpcId returns the id of the default VPC of the region, which is created by the system when the
+// first instance is launched without a VPC in the console.
+func (client *AliyunClient) DescribeDefaultVpcId() (string, error) {
+	args := DescribeDefaultVpcArgs{
+		RegionId:  client.Region,
+		IsDefault: true,
+	}
+	resp := DescribeDefaultVpcResponse{}
+	if err := client.vpcconn().Invoke("DescribeVpcs", &args, &resp); err != nil {
+		return "", err
+	}
+	for _, vpc := range resp.Vpcs.Vpc {
+		if vpc.IsDefault {
+			return vpc.VpcId, nil
+		}
 	}
+	return "", GetNotFoundErrorFromString(fmt.Sprintf("The default VPC of region %s not found", client.Region))
+}
 
-	return &eips[0], nil
+// DescribeVpcEipAddress describes the EIP with the VPC API, which returns the ISP, the charge type and the
+// deletion protection that are missing in the ECS one.
+func (client *AliyunClient) DescribeVpcEipAddress(allocationId string) (*VpcEipAddress, error) {
+	args := DescribeVpcEipAddressesArgs{
+		RegionId:     client.Region,
+		AllocationId: allocationId,
+	}
+	resp := DescribeVpcEipAddressesResponse{}
+	if err := client.vpcconn().Invoke("DescribeEipAddresses", &args, &resp); err != nil {
+		return nil, err
+	}
+	for _, eip := range resp.EipAddresses.EipAddress {
+		if eip.AllocationId == allocationId {
+			return &eip, nil
+		}
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("EIP %s not found", allocationId))
 }
 
 func (client *AliyunClient) DescribeNatGateway(natGatewayId string) (*ecs.NatGatewaySetType, error) {
@@ -33,17 +80,23 @@ func (client *AliyunClient) DescribeNatGateway(natGatewayId string) (*ecs.NatGat
 		NatGatewayId: natGatewayId,
 	}
 
-	natGateways, _, err := client.vpcconn.DescribeNatGateways(args)
-	//fmt.Println("natGateways %#v", natGateways)
+	var natGateways []ecs.NatGatewaySetType
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		page, _, err := client.vpcconn().DescribeNatGateways(args)
+		natGateways = append(natGateways, page...)
+		return len(page), err
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	if len(natGateways) == 0 {
-		return nil, common.GetClientErrorFromString("Not found")
+	for _, natGateway := range natGateways {
+		if natGateway.NatGatewayId == natGatewayId {
+			return &natGateway, nil
+		}
 	}
 
-	return &natGateways[0], nil
+	return nil, common.GetClientErrorFromString("Not found")
 }
 
 func (client *AliyunClient) DescribeVpc(vpcId string) (*ecs.VpcSetType, error) {
@@ -52,19 +105,26 @@ func (client *AliyunClient) DescribeVpc(vpcId string) (*ecs.VpcSetType, error) {
 		VpcId:    vpcId,
 	}
 
-	vpcs, _, err := client.ecsconn.DescribeVpcs(&args)
+	var vpcs []ecs.VpcSetType
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		page, _, err := client.ecsconn().DescribeVpcs(&args)
+		vpcs = append(vpcs, page...)
+		return len(page), err
+	})
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			return nil, nil
 		}
 		return nil, err
 	}
-
-	if len(vpcs) == 0 {
-		return nil, nil
+	for _, vpc := range vpcs {
+		if vpc.VpcId == vpcId {
+			return &vpc, nil
+		}
 	}
 
-	return &vpcs[0], nil
+	return nil, nil
 }
 
 func (client *AliyunClient) DescribeSnatEntry(snatTableId string, snatEntryId string) (ecs.SnatEntrySetType, error) {
@@ -76,7 +136,13 @@ func (client *AliyunClient) DescribeSnatEntry(snatTableId string, snatEntryId st
 		SnatTableId: snatTableId,
 	}
 
-	snatEntries, _, err := client.vpcconn.DescribeSnatTableEntries(args)
+	var snatEntries []ecs.SnatEntrySetType
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		entries, _, err := client.vpcconn().DescribeSnatTableEntries(args)
+		snatEntries = append(snatEntries, entries...)
+		return len(entries), err
+	})
 
 	//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
 	//so judge the snatEntries length priority
@@ -111,7 +177,13 @@ func (client *AliyunClient) DescribeForwardEntry(forwardTableId string, forwardE
 		ForwardTableId: forwardTableId,
 	}
 
-	forwardEntries, _, err := client.vpcconn.DescribeForwardTableEntries(args)
+	var forwardEntries []ecs.ForwardTableEntrySetType
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		entries, _, err := client.vpcconn().DescribeForwardTableEntries(args)
+		forwardEntries = append(forwardEntries, entries...)
+		return len(entries), err
+	})
 	//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
 	//so judge the snatEntries length priority
 	if len(forwardEntries) == 0 {
@@ -139,15 +211,20 @@ func (client *AliyunClient) DescribeForwardEntry(forwardTableId string, forwardE
 
 // describe vswitch by param filters
 func (client *AliyunClient) QueryVswitches(args *ecs.DescribeVSwitchesArgs) (vswitches []ecs.VSwitchSetType, err error) {
-	vsws, _, err := client.ecsconn.DescribeVSwitches(args)
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		vsws, _, err := client.ecsconn().DescribeVSwitches(args)
+		vswitches = append(vswitches, vsws...)
+		return len(vsws), err
+	})
 	if err != nil {
-		if NotFoundError(err) {
+		if NotFoundError(err, "vpc") {
 			return nil, nil
 		}
 		return nil, err
 	}
 
-	return vsws, nil
+	return vswitches, nil
 }
 
 func (client *AliyunClient) QueryVswitchById(vpcId, vswitchId string) (vsw *ecs.VSwitchSetType, err error) {
@@ -168,12 +245,17 @@ func (client *AliyunClient) QueryVswitchById(vpcId, vswitchId string) (vsw *ecs.
 }
 
 func (client *AliyunClient) QueryRouteTables(args *ecs.DescribeRouteTablesArgs) (routeTables []ecs.RouteTableSetType, err error) {
-	rts, _, err := client.ecsconn.DescribeRouteTables(args)
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		rts, _, err := client.ecsconn().DescribeRouteTables(args)
+		routeTables = append(routeTables, rts...)
+		return len(rts), err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return rts, nil
+	return routeTables, nil
 }
 
 func (client *AliyunClient) QueryRouteTableById(routeTableId string) (rt *ecs.RouteTableSetType, err error) {
@@ -208,8 +290,15 @@ func (client *AliyunClient) QueryRouteEntry(routeTableId, cidrBlock, nextHopType
 
 func (client *AliyunClient) GetVpcIdByVSwitchId(vswitchId string) (vpcId string, err error) {
 
-	vs, _, err := client.ecsconn.DescribeVpcs(&ecs.DescribeVpcsArgs{
+	args := ecs.DescribeVpcsArgs{
 		RegionId: client.Region,
+	}
+	var vs []ecs.VpcSetType
+	err = describeAllPages(func(pagination common.Pagination) (int, error) {
+		args.Pagination = pagination
+		page, _, err := client.ecsconn().DescribeVpcs(&args)
+		vs = append(vs, page...)
+		return len(page), err
 	})
 	if err != nil {
 		return "", err
@@ -226,6 +315,25 @@ func (client *AliyunClient) GetVpcIdByVSwitchId(vswitchId string) (vpcId string,
 	return "", &common.Error{ErrorResponse: common.ErrorResponse{Message: Notfound}}
 }
 
+// DescribeVSwitchPlacement returns the vpc id and zone id of the vswitch, and checks that
+// the vswitch belongs to the zone when zoneId is not empty.
+func (client *AliyunClient) DescribeVSwitchPlacement(vswitchId, zoneId string) (vpcId, zone string, err error) {
+	vpcId, err = client.GetVpcIdByVSwitchId(vswitchId)
+	if err != nil {
+		return "", "", fmt.Errorf("VswitchId %s is not valid of current region", vswitchId)
+	}
+
+	vsw, err := client.QueryVswitchById(vpcId, vswitchId)
+	if err != nil || vsw == nil {
+		return "", "", fmt.Errorf("VswitchId %s is not valid of current region", vswitchId)
+	}
+
+	if zoneId != "" && vsw.ZoneId != zoneId {
+		return "", "", fmt.Errorf("VswitchId %s is not belong to the zone %s", vswitchId, zoneId)
+	}
+	return vpcId, vsw.ZoneId, nil
+}
+
 func GetAllRouterInterfaceSpec() (specifications []string) {
 	specifications = append(specifications, string(ecs.Large1), string(ecs.Large2),
 		string(ecs.Small1), string(ecs.Small2), string(ecs.Small5), string(ecs.Middle1),
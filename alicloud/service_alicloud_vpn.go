@@ -0,0 +1,72 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// invokeVpnGateway invokes the route APIs of the vpn gateways, which are retried while the vpn gateway is
+// applying the previous changes until the timeout.
+func (client *AliyunClient) invokeVpnGateway(action string, args interface{}, resp interface{}, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		if err := client.vpcconn().Invoke(action, args, resp); err != nil {
+			if IsExceptedError(err, VpnGatewayConfiguring) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func (client *AliyunClient) DescribeVpnRouteEntry(vpnGatewayId, nextHop, routeDest string) (*VpnRouteEntry, error) {
+	args := DescribeVpnRouteEntriesArgs{
+		RegionId:     client.Region,
+		VpnGatewayId: vpnGatewayId,
+		PageNumber:   1,
+		PageSize:     50,
+	}
+	for {
+		resp := DescribeVpnRouteEntriesResponse{}
+		if err := client.vpcconn().Invoke("DescribeVpnRouteEntries", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, entry := range resp.VpnRouteEntries.VpnRouteEntry {
+			if entry.NextHop == nextHop && entry.RouteDest == routeDest {
+				return &entry, nil
+			}
+		}
+		if len(resp.VpnRouteEntries.VpnRouteEntry) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("vpn route entry %s to %s of vpn gateway %s not found", routeDest, nextHop, vpnGatewayId))
+}
+
+func (client *AliyunClient) DescribeVpnPbrRouteEntry(vpnGatewayId, nextHop, routeSource, routeDest string) (*VpnRouteEntry, error) {
+	args := DescribeVpnRouteEntriesArgs{
+		RegionId:     client.Region,
+		VpnGatewayId: vpnGatewayId,
+		PageNumber:   1,
+		PageSize:     50,
+	}
+	for {
+		resp := DescribeVpnPbrRouteEntriesResponse{}
+		if err := client.vpcconn().Invoke("DescribeVpnPbrRouteEntries", &args, &resp); err != nil {
+			return nil, err
+		}
+		for _, entry := range resp.VpnPbrRouteEntries.VpnPbrRouteEntry {
+			if entry.NextHop == nextHop && entry.RouteSource == routeSource && entry.RouteDest == routeDest {
+				return &entry, nil
+			}
+		}
+		if len(resp.VpnPbrRouteEntries.VpnPbrRouteEntry) < args.PageSize {
+			break
+		}
+		args.PageNumber++
+	}
+	return nil, GetNotFoundErrorFromString(fmt.Sprintf("vpn pbr route entry %s to %s of vpn gateway %s not found", routeSource, routeDest, vpnGatewayId))
+}
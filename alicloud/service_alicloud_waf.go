@@ -0,0 +1,73 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) DescribeWafInstance(instanceId string) (*WafInstanceInfo, error) {
+	args := WafInstanceArgs{
+		InstanceId: instanceId,
+	}
+	resp := DescribeWafInstanceInfoResponse{}
+	if err := client.wafconn().Invoke("DescribeInstanceInfo", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.InstanceInfo.InstanceId != instanceId {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("WAF instance %s not found", instanceId))
+	}
+	return &resp.InstanceInfo, nil
+}
+
+func (client *AliyunClient) WaitForWafInstance(instanceId, status string, timeout int) error {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			instance, err := client.DescribeWafInstance(instanceId)
+			if err != nil {
+				// The instance bought by the order is not returned until it is deployed.
+				if NotFoundError(err, "waf") {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return instance, strconv.Itoa(instance.Status), nil
+		},
+		Target:  []string{status},
+		Timeout: waitTimeout(timeout, defaultTimeout),
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (client *AliyunClient) DescribeWafDomain(instanceId, domain string) (*WafDomain, error) {
+	args := WafDomainArgs{
+		InstanceId: instanceId,
+		Domain:     domain,
+	}
+	resp := DescribeWafDomainResponse{}
+	if err := client.wafconn().Invoke("DescribeDomain", &args, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Domain.Cname == "" {
+		return nil, GetNotFoundErrorFromString(fmt.Sprintf("WAF domain %s of instance %s not found", domain, instanceId))
+	}
+	return &resp.Domain, nil
+}
+
+func (client *AliyunClient) DescribeWafProtectionMode(instanceId, domain string) (string, error) {
+	args := WafProtectionModeArgs{
+		InstanceId:  instanceId,
+		Domain:      domain,
+		DefenseType: WafDefenseTypeWaf,
+	}
+	resp := DescribeWafProtectionModeResponse{}
+	if err := client.wafconn().Invoke("DescribeProtectionModuleMode", &args, &resp); err != nil {
+		return "", err
+	}
+	for mode, value := range WafProtectionModes {
+		if value == resp.Mode {
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("Unknown protection mode %d of WAF domain %s", resp.Mode, domain)
+}
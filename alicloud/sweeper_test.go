@@ -0,0 +1,167 @@
+package alicloud
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/denverdino/aliyungo/ecs"
+)
+
+// The sweepers delete the resources leaked by the failed or interrupted acceptance tests, which are the
+// resources named with one of the sweepPrefixes. They are run in the regions separated by commas, like
+//
+//	go test ./alicloud -v -sweep=cn-beijing,cn-hangzhou
+//
+// and -sweep-run limits the sweepers to run, like -sweep-run=alicloud_instance,alicloud_slb.
+//
+// There are no sweepers of the VPN route entries and the KVStore accounts, because their acceptance tests
+// use the VPN gateway and the KVStore instance given by the environment variables rather than create them.
+var (
+	flagSweep    = flag.String("sweep", "", "List of regions to run the sweepers in, separated by commas")
+	flagSweepRun = flag.String("sweep-run", "", "List of sweepers to run, separated by commas, and all the sweepers are run when it is empty")
+)
+
+// The names of the resources created by the acceptance tests start with one of them.
+var sweepPrefixes = []string{
+	"tf-test",
+	"tf_test",
+	"tf-foo",
+	"test_foo",
+	"test_bar",
+	"testAcc",
+}
+
+// testSweeper deletes the leaked resources of one resource type in a region. The sweepers of the
+// Dependencies run before it, because their resources have to be deleted first, like the instances
+// in a vswitch.
+type testSweeper struct {
+	Dependencies []string
+	F            func(region string) error
+}
+
+var testSweepers = make(map[string]*testSweeper)
+
+// addTestSweepers registers the sweeper of the resource type, which is called in the init of the
+// resource test files.
+func addTestSweepers(name string, s *testSweeper) {
+	if _, ok := testSweepers[name]; ok {
+		log.Fatalf("[ERROR] Sweeper %s has been added", name)
+	}
+	testSweepers[name] = s
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if *flagSweep == "" {
+		os.Exit(m.Run())
+	}
+
+	if err := runSweepers(strings.Split(*flagSweep, ","), *flagSweepRun); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func runSweepers(regions []string, filter string) error {
+	var names []string
+	if filter == "" {
+		for name := range testSweepers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	} else {
+		names = strings.Split(filter, ",")
+	}
+
+	for _, region := range regions {
+		ran := make(map[string]bool)
+		for _, name := range names {
+			if err := runSweeperWithDependencies(strings.TrimSpace(name), strings.TrimSpace(region), ran); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func runSweeperWithDependencies(name, region string, ran map[string]bool) error {
+	if ran[name] {
+		return nil
+	}
+	s, ok := testSweepers[name]
+	if !ok {
+		return fmt.Errorf("Sweeper %s is not found", name)
+	}
+	ran[name] = true
+
+	for _, dep := range s.Dependencies {
+		if err := runSweeperWithDependencies(dep, region, ran); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Running sweeper %s in region %s", name, region)
+	if err := s.F(region); err != nil {
+		return fmt.Errorf("Running sweeper %s in region %s got an error: %s", name, region, err)
+	}
+	return nil
+}
+
+// sharedClientForRegion returns the client of the region with the credentials of the acceptance tests.
+func sharedClientForRegion(region string) (*AliyunClient, error) {
+	accessKey := os.Getenv("ALICLOUD_ACCESS_KEY")
+	if accessKey == "" {
+		return nil, fmt.Errorf("ALICLOUD_ACCESS_KEY must be set for the sweepers")
+	}
+	secretKey := os.Getenv("ALICLOUD_SECRET_KEY")
+	if secretKey == "" {
+		return nil, fmt.Errorf("ALICLOUD_SECRET_KEY must be set for the sweepers")
+	}
+
+	conf := Config{
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		Region:     common.Region(region),
+		MaxRetries: DefaultMaxRetries,
+	}
+	return conf.Client()
+}
+
+// isSweepable returns whether the resource is created by the acceptance tests.
+func isSweepable(name string) bool {
+	for _, prefix := range sweepPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sweepableVpcIds returns the ids of the VPCs created by the acceptance tests, which are used to sweep the
+// resources without a name, like the RDS instances, in them.
+func sweepableVpcIds(client *AliyunClient) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	err := describeAllPages(func(pagination common.Pagination) (int, error) {
+		vpcs, _, err := client.ecsconn().DescribeVpcs(&ecs.DescribeVpcsArgs{
+			RegionId:   client.Region,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return 0, WrapError(err, "DescribeVpcs", "")
+		}
+		for _, vpc := range vpcs {
+			if isSweepable(vpc.VpcName) {
+				ids[vpc.VpcId] = true
+			}
+		}
+		return len(vpcs), nil
+	})
+	return ids, err
+}
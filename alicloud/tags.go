@@ -1,9 +1,11 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"strings"
@@ -26,67 +28,256 @@ func tagsSchema() *schema.Schema {
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTags(client *AliyunClient, resourceType ecs.TagResourceType, d *schema.ResourceData) error {
+	conn := client.ecsconn()
 
-	conn := client.ecsconn
-
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			err := RemoveTags(conn, &RemoveTagsArgs{
-				RegionId:     client.Region,
-				ResourceId:   d.Id(),
-				ResourceType: resourceType,
-				Tag:          remove,
-			})
-			if err != nil {
-				return fmt.Errorf("Remove tags got error: %s", err)
-			}
+	return updateTags(d, client.DefaultTags, func() (map[string]string, error) {
+		return describeEcsTags(client, resourceType, d.Id())
+	}, func(tags []Tag) error {
+		return RemoveTags(conn, &RemoveTagsArgs{
+			RegionId:     client.Region,
+			ResourceId:   d.Id(),
+			ResourceType: resourceType,
+			Tag:          tags,
+		})
+	}, func(tags []Tag) error {
+		return AddTags(conn, &AddTagsArgs{
+			RegionId:     client.Region,
+			ResourceId:   d.Id(),
+			ResourceType: resourceType,
+			Tag:          tags,
+		})
+	})
+}
+
+// describeEcsTags returns the tags of the ECS resource, like the instance, disk and security group.
+func describeEcsTags(client *AliyunClient, resourceType ecs.TagResourceType, resourceId string) (map[string]string, error) {
+	tags, _, err := client.ecsconn().DescribeTags(&ecs.DescribeTagsArgs{
+		RegionId:     client.Region,
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tagsToMap(tags), nil
+}
+
+// updateTags sets the "tags" of the resource merged with the defaultTags of the provider, and calls remove with
+// the tags which are stale and add with the ones which should be set, because every product has its own tag API.
+// The tags to set are compared with the ones which describe returns from the resource, so that the default tags
+// added or changed in the provider are set by any update of the resource, while they are kept out of the diff
+// of the "tags". Only the tags in the old "tags" are removed, because the others, like the ones set by other
+// tools, are not managed by the resource.
+func updateTags(d *schema.ResourceData, defaultTags map[string]string, describe func() (map[string]string, error), remove, add func([]Tag) error) error {
+	desired := mergeDefaultTags(defaultTags, d.Get("tags").(map[string]interface{}))
+	if d.IsNewResource() && len(desired) == 0 {
+		return nil
+	}
+
+	current, err := describe()
+	if err != nil {
+		return fmt.Errorf("Describing tags of %s got an error: %s", d.Id(), err)
+	}
+
+	previous, _ := d.GetChange("tags")
+	stale, create := diffTags(current, desired, previous.(map[string]interface{}))
+
+	if len(stale) > 0 {
+		log.Printf("[DEBUG] Removing tags: %#v from %s", stale, d.Id())
+		if err := remove(stale); err != nil {
+			return fmt.Errorf("Remove tags got error: %s", err)
 		}
+	}
 
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			err := AddTags(conn, &AddTagsArgs{
-				RegionId:     client.Region,
-				ResourceId:   d.Id(),
-				ResourceType: resourceType,
-				Tag:          create,
-			})
-			if err != nil {
-				return fmt.Errorf("Creating tags got error: %s", err)
-			}
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+		if err := add(create); err != nil {
+			return fmt.Errorf("Creating tags got error: %s", err)
 		}
 	}
 
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTags(oldTags, newTags []Tag) ([]Tag, []Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[t.Key] = t.Value
+// diffTags returns the tags of the resource to remove, which are in the previous "tags" but not desired or
+// desired with another value, and the desired tags to add, which are missing or have another value.
+func diffTags(current map[string]string, desired, previous map[string]interface{}) (stale, create []Tag) {
+	for k, v := range current {
+		dv, ok := desired[k]
+		if _, managed := previous[k]; (!ok && managed) || (ok && dv.(string) != v) {
+			stale = append(stale, Tag{Key: k, Value: v})
+		}
+	}
+	for k, v := range desired {
+		if cv, ok := current[k]; !ok || cv != v.(string) {
+			create = append(create, Tag{Key: k, Value: v.(string)})
+		}
 	}
+	return
+}
 
-	// Build the list of what to remove
-	var remove []Tag
-	for _, t := range oldTags {
-		old, ok := create[t.Key]
-		if !ok || old != t.Value {
-			// Delete it!
-			remove = append(remove, t)
+// setVpcTags sets the tags for the resources of VPC, like the vpc, vswitch and EIP.
+func setVpcTags(client *AliyunClient, resourceType VpcTagResourceType, d *schema.ResourceData) error {
+	return updateTags(d, client.DefaultTags, func() (map[string]string, error) {
+		return describeVpcTags(client, resourceType, d.Id())
+	}, func(tags []Tag) error {
+		var keys []string
+		for _, t := range tags {
+			keys = append(keys, t.Key)
+		}
+		args := VpcTagResourcesArgs{
+			RegionId:     client.Region,
+			ResourceType: resourceType,
+			ResourceId:   []string{d.Id()},
+			TagKey:       keys,
+		}
+		return client.vpcconn().Invoke("UnTagResources", &args, &common.Response{})
+	}, func(tags []Tag) error {
+		args := VpcTagResourcesArgs{
+			RegionId:     client.Region,
+			ResourceType: resourceType,
+			ResourceId:   []string{d.Id()},
+			Tag:          tags,
 		}
+		return client.vpcconn().Invoke("TagResources", &args, &common.Response{})
+	})
+}
+
+func describeVpcTags(client *AliyunClient, resourceType VpcTagResourceType, resourceId string) (map[string]string, error) {
+	args := VpcTagResourcesArgs{
+		RegionId:     client.Region,
+		ResourceType: resourceType,
+		ResourceId:   []string{resourceId},
+	}
+	resp := ListVpcTagResourcesResponse{}
+	if err := client.vpcconn().Invoke("ListTagResources", &args, &resp); err != nil {
+		return nil, err
 	}
 
-	return tagsFromMap(create), remove
+	result := make(map[string]string)
+	for _, t := range resp.TagResources.TagResource {
+		if t.ResourceId == resourceId {
+			result[t.TagKey] = t.TagValue
+		}
+	}
+	return result, nil
+}
+
+func setSlbTags(client *AliyunClient, d *schema.ResourceData) error {
+	invoke := func(action string, tags []Tag) error {
+		var slbTags []SlbTag
+		for _, t := range tags {
+			slbTags = append(slbTags, SlbTag{TagKey: t.Key, TagValue: t.Value})
+		}
+		bytes, err := json.Marshal(slbTags)
+		if err != nil {
+			return err
+		}
+		args := SlbTagsArgs{
+			RegionId:       client.Region,
+			LoadBalancerId: d.Id(),
+			Tags:           string(bytes),
+		}
+		return client.slbconn().Invoke(action, &args, &common.Response{})
+	}
+
+	return updateTags(d, client.DefaultTags, func() (map[string]string, error) {
+		return describeSlbTags(client, d.Id())
+	}, func(tags []Tag) error {
+		return invoke("RemoveTags", tags)
+	}, func(tags []Tag) error {
+		return invoke("AddTags", tags)
+	})
+}
+
+func describeSlbTags(client *AliyunClient, loadBalancerId string) (map[string]string, error) {
+	args := SlbTagsArgs{
+		RegionId:       client.Region,
+		LoadBalancerId: loadBalancerId,
+	}
+	resp := DescribeSlbTagsResponse{}
+	if err := client.slbconn().Invoke("DescribeTags", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, t := range resp.TagSets.TagSet {
+		result[t.TagKey] = t.TagValue
+	}
+	return result, nil
+}
+
+func setRdsTags(client *AliyunClient, d *schema.ResourceData) error {
+	invoke := func(action string, tags []Tag) error {
+		args := RdsTagsArgs{
+			RegionId:     client.Region,
+			DBInstanceId: d.Id(),
+		}
+		for _, t := range tags {
+			args.Tag = append(args.Tag, RdsTag{Key: t.Key, Value: t.Value})
+		}
+		return client.rdsconn().Invoke(action, &args, &common.Response{})
+	}
+
+	return updateTags(d, client.DefaultTags, func() (map[string]string, error) {
+		return describeRdsTags(client, d.Id())
+	}, func(tags []Tag) error {
+		return invoke("RemoveTagsFromResource", tags)
+	}, func(tags []Tag) error {
+		return invoke("AddTagsToResource", tags)
+	})
+}
+
+func describeRdsTags(client *AliyunClient, instanceId string) (map[string]string, error) {
+	args := RdsTagsArgs{
+		RegionId:     client.Region,
+		DBInstanceId: instanceId,
+	}
+	resp := DescribeRdsTagsResponse{}
+	if err := client.rdsconn().Invoke("DescribeTags", &args, &resp); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, t := range resp.Items.TagInfos {
+		result[t.TagKey] = t.TagValue
+	}
+	return result, nil
+}
+
+// mergeDefaultTags returns the default tags of the provider merged with the tags of the resource, which
+// override the default ones with the same keys.
+func mergeDefaultTags(defaultTags map[string]string, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	return result
+}
+
+// ignoreDefaultTags removes the default tags of the provider from the tags read from the resource, unless
+// the "tags" of the resource set them too, so that they are not shown as a diff of the "tags". The default
+// tags missing on the resource are not shown either, as the "tags" of the resources like the scaling
+// configuration force a new resource, and they are set by the next update of the resource instead.
+func ignoreDefaultTags(client *AliyunClient, d *schema.ResourceData, tags map[string]string) map[string]string {
+	if len(client.DefaultTags) == 0 {
+		return tags
+	}
+
+	configured := d.Get("tags").(map[string]interface{})
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if dv, ok := client.DefaultTags[k]; ok && dv == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
 }
 
 // tagsFromMap returns the tags for the given map of data.
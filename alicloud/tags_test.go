@@ -0,0 +1,143 @@
+package alicloud
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{
+		"team":        "infra",
+		"environment": "production",
+	}
+	tags := map[string]interface{}{
+		"environment": "staging",
+		"name":        "tf-test",
+	}
+
+	expected := map[string]interface{}{
+		"team":        "infra",
+		"environment": "staging",
+		"name":        "tf-test",
+	}
+	if got := mergeDefaultTags(defaultTags, tags); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, but got %v", expected, got)
+	}
+}
+
+func TestIgnoreDefaultTags(t *testing.T) {
+	client := &AliyunClient{
+		DefaultTags: map[string]string{
+			"team":        "infra",
+			"environment": "production",
+			"owner":       "ops",
+		},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"owner": "ops",
+			"name":  "tf-test",
+		},
+	})
+
+	tags := map[string]string{
+		"team":        "infra",
+		"environment": "staging",
+		"owner":       "ops",
+		"name":        "tf-test",
+	}
+	// The team is only a default tag, and the environment is changed out of band, which should be shown as a diff.
+	expected := map[string]string{
+		"environment": "staging",
+		"owner":       "ops",
+		"name":        "tf-test",
+	}
+	if got := ignoreDefaultTags(client, d, tags); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, but got %v", expected, got)
+	}
+
+	// The team is a default tag added to the provider after the resource is created, which should not be shown
+	// as a diff, because it would replace the resources whose tags force a new resource.
+	tags = map[string]string{
+		"environment": "production",
+		"owner":       "ops",
+		"name":        "tf-test",
+	}
+	expected = map[string]string{
+		"owner": "ops",
+		"name":  "tf-test",
+	}
+	if got := ignoreDefaultTags(client, d, tags); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, but got %v", expected, got)
+	}
+}
+
+func TestUpdateTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"name": "tf-test",
+		},
+	})
+	d.SetId("i-abc123")
+	defaultTags := map[string]string{
+		"team": "infra",
+	}
+	// The owner is set out of band, which should be kept, and the team is a new default tag.
+	current := map[string]string{
+		"name":  "tf-test",
+		"owner": "ops",
+	}
+
+	var removed, added []Tag
+	err := updateTags(d, defaultTags, func() (map[string]string, error) {
+		return current, nil
+	}, func(tags []Tag) error {
+		removed = append(removed, tags...)
+		return nil
+	}, func(tags []Tag) error {
+		added = append(added, tags...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(removed) != 0 {
+		t.Fatalf("expected no removed tags, but got %v", removed)
+	}
+	if expected := []Tag{{Key: "team", Value: "infra"}}; !reflect.DeepEqual(added, expected) {
+		t.Fatalf("expected the added tags %v, but got %v", expected, added)
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	current := map[string]string{
+		"name":  "tf-test",
+		"owner": "ops",
+		"env":   "staging",
+		"team":  "infra",
+	}
+	desired := map[string]interface{}{
+		"name": "tf-test",
+		"env":  "production",
+		"cost": "foo",
+	}
+	// The team was in the tags of the resource and is removed, and the owner is set out of band.
+	previous := map[string]interface{}{
+		"name": "tf-test",
+		"env":  "staging",
+		"team": "infra",
+	}
+
+	stale, create := diffTags(current, desired, previous)
+	sort.Slice(stale, func(i, j int) bool { return stale[i].Key < stale[j].Key })
+	sort.Slice(create, func(i, j int) bool { return create[i].Key < create[j].Key })
+	if expected := []Tag{{Key: "env", Value: "staging"}, {Key: "team", Value: "infra"}}; !reflect.DeepEqual(stale, expected) {
+		t.Fatalf("expected the removed tags %v, but got %v", expected, stale)
+	}
+	if expected := []Tag{{Key: "cost", Value: "foo"}, {Key: "env", Value: "production"}}; !reflect.DeepEqual(create, expected) {
+		t.Fatalf("expected the added tags %v, but got %v", expected, create)
+	}
+}
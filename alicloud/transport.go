@@ -0,0 +1,518 @@
+package alicloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 5
+
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+
+	// defaultConnectTimeout is the connect timeout of the services without the client timeouts, like the one
+	// of the http.DefaultTransport.
+	defaultConnectTimeout = 30 * time.Second
+)
+
+// The error codes of the throttling, whose requests are rejected before they are processed, so they are
+// retried whatever the action is.
+var throttlingErrorCodes = []string{
+	"Throttling",
+	"Throttling.User",
+	"Throttling.Api",
+}
+
+// The error codes of the transient server errors, whose requests may have been processed, so they are only
+// retried for the idempotent requests.
+var retryableErrorCodes = []string{
+	"ServiceUnavailable",
+	"InternalError",
+	"UnknownError",
+}
+
+// The error code is in the JSON response of the RPC and ROA APIs, and in the XML response of the MNS and OSS APIs.
+var errorCodeRegexp = regexp.MustCompile(`"(?i:code)"\s*:\s*"([^"]+)"|<Code>([^<]+)</Code>`)
+
+// apiTransport is the transport of the service clients of a provider configuration. It paces the requests to
+// each service when the rate limiter is set, retries the throttled requests and the idempotent requests failed
+// by the transient errors with an exponential backoff and jitter, and logs the requests and responses when
+// TF_LOG is DEBUG.
+type apiTransport struct {
+	transport  http.RoundTripper
+	maxRetries int
+	// secretKey signs the retried RPC requests again, as their SignatureNonce can not be reused.
+	secretKey string
+	limiter   *rateLimiter
+	// debug logs the redacted requests and responses.
+	debug bool
+	// timeoutTransports are the transports of the services with the client timeouts, keyed by the service
+	// name, and the one keyed by an empty name has the default client timeouts of all the services.
+	timeoutTransports map[string]http.RoundTripper
+	// endpointServices are the services of the custom endpoints, keyed by the host of the endpoints.
+	endpointServices map[string]string
+}
+
+// ClientTimeout is the connect and read timeouts of the requests to a service. The read timeout is the
+// time to wait for the response after the request is sent, and a zero timeout means no limit.
+type ClientTimeout struct {
+	Connect time.Duration
+	Read    time.Duration
+}
+
+// The services of the hosts whose first label is not the service name. The first label of the other hosts
+// is the service name, which may be followed by the region like ecs-cn-hangzhou.
+var hostServices = map[string]string{
+	"r-kvstore":          "kvstore",
+	"m-kvstore":          "ocs",
+	"business":           "bss",
+	"metrics":            "cms",
+	"amqp-open":          "amqp",
+	"apigateway":         "cloudapi",
+	"alidns":             "dns",
+	"wafopenapi":         "waf",
+	"yundun-bastionhost": "bastionhost",
+}
+
+// newApiTransport returns the apiTransport of the service clients of the config. Each provider configuration,
+// like an aliased one, has its own transport, so that its proxy, retries, rate limit and client timeouts never
+// change the ones of the others. The OSS client has its own transport, so it does not use the apiTransport.
+func newApiTransport(c *Config) *apiTransport {
+	t := &apiTransport{
+		transport:         wrapBaseTransport(newTimeoutTransport(c.proxyFunc(), ClientTimeout{Connect: defaultConnectTimeout})),
+		maxRetries:        c.MaxRetries,
+		secretKey:         c.SecretKey,
+		debug:             isDebugLogging(),
+		timeoutTransports: make(map[string]http.RoundTripper),
+		endpointServices:  make(map[string]string),
+	}
+	if c.MaxRequestsPerSecond > 0 {
+		t.limiter = newRateLimiter(c.MaxRequestsPerSecond)
+	}
+
+	if c.ClientTimeout != (ClientTimeout{}) {
+		t.timeoutTransports[""] = newTimeoutTransport(c.proxyFunc(), c.ClientTimeout)
+	}
+	for service := range c.ServiceClientTimeouts {
+		t.timeoutTransports[service] = newTimeoutTransport(c.proxyFunc(), c.serviceClientTimeout(service))
+	}
+	for service, endpoint := range c.Endpoints {
+		if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+			t.endpointServices[u.Host] = service
+		} else {
+			t.endpointServices[endpoint] = service
+		}
+	}
+	return t
+}
+
+// wrapBaseTransport wraps the base transport of the new apiTransports. The acceptance tests replace it to
+// record and replay the interactions with the APIs.
+var wrapBaseTransport = func(transport http.RoundTripper) http.RoundTripper {
+	return transport
+}
+
+// newTimeoutTransport returns a transport like the http.DefaultTransport with the client timeout.
+func newTimeoutTransport(proxy func(*http.Request) (*url.URL, error), timeout ClientTimeout) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   timeout.Connect,
+		KeepAlive: 30 * time.Second,
+	}
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ResponseHeaderTimeout: timeout.Read,
+	}
+}
+
+// transportFor returns the transport with the client timeout of the service of the host, or the default one.
+func (t *apiTransport) transportFor(host string) http.RoundTripper {
+	if len(t.timeoutTransports) == 0 {
+		return t.transport
+	}
+	if rt, ok := t.timeoutTransports[t.serviceOfHost(host)]; ok {
+		return rt
+	}
+	if rt, ok := t.timeoutTransports[""]; ok {
+		return rt
+	}
+	return t.transport
+}
+
+// serviceOfHost returns the service name of the host, which is the one of the provider endpoints block when
+// the host is a custom endpoint.
+func (t *apiTransport) serviceOfHost(host string) string {
+	if service, ok := t.endpointServices[host]; ok {
+		return service
+	}
+
+	labels := strings.Split(strings.ToLower(host), ".")
+	// The FC, log and MNS hosts start with the account id or the project name, and the FnF hosts with the region.
+	for _, service := range []string{"fc", "log", "mns", "fnf"} {
+		for _, label := range labels[1:] {
+			if label == service {
+				return service
+			}
+		}
+	}
+	if service, ok := hostServices[labels[0]]; ok {
+		return service
+	}
+	return strings.SplitN(labels[0], "-", 2)[0]
+}
+
+func (t *apiTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	idempotent := isIdempotentRequest(req, body)
+	// The RPC requests are rejected when their SignatureNonce is reused, so they are only retried when they
+	// can be signed again, and the ROA requests are signed without a nonce.
+	params := requestParams(req, body)
+	rpc := params.Get("SignatureNonce") != ""
+	resignable := rpc && t.secretKey != "" && rpcSignature(req.Method, params, t.secretKey) == params.Get("Signature")
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		if t.limiter != nil {
+			t.limiter.wait(req.URL.Host)
+		}
+
+		if t.debug {
+			logRequest(req, body)
+		}
+		resp, err := t.transportFor(req.URL.Host).RoundTrip(req)
+		if t.debug {
+			logResponse(req, resp, err)
+		}
+		retryable, code := isRetryableResponse(resp, err, idempotent)
+		if !retryable || attempt >= t.maxRetries || (rpc && !resignable) {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		delay := retryDelay(attempt)
+		log.Printf("[DEBUG] Retrying %s %s in %s for %s (attempt %d of %d).", req.Method, req.URL.Host, delay, code, attempt+1, t.maxRetries)
+		time.Sleep(delay)
+
+		if rpc {
+			body = resignRpcRequest(req, body, t.secretKey)
+		}
+	}
+}
+
+// requestParams returns the parameters of the request, which are in the query and, for the RPC requests sent by
+// POST, in the form body.
+func requestParams(req *http.Request, body []byte) url.Values {
+	params := req.URL.Query()
+	if form, ok := requestForm(req, body); ok {
+		for k, v := range form {
+			params[k] = append(params[k], v...)
+		}
+	}
+	return params
+}
+
+func requestForm(req *http.Request, body []byte) (url.Values, bool) {
+	if req.Method != http.MethodPost || len(body) == 0 ||
+		!strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return nil, false
+	}
+	form, err := url.ParseQuery(string(body))
+	return form, err == nil
+}
+
+// resignRpcRequest sets a new SignatureNonce and Timestamp on the RPC request and signs it again, where each
+// parameter stays in the query or the form body it is sent in. It returns the new body.
+func resignRpcRequest(req *http.Request, body []byte, secretKey string) []byte {
+	nonce := make([]byte, 16)
+	crand.Read(nonce)
+	values := map[string]string{
+		"SignatureNonce": hex.EncodeToString(nonce),
+		"Timestamp":      time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+	}
+
+	query := req.URL.Query()
+	form, hasForm := requestForm(req, body)
+	for k, v := range values {
+		if hasForm && form.Get(k) != "" {
+			form.Set(k, v)
+		} else {
+			query.Set(k, v)
+		}
+	}
+
+	params := url.Values{}
+	for _, vs := range []url.Values{query, form} {
+		for k, v := range vs {
+			params[k] = append(params[k], v...)
+		}
+	}
+	signature := rpcSignature(req.Method, params, secretKey)
+	if hasForm && form.Get("Signature") != "" {
+		form.Set("Signature", signature)
+	} else {
+		query.Set("Signature", signature)
+	}
+
+	req.URL.RawQuery = query.Encode()
+	if hasForm {
+		body = []byte(form.Encode())
+		req.ContentLength = int64(len(body))
+	}
+	return body
+}
+
+// rpcSignature returns the HMAC-SHA1 signature of the RPC request with the parameters, which are canonicalized
+// without the Signature.
+func rpcSignature(method string, params url.Values, secretKey string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k != "Signature" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range params[k] {
+			pairs = append(pairs, percentEncode(k)+"="+percentEncode(v))
+		}
+	}
+	stringToSign := method + "&" + percentEncode("/") + "&" + percentEncode(strings.Join(pairs, "&"))
+
+	mac := hmac.New(sha1.New, []byte(secretKey+"&"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// percentEncode encodes the value as the RFC 3986 requires, which the signature of the RPC requests is based on.
+func percentEncode(value string) string {
+	encoded := url.QueryEscape(value)
+	encoded = strings.Replace(encoded, "+", "%20", -1)
+	encoded = strings.Replace(encoded, "*", "%2A", -1)
+	return strings.Replace(encoded, "%7E", "~", -1)
+}
+
+// The prefixes of the actions which only read, so they are safe to resend.
+var idempotentActionPrefixes = []string{"Describe", "List", "Get", "Query"}
+
+// isIdempotentRequest returns whether the request is safe to resend after it may have been processed. The
+// RPC requests are idempotent when their actions only read or they carry a ClientToken, and the ROA ones,
+// which have no action, when they are GET or HEAD.
+func isIdempotentRequest(req *http.Request, body []byte) bool {
+	query := req.URL.Query()
+	if req.Method == http.MethodPost && len(body) > 0 {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for k, v := range form {
+				query[k] = append(query[k], v...)
+			}
+		}
+	}
+	if query.Get("ClientToken") != "" {
+		return true
+	}
+	action := query.Get("Action")
+	if action == "" {
+		return req.Method == http.MethodGet || req.Method == http.MethodHead
+	}
+	for _, prefix := range idempotentActionPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableResponse returns whether the request should be retried and the reason. The throttled requests
+// are always retried, and the ones failed by the transient errors only when they are idempotent. The response
+// body is buffered so that it can still be read by the caller.
+func isRetryableResponse(resp *http.Response, err error, idempotent bool) (bool, string) {
+	if err != nil {
+		if e, ok := err.(interface {
+			Temporary() bool
+		}); ok && e.Temporary() && idempotent {
+			return true, err.Error()
+		}
+		return false, ""
+	}
+
+	if resp.StatusCode < http.StatusBadRequest {
+		return false, ""
+	}
+
+	body, readErr := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return false, ""
+	}
+
+	if m := errorCodeRegexp.FindSubmatch(body); m != nil {
+		code := string(m[1]) + string(m[2])
+		for _, c := range throttlingErrorCodes {
+			if code == c {
+				return true, code
+			}
+		}
+		for _, c := range retryableErrorCodes {
+			if code == c && idempotent {
+				return true, code
+			}
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true, resp.Status
+	}
+	if idempotent && (resp.StatusCode == http.StatusServiceUnavailable ||
+		resp.StatusCode == http.StatusBadGateway || resp.StatusCode == http.StatusGatewayTimeout) {
+		return true, resp.Status
+	}
+	return false, ""
+}
+
+// retryDelay returns the delay before the next attempt, which is doubled for each attempt and
+// jittered in the upper half.
+func retryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay << uint(attempt)
+	if delay > retryMaxDelay || delay <= 0 {
+		delay = retryMaxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// rateLimiter paces the requests to each host, which is the endpoint of a service, to the limit per second.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     map[string]time.Time
+}
+
+func newRateLimiter(requestsPerSecond int) *rateLimiter {
+	return &rateLimiter{
+		interval: time.Second / time.Duration(requestsPerSecond),
+		next:     make(map[string]time.Time),
+	}
+}
+
+// wait blocks until the next request to the host is allowed.
+func (l *rateLimiter) wait(host string) {
+	l.mu.Lock()
+	now := time.Now()
+	next := l.next[host]
+	if next.Before(now) {
+		next = now
+	}
+	l.next[host] = next.Add(l.interval)
+	l.mu.Unlock()
+
+	time.Sleep(next.Sub(now))
+}
+
+// The parameters, headers and fields matched are redacted in the debug logs, like the AccessKeyId,
+// the Signature, the SecurityToken and the passwords.
+var sensitiveNameRegexp = regexp.MustCompile(`(?i)accesskey|secret|signature|token|password|authorization|privatekey`)
+
+var (
+	sensitiveJsonRegexp = regexp.MustCompile(`("[^"]*(?i:accesskey|secret|signature|token|password|privatekey)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	sensitiveXmlRegexp  = regexp.MustCompile(`<([A-Za-z]*(?i:accesskey|secret|token|password|privatekey)[A-Za-z]*)>[^<]*<`)
+)
+
+const redacted = "******"
+
+func isDebugLogging() bool {
+	level := strings.ToUpper(os.Getenv("TF_LOG"))
+	return level == "DEBUG" || level == "TRACE"
+}
+
+func logRequest(req *http.Request, body []byte) {
+	headers := make([]string, 0, len(req.Header))
+	for k, v := range req.Header {
+		if sensitiveNameRegexp.MatchString(k) {
+			v = []string{redacted}
+		}
+		headers = append(headers, k+": "+strings.Join(v, ","))
+	}
+
+	u := *req.URL
+	u.RawQuery = redactQuery(u.RawQuery)
+	log.Printf("[DEBUG] API request: %s %s\nHeaders: %s\nBody: %s", req.Method, u.String(), strings.Join(headers, "; "), redactBody(body))
+}
+
+// logResponse logs the response, and the response body is buffered so that it can still be read by the caller.
+func logResponse(req *http.Request, resp *http.Response, err error) {
+	if err != nil {
+		log.Printf("[DEBUG] API response of %s %s: %s", req.Method, req.URL.Host+req.URL.Path, err)
+		return
+	}
+
+	body, readErr := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		log.Printf("[DEBUG] API response of %s %s: %s, reading the body got an error: %s", req.Method, req.URL.Host+req.URL.Path, resp.Status, readErr)
+		return
+	}
+	log.Printf("[DEBUG] API response of %s %s: %s\nBody: %s", req.Method, req.URL.Host+req.URL.Path, resp.Status, redactBody(body))
+}
+
+func redactQuery(rawQuery string) string {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return redacted
+	}
+	for k := range query {
+		if sensitiveNameRegexp.MatchString(k) {
+			query.Set(k, redacted)
+		}
+	}
+	return query.Encode()
+}
+
+// redactBody redacts the sensitive fields of the JSON, XML or form-urlencoded body.
+func redactBody(body []byte) string {
+	b := strings.TrimSpace(string(body))
+	switch {
+	case b == "":
+		return ""
+	case strings.HasPrefix(b, "{") || strings.HasPrefix(b, "["):
+		return sensitiveJsonRegexp.ReplaceAllString(b, `${1}"`+redacted+`"`)
+	case strings.HasPrefix(b, "<"):
+		return sensitiveXmlRegexp.ReplaceAllString(b, "<${1}>"+redacted+"<")
+	default:
+		return redactQuery(b)
+	}
+}
@@ -0,0 +1,300 @@
+package alicloud
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "Action=DescribeRegions" {
+			t.Errorf("the request body should be resent, but got %q", body)
+		}
+		if requests < 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"RequestId":"foo","Code":"Throttling.User","Message":"Request was denied due to user flow control."}`))
+			return
+		}
+		w.Write([]byte(`{"RequestId":"bar"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &apiTransport{transport: http.DefaultTransport, maxRetries: DefaultMaxRetries},
+	}
+	resp, err := client.Post(server.URL, "application/x-www-form-urlencoded", strings.NewReader("Action=DescribeRegions"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("the request should succeed after the retries, but got %s", resp.Status)
+	}
+	if requests != 3 {
+		t.Fatalf("the request should be sent 3 times, but sent %d times", requests)
+	}
+}
+
+func TestRetryTransport_maxRetries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"RequestId":"foo","Code":"ServiceUnavailable"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &apiTransport{transport: http.DefaultTransport, maxRetries: 1},
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if string(body) != `{"RequestId":"foo","Code":"ServiceUnavailable"}` {
+		t.Fatalf("the body of the last response should be returned, but got %q", body)
+	}
+	if requests != 2 {
+		t.Fatalf("the request should be sent 2 times, but sent %d times", requests)
+	}
+}
+
+func TestRetryTransport_notRetryable(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"RequestId":"foo","Code":"InvalidVpcID.NotFound"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &apiTransport{transport: http.DefaultTransport, maxRetries: DefaultMaxRetries},
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if requests != 1 {
+		t.Fatalf("the request should not be retried, but sent %d times", requests)
+	}
+}
+
+func TestRetryTransport_notIdempotent(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"RequestId":"foo","Code":"ServiceUnavailable"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &apiTransport{transport: http.DefaultTransport, maxRetries: DefaultMaxRetries},
+	}
+	cases := map[string]int{
+		"Action=CreateVpc":                 1,
+		"Action=CreateVpc&ClientToken=foo": DefaultMaxRetries + 1,
+	}
+	for body, expected := range cases {
+		requests = 0
+		resp, err := client.Post(server.URL, "application/x-www-form-urlencoded", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		resp.Body.Close()
+
+		if requests != expected {
+			t.Fatalf("the request %s should be sent %d times, but sent %d times", body, expected, requests)
+		}
+	}
+}
+
+func TestRetryTransport_resign(t *testing.T) {
+	var nonces []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		nonces = append(nonces, query.Get("SignatureNonce"))
+		if signature := rpcSignature(r.Method, query, "secret"); query.Get("Signature") != signature {
+			t.Errorf("the request should be signed again, but got the signature %s instead of %s", query.Get("Signature"), signature)
+		}
+		if len(nonces) < 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"RequestId":"foo","Code":"Throttling"}`))
+			return
+		}
+		w.Write([]byte(`{"RequestId":"bar"}`))
+	}))
+	defer server.Close()
+
+	query := url.Values{
+		"Action":           {"DescribeRegions"},
+		"SignatureMethod":  {"HMAC-SHA1"},
+		"SignatureVersion": {"1.0"},
+		"SignatureNonce":   {"foo"},
+		"Timestamp":        {"2018-01-01T00:00:00Z"},
+	}
+	query.Set("Signature", rpcSignature(http.MethodGet, query, "secret"))
+
+	client := &http.Client{
+		Transport: &apiTransport{transport: http.DefaultTransport, maxRetries: DefaultMaxRetries, secretKey: "secret"},
+	}
+	resp, err := client.Get(server.URL + "/?" + query.Encode())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("the request should succeed after the retries, but got %s", resp.Status)
+	}
+	if len(nonces) != 3 {
+		t.Fatalf("the request should be sent 3 times, but sent %d times", len(nonces))
+	}
+	for i, nonce := range nonces {
+		for _, other := range nonces[:i] {
+			if nonce == other {
+				t.Fatalf("each retry should have a new SignatureNonce, but got %v", nonces)
+			}
+		}
+	}
+}
+
+func TestRetryTransport_notResignable(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"RequestId":"foo","Code":"Throttling"}`))
+	}))
+	defer server.Close()
+
+	// The request signed by other credentials can not be signed again, so it is not retried with the same nonce.
+	client := &http.Client{
+		Transport: &apiTransport{transport: http.DefaultTransport, maxRetries: DefaultMaxRetries, secretKey: "secret"},
+	}
+	resp, err := client.Get(server.URL + "/?Action=DescribeRegions&SignatureNonce=foo&Signature=bar")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if requests != 1 {
+		t.Fatalf("the request should be sent once, but sent %d times", requests)
+	}
+}
+
+func TestIsIdempotentRequest(t *testing.T) {
+	cases := []struct {
+		method     string
+		url        string
+		body       string
+		idempotent bool
+	}{
+		{http.MethodGet, "https://ecs.aliyuncs.com/?Action=DescribeInstances", "", true},
+		{http.MethodPost, "https://vpc.aliyuncs.com/", "Action=ListTagResources", true},
+		{http.MethodPost, "https://vpc.aliyuncs.com/", "Action=CreateVpc", false},
+		{http.MethodPost, "https://vpc.aliyuncs.com/", "Action=CreateVpc&ClientToken=foo", true},
+		{http.MethodGet, "https://ecs.aliyuncs.com/?Action=DeleteInstance", "", false},
+		{http.MethodGet, "https://cs.aliyuncs.com/clusters", "", true},
+		{http.MethodPost, "https://cs.aliyuncs.com/clusters", `{"name":"foo"}`, false},
+	}
+	for _, c := range cases {
+		req, _ := http.NewRequest(c.method, c.url, nil)
+		if got := isIdempotentRequest(req, []byte(c.body)); got != c.idempotent {
+			t.Fatalf("%s %s %s should be idempotent %t, but got %t", c.method, c.url, c.body, c.idempotent, got)
+		}
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(10)
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		limiter.wait("ecs.aliyuncs.com")
+	}
+	limiter.wait("slb.aliyuncs.com")
+
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond || elapsed > time.Second {
+		t.Fatalf("6 requests to the same host should take about 500ms at 10 requests per second, but took %s", elapsed)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]string{
+		`{"RequestId":"foo","AccessKey":{"AccessKeyId":"LTAI4Fxx","AccessKeySecret":"bar"}}`: `{"RequestId":"foo","AccessKey":{"AccessKeyId":"******","AccessKeySecret":"******"}}`,
+		`<Queue><QueueName>foo</QueueName><SecurityToken>bar</SecurityToken></Queue>`:        `<Queue><QueueName>foo</QueueName><SecurityToken>******</SecurityToken></Queue>`,
+		`Action=CreateInstance&InstanceName=foo&Password=bar`:                                `Action=CreateInstance&InstanceName=foo&Password=%2A%2A%2A%2A%2A%2A`,
+	}
+	for body, expected := range cases {
+		if redacted := redactBody([]byte(body)); redacted != expected {
+			t.Fatalf("expected %s, but got %s", expected, redacted)
+		}
+	}
+}
+
+func TestApiTransport_serviceOfHost(t *testing.T) {
+	transport := &apiTransport{
+		endpointServices: map[string]string{"ecs.example.com": "ecs"},
+	}
+	cases := map[string]string{
+		"ecs-cn-hangzhou.aliyuncs.com":           "ecs",
+		"ecs.example.com":                        "ecs",
+		"rds.aliyuncs.com":                       "rds",
+		"r-kvstore.aliyuncs.com":                 "kvstore",
+		"metrics.cn-hangzhou.aliyuncs.com":       "cms",
+		"1234567890.cn-hangzhou.fc.aliyuncs.com": "fc",
+		"tf-test.cn-hangzhou.log.aliyuncs.com":   "log",
+		"cn-hangzhou.fnf.aliyuncs.com":           "fnf",
+		"polardb.aliyuncs.com":                   "polardb",
+	}
+	for host, service := range cases {
+		if got := transport.serviceOfHost(host); got != service {
+			t.Fatalf("the service of %s should be %s, but got %s", host, service, got)
+		}
+	}
+}
+
+func TestApiTransport_clientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte(`{"RequestId":"foo"}`))
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	transport := &apiTransport{
+		transport: http.DefaultTransport,
+		timeoutTransports: map[string]http.RoundTripper{
+			"rds": newTimeoutTransport(nil, ClientTimeout{Read: 50 * time.Millisecond}),
+			"ecs": newTimeoutTransport(nil, ClientTimeout{Read: 5 * time.Second}),
+		},
+		endpointServices: map[string]string{host: "rds"},
+	}
+	client := &http.Client{Transport: transport}
+	if _, err := client.Get(server.URL); err == nil {
+		t.Fatalf("the request should time out by the read timeout of the service")
+	}
+
+	transport.endpointServices[host] = "ecs"
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("the request should not time out by the read timeout of the other service: %s", err)
+	}
+	resp.Body.Close()
+}
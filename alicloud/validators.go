@@ -1,14 +1,13 @@
 package alicloud
 
 import (
-	"encoding/json"
 	"fmt"
-	"net"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/alibaba/terraform-provider/alicloud/validators"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/denverdino/aliyungo/cdn"
 	"github.com/denverdino/aliyungo/common"
@@ -16,7 +15,25 @@ import (
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/denverdino/aliyungo/ram"
 	"github.com/denverdino/aliyungo/slb"
-	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The validators checking the values against the types and the constants of the provider, like the
+// GroupRuleDirection, stay in the provider package, because the validators package could not import them
+// without an import cycle. The others are in the validators package, and are aliased here.
+var (
+	validateCIDRNetworkAddress         = validators.CIDRNetworkAddress
+	validateCIDRNetworkAddressWithMask = validators.CIDRNetworkAddressWithMask
+	validateAllowedStringValue         = validators.AllowedStringValue
+	validateAllowedSplitStringValue    = validators.AllowedSplitStringValue
+	validateAllowedIntValue            = validators.AllowedIntValue
+	validateIntegerInRange             = validators.IntegerInRange
+	validateStringLengthInRange        = validators.StringLengthInRange
+	validateArn                        = validators.Arn
+	validateTimeWindow                 = validators.TimeWindow
+	validateNameRegex                  = validators.NameRegex
+	validateJsonString                 = validators.JsonString
+	validateJsonDocument               = validators.JsonDocument
+	normalizeJsonString                = validators.NormalizeJsonString
 )
 
 // common
@@ -176,26 +193,6 @@ func validateSecurityPriority(v interface{}, k string) (ws []string, errors []er
 	return
 }
 
-// validateCIDRNetworkAddress ensures that the string value is a valid CIDR that
-// represents a network address - it adds an error otherwise
-func validateCIDRNetworkAddress(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	_, ipnet, err := net.ParseCIDR(value)
-	if err != nil {
-		errors = append(errors, fmt.Errorf(
-			"%q must contain a valid CIDR, got error parsing: %s", k, err))
-		return
-	}
-
-	if ipnet == nil || value != ipnet.String() {
-		errors = append(errors, fmt.Errorf(
-			"%q must contain a valid network CIDR, expected %q, got %q",
-			k, ipnet, value))
-	}
-
-	return
-}
-
 func validateRouteEntryNextHopType(v interface{}, k string) (ws []string, errors []error) {
 	nht := ecs.NextHopType(v.(string))
 	if nht != ecs.NextHopIntance && nht != ecs.NextHopTunnel {
@@ -207,29 +204,7 @@ func validateRouteEntryNextHopType(v interface{}, k string) (ws []string, errors
 }
 
 func validateSwitchCIDRNetworkAddress(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	_, ipnet, err := net.ParseCIDR(value)
-	if err != nil {
-		errors = append(errors, fmt.Errorf(
-			"%q must contain a valid CIDR, got error parsing: %s", k, err))
-		return
-	}
-
-	if ipnet == nil || value != ipnet.String() {
-		errors = append(errors, fmt.Errorf(
-			"%q must contain a valid network CIDR, expected %q, got %q",
-			k, ipnet, value))
-		return
-	}
-
-	mark, _ := strconv.Atoi(strings.Split(ipnet.String(), "/")[1])
-	if mark < 16 || mark > 29 {
-		errors = append(errors, fmt.Errorf(
-			"%q must contain a network CIDR which mark between 16 and 29",
-			k))
-	}
-
-	return
+	return validateCIDRNetworkAddressWithMask(16, 29)(v, k)
 }
 
 // validateIoOptimized ensures that the string value is a valid IoOptimized that
@@ -449,99 +424,6 @@ func validateDBBackupPeriod(v interface{}, k string) (ws []string, errors []erro
 	return
 }
 
-func validateAllowedStringValue(ss []string) schema.SchemaValidateFunc {
-	return func(v interface{}, k string) (ws []string, errors []error) {
-		value := v.(string)
-		existed := false
-		for _, s := range ss {
-			if s == value {
-				existed = true
-				break
-			}
-		}
-		if !existed {
-			errors = append(errors, fmt.Errorf(
-				"%q must contain a valid string value should in array %#v, got %q",
-				k, ss, value))
-		}
-		return
-
-	}
-}
-
-func validateAllowedSplitStringValue(ss []string, splitStr string) schema.SchemaValidateFunc {
-	return func(v interface{}, k string) (ws []string, errors []error) {
-		value := v.(string)
-		existed := false
-		tsList := strings.Split(value, splitStr)
-
-		for _, ts := range tsList {
-			existed = false
-			for _, s := range ss {
-				if ts == s {
-					existed = true
-					break
-				}
-			}
-		}
-		if !existed {
-			errors = append(errors, fmt.Errorf(
-				"%q must contain a valid string value should in %#v, got %q",
-				k, ss, value))
-		}
-		return
-
-	}
-}
-
-func validateAllowedIntValue(is []int) schema.SchemaValidateFunc {
-	return func(v interface{}, k string) (ws []string, errors []error) {
-		value := v.(int)
-		existed := false
-		for _, i := range is {
-			if i == value {
-				existed = true
-				break
-			}
-		}
-		if !existed {
-			errors = append(errors, fmt.Errorf(
-				"%q must contain a valid int value should in array %#v, got %q",
-				k, is, value))
-		}
-		return
-
-	}
-}
-
-func validateIntegerInRange(min, max int) schema.SchemaValidateFunc {
-	return func(v interface{}, k string) (ws []string, errors []error) {
-		value := v.(int)
-		if value < min {
-			errors = append(errors, fmt.Errorf(
-				"%q cannot be lower than %d: %d", k, min, value))
-		}
-		if value > max {
-			errors = append(errors, fmt.Errorf(
-				"%q cannot be higher than %d: %d", k, max, value))
-		}
-		return
-	}
-}
-
-//data source validate func
-//data_source_alicloud_image
-func validateNameRegex(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-
-	if _, err := regexp.Compile(value); err != nil {
-		errors = append(errors, fmt.Errorf(
-			"%q contains an invalid regular expression: %s",
-			k, err))
-	}
-	return
-}
-
 func validateImageOwners(v interface{}, k string) (ws []string, errors []error) {
 	if value := v.(string); value != "" {
 		owners := ecs.ImageOwnerAlias(value)
@@ -792,40 +674,6 @@ func validateRamPolicyName(v interface{}, k string) (ws []string, errors []error
 	return
 }
 
-// Takes a value containing JSON string and passes it through
-// the JSON parser to normalize it, returns either a parsing
-// error or normalized JSON string.
-func normalizeJsonString(jsonString interface{}) (string, error) {
-	var j interface{}
-
-	if jsonString == nil || jsonString.(string) == "" {
-		return "", nil
-	}
-
-	s := jsonString.(string)
-
-	err := json.Unmarshal([]byte(s), &j)
-	if err != nil {
-		return s, err
-	}
-
-	// The error is intentionally ignored here to allow empty policies to passthrough validation.
-	// This covers any interpolated values
-	bytes, _ := json.Marshal(j)
-
-	return string(bytes[:]), nil
-}
-
-func validateJsonString(v interface{}, k string) (ws []string, errors []error) {
-	if _, err := normalizeJsonString(v); err != nil {
-		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
-	}
-	if strings.Contains(v.(string), " ") || strings.Contains(v.(string), "\n") {
-		errors = append(errors, fmt.Errorf("%q can not contain any space or newline character.", k))
-	}
-	return
-}
-
 func validatePolicyType(v interface{}, k string) (ws []string, errors []error) {
 	value := ram.Type(v.(string))
 
@@ -1035,3 +883,92 @@ func validateRouterInterfaceDescription(v interface{}, k string) (ws []string, e
 	}
 	return
 }
+
+func validateKVStoreAccountName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	pattern := `^[a-z][a-z0-9_]{1,15}$`
+	if match, _ := regexp.Match(pattern, []byte(value)); !match {
+		errors = append(errors, fmt.Errorf("%q must start with a lowercase letter, can only contain lowercase letters, digits and underscores, and must be 2 to 16 characters.", k))
+	}
+	return
+}
+
+func validateKVStoreConnectionPrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	pattern := `^[a-z][a-z0-9-]{7,39}$`
+	if match, _ := regexp.Match(pattern, []byte(value)); !match {
+		errors = append(errors, fmt.Errorf("%q must start with a lowercase letter, can only contain lowercase letters, digits and hyphens, and must be 8 to 40 characters.", k))
+	}
+	return
+}
+
+func validateHBaseColdStorageSize(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value != 0 && (value < 800 || value > 1000000) {
+		errors = append(errors, fmt.Errorf("%q must be 0 to disable cold storage, or between 800 and 1000000 GB.", k))
+	}
+	return
+}
+
+func validateEssScheduledAction(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasPrefix(value, "ari:acs:ess:") {
+		errors = append(errors, fmt.Errorf("%q must be the ari of a scaling rule, like 'ari:acs:ess:<region>:<account id>:scalingrule/<rule id>'.", k))
+	}
+	return
+}
+
+func validateEssNotificationArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	pattern := `^acs:ess:[a-z0-9-]+:[0-9]+:(queue/[^/]+|topic/[^/]+|cloudmonitor)$`
+	if match, _ := regexp.Match(pattern, []byte(value)); !match {
+		errors = append(errors, fmt.Errorf("%q must be like 'acs:ess:<region>:<account id>:queue/<queue name>', 'acs:ess:<region>:<account id>:topic/<topic name>' or 'acs:ess:<region>:<account id>:cloudmonitor'.", k))
+	}
+	return
+}
+
+func validateCRName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	pattern := `^[a-z0-9][a-z0-9_-]{1,29}$`
+	if match, _ := regexp.Match(pattern, []byte(value)); !match {
+		errors = append(errors, fmt.Errorf("%q can only contain lowercase letters, digits, underscores and hyphens, can not start with an underscore or a hyphen, and must be 2 to 30 characters.", k))
+	}
+	return
+}
+
+func validateCRRepoSummary(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 100 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 100 characters and less than 1", k))
+	}
+	return
+}
+
+func validateCRRepoDetail(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 2000 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 2000 characters", k))
+	}
+	return
+}
+
+func validateOnsTopic(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	pattern := `^[a-zA-Z0-9_-]{3,64}$`
+	if match, _ := regexp.Match(pattern, []byte(value)); !match {
+		errors = append(errors, fmt.Errorf("%q can only contain letters, digits, underscores and hyphens, and must be 3 to 64 characters.", k))
+	}
+	if strings.HasPrefix(value, "CID") || strings.HasPrefix(value, "GID") {
+		errors = append(errors, fmt.Errorf("%q cannot start with 'CID' or 'GID'.", k))
+	}
+	return
+}
+
+func validateOnsGroupId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	pattern := `^GID[_-][a-zA-Z0-9_-]{3,60}$`
+	if match, _ := regexp.Match(pattern, []byte(value)); !match {
+		errors = append(errors, fmt.Errorf("%q must start with 'GID_' or 'GID-', can only contain letters, digits, underscores and hyphens, and must be 7 to 64 characters.", k))
+	}
+	return
+}
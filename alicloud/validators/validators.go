@@ -0,0 +1,244 @@
+// Package validators contains the schema validate functions which check the values against nothing but the
+// arguments, like the ranges and the allowed values, and so do not depend on the types and the constants of the
+// provider. They are aliased by the validate functions of the provider package, which keeps the ones checking
+// the values against its constants.
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// CIDRNetworkAddress ensures that the string value is a valid CIDR that
+// represents a network address - it adds an error otherwise
+func CIDRNetworkAddress(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, ipnet, err := net.ParseCIDR(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain a valid CIDR, got error parsing: %s", k, err))
+		return
+	}
+
+	if ipnet == nil || value != ipnet.String() {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain a valid network CIDR, expected %q, got %q",
+			k, ipnet, value))
+	}
+
+	return
+}
+
+// CIDRNetworkAddressWithMask ensures that the string value is a valid network CIDR whose mask
+// length is between min and max, like the 16 to 29 of the vswitch cidr_block.
+func CIDRNetworkAddressWithMask(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		ws, errors = CIDRNetworkAddress(v, k)
+		if len(errors) > 0 {
+			return
+		}
+
+		_, ipnet, _ := net.ParseCIDR(v.(string))
+		if mask, _ := ipnet.Mask.Size(); mask < min || mask > max {
+			errors = append(errors, fmt.Errorf(
+				"%q must contain a network CIDR whose mask length is between %d and %d, got %d",
+				k, min, max, mask))
+		}
+		return
+	}
+}
+
+// AllowedStringValue ensures that the string value is one of the ss.
+func AllowedStringValue(ss []string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		existed := false
+		for _, s := range ss {
+			if s == value {
+				existed = true
+				break
+			}
+		}
+		if !existed {
+			errors = append(errors, fmt.Errorf(
+				"%q must be one of %s, got %q",
+				k, strings.Join(ss, ", "), value))
+		}
+		return
+
+	}
+}
+
+// AllowedSplitStringValue ensures that each value separated by the splitStr in the string value is one of the ss.
+func AllowedSplitStringValue(ss []string, splitStr string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		tsList := strings.Split(value, splitStr)
+
+		for _, ts := range tsList {
+			existed := false
+			for _, s := range ss {
+				if ts == s {
+					existed = true
+					break
+				}
+			}
+			if !existed {
+				errors = append(errors, fmt.Errorf(
+					"%q must be values of %s separated by %q, got %q in %q",
+					k, strings.Join(ss, ", "), splitStr, ts, value))
+			}
+		}
+		return
+
+	}
+}
+
+// AllowedIntValue ensures that the int value is one of the is.
+func AllowedIntValue(is []int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		existed := false
+		for _, i := range is {
+			if i == value {
+				existed = true
+				break
+			}
+		}
+		if !existed {
+			valid := make([]string, 0, len(is))
+			for _, i := range is {
+				valid = append(valid, strconv.Itoa(i))
+			}
+			errors = append(errors, fmt.Errorf(
+				"%q must be one of %s, got %d",
+				k, strings.Join(valid, ", "), value))
+		}
+		return
+
+	}
+}
+
+// IntegerInRange ensures that the int value is between min and max, which are included.
+func IntegerInRange(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		if value < min || value > max {
+			errors = append(errors, fmt.Errorf(
+				"%q must be between %d and %d, got %d", k, min, max, value))
+		}
+		return
+	}
+}
+
+// StringLengthInRange ensures that the length of the string value is between min and max, which are included.
+func StringLengthInRange(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if len(value) < min || len(value) > max {
+			errors = append(errors, fmt.Errorf(
+				"%q must be %d to %d characters, got %d characters: %q", k, min, max, len(value), value))
+		}
+		return
+	}
+}
+
+// Arn ensures that the string value is an ARN of the resources, like acs:ram::<account id>:role/<role name>.
+// The service of the ARN is not checked when it is empty.
+func Arn(service string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		parts := strings.SplitN(value, ":", 5)
+		if len(parts) != 5 || parts[0] != "acs" || parts[1] == "" || parts[4] == "" {
+			errors = append(errors, fmt.Errorf(
+				"%q must be an ARN like 'acs:<service>:<region>:<account id>:<resource>', got %q", k, value))
+			return
+		}
+		if service != "" && parts[1] != service {
+			errors = append(errors, fmt.Errorf(
+				"%q must be an ARN of the %s service like 'acs:%s:<region>:<account id>:<resource>', got %q",
+				k, service, service, value))
+		}
+		return
+	}
+}
+
+var timeWindowRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):([0-5][0-9])Z-([01][0-9]|2[0-3]):([0-5][0-9])Z$`)
+
+// TimeWindow ensures that the string value is a time window in UTC, like 02:00Z-03:00Z, which is used
+// by the maintenance and the backup windows.
+func TimeWindow(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	m := timeWindowRegexp.FindStringSubmatch(value)
+	if m == nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a time window in UTC like 'HH:mmZ-HH:mmZ', got %q", k, value))
+		return
+	}
+	if m[1] == m[3] && m[2] == m[4] {
+		errors = append(errors, fmt.Errorf(
+			"%q must end at a different time from the start, got %q", k, value))
+	}
+	return
+}
+
+// NameRegex ensures that the string value is a valid regular expression, like the name_regex of the data sources.
+func NameRegex(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := regexp.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid regular expression: %s",
+			k, err))
+	}
+	return
+}
+
+// NormalizeJsonString takes a value containing JSON string and passes it through
+// the JSON parser to normalize it, returns either a parsing
+// error or normalized JSON string.
+func NormalizeJsonString(jsonString interface{}) (string, error) {
+	var j interface{}
+
+	if jsonString == nil || jsonString.(string) == "" {
+		return "", nil
+	}
+
+	s := jsonString.(string)
+
+	err := json.Unmarshal([]byte(s), &j)
+	if err != nil {
+		return s, err
+	}
+
+	// The error is intentionally ignored here to allow empty policies to passthrough validation.
+	// This covers any interpolated values
+	bytes, _ := json.Marshal(j)
+
+	return string(bytes[:]), nil
+}
+
+// JsonString ensures that the string value is a JSON without any space or newline character.
+func JsonString(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := NormalizeJsonString(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+	}
+	if strings.Contains(v.(string), " ") || strings.Contains(v.(string), "\n") {
+		errors = append(errors, fmt.Errorf("%q can not contain any space or newline character.", k))
+	}
+	return
+}
+
+// JsonDocument checks the JSON only, and the spaces are allowed as the JSON may contain queries or expressions.
+func JsonDocument(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := NormalizeJsonString(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,241 @@
+package validators
+
+import (
+	"testing"
+)
+
+func TestCIDRNetworkAddress(t *testing.T) {
+	validCIDRNetworkAddress := []string{"192.168.10.0/24", "0.0.0.0/0", "10.121.10.0/24"}
+	for _, v := range validCIDRNetworkAddress {
+		_, errors := CIDRNetworkAddress(v, "cidr_network_address")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid cidr network address: %q", v, errors)
+		}
+	}
+
+	invalidCIDRNetworkAddress := []string{"1.2.3.4", "0x38732/21"}
+	for _, v := range invalidCIDRNetworkAddress {
+		_, errors := CIDRNetworkAddress(v, "cidr_network_address")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid cidr network address", v)
+		}
+	}
+}
+
+func TestAllowedStringValue(t *testing.T) {
+	exceptValues := []string{"aliyun", "alicloud", "alibaba"}
+	validValues := []string{"aliyun"}
+	for _, v := range validValues {
+		_, errors := AllowedStringValue(exceptValues)(v, "allowvalue")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid value in %#v: %q", v, exceptValues, errors)
+		}
+	}
+
+	invalidValues := []string{"ali", "alidata", "terraform"}
+	for _, v := range invalidValues {
+		_, errors := AllowedStringValue(exceptValues)(v, "allowvalue")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid value", v)
+		}
+	}
+}
+
+func TestAllowedStringSplitValue(t *testing.T) {
+	exceptValues := []string{"aliyun", "alicloud", "alibaba"}
+	validValues := "aliyun,alicloud"
+	_, errors := AllowedSplitStringValue(exceptValues, ",")(validValues, "allowvalue")
+	if len(errors) != 0 {
+		t.Fatalf("%q should be a valid value in %#v: %q", validValues, exceptValues, errors)
+	}
+
+	invalidValues := "ali,alidata"
+	_, invalidErr := AllowedSplitStringValue(exceptValues, ",")(invalidValues, "allowvalue")
+	if len(invalidErr) == 0 {
+		t.Fatalf("%q should be an invalid value", invalidValues)
+	}
+}
+
+func TestAllowedIntValue(t *testing.T) {
+	exceptValues := []int{1, 3, 5, 6}
+	validValues := []int{1, 3, 5, 6}
+	for _, v := range validValues {
+		_, errors := AllowedIntValue(exceptValues)(v, "allowvalue")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid value in %#v: %q", v, exceptValues, errors)
+		}
+	}
+
+	invalidValues := []int{0, 7, 10}
+	for _, v := range invalidValues {
+		_, errors := AllowedIntValue(exceptValues)(v, "allowvalue")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid value", v)
+		}
+	}
+}
+
+func TestIntegerInRange(t *testing.T) {
+	validIntegers := []int{-259, 0, 1, 5, 999}
+	min := -259
+	max := 999
+	for _, v := range validIntegers {
+		_, errors := IntegerInRange(min, max)(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be an integer in range (%d, %d): %q", v, min, max, errors)
+		}
+	}
+
+	invalidIntegers := []int{-260, -99999, 1000, 25678}
+	for _, v := range invalidIntegers {
+		_, errors := IntegerInRange(min, max)(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an integer outside range (%d, %d)", v, min, max)
+		}
+	}
+}
+
+func TestStringLengthInRange(t *testing.T) {
+	validStrings := []string{"abc", "abcde", "abcdefghij"}
+	min := 3
+	max := 10
+	for _, v := range validStrings {
+		_, errors := StringLengthInRange(min, max)(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a string with length in range (%d, %d): %q", v, min, max, errors)
+		}
+	}
+
+	invalidStrings := []string{"", "ab", "abcdefghijk"}
+	for _, v := range invalidStrings {
+		_, errors := StringLengthInRange(min, max)(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be a string with length outside range (%d, %d)", v, min, max)
+		}
+	}
+}
+
+func TestJsonDocument(t *testing.T) {
+	validDocuments := []string{`{"query":"* | select count(1)"}`, `[{"title":"count"}]`, `{}`}
+	for _, v := range validDocuments {
+		_, errors := JsonDocument(v, "charts")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid JSON document: %q", v, errors)
+		}
+	}
+
+	invalidDocuments := []string{`{"query":}`, `[{"title":"count"}`, `query`}
+	for _, v := range invalidDocuments {
+		_, errors := JsonDocument(v, "charts")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid JSON document", v)
+		}
+	}
+}
+
+func TestCIDRNetworkAddressWithMask(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{"172.16.0.0/12", true},
+		{"192.168.0.0/24", true},
+		{"10.0.0.0/8", true},
+		{"10.0.0.0/7", false},
+		{"192.168.1.0/28", false},
+		{"192.168.1.1/24", false},
+		{"192.168.1.0", false},
+	}
+	for _, c := range cases {
+		_, errors := CIDRNetworkAddressWithMask(8, 24)(c.value, "cidr_block")
+		if c.valid && len(errors) != 0 {
+			t.Fatalf("%q should be a valid CIDR with the mask length between 8 and 24: %q", c.value, errors)
+		}
+		if !c.valid && len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CIDR with the mask length between 8 and 24", c.value)
+		}
+	}
+}
+
+func TestArn(t *testing.T) {
+	cases := []struct {
+		service string
+		value   string
+		valid   bool
+	}{
+		{"ram", "acs:ram::1234567890:role/aliyunfcdefaultrole", true},
+		{"", "acs:oss:cn-shanghai:1234567890:foo-bucket", true},
+		{"", "acs:log:cn-shanghai:1234567890:project/foo", true},
+		{"ram", "acs:oss:cn-shanghai:1234567890:foo-bucket", false},
+		{"ram", "aliyunfcdefaultrole", false},
+		{"", "arn:aws:iam::1234567890:role/foo", false},
+		{"", "acs:ram::1234567890:", false},
+	}
+	for _, c := range cases {
+		_, errors := Arn(c.service)(c.value, "role")
+		if c.valid && len(errors) != 0 {
+			t.Fatalf("%q should be a valid ARN of the service %q: %q", c.value, c.service, errors)
+		}
+		if !c.valid && len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ARN of the service %q", c.value, c.service)
+		}
+	}
+}
+
+func TestTimeWindow(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{"02:00Z-03:00Z", true},
+		{"23:30Z-00:30Z", true},
+		{"00:00Z-01:00Z", true},
+		{"02:00-03:00", false},
+		{"24:00Z-01:00Z", false},
+		{"02:60Z-03:00Z", false},
+		{"2:00Z-3:00Z", false},
+		{"02:00Z-02:00Z", false},
+	}
+	for _, c := range cases {
+		_, errors := TimeWindow(c.value, "maintain_time")
+		if c.valid && len(errors) != 0 {
+			t.Fatalf("%q should be a valid time window: %q", c.value, errors)
+		}
+		if !c.valid && len(errors) == 0 {
+			t.Fatalf("%q should be an invalid time window", c.value)
+		}
+	}
+}
+
+func TestMessages(t *testing.T) {
+	cases := []struct {
+		errors   []error
+		expected string
+	}{
+		{
+			second(AllowedStringValue([]string{"PayByTraffic", "PayByBandwidth"})("PayByHour", "internet_charge_type")),
+			`"internet_charge_type" must be one of PayByTraffic, PayByBandwidth, got "PayByHour"`,
+		},
+		{
+			second(AllowedIntValue([]int{1, 2, 3})(4, "period")),
+			`"period" must be one of 1, 2, 3, got 4`,
+		},
+		{
+			second(IntegerInRange(1, 100)(0, "bandwidth")),
+			`"bandwidth" must be between 1 and 100, got 0`,
+		},
+		{
+			second(AllowedSplitStringValue([]string{"http_2xx", "http_3xx"}, ",")("http_2xx,http_4xx", "health_check_http_code")),
+			`"health_check_http_code" must be values of http_2xx, http_3xx separated by ",", got "http_4xx" in "http_2xx,http_4xx"`,
+		},
+	}
+	for _, c := range cases {
+		if len(c.errors) != 1 || c.errors[0].Error() != c.expected {
+			t.Fatalf("expected the error %q, but got %q", c.expected, c.errors)
+		}
+	}
+}
+
+func second(_ []string, errors []error) []error {
+	return errors
+}
@@ -218,24 +218,6 @@ func TestValidateSecurityRulePriority(t *testing.T) {
 	}
 }
 
-func TestValidateCIDRNetworkAddress(t *testing.T) {
-	validCIDRNetworkAddress := []string{"192.168.10.0/24", "0.0.0.0/0", "10.121.10.0/24"}
-	for _, v := range validCIDRNetworkAddress {
-		_, errors := validateCIDRNetworkAddress(v, "cidr_network_address")
-		if len(errors) != 0 {
-			t.Fatalf("%q should be a valid cidr network address: %q", v, errors)
-		}
-	}
-
-	invalidCIDRNetworkAddress := []string{"1.2.3.4", "0x38732/21"}
-	for _, v := range invalidCIDRNetworkAddress {
-		_, errors := validateCIDRNetworkAddress(v, "cidr_network_address")
-		if len(errors) == 0 {
-			t.Fatalf("%q should be an invalid cidr network address", v)
-		}
-	}
-}
-
 func TestValidateRouteEntryNextHopType(t *testing.T) {
 	validNexthopType := []string{"Instance", "Tunnel"}
 	for _, v := range validNexthopType {
@@ -428,75 +410,137 @@ func TestValidateSlbListenerBandwidth(t *testing.T) {
 	}
 }
 
-func TestValidateAllowedStringValue(t *testing.T) {
-	exceptValues := []string{"aliyun", "alicloud", "alibaba"}
-	validValues := []string{"aliyun"}
-	for _, v := range validValues {
-		_, errors := validateAllowedStringValue(exceptValues)(v, "allowvalue")
+func TestValidateKVStoreAccountName(t *testing.T) {
+	validNames := []string{"tf_test", "ab", "redis01", "a_b_c_d_e_f_g_hi"}
+	for _, v := range validNames {
+		_, errors := validateKVStoreAccountName(v, "account_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid kvstore account name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"a", "1abc", "Abc", "tf-test", "a_b_c_d_e_f_g_hij"}
+	for _, v := range invalidNames {
+		_, errors := validateKVStoreAccountName(v, "account_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid kvstore account name", v)
+		}
+	}
+}
+
+func TestValidateHBaseColdStorageSize(t *testing.T) {
+	validSizes := []int{0, 800, 1024, 1000000}
+	for _, v := range validSizes {
+		_, errors := validateHBaseColdStorageSize(v, "cold_storage_size")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid hbase cold storage size: %q", v, errors)
+		}
+	}
+
+	invalidSizes := []int{-1, 100, 799, 1000001}
+	for _, v := range invalidSizes {
+		_, errors := validateHBaseColdStorageSize(v, "cold_storage_size")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid hbase cold storage size", v)
+		}
+	}
+}
+
+func TestValidateEssScheduledAction(t *testing.T) {
+	validActions := []string{"ari:acs:ess:cn-beijing:1234567890:scalingrule/asr-abc123"}
+	for _, v := range validActions {
+		_, errors := validateEssScheduledAction(v, "scheduled_action")
 		if len(errors) != 0 {
-			t.Fatalf("%q should be a valid value in %#v: %q", v, exceptValues, errors)
+			t.Fatalf("%q should be a valid scheduled action: %q", v, errors)
 		}
 	}
 
-	invalidValues := []string{"ali", "alidata", "terraform"}
-	for _, v := range invalidValues {
-		_, errors := validateAllowedStringValue(exceptValues)(v, "allowvalue")
+	invalidActions := []string{"", "asr-abc123", "acs:ess:cn-beijing:1234567890:scalingrule/asr-abc123"}
+	for _, v := range invalidActions {
+		_, errors := validateEssScheduledAction(v, "scheduled_action")
 		if len(errors) == 0 {
-			t.Fatalf("%q should be an invalid value", v)
+			t.Fatalf("%q should be an invalid scheduled action", v)
 		}
 	}
 }
 
-func TestValidateAllowedStringSplitValue(t *testing.T) {
-	exceptValues := []string{"aliyun", "alicloud", "alibaba"}
-	validValues := "aliyun,alicloud"
-	_, errors := validateAllowedSplitStringValue(exceptValues, ",")(validValues, "allowvalue")
-	if len(errors) != 0 {
-		t.Fatalf("%q should be a valid value in %#v: %q", validValues, exceptValues, errors)
+func TestValidateEssNotificationArn(t *testing.T) {
+	validArns := []string{
+		"acs:ess:cn-beijing:1234567890:queue/tf-test",
+		"acs:ess:cn-beijing:1234567890:topic/tf-test",
+		"acs:ess:cn-beijing:1234567890:cloudmonitor",
+	}
+	for _, v := range validArns {
+		_, errors := validateEssNotificationArn(v, "notification_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid notification arn: %q", v, errors)
+		}
 	}
 
-	invalidValues := "ali,alidata"
-	_, invalidErr := validateAllowedSplitStringValue(exceptValues, ",")(invalidValues, "allowvalue")
-	if len(invalidErr) == 0 {
-		t.Fatalf("%q should be an invalid value", invalidValues)
+	invalidArns := []string{
+		"",
+		"acs:mns:cn-beijing:1234567890:queue/tf-test",
+		"acs:ess:cn-beijing:1234567890:bucket/tf-test",
+		"acs:ess:cn-beijing:account:queue/tf-test",
+	}
+	for _, v := range invalidArns {
+		_, errors := validateEssNotificationArn(v, "notification_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid notification arn", v)
+		}
+	}
+}
+
+func TestValidateCRName(t *testing.T) {
+	validNames := []string{"tf-test", "tf_test", "0test", "ab"}
+	for _, v := range validNames {
+		_, errors := validateCRName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid container registry name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"", "a", "-test", "_test", "Test", "tf.test", "abcdefghijklmnopqrstuvwxyz01234"}
+	for _, v := range invalidNames {
+		_, errors := validateCRName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid container registry name", v)
+		}
 	}
 }
 
-func TestValidateAllowedIntValue(t *testing.T) {
-	exceptValues := []int{1, 3, 5, 6}
-	validValues := []int{1, 3, 5, 6}
-	for _, v := range validValues {
-		_, errors := validateAllowedIntValue(exceptValues)(v, "allowvalue")
+func TestValidateOnsTopic(t *testing.T) {
+	validTopics := []string{"tf-test", "tf_test_topic", "abc"}
+	for _, v := range validTopics {
+		_, errors := validateOnsTopic(v, "topic")
 		if len(errors) != 0 {
-			t.Fatalf("%q should be a valid value in %#v: %q", v, exceptValues, errors)
+			t.Fatalf("%q should be a valid ONS topic: %q", v, errors)
 		}
 	}
 
-	invalidValues := []int{0, 7, 10}
-	for _, v := range invalidValues {
-		_, errors := validateAllowedIntValue(exceptValues)(v, "allowvalue")
+	invalidTopics := []string{"", "ab", "tf.test", "CID_test", "GID_test"}
+	for _, v := range invalidTopics {
+		_, errors := validateOnsTopic(v, "topic")
 		if len(errors) == 0 {
-			t.Fatalf("%q should be an invalid value", v)
+			t.Fatalf("%q should be an invalid ONS topic", v)
 		}
 	}
 }
 
-func TestValidateIntegerInRange(t *testing.T) {
-	validIntegers := []int{-259, 0, 1, 5, 999}
-	min := -259
-	max := 999
-	for _, v := range validIntegers {
-		_, errors := validateIntegerInRange(min, max)(v, "name")
+func TestValidateOnsGroupId(t *testing.T) {
+	validGroupIds := []string{"GID_tf-test", "GID-tf_test", "GID_abc"}
+	for _, v := range validGroupIds {
+		_, errors := validateOnsGroupId(v, "group_id")
 		if len(errors) != 0 {
-			t.Fatalf("%q should be an integer in range (%d, %d): %q", v, min, max, errors)
+			t.Fatalf("%q should be a valid ONS group id: %q", v, errors)
 		}
 	}
 
-	invalidIntegers := []int{-260, -99999, 1000, 25678}
-	for _, v := range invalidIntegers {
-		_, errors := validateIntegerInRange(min, max)(v, "name")
+	invalidGroupIds := []string{"", "tf-test", "CID_tf-test", "GID_ab", "GID_tf.test"}
+	for _, v := range invalidGroupIds {
+		_, errors := validateOnsGroupId(v, "group_id")
 		if len(errors) == 0 {
-			t.Fatalf("%q should be an integer outside range (%d, %d)", v, min, max)
+			t.Fatalf("%q should be an invalid ONS group id", v)
 		}
 	}
 }
@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// stateWaiterPending is the state reported to the resource.StateChangeConf for all the states except the target
+// and the failed ones, when the pending states of the waiter are not listed.
+const stateWaiterPending = "Pending"
+
+// stateWaiter waits for the state of a resource, which is returned by the Refresh, to turn into one of the
+// Target states with the resource.StateChangeConf, so that the eventual consistency of the APIs is handled
+// in the same way by all the resources.
+type stateWaiter struct {
+	// Refresh returns the resource and its state. A nil resource means that it is not found yet, and the
+	// waiter keeps waiting for it until the timeout.
+	Refresh resource.StateRefreshFunc
+	// Pending are the states to keep waiting in, and the other states are unexpected. The waiter keeps waiting
+	// in all the states except the Target and the Failed ones when it is empty, as the full state lists of
+	// many APIs are not documented.
+	Pending []string
+	Target  []string
+	// Failed are the states in which the resource never turns into the Target ones.
+	Failed []string
+	// PollInterval is the minimum interval between the refreshes, and it is DefaultIntervalShort seconds by default.
+	PollInterval time.Duration
+	// Timeout is defaultTimeout seconds by default.
+	Timeout time.Duration
+}
+
+// Wait returns the resource in the target state, or an error when the refresh fails, the resource is
+// in a failed or unexpected state or the waiter times out.
+func (w *stateWaiter) Wait() (interface{}, error) {
+	conf := &resource.StateChangeConf{
+		Pending:    w.Pending,
+		Target:     w.Target,
+		Refresh:    w.refresh,
+		Timeout:    w.Timeout,
+		MinTimeout: w.PollInterval,
+	}
+	if len(conf.Pending) == 0 {
+		conf.Pending = []string{stateWaiterPending}
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = defaultTimeout * time.Second
+	}
+	if conf.MinTimeout <= 0 {
+		conf.MinTimeout = DefaultIntervalShort * time.Second
+	}
+	// The resource which is not found is waited for until the timeout.
+	conf.NotFoundChecks = int(conf.Timeout/conf.MinTimeout) + 1
+
+	return conf.WaitForState()
+}
+
+func (w *stateWaiter) refresh() (interface{}, string, error) {
+	obj, state, err := w.Refresh()
+	if err != nil || obj == nil {
+		return obj, state, err
+	}
+
+	for _, s := range w.Failed {
+		if state == s {
+			return obj, state, fmt.Errorf("The state %s is failed.", state)
+		}
+	}
+	if len(w.Pending) == 0 && !containsState(w.Target, state) {
+		log.Printf("[DEBUG] Waiting for the state %v, and the current state is %s.", w.Target, state)
+		return obj, stateWaiterPending, nil
+	}
+	return obj, state, nil
+}
+
+func containsState(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// waitTimeout returns the timeout of the WaitFor* functions, which is in seconds like the ones of the SDK.
+func waitTimeout(timeout, defaultValue int) time.Duration {
+	if timeout <= 0 {
+		timeout = defaultValue
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+// operationDeadline is the deadline of the timeout of a Create, Update or Delete, so that all the waits of the
+// operation share its timeout instead of each of them waiting for the whole timeout.
+type operationDeadline time.Time
+
+func newOperationDeadline(d *schema.ResourceData, key string) operationDeadline {
+	return operationDeadline(time.Now().Add(d.Timeout(key)))
+}
+
+// Remaining returns the time left before the deadline, which is at least one second so that a wait after the
+// deadline still checks the state once.
+func (t operationDeadline) Remaining() time.Duration {
+	remaining := time.Time(t).Sub(time.Now())
+	if remaining < time.Second {
+		return time.Second
+	}
+	return remaining
+}
+
+// Seconds returns the time left before the deadline in seconds for the WaitFor* functions.
+func (t operationDeadline) Seconds() int {
+	return int(t.Remaining() / time.Second)
+}
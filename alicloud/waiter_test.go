@@ -0,0 +1,90 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// testStateRefresh returns the states one by one, and a nil resource for the empty state.
+func testStateRefresh(states ...string) func() (interface{}, string, error) {
+	i := 0
+	return func() (interface{}, string, error) {
+		state := states[i]
+		if i < len(states)-1 {
+			i++
+		}
+		if state == "" {
+			return nil, "", nil
+		}
+		return state, state, nil
+	}
+}
+
+func TestStateWaiter(t *testing.T) {
+	waiter := &stateWaiter{
+		Refresh:      testStateRefresh("", "Creating", "Starting", "Running"),
+		Target:       []string{"Running"},
+		PollInterval: 10 * time.Millisecond,
+		Timeout:      10 * time.Second,
+	}
+	obj, err := waiter.Wait()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if obj.(string) != "Running" {
+		t.Fatalf("the resource in the target state should be returned, but got %s", obj)
+	}
+}
+
+func TestStateWaiter_failed(t *testing.T) {
+	waiter := &stateWaiter{
+		Refresh:      testStateRefresh("Creating", "CreateFailed"),
+		Target:       []string{"Running"},
+		Failed:       []string{"CreateFailed"},
+		PollInterval: 10 * time.Millisecond,
+		Timeout:      10 * time.Second,
+	}
+	if _, err := waiter.Wait(); err == nil {
+		t.Fatalf("the waiter should fail in the failed state")
+	}
+}
+
+func TestStateWaiter_unexpected(t *testing.T) {
+	waiter := &stateWaiter{
+		Refresh:      testStateRefresh("Creating", "Deleting"),
+		Pending:      []string{"Creating"},
+		Target:       []string{"Running"},
+		PollInterval: 10 * time.Millisecond,
+		Timeout:      10 * time.Second,
+	}
+	if _, err := waiter.Wait(); err == nil {
+		t.Fatalf("the waiter should fail in the state which is not pending")
+	}
+}
+
+func TestStateWaiter_refreshError(t *testing.T) {
+	waiter := &stateWaiter{
+		Refresh: func() (interface{}, string, error) {
+			return nil, "", fmt.Errorf("DescribeInstances got an error")
+		},
+		Target:       []string{"Running"},
+		PollInterval: 10 * time.Millisecond,
+		Timeout:      10 * time.Second,
+	}
+	if _, err := waiter.Wait(); err == nil {
+		t.Fatalf("the error of the refresh should be returned")
+	}
+}
+
+func TestStateWaiter_timeout(t *testing.T) {
+	waiter := &stateWaiter{
+		Refresh:      testStateRefresh("Creating"),
+		Target:       []string{"Running"},
+		PollInterval: 10 * time.Millisecond,
+		Timeout:      500 * time.Millisecond,
+	}
+	if _, err := waiter.Wait(); err == nil {
+		t.Fatalf("the waiter should time out")
+	}
+}